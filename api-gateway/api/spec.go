@@ -0,0 +1,9 @@
+// Package api содержит OpenAPI-описание REST API шлюза.
+package api
+
+import _ "embed"
+
+// OpenAPISpec - документ OpenAPI 3.1 из openapi.json; при изменении маршрутов его нужно обновлять вручную
+//
+//go:embed openapi.json
+var OpenAPISpec []byte
@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"strings"
 
+	"github.com/Abdurahmanit/GroupProject/api-gateway/internal/client"
 	"github.com/Abdurahmanit/GroupProject/api-gateway/internal/config"
 	"github.com/Abdurahmanit/GroupProject/api-gateway/internal/handler"
 	"github.com/Abdurahmanit/GroupProject/api-gateway/internal/middleware"
@@ -13,7 +15,6 @@ import (
 	"github.com/go-chi/chi/v5"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 func main() {
@@ -31,8 +32,35 @@ func main() {
 		logger.Fatal("Failed to load API Gateway config", zap.Error(err))
 	}
 
+	// Повторы выполняются внутри circuit breaker: он видит одну ошибку на весь вызов, а не на каждую попытку
+	retry := handler.RetryInterceptor(handler.RetryPolicy{
+		MaxAttempts:    cfg.RetryMaxAttempts,
+		InitialBackoff: cfg.RetryInitialBackoff,
+		MaxBackoff:     cfg.RetryMaxBackoff,
+		Timeout:        cfg.RetryTimeout,
+	}, logger)
+
+	grpcOpts := client.Options{
+		Insecure:         cfg.GRPCInsecure,
+		CAFile:           cfg.GRPCTLSCAFile,
+		CertFile:         cfg.GRPCTLSCertFile,
+		KeyFile:          cfg.GRPCTLSKeyFile,
+		KeepaliveTime:    cfg.GRPCKeepaliveTime,
+		KeepaliveTimeout: cfg.GRPCKeepaliveTimeout,
+		MaxRecvMsgSize:   cfg.GRPCMaxRecvMsgSize,
+		MaxSendMsgSize:   cfg.GRPCMaxSendMsgSize,
+	}
+	if cfg.GRPCInsecure {
+		logger.Warn("Connecting to services without TLS (GRPC_INSECURE=true)")
+	}
+
 	userConnAddr := fmt.Sprintf("%s:%d", cfg.UserServiceHost, cfg.UserServicePort)
-	userConn, err := grpc.NewClient(userConnAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	userConn, err := client.Dial(userConnAddr, grpcOpts,
+		grpc.WithChainUnaryInterceptor(handler.CircuitBreakerInterceptor("user-service", handler.BreakerSettings{
+			MaxFailures: cfg.UserBreakerMaxFailures,
+			OpenTimeout: cfg.UserBreakerOpenTimeout,
+		}, logger), retry),
+	)
 	if err != nil {
 		logger.Fatal("Failed to connect to User Service", zap.String("address", userConnAddr), zap.Error(err))
 	}
@@ -41,7 +69,12 @@ func main() {
 
 	// Подключение к Listing Service
 	listingConnAddr := fmt.Sprintf("%s:%d", cfg.ListingServiceHost, cfg.ListingServicePort)
-	listingConn, err := grpc.NewClient(listingConnAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	listingConn, err := client.Dial(listingConnAddr, grpcOpts,
+		grpc.WithChainUnaryInterceptor(handler.CircuitBreakerInterceptor("listing-service", handler.BreakerSettings{
+			MaxFailures: cfg.ListingBreakerMaxFailures,
+			OpenTimeout: cfg.ListingBreakerOpenTimeout,
+		}, logger), retry),
+	)
 	if err != nil {
 		logger.Fatal("Failed to connect to Listing Service", zap.String("address", listingConnAddr), zap.Error(err))
 	}
@@ -50,7 +83,12 @@ func main() {
 
 	// Подключение к Review Service (Новое)
 	reviewConnAddr := fmt.Sprintf("%s:%d", cfg.ReviewServiceHost, cfg.ReviewServicePort)
-	reviewConn, err := grpc.NewClient(reviewConnAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	reviewConn, err := client.Dial(reviewConnAddr, grpcOpts,
+		grpc.WithChainUnaryInterceptor(handler.CircuitBreakerInterceptor("review-service", handler.BreakerSettings{
+			MaxFailures: cfg.ReviewBreakerMaxFailures,
+			OpenTimeout: cfg.ReviewBreakerOpenTimeout,
+		}, logger), retry),
+	)
 	if err != nil {
 		logger.Fatal("Failed to connect to Review Service", zap.String("address", reviewConnAddr), zap.Error(err))
 	}
@@ -61,12 +99,55 @@ func main() {
 	userHandler := handler.NewUserHandler(userConn, logger)
 	listingHandler := handler.NewListingHandler(listingConn, logger)
 	reviewHandler := handler.NewReviewHandler(reviewConn, logger)
+	aggregateHandler := handler.NewAggregateHandler(listingConn, reviewConn, cfg.AggregateTimeout, logger)
+
+	cacheTTLs, err := middleware.ParseCacheTTLs(cfg.ResponseCacheTTLs)
+	if err != nil {
+		logger.Fatal("Invalid RESPONSE_CACHE_TTLS", zap.Error(err))
+	}
+	responseCache := middleware.NewResponseCache(cacheTTLs, cfg.ResponseCacheMaxEntries)
+
+	timeoutOverrides, err := middleware.ParseTimeoutOverrides(cfg.RequestTimeoutOverrides)
+	if err != nil {
+		logger.Fatal("Invalid REQUEST_TIMEOUT_OVERRIDES", zap.Error(err))
+	}
+
+	trustedProxies, err := middleware.ParseTrustedProxies(config.SplitList(cfg.TrustedProxies))
+	if err != nil {
+		logger.Fatal("Invalid TRUSTED_PROXIES", zap.Error(err))
+	}
+
+	var limiter middleware.Limiter
+	if cfg.RateLimitRPS > 0 {
+		limiter = middleware.NewTokenBucketLimiter(cfg.RateLimitRPS, cfg.RateLimitBurst)
+	}
+	rateLimit := middleware.RateLimit(limiter, cfg.RateLimitPerUser)
+
+	var revocation middleware.RevocationChecker
+	if cfg.JWTRevocationCheck {
+		revocation = userHandler
+	}
+	auth := middleware.JWTAuth(cfg.JWTSecret, revocation)
 
 	r := chi.NewRouter()
-	r.Use(middleware.Logger(logger))
-	router.SetupUserRoutes(r, userHandler, cfg.JWTSecret)
-	router.SetupListingRoutes(r, listingHandler, cfg.JWTSecret)
-	router.SetupReviewRoutes(r, reviewHandler, cfg.JWTSecret)
+	r.Use(middleware.RequestID)
+	r.Use(middleware.RealIP(trustedProxies))
+	r.Use(middleware.Logger(logger, strings.Split(cfg.AccessLogSkipPaths, ",")...))
+	r.Use(middleware.Recoverer(logger))
+	r.Use(middleware.CORS(middleware.CORSOptions{
+		AllowedOrigins:   config.SplitList(cfg.CORSAllowedOrigins),
+		AllowedMethods:   config.SplitList(cfg.CORSAllowedMethods),
+		AllowedHeaders:   config.SplitList(cfg.CORSAllowedHeaders),
+		AllowCredentials: cfg.CORSAllowCredentials,
+		MaxAge:           cfg.CORSMaxAgeSeconds,
+	}))
+	r.Use(middleware.Compress(cfg.CompressMinSize))
+	r.Use(middleware.Timeout(r, cfg.RequestTimeout, timeoutOverrides))
+	router.SetupUserRoutes(r, userHandler, auth, rateLimit)
+	router.SetupListingRoutes(r, listingHandler, auth, responseCache, rateLimit)
+	router.SetupReviewRoutes(r, reviewHandler, auth, responseCache, rateLimit)
+	router.SetupAggregateRoutes(r, aggregateHandler, rateLimit)
+	router.SetupDocsRoutes(r)
 
 	// Запуск HTTP сервера
 	httpServerAddr := fmt.Sprintf(":%d", cfg.Port)
@@ -1,22 +1,32 @@
 module github.com/Abdurahmanit/GroupProject/api-gateway
 
-go 1.23.4
+go 1.24.2
 
 require (
 	github.com/Abdurahmanit/GroupProject/listing-service v0.0.0
 	github.com/Abdurahmanit/GroupProject/user-service v0.0.0-20250529172304-38141d74e416
 	github.com/go-chi/chi/v5 v5.2.1
 	github.com/golang-jwt/jwt/v5 v5.2.2
+	github.com/sony/gobreaker v1.0.0
 	github.com/spf13/viper v1.20.1
+	go.opentelemetry.io/otel v1.36.0
+	go.opentelemetry.io/otel/trace v1.36.0
 	go.uber.org/zap v1.27.0
+	golang.org/x/sync v0.14.0
 	google.golang.org/grpc v1.72.2
 )
 
 replace github.com/Abdurahmanit/GroupProject/listing-service => ../listing-service
 
+replace github.com/Abdurahmanit/GroupProject/user-service => ../user-service
+
+replace github.com/Abdurahmanit/GroupProject/review-service => ../review-service
+
 require (
 	github.com/Abdurahmanit/GroupProject/review-service v0.0.0-20250529233351-364af3648168
 	github.com/fsnotify/fsnotify v1.8.0 // indirect
+	github.com/go-logr/logr v1.4.2 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
 	github.com/go-viper/mapstructure/v2 v2.2.1 // indirect
 	github.com/google/go-cmp v0.7.0 // indirect
 	github.com/pelletier/go-toml/v2 v2.2.3 // indirect
@@ -26,6 +36,8 @@ require (
 	github.com/spf13/cast v1.7.1 // indirect
 	github.com/spf13/pflag v1.0.6 // indirect
 	github.com/subosito/gotenv v1.6.0 // indirect
+	go.opentelemetry.io/auto/sdk v1.1.0 // indirect
+	go.opentelemetry.io/otel/metric v1.36.0 // indirect
 	go.uber.org/multierr v1.10.0 // indirect
 	golang.org/x/net v0.40.0 // indirect
 	golang.org/x/sys v0.33.0 // indirect
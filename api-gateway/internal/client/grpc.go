@@ -0,0 +1,102 @@
+// Package client создает gRPC-соединения шлюза с сервисами.
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
+)
+
+// Options - общие параметры соединений с сервисами
+type Options struct {
+	// Insecure разрешает соединение без TLS; без него TLS обязателен
+	Insecure bool
+	// CAFile - сертификат CA для проверки сервисов; CertFile и KeyFile задаются вместе для mTLS
+	CAFile   string
+	CertFile string
+	KeyFile  string
+
+	// KeepaliveTime - пинг соединения после такого простоя (0 - без keepalive);
+	// KeepaliveTimeout - сколько ждать ответа на пинг, прежде чем закрыть соединение
+	KeepaliveTime    time.Duration
+	KeepaliveTimeout time.Duration
+
+	// Максимальный размер сообщений в байтах; 0 - значение gRPC по умолчанию
+	MaxRecvMsgSize int
+	MaxSendMsgSize int
+}
+
+// Dial создает соединение с addr с учетом opts; extra добавляются после общих опций (например, интерцепторы).
+// Соединение устанавливается лениво, но ошибки конфигурации TLS возвращаются сразу.
+func Dial(addr string, opts Options, extra ...grpc.DialOption) (*grpc.ClientConn, error) {
+	creds, err := TransportCredentials(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	dialOpts := []grpc.DialOption{grpc.WithTransportCredentials(creds)}
+	if opts.KeepaliveTime > 0 {
+		dialOpts = append(dialOpts, grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:    opts.KeepaliveTime,
+			Timeout: opts.KeepaliveTimeout,
+		}))
+	}
+
+	var callOpts []grpc.CallOption
+	if opts.MaxRecvMsgSize > 0 {
+		callOpts = append(callOpts, grpc.MaxCallRecvMsgSize(opts.MaxRecvMsgSize))
+	}
+	if opts.MaxSendMsgSize > 0 {
+		callOpts = append(callOpts, grpc.MaxCallSendMsgSize(opts.MaxSendMsgSize))
+	}
+	if len(callOpts) > 0 {
+		dialOpts = append(dialOpts, grpc.WithDefaultCallOptions(callOpts...))
+	}
+
+	conn, err := grpc.NewClient(addr, append(dialOpts, extra...)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gRPC client for %s: %w", addr, err)
+	}
+	return conn, nil
+}
+
+// TransportCredentials возвращает insecure-креды, только если это явно разрешено,
+// иначе TLS с CA из CAFile (или системными корневыми сертификатами) и, если задан, клиентским сертификатом.
+func TransportCredentials(opts Options) (credentials.TransportCredentials, error) {
+	if opts.Insecure {
+		return insecure.NewCredentials(), nil
+	}
+
+	tlsCfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if opts.CAFile != "" {
+		caPEM, err := os.ReadFile(opts.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read gRPC CA file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("gRPC CA file %s contains no PEM certificates", opts.CAFile)
+		}
+		tlsCfg.RootCAs = pool
+	}
+
+	if (opts.CertFile == "") != (opts.KeyFile == "") {
+		return nil, errors.New("gRPC client certificate and key must be set together")
+	}
+	if opts.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(opts.CertFile, opts.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load gRPC client certificate: %w", err)
+		}
+		tlsCfg.Certificates = []tls.Certificate{cert}
+	}
+	return credentials.NewTLS(tlsCfg), nil
+}
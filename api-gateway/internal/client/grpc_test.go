@@ -0,0 +1,113 @@
+package client
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// writeTestCert пишет в dir самоподписанный сертификат и его ключ в PEM и возвращает пути к файлам
+func writeTestCert(t *testing.T, dir string) (certFile, keyFile string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create certificate: %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("marshal key: %v", err)
+	}
+
+	certFile = filepath.Join(dir, "cert.pem")
+	keyFile = filepath.Join(dir, "key.pem")
+	writeFile(t, certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	writeFile(t, keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+	return certFile, keyFile
+}
+
+func writeFile(t *testing.T, path string, data []byte) {
+	t.Helper()
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("write %s: %v", path, err)
+	}
+}
+
+func TestTransportCredentials_Insecure(t *testing.T) {
+	creds, err := TransportCredentials(Options{Insecure: true, CAFile: "/does/not/exist"})
+	if err != nil {
+		t.Fatalf("insecure: %v", err)
+	}
+	if proto := creds.Info().SecurityProtocol; proto != "insecure" {
+		t.Errorf("security protocol = %q, want insecure", proto)
+	}
+}
+
+func TestTransportCredentials_TLS(t *testing.T) {
+	certFile, keyFile := writeTestCert(t, t.TempDir())
+
+	for name, opts := range map[string]Options{
+		"CA only": {CAFile: certFile},
+		"mTLS":    {CAFile: certFile, CertFile: certFile, KeyFile: keyFile},
+	} {
+		t.Run(name, func(t *testing.T) {
+			creds, err := TransportCredentials(opts)
+			if err != nil {
+				t.Fatalf("TransportCredentials: %v", err)
+			}
+			if proto := creds.Info().SecurityProtocol; proto != "tls" {
+				t.Errorf("security protocol = %q, want tls", proto)
+			}
+		})
+	}
+}
+
+func TestTransportCredentials_FailsFast(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := writeTestCert(t, dir)
+	notPEM := filepath.Join(dir, "not.pem")
+	writeFile(t, notPEM, []byte("not a certificate"))
+
+	tests := []struct {
+		name    string
+		opts    Options
+		wantErr string
+	}{
+		{"missing CA file", Options{CAFile: filepath.Join(dir, "missing.pem")}, "failed to read gRPC CA file"},
+		{"CA without PEM", Options{CAFile: notPEM}, "contains no PEM certificates"},
+		{"cert without key", Options{CAFile: certFile, CertFile: certFile}, "must be set together"},
+		{"key without cert", Options{CAFile: certFile, KeyFile: keyFile}, "must be set together"},
+		{"bad key pair", Options{CAFile: certFile, CertFile: certFile, KeyFile: notPEM}, "failed to load gRPC client certificate"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := TransportCredentials(tt.opts)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("err = %v, want one containing %q", err, tt.wantErr)
+			}
+			if _, dialErr := Dial("localhost:0", tt.opts); dialErr == nil {
+				t.Errorf("Dial succeeded, want the TLS configuration error")
+			}
+		})
+	}
+}
@@ -1,7 +1,10 @@
 package config
 
 import (
+	"fmt"
 	"log" // Using log for simplicity in config loading status/errors
+	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 )
@@ -15,6 +18,63 @@ type Config struct {
 	ReviewServiceHost  string `mapstructure:"REVIEW_SERVICE_HOST"`
 	ReviewServicePort  int    `mapstructure:"REVIEW_SERVICE_PORT"`
 	JWTSecret          string `mapstructure:"JWT_SECRET"`
+	// JWTRevocationCheck - проверять каждый токен в user-service, чтобы токены после logout
+	// переставали работать сразу, а не по истечении срока. Стоит одного gRPC-вызова на запрос,
+	// поэтому по умолчанию выключено.
+	JWTRevocationCheck bool `mapstructure:"JWT_REVOCATION_CHECK"`
+	// AccessLogSkipPaths - пути через запятую, для которых не пишется access log (например, /health)
+	AccessLogSkipPaths string `mapstructure:"ACCESS_LOG_SKIP_PATHS"`
+	// ResponseCacheTTLs - TTL кэша ответов для публичных маршрутов, "имя=длительность" через запятую
+	// (categories, listing_search, product_reviews, product_rating). Маршрут без TTL не кэшируется.
+	ResponseCacheTTLs       string `mapstructure:"RESPONSE_CACHE_TTLS"`
+	ResponseCacheMaxEntries int    `mapstructure:"RESPONSE_CACHE_MAX_ENTRIES"`
+	// RateLimitRPS - сколько запросов в секунду в среднем разрешено одному клиенту, 0 отключает лимит.
+	// RateLimitBurst - сколько запросов подряд клиент может сделать сверх среднего темпа.
+	// RateLimitPerUser - на защищённых маршрутах считать лимит по user ID из JWT, а не по IP.
+	RateLimitRPS     float64 `mapstructure:"RATE_LIMIT_RPS"`
+	RateLimitBurst   int     `mapstructure:"RATE_LIMIT_BURST"`
+	RateLimitPerUser bool    `mapstructure:"RATE_LIMIT_PER_USER"`
+	// TrustedProxies - IP и подсети (CIDR) через запятую, от которых принимаются X-Forwarded-For и
+	// X-Real-IP. Для остальных клиентов адресом считается адрес соединения; пусто - заголовкам не верим.
+	TrustedProxies string `mapstructure:"TRUSTED_PROXIES"`
+	// CORS: списки через запятую. Пустой CORS_ALLOWED_ORIGINS запрещает кросс-доменные запросы,
+	// "*" разрешает любой origin, но только при CORS_ALLOW_CREDENTIALS=false.
+	CORSAllowedOrigins   string `mapstructure:"CORS_ALLOWED_ORIGINS"`
+	CORSAllowedMethods   string `mapstructure:"CORS_ALLOWED_METHODS"`
+	CORSAllowedHeaders   string `mapstructure:"CORS_ALLOWED_HEADERS"`
+	CORSAllowCredentials bool   `mapstructure:"CORS_ALLOW_CREDENTIALS"`
+	CORSMaxAgeSeconds    int    `mapstructure:"CORS_MAX_AGE_SECONDS"`
+	// Ответы меньше этого размера (в байтах) не сжимаются gzip
+	CompressMinSize int `mapstructure:"COMPRESS_MIN_SIZE"`
+	// Общий таймаут вызовов к сервисам для GET /api/products/{productId}
+	AggregateTimeout time.Duration `mapstructure:"AGGREGATE_TIMEOUT"`
+	// Circuit breaker для каждого сервиса: число ошибок подряд до открытия (0 - выключен)
+	// и время в открытом состоянии до пробного запроса
+	UserBreakerMaxFailures    uint32        `mapstructure:"USER_BREAKER_MAX_FAILURES"`
+	UserBreakerOpenTimeout    time.Duration `mapstructure:"USER_BREAKER_OPEN_TIMEOUT"`
+	ListingBreakerMaxFailures uint32        `mapstructure:"LISTING_BREAKER_MAX_FAILURES"`
+	ListingBreakerOpenTimeout time.Duration `mapstructure:"LISTING_BREAKER_OPEN_TIMEOUT"`
+	ReviewBreakerMaxFailures  uint32        `mapstructure:"REVIEW_BREAKER_MAX_FAILURES"`
+	ReviewBreakerOpenTimeout  time.Duration `mapstructure:"REVIEW_BREAKER_OPEN_TIMEOUT"`
+	// Повторы идемпотентных вызовов при Unavailable/DeadlineExceeded (RETRY_MAX_ATTEMPTS=1 - выключены)
+	RetryMaxAttempts    int           `mapstructure:"RETRY_MAX_ATTEMPTS"`
+	RetryInitialBackoff time.Duration `mapstructure:"RETRY_INITIAL_BACKOFF"`
+	RetryMaxBackoff     time.Duration `mapstructure:"RETRY_MAX_BACKOFF"`
+	RetryTimeout        time.Duration `mapstructure:"RETRY_TIMEOUT"`
+	// RequestTimeout - дедлайн обработки запроса (0 - без дедлайна); RequestTimeoutOverrides -
+	// "шаблон маршрута=длительность" через запятую, шаблон может начинаться с HTTP-метода
+	RequestTimeout          time.Duration `mapstructure:"REQUEST_TIMEOUT"`
+	RequestTimeoutOverrides string        `mapstructure:"REQUEST_TIMEOUT_OVERRIDES"`
+	// Соединения с сервисами: без TLS только при GRPC_INSECURE=true, иначе нужен GRPC_TLS_CA_FILE;
+	// GRPC_TLS_CERT_FILE и GRPC_TLS_KEY_FILE включают mTLS
+	GRPCInsecure         bool          `mapstructure:"GRPC_INSECURE"`
+	GRPCTLSCAFile        string        `mapstructure:"GRPC_TLS_CA_FILE"`
+	GRPCTLSCertFile      string        `mapstructure:"GRPC_TLS_CERT_FILE"`
+	GRPCTLSKeyFile       string        `mapstructure:"GRPC_TLS_KEY_FILE"`
+	GRPCKeepaliveTime    time.Duration `mapstructure:"GRPC_KEEPALIVE_TIME"`
+	GRPCKeepaliveTimeout time.Duration `mapstructure:"GRPC_KEEPALIVE_TIMEOUT"`
+	GRPCMaxRecvMsgSize   int           `mapstructure:"GRPC_MAX_RECV_MSG_SIZE"`
+	GRPCMaxSendMsgSize   int           `mapstructure:"GRPC_MAX_SEND_MSG_SIZE"`
 }
 
 func LoadConfig() (*Config, error) {
@@ -33,6 +93,63 @@ func LoadConfig() (*Config, error) {
 	viper.BindEnv("REVIEW_SERVICE_HOST") // New
 	viper.BindEnv("REVIEW_SERVICE_PORT")
 	viper.BindEnv("JWT_SECRET", "JWT_SECRET")
+	viper.BindEnv("JWT_REVOCATION_CHECK")
+	viper.SetDefault("JWT_REVOCATION_CHECK", false)
+	viper.BindEnv("ACCESS_LOG_SKIP_PATHS")
+	viper.BindEnv("RESPONSE_CACHE_TTLS")
+	viper.BindEnv("RESPONSE_CACHE_MAX_ENTRIES")
+	viper.SetDefault("RESPONSE_CACHE_MAX_ENTRIES", 10000)
+	viper.BindEnv("RATE_LIMIT_RPS")
+	viper.BindEnv("RATE_LIMIT_BURST")
+	viper.BindEnv("RATE_LIMIT_PER_USER")
+	viper.BindEnv("TRUSTED_PROXIES")
+	viper.SetDefault("RATE_LIMIT_RPS", 10)
+	viper.SetDefault("RATE_LIMIT_BURST", 20)
+	viper.SetDefault("RATE_LIMIT_PER_USER", true)
+	viper.BindEnv("CORS_ALLOWED_ORIGINS")
+	viper.BindEnv("CORS_ALLOWED_METHODS")
+	viper.BindEnv("CORS_ALLOWED_HEADERS")
+	viper.BindEnv("CORS_ALLOW_CREDENTIALS")
+	viper.BindEnv("CORS_MAX_AGE_SECONDS")
+	viper.SetDefault("CORS_ALLOWED_METHODS", "GET,POST,PUT,PATCH,DELETE,OPTIONS")
+	viper.SetDefault("CORS_ALLOWED_HEADERS", "Authorization,Content-Type,X-Request-ID")
+	viper.SetDefault("CORS_ALLOW_CREDENTIALS", true)
+	viper.SetDefault("CORS_MAX_AGE_SECONDS", 600)
+	viper.BindEnv("COMPRESS_MIN_SIZE")
+	viper.SetDefault("COMPRESS_MIN_SIZE", 1024)
+	viper.BindEnv("AGGREGATE_TIMEOUT")
+	viper.SetDefault("AGGREGATE_TIMEOUT", "3s")
+	for _, service := range []string{"USER", "LISTING", "REVIEW"} {
+		viper.BindEnv(service + "_BREAKER_MAX_FAILURES")
+		viper.BindEnv(service + "_BREAKER_OPEN_TIMEOUT")
+		viper.SetDefault(service+"_BREAKER_MAX_FAILURES", 5)
+		viper.SetDefault(service+"_BREAKER_OPEN_TIMEOUT", "30s")
+	}
+	viper.BindEnv("RETRY_MAX_ATTEMPTS")
+	viper.BindEnv("RETRY_INITIAL_BACKOFF")
+	viper.BindEnv("RETRY_MAX_BACKOFF")
+	viper.BindEnv("RETRY_TIMEOUT")
+	viper.SetDefault("RETRY_MAX_ATTEMPTS", 3)
+	viper.SetDefault("RETRY_INITIAL_BACKOFF", "100ms")
+	viper.SetDefault("RETRY_MAX_BACKOFF", "1s")
+	viper.SetDefault("RETRY_TIMEOUT", "5s")
+	viper.BindEnv("REQUEST_TIMEOUT")
+	viper.BindEnv("REQUEST_TIMEOUT_OVERRIDES")
+	viper.SetDefault("REQUEST_TIMEOUT", "15s")
+	viper.SetDefault("REQUEST_TIMEOUT_OVERRIDES", "POST /api/listings/{id}/photos=2m,POST /api/user/avatar=2m")
+	viper.BindEnv("GRPC_INSECURE")
+	viper.BindEnv("GRPC_TLS_CA_FILE")
+	viper.BindEnv("GRPC_TLS_CERT_FILE")
+	viper.BindEnv("GRPC_TLS_KEY_FILE")
+	viper.BindEnv("GRPC_KEEPALIVE_TIME")
+	viper.BindEnv("GRPC_KEEPALIVE_TIMEOUT")
+	viper.BindEnv("GRPC_MAX_RECV_MSG_SIZE")
+	viper.BindEnv("GRPC_MAX_SEND_MSG_SIZE")
+	viper.SetDefault("GRPC_INSECURE", false)
+	viper.SetDefault("GRPC_KEEPALIVE_TIME", "5m") // Не чаще, чем разрешает сервер по умолчанию
+	viper.SetDefault("GRPC_KEEPALIVE_TIMEOUT", "20s")
+	viper.SetDefault("GRPC_MAX_RECV_MSG_SIZE", 4<<20)
+	viper.SetDefault("GRPC_MAX_SEND_MSG_SIZE", 16<<20) // Фото до 10 МБ передаются в теле запроса
 	viper.AutomaticEnv()
 
 	var cfg Config
@@ -40,6 +157,21 @@ func LoadConfig() (*Config, error) {
 		return nil, err
 	}
 
+	if !cfg.GRPCInsecure && cfg.GRPCTLSCAFile == "" {
+		return nil, fmt.Errorf("GRPC_TLS_CA_FILE is required for TLS connections to services; set GRPC_INSECURE=true to connect without TLS")
+	}
+	if (cfg.GRPCTLSCertFile == "") != (cfg.GRPCTLSKeyFile == "") {
+		return nil, fmt.Errorf("GRPC_TLS_CERT_FILE and GRPC_TLS_KEY_FILE must be set together")
+	}
+
+	if cfg.CORSAllowCredentials {
+		for _, origin := range SplitList(cfg.CORSAllowedOrigins) {
+			if origin == "*" {
+				return nil, fmt.Errorf("CORS_ALLOWED_ORIGINS must list explicit origins when CORS_ALLOW_CREDENTIALS is true")
+			}
+		}
+	}
+
 	log.Printf("API Gateway configuration loaded. PORT resolved to: %d\n", cfg.Port)
 
 	if cfg.Port == 0 {
@@ -48,3 +180,14 @@ func LoadConfig() (*Config, error) {
 
 	return &cfg, nil
 }
+
+// SplitList splits a comma-separated setting, trimming spaces and dropping empty items.
+func SplitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
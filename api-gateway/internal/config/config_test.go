@@ -0,0 +1,35 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLoadConfig_GRPCTransport(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     map[string]string
+		wantErr string
+	}{
+		{"insecure allowed explicitly", map[string]string{"GRPC_INSECURE": "true"}, ""},
+		{"TLS without CA", map[string]string{"GRPC_INSECURE": "false"}, "GRPC_TLS_CA_FILE is required"},
+		{"cert without key", map[string]string{"GRPC_INSECURE": "false", "GRPC_TLS_CA_FILE": "ca.pem", "GRPC_TLS_CERT_FILE": "cert.pem"}, "must be set together"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, key := range []string{"GRPC_INSECURE", "GRPC_TLS_CA_FILE", "GRPC_TLS_CERT_FILE", "GRPC_TLS_KEY_FILE"} {
+				t.Setenv(key, tt.env[key])
+			}
+			_, err := LoadConfig()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("LoadConfig: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("err = %v, want one containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
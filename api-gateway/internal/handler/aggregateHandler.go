@@ -0,0 +1,132 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/Abdurahmanit/GroupProject/api-gateway/internal/middleware"
+	"github.com/Abdurahmanit/GroupProject/listing-service/genproto/listing_service"
+	pb "github.com/Abdurahmanit/GroupProject/review-service"
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Секции ответа страницы товара
+const (
+	productSectionListing = "listing"
+	productSectionReviews = "reviews"
+	productSectionRating  = "rating"
+)
+
+// ProductDetails — объединенный ответ GET /api/products/{productId}. Секция, которую не удалось
+// получить, равна null, а причина записывается в Errors под именем секции.
+type ProductDetails struct {
+	ID      string                           `json:"id"`
+	Listing *listing_service.ListingResponse `json:"listing"`
+	Reviews *pb.ListReviewsResponse          `json:"reviews"`
+	Rating  *pb.ProductAverageRatingResponse `json:"rating"`
+	Errors  map[string]string                `json:"errors,omitempty"`
+}
+
+// AggregateHandler собирает данные для страницы товара из нескольких сервисов одним запросом
+type AggregateHandler struct {
+	listings listing_service.ListingServiceClient
+	reviews  pb.ReviewServiceClient
+	timeout  time.Duration
+	logger   *zap.Logger
+}
+
+// NewAggregateHandler создает обработчик; timeout ограничивает время всех вызовов к сервисам
+func NewAggregateHandler(listingConn, reviewConn *grpc.ClientConn, timeout time.Duration, logger *zap.Logger) *AggregateHandler {
+	return &AggregateHandler{
+		listings: listing_service.NewListingServiceClient(listingConn),
+		reviews:  pb.NewReviewServiceClient(reviewConn),
+		timeout:  timeout,
+		logger:   logger.Named("AggregateHTTPHandler"),
+	}
+}
+
+// HandleGetProduct параллельно запрашивает объявление, первую страницу отзывов и средний рейтинг.
+// Если упала только часть вызовов, возвращает 200 с остальными секциями и ошибками в "errors";
+// 404 — если объявления не существует, 502 — если не ответил ни один сервис.
+func (h *AggregateHandler) HandleGetProduct(w http.ResponseWriter, r *http.Request) {
+	productID := chi.URLParam(r, "productId")
+	if productID == "" {
+		http.Error(w, "Missing product ID", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(withAuth(r.Context(), r), h.timeout)
+	defer cancel()
+
+	details := &ProductDetails{ID: productID}
+	var (
+		mu         sync.Mutex
+		listingErr error
+	)
+	fail := func(section string, err error) {
+		h.logger.Warn("Product section unavailable", zap.String("product_id", productID), zap.String("section", section), zap.Error(err))
+		mu.Lock()
+		defer mu.Unlock()
+		if details.Errors == nil {
+			details.Errors = make(map[string]string)
+		}
+		details.Errors[section] = status.Convert(err).Message()
+		if section == productSectionListing {
+			listingErr = err
+		}
+	}
+
+	// Горутины не возвращают ошибки: отказ одного сервиса не должен отменять остальные вызовы
+	var g errgroup.Group
+	g.Go(func() error {
+		// listing-service считает просмотры по адресу клиента, а не шлюза (если шлюз в его TRUSTED_PROXIES)
+		listingCtx := metadata.AppendToOutgoingContext(ctx, "x-forwarded-for", middleware.ClientIP(r))
+		resp, err := h.listings.GetListingByID(listingCtx, &listing_service.GetListingRequest{Id: productID})
+		if err != nil {
+			fail(productSectionListing, err)
+			return nil
+		}
+		details.Listing = resp
+		return nil
+	})
+	g.Go(func() error {
+		resp, err := h.reviews.ListReviewsByProduct(ctx, &pb.ListReviewsByProductRequest{
+			ProductId: productID,
+			Page:      1,
+			Limit:     parseIntQueryParam(r, "reviews_limit", 5),
+		})
+		if err != nil {
+			fail(productSectionReviews, err)
+			return nil
+		}
+		details.Reviews = resp
+		return nil
+	})
+	g.Go(func() error {
+		resp, err := h.reviews.GetProductAverageRating(ctx, &pb.GetProductAverageRatingRequest{ProductId: productID})
+		if err != nil {
+			fail(productSectionRating, err)
+			return nil
+		}
+		details.Rating = resp
+		return nil
+	})
+	_ = g.Wait()
+
+	switch {
+	case status.Code(listingErr) == codes.NotFound:
+		http.Error(w, status.Convert(listingErr).Message(), http.StatusNotFound)
+	case len(details.Errors) == 3:
+		respondWithJSON(w, http.StatusBadGateway, details)
+	default:
+		respondWithJSON(w, http.StatusOK, details)
+	}
+}
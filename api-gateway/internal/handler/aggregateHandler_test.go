@@ -0,0 +1,130 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Abdurahmanit/GroupProject/listing-service/genproto/listing_service"
+	pb "github.com/Abdurahmanit/GroupProject/review-service"
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeListingClient struct {
+	listing_service.ListingServiceClient
+	err error
+}
+
+func (f *fakeListingClient) GetListingByID(ctx context.Context, in *listing_service.GetListingRequest, opts ...grpc.CallOption) (*listing_service.ListingResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &listing_service.ListingResponse{Id: in.GetId(), Title: "Bike"}, nil
+}
+
+type fakeReviewClient struct {
+	pb.ReviewServiceClient
+	reviewsErr error
+	ratingErr  error
+}
+
+func (f *fakeReviewClient) ListReviewsByProduct(ctx context.Context, in *pb.ListReviewsByProductRequest, opts ...grpc.CallOption) (*pb.ListReviewsResponse, error) {
+	if f.reviewsErr != nil {
+		return nil, f.reviewsErr
+	}
+	return &pb.ListReviewsResponse{}, nil
+}
+
+func (f *fakeReviewClient) GetProductAverageRating(ctx context.Context, in *pb.GetProductAverageRatingRequest, opts ...grpc.CallOption) (*pb.ProductAverageRatingResponse, error) {
+	if f.ratingErr != nil {
+		return nil, f.ratingErr
+	}
+	return &pb.ProductAverageRatingResponse{ProductId: in.GetProductId()}, nil
+}
+
+func serveGetProduct(t *testing.T, listings *fakeListingClient, reviews *fakeReviewClient) (*httptest.ResponseRecorder, ProductDetails) {
+	t.Helper()
+	h := &AggregateHandler{listings: listings, reviews: reviews, timeout: time.Second, logger: zap.NewNop()}
+	r := chi.NewRouter()
+	r.Get("/api/products/{productId}", h.HandleGetProduct)
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/products/p1", nil))
+
+	var details ProductDetails
+	if rec.Header().Get("Content-Type") == "application/json" {
+		if err := json.Unmarshal(rec.Body.Bytes(), &details); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+	}
+	return rec, details
+}
+
+func TestHandleGetProduct_AllSections(t *testing.T) {
+	rec, details := serveGetProduct(t, &fakeListingClient{}, &fakeReviewClient{})
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if details.Listing == nil || details.Reviews == nil || details.Rating == nil {
+		t.Errorf("missing sections: %+v", details)
+	}
+	if len(details.Errors) != 0 {
+		t.Errorf("errors = %v, want none", details.Errors)
+	}
+}
+
+func TestHandleGetProduct_PartialFailure(t *testing.T) {
+	reviews := &fakeReviewClient{ratingErr: status.Error(codes.Unavailable, "review-service unavailable")}
+
+	rec, details := serveGetProduct(t, &fakeListingClient{}, reviews)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if details.Listing == nil || details.Reviews == nil {
+		t.Errorf("healthy sections dropped: %+v", details)
+	}
+	if details.Rating != nil {
+		t.Errorf("rating = %+v, want null", details.Rating)
+	}
+	if got := details.Errors[productSectionRating]; got != "review-service unavailable" {
+		t.Errorf("errors[rating] = %q, want the backend message", got)
+	}
+	if len(details.Errors) != 1 {
+		t.Errorf("errors = %v, want only rating", details.Errors)
+	}
+}
+
+func TestHandleGetProduct_ListingNotFound(t *testing.T) {
+	listings := &fakeListingClient{err: status.Error(codes.NotFound, "listing not found")}
+
+	rec, _ := serveGetProduct(t, listings, &fakeReviewClient{})
+
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
+
+func TestHandleGetProduct_AllSectionsFail(t *testing.T) {
+	unavailable := status.Error(codes.Unavailable, "connection refused")
+	reviews := &fakeReviewClient{reviewsErr: unavailable, ratingErr: unavailable}
+
+	rec, details := serveGetProduct(t, &fakeListingClient{err: unavailable}, reviews)
+
+	if rec.Code != http.StatusBadGateway {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusBadGateway)
+	}
+	for _, section := range []string{productSectionListing, productSectionReviews, productSectionRating} {
+		if _, ok := details.Errors[section]; !ok {
+			t.Errorf("errors[%s] missing: %v", section, details.Errors)
+		}
+	}
+}
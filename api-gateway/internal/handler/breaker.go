@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/sony/gobreaker"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// BreakerSettings задает параметры circuit breaker для одного сервиса
+type BreakerSettings struct {
+	// MaxFailures - число ошибок подряд, после которого breaker открывается; 0 отключает breaker
+	MaxFailures uint32
+	// OpenTimeout - сколько breaker остается открытым, прежде чем пропустить пробный запрос
+	OpenTimeout time.Duration
+}
+
+// CircuitBreakerInterceptor оборачивает все unary-вызовы соединения с сервисом в circuit breaker.
+// Пока breaker открыт, вызовы сразу завершаются с codes.Unavailable (HTTP 503), не дожидаясь
+// таймаута. По истечении OpenTimeout один пробный запрос переводит его обратно в closed или open.
+// Ошибкой считаются только отказы сервиса, а не бизнес-ошибки вроде NotFound или InvalidArgument.
+func CircuitBreakerInterceptor(service string, s BreakerSettings, logger *zap.Logger) grpc.UnaryClientInterceptor {
+	if s.MaxFailures == 0 {
+		return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+	}
+
+	cb := gobreaker.NewCircuitBreaker(gobreaker.Settings{
+		Name:    service,
+		Timeout: s.OpenTimeout,
+		ReadyToTrip: func(counts gobreaker.Counts) bool {
+			return counts.ConsecutiveFailures >= s.MaxFailures
+		},
+		OnStateChange: func(name string, from, to gobreaker.State) {
+			logger.Warn("Circuit breaker state changed",
+				zap.String("service", name), zap.String("from", from.String()), zap.String("to", to.String()))
+		},
+		IsSuccessful: func(err error) bool {
+			return err == nil || !isBackendFailure(err)
+		},
+	})
+
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		_, err := cb.Execute(func() (interface{}, error) {
+			return nil, invoker(ctx, method, req, reply, cc, opts...)
+		})
+		if errors.Is(err, gobreaker.ErrOpenState) || errors.Is(err, gobreaker.ErrTooManyRequests) {
+			return status.Errorf(codes.Unavailable, "%s is temporarily unavailable", service)
+		}
+		return err
+	}
+}
+
+// isBackendFailure сообщает, говорит ли ошибка о недоступности или сбое сервиса
+func isBackendFailure(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Internal, codes.Unknown:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCircuitBreakerInterceptor_OpensAndRecovers(t *testing.T) {
+	interceptor := CircuitBreakerInterceptor("review-service", BreakerSettings{MaxFailures: 2, OpenTimeout: 50 * time.Millisecond}, zap.NewNop())
+
+	calls := 0
+	backendErr := status.Error(codes.Unavailable, "connection refused")
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		calls++
+		return backendErr
+	}
+	call := func() error {
+		return interceptor(context.Background(), "/review.ReviewService/GetReview", nil, nil, nil, invoker)
+	}
+
+	// Бизнес-ошибки не открывают breaker
+	backendErr = status.Error(codes.NotFound, "review not found")
+	for i := 0; i < 3; i++ {
+		if err := call(); status.Code(err) != codes.NotFound {
+			t.Fatalf("call %d: got %v, want NotFound", i, err)
+		}
+	}
+
+	backendErr = status.Error(codes.Unavailable, "connection refused")
+	_ = call()
+	_ = call()
+	calls = 0
+	if err := call(); status.Code(err) != codes.Unavailable {
+		t.Fatalf("open breaker: got %v, want Unavailable", err)
+	}
+	if calls != 0 {
+		t.Fatalf("open breaker called the backend %d times", calls)
+	}
+
+	time.Sleep(60 * time.Millisecond)
+	backendErr = nil
+	if err := call(); err != nil {
+		t.Fatalf("half-open probe: got %v, want success", err)
+	}
+	if err := call(); err != nil || calls != 2 {
+		t.Fatalf("closed breaker: got %v after %d backend calls, want success after 2", err, calls)
+	}
+}
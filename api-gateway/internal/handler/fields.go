@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/Abdurahmanit/GroupProject/listing-service/genproto/listing_service"
+	pb "github.com/Abdurahmanit/GroupProject/review-service"
+)
+
+// fieldSelection реализует sparse fieldsets: ?fields=id,title,price оставляет в ответе
+// только перечисленные поля. Для списков фильтруются элементы массива listKey,
+// остальные ключи ответа (total, page, limit) сохраняются.
+type fieldSelection struct {
+	listKey string              // ключ массива в ответе списка; "" - ответ с одним объектом
+	known   map[string]struct{} // JSON-имена полей элемента
+}
+
+var (
+	listingFields       = newFieldSelection("", listing_service.ListingResponse{})
+	listingSearchFields = newFieldSelection("listings", listing_service.ListingResponse{})
+	reviewListFields    = newFieldSelection("reviews", pb.Review{})
+)
+
+// newFieldSelection собирает допустимые имена полей из json-тегов структуры item.
+func newFieldSelection(listKey string, item interface{}) fieldSelection {
+	known := make(map[string]struct{})
+	t := reflect.TypeOf(item)
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if name != "" && name != "-" {
+			known[name] = struct{}{}
+		}
+	}
+	return fieldSelection{listKey: listKey, known: known}
+}
+
+// requested возвращает запрошенные известные поля. Неизвестные имена игнорируются;
+// nil означает, что фильтровать не нужно.
+func (s fieldSelection) requested(r *http.Request) map[string]struct{} {
+	raw := r.URL.Query().Get("fields")
+	if raw == "" {
+		return nil
+	}
+	fields := make(map[string]struct{})
+	for _, name := range strings.Split(raw, ",") {
+		name = strings.TrimSpace(name)
+		if _, ok := s.known[name]; ok {
+			fields[name] = struct{}{}
+		}
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	return fields
+}
+
+// filter возвращает payload, урезанный до полей из ?fields=. Если параметр не передан
+// или ответ не удалось преобразовать, возвращается исходный payload.
+func (s fieldSelection) filter(r *http.Request, payload interface{}) interface{} {
+	fields := s.requested(r)
+	if fields == nil {
+		return payload
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return payload
+	}
+	var obj map[string]interface{}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return payload
+	}
+
+	if s.listKey == "" {
+		return pruneFields(obj, fields)
+	}
+	if items, ok := obj[s.listKey].([]interface{}); ok {
+		for i, item := range items {
+			if m, ok := item.(map[string]interface{}); ok {
+				items[i] = pruneFields(m, fields)
+			}
+		}
+	}
+	return obj
+}
+
+func pruneFields(obj map[string]interface{}, fields map[string]struct{}) map[string]interface{} {
+	for key := range obj {
+		if _, ok := fields[key]; !ok {
+			delete(obj, key)
+		}
+	}
+	return obj
+}
@@ -5,6 +5,9 @@ import (
 	"encoding/json"
 	"net/http"
 	"io"
+	"strconv"
+	"strings"
+	"github.com/Abdurahmanit/GroupProject/api-gateway/internal/middleware"
 	"github.com/Abdurahmanit/GroupProject/listing-service/genproto/listing_service"
 	"github.com/go-chi/chi/v5" // Возвращаем импорт chi
 	"go.uber.org/zap"
@@ -48,7 +51,7 @@ func (h *ListingHandler) HandleCreateListing(w http.ResponseWriter, r *http.Requ
 		h.logger.Error("Failed to create listing via gRPC", zap.Error(err))
 		st, ok := status.FromError(err)
 		if ok {
-			http.Error(w, st.Message(), http.StatusInternalServerError)
+			http.Error(w, st.Message(), GRPCCodeToHTTPStatus(st.Code()))
 		} else {
 			http.Error(w, "Failed to create listing: "+err.Error(), http.StatusInternalServerError)
 		}
@@ -81,7 +84,7 @@ func (h *ListingHandler) HandleUpdateListing(w http.ResponseWriter, r *http.Requ
 		h.logger.Error("Failed to update listing via gRPC", zap.String("id", id), zap.Error(err))
 		st, ok := status.FromError(err)
 		if ok {
-			http.Error(w, st.Message(), http.StatusInternalServerError)
+			http.Error(w, st.Message(), GRPCCodeToHTTPStatus(st.Code()))
 		} else {
 			http.Error(w, "Failed to update listing: "+err.Error(), http.StatusInternalServerError)
 		}
@@ -111,7 +114,7 @@ func (h *ListingHandler) HandleDeleteListing(w http.ResponseWriter, r *http.Requ
 		h.logger.Error("Failed to delete listing via gRPC", zap.String("id", id), zap.Error(err))
 		st, ok := status.FromError(err)
 		if ok {
-			http.Error(w, st.Message(), http.StatusInternalServerError)
+			http.Error(w, st.Message(), GRPCCodeToHTTPStatus(st.Code()))
 		} else {
 			http.Error(w, "Failed to delete listing: "+err.Error(), http.StatusInternalServerError)
 		}
@@ -131,13 +134,18 @@ func (h *ListingHandler) HandleGetListingByID(w http.ResponseWriter, r *http.Req
 	}
 
 	ctx := withAuth(r.Context(), r)
+	// listing-service считает просмотры по адресу клиента, а не шлюза (если шлюз в его TRUSTED_PROXIES)
+	ctx = metadata.AppendToOutgoingContext(ctx, "x-forwarded-for", middleware.ClientIP(r))
 	client := listing_service.NewListingServiceClient(h.client)
-	resp, err := client.GetListingByID(ctx, &listing_service.GetListingRequest{Id: id})
+	resp, err := client.GetListingByID(ctx, &listing_service.GetListingRequest{
+		Id:             id,
+		IncludeDeleted: r.URL.Query().Get("include_deleted") == "true", // listing-service пропустит только admin
+	})
 	if err != nil {
 		h.logger.Error("Failed to get listing by ID via gRPC", zap.String("id", id), zap.Error(err))
 		st, ok := status.FromError(err)
 		if ok {
-			http.Error(w, st.Message(), http.StatusInternalServerError)
+			http.Error(w, st.Message(), GRPCCodeToHTTPStatus(st.Code()))
 		} else {
 			http.Error(w, "Failed to get listing: "+err.Error(), http.StatusInternalServerError)
 		}
@@ -145,7 +153,7 @@ func (h *ListingHandler) HandleGetListingByID(w http.ResponseWriter, r *http.Req
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	if err := json.NewEncoder(w).Encode(resp); err != nil {
+	if err := json.NewEncoder(w).Encode(listingFields.filter(r, resp)); err != nil {
 		h.logger.Error("Failed to encode GetListingByID response", zap.String("id", id), zap.Error(err))
 		http.Error(w, status.Errorf(codes.Internal, "Failed to encode response: %v", err).Error(), http.StatusInternalServerError)
 	}
@@ -173,7 +181,7 @@ func (h *ListingHandler) HandleSearchListings(w http.ResponseWriter, r *http.Req
 		h.logger.Error("Failed to search listings via gRPC", zap.Error(err))
 		st, ok := status.FromError(err)
 		if ok {
-			http.Error(w, st.Message(), http.StatusInternalServerError)
+			http.Error(w, st.Message(), GRPCCodeToHTTPStatus(st.Code()))
 		} else {
 			http.Error(w, "Failed to search listings: "+err.Error(), http.StatusInternalServerError)
 		}
@@ -181,7 +189,7 @@ func (h *ListingHandler) HandleSearchListings(w http.ResponseWriter, r *http.Req
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	if err := json.NewEncoder(w).Encode(resp); err != nil {
+	if err := json.NewEncoder(w).Encode(listingSearchFields.filter(r, resp)); err != nil {
 		h.logger.Error("Failed to encode SearchListings response", zap.Error(err))
 		http.Error(w, status.Errorf(codes.Internal, "Failed to encode response: %v", err).Error(), http.StatusInternalServerError)
 	}
@@ -221,7 +229,7 @@ func (h *ListingHandler) HandleUploadPhoto(w http.ResponseWriter, r *http.Reques
 	if err != nil {
 		st, ok := status.FromError(err)
 		if ok {
-			http.Error(w, st.Message(), http.StatusInternalServerError)
+			http.Error(w, st.Message(), GRPCCodeToHTTPStatus(st.Code()))
 		} else {
 			http.Error(w, "Failed to upload photo: "+err.Error(), http.StatusInternalServerError)
 		}
@@ -233,6 +241,107 @@ func (h *ListingHandler) HandleUploadPhoto(w http.ResponseWriter, r *http.Reques
 	json.NewEncoder(w).Encode(resp)
 }
 
+// HandleDeletePhoto удаляет фото объявления: {"photo_url": "..."}
+func (h *ListingHandler) HandleDeletePhoto(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	var req listing_service.DeletePhotoRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("Invalid request body for DeletePhoto", zap.String("id", id), zap.Error(err))
+		http.Error(w, status.Errorf(codes.InvalidArgument, "Invalid request body: %v", err).Error(), http.StatusBadRequest)
+		return
+	}
+	req.ListingId = id
+
+	ctx := withAuth(r.Context(), r)
+	client := listing_service.NewListingServiceClient(h.client)
+	if _, err := client.DeletePhoto(ctx, &req); err != nil {
+		h.logger.Error("Failed to delete photo via gRPC", zap.String("id", id), zap.Error(err))
+		st, _ := status.FromError(err)
+		http.Error(w, st.Message(), GRPCCodeToHTTPStatus(st.Code()))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleReorderPhotos задает порядок фото объявления: {"photo_urls": ["...", "..."]}
+func (h *ListingHandler) HandleReorderPhotos(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	var req listing_service.ReorderPhotosRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("Invalid request body for ReorderPhotos", zap.String("id", id), zap.Error(err))
+		http.Error(w, status.Errorf(codes.InvalidArgument, "Invalid request body: %v", err).Error(), http.StatusBadRequest)
+		return
+	}
+	req.ListingId = id
+
+	ctx := withAuth(r.Context(), r)
+	client := listing_service.NewListingServiceClient(h.client)
+	if _, err := client.ReorderPhotos(ctx, &req); err != nil {
+		h.logger.Error("Failed to reorder photos via gRPC", zap.String("id", id), zap.Error(err))
+		st, _ := status.FromError(err)
+		http.Error(w, st.Message(), GRPCCodeToHTTPStatus(st.Code()))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleGeneratePhotoUploadURL выдает presigned URL для загрузки фото напрямую в хранилище.
+// Тело: {"file_name": "..."}; после PUT на upload_url клиент вызывает HandleConfirmPhotoUpload.
+func (h *ListingHandler) HandleGeneratePhotoUploadURL(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	var req listing_service.GeneratePhotoUploadURLRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("Invalid request body for GeneratePhotoUploadURL", zap.String("id", id), zap.Error(err))
+		http.Error(w, status.Errorf(codes.InvalidArgument, "Invalid request body: %v", err).Error(), http.StatusBadRequest)
+		return
+	}
+	req.ListingId = id
+
+	ctx := withAuth(r.Context(), r)
+	client := listing_service.NewListingServiceClient(h.client)
+	resp, err := client.GeneratePhotoUploadURL(ctx, &req)
+	if err != nil {
+		h.logger.Error("Failed to generate photo upload URL via gRPC", zap.String("id", id), zap.Error(err))
+		st, _ := status.FromError(err)
+		http.Error(w, st.Message(), GRPCCodeToHTTPStatus(st.Code()))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.logger.Error("Failed to encode GeneratePhotoUploadURL response", zap.String("id", id), zap.Error(err))
+	}
+}
+
+// HandleConfirmPhotoUpload прикрепляет загруженное по presigned URL фото к объявлению.
+// Тело: {"photo_url": "..."}
+func (h *ListingHandler) HandleConfirmPhotoUpload(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	var req listing_service.ConfirmPhotoUploadRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("Invalid request body for ConfirmPhotoUpload", zap.String("id", id), zap.Error(err))
+		http.Error(w, status.Errorf(codes.InvalidArgument, "Invalid request body: %v", err).Error(), http.StatusBadRequest)
+		return
+	}
+	req.ListingId = id
+
+	ctx := withAuth(r.Context(), r)
+	client := listing_service.NewListingServiceClient(h.client)
+	resp, err := client.ConfirmPhotoUpload(ctx, &req)
+	if err != nil {
+		h.logger.Error("Failed to confirm photo upload via gRPC", zap.String("id", id), zap.Error(err))
+		st, _ := status.FromError(err)
+		http.Error(w, st.Message(), GRPCCodeToHTTPStatus(st.Code()))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.logger.Error("Failed to encode ConfirmPhotoUpload response", zap.String("id", id), zap.Error(err))
+	}
+}
 
 // HandleGetListingStatus обрабатывает получение статуса объявления
 func (h *ListingHandler) HandleGetListingStatus(w http.ResponseWriter, r *http.Request) { // Сигнатура для chi
@@ -250,7 +359,7 @@ func (h *ListingHandler) HandleGetListingStatus(w http.ResponseWriter, r *http.R
 		h.logger.Error("Failed to get listing status via gRPC", zap.String("id", id), zap.Error(err))
 		st, ok := status.FromError(err)
 		if ok {
-			http.Error(w, st.Message(), http.StatusInternalServerError)
+			http.Error(w, st.Message(), GRPCCodeToHTTPStatus(st.Code()))
 		} else {
 			http.Error(w, "Failed to get listing status: "+err.Error(), http.StatusInternalServerError)
 		}
@@ -288,7 +397,7 @@ func (h *ListingHandler) HandleAddFavorite(w http.ResponseWriter, r *http.Reques
 		h.logger.Error("Failed to add favorite via gRPC", zap.String("user_id", userID), zap.Error(err))
 		st, sOk := status.FromError(err)
 		if sOk {
-			http.Error(w, st.Message(), http.StatusInternalServerError)
+			http.Error(w, st.Message(), GRPCCodeToHTTPStatus(st.Code()))
 		} else {
 			http.Error(w, "Failed to add favorite: "+err.Error(), http.StatusInternalServerError)
 		}
@@ -322,7 +431,7 @@ func (h *ListingHandler) HandleRemoveFavorite(w http.ResponseWriter, r *http.Req
 		h.logger.Error("Failed to remove favorite via gRPC", zap.String("user_id", userID), zap.Error(err))
 		st, sOk := status.FromError(err)
 		if sOk {
-			http.Error(w, st.Message(), http.StatusInternalServerError)
+			http.Error(w, st.Message(), GRPCCodeToHTTPStatus(st.Code()))
 		} else {
 			http.Error(w, "Failed to remove favorite: "+err.Error(), http.StatusInternalServerError)
 		}
@@ -332,7 +441,7 @@ func (h *ListingHandler) HandleRemoveFavorite(w http.ResponseWriter, r *http.Req
 	w.WriteHeader(http.StatusNoContent)
 }
 
-// HandleGetFavorites обрабатывает получение списка избранного
+// HandleGetFavorites обрабатывает получение списка избранного (?include_listings=true&page=N&limit=M)
 func (h *ListingHandler) HandleGetFavorites(w http.ResponseWriter, r *http.Request) { // Сигнатура для chi
 	userID, ok := r.Context().Value("user_id").(string)
 	if !ok || userID == "" {
@@ -354,6 +463,26 @@ func (h *ListingHandler) HandleGetFavorites(w http.ResponseWriter, r *http.Reque
 	// }
 	req.UserId = userID // Устанавливаем userID из контекста
 
+	query := r.URL.Query()
+	if v := query.Get("include_listings"); v != "" {
+		include, err := strconv.ParseBool(v)
+		if err != nil {
+			http.Error(w, "Invalid include_listings parameter", http.StatusBadRequest)
+			return
+		}
+		req.IncludeListings = include
+	}
+	for name, dst := range map[string]*int32{"page": &req.Page, "limit": &req.Limit} {
+		if v := query.Get(name); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil || n < 0 {
+				http.Error(w, "Invalid "+name+" parameter", http.StatusBadRequest)
+				return
+			}
+			*dst = int32(n)
+		}
+	}
+
 	ctx := withAuth(r.Context(), r)
 	client := listing_service.NewListingServiceClient(h.client)
 	resp, err := client.GetFavorites(ctx, &req)
@@ -361,7 +490,7 @@ func (h *ListingHandler) HandleGetFavorites(w http.ResponseWriter, r *http.Reque
 		h.logger.Error("Failed to get favorites via gRPC", zap.String("user_id", userID), zap.Error(err))
 		st, sOk := status.FromError(err)
 		if sOk {
-			http.Error(w, st.Message(), http.StatusInternalServerError)
+			http.Error(w, st.Message(), GRPCCodeToHTTPStatus(st.Code()))
 		} else {
 			http.Error(w, "Failed to get favorites: "+err.Error(), http.StatusInternalServerError)
 		}
@@ -375,6 +504,40 @@ func (h *ListingHandler) HandleGetFavorites(w http.ResponseWriter, r *http.Reque
 	}
 }
 
+// HandleGetRecentlyViewed возвращает недавно просмотренные объявления пользователя (?limit=N)
+func (h *ListingHandler) HandleGetRecentlyViewed(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok || userID == "" {
+		http.Error(w, "Unauthorized: User ID missing", http.StatusUnauthorized)
+		return
+	}
+
+	req := listing_service.GetRecentlyViewedRequest{UserId: userID}
+	if v := r.URL.Query().Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil || limit < 0 {
+			http.Error(w, "Invalid limit parameter", http.StatusBadRequest)
+			return
+		}
+		req.Limit = int32(limit)
+	}
+
+	ctx := withAuth(r.Context(), r)
+	client := listing_service.NewListingServiceClient(h.client)
+	resp, err := client.GetRecentlyViewed(ctx, &req)
+	if err != nil {
+		h.logger.Error("Failed to get recently viewed listings via gRPC", zap.String("user_id", userID), zap.Error(err))
+		st, _ := status.FromError(err)
+		http.Error(w, st.Message(), GRPCCodeToHTTPStatus(st.Code()))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.logger.Error("Failed to encode GetRecentlyViewed response", zap.String("user_id", userID), zap.Error(err))
+	}
+}
+
 // HandleGetPhotoURLs обрабатывает получение URL фотографий
 func (h *ListingHandler) HandleGetPhotoURLs(w http.ResponseWriter, r *http.Request) { // Сигнатура для chi
 	id := chi.URLParam(r, "id") // Используем chi.URLParam
@@ -391,7 +554,7 @@ func (h *ListingHandler) HandleGetPhotoURLs(w http.ResponseWriter, r *http.Reque
 		h.logger.Error("Failed to get photo URLs via gRPC", zap.String("listing_id", id), zap.Error(err))
 		st, ok := status.FromError(err)
 		if ok {
-			http.Error(w, st.Message(), http.StatusInternalServerError)
+			http.Error(w, st.Message(), GRPCCodeToHTTPStatus(st.Code()))
 		} else {
 			http.Error(w, "Failed to get photo URLs: "+err.Error(), http.StatusInternalServerError)
 		}
@@ -423,7 +586,7 @@ func (h *ListingHandler) HandleUpdateListingStatus(w http.ResponseWriter, r *htt
 		h.logger.Error("Failed to update listing status via gRPC", zap.String("id", id), zap.Error(err))
 		st, ok := status.FromError(err)
 		if ok {
-			http.Error(w, st.Message(), http.StatusInternalServerError)
+			http.Error(w, st.Message(), GRPCCodeToHTTPStatus(st.Code()))
 		} else {
 			http.Error(w, "Failed to update listing status: "+err.Error(), http.StatusInternalServerError)
 		}
@@ -437,8 +600,281 @@ func (h *ListingHandler) HandleUpdateListingStatus(w http.ResponseWriter, r *htt
 	}
 }
 
-// withAuth добавляет JWT-токен в метаданные контекста для gRPC вызовов
+// HandleGetListingsStatus возвращает статус, цену и доступность нескольких объявлений (?ids=a,b,c)
+func (h *ListingHandler) HandleGetListingsStatus(w http.ResponseWriter, r *http.Request) {
+	var req listing_service.GetListingsStatusRequest
+	for _, id := range strings.Split(r.URL.Query().Get("ids"), ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			req.Ids = append(req.Ids, id)
+		}
+	}
+	if len(req.Ids) == 0 {
+		http.Error(w, "Query parameter ids is required", http.StatusBadRequest)
+		return
+	}
+
+	client := listing_service.NewListingServiceClient(h.client)
+	resp, err := client.GetListingsStatus(r.Context(), &req)
+	if err != nil {
+		h.logger.Error("Failed to get listings status via gRPC", zap.Int("count", len(req.Ids)), zap.Error(err))
+		st, _ := status.FromError(err)
+		http.Error(w, st.Message(), GRPCCodeToHTTPStatus(st.Code()))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.logger.Error("Failed to encode GetListingsStatus response", zap.Error(err))
+	}
+}
+
+// HandleMakeOffer обрабатывает предложение цены по объявлению
+func (h *ListingHandler) HandleMakeOffer(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	var req listing_service.MakeOfferRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("Invalid request body for MakeOffer", zap.String("id", id), zap.Error(err))
+		http.Error(w, status.Errorf(codes.InvalidArgument, "Invalid request body: %v", err).Error(), http.StatusBadRequest)
+		return
+	}
+	req.ListingId = id
+
+	ctx := withAuth(r.Context(), r)
+	client := listing_service.NewListingServiceClient(h.client)
+	resp, err := client.MakeOffer(ctx, &req)
+	if err != nil {
+		h.logger.Error("Failed to make offer via gRPC", zap.String("id", id), zap.Error(err))
+		st, _ := status.FromError(err)
+		http.Error(w, st.Message(), GRPCCodeToHTTPStatus(st.Code()))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.logger.Error("Failed to encode MakeOffer response", zap.String("id", id), zap.Error(err))
+	}
+}
+
+// HandleRespondToOffer обрабатывает ход по предложению (accept/reject/counter) - продавца или покупателя, чья очередь
+func (h *ListingHandler) HandleRespondToOffer(w http.ResponseWriter, r *http.Request) {
+	offerID := chi.URLParam(r, "offerID")
+	var req listing_service.RespondToOfferRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("Invalid request body for RespondToOffer", zap.String("offer_id", offerID), zap.Error(err))
+		http.Error(w, status.Errorf(codes.InvalidArgument, "Invalid request body: %v", err).Error(), http.StatusBadRequest)
+		return
+	}
+	req.OfferId = offerID
+
+	ctx := withAuth(r.Context(), r)
+	client := listing_service.NewListingServiceClient(h.client)
+	resp, err := client.RespondToOffer(ctx, &req)
+	if err != nil {
+		h.logger.Error("Failed to respond to offer via gRPC", zap.String("offer_id", offerID), zap.Error(err))
+		st, _ := status.FromError(err)
+		http.Error(w, st.Message(), GRPCCodeToHTTPStatus(st.Code()))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.logger.Error("Failed to encode RespondToOffer response", zap.String("offer_id", offerID), zap.Error(err))
+	}
+}
+
+// HandleMarkUnavailable снимает объявление с продажи: {"status": "sold_elsewhere"|"withdrawn", "reason": "..."}
+func (h *ListingHandler) HandleMarkUnavailable(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	var req listing_service.MarkUnavailableRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("Invalid request body for MarkUnavailable", zap.String("id", id), zap.Error(err))
+		http.Error(w, status.Errorf(codes.InvalidArgument, "Invalid request body: %v", err).Error(), http.StatusBadRequest)
+		return
+	}
+	req.Id = id
+
+	ctx := withAuth(r.Context(), r)
+	client := listing_service.NewListingServiceClient(h.client)
+	resp, err := client.MarkUnavailable(ctx, &req)
+	if err != nil {
+		h.logger.Error("Failed to mark listing unavailable via gRPC", zap.String("id", id), zap.Error(err))
+		st, _ := status.FromError(err)
+		http.Error(w, st.Message(), GRPCCodeToHTTPStatus(st.Code()))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.logger.Error("Failed to encode MarkUnavailable response", zap.String("id", id), zap.Error(err))
+	}
+}
+
+// HandlePublishListing публикует черновик объявления (draft -> active)
+func (h *ListingHandler) HandlePublishListing(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	ctx := withAuth(r.Context(), r)
+	client := listing_service.NewListingServiceClient(h.client)
+	resp, err := client.PublishListing(ctx, &listing_service.PublishListingRequest{Id: id})
+	if err != nil {
+		h.logger.Error("Failed to publish listing via gRPC", zap.String("id", id), zap.Error(err))
+		st, _ := status.FromError(err)
+		http.Error(w, st.Message(), GRPCCodeToHTTPStatus(st.Code()))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.logger.Error("Failed to encode PublishListing response", zap.String("id", id), zap.Error(err))
+	}
+}
+
+// HandleDuplicateListing создает черновик-копию объявления и возвращает его (с новым id)
+func (h *ListingHandler) HandleDuplicateListing(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	ctx := withAuth(r.Context(), r)
+	client := listing_service.NewListingServiceClient(h.client)
+	resp, err := client.DuplicateListing(ctx, &listing_service.DuplicateListingRequest{Id: id})
+	if err != nil {
+		h.logger.Error("Failed to duplicate listing via gRPC", zap.String("id", id), zap.Error(err))
+		st, _ := status.FromError(err)
+		http.Error(w, st.Message(), GRPCCodeToHTTPStatus(st.Code()))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.logger.Error("Failed to encode DuplicateListing response", zap.String("id", id), zap.Error(err))
+	}
+}
+
+// HandleListOffers возвращает предложения: по объявлению (для продавца - все) или собственные
+func (h *ListingHandler) HandleListOffers(w http.ResponseWriter, r *http.Request) {
+	req := listing_service.ListOffersRequest{ListingId: r.URL.Query().Get("listing_id")}
+
+	ctx := withAuth(r.Context(), r)
+	client := listing_service.NewListingServiceClient(h.client)
+	resp, err := client.ListOffers(ctx, &req)
+	if err != nil {
+		h.logger.Error("Failed to list offers via gRPC", zap.String("listing_id", req.ListingId), zap.Error(err))
+		st, _ := status.FromError(err)
+		http.Error(w, st.Message(), GRPCCodeToHTTPStatus(st.Code()))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.logger.Error("Failed to encode ListOffers response", zap.Error(err))
+	}
+}
+
+// HandleListCategories возвращает все категории, отсортированные по имени
+func (h *ListingHandler) HandleListCategories(w http.ResponseWriter, r *http.Request) {
+	client := listing_service.NewListingServiceClient(h.client)
+	resp, err := client.ListCategories(r.Context(), &listing_service.Empty{})
+	if err != nil {
+		h.logger.Error("Failed to list categories via gRPC", zap.Error(err))
+		st, _ := status.FromError(err)
+		http.Error(w, st.Message(), GRPCCodeToHTTPStatus(st.Code()))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.logger.Error("Failed to encode ListCategories response", zap.Error(err))
+	}
+}
+
+func (h *ListingHandler) HandleGetCategory(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	client := listing_service.NewListingServiceClient(h.client)
+	resp, err := client.GetCategory(r.Context(), &listing_service.GetCategoryRequest{Id: id})
+	if err != nil {
+		h.logger.Error("Failed to get category via gRPC", zap.String("id", id), zap.Error(err))
+		st, _ := status.FromError(err)
+		http.Error(w, st.Message(), GRPCCodeToHTTPStatus(st.Code()))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.logger.Error("Failed to encode GetCategory response", zap.String("id", id), zap.Error(err))
+	}
+}
+
+// HandleCreateCategory - только для admin (проверяется в listing-service)
+func (h *ListingHandler) HandleCreateCategory(w http.ResponseWriter, r *http.Request) {
+	var req listing_service.CreateCategoryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	ctx := withAuth(r.Context(), r)
+	client := listing_service.NewListingServiceClient(h.client)
+	resp, err := client.CreateCategory(ctx, &req)
+	if err != nil {
+		h.logger.Error("Failed to create category via gRPC", zap.String("name", req.Name), zap.Error(err))
+		st, _ := status.FromError(err)
+		http.Error(w, st.Message(), GRPCCodeToHTTPStatus(st.Code()))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.logger.Error("Failed to encode CreateCategory response", zap.Error(err))
+	}
+}
+
+// HandleUpdateCategory - только для admin (проверяется в listing-service)
+func (h *ListingHandler) HandleUpdateCategory(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	var req listing_service.UpdateCategoryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	req.Id = id
+
+	ctx := withAuth(r.Context(), r)
+	client := listing_service.NewListingServiceClient(h.client)
+	resp, err := client.UpdateCategory(ctx, &req)
+	if err != nil {
+		h.logger.Error("Failed to update category via gRPC", zap.String("id", id), zap.Error(err))
+		st, _ := status.FromError(err)
+		http.Error(w, st.Message(), GRPCCodeToHTTPStatus(st.Code()))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		h.logger.Error("Failed to encode UpdateCategory response", zap.String("id", id), zap.Error(err))
+	}
+}
+
+// HandleDeleteCategory - только для admin; категорию с объявлениями удалить нельзя
+func (h *ListingHandler) HandleDeleteCategory(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	ctx := withAuth(r.Context(), r)
+	client := listing_service.NewListingServiceClient(h.client)
+	if _, err := client.DeleteCategory(ctx, &listing_service.DeleteCategoryRequest{Id: id}); err != nil {
+		h.logger.Error("Failed to delete category via gRPC", zap.String("id", id), zap.Error(err))
+		st, _ := status.FromError(err)
+		http.Error(w, st.Message(), GRPCCodeToHTTPStatus(st.Code()))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// withAuth добавляет JWT-токен и request ID в метаданные контекста для gRPC вызовов
 func withAuth(ctx context.Context, r *http.Request) context.Context {
+	ctx = withRequestID(ctx)
 	token := r.Header.Get("Authorization") // Это оригинальный Bearer токен
 	if token != "" {
 		// Для gRPC нам нужен сам токен, без "Bearer "
@@ -457,4 +893,12 @@ func withAuth(ctx context.Context, r *http.Request) context.Context {
 	// Функция `withAuth` сейчас просто передает оригинальный токен дальше,
 	// если он есть, для gRPC вызова.
 	return ctx
-}
\ No newline at end of file
+}
+// withRequestID передает request ID из контекста (middleware.RequestID) в gRPC метаданные,
+// чтобы сервисы могли записать его в свои логи и трейсы.
+func withRequestID(ctx context.Context) context.Context {
+	if id := middleware.RequestIDFromContext(ctx); id != "" {
+		return metadata.AppendToOutgoingContext(ctx, middleware.RequestIDMetadataKey, id)
+	}
+	return ctx
+}
@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/Abdurahmanit/GroupProject/listing-service/genproto/listing_service"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type fakeListingServer struct {
+	listing_service.UnimplementedListingServiceServer
+	err error
+	ids []string
+}
+
+func (s *fakeListingServer) GetListingsStatus(ctx context.Context, req *listing_service.GetListingsStatusRequest) (*listing_service.GetListingsStatusResponse, error) {
+	s.ids = req.GetIds()
+	if s.err != nil {
+		return nil, s.err
+	}
+	resp := &listing_service.GetListingsStatusResponse{Statuses: map[string]*listing_service.ListingAvailability{}}
+	for _, id := range req.GetIds() {
+		resp.Statuses[id] = &listing_service.ListingAvailability{Status: "active", Price: 100, Available: true, Quantity: 1}
+	}
+	return resp, nil
+}
+
+// newTestListingHandler поднимает fake listing-service в памяти и возвращает обработчик, подключенный к нему
+func newTestListingHandler(t *testing.T, srv *fakeListingServer) *ListingHandler {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	listing_service.RegisterListingServiceServer(s, srv)
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("dial fake listing-service: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return NewListingHandler(conn, zap.NewNop())
+}
+
+func serveListingsStatus(t *testing.T, srv *fakeListingServer, query string) *httptest.ResponseRecorder {
+	t.Helper()
+	h := newTestListingHandler(t, srv)
+	rec := httptest.NewRecorder()
+	h.HandleGetListingsStatus(rec, httptest.NewRequest(http.MethodGet, "/api/listings/status"+query, nil))
+	return rec
+}
+
+func TestHandleGetListingsStatus(t *testing.T) {
+	srv := &fakeListingServer{}
+
+	rec := serveListingsStatus(t, srv, "?ids=a,%20b,,c")
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	if want := []string{"a", "b", "c"}; !reflect.DeepEqual(srv.ids, want) {
+		t.Errorf("requested ids = %v, want %v", srv.ids, want)
+	}
+	var resp struct {
+		Statuses map[string]struct {
+			Status    string  `json:"status"`
+			Price     float64 `json:"price"`
+			Available bool    `json:"available"`
+		} `json:"statuses"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if len(resp.Statuses) != 3 || !resp.Statuses["b"].Available || resp.Statuses["b"].Price != 100 {
+		t.Errorf("statuses = %+v, want all three available", resp.Statuses)
+	}
+}
+
+func TestHandleGetListingsStatus_MissingIDs(t *testing.T) {
+	for _, query := range []string{"", "?ids=", "?ids=,%20,"} {
+		srv := &fakeListingServer{}
+		rec := serveListingsStatus(t, srv, query)
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("%q: status = %d, want %d", query, rec.Code, http.StatusBadRequest)
+		}
+		if srv.ids != nil {
+			t.Errorf("%q: listing-service called with %v", query, srv.ids)
+		}
+	}
+}
+
+func TestHandleGetListingsStatus_BackendError(t *testing.T) {
+	srv := &fakeListingServer{err: status.Error(codes.InvalidArgument, "too many ids")}
+
+	rec := serveListingsStatus(t, srv, "?ids=a")
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+}
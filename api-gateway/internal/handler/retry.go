@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"context"
+	"math/rand/v2"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// idempotentMethodPrefixes - префиксы имен RPC, которые только читают данные и безопасны для повтора.
+// Все остальные вызовы (Register, Create*, Update*, PlaceOrder, ...) не повторяются.
+var idempotentMethodPrefixes = []string{"Get", "List", "Search", "Check", "Count"}
+
+// RetryPolicy задает повторы вызовов при временных ошибках сервисов
+type RetryPolicy struct {
+	// MaxAttempts - максимальное число попыток, включая первую; 1 и меньше отключает повторы
+	MaxAttempts int
+	// InitialBackoff - пауза перед первым повтором, дальше удваивается до MaxBackoff
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// Timeout - общее время на все попытки; повтор, который не успевает в него уложиться, не делается
+	Timeout time.Duration
+}
+
+// RetryInterceptor повторяет идемпотентные unary-вызовы, завершившиеся codes.Unavailable или
+// codes.DeadlineExceeded, с экспоненциальной паузой и full jitter. Повторы прекращаются, когда
+// исчерпаны попытки, истекает Timeout или отменен контекст запроса.
+func RetryInterceptor(policy RetryPolicy, logger *zap.Logger) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if policy.MaxAttempts <= 1 || !isIdempotentMethod(method) {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		deadline := time.Now().Add(policy.Timeout)
+		backoff := policy.InitialBackoff
+		for attempt := 1; ; attempt++ {
+			err := invoker(ctx, method, req, reply, cc, opts...)
+			if err == nil || !isRetryableCode(status.Code(err)) || attempt >= policy.MaxAttempts || ctx.Err() != nil {
+				return err
+			}
+
+			sleep := time.Duration(rand.Int64N(int64(backoff) + 1))
+			if policy.Timeout > 0 && time.Now().Add(sleep).After(deadline) {
+				return err
+			}
+			logger.Warn("Retrying gRPC call after transient error",
+				zap.String("method", method), zap.Int("attempt", attempt), zap.Duration("backoff", sleep), zap.Error(err))
+
+			timer := time.NewTimer(sleep)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return err
+			case <-timer.C:
+			}
+			backoff = min(backoff*2, policy.MaxBackoff)
+		}
+	}
+}
+
+// isIdempotentMethod проверяет имя RPC из полного метода вида "/package.Service/Method"
+func isIdempotentMethod(fullMethod string) bool {
+	name := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	for _, prefix := range idempotentMethodPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func isRetryableCode(code codes.Code) bool {
+	return code == codes.Unavailable || code == codes.DeadlineExceeded
+}
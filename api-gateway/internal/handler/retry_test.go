@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// countingInvoker возвращает invoker, который всегда отвечает err, и счетчик его вызовов
+func countingInvoker(err error) (grpc.UnaryInvoker, *int) {
+	calls := 0
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		calls++
+		return err
+	}, &calls
+}
+
+func testRetryPolicy() RetryPolicy {
+	return RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: 2 * time.Millisecond, Timeout: time.Second}
+}
+
+func TestRetryInterceptor_RetriesIdempotentUpToMaxAttempts(t *testing.T) {
+	interceptor := RetryInterceptor(testRetryPolicy(), zap.NewNop())
+
+	for _, code := range []codes.Code{codes.Unavailable, codes.DeadlineExceeded} {
+		invoker, calls := countingInvoker(status.Error(code, "backend down"))
+		err := interceptor(context.Background(), "/listing.ListingService/GetListingByID", nil, nil, nil, invoker)
+		if status.Code(err) != code {
+			t.Fatalf("%v: got %v, want the last backend error", code, err)
+		}
+		if *calls != 3 {
+			t.Errorf("%v: backend called %d times, want 3", code, *calls)
+		}
+	}
+}
+
+func TestRetryInterceptor_NeverRetriesNonIdempotent(t *testing.T) {
+	interceptor := RetryInterceptor(testRetryPolicy(), zap.NewNop())
+
+	for _, method := range []string{
+		"/user.UserService/Register",
+		"/listing.ListingService/CreateListing",
+		"/review.ReviewService/CreateReview",
+		"/order.OrderService/PlaceOrder",
+	} {
+		invoker, calls := countingInvoker(status.Error(codes.Unavailable, "backend down"))
+		_ = interceptor(context.Background(), method, nil, nil, nil, invoker)
+		if *calls != 1 {
+			t.Errorf("%s: backend called %d times, want 1", method, *calls)
+		}
+	}
+}
+
+func TestRetryInterceptor_DoesNotRetryOtherCodes(t *testing.T) {
+	interceptor := RetryInterceptor(testRetryPolicy(), zap.NewNop())
+
+	for _, code := range []codes.Code{codes.NotFound, codes.InvalidArgument, codes.Internal, codes.ResourceExhausted} {
+		invoker, calls := countingInvoker(status.Error(code, "business error"))
+		err := interceptor(context.Background(), "/listing.ListingService/GetListingByID", nil, nil, nil, invoker)
+		if status.Code(err) != code {
+			t.Fatalf("%v: got %v", code, err)
+		}
+		if *calls != 1 {
+			t.Errorf("%v: backend called %d times, want 1", code, *calls)
+		}
+	}
+}
+
+func TestRetryInterceptor_StopsAtTimeout(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 10, InitialBackoff: time.Hour, MaxBackoff: time.Hour, Timeout: 10 * time.Millisecond}
+	interceptor := RetryInterceptor(policy, zap.NewNop())
+	invoker, calls := countingInvoker(status.Error(codes.Unavailable, "backend down"))
+
+	start := time.Now()
+	// Пауза может выпасть нулевой (full jitter), поэтому попыток бывает больше одной, но до MaxAttempts не доходит
+	err := interceptor(context.Background(), "/listing.ListingService/GetListingByID", nil, nil, nil, invoker)
+	if status.Code(err) != codes.Unavailable {
+		t.Fatalf("got %v, want Unavailable", err)
+	}
+	if *calls >= policy.MaxAttempts {
+		t.Errorf("backend called %d times, want fewer than MaxAttempts", *calls)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("retries took %v, want them cut by Timeout", elapsed)
+	}
+}
+
+func TestRetryInterceptor_StopsWhenContextCancelled(t *testing.T) {
+	// Без Timeout и с часовой паузой выйти из ожидания можно только по отмене контекста
+	policy := RetryPolicy{MaxAttempts: 10, InitialBackoff: time.Hour, MaxBackoff: time.Hour}
+	interceptor := RetryInterceptor(policy, zap.NewNop())
+	invoker, calls := countingInvoker(status.Error(codes.Unavailable, "backend down"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+	err := interceptor(ctx, "/listing.ListingService/GetListingByID", nil, nil, nil, invoker)
+	if status.Code(err) != codes.Unavailable {
+		t.Fatalf("got %v, want Unavailable", err)
+	}
+	if *calls != 1 {
+		t.Errorf("backend called %d times after cancel, want 1", *calls)
+	}
+}
@@ -33,9 +33,10 @@ func NewReviewHandler(conn *grpc.ClientConn, logger *zap.Logger) *ReviewHandler
 // --- Начало вспомогательных функций (если их нет в общем месте) ---
 
 func withAuthFromHttpRequest(ctx context.Context, r *http.Request) context.Context {
+	ctx = withRequestID(ctx)
 	token := r.Header.Get("Authorization")
 	if token != "" {
-		return metadata.NewOutgoingContext(ctx, metadata.Pairs("authorization", token))
+		return metadata.AppendToOutgoingContext(ctx, "authorization", token)
 	}
 	return ctx
 }
@@ -109,7 +110,7 @@ func (h *ReviewHandler) HandleGetReview(w http.ResponseWriter, r *http.Request)
 		return
 	}
 	req := &pb.GetReviewRequest{ReviewId: reviewID}
-	resp, err := h.client.GetReview(context.Background(), req)
+	resp, err := h.client.GetReview(withAuthFromHttpRequest(r.Context(), r), req)
 	if err != nil {
 		h.logger.Error("gRPC GetReview call failed", zap.String("review_id", reviewID), zap.Error(err))
 		handleGRPCError(w, err, "Failed to get review", h.logger)
@@ -187,13 +188,13 @@ func (h *ReviewHandler) HandleListReviewsByProduct(w http.ResponseWriter, r *htt
 		StatusFilter: statusFilter,
 	}
 
-	resp, err := h.client.ListReviewsByProduct(context.Background(), req)
+	resp, err := h.client.ListReviewsByProduct(withAuthFromHttpRequest(r.Context(), r), req)
 	if err != nil {
 		h.logger.Error("gRPC ListReviewsByProduct call failed", zap.String("product_id", productID), zap.Error(err))
 		handleGRPCError(w, err, "Failed to list reviews for product", h.logger)
 		return
 	}
-	respondWithJSON(w, http.StatusOK, resp)
+	respondWithJSON(w, http.StatusOK, reviewListFields.filter(r, resp))
 }
 
 func (h *ReviewHandler) HandleListReviewsByUser(w http.ResponseWriter, r *http.Request) {
@@ -220,7 +221,7 @@ func (h *ReviewHandler) HandleListReviewsByUser(w http.ResponseWriter, r *http.R
 		handleGRPCError(w, err, "Failed to list reviews for user", h.logger)
 		return
 	}
-	respondWithJSON(w, http.StatusOK, resp)
+	respondWithJSON(w, http.StatusOK, reviewListFields.filter(r, resp))
 }
 
 func (h *ReviewHandler) HandleGetProductAverageRating(w http.ResponseWriter, r *http.Request) {
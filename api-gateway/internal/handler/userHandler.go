@@ -1,9 +1,12 @@
 package handler
 
 import (
+	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 
+	"github.com/Abdurahmanit/GroupProject/api-gateway/internal/middleware"
 	user "github.com/Abdurahmanit/GroupProject/user-service/proto" // Ensure this path is correct
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -23,6 +26,19 @@ func NewUserHandler(conn *grpc.ClientConn, logger *zap.Logger) *UserHandler {
 	}
 }
 
+// CheckToken implements middleware.RevocationChecker by asking user-service whether the
+// token was revoked (logout, password reset, account removal).
+func (h *UserHandler) CheckToken(ctx context.Context, token string) error {
+	_, err := h.userClient.ValidateToken(withRequestID(ctx), &user.ValidateTokenRequest{AccessToken: token})
+	if status.Code(err) == codes.Unauthenticated {
+		return middleware.ErrTokenRevoked
+	}
+	if err != nil {
+		h.logger.Warn("Failed to validate token via gRPC", zap.Error(err))
+	}
+	return err
+}
+
 func (h *UserHandler) Register(w http.ResponseWriter, r *http.Request) {
 	var grpcReq user.RegisterRequest
 	if err := json.NewDecoder(r.Body).Decode(&grpcReq); err != nil {
@@ -42,7 +58,7 @@ func (h *UserHandler) Register(w http.ResponseWriter, r *http.Request) {
 	}
 	h.logger.Info("HTTP Register request received", zap.String("email", grpcReq.GetEmail()))
 
-	resp, err := h.userClient.Register(r.Context(), &grpcReq)
+	resp, err := h.userClient.Register(withRequestID(r.Context()), &grpcReq)
 	if err != nil {
 		h.logger.Error("Failed to register user via gRPC from API Gateway", zap.String("email", grpcReq.GetEmail()), zap.Error(err))
 		s, _ := status.FromError(err)
@@ -62,7 +78,7 @@ func (h *UserHandler) Login(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "Invalid request body", http.StatusBadRequest)
 		return
 	}
-	resp, err := h.userClient.Login(r.Context(), &req) // Use r.Context()
+	resp, err := h.userClient.Login(withRequestID(r.Context()), &req)
 	if err != nil {
 		h.logger.Error("Failed to login user via gRPC", zap.Error(err))
 		s, _ := status.FromError(err)
@@ -73,6 +89,25 @@ func (h *UserHandler) Login(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(resp)
 }
 
+func (h *UserHandler) RefreshToken(w http.ResponseWriter, r *http.Request) {
+	var reqBody struct {
+		RefreshToken string `json:"refresh_token"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil || reqBody.RefreshToken == "" {
+		http.Error(w, "refresh_token is required", http.StatusBadRequest)
+		return
+	}
+	resp, err := h.userClient.RefreshToken(withRequestID(r.Context()), &user.RefreshTokenRequest{RefreshToken: reqBody.RefreshToken})
+	if err != nil {
+		h.logger.Warn("gRPC RefreshToken call failed", zap.Error(err))
+		s, _ := status.FromError(err)
+		http.Error(w, s.Message(), GRPCCodeToHTTPStatus(s.Code()))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
 func (h *UserHandler) Logout(w http.ResponseWriter, r *http.Request) {
 	userID, ok := r.Context().Value("user_id").(string)
 	if !ok || userID == "" {
@@ -81,7 +116,7 @@ func (h *UserHandler) Logout(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	req := &user.LogoutRequest{UserId: userID}
-	resp, err := h.userClient.Logout(r.Context(), req)
+	resp, err := h.userClient.Logout(withRequestID(r.Context()), req)
 	if err != nil {
 		h.logger.Error("Failed to logout user via gRPC", zap.String("userID", userID), zap.Error(err))
 		s, _ := status.FromError(err)
@@ -101,7 +136,7 @@ func (h *UserHandler) GetProfile(w http.ResponseWriter, r *http.Request) {
 	}
 	h.logger.Info("HTTP GetProfile request received", zap.String("userID", userID))
 	grpcReq := &user.GetProfileRequest{UserId: userID}
-	resp, err := h.userClient.GetProfile(r.Context(), grpcReq)
+	resp, err := h.userClient.GetProfile(withRequestID(r.Context()), grpcReq)
 	if err != nil {
 		h.logger.Error("Failed to get profile via gRPC from API Gateway", zap.String("userID", userID), zap.Error(err))
 		s, _ := status.FromError(err)
@@ -134,7 +169,7 @@ func (h *UserHandler) UpdateProfile(w http.ResponseWriter, r *http.Request) {
 		zap.String("email", grpcReq.GetEmail()),
 		zap.String("phoneNumber", grpcReq.GetPhoneNumber()))
 
-	resp, err := h.userClient.UpdateProfile(r.Context(), &grpcReq)
+	resp, err := h.userClient.UpdateProfile(withRequestID(r.Context()), &grpcReq)
 	if err != nil {
 		h.logger.Error("Failed to update profile via gRPC from API Gateway", zap.String("userID", userID), zap.Error(err))
 		s, _ := status.FromError(err)
@@ -160,7 +195,7 @@ func (h *UserHandler) ChangePassword(w http.ResponseWriter, r *http.Request) {
 	}
 	reqBody.UserId = userID
 
-	resp, err := h.userClient.ChangePassword(r.Context(), &reqBody)
+	resp, err := h.userClient.ChangePassword(withRequestID(r.Context()), &reqBody)
 	if err != nil {
 		h.logger.Error("Failed to change password via gRPC", zap.String("userID", userID), zap.Error(err))
 		s, _ := status.FromError(err)
@@ -182,7 +217,7 @@ func (h *UserHandler) RequestEmailVerification(w http.ResponseWriter, r *http.Re
 	h.logger.Info("HTTP RequestEmailVerification request received", zap.String("userID", userID))
 
 	grpcReq := &user.RequestEmailVerificationRequest{UserId: userID}
-	resp, err := h.userClient.RequestEmailVerification(r.Context(), grpcReq)
+	resp, err := h.userClient.RequestEmailVerification(withRequestID(r.Context()), grpcReq)
 	if err != nil {
 		h.logger.Error("gRPC RequestEmailVerification call failed", zap.String("userID", userID), zap.Error(err))
 		s, _ := status.FromError(err)
@@ -238,7 +273,7 @@ func (h *UserHandler) VerifyEmail(w http.ResponseWriter, r *http.Request) {
 	h.logger.Info("HTTP VerifyEmail request received", zap.String("userID", userID))
 
 	grpcReq := &user.VerifyEmailRequest{UserId: userID, Code: reqBody.Code}
-	resp, err := h.userClient.VerifyEmail(r.Context(), grpcReq)
+	resp, err := h.userClient.VerifyEmail(withRequestID(r.Context()), grpcReq)
 	if err != nil {
 		h.logger.Error("gRPC VerifyEmail call failed", zap.String("userID", userID), zap.Error(err))
 		s, _ := status.FromError(err)
@@ -272,7 +307,7 @@ func (h *UserHandler) CheckEmailVerificationStatus(w http.ResponseWriter, r *htt
 	h.logger.Info("HTTP CheckEmailVerificationStatus request received", zap.String("userID", userID))
 
 	grpcReq := &user.CheckEmailVerificationStatusRequest{UserId: userID}
-	resp, err := h.userClient.CheckEmailVerificationStatus(r.Context(), grpcReq)
+	resp, err := h.userClient.CheckEmailVerificationStatus(withRequestID(r.Context()), grpcReq)
 	if err != nil {
 		h.logger.Error("gRPC CheckEmailVerificationStatus call failed", zap.String("userID", userID), zap.Error(err))
 		s, _ := status.FromError(err)
@@ -286,6 +321,177 @@ func (h *UserHandler) CheckEmailVerificationStatus(w http.ResponseWriter, r *htt
 	h.logger.Info("HTTP CheckEmailVerificationStatus request processed", zap.String("userID", userID), zap.Bool("is_verified", resp.GetIsVerified()))
 }
 
+func (h *UserHandler) RequestPasswordReset(w http.ResponseWriter, r *http.Request) {
+	var reqBody struct {
+		Email string `json:"email"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+		http.Error(w, "Invalid request body: missing email", http.StatusBadRequest)
+		return
+	}
+	if reqBody.Email == "" {
+		http.Error(w, "Email is required", http.StatusBadRequest)
+		return
+	}
+	h.logger.Info("HTTP RequestPasswordReset request received", zap.String("email", reqBody.Email))
+
+	grpcReq := &user.RequestPasswordResetRequest{Email: reqBody.Email}
+	resp, err := h.userClient.RequestPasswordReset(withRequestID(r.Context()), grpcReq)
+	if err != nil {
+		h.logger.Error("gRPC RequestPasswordReset call failed", zap.String("email", reqBody.Email), zap.Error(err))
+		s, _ := status.FromError(err)
+		http.Error(w, s.Message(), GRPCCodeToHTTPStatus(s.Code()))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+	h.logger.Info("HTTP RequestPasswordReset request processed", zap.String("email", reqBody.Email))
+}
+
+func (h *UserHandler) ResetPassword(w http.ResponseWriter, r *http.Request) {
+	var reqBody struct {
+		Email       string `json:"email"`
+		Code        string `json:"code"`
+		NewPassword string `json:"new_password"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	if reqBody.Email == "" || reqBody.Code == "" || reqBody.NewPassword == "" {
+		http.Error(w, "Email, code and new_password are required", http.StatusBadRequest)
+		return
+	}
+	h.logger.Info("HTTP ResetPassword request received", zap.String("email", reqBody.Email))
+
+	grpcReq := &user.ResetPasswordRequest{Email: reqBody.Email, Code: reqBody.Code, NewPassword: reqBody.NewPassword}
+	resp, err := h.userClient.ResetPassword(withRequestID(r.Context()), grpcReq)
+	if err != nil {
+		h.logger.Error("gRPC ResetPassword call failed", zap.String("email", reqBody.Email), zap.Error(err))
+		s, _ := status.FromError(err)
+		http.Error(w, s.Message(), GRPCCodeToHTTPStatus(s.Code()))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !resp.GetSuccess() {
+		w.WriteHeader(http.StatusBadRequest) // invalid or expired reset code
+	} else {
+		w.WriteHeader(http.StatusOK)
+	}
+	json.NewEncoder(w).Encode(resp)
+	h.logger.Info("HTTP ResetPassword request processed", zap.String("email", reqBody.Email), zap.Bool("success", resp.GetSuccess()))
+}
+
+func (h *UserHandler) EnableTwoFactor(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok || userID == "" {
+		h.logger.Warn("User ID not found in token for EnableTwoFactor")
+		http.Error(w, "User ID not found in token", http.StatusUnauthorized)
+		return
+	}
+	h.logger.Info("HTTP EnableTwoFactor request received", zap.String("userID", userID))
+
+	resp, err := h.userClient.EnableTwoFactor(withRequestID(r.Context()), &user.EnableTwoFactorRequest{UserId: userID})
+	if err != nil {
+		h.logger.Error("gRPC EnableTwoFactor call failed", zap.String("userID", userID), zap.Error(err))
+		s, _ := status.FromError(err)
+		http.Error(w, s.Message(), GRPCCodeToHTTPStatus(s.Code()))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+	h.logger.Info("HTTP EnableTwoFactor request processed", zap.String("userID", userID))
+}
+
+func (h *UserHandler) ConfirmTwoFactor(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok || userID == "" {
+		h.logger.Warn("User ID not found in token for ConfirmTwoFactor")
+		http.Error(w, "User ID not found in token", http.StatusUnauthorized)
+		return
+	}
+	var reqBody struct {
+		Code string `json:"code"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil || reqBody.Code == "" {
+		http.Error(w, "Code is required", http.StatusBadRequest)
+		return
+	}
+	h.logger.Info("HTTP ConfirmTwoFactor request received", zap.String("userID", userID))
+
+	resp, err := h.userClient.ConfirmTwoFactor(withRequestID(r.Context()), &user.ConfirmTwoFactorRequest{UserId: userID, Code: reqBody.Code})
+	if err != nil {
+		h.logger.Error("gRPC ConfirmTwoFactor call failed", zap.String("userID", userID), zap.Error(err))
+		s, _ := status.FromError(err)
+		http.Error(w, s.Message(), GRPCCodeToHTTPStatus(s.Code()))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+	h.logger.Info("HTTP ConfirmTwoFactor request processed", zap.String("userID", userID))
+}
+
+func (h *UserHandler) VerifyTwoFactor(w http.ResponseWriter, r *http.Request) {
+	var reqBody struct {
+		PendingToken string `json:"pending_token"`
+		Code         string `json:"code"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	if reqBody.PendingToken == "" || reqBody.Code == "" {
+		http.Error(w, "pending_token and code are required", http.StatusBadRequest)
+		return
+	}
+	h.logger.Info("HTTP VerifyTwoFactor request received")
+
+	resp, err := h.userClient.VerifyTwoFactor(withRequestID(r.Context()), &user.VerifyTwoFactorRequest{PendingToken: reqBody.PendingToken, Code: reqBody.Code})
+	if err != nil {
+		h.logger.Warn("gRPC VerifyTwoFactor call failed", zap.Error(err))
+		s, _ := status.FromError(err)
+		http.Error(w, s.Message(), GRPCCodeToHTTPStatus(s.Code()))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+	h.logger.Info("HTTP VerifyTwoFactor request processed")
+}
+
+func (h *UserHandler) DisableTwoFactor(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok || userID == "" {
+		h.logger.Warn("User ID not found in token for DisableTwoFactor")
+		http.Error(w, "User ID not found in token", http.StatusUnauthorized)
+		return
+	}
+	var reqBody struct {
+		Code string `json:"code"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil || reqBody.Code == "" {
+		http.Error(w, "Code is required", http.StatusBadRequest)
+		return
+	}
+	h.logger.Info("HTTP DisableTwoFactor request received", zap.String("userID", userID))
+
+	resp, err := h.userClient.DisableTwoFactor(withRequestID(r.Context()), &user.DisableTwoFactorRequest{UserId: userID, Code: reqBody.Code})
+	if err != nil {
+		h.logger.Error("gRPC DisableTwoFactor call failed", zap.String("userID", userID), zap.Error(err))
+		s, _ := status.FromError(err)
+		http.Error(w, s.Message(), GRPCCodeToHTTPStatus(s.Code()))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+	h.logger.Info("HTTP DisableTwoFactor request processed", zap.String("userID", userID))
+}
+
 func (h *UserHandler) DeleteUser(w http.ResponseWriter, r *http.Request) {
 	userID, ok := r.Context().Value("user_id").(string)
 	if !ok || userID == "" {
@@ -294,7 +500,7 @@ func (h *UserHandler) DeleteUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	req := &user.DeleteUserRequest{UserId: userID}
-	resp, err := h.userClient.DeleteUser(r.Context(), req)
+	resp, err := h.userClient.DeleteUser(withRequestID(r.Context()), req)
 	if err != nil {
 		h.logger.Error("Failed to delete user (hard) via gRPC", zap.String("userID", userID), zap.Error(err))
 		s, _ := status.FromError(err)
@@ -313,7 +519,7 @@ func (h *UserHandler) DeactivateUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	req := &user.DeactivateUserRequest{UserId: userID}
-	resp, err := h.userClient.DeactivateUser(r.Context(), req)
+	resp, err := h.userClient.DeactivateUser(withRequestID(r.Context()), req)
 	if err != nil {
 		h.logger.Error("Failed to deactivate user via gRPC", zap.String("userID", userID), zap.Error(err))
 		s, _ := status.FromError(err)
@@ -324,6 +530,44 @@ func (h *UserHandler) DeactivateUser(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(resp)
 }
 
+// UploadAvatar accepts a multipart form with the image in the "avatar_file" field.
+// Size and image type are validated by user-service.
+func (h *UserHandler) UploadAvatar(w http.ResponseWriter, r *http.Request) {
+	userID, ok := r.Context().Value("user_id").(string)
+	if !ok || userID == "" {
+		h.logger.Warn("User ID not found in token for UploadAvatar")
+		http.Error(w, "User ID not found in token", http.StatusUnauthorized)
+		return
+	}
+	if err := r.ParseMultipartForm(10 << 20); err != nil {
+		http.Error(w, "Invalid multipart form: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	file, header, err := r.FormFile("avatar_file")
+	if err != nil {
+		http.Error(w, "Failed to get uploaded file: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer file.Close()
+	data, err := io.ReadAll(file)
+	if err != nil {
+		http.Error(w, "Failed to read file: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	req := &user.UploadAvatarRequest{UserId: userID, FileName: header.Filename, Data: data}
+	resp, err := h.userClient.UploadAvatar(withRequestID(r.Context()), req)
+	if err != nil {
+		h.logger.Error("Failed to upload avatar via gRPC", zap.String("userID", userID), zap.Error(err))
+		s, _ := status.FromError(err)
+		http.Error(w, s.Message(), GRPCCodeToHTTPStatus(s.Code()))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(resp)
+}
+
 // --- Admin Handlers ---
 func (h *UserHandler) AdminDeleteUser(w http.ResponseWriter, r *http.Request) {
 	adminID, ok := r.Context().Value("user_id").(string)
@@ -344,9 +588,69 @@ func (h *UserHandler) AdminDeleteUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	grpcReq := &user.AdminDeleteUserRequest{AdminId: adminID, UserIdToDelete: reqBody.UserIDToDelete}
-	resp, err := h.userClient.AdminDeleteUser(r.Context(), grpcReq)
+	resp, err := h.userClient.AdminDeleteUser(withRequestID(r.Context()), grpcReq)
+	if err != nil {
+		h.logger.Error("Failed to admin delete user via gRPC", zap.String("adminID", adminID), zap.String("targetUserID", reqBody.UserIDToDelete), zap.Error(err))
+		s, _ := status.FromError(err)
+		http.Error(w, s.Message(), GRPCCodeToHTTPStatus(s.Code()))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func (h *UserHandler) AdminRestoreUser(w http.ResponseWriter, r *http.Request) {
+	adminID, ok := r.Context().Value("user_id").(string)
+	if !ok || adminID == "" {
+		h.logger.Warn("Admin ID not found in token for AdminRestoreUser")
+		http.Error(w, "Admin ID not found in token", http.StatusUnauthorized)
+		return
+	}
+	var reqBody struct {
+		UserID string `json:"user_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+		http.Error(w, "Invalid request body: missing user_id", http.StatusBadRequest)
+		return
+	}
+	if reqBody.UserID == "" {
+		http.Error(w, "user_id is required", http.StatusBadRequest)
+		return
+	}
+	grpcReq := &user.RestoreUserRequest{AdminId: adminID, UserId: reqBody.UserID}
+	resp, err := h.userClient.RestoreUser(withRequestID(r.Context()), grpcReq)
+	if err != nil {
+		h.logger.Error("Failed to restore user via gRPC", zap.String("adminID", adminID), zap.String("targetUserID", reqBody.UserID), zap.Error(err))
+		s, _ := status.FromError(err)
+		http.Error(w, s.Message(), GRPCCodeToHTTPStatus(s.Code()))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func (h *UserHandler) AdminPurgeUser(w http.ResponseWriter, r *http.Request) {
+	adminID, ok := r.Context().Value("user_id").(string)
+	if !ok || adminID == "" {
+		h.logger.Warn("Admin ID not found in token for AdminPurgeUser")
+		http.Error(w, "Admin ID not found in token", http.StatusUnauthorized)
+		return
+	}
+	var reqBody struct {
+		UserIDToPurge string `json:"user_id_to_purge"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+		http.Error(w, "Invalid request body: missing user_id_to_purge", http.StatusBadRequest)
+		return
+	}
+	if reqBody.UserIDToPurge == "" {
+		http.Error(w, "user_id_to_purge is required", http.StatusBadRequest)
+		return
+	}
+	grpcReq := &user.AdminPurgeUserRequest{AdminId: adminID, UserIdToPurge: reqBody.UserIDToPurge}
+	resp, err := h.userClient.AdminPurgeUser(withRequestID(r.Context()), grpcReq)
 	if err != nil {
-		h.logger.Error("Failed to admin delete user (hard) via gRPC", zap.String("adminID", adminID), zap.String("targetUserID", reqBody.UserIDToDelete), zap.Error(err))
+		h.logger.Error("Failed to purge user via gRPC", zap.String("adminID", adminID), zap.String("targetUserID", reqBody.UserIDToPurge), zap.Error(err))
 		s, _ := status.FromError(err)
 		http.Error(w, s.Message(), GRPCCodeToHTTPStatus(s.Code()))
 		return
@@ -366,7 +670,7 @@ func (h *UserHandler) AdminListUsers(w http.ResponseWriter, r *http.Request) {
 	_ = json.NewDecoder(r.Body).Decode(&reqBody)
 	reqBody.AdminId = adminID
 
-	resp, err := h.userClient.AdminListUsers(r.Context(), &reqBody)
+	resp, err := h.userClient.AdminListUsers(withRequestID(r.Context()), &reqBody)
 	if err != nil {
 		h.logger.Error("Failed to list users by admin via gRPC", zap.String("adminID", adminID), zap.Error(err))
 		s, _ := status.FromError(err)
@@ -391,7 +695,7 @@ func (h *UserHandler) AdminSearchUsers(w http.ResponseWriter, r *http.Request) {
 	}
 	reqBody.AdminId = adminID
 
-	resp, err := h.userClient.AdminSearchUsers(r.Context(), &reqBody)
+	resp, err := h.userClient.AdminSearchUsers(withRequestID(r.Context()), &reqBody)
 	if err != nil {
 		h.logger.Error("Failed to search users by admin via gRPC", zap.String("adminID", adminID), zap.String("query", reqBody.Query), zap.Error(err))
 		s, _ := status.FromError(err)
@@ -426,7 +730,7 @@ func (h *UserHandler) AdminUpdateUserRole(w http.ResponseWriter, r *http.Request
 		UserIdToUpdate: reqBody.UserIDToUpdate,
 		Role:           reqBody.Role,
 	}
-	resp, err := h.userClient.AdminUpdateUserRole(r.Context(), grpcReq)
+	resp, err := h.userClient.AdminUpdateUserRole(withRequestID(r.Context()), grpcReq)
 	if err != nil {
 		h.logger.Error("Failed to update user role by admin via gRPC", zap.String("adminID", adminID), zap.String("targetUserID", reqBody.UserIDToUpdate), zap.Error(err))
 		s, _ := status.FromError(err)
@@ -461,7 +765,7 @@ func (h *UserHandler) AdminSetUserActiveStatus(w http.ResponseWriter, r *http.Re
 		UserId:   reqBody.UserID,
 		IsActive: reqBody.IsActive,
 	}
-	resp, err := h.userClient.AdminSetUserActiveStatus(r.Context(), grpcReq)
+	resp, err := h.userClient.AdminSetUserActiveStatus(withRequestID(r.Context()), grpcReq)
 	if err != nil {
 		h.logger.Error("Failed to set user active status by admin via gRPC", zap.String("adminID", adminID), zap.String("targetUserID", reqBody.UserID), zap.Error(err))
 		s, _ := status.FromError(err)
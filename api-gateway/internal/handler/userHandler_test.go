@@ -0,0 +1,100 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	user "github.com/Abdurahmanit/GroupProject/user-service/proto"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type fakeUserServer struct {
+	user.UnimplementedUserServiceServer
+	err          error
+	refreshToken string
+}
+
+func (s *fakeUserServer) RefreshToken(ctx context.Context, req *user.RefreshTokenRequest) (*user.RefreshTokenResponse, error) {
+	s.refreshToken = req.GetRefreshToken()
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &user.RefreshTokenResponse{Token: "new-access-token"}, nil
+}
+
+// serveRefreshToken поднимает fake user-service в памяти и отправляет body в RefreshToken
+func serveRefreshToken(t *testing.T, srv *fakeUserServer, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	user.RegisterUserServiceServer(s, srv)
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("dial fake user-service: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	rec := httptest.NewRecorder()
+	NewUserHandler(conn, zap.NewNop()).RefreshToken(rec, httptest.NewRequest(http.MethodPost, "/api/user/refresh", strings.NewReader(body)))
+	return rec
+}
+
+func TestRefreshToken(t *testing.T) {
+	srv := &fakeUserServer{}
+
+	rec := serveRefreshToken(t, srv, `{"refresh_token":"rt"}`)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	if srv.refreshToken != "rt" {
+		t.Errorf("refresh token sent = %q, want %q", srv.refreshToken, "rt")
+	}
+	var resp struct {
+		Token string `json:"token"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if resp.Token != "new-access-token" {
+		t.Errorf("token = %q, want the new access token", resp.Token)
+	}
+}
+
+func TestRefreshToken_MissingToken(t *testing.T) {
+	for _, body := range []string{"", "{}", `{"refresh_token":""}`} {
+		srv := &fakeUserServer{}
+		rec := serveRefreshToken(t, srv, body)
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("%q: status = %d, want %d", body, rec.Code, http.StatusBadRequest)
+		}
+		if srv.refreshToken != "" {
+			t.Errorf("%q: user-service called", body)
+		}
+	}
+}
+
+func TestRefreshToken_Revoked(t *testing.T) {
+	srv := &fakeUserServer{err: status.Error(codes.Unauthenticated, "invalid refresh token")}
+
+	rec := serveRefreshToken(t, srv, `{"refresh_token":"rt"}`)
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+}
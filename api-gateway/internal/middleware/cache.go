@@ -0,0 +1,297 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// maxCachedBodyBytes keeps a single large response (e.g. a huge search page) from filling the cache.
+const maxCachedBodyBytes = 1 << 20
+
+type cachedResponse struct {
+	status    int
+	header    http.Header
+	body      []byte
+	path      string
+	storedAt  time.Time
+	expiresAt time.Time
+}
+
+// ResponseCache is an in-memory cache of anonymous GET responses. Routes opt in with For,
+// using the TTL configured for their name; routes without a TTL are not cached.
+// Entries live in this gateway instance only, so TTLs should stay short.
+type ResponseCache struct {
+	mu         sync.Mutex
+	entries    map[string]*cachedResponse
+	ttls       map[string]time.Duration
+	maxEntries int
+}
+
+// NewResponseCache creates a cache holding up to maxEntries responses. ttls maps route
+// names (as passed to For) to their TTL.
+func NewResponseCache(ttls map[string]time.Duration, maxEntries int) *ResponseCache {
+	return &ResponseCache{
+		entries:    make(map[string]*cachedResponse),
+		ttls:       ttls,
+		maxEntries: maxEntries,
+	}
+}
+
+// ParseCacheTTLs parses "name=duration" pairs separated by commas, e.g. "categories=5m,rating=30s".
+func ParseCacheTTLs(s string) (map[string]time.Duration, error) {
+	ttls := make(map[string]time.Duration)
+	for _, pair := range strings.Split(s, ",") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("cache TTL %q: expected name=duration", pair)
+		}
+		ttl, err := time.ParseDuration(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("cache TTL for %q: %w", name, err)
+		}
+		ttls[strings.TrimSpace(name)] = ttl
+	}
+	return ttls, nil
+}
+
+// uncachedHeaders are never stored with an entry. Cache-Control, Vary, Age and X-Cache belong
+// to the caching layer itself, so each hit gets its own values. Content-Encoding and
+// Content-Length are set by Compress when it gzips the response, while the cache stores the
+// uncompressed body; on a hit Compress decides again for the current client.
+var uncachedHeaders = map[string]bool{
+	"Cache-Control":    true,
+	"Vary":             true,
+	"Age":              true,
+	"X-Cache":          true,
+	"Content-Encoding": true,
+	"Content-Length":   true,
+}
+
+// For returns middleware caching the route's responses for the TTL configured under name.
+// Only successful GET responses to requests without an Authorization header are cached;
+// a request with "Cache-Control: no-cache" skips the lookup but refreshes the entry.
+// The key covers the path, the query and a hash of the request body, since some GET routes
+// (listing search) take their filter as JSON. Only headers set by the handler itself are
+// stored, not ones added earlier in the chain (request ID, CORS).
+// Responses carry Cache-Control and X-Cache (HIT/MISS) headers.
+func (c *ResponseCache) For(name string) func(http.Handler) http.Handler {
+	var ttl time.Duration
+	if c != nil {
+		ttl = c.ttls[name]
+	}
+	return func(next http.Handler) http.Handler {
+		if ttl <= 0 {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet || r.Header.Get("Authorization") != "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			bodyHash, ok := hashRequestBody(r)
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+			key := r.Method + " " + r.URL.Path + "?" + r.URL.Query().Encode() + "#" + bodyHash
+			now := time.Now()
+			if !strings.Contains(r.Header.Get("Cache-Control"), "no-cache") {
+				if entry := c.get(key, now); entry != nil {
+					writeCached(w, entry, now)
+					return
+				}
+			}
+
+			preset := make(map[string]bool, len(w.Header()))
+			for k := range w.Header() {
+				preset[k] = true
+			}
+			rec := &cacheRecorder{ResponseWriter: w, ttl: ttl}
+			next.ServeHTTP(rec, r)
+
+			if rec.code == http.StatusOK && !rec.overflow {
+				header := make(http.Header)
+				for k, v := range w.Header() {
+					if !preset[k] && !uncachedHeaders[k] {
+						header[k] = append([]string(nil), v...)
+					}
+				}
+				c.set(key, &cachedResponse{
+					status:    http.StatusOK,
+					header:    header,
+					body:      rec.body.Bytes(),
+					path:      r.URL.Path,
+					storedAt:  now,
+					expiresAt: now.Add(ttl),
+				}, now)
+			}
+		})
+	}
+}
+
+// hashRequestBody returns a hex SHA-256 of the request body ("" for an empty body) and puts
+// the body back for the handler. Bodies larger than maxCachedBodyBytes are not cacheable.
+func hashRequestBody(r *http.Request) (string, bool) {
+	if r.Body == nil || r.Body == http.NoBody {
+		return "", true
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxCachedBodyBytes+1))
+	r.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(body), r.Body), r.Body}
+	if err != nil || len(body) > maxCachedBodyBytes {
+		return "", false
+	}
+	if len(body) == 0 {
+		return "", true
+	}
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:]), true
+}
+
+// addVary appends value to the Vary header unless it is already listed, keeping the
+// values set by other middleware (Origin, Accept-Encoding).
+func addVary(h http.Header, value string) {
+	for _, v := range h.Values("Vary") {
+		for _, item := range strings.Split(v, ",") {
+			if strings.EqualFold(strings.TrimSpace(item), value) {
+				return
+			}
+		}
+	}
+	h.Add("Vary", value)
+}
+
+// PurgeOnWrite drops cached responses whose path starts with one of prefixes after a
+// successful non-GET request, so a gateway's own writes are visible to its next read.
+func (c *ResponseCache) PurgeOnWrite(prefixes ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if c == nil {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet || r.Method == http.MethodHead {
+				next.ServeHTTP(w, r)
+				return
+			}
+			rec := &responseRecorder{ResponseWriter: w}
+			next.ServeHTTP(rec, r)
+			if rec.status == 0 || rec.status < http.StatusBadRequest {
+				c.purge(prefixes)
+			}
+		})
+	}
+}
+
+func (c *ResponseCache) get(key string, now time.Time) *cachedResponse {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil
+	}
+	if now.After(entry.expiresAt) {
+		delete(c.entries, key)
+		return nil
+	}
+	return entry
+}
+
+func (c *ResponseCache) set(key string, entry *cachedResponse, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, exists := c.entries[key]; !exists && len(c.entries) >= c.maxEntries {
+		for k, e := range c.entries {
+			if now.After(e.expiresAt) {
+				delete(c.entries, k)
+			}
+		}
+		if len(c.entries) >= c.maxEntries {
+			return
+		}
+	}
+	c.entries[key] = entry
+}
+
+func (c *ResponseCache) purge(prefixes []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for k, e := range c.entries {
+		for _, p := range prefixes {
+			if strings.HasPrefix(e.path, p) {
+				delete(c.entries, k)
+				break
+			}
+		}
+	}
+}
+
+func writeCached(w http.ResponseWriter, entry *cachedResponse, now time.Time) {
+	h := w.Header()
+	for k, v := range entry.header {
+		h[k] = append([]string(nil), v...)
+	}
+	remaining := int(entry.expiresAt.Sub(now).Seconds())
+	h.Set("Cache-Control", fmt.Sprintf("public, max-age=%d", remaining))
+	h.Set("Age", strconv.Itoa(int(now.Sub(entry.storedAt).Seconds())))
+	addVary(h, "Authorization")
+	h.Set("X-Cache", "HIT")
+	w.WriteHeader(entry.status)
+	w.Write(entry.body)
+}
+
+// cacheRecorder passes the response through while keeping a copy of the body. Cache
+// headers are added only to 200 responses; errors are neither cached nor marked cacheable.
+type cacheRecorder struct {
+	http.ResponseWriter
+	ttl      time.Duration
+	code     int
+	body     bytes.Buffer
+	overflow bool
+}
+
+func (rr *cacheRecorder) WriteHeader(status int) {
+	if rr.code == 0 {
+		rr.code = status
+		if status == http.StatusOK {
+			h := rr.Header()
+			h.Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(rr.ttl.Seconds())))
+			addVary(h, "Authorization")
+			h.Set("X-Cache", "MISS")
+		}
+	}
+	rr.ResponseWriter.WriteHeader(status)
+}
+
+func (rr *cacheRecorder) Write(b []byte) (int, error) {
+	if rr.code == 0 {
+		rr.WriteHeader(http.StatusOK)
+	}
+	if !rr.overflow {
+		if rr.body.Len()+len(b) > maxCachedBodyBytes {
+			rr.overflow = true
+			rr.body.Reset()
+		} else {
+			rr.body.Write(b)
+		}
+	}
+	return rr.ResponseWriter.Write(b)
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer (Flush, deadlines).
+func (rr *cacheRecorder) Unwrap() http.ResponseWriter {
+	return rr.ResponseWriter
+}
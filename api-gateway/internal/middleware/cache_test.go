@@ -0,0 +1,87 @@
+package middleware
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// echoBodyHandler answers with the request body, so each distinct filter gives a distinct response.
+func echoBodyHandler(calls *int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*calls++
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(body)
+	})
+}
+
+func TestResponseCache_KeysOnRequestBody(t *testing.T) {
+	cache := NewResponseCache(map[string]time.Duration{"listing_search": time.Minute}, 100)
+	calls := 0
+	h := cache.For("listing_search")(echoBodyHandler(&calls))
+
+	search := func(body string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/listings/search", strings.NewReader(body)))
+		return rec
+	}
+
+	if got := search(`{"query":"bike"}`).Body.String(); got != `{"query":"bike"}` {
+		t.Fatalf("first search body = %q", got)
+	}
+	if got := search(`{"query":"helmet"}`).Body.String(); got != `{"query":"helmet"}` {
+		t.Fatalf("different filter got %q, want its own result", got)
+	}
+	rec := search(`{"query":"bike"}`)
+	if rec.Header().Get("X-Cache") != "HIT" || rec.Body.String() != `{"query":"bike"}` {
+		t.Fatalf("repeated filter: X-Cache = %q, body = %q, want cached result", rec.Header().Get("X-Cache"), rec.Body.String())
+	}
+	if calls != 2 {
+		t.Fatalf("handler called %d times, want 2", calls)
+	}
+}
+
+func TestResponseCache_DoesNotReplayOuterHeaders(t *testing.T) {
+	cache := NewResponseCache(map[string]time.Duration{"categories": time.Minute}, 100)
+	calls := 0
+	cached := cache.For("categories")(echoBodyHandler(&calls))
+	// Внешние middleware ставят заголовки конкретного запроса до обработчика
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(RequestIDHeader, r.Header.Get(RequestIDHeader))
+		w.Header().Set("Access-Control-Allow-Origin", r.Header.Get("Origin"))
+		w.Header().Add("Vary", "Origin")
+		cached.ServeHTTP(w, r)
+	})
+
+	request := func(id, origin string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/categories", nil)
+		req.Header.Set(RequestIDHeader, id)
+		req.Header.Set("Origin", origin)
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec
+	}
+
+	request("first", "https://a.example")
+	rec := request("second", "https://b.example")
+
+	if rec.Header().Get("X-Cache") != "HIT" {
+		t.Fatalf("X-Cache = %q, want HIT", rec.Header().Get("X-Cache"))
+	}
+	if got := rec.Header().Get(RequestIDHeader); got != "second" {
+		t.Errorf("X-Request-ID = %q, want the current request's ID", got)
+	}
+	if got := rec.Header().Get("Access-Control-Allow-Origin"); got != "https://b.example" {
+		t.Errorf("Access-Control-Allow-Origin = %q, want the current origin", got)
+	}
+	if got := rec.Header().Values("Vary"); len(got) != 2 || got[0] != "Origin" || got[1] != "Authorization" {
+		t.Errorf("Vary = %q, want [Origin Authorization]", got)
+	}
+	if got := rec.Header().Get("Content-Type"); got != "application/json" {
+		t.Errorf("Content-Type = %q, want the handler's value", got)
+	}
+}
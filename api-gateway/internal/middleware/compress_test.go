@@ -0,0 +1,132 @@
+package middleware
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newCompressTestHandler(contentType string, body []byte) http.Handler {
+	return Compress(1024)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", contentType)
+		// Пишем частями, как json.Encoder при больших ответах
+		for len(body) > 0 {
+			n := min(len(body), 512)
+			_, _ = w.Write(body[:n])
+			body = body[n:]
+		}
+	}))
+}
+
+func TestCompress_LargeJSONIsGzipped(t *testing.T) {
+	body := []byte(`[` + strings.Repeat(`{"id":"listing","title":"Road bike","price":1200},`, 200) + `{}]`)
+	req := httptest.NewRequest(http.MethodGet, "/api/listings/search", nil)
+	req.Header.Set("Accept-Encoding", "br, gzip;q=0.8")
+	rec := httptest.NewRecorder()
+
+	newCompressTestHandler("application/json", body).ServeHTTP(rec, req)
+
+	if got := rec.Header().Get("Content-Encoding"); got != "gzip" {
+		t.Fatalf("Content-Encoding = %q, want gzip", got)
+	}
+	if got := rec.Header().Get("Vary"); got != "Accept-Encoding" {
+		t.Errorf("Vary = %q, want Accept-Encoding", got)
+	}
+	if rec.Body.Len() >= len(body) {
+		t.Errorf("compressed size %d is not smaller than original %d", rec.Body.Len(), len(body))
+	}
+
+	zr, err := gzip.NewReader(rec.Body)
+	if err != nil {
+		t.Fatalf("response is not valid gzip: %v", err)
+	}
+	decoded, err := io.ReadAll(zr)
+	if err != nil {
+		t.Fatalf("failed to read gzip body: %v", err)
+	}
+	if !bytes.Equal(decoded, body) {
+		t.Errorf("decompressed body differs from the original")
+	}
+}
+
+func TestCompress_SkipsSmallAndIncompressibleResponses(t *testing.T) {
+	tests := []struct {
+		name           string
+		acceptEncoding string
+		contentType    string
+		body           []byte
+	}{
+		{"below threshold", "gzip", "application/json", []byte(`{"ok":true}`)},
+		{"client without gzip", "identity", "application/json", bytes.Repeat([]byte("a"), 4096)},
+		{"gzip disabled by q=0", "gzip;q=0", "application/json", bytes.Repeat([]byte("a"), 4096)},
+		{"already compressed type", "gzip", "image/png", bytes.Repeat([]byte("a"), 4096)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/listings/1", nil)
+			req.Header.Set("Accept-Encoding", tt.acceptEncoding)
+			rec := httptest.NewRecorder()
+
+			newCompressTestHandler(tt.contentType, tt.body).ServeHTTP(rec, req)
+
+			if got := rec.Header().Get("Content-Encoding"); got != "" {
+				t.Errorf("Content-Encoding = %q, want none", got)
+			}
+			if !bytes.Equal(rec.Body.Bytes(), tt.body) {
+				t.Errorf("body was modified")
+			}
+		})
+	}
+}
+
+func TestCompress_WithResponseCache(t *testing.T) {
+	body := []byte(`[` + strings.Repeat(`{"id":"category","name":"Road bikes"},`, 100) + `{}]`)
+	cache := NewResponseCache(map[string]time.Duration{"categories": time.Minute}, 100)
+	h := Compress(1024)(cache.For("categories")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(body)
+	})))
+
+	request := func(acceptEncoding string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/categories", nil)
+		req.Header.Set("Accept-Encoding", acceptEncoding)
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec
+	}
+
+	if rec := request("gzip"); rec.Header().Get("Content-Encoding") != "gzip" || rec.Header().Get("X-Cache") != "MISS" {
+		t.Fatalf("first request: Content-Encoding = %q, X-Cache = %q, want gzip MISS",
+			rec.Header().Get("Content-Encoding"), rec.Header().Get("X-Cache"))
+	}
+
+	plain := request("identity")
+	if plain.Header().Get("X-Cache") != "HIT" {
+		t.Fatalf("X-Cache = %q, want HIT", plain.Header().Get("X-Cache"))
+	}
+	if got := plain.Header().Get("Content-Encoding"); got != "" {
+		t.Fatalf("cache hit for a client without gzip has Content-Encoding %q", got)
+	}
+	if !bytes.Equal(plain.Body.Bytes(), body) {
+		t.Fatalf("cache hit body differs from the original")
+	}
+
+	gzipped := request("gzip")
+	if gzipped.Header().Get("X-Cache") != "HIT" || gzipped.Header().Get("Content-Encoding") != "gzip" {
+		t.Fatalf("gzip cache hit: X-Cache = %q, Content-Encoding = %q",
+			gzipped.Header().Get("X-Cache"), gzipped.Header().Get("Content-Encoding"))
+	}
+	zr, err := gzip.NewReader(gzipped.Body)
+	if err != nil {
+		t.Fatalf("gzip cache hit is not valid gzip: %v", err)
+	}
+	decoded, _ := io.ReadAll(zr)
+	if !bytes.Equal(decoded, body) {
+		t.Fatalf("decompressed cache hit differs from the original")
+	}
+}
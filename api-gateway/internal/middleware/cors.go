@@ -0,0 +1,77 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// CORSOptions configures CORS. An origin of "*" allows any origin; it is only valid without
+// AllowCredentials, since browsers refuse credentialed responses for a wildcard origin.
+type CORSOptions struct {
+	AllowedOrigins   []string
+	AllowedMethods   []string
+	AllowedHeaders   []string
+	AllowCredentials bool
+	// MaxAge is how many seconds browsers may cache a preflight response; 0 omits the header.
+	MaxAge int
+}
+
+// CORS answers preflight (OPTIONS with Access-Control-Request-Method) requests itself and adds
+// the Access-Control-Allow-* headers to other requests from allowed origins. The allowed origin
+// is echoed back rather than "*", so responses vary by Origin. Preflights from other origins
+// get 403; other requests from them pass through without CORS headers and are blocked by the browser.
+func CORS(opts CORSOptions) func(http.Handler) http.Handler {
+	origins := make(map[string]bool, len(opts.AllowedOrigins))
+	anyOrigin := false
+	for _, o := range opts.AllowedOrigins {
+		if o = strings.TrimSpace(o); o == "*" {
+			anyOrigin = true
+		} else if o != "" {
+			origins[strings.ToLower(o)] = true
+		}
+	}
+	methods := strings.Join(opts.AllowedMethods, ", ")
+	headers := strings.Join(opts.AllowedHeaders, ", ")
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			origin := r.Header.Get("Origin")
+			if origin == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			allowed := anyOrigin || origins[strings.ToLower(origin)]
+			preflight := r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != ""
+
+			h := w.Header()
+			h.Add("Vary", "Origin")
+			if !allowed {
+				if preflight {
+					http.Error(w, "Origin not allowed", http.StatusForbidden)
+					return
+				}
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			h.Set("Access-Control-Allow-Origin", origin)
+			if opts.AllowCredentials {
+				h.Set("Access-Control-Allow-Credentials", "true")
+			}
+			if !preflight {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			h.Add("Vary", "Access-Control-Request-Method")
+			h.Add("Vary", "Access-Control-Request-Headers")
+			h.Set("Access-Control-Allow-Methods", methods)
+			h.Set("Access-Control-Allow-Headers", headers)
+			if opts.MaxAge > 0 {
+				h.Set("Access-Control-Max-Age", strconv.Itoa(opts.MaxAge))
+			}
+			w.WriteHeader(http.StatusNoContent)
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newCORSTestHandler() http.Handler {
+	opts := CORSOptions{
+		AllowedOrigins:   []string{"https://shop.example"},
+		AllowedMethods:   []string{"GET", "POST", "DELETE"},
+		AllowedHeaders:   []string{"Authorization", "Content-Type"},
+		AllowCredentials: true,
+		MaxAge:           600,
+	}
+	return CORS(opts)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+}
+
+func TestCORS_Preflight(t *testing.T) {
+	req := httptest.NewRequest(http.MethodOptions, "/api/listings/1", nil)
+	req.Header.Set("Origin", "https://shop.example")
+	req.Header.Set("Access-Control-Request-Method", "DELETE")
+	rec := httptest.NewRecorder()
+
+	newCORSTestHandler().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusNoContent)
+	}
+	want := map[string]string{
+		"Access-Control-Allow-Origin":      "https://shop.example",
+		"Access-Control-Allow-Credentials": "true",
+		"Access-Control-Allow-Methods":     "GET, POST, DELETE",
+		"Access-Control-Allow-Headers":     "Authorization, Content-Type",
+		"Access-Control-Max-Age":           "600",
+	}
+	for header, value := range want {
+		if got := rec.Header().Get(header); got != value {
+			t.Errorf("%s = %q, want %q", header, got, value)
+		}
+	}
+}
+
+func TestCORS_AllowedOriginSimpleRequest(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/api/categories", nil)
+	req.Header.Set("Origin", "https://shop.example")
+	rec := httptest.NewRecorder()
+
+	newCORSTestHandler().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if got := rec.Header().Get("Access-Control-Allow-Origin"); got != "https://shop.example" {
+		t.Errorf("Access-Control-Allow-Origin = %q, want the request origin", got)
+	}
+	if got := rec.Header().Get("Access-Control-Allow-Methods"); got != "" {
+		t.Errorf("Access-Control-Allow-Methods = %q on a non-preflight request", got)
+	}
+}
+
+func TestCORS_DisallowedOrigin(t *testing.T) {
+	preflight := httptest.NewRequest(http.MethodOptions, "/api/listings/1", nil)
+	preflight.Header.Set("Origin", "https://evil.example")
+	preflight.Header.Set("Access-Control-Request-Method", "DELETE")
+	rec := httptest.NewRecorder()
+
+	newCORSTestHandler().ServeHTTP(rec, preflight)
+
+	if rec.Code != http.StatusForbidden {
+		t.Fatalf("preflight status = %d, want %d", rec.Code, http.StatusForbidden)
+	}
+	if got := rec.Header().Get("Access-Control-Allow-Origin"); got != "" {
+		t.Errorf("Access-Control-Allow-Origin = %q for a disallowed origin", got)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/categories", nil)
+	req.Header.Set("Origin", "https://evil.example")
+	rec = httptest.NewRecorder()
+
+	newCORSTestHandler().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if got := rec.Header().Get("Access-Control-Allow-Origin"); got != "" {
+		t.Errorf("Access-Control-Allow-Origin = %q for a disallowed origin", got)
+	}
+}
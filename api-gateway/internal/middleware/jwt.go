@@ -2,14 +2,26 @@ package middleware
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"strings"
 
 	"github.com/golang-jwt/jwt/v5"
-	"go.uber.org/zap"
 )
 
-func JWTAuth(secret string) func(http.Handler) http.Handler {
+// ErrTokenRevoked is returned by a RevocationChecker for a token whose session has ended
+// (logout, password change) before the token expired.
+var ErrTokenRevoked = errors.New("token revoked")
+
+// RevocationChecker asks the issuer whether a validly signed access token is still usable.
+type RevocationChecker interface {
+	CheckToken(ctx context.Context, token string) error
+}
+
+// JWTAuth verifies the bearer token and puts its user ID into the request context. With a
+// non-nil revocation checker every token is also checked against its session, so logged-out
+// tokens stop working before they expire; a revoked token gets 401, a failed check 503.
+func JWTAuth(secret string, revocation RevocationChecker) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			authHeader := r.Header.Get("Authorization")
@@ -39,26 +51,32 @@ func JWTAuth(secret string) func(http.Handler) http.Handler {
 				return
 			}
 
+			// Only access tokens authorize requests, not refresh or 2FA pending tokens.
+			if tokenType, _ := claims["type"].(string); tokenType != "access" {
+				http.Error(w, "Token of type "+tokenType+" cannot be used for authorization", http.StatusUnauthorized)
+				return
+			}
+
 			userID, ok := claims["user_id"].(string)
 			if !ok {
 				http.Error(w, "Invalid user_id in token", http.StatusUnauthorized)
 				return
 			}
 
+			if revocation != nil {
+				if err := revocation.CheckToken(r.Context(), tokenStr); err != nil {
+					if errors.Is(err, ErrTokenRevoked) {
+						http.Error(w, "Token has been revoked", http.StatusUnauthorized)
+					} else {
+						http.Error(w, "Unable to verify token", http.StatusServiceUnavailable)
+					}
+					return
+				}
+			}
+
+			setAccessLogUserID(r.Context(), userID)
 			ctx := context.WithValue(r.Context(), "user_id", userID)
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
 }
-
-func Logger(logger *zap.Logger) func(http.Handler) http.Handler {
-	return func(next http.Handler) http.Handler {
-		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			logger.Info("Request received",
-				zap.String("method", r.Method),
-				zap.String("path", r.URL.Path),
-			)
-			next.ServeHTTP(w, r)
-		})
-	}
-}
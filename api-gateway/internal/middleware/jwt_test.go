@@ -0,0 +1,119 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const testJWTSecret = "test-secret"
+
+type fakeRevocationChecker struct {
+	err    error
+	tokens []string
+}
+
+func (f *fakeRevocationChecker) CheckToken(ctx context.Context, token string) error {
+	f.tokens = append(f.tokens, token)
+	return f.err
+}
+
+func signTestToken(t *testing.T, claims jwt.MapClaims) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(testJWTSecret))
+	if err != nil {
+		t.Fatalf("sign token: %v", err)
+	}
+	return token
+}
+
+func serveJWTAuth(t *testing.T, token string, revocation RevocationChecker) (*httptest.ResponseRecorder, string) {
+	t.Helper()
+	var userID string
+	h := JWTAuth(testJWTSecret, revocation)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ = r.Context().Value("user_id").(string)
+		w.WriteHeader(http.StatusOK)
+	}))
+	req := httptest.NewRequest(http.MethodGet, "/api/user/profile", nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec, userID
+}
+
+func TestJWTAuth_ValidToken(t *testing.T) {
+	token := signTestToken(t, jwt.MapClaims{"user_id": "u1", "type": "access", "exp": time.Now().Add(time.Minute).Unix()})
+	checker := &fakeRevocationChecker{}
+
+	rec, userID := serveJWTAuth(t, token, checker)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if userID != "u1" {
+		t.Errorf("user_id = %q, want %q", userID, "u1")
+	}
+	if len(checker.tokens) != 1 || checker.tokens[0] != token {
+		t.Errorf("checked tokens = %v, want the request token once", checker.tokens)
+	}
+}
+
+func TestJWTAuth_RevokedToken(t *testing.T) {
+	token := signTestToken(t, jwt.MapClaims{"user_id": "u1", "type": "access", "exp": time.Now().Add(time.Minute).Unix()})
+
+	rec, _ := serveJWTAuth(t, token, &fakeRevocationChecker{err: ErrTokenRevoked})
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+}
+
+func TestJWTAuth_RevocationCheckFails(t *testing.T) {
+	token := signTestToken(t, jwt.MapClaims{"user_id": "u1", "type": "access", "exp": time.Now().Add(time.Minute).Unix()})
+
+	rec, _ := serveJWTAuth(t, token, &fakeRevocationChecker{err: errors.New("user-service unavailable")})
+
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusServiceUnavailable)
+	}
+}
+
+func TestJWTAuth_RejectedBeforeRevocationCheck(t *testing.T) {
+	tests := map[string]string{
+		"missing":   "",
+		"bad sig":   signTestToken(t, jwt.MapClaims{"user_id": "u1", "type": "access"}) + "x",
+		"expired":   signTestToken(t, jwt.MapClaims{"user_id": "u1", "type": "access", "exp": time.Now().Add(-time.Minute).Unix()}),
+		"refresh":   signTestToken(t, jwt.MapClaims{"user_id": "u1", "type": "refresh"}),
+		"untyped":   signTestToken(t, jwt.MapClaims{"user_id": "u1", "exp": time.Now().Add(time.Minute).Unix()}),
+		"no userID": signTestToken(t, jwt.MapClaims{"type": "access", "exp": time.Now().Add(time.Minute).Unix()}),
+	}
+	for name, token := range tests {
+		t.Run(name, func(t *testing.T) {
+			checker := &fakeRevocationChecker{}
+			rec, _ := serveJWTAuth(t, token, checker)
+			if rec.Code != http.StatusUnauthorized {
+				t.Fatalf("status = %d, want %d", rec.Code, http.StatusUnauthorized)
+			}
+			if len(checker.tokens) != 0 {
+				t.Errorf("revocation checked for a rejected token")
+			}
+		})
+	}
+}
+
+func TestJWTAuth_NilCheckerSkipsRevocation(t *testing.T) {
+	token := signTestToken(t, jwt.MapClaims{"user_id": "u1", "type": "access", "exp": time.Now().Add(time.Minute).Unix()})
+
+	rec, _ := serveJWTAuth(t, token, nil)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+}
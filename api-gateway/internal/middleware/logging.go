@@ -0,0 +1,105 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// accessLogCtxKey holds the *accessLogEntry of the current request so that
+// middleware further down the chain (JWTAuth) can attach the user ID to it.
+const accessLogCtxKey = ContextKey("access_log_entry")
+
+type accessLogEntry struct {
+	userID string
+}
+
+// setAccessLogUserID records the authenticated user on the request's access log line.
+func setAccessLogUserID(ctx context.Context, userID string) {
+	if entry, ok := ctx.Value(accessLogCtxKey).(*accessLogEntry); ok {
+		entry.userID = userID
+	}
+}
+
+// responseRecorder captures the status code and body size written by the handler.
+type responseRecorder struct {
+	http.ResponseWriter
+	status int
+	bytes  int
+}
+
+func (rr *responseRecorder) WriteHeader(status int) {
+	if rr.status == 0 {
+		rr.status = status
+	}
+	rr.ResponseWriter.WriteHeader(status)
+}
+
+func (rr *responseRecorder) Write(b []byte) (int, error) {
+	if rr.status == 0 {
+		rr.status = http.StatusOK
+	}
+	n, err := rr.ResponseWriter.Write(b)
+	rr.bytes += n
+	return n, err
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer (Flush, deadlines).
+func (rr *responseRecorder) Unwrap() http.ResponseWriter {
+	return rr.ResponseWriter
+}
+
+// Logger writes one structured access log line per request with its method, path, status,
+// duration, response size, client IP, request ID and, for authenticated routes, the user ID.
+// Requests to skipPaths (e.g. health probes) are not logged.
+func Logger(logger *zap.Logger, skipPaths ...string) func(http.Handler) http.Handler {
+	skip := make(map[string]bool, len(skipPaths))
+	for _, p := range skipPaths {
+		if p = strings.TrimSpace(p); p != "" {
+			skip[p] = true
+		}
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if skip[r.URL.Path] {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			start := time.Now()
+			entry := &accessLogEntry{}
+			rec := &responseRecorder{ResponseWriter: w}
+			next.ServeHTTP(rec, r.WithContext(context.WithValue(r.Context(), accessLogCtxKey, entry)))
+
+			status := rec.status
+			if status == 0 {
+				status = http.StatusOK
+			}
+			fields := []zap.Field{
+				zap.String("method", r.Method),
+				zap.String("path", r.URL.Path),
+				zap.Int("status", status),
+				zap.Duration("duration", time.Since(start)),
+				zap.Int("bytes", rec.bytes),
+				zap.String("client_ip", ClientIP(r)),
+				zap.String("request_id", RequestIDFromContext(r.Context())),
+			}
+			if entry.userID != "" {
+				fields = append(fields, zap.String("user_id", entry.userID))
+			}
+
+			switch {
+			case status >= http.StatusInternalServerError:
+				logger.Error("Request completed", fields...)
+			case status >= http.StatusBadRequest:
+				logger.Warn("Request completed", fields...)
+			default:
+				logger.Info("Request completed", fields...)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package middleware
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Limiter decides whether another request from the client identified by key may proceed.
+// When it refuses, retryAfter is how long the client should wait. Implementations must be
+// safe for concurrent use; TokenBucketLimiter keeps its state in memory, a shared store
+// (e.g. Redis) can be plugged in to limit across gateway instances.
+type Limiter interface {
+	Allow(key string) (allowed bool, retryAfter time.Duration)
+}
+
+// idleBucketSweepInterval is how often TokenBucketLimiter drops buckets of clients that
+// have been quiet long enough to be full again.
+const idleBucketSweepInterval = time.Minute
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// TokenBucketLimiter gives every key a bucket of burst tokens refilled at rps per second;
+// each request takes one token.
+type TokenBucketLimiter struct {
+	mu        sync.Mutex
+	buckets   map[string]*tokenBucket
+	rps       float64
+	burst     float64
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+func NewTokenBucketLimiter(rps float64, burst int) *TokenBucketLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &TokenBucketLimiter{
+		buckets:   make(map[string]*tokenBucket),
+		rps:       rps,
+		burst:     float64(burst),
+		lastSweep: time.Now(),
+		now:       time.Now,
+	}
+}
+
+func (l *TokenBucketLimiter) Allow(key string) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &tokenBucket{tokens: l.burst, last: now}
+		l.buckets[key] = b
+	}
+	b.tokens = math.Min(l.burst, b.tokens+now.Sub(b.last).Seconds()*l.rps)
+	b.last = now
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0
+	}
+	wait := time.Duration((1 - b.tokens) / l.rps * float64(time.Second))
+	return false, wait
+}
+
+func (l *TokenBucketLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < idleBucketSweepInterval {
+		return
+	}
+	l.lastSweep = now
+	refill := time.Duration(l.burst / l.rps * float64(time.Second))
+	for key, b := range l.buckets {
+		if now.Sub(b.last) >= refill {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// RateLimit rejects requests over the limiter's budget with 429 Too Many Requests and a
+// Retry-After header. Clients are keyed by ClientIP, so forwarding headers only count when
+// RealIP trusts the peer; with perUser set, requests that passed JWTAuth
+// are keyed by user ID instead, so it must be installed after JWTAuth on protected routes.
+// A nil limiter disables throttling.
+func RateLimit(limiter Limiter, perUser bool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if limiter == nil {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := "ip:" + ClientIP(r)
+			if userID, ok := r.Context().Value("user_id").(string); perUser && ok && userID != "" {
+				key = "user:" + userID
+			}
+
+			allowed, retryAfter := limiter.Allow(key)
+			if !allowed {
+				seconds := int(math.Ceil(retryAfter.Seconds()))
+				if seconds < 1 {
+					seconds = 1
+				}
+				w.Header().Set("Retry-After", strconv.Itoa(seconds))
+				http.Error(w, "Too many requests", http.StatusTooManyRequests)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
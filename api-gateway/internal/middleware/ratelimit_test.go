@@ -0,0 +1,102 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newTestLimiter(rps float64, burst int) (*TokenBucketLimiter, *time.Time) {
+	now := time.Unix(1700000000, 0)
+	l := NewTokenBucketLimiter(rps, burst)
+	l.now = func() time.Time { return now }
+	l.lastSweep = now
+	return l, &now
+}
+
+func serveRateLimited(h http.Handler, remoteAddr, forwardedFor, userID string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/api/user/login", nil)
+	req.RemoteAddr = remoteAddr
+	if forwardedFor != "" {
+		req.Header.Set("X-Forwarded-For", forwardedFor)
+	}
+	if userID != "" {
+		req = req.WithContext(context.WithValue(req.Context(), "user_id", userID))
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func rateLimitedHandler(limiter Limiter, perUser bool) http.Handler {
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusOK) })
+	return RealIP(nil)(RateLimit(limiter, perUser)(ok))
+}
+
+func TestRateLimit_RejectsOverBurstWithRetryAfter(t *testing.T) {
+	limiter, _ := newTestLimiter(0.5, 2)
+	h := rateLimitedHandler(limiter, false)
+
+	for i := 0; i < 2; i++ {
+		if rec := serveRateLimited(h, "203.0.113.7:1234", "", ""); rec.Code != http.StatusOK {
+			t.Fatalf("request %d: status = %d, want %d", i+1, rec.Code, http.StatusOK)
+		}
+	}
+	rec := serveRateLimited(h, "203.0.113.7:1234", "", "")
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusTooManyRequests)
+	}
+	if got := rec.Header().Get("Retry-After"); got != "2" {
+		t.Errorf("Retry-After = %q, want %q", got, "2")
+	}
+}
+
+func TestRateLimit_Refill(t *testing.T) {
+	limiter, now := newTestLimiter(1, 1)
+	h := rateLimitedHandler(limiter, false)
+
+	serveRateLimited(h, "203.0.113.7:1234", "", "")
+	if rec := serveRateLimited(h, "203.0.113.7:1234", "", ""); rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusTooManyRequests)
+	}
+	*now = now.Add(time.Second)
+	if rec := serveRateLimited(h, "203.0.113.7:1234", "", ""); rec.Code != http.StatusOK {
+		t.Fatalf("after refill: status = %d, want %d", rec.Code, http.StatusOK)
+	}
+}
+
+func TestRateLimit_SpoofedForwardedForSharesBucket(t *testing.T) {
+	limiter, _ := newTestLimiter(1, 1)
+	h := rateLimitedHandler(limiter, false)
+
+	serveRateLimited(h, "203.0.113.7:1234", "198.51.100.1", "")
+	if rec := serveRateLimited(h, "203.0.113.7:1234", "198.51.100.2", ""); rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("status = %d, want %d: X-Forwarded-For from an untrusted peer must not pick the bucket", rec.Code, http.StatusTooManyRequests)
+	}
+}
+
+func TestRateLimit_PerUserKeying(t *testing.T) {
+	limiter, _ := newTestLimiter(1, 1)
+	h := rateLimitedHandler(limiter, true)
+
+	if rec := serveRateLimited(h, "203.0.113.7:1234", "", "u1"); rec.Code != http.StatusOK {
+		t.Fatalf("u1: status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if rec := serveRateLimited(h, "203.0.113.7:1234", "", "u2"); rec.Code != http.StatusOK {
+		t.Fatalf("u2 behind the same IP: status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if rec := serveRateLimited(h, "198.51.100.9:1234", "", "u1"); rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("u1 from another IP: status = %d, want %d", rec.Code, http.StatusTooManyRequests)
+	}
+}
+
+func TestRateLimit_NilLimiter(t *testing.T) {
+	h := rateLimitedHandler(nil, false)
+	for i := 0; i < 5; i++ {
+		if rec := serveRateLimited(h, "203.0.113.7:1234", "", ""); rec.Code != http.StatusOK {
+			t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+// clientIPCtxKey holds the client address resolved by RealIP.
+const clientIPCtxKey = ContextKey("client_ip")
+
+// ParseTrustedProxies parses proxy addresses given as IPs or CIDRs, e.g. "10.0.0.0/8,192.168.1.5".
+func ParseTrustedProxies(items []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(items))
+	for _, item := range items {
+		if strings.Contains(item, "/") {
+			prefix, err := netip.ParsePrefix(item)
+			if err != nil {
+				return nil, fmt.Errorf("trusted proxy %q: %w", item, err)
+			}
+			prefixes = append(prefixes, prefix.Masked())
+			continue
+		}
+		addr, err := netip.ParseAddr(item)
+		if err != nil {
+			return nil, fmt.Errorf("trusted proxy %q: %w", item, err)
+		}
+		addr = addr.Unmap()
+		prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+	}
+	return prefixes, nil
+}
+
+// RealIP resolves the client address for ClientIP. Forwarding headers are client-controlled,
+// so they are only read when the peer is one of the trusted proxies: X-Forwarded-For is then
+// walked from the right, skipping trusted hops, and the first other address is the client.
+// Entries a client puts into the header itself sit to the left of it and are never used.
+// X-Real-IP is honoured only from a trusted peer that sent no X-Forwarded-For.
+func RealIP(trusted []netip.Prefix) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ip := resolveClientIP(r, trusted)
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), clientIPCtxKey, ip)))
+		})
+	}
+}
+
+// ClientIP returns the client address resolved by RealIP, or the peer address if RealIP
+// is not installed.
+func ClientIP(r *http.Request) string {
+	if ip, ok := r.Context().Value(clientIPCtxKey).(string); ok {
+		return ip
+	}
+	return peerHost(r)
+}
+
+func resolveClientIP(r *http.Request, trusted []netip.Prefix) string {
+	peer := peerHost(r)
+	if !isTrustedProxy(peer, trusted) {
+		return peer
+	}
+
+	if values := r.Header.Values("X-Forwarded-For"); len(values) > 0 {
+		hops := strings.Split(strings.Join(values, ","), ",")
+		client := peer
+		for i := len(hops) - 1; i >= 0; i-- {
+			addr, err := netip.ParseAddr(strings.TrimSpace(hops[i]))
+			if err != nil {
+				// Not written by a proxy; stop at the last address a trusted hop vouched for
+				break
+			}
+			client = addr.Unmap().String()
+			if !isTrustedProxy(client, trusted) {
+				break
+			}
+		}
+		return client
+	}
+	if addr, err := netip.ParseAddr(strings.TrimSpace(r.Header.Get("X-Real-IP"))); err == nil {
+		return addr.Unmap().String()
+	}
+	return peer
+}
+
+func peerHost(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+func isTrustedProxy(ip string, trusted []netip.Prefix) bool {
+	if len(trusted) == 0 {
+		return false
+	}
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, prefix := range trusted {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,58 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRealIP(t *testing.T) {
+	trusted, err := ParseTrustedProxies([]string{"10.0.0.0/8", "192.168.1.5"})
+	if err != nil {
+		t.Fatalf("ParseTrustedProxies: %v", err)
+	}
+	tests := []struct {
+		name       string
+		remoteAddr string
+		forwarded  string
+		realIP     string
+		want       string
+	}{
+		{"untrusted peer ignores headers", "203.0.113.7:1234", "198.51.100.1", "198.51.100.2", "203.0.113.7"},
+		{"trusted peer", "10.1.2.3:80", "198.51.100.1", "", "198.51.100.1"},
+		{"client-prepended hop is skipped", "10.1.2.3:80", "1.2.3.4, 198.51.100.1", "", "198.51.100.1"},
+		{"chain of trusted proxies", "192.168.1.5:80", "198.51.100.1, 10.0.0.9", "", "198.51.100.1"},
+		{"all hops trusted", "10.1.2.3:80", "10.0.0.9", "", "10.0.0.9"},
+		{"garbage hop", "10.1.2.3:80", "not-an-ip", "", "10.1.2.3"},
+		{"x-real-ip from trusted peer", "10.1.2.3:80", "", "198.51.100.3", "198.51.100.3"},
+		{"no headers", "10.1.2.3:80", "", "", "10.1.2.3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.RemoteAddr = tt.remoteAddr
+			if tt.forwarded != "" {
+				req.Header.Set("X-Forwarded-For", tt.forwarded)
+			}
+			if tt.realIP != "" {
+				req.Header.Set("X-Real-IP", tt.realIP)
+			}
+			var got string
+			RealIP(trusted)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = ClientIP(r)
+			})).ServeHTTP(httptest.NewRecorder(), req)
+			if got != tt.want {
+				t.Errorf("ClientIP = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseTrustedProxies_Invalid(t *testing.T) {
+	if _, err := ParseTrustedProxies([]string{"10.0.0.0/40"}); err == nil {
+		t.Error("expected an error for a bad CIDR")
+	}
+	if _, err := ParseTrustedProxies([]string{"proxy.local"}); err == nil {
+		t.Error("expected an error for a host name")
+	}
+}
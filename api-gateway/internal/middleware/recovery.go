@@ -0,0 +1,54 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+// Recoverer turns a panic in a handler into a 500 response instead of killing the
+// connection, and logs the panic value and stack trace with the request ID and user ID.
+// It must be installed after Logger so that the access log records the 500.
+func Recoverer(logger *zap.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			rec := &responseRecorder{ResponseWriter: w}
+			defer func() {
+				p := recover()
+				if p == nil {
+					return
+				}
+				if p == http.ErrAbortHandler {
+					// Намеренный обрыв соединения — пусть net/http обработает его сам
+					panic(p)
+				}
+
+				route := r.URL.Path
+				if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePattern() != "" {
+					route = rctx.RoutePattern()
+				}
+				fields := []zap.Field{
+					zap.String("method", r.Method),
+					zap.String("path", r.URL.Path),
+					zap.String("route", route),
+					zap.String("panic", fmt.Sprint(p)),
+					zap.ByteString("stack", debug.Stack()),
+					zap.String("request_id", RequestIDFromContext(r.Context())),
+				}
+				if entry, ok := r.Context().Value(accessLogCtxKey).(*accessLogEntry); ok && entry.userID != "" {
+					fields = append(fields, zap.String("user_id", entry.userID))
+				}
+				logger.Error("Recovered from panic in HTTP handler", fields...)
+
+				// Если заголовки уже отправлены, статус изменить нельзя
+				if rec.status == 0 {
+					http.Error(w, "Internal server error", http.StatusInternalServerError)
+				}
+			}()
+			next.ServeHTTP(rec, r)
+		})
+	}
+}
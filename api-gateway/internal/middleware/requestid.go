@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RequestIDHeader is the HTTP header carrying the correlation ID; RequestIDMetadataKey is
+// the gRPC metadata key it is forwarded under.
+const (
+	RequestIDHeader      = "X-Request-ID"
+	RequestIDMetadataKey = "x-request-id"
+)
+
+const (
+	RequestIDCtxKey = ContextKey("request_id")
+
+	maxRequestIDLength = 128
+)
+
+// RequestID takes the client's X-Request-ID or generates a new one, stores it in the request
+// context, echoes it in the response header and records it on the active OpenTelemetry span
+// (a no-op when tracing is disabled). It must be installed before Logger and Recoverer.
+func RequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(RequestIDHeader)
+		if !validRequestID(id) {
+			id = newRequestID()
+		}
+		r.Header.Set(RequestIDHeader, id)
+		w.Header().Set(RequestIDHeader, id)
+
+		ctx := r.Context()
+		trace.SpanFromContext(ctx).SetAttributes(attribute.String("http.request_id", id))
+		next.ServeHTTP(w, r.WithContext(context.WithValue(ctx, RequestIDCtxKey, id)))
+	})
+}
+
+// RequestIDFromContext returns the request ID stored by RequestID, or "" if there is none.
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(RequestIDCtxKey).(string)
+	return id
+}
+
+// validRequestID accepts only short printable ASCII IDs so that client input
+// can't inject anything into logs or downstream metadata.
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] < 0x21 || id[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+func newRequestID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// ParseTimeoutOverrides parses "pattern=duration" pairs separated by commas, e.g.
+// "/api/user/avatar=2m,POST /api/listings/{id}/photos=2m". A pattern may be prefixed
+// with an HTTP method to apply only to that method.
+func ParseTimeoutOverrides(s string) (map[string]time.Duration, error) {
+	overrides := make(map[string]time.Duration)
+	for _, pair := range strings.Split(s, ",") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+		route, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("timeout override %q: expected pattern=duration", pair)
+		}
+		timeout, err := time.ParseDuration(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("timeout override for %q: %w", route, err)
+		}
+		overrides[strings.TrimSpace(route)] = timeout
+	}
+	return overrides, nil
+}
+
+// Timeout gives every request context a deadline so that gRPC calls made with it are cancelled
+// when a backend hangs. The deadline is defaultTimeout unless the chi route pattern the request
+// resolves to in routes (optionally prefixed with the method) has an override; 0 disables it.
+// If the deadline passes before the handler writes a response, the client gets 504.
+// Handlers run in the request goroutine, so they must respect ctx for the deadline to take effect.
+func Timeout(routes chi.Routes, defaultTimeout time.Duration, overrides map[string]time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			timeout := defaultTimeout
+			if len(overrides) > 0 {
+				pattern := routes.Find(chi.NewRouteContext(), r.Method, r.URL.Path)
+				if d, ok := overrides[r.Method+" "+pattern]; ok {
+					timeout = d
+				} else if d, ok := overrides[pattern]; ok {
+					timeout = d
+				}
+			}
+			if timeout <= 0 {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			ctx, cancel := context.WithTimeout(r.Context(), timeout)
+			defer cancel()
+
+			rec := &responseRecorder{ResponseWriter: w}
+			next.ServeHTTP(rec, r.WithContext(ctx))
+			if rec.status == 0 && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				http.Error(w, "Request timed out", http.StatusGatewayTimeout)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func TestTimeout_CancelsDownstreamContext(t *testing.T) {
+	r := chi.NewRouter()
+	r.Use(Timeout(r, 20*time.Millisecond, map[string]time.Duration{
+		"POST /api/listings/{id}/photos": time.Second,
+	}))
+
+	var observed error
+	slow := func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+			observed = r.Context().Err()
+		case <-time.After(100 * time.Millisecond):
+			w.WriteHeader(http.StatusOK)
+		}
+	}
+	r.Get("/api/listings/{id}", slow)
+	r.Post("/api/listings/{id}/photos", slow)
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/listings/42", nil))
+	if !errors.Is(observed, context.DeadlineExceeded) {
+		t.Fatalf("handler observed ctx.Err() = %v, want DeadlineExceeded", observed)
+	}
+	if rec.Code != http.StatusGatewayTimeout {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusGatewayTimeout)
+	}
+
+	// Для загрузки фото действует увеличенный таймаут
+	observed = nil
+	rec = httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/listings/42/photos", nil))
+	if observed != nil || rec.Code != http.StatusOK {
+		t.Fatalf("override route: status = %d, ctx.Err() = %v, want 200 without cancellation", rec.Code, observed)
+	}
+}
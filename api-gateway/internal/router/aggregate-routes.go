@@ -0,0 +1,13 @@
+package router
+
+import (
+	"net/http"
+
+	"github.com/Abdurahmanit/GroupProject/api-gateway/internal/handler"
+	"github.com/go-chi/chi/v5"
+)
+
+// SetupAggregateRoutes configures routes that combine responses from several services.
+func SetupAggregateRoutes(mux *chi.Mux, h *handler.AggregateHandler, rateLimit func(http.Handler) http.Handler) {
+	mux.With(rateLimit).Get("/api/products/{productId}", h.HandleGetProduct)
+}
@@ -0,0 +1,12 @@
+package router
+
+import (
+	"github.com/Abdurahmanit/GroupProject/api-gateway/internal/handler"
+	"github.com/go-chi/chi/v5"
+)
+
+// SetupDocsRoutes serves the OpenAPI spec and a Swagger UI page for it.
+func SetupDocsRoutes(mux *chi.Mux) {
+	mux.Get("/openapi.json", handler.HandleOpenAPISpec)
+	mux.Get("/docs", handler.HandleDocs)
+}
@@ -1,40 +1,71 @@
 package router
 
 import (
-	// "net/http" // Не нужен для методов chi
+	"net/http"
+
 	"github.com/Abdurahmanit/GroupProject/api-gateway/internal/handler"
 	"github.com/Abdurahmanit/GroupProject/api-gateway/internal/middleware"
 	"github.com/go-chi/chi/v5" // Импортируем chi
 )
 
-func SetupListingRoutes(mux *chi.Mux, h *handler.ListingHandler, jwtSecret string) {
+func SetupListingRoutes(mux *chi.Mux, h *handler.ListingHandler, auth func(http.Handler) http.Handler, cache *middleware.ResponseCache, rateLimit func(http.Handler) http.Handler) {
 	// Группа маршрутов для ИЗБРАННОГО, требующих аутентификации
 	mux.Group(func(r chi.Router) {
-		r.Use(middleware.JWTAuth(jwtSecret)) // Применяем JWTAuth middleware
+		r.Use(auth)      // Применяем JWTAuth middleware
+		r.Use(rateLimit) // После JWTAuth, чтобы лимит считался по пользователю
 
 		r.Post("/api/favorites", h.HandleAddFavorite)
 		r.Delete("/api/favorites", h.HandleRemoveFavorite) // Убедись, что есть способ указать ID, например, в теле запроса
-		r.Get("/api/favorites", h.HandleGetFavorites)
+		r.Get("/api/favorites", h.HandleGetFavorites)      // ?include_listings=true&page=N&limit=M
+		r.Get("/api/recently-viewed", h.HandleGetRecentlyViewed) // ?limit=N
+
+		r.Get("/api/offers", h.HandleListOffers)              // ?listing_id= для предложений по объявлению
+		r.Post("/api/offers/{offerID}/respond", h.HandleRespondToOffer) // Покупатель или продавец, чей ход
+
+		// Управление категориями - только admin (роль проверяет listing-service)
+		categoryWrites := r.With(cache.PurgeOnWrite("/api/categories"))
+		categoryWrites.Post("/api/categories", h.HandleCreateCategory)
+		categoryWrites.Put("/api/categories/{id}", h.HandleUpdateCategory)
+		categoryWrites.Delete("/api/categories/{id}", h.HandleDeleteCategory)
 	})
 
+	// Категории доступны для чтения без авторизации и кэшируются, если для них задан TTL
+	mux.With(rateLimit, cache.For("categories")).Get("/api/categories", h.HandleListCategories)
+	mux.With(rateLimit, cache.For("categories")).Get("/api/categories/{id}", h.HandleGetCategory)
+
 	// Группа маршрутов для ОБЪЯВЛЕНИЙ ("/api/listings")
 	mux.Route("/api/listings", func(r chi.Router) {
 		// Публичные маршруты для объявлений (не требуют авторизации)
-		r.Get("/{id}", h.HandleGetListingByID)           // GET /api/listings/{id}
-		r.Get("/search", h.HandleSearchListings)        // GET /api/listings/search
-		r.Get("/{id}/photos", h.HandleGetPhotoURLs)     // GET /api/listings/{id}/photos
-		r.Get("/{id}/status", h.HandleGetListingStatus) // GET /api/listings/{id}/status
+		r.Group(func(publicR chi.Router) {
+			publicR.Use(rateLimit)
+
+			publicR.Get("/{id}", h.HandleGetListingByID)                                     // GET /api/listings/{id}
+			publicR.With(cache.For("listing_search")).Get("/search", h.HandleSearchListings) // GET /api/listings/search
+			publicR.Get("/status", h.HandleGetListingsStatus)                                // GET /api/listings/status?ids=a,b,c
+			publicR.Get("/{id}/photos", h.HandleGetPhotoURLs)                                // GET /api/listings/{id}/photos
+			publicR.Get("/{id}/status", h.HandleGetListingStatus)                            // GET /api/listings/{id}/status
+		})
 
 		// Маршруты для объявлений, ТРЕБУЮЩИЕ аутентификации
 		r.Group(func(authR chi.Router) {
-			authR.Use(middleware.JWTAuth(jwtSecret)) // Применяем JWTAuth middleware
+			authR.Use(auth) // Применяем JWTAuth middleware
+			authR.Use(rateLimit)
+			authR.Use(cache.PurgeOnWrite("/api/listings/search")) // Изменения объявлений сразу видны в поиске
 
 			// Обрати внимание, что пути здесь относительны к "/api/listings"
 			authR.Post("/", h.HandleCreateListing)                  // POST /api/listings
 			authR.Put("/{id}", h.HandleUpdateListing)               // PUT /api/listings/{id}
 			authR.Delete("/{id}", h.HandleDeleteListing)           // DELETE /api/listings/{id}
 			authR.Post("/{id}/photos", h.HandleUploadPhoto)         // POST /api/listings/{id}/photos
+			authR.Delete("/{id}/photos", h.HandleDeletePhoto)       // DELETE /api/listings/{id}/photos {"photo_url": "..."}
+			authR.Put("/{id}/photos/order", h.HandleReorderPhotos)  // PUT /api/listings/{id}/photos/order {"photo_urls": [...]}
+			authR.Post("/{id}/photos/upload-url", h.HandleGeneratePhotoUploadURL) // POST {"file_name": "..."} -> presigned PUT URL
+			authR.Post("/{id}/photos/confirm", h.HandleConfirmPhotoUpload)        // POST {"photo_url": "..."} после загрузки
 			authR.Patch("/{id}/status", h.HandleUpdateListingStatus) // PATCH /api/listings/{id}/status
+			authR.Post("/{id}/offers", h.HandleMakeOffer)            // POST /api/listings/{id}/offers
+			authR.Post("/{id}/unavailable", h.HandleMarkUnavailable) // POST /api/listings/{id}/unavailable
+			authR.Post("/{id}/duplicate", h.HandleDuplicateListing)  // POST /api/listings/{id}/duplicate
+			authR.Post("/{id}/publish", h.HandlePublishListing)      // POST /api/listings/{id}/publish (draft -> active)
 		})
 	})
 }
\ No newline at end of file
@@ -1,21 +1,30 @@
 package router
 
 import (
+	"net/http"
+
 	"github.com/Abdurahmanit/GroupProject/api-gateway/internal/handler"
 	"github.com/Abdurahmanit/GroupProject/api-gateway/internal/middleware"
 	"github.com/go-chi/chi/v5"
 )
 
 // SetupReviewRoutes configures routes for the Review service.
-func SetupReviewRoutes(mux *chi.Mux, h *handler.ReviewHandler, jwtSecret string) {
+// Anonymous product review lists and ratings are cached when TTLs are configured for them.
+func SetupReviewRoutes(mux *chi.Mux, h *handler.ReviewHandler, auth func(http.Handler) http.Handler, cache *middleware.ResponseCache, rateLimit func(http.Handler) http.Handler) {
 	// Public routes for reviews (mostly read operations)
-	mux.Get("/api/reviews/{reviewId}", h.HandleGetReview)
-	mux.Get("/api/products/{productId}/reviews", h.HandleListReviewsByProduct) // Example: list reviews for a product
-	mux.Get("/api/products/{productId}/reviews/rating", h.HandleGetProductAverageRating)
+	mux.Group(func(r chi.Router) {
+		r.Use(rateLimit)
+
+		r.Get("/api/reviews/{reviewId}", h.HandleGetReview)
+		r.With(cache.For("product_reviews")).Get("/api/products/{productId}/reviews", h.HandleListReviewsByProduct) // Example: list reviews for a product
+		r.With(cache.For("product_rating")).Get("/api/products/{productId}/reviews/rating", h.HandleGetProductAverageRating)
+	})
 
 	// Protected routes for reviews (require JWT authentication)
 	mux.Group(func(r chi.Router) {
-		r.Use(middleware.JWTAuth(jwtSecret)) // Apply JWT authentication
+		r.Use(auth) // Apply JWT authentication
+		r.Use(rateLimit)
+		r.Use(cache.PurgeOnWrite("/api/products/"))
 
 		r.Post("/api/reviews", h.HandleCreateReview)
 		r.Put("/api/reviews/{reviewId}", h.HandleUpdateReview)
@@ -1,23 +1,34 @@
 package router
 
 import (
+	"net/http"
+
 	"github.com/Abdurahmanit/GroupProject/api-gateway/internal/handler"
-	"github.com/Abdurahmanit/GroupProject/api-gateway/internal/middleware"
 	"github.com/go-chi/chi/v5"
 )
 
-func SetupUserRoutes(r *chi.Mux, userHandler *handler.UserHandler, jwtSecret string) {
+func SetupUserRoutes(r *chi.Mux, userHandler *handler.UserHandler, auth func(http.Handler) http.Handler, rateLimit func(http.Handler) http.Handler) {
 	// Public user routes
-	r.Post("/api/user/register", userHandler.Register)
-	r.Post("/api/user/login", userHandler.Login)
+	r.Group(func(public chi.Router) {
+		public.Use(rateLimit)
+
+		public.Post("/api/user/register", userHandler.Register)
+		public.Post("/api/user/login", userHandler.Login)
+		public.Post("/api/user/refresh", userHandler.RefreshToken)
+		public.Post("/api/user/password/forgot", userHandler.RequestPasswordReset)
+		public.Post("/api/user/password/reset", userHandler.ResetPassword)
+		public.Post("/api/user/2fa/verify", userHandler.VerifyTwoFactor)
+	})
 
 	// Protected user routes (require JWT authentication)
 	r.Group(func(authRouter chi.Router) {
-		authRouter.Use(middleware.JWTAuth(jwtSecret))
+		authRouter.Use(auth)
+		authRouter.Use(rateLimit)
 
 		authRouter.Post("/api/user/logout", userHandler.Logout)
 		authRouter.Get("/api/user/profile", userHandler.GetProfile)
 		authRouter.Put("/api/user/profile", userHandler.UpdateProfile)
+		authRouter.Post("/api/user/avatar", userHandler.UploadAvatar)
 		authRouter.Post("/api/user/change-password", userHandler.ChangePassword)
 
 		authRouter.Delete("/api/user/delete", userHandler.DeleteUser)
@@ -28,8 +39,15 @@ func SetupUserRoutes(r *chi.Mux, userHandler *handler.UserHandler, jwtSecret str
 		authRouter.Post("/api/user/email/verify", userHandler.VerifyEmail)
 		authRouter.Get("/api/user/email/status", userHandler.CheckEmailVerificationStatus)
 
+		// Two-Factor Authentication Routes
+		authRouter.Post("/api/user/2fa/enable", userHandler.EnableTwoFactor)
+		authRouter.Post("/api/user/2fa/confirm", userHandler.ConfirmTwoFactor)
+		authRouter.Post("/api/user/2fa/disable", userHandler.DisableTwoFactor)
+
 		// Admin routes related to users
 		authRouter.Post("/api/admin/user/delete", userHandler.AdminDeleteUser)
+		authRouter.Post("/api/admin/user/restore", userHandler.AdminRestoreUser)
+		authRouter.Post("/api/admin/user/purge", userHandler.AdminPurgeUser)
 		authRouter.Post("/api/admin/users/list", userHandler.AdminListUsers)
 		authRouter.Post("/api/admin/users/search", userHandler.AdminSearchUsers)
 		authRouter.Post("/api/admin/user/update-role", userHandler.AdminUpdateUserRole)
@@ -11,11 +11,15 @@ import (
 	"github.com/Abdurahmanit/GroupProject/listing-service/internal/adapter/messaging/nats"
 	"github.com/Abdurahmanit/GroupProject/listing-service/internal/adapter/repository/mongodb"
 	"github.com/Abdurahmanit/GroupProject/listing-service/internal/adapter/storage/s3"
+	"github.com/Abdurahmanit/GroupProject/listing-service/internal/adapter/userclient"
 	"github.com/Abdurahmanit/GroupProject/listing-service/internal/adapter/repository/cache"
 	"github.com/Abdurahmanit/GroupProject/listing-service/internal/config"
+	"github.com/Abdurahmanit/GroupProject/listing-service/internal/listing/usecase"
 	"github.com/Abdurahmanit/GroupProject/listing-service/internal/platform/logger"   // <--- ПУТЬ К ТВОЕМУ ЛОГГЕРУ
 	"github.com/Abdurahmanit/GroupProject/listing-service/internal/platform/tracer"   // <--- ПУТЬ К ТВОЕМУ ТРЕЙСЕРУ
 	pb "github.com/Abdurahmanit/GroupProject/listing-service/genproto/listing_service"
+	"github.com/Abdurahmanit/GroupProject/platform/health"
+	"github.com/Abdurahmanit/GroupProject/platform/selftest"
 	"github.com/joho/godotenv" // Для загрузки .env файла
 
 	"go.mongodb.org/mongo-driver/mongo"
@@ -73,7 +77,10 @@ func main() {
 	// Initialize repositories
 	userRepo := mongodb.NewUserRepository(db, appLogger)
 	listingRepo := mongodb.NewListingRepository(db, appLogger)     // Передай логгер, если репозиторий его использует
+	listingRepo.EnsureUniqueActiveTitles(cfg.ListingUniqueTitlePerSeller)
 	favoriteRepo := mongodb.NewFavoriteRepository(db, appLogger) // Аналогично
+	offerRepo := mongodb.NewOfferRepository(db, appLogger)
+	categoryRepo := mongodb.NewCategoryRepository(db, appLogger)
 	appLogger.Info("Repositories initialized.")
 
 	// Initialize ListingCache (Redis)
@@ -113,6 +120,17 @@ func main() {
 	}()
 	appLogger.Info("NATS publisher initialized.")
 
+	if cfg.StartupSelfTest {
+		selfTest := selftest.NewRunner(appLogger)
+		selfTest.Add("mongodb", selftest.MongoRoundTrip(db))
+		selfTest.Add("redis", listingCache.RoundTrip)
+		selfTest.Add("nats", func(ctx context.Context) error { return natsPublisher.PublishProbe(ctx, "listing.selftest") })
+		if err := selfTest.Run(context.Background()); err != nil {
+			appLogger.Error("Startup self-test failed", "error", err)
+			os.Exit(1)
+		}
+	}
+
 	// Set up gRPC server
 	lis, err := net.Listen("tcp", ":"+cfg.GRPCPort)
 	if err != nil {
@@ -123,12 +141,49 @@ func main() {
 	// grpcAdapter.NewGRPCServer() вероятно создает *grpc.Server и возвращает его и функцию cleanup.
 	// cleanup обычно вызывает server.GracefulStop() или server.Stop()
 	// Можно также передать appLogger в grpcAdapter.NewGRPCServer(), если там нужны логи
-	grpcSrv, cleanup := grpcAdapter.NewGRPCServer(appLogger, cfg.JWTSecret) // <--- ПЕРЕДАЕМ ЛОГГЕР В GRPC SERVER ADAPTER
+	// Роль пользователя для админских методов берется из профиля в user-service
+	userClient, err := userclient.NewClient(cfg.UserServiceAddress, appLogger)
+	if err != nil {
+		appLogger.Error("Failed to create user service client", "address", cfg.UserServiceAddress, "error", err)
+		os.Exit(1)
+	}
+	defer userClient.Close()
+
+	grpcSrv, cleanup := grpcAdapter.NewGRPCServer(appLogger, cfg.JWTSecret, userClient, cfg.InternalAPIToken) // <--- ПЕРЕДАЕМ ЛОГГЕР В GRPC SERVER ADAPTER
 
 	// Передаем appLogger в Handler
-	handler := grpcAdapter.NewHandler(listingRepo, favoriteRepo,userRepo, storageClient, natsPublisher, listingCache, appLogger) // <--- ЛОГГЕР ПЕРЕДАН В GRPC HANDLER
+	handler := grpcAdapter.NewHandler(listingRepo, favoriteRepo, offerRepo, categoryRepo, userRepo, storageClient, natsPublisher, listingCache, appLogger,
+		usecase.PublishRules{MinPhotos: cfg.ListingMinPhotos, UniqueTitlePerSeller: cfg.ListingUniqueTitlePerSeller},
+		usecase.PhotoLimits{MaxBytes: cfg.PhotoMaxBytes, MaxPerListing: cfg.MaxPhotosPerListing, MinPerActiveListing: cfg.ListingMinPhotos, UploadURLExpiry: cfg.PhotoUploadURLExpiry},
+		usecase.ViewSettings{Debounce: cfg.ViewDebounce, HistorySize: cfg.ViewHistorySize, TrustedProxies: cfg.TrustedProxies}, cfg.SearchCacheTTL,
+		usecase.FavoriteRules{BlockOwnListing: cfg.FavoriteBlockOwnListing}) // <--- ЛОГГЕР ПЕРЕДАН В GRPC HANDLER
 	pb.RegisterListingServiceServer(grpcSrv, handler)
 
+	// Кэш поиска сбрасывается по событиям изменения объявлений от любого экземпляра сервиса
+	if cfg.SearchCacheTTL > 0 {
+		if err := natsPublisher.OnListingChange(handler.InvalidateSearchCache); err != nil {
+			appLogger.Error("Failed to subscribe to listing change events", "error", err)
+			os.Exit(1)
+		}
+	}
+
+	healthManager := health.NewManager(appLogger, pb.ListingService_ServiceDesc.ServiceName)
+	healthManager.AddCheck("mongodb", func(ctx context.Context) error { return mongoClient.Ping(ctx, nil) })
+	healthManager.AddCheck("redis", listingCache.Ping)
+	healthManager.AddCheck("nats", natsPublisher.Ping)
+	healthManager.Register(grpcSrv)
+	healthCtx, stopHealthChecks := context.WithCancel(context.Background())
+	defer stopHealthChecks()
+	go healthManager.Run(healthCtx, health.DefaultInterval)
+
+	// Прогрев кэша в фоне, чтобы не задерживать старт сервера
+	warmCtx, stopWarming := context.WithCancel(context.Background())
+	defer stopWarming()
+	if cfg.CacheWarmEnabled {
+		warmer := usecase.NewCacheWarmer(favoriteRepo, listingRepo, listingCache, appLogger, cfg.CacheWarmTopN, cfg.CacheWarmConcurrency)
+		go warmer.Run(warmCtx)
+	}
+
 	// Graceful Shutdown
 	go func() {
 		appLogger.Info("Starting gRPC server", "port", cfg.GRPCPort)
@@ -142,6 +197,9 @@ func main() {
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
+	stopWarming()
+	stopHealthChecks()
+	healthManager.Shutdown()
 	appLogger.Info("Shutting down gRPC server...")
 	cleanup() // Вызываем cleanup от gRPC сервера (например, grpcSrv.GracefulStop())
 	appLogger.Info("gRPC server stopped.")
@@ -59,12 +59,19 @@ func (*Empty) Descriptor() ([]byte, []int) {
 }
 
 type CreateListingRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`             // <--- ДОБАВЛЕНО (ID пользователя, создающего объявление)
-	CategoryId    string                 `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // <--- ДОБАВЛЕНО
-	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float64                `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"` // repeated string photos = 6; // Если фото можно загружать сразу при создании
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	UserId      string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`             // <--- ДОБАВЛЕНО (ID пользователя, создающего объявление)
+	CategoryId  string                 `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // <--- ДОБАВЛЕНО
+	Title       string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price       float64                `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
+	// repeated string photos = 6; // Если фото можно загружать сразу при создании
+	Negotiable    bool                `protobuf:"varint,7,opt,name=negotiable,proto3" json:"negotiable,omitempty"` // Разрешить покупателям предлагать цену
+	Latitude      float64             `protobuf:"fixed64,8,opt,name=latitude,proto3" json:"latitude,omitempty"`    // Местоположение товара; 0/0 - не указано
+	Longitude     float64             `protobuf:"fixed64,9,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Draft         bool                `protobuf:"varint,10,opt,name=draft,proto3" json:"draft,omitempty"`              // Создать черновиком; опубликовать позже через PublishListing
+	Availability  *AvailabilityWindow `protobuf:"bytes,11,opt,name=availability,proto3" json:"availability,omitempty"` // Не задано - доступно всегда
+	Quantity      int64               `protobuf:"varint,12,opt,name=quantity,proto3" json:"quantity,omitempty"`        // Количество единиц товара; 0 - одна единица
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -134,6 +141,102 @@ func (x *CreateListingRequest) GetPrice() float64 {
 	return 0
 }
 
+func (x *CreateListingRequest) GetNegotiable() bool {
+	if x != nil {
+		return x.Negotiable
+	}
+	return false
+}
+
+func (x *CreateListingRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *CreateListingRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *CreateListingRequest) GetDraft() bool {
+	if x != nil {
+		return x.Draft
+	}
+	return false
+}
+
+func (x *CreateListingRequest) GetAvailability() *AvailabilityWindow {
+	if x != nil {
+		return x.Availability
+	}
+	return nil
+}
+
+func (x *CreateListingRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// AvailabilityWindow - когда объявление видно в поиске и доступно для покупки.
+// Незаданная граница не ограничивает окно; from должно быть раньше until.
+type AvailabilityWindow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Until         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=until,proto3" json:"until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AvailabilityWindow) Reset() {
+	*x = AvailabilityWindow{}
+	mi := &file_api_proto_listing_listing_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AvailabilityWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailabilityWindow) ProtoMessage() {}
+
+func (x *AvailabilityWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listing_listing_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailabilityWindow.ProtoReflect.Descriptor instead.
+func (*AvailabilityWindow) Descriptor() ([]byte, []int) {
+	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AvailabilityWindow) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *AvailabilityWindow) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
 type UpdateListingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -142,14 +245,17 @@ type UpdateListingRequest struct {
 	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
 	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
 	Price         float64                `protobuf:"fixed64,6,opt,name=price,proto3" json:"price,omitempty"`
-	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"` // Рассмотри использование enum для статуса
+	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`                // Рассмотри использование enum для статуса
+	Negotiable    *bool                  `protobuf:"varint,8,opt,name=negotiable,proto3,oneof" json:"negotiable,omitempty"` // Не передано - без изменений
+	Availability  *AvailabilityWindow    `protobuf:"bytes,9,opt,name=availability,proto3" json:"availability,omitempty"`    // Не передано - без изменений; пустое окно снимает ограничение
+	Quantity      *int64                 `protobuf:"varint,10,opt,name=quantity,proto3,oneof" json:"quantity,omitempty"`    // Новый остаток (пополнение склада); не передано - без изменений
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateListingRequest) Reset() {
 	*x = UpdateListingRequest{}
-	mi := &file_api_proto_listing_listing_proto_msgTypes[2]
+	mi := &file_api_proto_listing_listing_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -161,7 +267,7 @@ func (x *UpdateListingRequest) String() string {
 func (*UpdateListingRequest) ProtoMessage() {}
 
 func (x *UpdateListingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listing_listing_proto_msgTypes[2]
+	mi := &file_api_proto_listing_listing_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -174,7 +280,7 @@ func (x *UpdateListingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateListingRequest.ProtoReflect.Descriptor instead.
 func (*UpdateListingRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{2}
+	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *UpdateListingRequest) GetId() string {
@@ -226,6 +332,27 @@ func (x *UpdateListingRequest) GetStatus() string {
 	return ""
 }
 
+func (x *UpdateListingRequest) GetNegotiable() bool {
+	if x != nil && x.Negotiable != nil {
+		return *x.Negotiable
+	}
+	return false
+}
+
+func (x *UpdateListingRequest) GetAvailability() *AvailabilityWindow {
+	if x != nil {
+		return x.Availability
+	}
+	return nil
+}
+
+func (x *UpdateListingRequest) GetQuantity() int64 {
+	if x != nil && x.Quantity != nil {
+		return *x.Quantity
+	}
+	return 0
+}
+
 type DeleteListingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -236,7 +363,7 @@ type DeleteListingRequest struct {
 
 func (x *DeleteListingRequest) Reset() {
 	*x = DeleteListingRequest{}
-	mi := &file_api_proto_listing_listing_proto_msgTypes[3]
+	mi := &file_api_proto_listing_listing_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -248,7 +375,7 @@ func (x *DeleteListingRequest) String() string {
 func (*DeleteListingRequest) ProtoMessage() {}
 
 func (x *DeleteListingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listing_listing_proto_msgTypes[3]
+	mi := &file_api_proto_listing_listing_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -261,7 +388,7 @@ func (x *DeleteListingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteListingRequest.ProtoReflect.Descriptor instead.
 func (*DeleteListingRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{3}
+	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *DeleteListingRequest) GetId() string {
@@ -279,15 +406,16 @@ func (x *DeleteListingRequest) GetUserId() string {
 }
 
 type GetListingRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // Только для admin
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *GetListingRequest) Reset() {
 	*x = GetListingRequest{}
-	mi := &file_api_proto_listing_listing_proto_msgTypes[4]
+	mi := &file_api_proto_listing_listing_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -299,7 +427,7 @@ func (x *GetListingRequest) String() string {
 func (*GetListingRequest) ProtoMessage() {}
 
 func (x *GetListingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listing_listing_proto_msgTypes[4]
+	mi := &file_api_proto_listing_listing_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -312,7 +440,7 @@ func (x *GetListingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetListingRequest.ProtoReflect.Descriptor instead.
 func (*GetListingRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{4}
+	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetListingRequest) GetId() string {
@@ -322,25 +450,41 @@ func (x *GetListingRequest) GetId() string {
 	return ""
 }
 
+func (x *GetListingRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
 type ListingResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`             // <--- ДОБАВЛЕНО
-	CategoryId    string                 `protobuf:"bytes,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // <--- ДОБАВЛЕНО
-	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float64                `protobuf:"fixed64,6,opt,name=price,proto3" json:"price,omitempty"`
-	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"` // Рассмотри использование enum для статуса
-	Photos        []string               `protobuf:"bytes,8,rep,name=photos,proto3" json:"photos,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`  // <--- ИЗМЕНЕНО НА Timestamp
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // <--- ИЗМЕНЕНО НА Timestamp
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId            string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`             // <--- ДОБАВЛЕНО
+	CategoryId        string                 `protobuf:"bytes,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // <--- ДОБАВЛЕНО
+	Title             string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Description       string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Price             float64                `protobuf:"fixed64,6,opt,name=price,proto3" json:"price,omitempty"`
+	Status            string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"` // Рассмотри использование enum для статуса
+	Photos            []string               `protobuf:"bytes,8,rep,name=photos,proto3" json:"photos,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`  // <--- ИЗМЕНЕНО НА Timestamp
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // <--- ИЗМЕНЕНО НА Timestamp
+	Negotiable        bool                   `protobuf:"varint,11,opt,name=negotiable,proto3" json:"negotiable,omitempty"`
+	UnavailableReason string                 `protobuf:"bytes,12,opt,name=unavailable_reason,json=unavailableReason,proto3" json:"unavailable_reason,omitempty"`
+	Latitude          float64                `protobuf:"fixed64,13,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude         float64                `protobuf:"fixed64,14,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	ViewCount         int64                  `protobuf:"varint,15,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`
+	DeletedAt         *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"` // Только у удаленных объявлений
+	FavoriteCount     int64                  `protobuf:"varint,17,opt,name=favorite_count,json=favoriteCount,proto3" json:"favorite_count,omitempty"`
+	Availability      *AvailabilityWindow    `protobuf:"bytes,18,opt,name=availability,proto3" json:"availability,omitempty"` // Только у объявлений с ограниченной доступностью
+	Quantity          int64                  `protobuf:"varint,19,opt,name=quantity,proto3" json:"quantity,omitempty"`        // Остаток на складе
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *ListingResponse) Reset() {
 	*x = ListingResponse{}
-	mi := &file_api_proto_listing_listing_proto_msgTypes[5]
+	mi := &file_api_proto_listing_listing_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -352,7 +496,7 @@ func (x *ListingResponse) String() string {
 func (*ListingResponse) ProtoMessage() {}
 
 func (x *ListingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listing_listing_proto_msgTypes[5]
+	mi := &file_api_proto_listing_listing_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -365,7 +509,7 @@ func (x *ListingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListingResponse.ProtoReflect.Descriptor instead.
 func (*ListingResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{5}
+	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ListingResponse) GetId() string {
@@ -438,25 +582,93 @@ func (x *ListingResponse) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *ListingResponse) GetNegotiable() bool {
+	if x != nil {
+		return x.Negotiable
+	}
+	return false
+}
+
+func (x *ListingResponse) GetUnavailableReason() string {
+	if x != nil {
+		return x.UnavailableReason
+	}
+	return ""
+}
+
+func (x *ListingResponse) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *ListingResponse) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *ListingResponse) GetViewCount() int64 {
+	if x != nil {
+		return x.ViewCount
+	}
+	return 0
+}
+
+func (x *ListingResponse) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *ListingResponse) GetFavoriteCount() int64 {
+	if x != nil {
+		return x.FavoriteCount
+	}
+	return 0
+}
+
+func (x *ListingResponse) GetAvailability() *AvailabilityWindow {
+	if x != nil {
+		return x.Availability
+	}
+	return nil
+}
+
+func (x *ListingResponse) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
 type SearchListingsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	MinPrice      float64                `protobuf:"fixed64,2,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
-	MaxPrice      float64                `protobuf:"fixed64,3,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
-	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`                           // Рассмотри использование enum для статуса
-	CategoryId    string                 `protobuf:"bytes,5,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // <--- ДОБАВЛЕНО (для фильтрации по категории)
-	UserId        string                 `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`             // <--- ДОБАВЛЕНО (для фильтрации по объявлениям пользователя)
-	Page          int32                  `protobuf:"varint,7,opt,name=page,proto3" json:"page,omitempty"`                              // <--- ДОБАВЛЕНО (для пагинации)
-	Limit         int32                  `protobuf:"varint,8,opt,name=limit,proto3" json:"limit,omitempty"`                            // <--- ДОБАВЛЕНО (для пагинации)
-	SortBy        string                 `protobuf:"bytes,9,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`             // <--- ДОБАВЛЕНО (например, "price", "created_at")
-	SortOrder     string                 `protobuf:"bytes,10,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`   // <--- ДОБАВЛЕНО (например, "asc", "desc")
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Query          string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	MinPrice       float64                `protobuf:"fixed64,2,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
+	MaxPrice       float64                `protobuf:"fixed64,3,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+	Status         string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`                           // Рассмотри использование enum для статуса
+	CategoryId     string                 `protobuf:"bytes,5,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // <--- ДОБАВЛЕНО (для фильтрации по категории)
+	UserId         string                 `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`             // <--- ДОБАВЛЕНО (для фильтрации по объявлениям пользователя)
+	Page           int32                  `protobuf:"varint,7,opt,name=page,proto3" json:"page,omitempty"`                              // <--- ДОБАВЛЕНО (для пагинации)
+	Limit          int32                  `protobuf:"varint,8,opt,name=limit,proto3" json:"limit,omitempty"`                            // <--- ДОБАВЛЕНО (для пагинации)
+	SortBy         string                 `protobuf:"bytes,9,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`             // <--- ДОБАВЛЕНО (например, "price", "created_at")
+	SortOrder      string                 `protobuf:"bytes,10,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`   // <--- ДОБАВЛЕНО (например, "asc", "desc")
+	CenterLat      float64                `protobuf:"fixed64,11,opt,name=center_lat,json=centerLat,proto3" json:"center_lat,omitempty"` // Геопоиск: центр и радиус; radius_km = 0 отключает фильтр
+	CenterLng      float64                `protobuf:"fixed64,12,opt,name=center_lng,json=centerLng,proto3" json:"center_lng,omitempty"`
+	RadiusKm       float64                `protobuf:"fixed64,13,opt,name=radius_km,json=radiusKm,proto3" json:"radius_km,omitempty"`
+	ExcludeUserId  string                 `protobuf:"bytes,14,opt,name=exclude_user_id,json=excludeUserId,proto3" json:"exclude_user_id,omitempty"`   // Скрыть объявления этого пользователя (например, свои)
+	IncludeDeleted bool                   `protobuf:"varint,15,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // Только для admin
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *SearchListingsRequest) Reset() {
 	*x = SearchListingsRequest{}
-	mi := &file_api_proto_listing_listing_proto_msgTypes[6]
+	mi := &file_api_proto_listing_listing_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -468,7 +680,7 @@ func (x *SearchListingsRequest) String() string {
 func (*SearchListingsRequest) ProtoMessage() {}
 
 func (x *SearchListingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listing_listing_proto_msgTypes[6]
+	mi := &file_api_proto_listing_listing_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -481,7 +693,7 @@ func (x *SearchListingsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchListingsRequest.ProtoReflect.Descriptor instead.
 func (*SearchListingsRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{6}
+	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SearchListingsRequest) GetQuery() string {
@@ -554,6 +766,41 @@ func (x *SearchListingsRequest) GetSortOrder() string {
 	return ""
 }
 
+func (x *SearchListingsRequest) GetCenterLat() float64 {
+	if x != nil {
+		return x.CenterLat
+	}
+	return 0
+}
+
+func (x *SearchListingsRequest) GetCenterLng() float64 {
+	if x != nil {
+		return x.CenterLng
+	}
+	return 0
+}
+
+func (x *SearchListingsRequest) GetRadiusKm() float64 {
+	if x != nil {
+		return x.RadiusKm
+	}
+	return 0
+}
+
+func (x *SearchListingsRequest) GetExcludeUserId() string {
+	if x != nil {
+		return x.ExcludeUserId
+	}
+	return ""
+}
+
+func (x *SearchListingsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
 type SearchListingsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Listings      []*ListingResponse     `protobuf:"bytes,1,rep,name=listings,proto3" json:"listings,omitempty"`
@@ -566,7 +813,7 @@ type SearchListingsResponse struct {
 
 func (x *SearchListingsResponse) Reset() {
 	*x = SearchListingsResponse{}
-	mi := &file_api_proto_listing_listing_proto_msgTypes[7]
+	mi := &file_api_proto_listing_listing_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -578,7 +825,7 @@ func (x *SearchListingsResponse) String() string {
 func (*SearchListingsResponse) ProtoMessage() {}
 
 func (x *SearchListingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listing_listing_proto_msgTypes[7]
+	mi := &file_api_proto_listing_listing_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -591,7 +838,7 @@ func (x *SearchListingsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchListingsResponse.ProtoReflect.Descriptor instead.
 func (*SearchListingsResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{7}
+	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SearchListingsResponse) GetListings() []*ListingResponse {
@@ -634,7 +881,7 @@ type UploadPhotoRequest struct {
 
 func (x *UploadPhotoRequest) Reset() {
 	*x = UploadPhotoRequest{}
-	mi := &file_api_proto_listing_listing_proto_msgTypes[8]
+	mi := &file_api_proto_listing_listing_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -646,7 +893,7 @@ func (x *UploadPhotoRequest) String() string {
 func (*UploadPhotoRequest) ProtoMessage() {}
 
 func (x *UploadPhotoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listing_listing_proto_msgTypes[8]
+	mi := &file_api_proto_listing_listing_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -659,7 +906,7 @@ func (x *UploadPhotoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadPhotoRequest.ProtoReflect.Descriptor instead.
 func (*UploadPhotoRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{8}
+	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UploadPhotoRequest) GetListingId() string {
@@ -699,7 +946,7 @@ type UploadPhotoResponse struct {
 
 func (x *UploadPhotoResponse) Reset() {
 	*x = UploadPhotoResponse{}
-	mi := &file_api_proto_listing_listing_proto_msgTypes[9]
+	mi := &file_api_proto_listing_listing_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -711,7 +958,7 @@ func (x *UploadPhotoResponse) String() string {
 func (*UploadPhotoResponse) ProtoMessage() {}
 
 func (x *UploadPhotoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listing_listing_proto_msgTypes[9]
+	mi := &file_api_proto_listing_listing_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -724,7 +971,7 @@ func (x *UploadPhotoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadPhotoResponse.ProtoReflect.Descriptor instead.
 func (*UploadPhotoResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{9}
+	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UploadPhotoResponse) GetPhotoUrl() string {
@@ -734,32 +981,30 @@ func (x *UploadPhotoResponse) GetPhotoUrl() string {
 	return ""
 }
 
-// ListingStatusResponse и PhotoURLsResponse могут быть избыточны,
-// если GetListingByID возвращает полный ListingResponse.
-// Если они остаются, стоит добавить listing_id в ответ для контекста.
-type ListingStatusResponse struct {
+type GeneratePhotoUploadURLRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ListingId     string                 `protobuf:"bytes,1,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"` // <--- ДОБАВЛЕНО для контекста
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	ListingId     string                 `protobuf:"bytes,1,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // Владелец объявления
+	FileName      string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"` // Используется только для расширения файла
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListingStatusResponse) Reset() {
-	*x = ListingStatusResponse{}
-	mi := &file_api_proto_listing_listing_proto_msgTypes[10]
+func (x *GeneratePhotoUploadURLRequest) Reset() {
+	*x = GeneratePhotoUploadURLRequest{}
+	mi := &file_api_proto_listing_listing_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListingStatusResponse) String() string {
+func (x *GeneratePhotoUploadURLRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListingStatusResponse) ProtoMessage() {}
+func (*GeneratePhotoUploadURLRequest) ProtoMessage() {}
 
-func (x *ListingStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listing_listing_proto_msgTypes[10]
+func (x *GeneratePhotoUploadURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listing_listing_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -770,48 +1015,56 @@ func (x *ListingStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListingStatusResponse.ProtoReflect.Descriptor instead.
-func (*ListingStatusResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use GeneratePhotoUploadURLRequest.ProtoReflect.Descriptor instead.
+func (*GeneratePhotoUploadURLRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ListingStatusResponse) GetListingId() string {
+func (x *GeneratePhotoUploadURLRequest) GetListingId() string {
 	if x != nil {
 		return x.ListingId
 	}
 	return ""
 }
 
-func (x *ListingStatusResponse) GetStatus() string {
+func (x *GeneratePhotoUploadURLRequest) GetUserId() string {
 	if x != nil {
-		return x.Status
+		return x.UserId
 	}
 	return ""
 }
 
-type AddFavoriteRequest struct {
+func (x *GeneratePhotoUploadURLRequest) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+type GeneratePhotoUploadURLResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ListingId     string                 `protobuf:"bytes,2,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`
+	UploadUrl     string                 `protobuf:"bytes,1,opt,name=upload_url,json=uploadUrl,proto3" json:"upload_url,omitempty"` // Presigned URL: клиент загружает файл HTTP PUT-запросом
+	PhotoUrl      string                 `protobuf:"bytes,2,opt,name=photo_url,json=photoUrl,proto3" json:"photo_url,omitempty"`    // Передается в ConfirmPhotoUpload после загрузки
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddFavoriteRequest) Reset() {
-	*x = AddFavoriteRequest{}
-	mi := &file_api_proto_listing_listing_proto_msgTypes[11]
+func (x *GeneratePhotoUploadURLResponse) Reset() {
+	*x = GeneratePhotoUploadURLResponse{}
+	mi := &file_api_proto_listing_listing_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddFavoriteRequest) String() string {
+func (x *GeneratePhotoUploadURLResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddFavoriteRequest) ProtoMessage() {}
+func (*GeneratePhotoUploadURLResponse) ProtoMessage() {}
 
-func (x *AddFavoriteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listing_listing_proto_msgTypes[11]
+func (x *GeneratePhotoUploadURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listing_listing_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -822,48 +1075,1637 @@ func (x *AddFavoriteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddFavoriteRequest.ProtoReflect.Descriptor instead.
+// Deprecated: Use GeneratePhotoUploadURLResponse.ProtoReflect.Descriptor instead.
+func (*GeneratePhotoUploadURLResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GeneratePhotoUploadURLResponse) GetUploadUrl() string {
+	if x != nil {
+		return x.UploadUrl
+	}
+	return ""
+}
+
+func (x *GeneratePhotoUploadURLResponse) GetPhotoUrl() string {
+	if x != nil {
+		return x.PhotoUrl
+	}
+	return ""
+}
+
+func (x *GeneratePhotoUploadURLResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type ConfirmPhotoUploadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListingId     string                 `protobuf:"bytes,1,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PhotoUrl      string                 `protobuf:"bytes,3,opt,name=photo_url,json=photoUrl,proto3" json:"photo_url,omitempty"` // photo_url из GeneratePhotoUploadURLResponse
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmPhotoUploadRequest) Reset() {
+	*x = ConfirmPhotoUploadRequest{}
+	mi := &file_api_proto_listing_listing_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmPhotoUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmPhotoUploadRequest) ProtoMessage() {}
+
+func (x *ConfirmPhotoUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listing_listing_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmPhotoUploadRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmPhotoUploadRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ConfirmPhotoUploadRequest) GetListingId() string {
+	if x != nil {
+		return x.ListingId
+	}
+	return ""
+}
+
+func (x *ConfirmPhotoUploadRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ConfirmPhotoUploadRequest) GetPhotoUrl() string {
+	if x != nil {
+		return x.PhotoUrl
+	}
+	return ""
+}
+
+type DeletePhotoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListingId     string                 `protobuf:"bytes,1,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // ID владельца объявления
+	PhotoUrl      string                 `protobuf:"bytes,3,opt,name=photo_url,json=photoUrl,proto3" json:"photo_url,omitempty"` // URL из списка photos объявления
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePhotoRequest) Reset() {
+	*x = DeletePhotoRequest{}
+	mi := &file_api_proto_listing_listing_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePhotoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePhotoRequest) ProtoMessage() {}
+
+func (x *DeletePhotoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listing_listing_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePhotoRequest.ProtoReflect.Descriptor instead.
+func (*DeletePhotoRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeletePhotoRequest) GetListingId() string {
+	if x != nil {
+		return x.ListingId
+	}
+	return ""
+}
+
+func (x *DeletePhotoRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeletePhotoRequest) GetPhotoUrl() string {
+	if x != nil {
+		return x.PhotoUrl
+	}
+	return ""
+}
+
+type ReorderPhotosRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListingId     string                 `protobuf:"bytes,1,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`          // ID владельца объявления
+	PhotoUrls     []string               `protobuf:"bytes,3,rep,name=photo_urls,json=photoUrls,proto3" json:"photo_urls,omitempty"` // Все текущие фото в новом порядке
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderPhotosRequest) Reset() {
+	*x = ReorderPhotosRequest{}
+	mi := &file_api_proto_listing_listing_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderPhotosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderPhotosRequest) ProtoMessage() {}
+
+func (x *ReorderPhotosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listing_listing_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderPhotosRequest.ProtoReflect.Descriptor instead.
+func (*ReorderPhotosRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ReorderPhotosRequest) GetListingId() string {
+	if x != nil {
+		return x.ListingId
+	}
+	return ""
+}
+
+func (x *ReorderPhotosRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReorderPhotosRequest) GetPhotoUrls() []string {
+	if x != nil {
+		return x.PhotoUrls
+	}
+	return nil
+}
+
+// ListingStatusResponse и PhotoURLsResponse могут быть избыточны,
+// если GetListingByID возвращает полный ListingResponse.
+// Если они остаются, стоит добавить listing_id в ответ для контекста.
+type ListingStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListingId     string                 `protobuf:"bytes,1,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"` // <--- ДОБАВЛЕНО для контекста
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListingStatusResponse) Reset() {
+	*x = ListingStatusResponse{}
+	mi := &file_api_proto_listing_listing_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListingStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListingStatusResponse) ProtoMessage() {}
+
+func (x *ListingStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listing_listing_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListingStatusResponse.ProtoReflect.Descriptor instead.
+func (*ListingStatusResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListingStatusResponse) GetListingId() string {
+	if x != nil {
+		return x.ListingId
+	}
+	return ""
+}
+
+func (x *ListingStatusResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type GetListingsStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetListingsStatusRequest) Reset() {
+	*x = GetListingsStatusRequest{}
+	mi := &file_api_proto_listing_listing_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetListingsStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListingsStatusRequest) ProtoMessage() {}
+
+func (x *GetListingsStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listing_listing_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListingsStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetListingsStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetListingsStatusRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type ListingAvailability struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // Статус объявления или "not_found", если ID не существует
+	Price         float64                `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	Available     bool                   `protobuf:"varint,3,opt,name=available,proto3" json:"available,omitempty"` // true только для активных объявлений внутри окна доступности
+	Availability  *AvailabilityWindow    `protobuf:"bytes,4,opt,name=availability,proto3" json:"availability,omitempty"`
+	UserId        string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Владелец объявления
+	Quantity      int64                  `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`          // Остаток; при 0 available = false
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListingAvailability) Reset() {
+	*x = ListingAvailability{}
+	mi := &file_api_proto_listing_listing_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListingAvailability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListingAvailability) ProtoMessage() {}
+
+func (x *ListingAvailability) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listing_listing_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListingAvailability.ProtoReflect.Descriptor instead.
+func (*ListingAvailability) Descriptor() ([]byte, []int) {
+	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListingAvailability) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListingAvailability) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *ListingAvailability) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *ListingAvailability) GetAvailability() *AvailabilityWindow {
+	if x != nil {
+		return x.Availability
+	}
+	return nil
+}
+
+func (x *ListingAvailability) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListingAvailability) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type GetListingsStatusResponse struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Statuses      map[string]*ListingAvailability `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // listing_id -> статус
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetListingsStatusResponse) Reset() {
+	*x = GetListingsStatusResponse{}
+	mi := &file_api_proto_listing_listing_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetListingsStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListingsStatusResponse) ProtoMessage() {}
+
+func (x *GetListingsStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listing_listing_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListingsStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetListingsStatusResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetListingsStatusResponse) GetStatuses() map[string]*ListingAvailability {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+type ReserveStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListingId     string                 `protobuf:"bytes,1,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`                         // Больше 0; при нехватке остатка - FAILED_PRECONDITION
+	OrderNumber   string                 `protobuf:"bytes,3,opt,name=order_number,json=orderNumber,proto3" json:"order_number,omitempty"` // Заказ, за которым числится резерв; повторный вызов не списывает остаток снова
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveStockRequest) Reset() {
+	*x = ReserveStockRequest{}
+	mi := &file_api_proto_listing_listing_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveStockRequest) ProtoMessage() {}
+
+func (x *ReserveStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listing_listing_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveStockRequest.ProtoReflect.Descriptor instead.
+func (*ReserveStockRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ReserveStockRequest) GetListingId() string {
+	if x != nil {
+		return x.ListingId
+	}
+	return ""
+}
+
+func (x *ReserveStockRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ReserveStockRequest) GetOrderNumber() string {
+	if x != nil {
+		return x.OrderNumber
+	}
+	return ""
+}
+
+type ReserveStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Remaining     int64                  `protobuf:"varint,1,opt,name=remaining,proto3" json:"remaining,omitempty"` // Остаток после списания
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveStockResponse) Reset() {
+	*x = ReserveStockResponse{}
+	mi := &file_api_proto_listing_listing_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveStockResponse) ProtoMessage() {}
+
+func (x *ReserveStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listing_listing_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveStockResponse.ProtoReflect.Descriptor instead.
+func (*ReserveStockResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ReserveStockResponse) GetRemaining() int64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+type ReleaseStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListingId     string                 `protobuf:"bytes,1,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`
+	OrderNumber   string                 `protobuf:"bytes,2,opt,name=order_number,json=orderNumber,proto3" json:"order_number,omitempty"` // Возвращается весь резерв этого заказа, не более одного раза
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseStockRequest) Reset() {
+	*x = ReleaseStockRequest{}
+	mi := &file_api_proto_listing_listing_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseStockRequest) ProtoMessage() {}
+
+func (x *ReleaseStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listing_listing_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseStockRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseStockRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ReleaseStockRequest) GetListingId() string {
+	if x != nil {
+		return x.ListingId
+	}
+	return ""
+}
+
+func (x *ReleaseStockRequest) GetOrderNumber() string {
+	if x != nil {
+		return x.OrderNumber
+	}
+	return ""
+}
+
+type BatchGetListingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"` // Не более 100 ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetListingsRequest) Reset() {
+	*x = BatchGetListingsRequest{}
+	mi := &file_api_proto_listing_listing_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetListingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetListingsRequest) ProtoMessage() {}
+
+func (x *BatchGetListingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listing_listing_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetListingsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetListingsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *BatchGetListingsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type BatchGetListingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Listings      []*ListingResponse     `protobuf:"bytes,1,rep,name=listings,proto3" json:"listings,omitempty"` // В порядке запроса; несуществующие ID пропущены
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetListingsResponse) Reset() {
+	*x = BatchGetListingsResponse{}
+	mi := &file_api_proto_listing_listing_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetListingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetListingsResponse) ProtoMessage() {}
+
+func (x *BatchGetListingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listing_listing_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetListingsResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetListingsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *BatchGetListingsResponse) GetListings() []*ListingResponse {
+	if x != nil {
+		return x.Listings
+	}
+	return nil
+}
+
+type GetRecentlyViewedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // 0 - вся сохраненная история
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecentlyViewedRequest) Reset() {
+	*x = GetRecentlyViewedRequest{}
+	mi := &file_api_proto_listing_listing_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecentlyViewedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecentlyViewedRequest) ProtoMessage() {}
+
+func (x *GetRecentlyViewedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listing_listing_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecentlyViewedRequest.ProtoReflect.Descriptor instead.
+func (*GetRecentlyViewedRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetRecentlyViewedRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetRecentlyViewedRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetRecentlyViewedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Listings      []*ListingResponse     `protobuf:"bytes,1,rep,name=listings,proto3" json:"listings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecentlyViewedResponse) Reset() {
+	*x = GetRecentlyViewedResponse{}
+	mi := &file_api_proto_listing_listing_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecentlyViewedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecentlyViewedResponse) ProtoMessage() {}
+
+func (x *GetRecentlyViewedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listing_listing_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecentlyViewedResponse.ProtoReflect.Descriptor instead.
+func (*GetRecentlyViewedResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetRecentlyViewedResponse) GetListings() []*ListingResponse {
+	if x != nil {
+		return x.Listings
+	}
+	return nil
+}
+
+type AddFavoriteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ListingId     string                 `protobuf:"bytes,2,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddFavoriteRequest) Reset() {
+	*x = AddFavoriteRequest{}
+	mi := &file_api_proto_listing_listing_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddFavoriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFavoriteRequest) ProtoMessage() {}
+
+func (x *AddFavoriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listing_listing_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddFavoriteRequest.ProtoReflect.Descriptor instead.
 func (*AddFavoriteRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{11}
+	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AddFavoriteRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AddFavoriteRequest) GetListingId() string {
+	if x != nil {
+		return x.ListingId
+	}
+	return ""
+}
+
+type RemoveFavoriteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ListingId     string                 `protobuf:"bytes,2,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFavoriteRequest) Reset() {
+	*x = RemoveFavoriteRequest{}
+	mi := &file_api_proto_listing_listing_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFavoriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFavoriteRequest) ProtoMessage() {}
+
+func (x *RemoveFavoriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listing_listing_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFavoriteRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFavoriteRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RemoveFavoriteRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RemoveFavoriteRequest) GetListingId() string {
+	if x != nil {
+		return x.ListingId
+	}
+	return ""
+}
+
+type GetFavoritesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IncludeListings bool                   `protobuf:"varint,2,opt,name=include_listings,json=includeListings,proto3" json:"include_listings,omitempty"` // Вернуть полные объявления в listings; тогда limit по умолчанию 20, не более 100
+	Page            int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                                              // page = 0 и limit = 0 без include_listings - все ID сразу
+	Limit           int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetFavoritesRequest) Reset() {
+	*x = GetFavoritesRequest{}
+	mi := &file_api_proto_listing_listing_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFavoritesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFavoritesRequest) ProtoMessage() {}
+
+func (x *GetFavoritesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listing_listing_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFavoritesRequest.ProtoReflect.Descriptor instead.
+func (*GetFavoritesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetFavoritesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetFavoritesRequest) GetIncludeListings() bool {
+	if x != nil {
+		return x.IncludeListings
+	}
+	return false
+}
+
+func (x *GetFavoritesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetFavoritesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetFavoritesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListingIds    []string               `protobuf:"bytes,1,rep,name=listing_ids,json=listingIds,proto3" json:"listing_ids,omitempty"` // ID избранного на странице, от новых к старым
+	Listings      []*ListingResponse     `protobuf:"bytes,2,rep,name=listings,proto3" json:"listings,omitempty"`                       // Только с include_listings; удаленные объявления пропущены
+	Total         int64                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`                            // Всего записей в избранном
+	Page          int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFavoritesResponse) Reset() {
+	*x = GetFavoritesResponse{}
+	mi := &file_api_proto_listing_listing_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFavoritesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFavoritesResponse) ProtoMessage() {}
+
+func (x *GetFavoritesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listing_listing_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFavoritesResponse.ProtoReflect.Descriptor instead.
+func (*GetFavoritesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetFavoritesResponse) GetListingIds() []string {
+	if x != nil {
+		return x.ListingIds
+	}
+	return nil
+}
+
+func (x *GetFavoritesResponse) GetListings() []*ListingResponse {
+	if x != nil {
+		return x.Listings
+	}
+	return nil
+}
+
+func (x *GetFavoritesResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetFavoritesResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetFavoritesResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type PhotoURLsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListingId     string                 `protobuf:"bytes,1,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"` // <--- ДОБАВЛЕНО для контекста
+	Urls          []string               `protobuf:"bytes,2,rep,name=urls,proto3" json:"urls,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PhotoURLsResponse) Reset() {
+	*x = PhotoURLsResponse{}
+	mi := &file_api_proto_listing_listing_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PhotoURLsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PhotoURLsResponse) ProtoMessage() {}
+
+func (x *PhotoURLsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listing_listing_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PhotoURLsResponse.ProtoReflect.Descriptor instead.
+func (*PhotoURLsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *PhotoURLsResponse) GetListingId() string {
+	if x != nil {
+		return x.ListingId
+	}
+	return ""
+}
+
+func (x *PhotoURLsResponse) GetUrls() []string {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+type UpdateListingStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // <--- ДОБАВЛЕНО (ID пользователя, изменяющего статус)
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`               // Рассмотри использование enum для статуса
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateListingStatusRequest) Reset() {
+	*x = UpdateListingStatusRequest{}
+	mi := &file_api_proto_listing_listing_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateListingStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateListingStatusRequest) ProtoMessage() {}
+
+func (x *UpdateListingStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listing_listing_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateListingStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateListingStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UpdateListingStatusRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateListingStatusRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateListingStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type MarkUnavailableRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // ID владельца объявления
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`               // "sold_elsewhere" или "withdrawn"; пусто - "sold_elsewhere"
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`               // Необязательный комментарий продавца
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkUnavailableRequest) Reset() {
+	*x = MarkUnavailableRequest{}
+	mi := &file_api_proto_listing_listing_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkUnavailableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkUnavailableRequest) ProtoMessage() {}
+
+func (x *MarkUnavailableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listing_listing_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkUnavailableRequest.ProtoReflect.Descriptor instead.
+func (*MarkUnavailableRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *MarkUnavailableRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MarkUnavailableRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MarkUnavailableRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *MarkUnavailableRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type PublishListingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // ID владельца объявления
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishListingRequest) Reset() {
+	*x = PublishListingRequest{}
+	mi := &file_api_proto_listing_listing_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishListingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishListingRequest) ProtoMessage() {}
+
+func (x *PublishListingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listing_listing_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishListingRequest.ProtoReflect.Descriptor instead.
+func (*PublishListingRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *PublishListingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PublishListingRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DuplicateListingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                       // Исходное объявление
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // ID владельца объявления
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DuplicateListingRequest) Reset() {
+	*x = DuplicateListingRequest{}
+	mi := &file_api_proto_listing_listing_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateListingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateListingRequest) ProtoMessage() {}
+
+func (x *DuplicateListingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listing_listing_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateListingRequest.ProtoReflect.Descriptor instead.
+func (*DuplicateListingRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DuplicateListingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DuplicateListingRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type MakeOfferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListingId     string                 `protobuf:"bytes,1,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // ID покупателя
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MakeOfferRequest) Reset() {
+	*x = MakeOfferRequest{}
+	mi := &file_api_proto_listing_listing_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MakeOfferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MakeOfferRequest) ProtoMessage() {}
+
+func (x *MakeOfferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listing_listing_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MakeOfferRequest.ProtoReflect.Descriptor instead.
+func (*MakeOfferRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *MakeOfferRequest) GetListingId() string {
+	if x != nil {
+		return x.ListingId
+	}
+	return ""
+}
+
+func (x *MakeOfferRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MakeOfferRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type RespondToOfferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OfferId       string                 `protobuf:"bytes,1,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // ID покупателя или продавца, чей сейчас ход
+	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`               // "accept", "reject" или "counter"
+	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`             // Только для "counter"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RespondToOfferRequest) Reset() {
+	*x = RespondToOfferRequest{}
+	mi := &file_api_proto_listing_listing_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespondToOfferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondToOfferRequest) ProtoMessage() {}
+
+func (x *RespondToOfferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listing_listing_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondToOfferRequest.ProtoReflect.Descriptor instead.
+func (*RespondToOfferRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RespondToOfferRequest) GetOfferId() string {
+	if x != nil {
+		return x.OfferId
+	}
+	return ""
+}
+
+func (x *RespondToOfferRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RespondToOfferRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *RespondToOfferRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type ListOffersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListingId     string                 `protobuf:"bytes,1,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"` // Пусто - предложения самого пользователя
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOffersRequest) Reset() {
+	*x = ListOffersRequest{}
+	mi := &file_api_proto_listing_listing_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOffersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOffersRequest) ProtoMessage() {}
+
+func (x *ListOffersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listing_listing_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOffersRequest.ProtoReflect.Descriptor instead.
+func (*ListOffersRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListOffersRequest) GetListingId() string {
+	if x != nil {
+		return x.ListingId
+	}
+	return ""
+}
+
+func (x *ListOffersRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type OfferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ListingId     string                 `protobuf:"bytes,2,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`
+	BuyerId       string                 `protobuf:"bytes,3,opt,name=buyer_id,json=buyerId,proto3" json:"buyer_id,omitempty"`
+	SellerId      string                 `protobuf:"bytes,4,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	Amount        float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	CounterAmount float64                `protobuf:"fixed64,6,opt,name=counter_amount,json=counterAmount,proto3" json:"counter_amount,omitempty"`
+	AgreedPrice   float64                `protobuf:"fixed64,7,opt,name=agreed_price,json=agreedPrice,proto3" json:"agreed_price,omitempty"`
+	Status        string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	LastActor     string                 `protobuf:"bytes,11,opt,name=last_actor,json=lastActor,proto3" json:"last_actor,omitempty"` // Who made the latest move; the other party responds
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OfferResponse) Reset() {
+	*x = OfferResponse{}
+	mi := &file_api_proto_listing_listing_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OfferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OfferResponse) ProtoMessage() {}
+
+func (x *OfferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listing_listing_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OfferResponse.ProtoReflect.Descriptor instead.
+func (*OfferResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *OfferResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OfferResponse) GetListingId() string {
+	if x != nil {
+		return x.ListingId
+	}
+	return ""
+}
+
+func (x *OfferResponse) GetBuyerId() string {
+	if x != nil {
+		return x.BuyerId
+	}
+	return ""
+}
+
+func (x *OfferResponse) GetSellerId() string {
+	if x != nil {
+		return x.SellerId
+	}
+	return ""
 }
 
-func (x *AddFavoriteRequest) GetUserId() string {
+func (x *OfferResponse) GetAmount() float64 {
 	if x != nil {
-		return x.UserId
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *OfferResponse) GetCounterAmount() float64 {
+	if x != nil {
+		return x.CounterAmount
+	}
+	return 0
+}
+
+func (x *OfferResponse) GetAgreedPrice() float64 {
+	if x != nil {
+		return x.AgreedPrice
+	}
+	return 0
+}
+
+func (x *OfferResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
 	}
 	return ""
 }
 
-func (x *AddFavoriteRequest) GetListingId() string {
+func (x *OfferResponse) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ListingId
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *OfferResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *OfferResponse) GetLastActor() string {
+	if x != nil {
+		return x.LastActor
 	}
 	return ""
 }
 
-type RemoveFavoriteRequest struct {
+type ListOffersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ListingId     string                 `protobuf:"bytes,2,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`
+	Offers        []*OfferResponse       `protobuf:"bytes,1,rep,name=offers,proto3" json:"offers,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveFavoriteRequest) Reset() {
-	*x = RemoveFavoriteRequest{}
-	mi := &file_api_proto_listing_listing_proto_msgTypes[12]
+func (x *ListOffersResponse) Reset() {
+	*x = ListOffersResponse{}
+	mi := &file_api_proto_listing_listing_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveFavoriteRequest) String() string {
+func (x *ListOffersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveFavoriteRequest) ProtoMessage() {}
+func (*ListOffersResponse) ProtoMessage() {}
 
-func (x *RemoveFavoriteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listing_listing_proto_msgTypes[12]
+func (x *ListOffersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listing_listing_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -874,47 +2716,92 @@ func (x *RemoveFavoriteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveFavoriteRequest.ProtoReflect.Descriptor instead.
-func (*RemoveFavoriteRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ListOffersResponse.ProtoReflect.Descriptor instead.
+func (*ListOffersResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *RemoveFavoriteRequest) GetUserId() string {
+func (x *ListOffersResponse) GetOffers() []*OfferResponse {
 	if x != nil {
-		return x.UserId
+		return x.Offers
+	}
+	return nil
+}
+
+type CreateCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryRequest) Reset() {
+	*x = CreateCategoryRequest{}
+	mi := &file_api_proto_listing_listing_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryRequest) ProtoMessage() {}
+
+func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listing_listing_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CreateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *RemoveFavoriteRequest) GetListingId() string {
+func (x *CreateCategoryRequest) GetDescription() string {
 	if x != nil {
-		return x.ListingId
+		return x.Description
 	}
 	return ""
 }
 
-type GetFavoritesRequest struct {
+type GetCategoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFavoritesRequest) Reset() {
-	*x = GetFavoritesRequest{}
-	mi := &file_api_proto_listing_listing_proto_msgTypes[13]
+func (x *GetCategoryRequest) Reset() {
+	*x = GetCategoryRequest{}
+	mi := &file_api_proto_listing_listing_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFavoritesRequest) String() string {
+func (x *GetCategoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFavoritesRequest) ProtoMessage() {}
+func (*GetCategoryRequest) ProtoMessage() {}
 
-func (x *GetFavoritesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listing_listing_proto_msgTypes[13]
+func (x *GetCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listing_listing_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -925,40 +2812,42 @@ func (x *GetFavoritesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFavoritesRequest.ProtoReflect.Descriptor instead.
-func (*GetFavoritesRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use GetCategoryRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *GetFavoritesRequest) GetUserId() string {
+func (x *GetCategoryRequest) GetId() string {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
 	return ""
 }
 
-type GetFavoritesResponse struct {
+type UpdateCategoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ListingIds    []string               `protobuf:"bytes,1,rep,name=listing_ids,json=listingIds,proto3" json:"listing_ids,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`               // Пусто - не менять
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"` // Пусто - не менять
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFavoritesResponse) Reset() {
-	*x = GetFavoritesResponse{}
-	mi := &file_api_proto_listing_listing_proto_msgTypes[14]
+func (x *UpdateCategoryRequest) Reset() {
+	*x = UpdateCategoryRequest{}
+	mi := &file_api_proto_listing_listing_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFavoritesResponse) String() string {
+func (x *UpdateCategoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFavoritesResponse) ProtoMessage() {}
+func (*UpdateCategoryRequest) ProtoMessage() {}
 
-func (x *GetFavoritesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listing_listing_proto_msgTypes[14]
+func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listing_listing_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -969,41 +2858,54 @@ func (x *GetFavoritesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFavoritesResponse.ProtoReflect.Descriptor instead.
-func (*GetFavoritesResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use UpdateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *GetFavoritesResponse) GetListingIds() []string {
+func (x *UpdateCategoryRequest) GetId() string {
 	if x != nil {
-		return x.ListingIds
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type PhotoURLsResponse struct {
+func (x *UpdateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type DeleteCategoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ListingId     string                 `protobuf:"bytes,1,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"` // <--- ДОБАВЛЕНО для контекста
-	Urls          []string               `protobuf:"bytes,2,rep,name=urls,proto3" json:"urls,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PhotoURLsResponse) Reset() {
-	*x = PhotoURLsResponse{}
-	mi := &file_api_proto_listing_listing_proto_msgTypes[15]
+func (x *DeleteCategoryRequest) Reset() {
+	*x = DeleteCategoryRequest{}
+	mi := &file_api_proto_listing_listing_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PhotoURLsResponse) String() string {
+func (x *DeleteCategoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PhotoURLsResponse) ProtoMessage() {}
+func (*DeleteCategoryRequest) ProtoMessage() {}
 
-func (x *PhotoURLsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listing_listing_proto_msgTypes[15]
+func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listing_listing_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1014,49 +2916,44 @@ func (x *PhotoURLsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PhotoURLsResponse.ProtoReflect.Descriptor instead.
-func (*PhotoURLsResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use DeleteCategoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *PhotoURLsResponse) GetListingId() string {
+func (x *DeleteCategoryRequest) GetId() string {
 	if x != nil {
-		return x.ListingId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *PhotoURLsResponse) GetUrls() []string {
-	if x != nil {
-		return x.Urls
-	}
-	return nil
-}
-
-type UpdateListingStatusRequest struct {
+type CategoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // <--- ДОБАВЛЕНО (ID пользователя, изменяющего статус)
-	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`               // Рассмотри использование enum для статуса
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateListingStatusRequest) Reset() {
-	*x = UpdateListingStatusRequest{}
-	mi := &file_api_proto_listing_listing_proto_msgTypes[16]
+func (x *CategoryResponse) Reset() {
+	*x = CategoryResponse{}
+	mi := &file_api_proto_listing_listing_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateListingStatusRequest) String() string {
+func (x *CategoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateListingStatusRequest) ProtoMessage() {}
+func (*CategoryResponse) ProtoMessage() {}
 
-func (x *UpdateListingStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_listing_listing_proto_msgTypes[16]
+func (x *CategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listing_listing_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1067,45 +2964,115 @@ func (x *UpdateListingStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateListingStatusRequest.ProtoReflect.Descriptor instead.
-func (*UpdateListingStatusRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use CategoryResponse.ProtoReflect.Descriptor instead.
+func (*CategoryResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *UpdateListingStatusRequest) GetId() string {
+func (x *CategoryResponse) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateListingStatusRequest) GetUserId() string {
+func (x *CategoryResponse) GetName() string {
 	if x != nil {
-		return x.UserId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *UpdateListingStatusRequest) GetStatus() string {
+func (x *CategoryResponse) GetDescription() string {
 	if x != nil {
-		return x.Status
+		return x.Description
 	}
 	return ""
 }
 
+func (x *CategoryResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CategoryResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*CategoryResponse    `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesResponse) Reset() {
+	*x = ListCategoriesResponse{}
+	mi := &file_api_proto_listing_listing_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesResponse) ProtoMessage() {}
+
+func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_listing_listing_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_listing_listing_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListCategoriesResponse) GetCategories() []*CategoryResponse {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
 var File_api_proto_listing_listing_proto protoreflect.FileDescriptor
 
 const file_api_proto_listing_listing_proto_rawDesc = "" +
 	"\n" +
 	"\x1fapi/proto/listing/listing.proto\x12\alisting\x1a\x1fgoogle/protobuf/timestamp.proto\"\a\n" +
-	"\x05Empty\"\x9e\x01\n" +
+	"\x05Empty\"\xeb\x02\n" +
 	"\x14CreateListingRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
 	"\vcategory_id\x18\x02 \x01(\tR\n" +
 	"categoryId\x12\x14\n" +
 	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x14\n" +
-	"\x05price\x18\x05 \x01(\x01R\x05price\"\xc6\x01\n" +
+	"\x05price\x18\x05 \x01(\x01R\x05price\x12\x1e\n" +
+	"\n" +
+	"negotiable\x18\a \x01(\bR\n" +
+	"negotiable\x12\x1a\n" +
+	"\blatitude\x18\b \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\t \x01(\x01R\tlongitude\x12\x14\n" +
+	"\x05draft\x18\n" +
+	" \x01(\bR\x05draft\x12?\n" +
+	"\favailability\x18\v \x01(\v2\x1b.listing.AvailabilityWindowR\favailability\x12\x1a\n" +
+	"\bquantity\x18\f \x01(\x03R\bquantity\"v\n" +
+	"\x12AvailabilityWindow\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x120\n" +
+	"\x05until\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05until\"\xe9\x02\n" +
 	"\x14UpdateListingRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1f\n" +
@@ -1114,12 +3081,21 @@ const file_api_proto_listing_listing_proto_rawDesc = "" +
 	"\x05title\x18\x04 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x14\n" +
 	"\x05price\x18\x06 \x01(\x01R\x05price\x12\x16\n" +
-	"\x06status\x18\a \x01(\tR\x06status\"?\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12#\n" +
+	"\n" +
+	"negotiable\x18\b \x01(\bH\x00R\n" +
+	"negotiable\x88\x01\x01\x12?\n" +
+	"\favailability\x18\t \x01(\v2\x1b.listing.AvailabilityWindowR\favailability\x12\x1f\n" +
+	"\bquantity\x18\n" +
+	" \x01(\x03H\x01R\bquantity\x88\x01\x01B\r\n" +
+	"\v_negotiableB\v\n" +
+	"\t_quantity\"?\n" +
 	"\x14DeleteListingRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\"#\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"L\n" +
 	"\x11GetListingRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\xcf\x02\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0finclude_deleted\x18\x02 \x01(\bR\x0eincludeDeleted\"\xb6\x05\n" +
 	"\x0fListingResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1f\n" +
@@ -1134,7 +3110,20 @@ const file_api_proto_listing_listing_proto_rawDesc = "" +
 	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
 	"updated_at\x18\n" +
-	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x9b\x02\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1e\n" +
+	"\n" +
+	"negotiable\x18\v \x01(\bR\n" +
+	"negotiable\x12-\n" +
+	"\x12unavailable_reason\x18\f \x01(\tR\x11unavailableReason\x12\x1a\n" +
+	"\blatitude\x18\r \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x0e \x01(\x01R\tlongitude\x12\x1d\n" +
+	"\n" +
+	"view_count\x18\x0f \x01(\x03R\tviewCount\x129\n" +
+	"\n" +
+	"deleted_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12%\n" +
+	"\x0efavorite_count\x18\x11 \x01(\x03R\rfavoriteCount\x12?\n" +
+	"\favailability\x18\x12 \x01(\v2\x1b.listing.AvailabilityWindowR\favailability\x12\x1a\n" +
+	"\bquantity\x18\x13 \x01(\x03R\bquantity\"\xc7\x03\n" +
 	"\x15SearchListingsRequest\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1b\n" +
 	"\tmin_price\x18\x02 \x01(\x01R\bminPrice\x12\x1b\n" +
@@ -1148,7 +3137,14 @@ const file_api_proto_listing_listing_proto_rawDesc = "" +
 	"\asort_by\x18\t \x01(\tR\x06sortBy\x12\x1d\n" +
 	"\n" +
 	"sort_order\x18\n" +
-	" \x01(\tR\tsortOrder\"\x8e\x01\n" +
+	" \x01(\tR\tsortOrder\x12\x1d\n" +
+	"\n" +
+	"center_lat\x18\v \x01(\x01R\tcenterLat\x12\x1d\n" +
+	"\n" +
+	"center_lng\x18\f \x01(\x01R\tcenterLng\x12\x1b\n" +
+	"\tradius_km\x18\r \x01(\x01R\bradiusKm\x12&\n" +
+	"\x0fexclude_user_id\x18\x0e \x01(\tR\rexcludeUserId\x12'\n" +
+	"\x0finclude_deleted\x18\x0f \x01(\bR\x0eincludeDeleted\"\x8e\x01\n" +
 	"\x16SearchListingsResponse\x124\n" +
 	"\blistings\x18\x01 \x03(\v2\x18.listing.ListingResponseR\blistings\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x12\n" +
@@ -1161,11 +3157,72 @@ const file_api_proto_listing_listing_proto_rawDesc = "" +
 	"\tfile_name\x18\x03 \x01(\tR\bfileName\x12\x12\n" +
 	"\x04data\x18\x04 \x01(\fR\x04data\"2\n" +
 	"\x13UploadPhotoResponse\x12\x1b\n" +
-	"\tphoto_url\x18\x01 \x01(\tR\bphotoUrl\"N\n" +
+	"\tphoto_url\x18\x01 \x01(\tR\bphotoUrl\"t\n" +
+	"\x1dGeneratePhotoUploadURLRequest\x12\x1d\n" +
+	"\n" +
+	"listing_id\x18\x01 \x01(\tR\tlistingId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tfile_name\x18\x03 \x01(\tR\bfileName\"\x97\x01\n" +
+	"\x1eGeneratePhotoUploadURLResponse\x12\x1d\n" +
+	"\n" +
+	"upload_url\x18\x01 \x01(\tR\tuploadUrl\x12\x1b\n" +
+	"\tphoto_url\x18\x02 \x01(\tR\bphotoUrl\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"p\n" +
+	"\x19ConfirmPhotoUploadRequest\x12\x1d\n" +
+	"\n" +
+	"listing_id\x18\x01 \x01(\tR\tlistingId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tphoto_url\x18\x03 \x01(\tR\bphotoUrl\"i\n" +
+	"\x12DeletePhotoRequest\x12\x1d\n" +
+	"\n" +
+	"listing_id\x18\x01 \x01(\tR\tlistingId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tphoto_url\x18\x03 \x01(\tR\bphotoUrl\"m\n" +
+	"\x14ReorderPhotosRequest\x12\x1d\n" +
+	"\n" +
+	"listing_id\x18\x01 \x01(\tR\tlistingId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"photo_urls\x18\x03 \x03(\tR\tphotoUrls\"N\n" +
 	"\x15ListingStatusResponse\x12\x1d\n" +
 	"\n" +
 	"listing_id\x18\x01 \x01(\tR\tlistingId\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\tR\x06status\"L\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\",\n" +
+	"\x18GetListingsStatusRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"\xd7\x01\n" +
+	"\x13ListingAvailability\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\x01R\x05price\x12\x1c\n" +
+	"\tavailable\x18\x03 \x01(\bR\tavailable\x12?\n" +
+	"\favailability\x18\x04 \x01(\v2\x1b.listing.AvailabilityWindowR\favailability\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bquantity\x18\x06 \x01(\x03R\bquantity\"\xc4\x01\n" +
+	"\x19GetListingsStatusResponse\x12L\n" +
+	"\bstatuses\x18\x01 \x03(\v20.listing.GetListingsStatusResponse.StatusesEntryR\bstatuses\x1aY\n" +
+	"\rStatusesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x122\n" +
+	"\x05value\x18\x02 \x01(\v2\x1c.listing.ListingAvailabilityR\x05value:\x028\x01\"s\n" +
+	"\x13ReserveStockRequest\x12\x1d\n" +
+	"\n" +
+	"listing_id\x18\x01 \x01(\tR\tlistingId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\x12!\n" +
+	"\forder_number\x18\x03 \x01(\tR\vorderNumber\"4\n" +
+	"\x14ReserveStockResponse\x12\x1c\n" +
+	"\tremaining\x18\x01 \x01(\x03R\tremaining\"W\n" +
+	"\x13ReleaseStockRequest\x12\x1d\n" +
+	"\n" +
+	"listing_id\x18\x01 \x01(\tR\tlistingId\x12!\n" +
+	"\forder_number\x18\x02 \x01(\tR\vorderNumber\"+\n" +
+	"\x17BatchGetListingsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"P\n" +
+	"\x18BatchGetListingsResponse\x124\n" +
+	"\blistings\x18\x01 \x03(\v2\x18.listing.ListingResponseR\blistings\"I\n" +
+	"\x18GetRecentlyViewedRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"Q\n" +
+	"\x19GetRecentlyViewedResponse\x124\n" +
+	"\blistings\x18\x01 \x03(\v2\x18.listing.ListingResponseR\blistings\"L\n" +
 	"\x12AddFavoriteRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
 	"\n" +
@@ -1173,12 +3230,19 @@ const file_api_proto_listing_listing_proto_rawDesc = "" +
 	"\x15RemoveFavoriteRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
 	"\n" +
-	"listing_id\x18\x02 \x01(\tR\tlistingId\".\n" +
+	"listing_id\x18\x02 \x01(\tR\tlistingId\"\x83\x01\n" +
 	"\x13GetFavoritesRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"7\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12)\n" +
+	"\x10include_listings\x18\x02 \x01(\bR\x0fincludeListings\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"\xad\x01\n" +
 	"\x14GetFavoritesResponse\x12\x1f\n" +
 	"\vlisting_ids\x18\x01 \x03(\tR\n" +
-	"listingIds\"F\n" +
+	"listingIds\x124\n" +
+	"\blistings\x18\x02 \x03(\v2\x18.listing.ListingResponseR\blistings\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\"F\n" +
 	"\x11PhotoURLsResponse\x12\x1d\n" +
 	"\n" +
 	"listing_id\x18\x01 \x01(\tR\tlistingId\x12\x12\n" +
@@ -1186,20 +3250,108 @@ const file_api_proto_listing_listing_proto_rawDesc = "" +
 	"\x1aUpdateListingStatusRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
-	"\x06status\x18\x03 \x01(\tR\x06status2\x82\a\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\"q\n" +
+	"\x16MarkUnavailableRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"@\n" +
+	"\x15PublishListingRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"B\n" +
+	"\x17DuplicateListingRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"b\n" +
+	"\x10MakeOfferRequest\x12\x1d\n" +
+	"\n" +
+	"listing_id\x18\x01 \x01(\tR\tlistingId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\"{\n" +
+	"\x15RespondToOfferRequest\x12\x19\n" +
+	"\boffer_id\x18\x01 \x01(\tR\aofferId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\"K\n" +
+	"\x11ListOffersRequest\x12\x1d\n" +
+	"\n" +
+	"listing_id\x18\x01 \x01(\tR\tlistingId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x85\x03\n" +
+	"\rOfferResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"listing_id\x18\x02 \x01(\tR\tlistingId\x12\x19\n" +
+	"\bbuyer_id\x18\x03 \x01(\tR\abuyerId\x12\x1b\n" +
+	"\tseller_id\x18\x04 \x01(\tR\bsellerId\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x01R\x06amount\x12%\n" +
+	"\x0ecounter_amount\x18\x06 \x01(\x01R\rcounterAmount\x12!\n" +
+	"\fagreed_price\x18\a \x01(\x01R\vagreedPrice\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"last_actor\x18\v \x01(\tR\tlastActor\"D\n" +
+	"\x12ListOffersResponse\x12.\n" +
+	"\x06offers\x18\x01 \x03(\v2\x16.listing.OfferResponseR\x06offers\"M\n" +
+	"\x15CreateCategoryRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\"$\n" +
+	"\x12GetCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"]\n" +
+	"\x15UpdateCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\"'\n" +
+	"\x15DeleteCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xce\x01\n" +
+	"\x10CategoryResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"S\n" +
+	"\x16ListCategoriesResponse\x129\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x19.listing.CategoryResponseR\n" +
+	"categories2\xfe\x12\n" +
 	"\x0eListingService\x12H\n" +
 	"\rCreateListing\x12\x1d.listing.CreateListingRequest\x1a\x18.listing.ListingResponse\x12H\n" +
 	"\rUpdateListing\x12\x1d.listing.UpdateListingRequest\x1a\x18.listing.ListingResponse\x12>\n" +
-	"\rDeleteListing\x12\x1d.listing.DeleteListingRequest\x1a\x0e.listing.Empty\x12F\n" +
+	"\rDeleteListing\x12\x1d.listing.DeleteListingRequest\x1a\x0e.listing.Empty\x12J\n" +
+	"\x0ePublishListing\x12\x1e.listing.PublishListingRequest\x1a\x18.listing.ListingResponse\x12F\n" +
 	"\x0eGetListingByID\x12\x1a.listing.GetListingRequest\x1a\x18.listing.ListingResponse\x12Q\n" +
 	"\x0eSearchListings\x12\x1e.listing.SearchListingsRequest\x1a\x1f.listing.SearchListingsResponse\x12H\n" +
-	"\vUploadPhoto\x12\x1b.listing.UploadPhotoRequest\x1a\x1c.listing.UploadPhotoResponse\x12N\n" +
+	"\vUploadPhoto\x12\x1b.listing.UploadPhotoRequest\x1a\x1c.listing.UploadPhotoResponse\x12i\n" +
+	"\x16GeneratePhotoUploadURL\x12&.listing.GeneratePhotoUploadURLRequest\x1a'.listing.GeneratePhotoUploadURLResponse\x12V\n" +
+	"\x12ConfirmPhotoUpload\x12\".listing.ConfirmPhotoUploadRequest\x1a\x1c.listing.UploadPhotoResponse\x12:\n" +
+	"\vDeletePhoto\x12\x1b.listing.DeletePhotoRequest\x1a\x0e.listing.Empty\x12>\n" +
+	"\rReorderPhotos\x12\x1d.listing.ReorderPhotosRequest\x1a\x0e.listing.Empty\x12N\n" +
 	"\x10GetListingStatus\x12\x1a.listing.GetListingRequest\x1a\x1e.listing.ListingStatusResponse\x12:\n" +
 	"\vAddFavorite\x12\x1b.listing.AddFavoriteRequest\x1a\x0e.listing.Empty\x12@\n" +
 	"\x0eRemoveFavorite\x12\x1e.listing.RemoveFavoriteRequest\x1a\x0e.listing.Empty\x12K\n" +
 	"\fGetFavorites\x12\x1c.listing.GetFavoritesRequest\x1a\x1d.listing.GetFavoritesResponse\x12F\n" +
 	"\fGetPhotoURLs\x12\x1a.listing.GetListingRequest\x1a\x1a.listing.PhotoURLsResponse\x12T\n" +
-	"\x13UpdateListingStatus\x12#.listing.UpdateListingStatusRequest\x1a\x18.listing.ListingResponseB\x1aZ\x18genproto/listing_serviceb\x06proto3"
+	"\x13UpdateListingStatus\x12#.listing.UpdateListingStatusRequest\x1a\x18.listing.ListingResponse\x12>\n" +
+	"\tMakeOffer\x12\x19.listing.MakeOfferRequest\x1a\x16.listing.OfferResponse\x12H\n" +
+	"\x0eRespondToOffer\x12\x1e.listing.RespondToOfferRequest\x1a\x16.listing.OfferResponse\x12E\n" +
+	"\n" +
+	"ListOffers\x12\x1a.listing.ListOffersRequest\x1a\x1b.listing.ListOffersResponse\x12Z\n" +
+	"\x11GetListingsStatus\x12!.listing.GetListingsStatusRequest\x1a\".listing.GetListingsStatusResponse\x12K\n" +
+	"\fReserveStock\x12\x1c.listing.ReserveStockRequest\x1a\x1d.listing.ReserveStockResponse\x12<\n" +
+	"\fReleaseStock\x12\x1c.listing.ReleaseStockRequest\x1a\x0e.listing.Empty\x12L\n" +
+	"\x0fMarkUnavailable\x12\x1f.listing.MarkUnavailableRequest\x1a\x18.listing.ListingResponse\x12N\n" +
+	"\x10DuplicateListing\x12 .listing.DuplicateListingRequest\x1a\x18.listing.ListingResponse\x12W\n" +
+	"\x10BatchGetListings\x12 .listing.BatchGetListingsRequest\x1a!.listing.BatchGetListingsResponse\x12Z\n" +
+	"\x11GetRecentlyViewed\x12!.listing.GetRecentlyViewedRequest\x1a\".listing.GetRecentlyViewedResponse\x12K\n" +
+	"\x0eCreateCategory\x12\x1e.listing.CreateCategoryRequest\x1a\x19.listing.CategoryResponse\x12E\n" +
+	"\vGetCategory\x12\x1b.listing.GetCategoryRequest\x1a\x19.listing.CategoryResponse\x12A\n" +
+	"\x0eListCategories\x12\x0e.listing.Empty\x1a\x1f.listing.ListCategoriesResponse\x12K\n" +
+	"\x0eUpdateCategory\x12\x1e.listing.UpdateCategoryRequest\x1a\x19.listing.CategoryResponse\x12@\n" +
+	"\x0eDeleteCategory\x12\x1e.listing.DeleteCategoryRequest\x1a\x0e.listing.EmptyB\x1aZ\x18genproto/listing_serviceb\x06proto3"
 
 var (
 	file_api_proto_listing_listing_proto_rawDescOnce sync.Once
@@ -1213,60 +3365,150 @@ func file_api_proto_listing_listing_proto_rawDescGZIP() []byte {
 	return file_api_proto_listing_listing_proto_rawDescData
 }
 
-var file_api_proto_listing_listing_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_api_proto_listing_listing_proto_msgTypes = make([]protoimpl.MessageInfo, 48)
 var file_api_proto_listing_listing_proto_goTypes = []any{
-	(*Empty)(nil),                      // 0: listing.Empty
-	(*CreateListingRequest)(nil),       // 1: listing.CreateListingRequest
-	(*UpdateListingRequest)(nil),       // 2: listing.UpdateListingRequest
-	(*DeleteListingRequest)(nil),       // 3: listing.DeleteListingRequest
-	(*GetListingRequest)(nil),          // 4: listing.GetListingRequest
-	(*ListingResponse)(nil),            // 5: listing.ListingResponse
-	(*SearchListingsRequest)(nil),      // 6: listing.SearchListingsRequest
-	(*SearchListingsResponse)(nil),     // 7: listing.SearchListingsResponse
-	(*UploadPhotoRequest)(nil),         // 8: listing.UploadPhotoRequest
-	(*UploadPhotoResponse)(nil),        // 9: listing.UploadPhotoResponse
-	(*ListingStatusResponse)(nil),      // 10: listing.ListingStatusResponse
-	(*AddFavoriteRequest)(nil),         // 11: listing.AddFavoriteRequest
-	(*RemoveFavoriteRequest)(nil),      // 12: listing.RemoveFavoriteRequest
-	(*GetFavoritesRequest)(nil),        // 13: listing.GetFavoritesRequest
-	(*GetFavoritesResponse)(nil),       // 14: listing.GetFavoritesResponse
-	(*PhotoURLsResponse)(nil),          // 15: listing.PhotoURLsResponse
-	(*UpdateListingStatusRequest)(nil), // 16: listing.UpdateListingStatusRequest
-	(*timestamppb.Timestamp)(nil),      // 17: google.protobuf.Timestamp
+	(*Empty)(nil),                          // 0: listing.Empty
+	(*CreateListingRequest)(nil),           // 1: listing.CreateListingRequest
+	(*AvailabilityWindow)(nil),             // 2: listing.AvailabilityWindow
+	(*UpdateListingRequest)(nil),           // 3: listing.UpdateListingRequest
+	(*DeleteListingRequest)(nil),           // 4: listing.DeleteListingRequest
+	(*GetListingRequest)(nil),              // 5: listing.GetListingRequest
+	(*ListingResponse)(nil),                // 6: listing.ListingResponse
+	(*SearchListingsRequest)(nil),          // 7: listing.SearchListingsRequest
+	(*SearchListingsResponse)(nil),         // 8: listing.SearchListingsResponse
+	(*UploadPhotoRequest)(nil),             // 9: listing.UploadPhotoRequest
+	(*UploadPhotoResponse)(nil),            // 10: listing.UploadPhotoResponse
+	(*GeneratePhotoUploadURLRequest)(nil),  // 11: listing.GeneratePhotoUploadURLRequest
+	(*GeneratePhotoUploadURLResponse)(nil), // 12: listing.GeneratePhotoUploadURLResponse
+	(*ConfirmPhotoUploadRequest)(nil),      // 13: listing.ConfirmPhotoUploadRequest
+	(*DeletePhotoRequest)(nil),             // 14: listing.DeletePhotoRequest
+	(*ReorderPhotosRequest)(nil),           // 15: listing.ReorderPhotosRequest
+	(*ListingStatusResponse)(nil),          // 16: listing.ListingStatusResponse
+	(*GetListingsStatusRequest)(nil),       // 17: listing.GetListingsStatusRequest
+	(*ListingAvailability)(nil),            // 18: listing.ListingAvailability
+	(*GetListingsStatusResponse)(nil),      // 19: listing.GetListingsStatusResponse
+	(*ReserveStockRequest)(nil),            // 20: listing.ReserveStockRequest
+	(*ReserveStockResponse)(nil),           // 21: listing.ReserveStockResponse
+	(*ReleaseStockRequest)(nil),            // 22: listing.ReleaseStockRequest
+	(*BatchGetListingsRequest)(nil),        // 23: listing.BatchGetListingsRequest
+	(*BatchGetListingsResponse)(nil),       // 24: listing.BatchGetListingsResponse
+	(*GetRecentlyViewedRequest)(nil),       // 25: listing.GetRecentlyViewedRequest
+	(*GetRecentlyViewedResponse)(nil),      // 26: listing.GetRecentlyViewedResponse
+	(*AddFavoriteRequest)(nil),             // 27: listing.AddFavoriteRequest
+	(*RemoveFavoriteRequest)(nil),          // 28: listing.RemoveFavoriteRequest
+	(*GetFavoritesRequest)(nil),            // 29: listing.GetFavoritesRequest
+	(*GetFavoritesResponse)(nil),           // 30: listing.GetFavoritesResponse
+	(*PhotoURLsResponse)(nil),              // 31: listing.PhotoURLsResponse
+	(*UpdateListingStatusRequest)(nil),     // 32: listing.UpdateListingStatusRequest
+	(*MarkUnavailableRequest)(nil),         // 33: listing.MarkUnavailableRequest
+	(*PublishListingRequest)(nil),          // 34: listing.PublishListingRequest
+	(*DuplicateListingRequest)(nil),        // 35: listing.DuplicateListingRequest
+	(*MakeOfferRequest)(nil),               // 36: listing.MakeOfferRequest
+	(*RespondToOfferRequest)(nil),          // 37: listing.RespondToOfferRequest
+	(*ListOffersRequest)(nil),              // 38: listing.ListOffersRequest
+	(*OfferResponse)(nil),                  // 39: listing.OfferResponse
+	(*ListOffersResponse)(nil),             // 40: listing.ListOffersResponse
+	(*CreateCategoryRequest)(nil),          // 41: listing.CreateCategoryRequest
+	(*GetCategoryRequest)(nil),             // 42: listing.GetCategoryRequest
+	(*UpdateCategoryRequest)(nil),          // 43: listing.UpdateCategoryRequest
+	(*DeleteCategoryRequest)(nil),          // 44: listing.DeleteCategoryRequest
+	(*CategoryResponse)(nil),               // 45: listing.CategoryResponse
+	(*ListCategoriesResponse)(nil),         // 46: listing.ListCategoriesResponse
+	nil,                                    // 47: listing.GetListingsStatusResponse.StatusesEntry
+	(*timestamppb.Timestamp)(nil),          // 48: google.protobuf.Timestamp
 }
 var file_api_proto_listing_listing_proto_depIdxs = []int32{
-	17, // 0: listing.ListingResponse.created_at:type_name -> google.protobuf.Timestamp
-	17, // 1: listing.ListingResponse.updated_at:type_name -> google.protobuf.Timestamp
-	5,  // 2: listing.SearchListingsResponse.listings:type_name -> listing.ListingResponse
-	1,  // 3: listing.ListingService.CreateListing:input_type -> listing.CreateListingRequest
-	2,  // 4: listing.ListingService.UpdateListing:input_type -> listing.UpdateListingRequest
-	3,  // 5: listing.ListingService.DeleteListing:input_type -> listing.DeleteListingRequest
-	4,  // 6: listing.ListingService.GetListingByID:input_type -> listing.GetListingRequest
-	6,  // 7: listing.ListingService.SearchListings:input_type -> listing.SearchListingsRequest
-	8,  // 8: listing.ListingService.UploadPhoto:input_type -> listing.UploadPhotoRequest
-	4,  // 9: listing.ListingService.GetListingStatus:input_type -> listing.GetListingRequest
-	11, // 10: listing.ListingService.AddFavorite:input_type -> listing.AddFavoriteRequest
-	12, // 11: listing.ListingService.RemoveFavorite:input_type -> listing.RemoveFavoriteRequest
-	13, // 12: listing.ListingService.GetFavorites:input_type -> listing.GetFavoritesRequest
-	4,  // 13: listing.ListingService.GetPhotoURLs:input_type -> listing.GetListingRequest
-	16, // 14: listing.ListingService.UpdateListingStatus:input_type -> listing.UpdateListingStatusRequest
-	5,  // 15: listing.ListingService.CreateListing:output_type -> listing.ListingResponse
-	5,  // 16: listing.ListingService.UpdateListing:output_type -> listing.ListingResponse
-	0,  // 17: listing.ListingService.DeleteListing:output_type -> listing.Empty
-	5,  // 18: listing.ListingService.GetListingByID:output_type -> listing.ListingResponse
-	7,  // 19: listing.ListingService.SearchListings:output_type -> listing.SearchListingsResponse
-	9,  // 20: listing.ListingService.UploadPhoto:output_type -> listing.UploadPhotoResponse
-	10, // 21: listing.ListingService.GetListingStatus:output_type -> listing.ListingStatusResponse
-	0,  // 22: listing.ListingService.AddFavorite:output_type -> listing.Empty
-	0,  // 23: listing.ListingService.RemoveFavorite:output_type -> listing.Empty
-	14, // 24: listing.ListingService.GetFavorites:output_type -> listing.GetFavoritesResponse
-	15, // 25: listing.ListingService.GetPhotoURLs:output_type -> listing.PhotoURLsResponse
-	5,  // 26: listing.ListingService.UpdateListingStatus:output_type -> listing.ListingResponse
-	15, // [15:27] is the sub-list for method output_type
-	3,  // [3:15] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	2,  // 0: listing.CreateListingRequest.availability:type_name -> listing.AvailabilityWindow
+	48, // 1: listing.AvailabilityWindow.from:type_name -> google.protobuf.Timestamp
+	48, // 2: listing.AvailabilityWindow.until:type_name -> google.protobuf.Timestamp
+	2,  // 3: listing.UpdateListingRequest.availability:type_name -> listing.AvailabilityWindow
+	48, // 4: listing.ListingResponse.created_at:type_name -> google.protobuf.Timestamp
+	48, // 5: listing.ListingResponse.updated_at:type_name -> google.protobuf.Timestamp
+	48, // 6: listing.ListingResponse.deleted_at:type_name -> google.protobuf.Timestamp
+	2,  // 7: listing.ListingResponse.availability:type_name -> listing.AvailabilityWindow
+	6,  // 8: listing.SearchListingsResponse.listings:type_name -> listing.ListingResponse
+	48, // 9: listing.GeneratePhotoUploadURLResponse.expires_at:type_name -> google.protobuf.Timestamp
+	2,  // 10: listing.ListingAvailability.availability:type_name -> listing.AvailabilityWindow
+	47, // 11: listing.GetListingsStatusResponse.statuses:type_name -> listing.GetListingsStatusResponse.StatusesEntry
+	6,  // 12: listing.BatchGetListingsResponse.listings:type_name -> listing.ListingResponse
+	6,  // 13: listing.GetRecentlyViewedResponse.listings:type_name -> listing.ListingResponse
+	6,  // 14: listing.GetFavoritesResponse.listings:type_name -> listing.ListingResponse
+	48, // 15: listing.OfferResponse.created_at:type_name -> google.protobuf.Timestamp
+	48, // 16: listing.OfferResponse.updated_at:type_name -> google.protobuf.Timestamp
+	39, // 17: listing.ListOffersResponse.offers:type_name -> listing.OfferResponse
+	48, // 18: listing.CategoryResponse.created_at:type_name -> google.protobuf.Timestamp
+	48, // 19: listing.CategoryResponse.updated_at:type_name -> google.protobuf.Timestamp
+	45, // 20: listing.ListCategoriesResponse.categories:type_name -> listing.CategoryResponse
+	18, // 21: listing.GetListingsStatusResponse.StatusesEntry.value:type_name -> listing.ListingAvailability
+	1,  // 22: listing.ListingService.CreateListing:input_type -> listing.CreateListingRequest
+	3,  // 23: listing.ListingService.UpdateListing:input_type -> listing.UpdateListingRequest
+	4,  // 24: listing.ListingService.DeleteListing:input_type -> listing.DeleteListingRequest
+	34, // 25: listing.ListingService.PublishListing:input_type -> listing.PublishListingRequest
+	5,  // 26: listing.ListingService.GetListingByID:input_type -> listing.GetListingRequest
+	7,  // 27: listing.ListingService.SearchListings:input_type -> listing.SearchListingsRequest
+	9,  // 28: listing.ListingService.UploadPhoto:input_type -> listing.UploadPhotoRequest
+	11, // 29: listing.ListingService.GeneratePhotoUploadURL:input_type -> listing.GeneratePhotoUploadURLRequest
+	13, // 30: listing.ListingService.ConfirmPhotoUpload:input_type -> listing.ConfirmPhotoUploadRequest
+	14, // 31: listing.ListingService.DeletePhoto:input_type -> listing.DeletePhotoRequest
+	15, // 32: listing.ListingService.ReorderPhotos:input_type -> listing.ReorderPhotosRequest
+	5,  // 33: listing.ListingService.GetListingStatus:input_type -> listing.GetListingRequest
+	27, // 34: listing.ListingService.AddFavorite:input_type -> listing.AddFavoriteRequest
+	28, // 35: listing.ListingService.RemoveFavorite:input_type -> listing.RemoveFavoriteRequest
+	29, // 36: listing.ListingService.GetFavorites:input_type -> listing.GetFavoritesRequest
+	5,  // 37: listing.ListingService.GetPhotoURLs:input_type -> listing.GetListingRequest
+	32, // 38: listing.ListingService.UpdateListingStatus:input_type -> listing.UpdateListingStatusRequest
+	36, // 39: listing.ListingService.MakeOffer:input_type -> listing.MakeOfferRequest
+	37, // 40: listing.ListingService.RespondToOffer:input_type -> listing.RespondToOfferRequest
+	38, // 41: listing.ListingService.ListOffers:input_type -> listing.ListOffersRequest
+	17, // 42: listing.ListingService.GetListingsStatus:input_type -> listing.GetListingsStatusRequest
+	20, // 43: listing.ListingService.ReserveStock:input_type -> listing.ReserveStockRequest
+	22, // 44: listing.ListingService.ReleaseStock:input_type -> listing.ReleaseStockRequest
+	33, // 45: listing.ListingService.MarkUnavailable:input_type -> listing.MarkUnavailableRequest
+	35, // 46: listing.ListingService.DuplicateListing:input_type -> listing.DuplicateListingRequest
+	23, // 47: listing.ListingService.BatchGetListings:input_type -> listing.BatchGetListingsRequest
+	25, // 48: listing.ListingService.GetRecentlyViewed:input_type -> listing.GetRecentlyViewedRequest
+	41, // 49: listing.ListingService.CreateCategory:input_type -> listing.CreateCategoryRequest
+	42, // 50: listing.ListingService.GetCategory:input_type -> listing.GetCategoryRequest
+	0,  // 51: listing.ListingService.ListCategories:input_type -> listing.Empty
+	43, // 52: listing.ListingService.UpdateCategory:input_type -> listing.UpdateCategoryRequest
+	44, // 53: listing.ListingService.DeleteCategory:input_type -> listing.DeleteCategoryRequest
+	6,  // 54: listing.ListingService.CreateListing:output_type -> listing.ListingResponse
+	6,  // 55: listing.ListingService.UpdateListing:output_type -> listing.ListingResponse
+	0,  // 56: listing.ListingService.DeleteListing:output_type -> listing.Empty
+	6,  // 57: listing.ListingService.PublishListing:output_type -> listing.ListingResponse
+	6,  // 58: listing.ListingService.GetListingByID:output_type -> listing.ListingResponse
+	8,  // 59: listing.ListingService.SearchListings:output_type -> listing.SearchListingsResponse
+	10, // 60: listing.ListingService.UploadPhoto:output_type -> listing.UploadPhotoResponse
+	12, // 61: listing.ListingService.GeneratePhotoUploadURL:output_type -> listing.GeneratePhotoUploadURLResponse
+	10, // 62: listing.ListingService.ConfirmPhotoUpload:output_type -> listing.UploadPhotoResponse
+	0,  // 63: listing.ListingService.DeletePhoto:output_type -> listing.Empty
+	0,  // 64: listing.ListingService.ReorderPhotos:output_type -> listing.Empty
+	16, // 65: listing.ListingService.GetListingStatus:output_type -> listing.ListingStatusResponse
+	0,  // 66: listing.ListingService.AddFavorite:output_type -> listing.Empty
+	0,  // 67: listing.ListingService.RemoveFavorite:output_type -> listing.Empty
+	30, // 68: listing.ListingService.GetFavorites:output_type -> listing.GetFavoritesResponse
+	31, // 69: listing.ListingService.GetPhotoURLs:output_type -> listing.PhotoURLsResponse
+	6,  // 70: listing.ListingService.UpdateListingStatus:output_type -> listing.ListingResponse
+	39, // 71: listing.ListingService.MakeOffer:output_type -> listing.OfferResponse
+	39, // 72: listing.ListingService.RespondToOffer:output_type -> listing.OfferResponse
+	40, // 73: listing.ListingService.ListOffers:output_type -> listing.ListOffersResponse
+	19, // 74: listing.ListingService.GetListingsStatus:output_type -> listing.GetListingsStatusResponse
+	21, // 75: listing.ListingService.ReserveStock:output_type -> listing.ReserveStockResponse
+	0,  // 76: listing.ListingService.ReleaseStock:output_type -> listing.Empty
+	6,  // 77: listing.ListingService.MarkUnavailable:output_type -> listing.ListingResponse
+	6,  // 78: listing.ListingService.DuplicateListing:output_type -> listing.ListingResponse
+	24, // 79: listing.ListingService.BatchGetListings:output_type -> listing.BatchGetListingsResponse
+	26, // 80: listing.ListingService.GetRecentlyViewed:output_type -> listing.GetRecentlyViewedResponse
+	45, // 81: listing.ListingService.CreateCategory:output_type -> listing.CategoryResponse
+	45, // 82: listing.ListingService.GetCategory:output_type -> listing.CategoryResponse
+	46, // 83: listing.ListingService.ListCategories:output_type -> listing.ListCategoriesResponse
+	45, // 84: listing.ListingService.UpdateCategory:output_type -> listing.CategoryResponse
+	0,  // 85: listing.ListingService.DeleteCategory:output_type -> listing.Empty
+	54, // [54:86] is the sub-list for method output_type
+	22, // [22:54] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_api_proto_listing_listing_proto_init() }
@@ -1274,13 +3516,14 @@ func file_api_proto_listing_listing_proto_init() {
 	if File_api_proto_listing_listing_proto != nil {
 		return
 	}
+	file_api_proto_listing_listing_proto_msgTypes[3].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_listing_listing_proto_rawDesc), len(file_api_proto_listing_listing_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   17,
+			NumMessages:   48,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,18 +19,38 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ListingService_CreateListing_FullMethodName       = "/listing.ListingService/CreateListing"
-	ListingService_UpdateListing_FullMethodName       = "/listing.ListingService/UpdateListing"
-	ListingService_DeleteListing_FullMethodName       = "/listing.ListingService/DeleteListing"
-	ListingService_GetListingByID_FullMethodName      = "/listing.ListingService/GetListingByID"
-	ListingService_SearchListings_FullMethodName      = "/listing.ListingService/SearchListings"
-	ListingService_UploadPhoto_FullMethodName         = "/listing.ListingService/UploadPhoto"
-	ListingService_GetListingStatus_FullMethodName    = "/listing.ListingService/GetListingStatus"
-	ListingService_AddFavorite_FullMethodName         = "/listing.ListingService/AddFavorite"
-	ListingService_RemoveFavorite_FullMethodName      = "/listing.ListingService/RemoveFavorite"
-	ListingService_GetFavorites_FullMethodName        = "/listing.ListingService/GetFavorites"
-	ListingService_GetPhotoURLs_FullMethodName        = "/listing.ListingService/GetPhotoURLs"
-	ListingService_UpdateListingStatus_FullMethodName = "/listing.ListingService/UpdateListingStatus"
+	ListingService_CreateListing_FullMethodName          = "/listing.ListingService/CreateListing"
+	ListingService_UpdateListing_FullMethodName          = "/listing.ListingService/UpdateListing"
+	ListingService_DeleteListing_FullMethodName          = "/listing.ListingService/DeleteListing"
+	ListingService_PublishListing_FullMethodName         = "/listing.ListingService/PublishListing"
+	ListingService_GetListingByID_FullMethodName         = "/listing.ListingService/GetListingByID"
+	ListingService_SearchListings_FullMethodName         = "/listing.ListingService/SearchListings"
+	ListingService_UploadPhoto_FullMethodName            = "/listing.ListingService/UploadPhoto"
+	ListingService_GeneratePhotoUploadURL_FullMethodName = "/listing.ListingService/GeneratePhotoUploadURL"
+	ListingService_ConfirmPhotoUpload_FullMethodName     = "/listing.ListingService/ConfirmPhotoUpload"
+	ListingService_DeletePhoto_FullMethodName            = "/listing.ListingService/DeletePhoto"
+	ListingService_ReorderPhotos_FullMethodName          = "/listing.ListingService/ReorderPhotos"
+	ListingService_GetListingStatus_FullMethodName       = "/listing.ListingService/GetListingStatus"
+	ListingService_AddFavorite_FullMethodName            = "/listing.ListingService/AddFavorite"
+	ListingService_RemoveFavorite_FullMethodName         = "/listing.ListingService/RemoveFavorite"
+	ListingService_GetFavorites_FullMethodName           = "/listing.ListingService/GetFavorites"
+	ListingService_GetPhotoURLs_FullMethodName           = "/listing.ListingService/GetPhotoURLs"
+	ListingService_UpdateListingStatus_FullMethodName    = "/listing.ListingService/UpdateListingStatus"
+	ListingService_MakeOffer_FullMethodName              = "/listing.ListingService/MakeOffer"
+	ListingService_RespondToOffer_FullMethodName         = "/listing.ListingService/RespondToOffer"
+	ListingService_ListOffers_FullMethodName             = "/listing.ListingService/ListOffers"
+	ListingService_GetListingsStatus_FullMethodName      = "/listing.ListingService/GetListingsStatus"
+	ListingService_ReserveStock_FullMethodName           = "/listing.ListingService/ReserveStock"
+	ListingService_ReleaseStock_FullMethodName           = "/listing.ListingService/ReleaseStock"
+	ListingService_MarkUnavailable_FullMethodName        = "/listing.ListingService/MarkUnavailable"
+	ListingService_DuplicateListing_FullMethodName       = "/listing.ListingService/DuplicateListing"
+	ListingService_BatchGetListings_FullMethodName       = "/listing.ListingService/BatchGetListings"
+	ListingService_GetRecentlyViewed_FullMethodName      = "/listing.ListingService/GetRecentlyViewed"
+	ListingService_CreateCategory_FullMethodName         = "/listing.ListingService/CreateCategory"
+	ListingService_GetCategory_FullMethodName            = "/listing.ListingService/GetCategory"
+	ListingService_ListCategories_FullMethodName         = "/listing.ListingService/ListCategories"
+	ListingService_UpdateCategory_FullMethodName         = "/listing.ListingService/UpdateCategory"
+	ListingService_DeleteCategory_FullMethodName         = "/listing.ListingService/DeleteCategory"
 )
 
 // ListingServiceClient is the client API for ListingService service.
@@ -40,15 +60,35 @@ type ListingServiceClient interface {
 	CreateListing(ctx context.Context, in *CreateListingRequest, opts ...grpc.CallOption) (*ListingResponse, error)
 	UpdateListing(ctx context.Context, in *UpdateListingRequest, opts ...grpc.CallOption) (*ListingResponse, error)
 	DeleteListing(ctx context.Context, in *DeleteListingRequest, opts ...grpc.CallOption) (*Empty, error)
+	PublishListing(ctx context.Context, in *PublishListingRequest, opts ...grpc.CallOption) (*ListingResponse, error)
 	GetListingByID(ctx context.Context, in *GetListingRequest, opts ...grpc.CallOption) (*ListingResponse, error)
 	SearchListings(ctx context.Context, in *SearchListingsRequest, opts ...grpc.CallOption) (*SearchListingsResponse, error)
 	UploadPhoto(ctx context.Context, in *UploadPhotoRequest, opts ...grpc.CallOption) (*UploadPhotoResponse, error)
+	GeneratePhotoUploadURL(ctx context.Context, in *GeneratePhotoUploadURLRequest, opts ...grpc.CallOption) (*GeneratePhotoUploadURLResponse, error)
+	ConfirmPhotoUpload(ctx context.Context, in *ConfirmPhotoUploadRequest, opts ...grpc.CallOption) (*UploadPhotoResponse, error)
+	DeletePhoto(ctx context.Context, in *DeletePhotoRequest, opts ...grpc.CallOption) (*Empty, error)
+	ReorderPhotos(ctx context.Context, in *ReorderPhotosRequest, opts ...grpc.CallOption) (*Empty, error)
 	GetListingStatus(ctx context.Context, in *GetListingRequest, opts ...grpc.CallOption) (*ListingStatusResponse, error)
 	AddFavorite(ctx context.Context, in *AddFavoriteRequest, opts ...grpc.CallOption) (*Empty, error)
 	RemoveFavorite(ctx context.Context, in *RemoveFavoriteRequest, opts ...grpc.CallOption) (*Empty, error)
 	GetFavorites(ctx context.Context, in *GetFavoritesRequest, opts ...grpc.CallOption) (*GetFavoritesResponse, error)
 	GetPhotoURLs(ctx context.Context, in *GetListingRequest, opts ...grpc.CallOption) (*PhotoURLsResponse, error)
 	UpdateListingStatus(ctx context.Context, in *UpdateListingStatusRequest, opts ...grpc.CallOption) (*ListingResponse, error)
+	MakeOffer(ctx context.Context, in *MakeOfferRequest, opts ...grpc.CallOption) (*OfferResponse, error)
+	RespondToOffer(ctx context.Context, in *RespondToOfferRequest, opts ...grpc.CallOption) (*OfferResponse, error)
+	ListOffers(ctx context.Context, in *ListOffersRequest, opts ...grpc.CallOption) (*ListOffersResponse, error)
+	GetListingsStatus(ctx context.Context, in *GetListingsStatusRequest, opts ...grpc.CallOption) (*GetListingsStatusResponse, error)
+	ReserveStock(ctx context.Context, in *ReserveStockRequest, opts ...grpc.CallOption) (*ReserveStockResponse, error)
+	ReleaseStock(ctx context.Context, in *ReleaseStockRequest, opts ...grpc.CallOption) (*Empty, error)
+	MarkUnavailable(ctx context.Context, in *MarkUnavailableRequest, opts ...grpc.CallOption) (*ListingResponse, error)
+	DuplicateListing(ctx context.Context, in *DuplicateListingRequest, opts ...grpc.CallOption) (*ListingResponse, error)
+	BatchGetListings(ctx context.Context, in *BatchGetListingsRequest, opts ...grpc.CallOption) (*BatchGetListingsResponse, error)
+	GetRecentlyViewed(ctx context.Context, in *GetRecentlyViewedRequest, opts ...grpc.CallOption) (*GetRecentlyViewedResponse, error)
+	CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*CategoryResponse, error)
+	GetCategory(ctx context.Context, in *GetCategoryRequest, opts ...grpc.CallOption) (*CategoryResponse, error)
+	ListCategories(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListCategoriesResponse, error)
+	UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*CategoryResponse, error)
+	DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*Empty, error)
 }
 
 type listingServiceClient struct {
@@ -89,6 +129,16 @@ func (c *listingServiceClient) DeleteListing(ctx context.Context, in *DeleteList
 	return out, nil
 }
 
+func (c *listingServiceClient) PublishListing(ctx context.Context, in *PublishListingRequest, opts ...grpc.CallOption) (*ListingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListingResponse)
+	err := c.cc.Invoke(ctx, ListingService_PublishListing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *listingServiceClient) GetListingByID(ctx context.Context, in *GetListingRequest, opts ...grpc.CallOption) (*ListingResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListingResponse)
@@ -119,6 +169,46 @@ func (c *listingServiceClient) UploadPhoto(ctx context.Context, in *UploadPhotoR
 	return out, nil
 }
 
+func (c *listingServiceClient) GeneratePhotoUploadURL(ctx context.Context, in *GeneratePhotoUploadURLRequest, opts ...grpc.CallOption) (*GeneratePhotoUploadURLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GeneratePhotoUploadURLResponse)
+	err := c.cc.Invoke(ctx, ListingService_GeneratePhotoUploadURL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingServiceClient) ConfirmPhotoUpload(ctx context.Context, in *ConfirmPhotoUploadRequest, opts ...grpc.CallOption) (*UploadPhotoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UploadPhotoResponse)
+	err := c.cc.Invoke(ctx, ListingService_ConfirmPhotoUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingServiceClient) DeletePhoto(ctx context.Context, in *DeletePhotoRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, ListingService_DeletePhoto_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingServiceClient) ReorderPhotos(ctx context.Context, in *ReorderPhotosRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, ListingService_ReorderPhotos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *listingServiceClient) GetListingStatus(ctx context.Context, in *GetListingRequest, opts ...grpc.CallOption) (*ListingStatusResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListingStatusResponse)
@@ -179,6 +269,156 @@ func (c *listingServiceClient) UpdateListingStatus(ctx context.Context, in *Upda
 	return out, nil
 }
 
+func (c *listingServiceClient) MakeOffer(ctx context.Context, in *MakeOfferRequest, opts ...grpc.CallOption) (*OfferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OfferResponse)
+	err := c.cc.Invoke(ctx, ListingService_MakeOffer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingServiceClient) RespondToOffer(ctx context.Context, in *RespondToOfferRequest, opts ...grpc.CallOption) (*OfferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OfferResponse)
+	err := c.cc.Invoke(ctx, ListingService_RespondToOffer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingServiceClient) ListOffers(ctx context.Context, in *ListOffersRequest, opts ...grpc.CallOption) (*ListOffersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOffersResponse)
+	err := c.cc.Invoke(ctx, ListingService_ListOffers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingServiceClient) GetListingsStatus(ctx context.Context, in *GetListingsStatusRequest, opts ...grpc.CallOption) (*GetListingsStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetListingsStatusResponse)
+	err := c.cc.Invoke(ctx, ListingService_GetListingsStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingServiceClient) ReserveStock(ctx context.Context, in *ReserveStockRequest, opts ...grpc.CallOption) (*ReserveStockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReserveStockResponse)
+	err := c.cc.Invoke(ctx, ListingService_ReserveStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingServiceClient) ReleaseStock(ctx context.Context, in *ReleaseStockRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, ListingService_ReleaseStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingServiceClient) MarkUnavailable(ctx context.Context, in *MarkUnavailableRequest, opts ...grpc.CallOption) (*ListingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListingResponse)
+	err := c.cc.Invoke(ctx, ListingService_MarkUnavailable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingServiceClient) DuplicateListing(ctx context.Context, in *DuplicateListingRequest, opts ...grpc.CallOption) (*ListingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListingResponse)
+	err := c.cc.Invoke(ctx, ListingService_DuplicateListing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingServiceClient) BatchGetListings(ctx context.Context, in *BatchGetListingsRequest, opts ...grpc.CallOption) (*BatchGetListingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetListingsResponse)
+	err := c.cc.Invoke(ctx, ListingService_BatchGetListings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingServiceClient) GetRecentlyViewed(ctx context.Context, in *GetRecentlyViewedRequest, opts ...grpc.CallOption) (*GetRecentlyViewedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRecentlyViewedResponse)
+	err := c.cc.Invoke(ctx, ListingService_GetRecentlyViewed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingServiceClient) CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*CategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryResponse)
+	err := c.cc.Invoke(ctx, ListingService_CreateCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingServiceClient) GetCategory(ctx context.Context, in *GetCategoryRequest, opts ...grpc.CallOption) (*CategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryResponse)
+	err := c.cc.Invoke(ctx, ListingService_GetCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingServiceClient) ListCategories(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCategoriesResponse)
+	err := c.cc.Invoke(ctx, ListingService_ListCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingServiceClient) UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*CategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryResponse)
+	err := c.cc.Invoke(ctx, ListingService_UpdateCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingServiceClient) DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, ListingService_DeleteCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ListingServiceServer is the server API for ListingService service.
 // All implementations must embed UnimplementedListingServiceServer
 // for forward compatibility.
@@ -186,15 +426,35 @@ type ListingServiceServer interface {
 	CreateListing(context.Context, *CreateListingRequest) (*ListingResponse, error)
 	UpdateListing(context.Context, *UpdateListingRequest) (*ListingResponse, error)
 	DeleteListing(context.Context, *DeleteListingRequest) (*Empty, error)
+	PublishListing(context.Context, *PublishListingRequest) (*ListingResponse, error)
 	GetListingByID(context.Context, *GetListingRequest) (*ListingResponse, error)
 	SearchListings(context.Context, *SearchListingsRequest) (*SearchListingsResponse, error)
 	UploadPhoto(context.Context, *UploadPhotoRequest) (*UploadPhotoResponse, error)
+	GeneratePhotoUploadURL(context.Context, *GeneratePhotoUploadURLRequest) (*GeneratePhotoUploadURLResponse, error)
+	ConfirmPhotoUpload(context.Context, *ConfirmPhotoUploadRequest) (*UploadPhotoResponse, error)
+	DeletePhoto(context.Context, *DeletePhotoRequest) (*Empty, error)
+	ReorderPhotos(context.Context, *ReorderPhotosRequest) (*Empty, error)
 	GetListingStatus(context.Context, *GetListingRequest) (*ListingStatusResponse, error)
 	AddFavorite(context.Context, *AddFavoriteRequest) (*Empty, error)
 	RemoveFavorite(context.Context, *RemoveFavoriteRequest) (*Empty, error)
 	GetFavorites(context.Context, *GetFavoritesRequest) (*GetFavoritesResponse, error)
 	GetPhotoURLs(context.Context, *GetListingRequest) (*PhotoURLsResponse, error)
 	UpdateListingStatus(context.Context, *UpdateListingStatusRequest) (*ListingResponse, error)
+	MakeOffer(context.Context, *MakeOfferRequest) (*OfferResponse, error)
+	RespondToOffer(context.Context, *RespondToOfferRequest) (*OfferResponse, error)
+	ListOffers(context.Context, *ListOffersRequest) (*ListOffersResponse, error)
+	GetListingsStatus(context.Context, *GetListingsStatusRequest) (*GetListingsStatusResponse, error)
+	ReserveStock(context.Context, *ReserveStockRequest) (*ReserveStockResponse, error)
+	ReleaseStock(context.Context, *ReleaseStockRequest) (*Empty, error)
+	MarkUnavailable(context.Context, *MarkUnavailableRequest) (*ListingResponse, error)
+	DuplicateListing(context.Context, *DuplicateListingRequest) (*ListingResponse, error)
+	BatchGetListings(context.Context, *BatchGetListingsRequest) (*BatchGetListingsResponse, error)
+	GetRecentlyViewed(context.Context, *GetRecentlyViewedRequest) (*GetRecentlyViewedResponse, error)
+	CreateCategory(context.Context, *CreateCategoryRequest) (*CategoryResponse, error)
+	GetCategory(context.Context, *GetCategoryRequest) (*CategoryResponse, error)
+	ListCategories(context.Context, *Empty) (*ListCategoriesResponse, error)
+	UpdateCategory(context.Context, *UpdateCategoryRequest) (*CategoryResponse, error)
+	DeleteCategory(context.Context, *DeleteCategoryRequest) (*Empty, error)
 	mustEmbedUnimplementedListingServiceServer()
 }
 
@@ -214,6 +474,9 @@ func (UnimplementedListingServiceServer) UpdateListing(context.Context, *UpdateL
 func (UnimplementedListingServiceServer) DeleteListing(context.Context, *DeleteListingRequest) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteListing not implemented")
 }
+func (UnimplementedListingServiceServer) PublishListing(context.Context, *PublishListingRequest) (*ListingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishListing not implemented")
+}
 func (UnimplementedListingServiceServer) GetListingByID(context.Context, *GetListingRequest) (*ListingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetListingByID not implemented")
 }
@@ -223,6 +486,18 @@ func (UnimplementedListingServiceServer) SearchListings(context.Context, *Search
 func (UnimplementedListingServiceServer) UploadPhoto(context.Context, *UploadPhotoRequest) (*UploadPhotoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UploadPhoto not implemented")
 }
+func (UnimplementedListingServiceServer) GeneratePhotoUploadURL(context.Context, *GeneratePhotoUploadURLRequest) (*GeneratePhotoUploadURLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GeneratePhotoUploadURL not implemented")
+}
+func (UnimplementedListingServiceServer) ConfirmPhotoUpload(context.Context, *ConfirmPhotoUploadRequest) (*UploadPhotoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmPhotoUpload not implemented")
+}
+func (UnimplementedListingServiceServer) DeletePhoto(context.Context, *DeletePhotoRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePhoto not implemented")
+}
+func (UnimplementedListingServiceServer) ReorderPhotos(context.Context, *ReorderPhotosRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderPhotos not implemented")
+}
 func (UnimplementedListingServiceServer) GetListingStatus(context.Context, *GetListingRequest) (*ListingStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetListingStatus not implemented")
 }
@@ -241,6 +516,51 @@ func (UnimplementedListingServiceServer) GetPhotoURLs(context.Context, *GetListi
 func (UnimplementedListingServiceServer) UpdateListingStatus(context.Context, *UpdateListingStatusRequest) (*ListingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateListingStatus not implemented")
 }
+func (UnimplementedListingServiceServer) MakeOffer(context.Context, *MakeOfferRequest) (*OfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MakeOffer not implemented")
+}
+func (UnimplementedListingServiceServer) RespondToOffer(context.Context, *RespondToOfferRequest) (*OfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespondToOffer not implemented")
+}
+func (UnimplementedListingServiceServer) ListOffers(context.Context, *ListOffersRequest) (*ListOffersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOffers not implemented")
+}
+func (UnimplementedListingServiceServer) GetListingsStatus(context.Context, *GetListingsStatusRequest) (*GetListingsStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListingsStatus not implemented")
+}
+func (UnimplementedListingServiceServer) ReserveStock(context.Context, *ReserveStockRequest) (*ReserveStockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveStock not implemented")
+}
+func (UnimplementedListingServiceServer) ReleaseStock(context.Context, *ReleaseStockRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseStock not implemented")
+}
+func (UnimplementedListingServiceServer) MarkUnavailable(context.Context, *MarkUnavailableRequest) (*ListingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkUnavailable not implemented")
+}
+func (UnimplementedListingServiceServer) DuplicateListing(context.Context, *DuplicateListingRequest) (*ListingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DuplicateListing not implemented")
+}
+func (UnimplementedListingServiceServer) BatchGetListings(context.Context, *BatchGetListingsRequest) (*BatchGetListingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetListings not implemented")
+}
+func (UnimplementedListingServiceServer) GetRecentlyViewed(context.Context, *GetRecentlyViewedRequest) (*GetRecentlyViewedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecentlyViewed not implemented")
+}
+func (UnimplementedListingServiceServer) CreateCategory(context.Context, *CreateCategoryRequest) (*CategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCategory not implemented")
+}
+func (UnimplementedListingServiceServer) GetCategory(context.Context, *GetCategoryRequest) (*CategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategory not implemented")
+}
+func (UnimplementedListingServiceServer) ListCategories(context.Context, *Empty) (*ListCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCategories not implemented")
+}
+func (UnimplementedListingServiceServer) UpdateCategory(context.Context, *UpdateCategoryRequest) (*CategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCategory not implemented")
+}
+func (UnimplementedListingServiceServer) DeleteCategory(context.Context, *DeleteCategoryRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategory not implemented")
+}
 func (UnimplementedListingServiceServer) mustEmbedUnimplementedListingServiceServer() {}
 func (UnimplementedListingServiceServer) testEmbeddedByValue()                        {}
 
@@ -316,6 +636,24 @@ func _ListingService_DeleteListing_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ListingService_PublishListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishListingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingServiceServer).PublishListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingService_PublishListing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingServiceServer).PublishListing(ctx, req.(*PublishListingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ListingService_GetListingByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetListingRequest)
 	if err := dec(in); err != nil {
@@ -370,6 +708,78 @@ func _ListingService_UploadPhoto_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ListingService_GeneratePhotoUploadURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeneratePhotoUploadURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingServiceServer).GeneratePhotoUploadURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingService_GeneratePhotoUploadURL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingServiceServer).GeneratePhotoUploadURL(ctx, req.(*GeneratePhotoUploadURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingService_ConfirmPhotoUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmPhotoUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingServiceServer).ConfirmPhotoUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingService_ConfirmPhotoUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingServiceServer).ConfirmPhotoUpload(ctx, req.(*ConfirmPhotoUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingService_DeletePhoto_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePhotoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingServiceServer).DeletePhoto(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingService_DeletePhoto_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingServiceServer).DeletePhoto(ctx, req.(*DeletePhotoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingService_ReorderPhotos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderPhotosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingServiceServer).ReorderPhotos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingService_ReorderPhotos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingServiceServer).ReorderPhotos(ctx, req.(*ReorderPhotosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ListingService_GetListingStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetListingRequest)
 	if err := dec(in); err != nil {
@@ -478,6 +888,276 @@ func _ListingService_UpdateListingStatus_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ListingService_MakeOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MakeOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingServiceServer).MakeOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingService_MakeOffer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingServiceServer).MakeOffer(ctx, req.(*MakeOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingService_RespondToOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondToOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingServiceServer).RespondToOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingService_RespondToOffer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingServiceServer).RespondToOffer(ctx, req.(*RespondToOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingService_ListOffers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOffersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingServiceServer).ListOffers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingService_ListOffers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingServiceServer).ListOffers(ctx, req.(*ListOffersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingService_GetListingsStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListingsStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingServiceServer).GetListingsStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingService_GetListingsStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingServiceServer).GetListingsStatus(ctx, req.(*GetListingsStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingService_ReserveStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingServiceServer).ReserveStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingService_ReserveStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingServiceServer).ReserveStock(ctx, req.(*ReserveStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingService_ReleaseStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingServiceServer).ReleaseStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingService_ReleaseStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingServiceServer).ReleaseStock(ctx, req.(*ReleaseStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingService_MarkUnavailable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkUnavailableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingServiceServer).MarkUnavailable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingService_MarkUnavailable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingServiceServer).MarkUnavailable(ctx, req.(*MarkUnavailableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingService_DuplicateListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DuplicateListingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingServiceServer).DuplicateListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingService_DuplicateListing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingServiceServer).DuplicateListing(ctx, req.(*DuplicateListingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingService_BatchGetListings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetListingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingServiceServer).BatchGetListings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingService_BatchGetListings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingServiceServer).BatchGetListings(ctx, req.(*BatchGetListingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingService_GetRecentlyViewed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecentlyViewedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingServiceServer).GetRecentlyViewed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingService_GetRecentlyViewed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingServiceServer).GetRecentlyViewed(ctx, req.(*GetRecentlyViewedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingService_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingServiceServer).CreateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingService_CreateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingServiceServer).CreateCategory(ctx, req.(*CreateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingService_GetCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingServiceServer).GetCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingService_GetCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingServiceServer).GetCategory(ctx, req.(*GetCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingService_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingServiceServer).ListCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingService_ListCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingServiceServer).ListCategories(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingService_UpdateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingServiceServer).UpdateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingService_UpdateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingServiceServer).UpdateCategory(ctx, req.(*UpdateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingService_DeleteCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingServiceServer).DeleteCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ListingService_DeleteCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingServiceServer).DeleteCategory(ctx, req.(*DeleteCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ListingService_ServiceDesc is the grpc.ServiceDesc for ListingService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -497,6 +1177,10 @@ var ListingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteListing",
 			Handler:    _ListingService_DeleteListing_Handler,
 		},
+		{
+			MethodName: "PublishListing",
+			Handler:    _ListingService_PublishListing_Handler,
+		},
 		{
 			MethodName: "GetListingByID",
 			Handler:    _ListingService_GetListingByID_Handler,
@@ -509,6 +1193,22 @@ var ListingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UploadPhoto",
 			Handler:    _ListingService_UploadPhoto_Handler,
 		},
+		{
+			MethodName: "GeneratePhotoUploadURL",
+			Handler:    _ListingService_GeneratePhotoUploadURL_Handler,
+		},
+		{
+			MethodName: "ConfirmPhotoUpload",
+			Handler:    _ListingService_ConfirmPhotoUpload_Handler,
+		},
+		{
+			MethodName: "DeletePhoto",
+			Handler:    _ListingService_DeletePhoto_Handler,
+		},
+		{
+			MethodName: "ReorderPhotos",
+			Handler:    _ListingService_ReorderPhotos_Handler,
+		},
 		{
 			MethodName: "GetListingStatus",
 			Handler:    _ListingService_GetListingStatus_Handler,
@@ -533,6 +1233,66 @@ var ListingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateListingStatus",
 			Handler:    _ListingService_UpdateListingStatus_Handler,
 		},
+		{
+			MethodName: "MakeOffer",
+			Handler:    _ListingService_MakeOffer_Handler,
+		},
+		{
+			MethodName: "RespondToOffer",
+			Handler:    _ListingService_RespondToOffer_Handler,
+		},
+		{
+			MethodName: "ListOffers",
+			Handler:    _ListingService_ListOffers_Handler,
+		},
+		{
+			MethodName: "GetListingsStatus",
+			Handler:    _ListingService_GetListingsStatus_Handler,
+		},
+		{
+			MethodName: "ReserveStock",
+			Handler:    _ListingService_ReserveStock_Handler,
+		},
+		{
+			MethodName: "ReleaseStock",
+			Handler:    _ListingService_ReleaseStock_Handler,
+		},
+		{
+			MethodName: "MarkUnavailable",
+			Handler:    _ListingService_MarkUnavailable_Handler,
+		},
+		{
+			MethodName: "DuplicateListing",
+			Handler:    _ListingService_DuplicateListing_Handler,
+		},
+		{
+			MethodName: "BatchGetListings",
+			Handler:    _ListingService_BatchGetListings_Handler,
+		},
+		{
+			MethodName: "GetRecentlyViewed",
+			Handler:    _ListingService_GetRecentlyViewed_Handler,
+		},
+		{
+			MethodName: "CreateCategory",
+			Handler:    _ListingService_CreateCategory_Handler,
+		},
+		{
+			MethodName: "GetCategory",
+			Handler:    _ListingService_GetCategory_Handler,
+		},
+		{
+			MethodName: "ListCategories",
+			Handler:    _ListingService_ListCategories_Handler,
+		},
+		{
+			MethodName: "UpdateCategory",
+			Handler:    _ListingService_UpdateCategory_Handler,
+		},
+		{
+			MethodName: "DeleteCategory",
+			Handler:    _ListingService_DeleteCategory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/proto/listing/listing.proto",
@@ -2,7 +2,12 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"fmt" // Для fmt.Errorf
+	"net"
+	"net/netip"
+	"strings"
+	"time"
 	"github.com/Abdurahmanit/GroupProject/listing-service/internal/adapter/repository/mongodb"
 	"github.com/Abdurahmanit/GroupProject/listing-service/internal/mailer" // Для middleware.UserIDKey
 	"github.com/Abdurahmanit/GroupProject/listing-service/internal/adapter/grpc/middleware" // Для middleware.UserIDKey
@@ -13,7 +18,10 @@ import (
 	pb "github.com/Abdurahmanit/GroupProject/listing-service/genproto/listing_service"
 	"github.com/Abdurahmanit/GroupProject/listing-service/internal/platform/logger" // Твой логгер
 	"github.com/redis/go-redis/v9"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -30,32 +38,52 @@ type Handler struct {
 	photoUsecase    *usecase.PhotoUsecase
 	userRepo *mongodb.UserRepository
 	favoriteUsecase *usecase.FavoriteUsecase
+	offerUsecase    *usecase.OfferUsecase
+	categoryUsecase *usecase.CategoryUsecase
 	natsPublisher   *nats.Publisher
 	cache           *cache.ListingCache
 	logger          *logger.Logger
+	// photoUploadURLExpiry - для expires_at в ответе GeneratePhotoUploadURL
+	photoUploadURLExpiry time.Duration
+	// trustedProxies - пиры, чьему x-forwarded-for верим при учете просмотров
+	trustedProxies []netip.Prefix
 }
 
 func NewHandler(
 	listingRepo domain.ListingRepository,
 	favoriteRepo domain.FavoriteRepository,
+	offerRepo domain.OfferRepository,
+	categoryRepo domain.CategoryRepository,
 	userRepo *mongodb.UserRepository, // Добавляем UserRepository для получения email
 	storage domain.Storage,
 	natsPublisher *nats.Publisher,
 	cache *cache.ListingCache,
 	log *logger.Logger,
+	publishRules usecase.PublishRules,
+	photoLimits usecase.PhotoLimits,
+	viewSettings usecase.ViewSettings,
+	searchCacheTTL time.Duration,
+	favoriteRules usecase.FavoriteRules,
 ) *Handler {
-	listingUc := usecase.NewListingUsecase(listingRepo, log) // Передаем логгер в usecase
-	photoUc := usecase.NewPhotoUsecase(storage, listingRepo, log)
-	favoriteUc := usecase.NewFavoriteUsecase(favoriteRepo, log)
+	listingUc := usecase.NewListingUsecase(listingRepo, categoryRepo, cache, cache, cache, log, publishRules, viewSettings, searchCacheTTL) // Передаем логгер в usecase
+	photoUc := usecase.NewPhotoUsecase(storage, listingRepo, cache, photoLimits, log)
+	favoriteUc := usecase.NewFavoriteUsecase(favoriteRepo, listingRepo, cache, favoriteRules, log)
+	offerUc := usecase.NewOfferUsecase(offerRepo, listingRepo, cache, log)
+	categoryUc := usecase.NewCategoryUsecase(categoryRepo, listingRepo, log)
 
 	return &Handler{
 		listingUsecase:  listingUc,
 		photoUsecase:    photoUc,
 		userRepo:        userRepo, // Сохраняем UserRepository для получения email
 		favoriteUsecase: favoriteUc,
+		offerUsecase:    offerUc,
+		categoryUsecase: categoryUc,
 		natsPublisher:   natsPublisher,
 		cache:           cache,
 		logger:          log,
+
+		photoUploadURLExpiry: photoLimits.UploadURLExpiry,
+		trustedProxies:       viewSettings.TrustedProxies,
 	}
 }
 
@@ -63,18 +91,83 @@ func toProtoListingResponse(listing *domain.Listing) *pb.ListingResponse {
 	if listing == nil {
 		return nil
 	}
-	return &pb.ListingResponse{
-		Id:          listing.ID,
-		UserId:      listing.UserID,
-		CategoryId:  listing.CategoryID,
-		Title:       listing.Title,
-		Description: listing.Description,
-		Price:       listing.Price,
-		Status:      string(listing.Status),
-		Photos:      listing.Photos,
-		CreatedAt:   timestamppb.New(listing.CreatedAt),
-		UpdatedAt:   timestamppb.New(listing.UpdatedAt),
+	resp := &pb.ListingResponse{
+		Id:                listing.ID,
+		UserId:            listing.UserID,
+		CategoryId:        listing.CategoryID,
+		Title:             listing.Title,
+		Description:       listing.Description,
+		Price:             listing.Price,
+		Negotiable:        listing.Negotiable,
+		Status:            string(listing.Status),
+		UnavailableReason: listing.UnavailableReason,
+		Photos:            listing.Photos,
+		Latitude:          listing.Latitude,
+		Longitude:         listing.Longitude,
+		ViewCount:         listing.ViewCount,
+		FavoriteCount:     listing.FavoriteCount,
+		Quantity:          listing.Quantity,
+		CreatedAt:         timestamppb.New(listing.CreatedAt),
+		UpdatedAt:         timestamppb.New(listing.UpdatedAt),
+	}
+	if !listing.DeletedAt.IsZero() {
+		resp.DeletedAt = timestamppb.New(listing.DeletedAt)
+	}
+	resp.Availability = toProtoAvailability(listing.Availability)
+	return resp
+}
+
+// recordView засчитывает просмотр и, если запрос пришел с токеном, добавляет объявление
+// в историю просмотров пользователя.
+func (h *Handler) recordView(ctx context.Context, listing *domain.Listing) {
+	h.listingUsecase.RecordView(ctx, listing, viewerFromContext(ctx, h.trustedProxies))
+	if userID, ok := ctx.Value(middleware.UserIDKey).(string); ok {
+		h.listingUsecase.AddToViewHistory(ctx, userID, listing)
+	}
+}
+
+// viewerFromContext определяет зрителя для учета просмотров: UserID, если запрос пришел с
+// токеном, иначе адрес gRPC-пира. Если пир - доверенный прокси (api-gateway), зритель - адрес
+// клиента, который шлюз передал в x-forwarded-for; остальным клиентам заголовок не подделать.
+func viewerFromContext(ctx context.Context, trusted []netip.Prefix) string {
+	if userID, ok := ctx.Value(middleware.UserIDKey).(string); ok && userID != "" {
+		return "user:" + userID
+	}
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+	if !isTrustedPeer(host, trusted) {
+		return host
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if fwd := md.Get("x-forwarded-for"); len(fwd) > 0 {
+			// Шлюз добавляет адрес последним, все левее мог прислать сам клиент
+			hops := strings.Split(fwd[len(fwd)-1], ",")
+			if addr, err := netip.ParseAddr(strings.TrimSpace(hops[len(hops)-1])); err == nil {
+				return addr.Unmap().String()
+			}
+		}
+	}
+	return host
+}
+
+func isTrustedPeer(host string, trusted []netip.Prefix) bool {
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, prefix := range trusted {
+		if prefix.Contains(addr) {
+			return true
+		}
 	}
+	return false
 }
 
 // getUserIDFromContext извлекает UserID, установленный AuthInterceptor'ом.
@@ -89,6 +182,65 @@ func getUserIDFromContext(ctx context.Context, logger *logger.Logger, methodName
 	return authenticatedUserID, nil
 }
 
+// toProtoAvailability возвращает nil для окна без ограничений.
+func toProtoAvailability(w domain.AvailabilityWindow) *pb.AvailabilityWindow {
+	if w.From.IsZero() && w.Until.IsZero() {
+		return nil
+	}
+	pw := &pb.AvailabilityWindow{}
+	if !w.From.IsZero() {
+		pw.From = timestamppb.New(w.From)
+	}
+	if !w.Until.IsZero() {
+		pw.Until = timestamppb.New(w.Until)
+	}
+	return pw
+}
+
+func fromProtoAvailability(pw *pb.AvailabilityWindow) domain.AvailabilityWindow {
+	var w domain.AvailabilityWindow
+	if pw.GetFrom() != nil {
+		w.From = pw.GetFrom().AsTime()
+	}
+	if pw.GetUntil() != nil {
+		w.Until = pw.GetUntil().AsTime()
+	}
+	return w
+}
+
+// checkIncludeDeleted пропускает запрос удаленных объявлений только от администратора.
+func checkIncludeDeleted(ctx context.Context, includeDeleted bool) error {
+	if !includeDeleted {
+		return nil
+	}
+	role, err := middleware.UserRole(ctx)
+	if err != nil {
+		return status.Error(codes.Unavailable, "failed to resolve user role")
+	}
+	if role != "admin" {
+		return status.Error(codes.PermissionDenied, "only admins can request deleted listings")
+	}
+	return nil
+}
+
+// duplicateTitleStatus возвращает AlreadyExists с ID конфликтующего объявления в ResourceInfo
+// или nil, если err не DuplicateTitleError.
+func duplicateTitleStatus(err error) error {
+	var dupErr *domain.DuplicateTitleError
+	if !errors.As(err, &dupErr) {
+		return nil
+	}
+	st := status.New(codes.AlreadyExists, err.Error())
+	if withDetails, detailErr := st.WithDetails(&errdetails.ResourceInfo{
+		ResourceType: "listing",
+		ResourceName: dupErr.ListingID,
+		Description:  "active listing of the same seller with this title",
+	}); detailErr == nil {
+		return withDetails.Err()
+	}
+	return st.Err()
+}
+
 // ---- Listing Management Methods ----
 
 func (h *Handler) CreateListing(ctx context.Context, req *pb.CreateListingRequest) (*pb.ListingResponse, error) {
@@ -117,10 +269,16 @@ func (h *Handler) CreateListing(ctx context.Context, req *pb.CreateListingReques
 	))
 	defer span.End()
 
-	listing, err := h.listingUsecase.CreateListing(ctx, authenticatedUserID, req.GetCategoryId(), req.GetTitle(), req.GetDescription(), req.GetPrice())
+	listing, err := h.listingUsecase.CreateListing(ctx, authenticatedUserID, req.GetCategoryId(), req.GetTitle(), req.GetDescription(), req.GetPrice(), req.GetNegotiable(), req.GetLatitude(), req.GetLongitude(), req.GetDraft(), fromProtoAvailability(req.GetAvailability()), req.GetQuantity())
 	if err != nil {
 		h.logger.Error("CreateListing: usecase failed", "user_id", authenticatedUserID, "title", req.GetTitle(), "error", err.Error())
 		span.RecordError(err)
+		if st := duplicateTitleStatus(err); st != nil {
+			return nil, st
+		}
+		if errors.Is(err, domain.ErrInvalidListingData) {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to create listing: %v", err)
+		}
 		return nil, status.Errorf(codes.Internal, "failed to create listing: %v", err)
 	}
 	span.SetAttributes(attribute.String("created_listing_id", listing.ID))
@@ -173,13 +331,28 @@ func (h *Handler) UpdateListing(ctx context.Context, req *pb.UpdateListingReques
 	))
 	defer span.End()
 
+	var availability *domain.AvailabilityWindow
+	if req.Availability != nil {
+		w := fromProtoAvailability(req.Availability)
+		availability = &w
+	}
+
 	// Usecase должен проверить, что authenticatedUserID является владельцем объявления req.GetId()
-	listing, err := h.listingUsecase.UpdateListing(ctx, req.GetId(), authenticatedUserID, req.GetCategoryId(), req.GetTitle(), req.GetDescription(), req.GetPrice(), domain.ListingStatus(req.GetStatus()))
+	listing, err := h.listingUsecase.UpdateListing(ctx, req.GetId(), authenticatedUserID, req.GetCategoryId(), req.GetTitle(), req.GetDescription(), req.GetPrice(), req.Negotiable, domain.ListingStatus(req.GetStatus()), availability, req.Quantity)
 	if err != nil {
 		h.logger.Error("UpdateListing: usecase failed", "listing_id", req.GetId(), "user_id", authenticatedUserID, "error", err.Error())
 		span.RecordError(err)
 		// Здесь можно добавить проверку на domain.ErrForbidden, если usecase ее возвращает
 		// if errors.Is(err, domain.ErrForbidden) { return nil, status.Errorf(codes.PermissionDenied, "user not authorized to update this listing")}
+		if st := duplicateTitleStatus(err); st != nil {
+			return nil, st
+		}
+		if errors.Is(err, domain.ErrNotEnoughPhotos) || errors.Is(err, domain.ErrInvalidStatusChange) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		if errors.Is(err, domain.ErrInvalidListingData) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Errorf(codes.Internal, "failed to update listing: %v", err)
 	}
 
@@ -265,6 +438,12 @@ func (h *Handler) UpdateListingStatus(ctx context.Context, req *pb.UpdateListing
 	if err != nil {
 		h.logger.Error("UpdateListingStatus: usecase failed", "listing_id", req.GetId(), "user_id", authenticatedUserID, "status", req.GetStatus(), "error", err.Error())
 		span.RecordError(err)
+		if st := duplicateTitleStatus(err); st != nil {
+			return nil, st
+		}
+		if errors.Is(err, domain.ErrNotEnoughPhotos) || errors.Is(err, domain.ErrInvalidStatusChange) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, status.Errorf(codes.Internal, "failed to update listing status: %v", err)
 	}
 
@@ -282,6 +461,156 @@ func (h *Handler) UpdateListingStatus(ctx context.Context, req *pb.UpdateListing
 	return toProtoListingResponse(listing), nil
 }
 
+// PublishListing публикует черновик (draft -> active).
+func (h *Handler) PublishListing(ctx context.Context, req *pb.PublishListingRequest) (*pb.ListingResponse, error) {
+	authenticatedUserID, err := getUserIDFromContext(ctx, h.logger, "PublishListing")
+	if err != nil {
+		return nil, err
+	}
+	if req.GetUserId() != "" && req.GetUserId() != authenticatedUserID {
+		h.logger.Warn("PublishListing: UserID in request body does not match authenticated UserID from token.",
+			"req_user_id", req.GetUserId(), "auth_user_id", authenticatedUserID, "listing_id", req.GetId())
+		return nil, status.Errorf(codes.PermissionDenied, "cannot publish listing for another user (user_id mismatch)")
+	}
+
+	ctx, span := tracer.Start(ctx, "Handler.PublishListing", oteltrace.WithAttributes(
+		attribute.String("listing_id", req.GetId()),
+		attribute.String("authenticated_user_id", authenticatedUserID),
+	))
+	defer span.End()
+
+	listing, err := h.listingUsecase.PublishListing(ctx, req.GetId(), authenticatedUserID)
+	if err != nil {
+		h.logger.Warn("PublishListing: usecase failed", "listing_id", req.GetId(), "user_id", authenticatedUserID, "error", err.Error())
+		span.RecordError(err)
+		switch {
+		case errors.Is(err, usecase.ErrListingNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, usecase.ErrForbidden):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		case errors.Is(err, domain.ErrNotEnoughPhotos), errors.Is(err, domain.ErrInvalidStatusChange):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, domain.ErrDuplicateTitle):
+			return nil, duplicateTitleStatus(err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to publish listing: %v", err)
+	}
+
+	if errCache := h.cache.SetListing(ctx, listing); errCache != nil {
+		h.logger.Warn("PublishListing: SetListing to cache failed", "listing_id", listing.ID, "error", errCache.Error())
+	}
+
+	_, natsSpan := tracer.Start(ctx, "NATS.Publish.listing.status.updated")
+	h.natsPublisher.Publish(ctx, "listing.status.updated", map[string]string{"id": listing.ID, "status": string(listing.Status), "user_id": listing.UserID})
+	natsSpan.End()
+
+	h.logger.Info("PublishListing: successful", "listing_id", listing.ID)
+	return toProtoListingResponse(listing), nil
+}
+
+// MarkUnavailable снимает объявление с продажи (продано в другом месте или отозвано).
+// Событие listing.unavailable позволяет уведомить пользователей, добавивших объявление в избранное.
+func (h *Handler) MarkUnavailable(ctx context.Context, req *pb.MarkUnavailableRequest) (*pb.ListingResponse, error) {
+	authenticatedUserID, err := getUserIDFromContext(ctx, h.logger, "MarkUnavailable")
+	if err != nil {
+		return nil, err
+	}
+	if req.GetUserId() != "" && req.GetUserId() != authenticatedUserID {
+		h.logger.Warn("MarkUnavailable: UserID in request body does not match authenticated UserID from token.",
+			"req_user_id", req.GetUserId(), "auth_user_id", authenticatedUserID, "listing_id", req.GetId())
+		return nil, status.Errorf(codes.PermissionDenied, "cannot change listing for another user (user_id mismatch)")
+	}
+
+	newStatus := domain.ListingStatus(req.GetStatus())
+	if newStatus == "" {
+		newStatus = domain.StatusSoldElsewhere
+	}
+
+	ctx, span := tracer.Start(ctx, "Handler.MarkUnavailable", oteltrace.WithAttributes(
+		attribute.String("listing_id", req.GetId()),
+		attribute.String("authenticated_user_id", authenticatedUserID),
+		attribute.String("new_status", string(newStatus)),
+	))
+	defer span.End()
+
+	listing, err := h.listingUsecase.MarkUnavailable(ctx, req.GetId(), authenticatedUserID, newStatus, req.GetReason())
+	if err != nil {
+		h.logger.Warn("MarkUnavailable: usecase failed", "listing_id", req.GetId(), "user_id", authenticatedUserID, "error", err.Error())
+		span.RecordError(err)
+		switch {
+		case errors.Is(err, usecase.ErrListingNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, usecase.ErrForbidden):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		case errors.Is(err, domain.ErrInvalidListingData):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, domain.ErrInvalidStatusChange):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to mark listing unavailable: %v", err)
+	}
+
+	if errCache := h.cache.SetListing(ctx, listing); errCache != nil {
+		h.logger.Warn("MarkUnavailable: SetListing to cache failed", "listing_id", listing.ID, "error", errCache.Error())
+	}
+
+	_, natsSpan := tracer.Start(ctx, "NATS.Publish.listing.unavailable")
+	h.natsPublisher.Publish(ctx, "listing.unavailable", map[string]string{
+		"id":      listing.ID,
+		"user_id": listing.UserID,
+		"status":  string(listing.Status),
+		"reason":  listing.UnavailableReason,
+	})
+	natsSpan.End()
+
+	h.logger.Info("MarkUnavailable: successful", "listing_id", listing.ID, "new_status", string(listing.Status))
+	return toProtoListingResponse(listing), nil
+}
+
+// DuplicateListing создает черновик-копию объявления владельца (без фото).
+func (h *Handler) DuplicateListing(ctx context.Context, req *pb.DuplicateListingRequest) (*pb.ListingResponse, error) {
+	authenticatedUserID, err := getUserIDFromContext(ctx, h.logger, "DuplicateListing")
+	if err != nil {
+		return nil, err
+	}
+	if req.GetUserId() != "" && req.GetUserId() != authenticatedUserID {
+		h.logger.Warn("DuplicateListing: UserID in request body does not match authenticated UserID from token.",
+			"req_user_id", req.GetUserId(), "auth_user_id", authenticatedUserID, "listing_id", req.GetId())
+		return nil, status.Errorf(codes.PermissionDenied, "cannot duplicate listing for another user (user_id mismatch)")
+	}
+
+	ctx, span := tracer.Start(ctx, "Handler.DuplicateListing", oteltrace.WithAttributes(
+		attribute.String("listing_id", req.GetId()),
+		attribute.String("authenticated_user_id", authenticatedUserID),
+	))
+	defer span.End()
+
+	listing, err := h.listingUsecase.DuplicateListing(ctx, req.GetId(), authenticatedUserID)
+	if err != nil {
+		h.logger.Warn("DuplicateListing: usecase failed", "listing_id", req.GetId(), "user_id", authenticatedUserID, "error", err.Error())
+		span.RecordError(err)
+		switch {
+		case errors.Is(err, usecase.ErrListingNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, usecase.ErrForbidden):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to duplicate listing: %v", err)
+	}
+	span.SetAttributes(attribute.String("created_listing_id", listing.ID))
+
+	if errCache := h.cache.SetListing(ctx, listing); errCache != nil {
+		h.logger.Warn("DuplicateListing: SetListing to cache failed", "listing_id", listing.ID, "error", errCache.Error())
+	}
+
+	_, natsSpan := tracer.Start(ctx, "NATS.Publish.listing.created")
+	h.natsPublisher.Publish(ctx, "listing.created", map[string]string{"id": listing.ID, "user_id": listing.UserID, "category_id": listing.CategoryID})
+	natsSpan.End()
+
+	h.logger.Info("DuplicateListing: successful", "source_listing_id", req.GetId(), "listing_id", listing.ID)
+	return toProtoListingResponse(listing), nil
+}
+
 // ---- Photo Management Methods ----
 
 func (h *Handler) UploadPhoto(ctx context.Context, req *pb.UploadPhotoRequest) (*pb.UploadPhotoResponse, error) {
@@ -310,6 +639,16 @@ func (h *Handler) UploadPhoto(ctx context.Context, req *pb.UploadPhotoRequest) (
 	if err != nil {
 		h.logger.Error("UploadPhoto: usecase failed", "listing_id", req.GetListingId(), "user_id", authenticatedUserID, "error", err.Error())
 		span.RecordError(err)
+		switch {
+		case errors.Is(err, domain.ErrInvalidPhoto):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, domain.ErrTooManyPhotos):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, usecase.ErrListingNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, usecase.ErrForbidden):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
 		return nil, status.Errorf(codes.Internal, "failed to upload photo: %v", err)
 	}
 	span.SetAttributes(attribute.String("uploaded_photo_url", url))
@@ -328,6 +667,162 @@ func (h *Handler) UploadPhoto(ctx context.Context, req *pb.UploadPhotoRequest) (
 	return &pb.UploadPhotoResponse{PhotoUrl: url}, nil
 }
 
+// photoErrorToStatus переводит ошибки PhotoUsecase в gRPC статусы.
+func photoErrorToStatus(err error, action string) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidPhoto):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrTooManyPhotos), errors.Is(err, domain.ErrPhotoNotUploaded):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, usecase.ErrListingNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, usecase.ErrForbidden):
+		return status.Error(codes.PermissionDenied, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "failed to %s: %v", action, err)
+	}
+}
+
+func (h *Handler) GeneratePhotoUploadURL(ctx context.Context, req *pb.GeneratePhotoUploadURLRequest) (*pb.GeneratePhotoUploadURLResponse, error) {
+	authenticatedUserID, err := getUserIDFromContext(ctx, h.logger, "GeneratePhotoUploadURL")
+	if err != nil {
+		return nil, err
+	}
+	if req.GetUserId() != "" && req.GetUserId() != authenticatedUserID {
+		return nil, status.Errorf(codes.PermissionDenied, "cannot upload photo for another user's listing (user_id mismatch)")
+	}
+
+	ctx, span := tracer.Start(ctx, "Handler.GeneratePhotoUploadURL", oteltrace.WithAttributes(
+		attribute.String("listing_id", req.GetListingId()),
+		attribute.String("authenticated_user_id", authenticatedUserID),
+	))
+	defer span.End()
+
+	issuedAt := time.Now()
+	uploadURL, photoURL, err := h.photoUsecase.GeneratePhotoUploadURL(ctx, req.GetListingId(), authenticatedUserID, req.GetFileName())
+	if err != nil {
+		h.logger.Error("GeneratePhotoUploadURL: usecase failed", "listing_id", req.GetListingId(), "user_id", authenticatedUserID, "error", err.Error())
+		span.RecordError(err)
+		return nil, photoErrorToStatus(err, "generate photo upload URL")
+	}
+
+	return &pb.GeneratePhotoUploadURLResponse{
+		UploadUrl: uploadURL,
+		PhotoUrl:  photoURL,
+		ExpiresAt: timestamppb.New(issuedAt.Add(h.photoUploadURLExpiry)),
+	}, nil
+}
+
+func (h *Handler) ConfirmPhotoUpload(ctx context.Context, req *pb.ConfirmPhotoUploadRequest) (*pb.UploadPhotoResponse, error) {
+	authenticatedUserID, err := getUserIDFromContext(ctx, h.logger, "ConfirmPhotoUpload")
+	if err != nil {
+		return nil, err
+	}
+	if req.GetUserId() != "" && req.GetUserId() != authenticatedUserID {
+		return nil, status.Errorf(codes.PermissionDenied, "cannot upload photo for another user's listing (user_id mismatch)")
+	}
+
+	ctx, span := tracer.Start(ctx, "Handler.ConfirmPhotoUpload", oteltrace.WithAttributes(
+		attribute.String("listing_id", req.GetListingId()),
+		attribute.String("authenticated_user_id", authenticatedUserID),
+		attribute.String("photo_url", req.GetPhotoUrl()),
+	))
+	defer span.End()
+
+	if err := h.photoUsecase.ConfirmPhotoUpload(ctx, req.GetListingId(), authenticatedUserID, req.GetPhotoUrl()); err != nil {
+		h.logger.Error("ConfirmPhotoUpload: usecase failed", "listing_id", req.GetListingId(), "user_id", authenticatedUserID, "error", err.Error())
+		span.RecordError(err)
+		return nil, photoErrorToStatus(err, "confirm photo upload")
+	}
+
+	_, natsSpan := tracer.Start(ctx, "NATS.Publish.listing.photo.uploaded")
+	h.natsPublisher.Publish(ctx, "listing.photo.uploaded", map[string]string{"id": req.GetListingId(), "photo_url": req.GetPhotoUrl(), "user_id": authenticatedUserID})
+	natsSpan.End()
+
+	return &pb.UploadPhotoResponse{PhotoUrl: req.GetPhotoUrl()}, nil
+}
+
+func (h *Handler) DeletePhoto(ctx context.Context, req *pb.DeletePhotoRequest) (*pb.Empty, error) {
+	authenticatedUserID, err := getUserIDFromContext(ctx, h.logger, "DeletePhoto")
+	if err != nil {
+		return nil, err
+	}
+	if req.GetUserId() != "" && req.GetUserId() != authenticatedUserID {
+		h.logger.Warn("DeletePhoto: UserID in request body does not match authenticated UserID from token.",
+			"req_user_id", req.GetUserId(), "auth_user_id", authenticatedUserID, "listing_id", req.GetListingId())
+		return nil, status.Errorf(codes.PermissionDenied, "cannot delete photo of another user's listing (user_id mismatch)")
+	}
+	if req.GetPhotoUrl() == "" {
+		return nil, status.Error(codes.InvalidArgument, "photo_url is required")
+	}
+
+	ctx, span := tracer.Start(ctx, "Handler.DeletePhoto", oteltrace.WithAttributes(
+		attribute.String("listing_id", req.GetListingId()),
+		attribute.String("authenticated_user_id", authenticatedUserID),
+		attribute.String("photo_url", req.GetPhotoUrl()),
+	))
+	defer span.End()
+
+	err = h.photoUsecase.DeletePhoto(ctx, req.GetListingId(), authenticatedUserID, req.GetPhotoUrl())
+	if err != nil {
+		h.logger.Warn("DeletePhoto: usecase failed", "listing_id", req.GetListingId(), "user_id", authenticatedUserID, "error", err.Error())
+		span.RecordError(err)
+		switch {
+		case errors.Is(err, usecase.ErrListingNotFound), errors.Is(err, domain.ErrPhotoNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, usecase.ErrForbidden):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		case errors.Is(err, domain.ErrNotEnoughPhotos):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to delete photo: %v", err)
+	}
+
+	_, natsSpan := tracer.Start(ctx, "NATS.Publish.listing.photo.deleted")
+	h.natsPublisher.Publish(ctx, "listing.photo.deleted", map[string]string{"id": req.GetListingId(), "photo_url": req.GetPhotoUrl(), "user_id": authenticatedUserID})
+	natsSpan.End()
+
+	h.logger.Info("DeletePhoto: successful", "listing_id", req.GetListingId(), "url", req.GetPhotoUrl())
+	return &pb.Empty{}, nil
+}
+
+func (h *Handler) ReorderPhotos(ctx context.Context, req *pb.ReorderPhotosRequest) (*pb.Empty, error) {
+	authenticatedUserID, err := getUserIDFromContext(ctx, h.logger, "ReorderPhotos")
+	if err != nil {
+		return nil, err
+	}
+	if req.GetUserId() != "" && req.GetUserId() != authenticatedUserID {
+		h.logger.Warn("ReorderPhotos: UserID in request body does not match authenticated UserID from token.",
+			"req_user_id", req.GetUserId(), "auth_user_id", authenticatedUserID, "listing_id", req.GetListingId())
+		return nil, status.Errorf(codes.PermissionDenied, "cannot reorder photos of another user's listing (user_id mismatch)")
+	}
+
+	ctx, span := tracer.Start(ctx, "Handler.ReorderPhotos", oteltrace.WithAttributes(
+		attribute.String("listing_id", req.GetListingId()),
+		attribute.String("authenticated_user_id", authenticatedUserID),
+		attribute.Int("photo_count", len(req.GetPhotoUrls())),
+	))
+	defer span.End()
+
+	err = h.photoUsecase.ReorderPhotos(ctx, req.GetListingId(), authenticatedUserID, req.GetPhotoUrls())
+	if err != nil {
+		h.logger.Warn("ReorderPhotos: usecase failed", "listing_id", req.GetListingId(), "user_id", authenticatedUserID, "error", err.Error())
+		span.RecordError(err)
+		switch {
+		case errors.Is(err, usecase.ErrListingNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, usecase.ErrForbidden):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		case errors.Is(err, domain.ErrInvalidPhotoOrder):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to reorder photos: %v", err)
+	}
+
+	h.logger.Info("ReorderPhotos: successful", "listing_id", req.GetListingId())
+	return &pb.Empty{}, nil
+}
+
 // ---- Public Read Methods ----
 
 func (h *Handler) GetListingByID(ctx context.Context, req *pb.GetListingRequest) (*pb.ListingResponse, error) {
@@ -335,13 +830,31 @@ func (h *Handler) GetListingByID(ctx context.Context, req *pb.GetListingRequest)
 	// UserID из контекста для авторизации здесь не извлекается.
 	ctx, span := tracer.Start(ctx, "Handler.GetListingByID", oteltrace.WithAttributes(
 		attribute.String("listing_id", req.GetId()),
+		attribute.Bool("include_deleted", req.GetIncludeDeleted()),
 	))
 	defer span.End()
 
+	if err := checkIncludeDeleted(ctx, req.GetIncludeDeleted()); err != nil {
+		return nil, err
+	}
+	if req.GetIncludeDeleted() {
+		// Запрос администратора: без кэша и без учета просмотра, чтобы удаленное объявление
+		// не попало в кэш публичного чтения
+		listing, err := h.listingUsecase.GetListingByID(ctx, req.GetId(), true)
+		if err != nil {
+			span.RecordError(err)
+			return nil, status.Errorf(codes.NotFound, "listing not found: %v", err)
+		}
+		return toProtoListingResponse(listing), nil
+	}
+
 	cachedListing, errCache := h.cache.GetListing(ctx, req.GetId())
-	if errCache == nil && cachedListing != nil {
+	if errCache == nil && cachedListing != nil && !cachedListing.IsDeleted() {
 		h.logger.Info("GetListingByID: Cache HIT", "listing_id", req.GetId())
 		span.SetAttributes(attribute.Bool("cache_hit", true))
+		// Просмотр засчитывается и при попадании в кэш; ViewCount в ответе обновляется,
+		// а в кэше может отставать до следующей перезаписи объявления
+		h.recordView(ctx, cachedListing)
 		return toProtoListingResponse(cachedListing), nil
 	}
 
@@ -353,7 +866,7 @@ func (h *Handler) GetListingByID(ctx context.Context, req *pb.GetListingRequest)
 		h.logger.Info("GetListingByID: Cache MISS", "listing_id", req.GetId())
 	}
 
-	listing, err := h.listingUsecase.GetListingByID(ctx, req.GetId())
+	listing, err := h.listingUsecase.GetListingByID(ctx, req.GetId(), false)
 	if err != nil {
 		h.logger.Warn("GetListingByID: usecase failed", "listing_id", req.GetId(), "error", err.Error()) // Warn, т.к. NotFound ожидаемо
 		span.RecordError(err)
@@ -372,10 +885,16 @@ func (h *Handler) GetListingByID(ctx context.Context, req *pb.GetListingRequest)
 		h.logger.Info("GetListingByID: SetListing to cache after fetch successful", "listing_id", listing.ID)
 	}
 
+	h.recordView(ctx, listing)
 	h.logger.Info("GetListingByID: Fetched from usecase", "listing_id", listing.ID)
 	return toProtoListingResponse(listing), nil
 }
 
+// InvalidateSearchCache сбрасывает кэш результатов поиска; вызывается подписчиком NATS.
+func (h *Handler) InvalidateSearchCache(ctx context.Context) error {
+	return h.listingUsecase.InvalidateSearchCache(ctx)
+}
+
 func (h *Handler) SearchListings(ctx context.Context, req *pb.SearchListingsRequest) (*pb.SearchListingsResponse, error) {
 	// Этот метод публичный. req.GetUserId() здесь используется как фильтр, а не для аутентификации.
 	ctx, span := tracer.Start(ctx, "Handler.SearchListings", oteltrace.WithAttributes(
@@ -389,9 +908,14 @@ func (h *Handler) SearchListings(ctx context.Context, req *pb.SearchListingsRequ
 		attribute.Int64("limit", int64(req.GetLimit())),
 		attribute.String("sort_by", req.GetSortBy()),
 		attribute.String("sort_order", req.GetSortOrder()),
+		attribute.Bool("include_deleted", req.GetIncludeDeleted()),
 	))
 	defer span.End()
 
+	if err := checkIncludeDeleted(ctx, req.GetIncludeDeleted()); err != nil {
+		return nil, err
+	}
+
 	filter := domain.Filter{
 		Query:      req.GetQuery(),
 		MinPrice:   req.GetMinPrice(),
@@ -403,12 +927,31 @@ func (h *Handler) SearchListings(ctx context.Context, req *pb.SearchListingsRequ
 		Limit:      req.GetLimit(),
 		SortBy:     req.GetSortBy(),
 		SortOrder:  req.GetSortOrder(),
+		CenterLat:  req.GetCenterLat(),
+		CenterLng:  req.GetCenterLng(),
+		RadiusKm:   req.GetRadiusKm(),
+
+		ExcludeUserID:  req.GetExcludeUserId(),
+		IncludeDeleted: req.GetIncludeDeleted(),
+	}
+	// Запланированные объявления вне окна доступности видят только их владелец и администраторы
+	viewerID, _ := ctx.Value(middleware.UserIDKey).(string)
+	filter.IncludeScheduled = viewerID != "" && filter.UserID == viewerID
+	if !filter.IncludeScheduled && viewerID != "" {
+		viewerRole, err := middleware.UserRole(ctx)
+		if err != nil {
+			h.logger.Warn("SearchListings: failed to resolve viewer role, hiding scheduled listings", "user_id", viewerID, "error", err.Error())
+		}
+		filter.IncludeScheduled = viewerRole == "admin"
 	}
 
 	listings, total, err := h.listingUsecase.SearchListings(ctx, filter)
 	if err != nil {
 		h.logger.Error("SearchListings: usecase failed", "filter", fmt.Sprintf("%+v", filter), "error", err.Error()) // %+v для полной структуры фильтра
 		span.RecordError(err)
+		if errors.Is(err, domain.ErrInvalidFilter) {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to search listings: %v", err)
+		}
 		return nil, status.Errorf(codes.Internal, "failed to search listings: %v", err)
 	}
 	span.SetAttributes(attribute.Int("search_results_count", len(listings)), attribute.Int64("search_total_count", total))
@@ -452,6 +995,141 @@ func (h *Handler) GetListingStatus(ctx context.Context, req *pb.GetListingReques
 	}, nil
 }
 
+// GetListingsStatus - публичный пакетный метод для корзины: один запрос вместо GetListingByID на каждый товар.
+func (h *Handler) GetListingsStatus(ctx context.Context, req *pb.GetListingsStatusRequest) (*pb.GetListingsStatusResponse, error) {
+	ctx, span := tracer.Start(ctx, "Handler.GetListingsStatus", oteltrace.WithAttributes(
+		attribute.Int("id_count", len(req.GetIds())),
+	))
+	defer span.End()
+
+	statuses, err := h.listingUsecase.GetListingsStatus(ctx, req.GetIds())
+	if err != nil {
+		h.logger.Error("GetListingsStatus: usecase failed", "count", len(req.GetIds()), "error", err.Error())
+		span.RecordError(err)
+		if errors.Is(err, usecase.ErrTooManyIDs) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get listings status: %v", err)
+	}
+
+	resp := &pb.GetListingsStatusResponse{Statuses: make(map[string]*pb.ListingAvailability, len(statuses))}
+	for id, a := range statuses {
+		resp.Statuses[id] = &pb.ListingAvailability{
+			Status:       string(a.Status),
+			Price:        a.Price,
+			Available:    a.Available,
+			Availability: toProtoAvailability(a.Availability),
+			UserId:       a.UserID,
+			Quantity:     a.Quantity,
+		}
+	}
+	return resp, nil
+}
+
+// stockErrorToStatus переводит ошибки ReserveStock/ReleaseStock в gRPC-коды.
+func stockErrorToStatus(err error, action string) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidListingData):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, usecase.ErrListingNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrOutOfStock):
+		return status.Error(codes.FailedPrecondition, "out of stock")
+	}
+	return status.Errorf(codes.Internal, "failed to %s stock: %v", action, err)
+}
+
+// ReserveStock вызывается order-service при оформлении заказа с внутренним токеном сервиса
+// (см. InternalTokenInterceptor), без токена пользователя.
+func (h *Handler) ReserveStock(ctx context.Context, req *pb.ReserveStockRequest) (*pb.ReserveStockResponse, error) {
+	ctx, span := tracer.Start(ctx, "Handler.ReserveStock", oteltrace.WithAttributes(
+		attribute.String("listing_id", req.GetListingId()),
+		attribute.String("order_number", req.GetOrderNumber()),
+		attribute.Int64("quantity", req.GetQuantity()),
+	))
+	defer span.End()
+
+	remaining, err := h.listingUsecase.ReserveStock(ctx, req.GetListingId(), req.GetOrderNumber(), req.GetQuantity())
+	if err != nil {
+		h.logger.Warn("ReserveStock: usecase failed", "listing_id", req.GetListingId(), "order_number", req.GetOrderNumber(), "quantity", req.GetQuantity(), "error", err.Error())
+		span.RecordError(err)
+		return nil, stockErrorToStatus(err, "reserve")
+	}
+	return &pb.ReserveStockResponse{Remaining: remaining}, nil
+}
+
+// ReleaseStock возвращает резерв заказа: заказ не оформился, отменен или по нему вернули деньги.
+func (h *Handler) ReleaseStock(ctx context.Context, req *pb.ReleaseStockRequest) (*pb.Empty, error) {
+	ctx, span := tracer.Start(ctx, "Handler.ReleaseStock", oteltrace.WithAttributes(
+		attribute.String("listing_id", req.GetListingId()),
+		attribute.String("order_number", req.GetOrderNumber()),
+	))
+	defer span.End()
+
+	if err := h.listingUsecase.ReleaseStock(ctx, req.GetListingId(), req.GetOrderNumber()); err != nil {
+		h.logger.Error("ReleaseStock: usecase failed", "listing_id", req.GetListingId(), "order_number", req.GetOrderNumber(), "error", err.Error())
+		span.RecordError(err)
+		return nil, stockErrorToStatus(err, "release")
+	}
+	return &pb.Empty{}, nil
+}
+
+// BatchGetListings - публичный пакетный аналог GetListingByID. Несуществующие ID пропускаются.
+func (h *Handler) BatchGetListings(ctx context.Context, req *pb.BatchGetListingsRequest) (*pb.BatchGetListingsResponse, error) {
+	ctx, span := tracer.Start(ctx, "Handler.BatchGetListings", oteltrace.WithAttributes(
+		attribute.Int("id_count", len(req.GetIds())),
+	))
+	defer span.End()
+
+	listings, err := h.listingUsecase.GetListingsByIDs(ctx, req.GetIds())
+	if err != nil {
+		h.logger.Error("BatchGetListings: usecase failed", "count", len(req.GetIds()), "error", err.Error())
+		span.RecordError(err)
+		if errors.Is(err, usecase.ErrTooManyIDs) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get listings: %v", err)
+	}
+	span.SetAttributes(attribute.Int("found_count", len(listings)))
+
+	resp := &pb.BatchGetListingsResponse{Listings: make([]*pb.ListingResponse, 0, len(listings))}
+	for _, l := range listings {
+		resp.Listings = append(resp.Listings, toProtoListingResponse(l))
+	}
+	return resp, nil
+}
+
+func (h *Handler) GetRecentlyViewed(ctx context.Context, req *pb.GetRecentlyViewedRequest) (*pb.GetRecentlyViewedResponse, error) {
+	authenticatedUserID, err := getUserIDFromContext(ctx, h.logger, "GetRecentlyViewed")
+	if err != nil {
+		return nil, err
+	}
+	if req.GetUserId() != authenticatedUserID {
+		h.logger.Warn("GetRecentlyViewed: Attempt to get view history of another user",
+			"req_user_id", req.GetUserId(), "auth_user_id", authenticatedUserID)
+		return nil, status.Errorf(codes.PermissionDenied, "cannot get view history of another user")
+	}
+
+	ctx, span := tracer.Start(ctx, "Handler.GetRecentlyViewed", oteltrace.WithAttributes(
+		attribute.String("user_id", authenticatedUserID),
+		attribute.Int64("limit", int64(req.GetLimit())),
+	))
+	defer span.End()
+
+	listings, err := h.listingUsecase.GetRecentlyViewed(ctx, authenticatedUserID, int(req.GetLimit()))
+	if err != nil {
+		h.logger.Error("GetRecentlyViewed: usecase failed", "user_id", authenticatedUserID, "error", err.Error())
+		span.RecordError(err)
+		return nil, status.Errorf(codes.Internal, "failed to get recently viewed listings: %v", err)
+	}
+
+	resp := &pb.GetRecentlyViewedResponse{Listings: make([]*pb.ListingResponse, 0, len(listings))}
+	for _, l := range listings {
+		resp.Listings = append(resp.Listings, toProtoListingResponse(l))
+	}
+	return resp, nil
+}
+
 func (h *Handler) GetPhotoURLs(ctx context.Context, req *pb.GetListingRequest) (*pb.PhotoURLsResponse, error) {
 	// Этот метод публичный, если GetListingByID публичный.
 	ctx, span := tracer.Start(ctx, "Handler.GetPhotoURLs", oteltrace.WithAttributes(
@@ -501,6 +1179,12 @@ func (h *Handler) AddFavorite(ctx context.Context, req *pb.AddFavoriteRequest) (
 	if err != nil {
 		h.logger.Error("AddFavorite: usecase failed", "user_id", authenticatedUserID, "listing_id", req.GetListingId(), "error", err.Error())
 		span.RecordError(err)
+		if errors.Is(err, domain.ErrOwnListing) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		if errors.Is(err, domain.ErrListingNotFound) {
+			return nil, status.Errorf(codes.NotFound, "listing not found: %s", req.GetListingId())
+		}
 		return nil, status.Errorf(codes.Internal, "failed to add favorite: %v", err)
 	}
 
@@ -552,7 +1236,26 @@ func (h *Handler) GetFavorites(ctx context.Context, req *pb.GetFavoritesRequest)
 	))
 	defer span.End()
 
-	favorites, err := h.favoriteUsecase.GetFavorites(ctx, authenticatedUserID) // domain.Favorite
+	page, limit := req.GetPage(), req.GetLimit()
+	if limit < 0 || limit > maxFavoritesPageSize {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must be between 0 and %d", maxFavoritesPageSize)
+	}
+	if limit == 0 && (page > 0 || req.GetIncludeListings()) {
+		limit = defaultFavoritesPageSize
+	}
+	if limit > 0 && page < 1 {
+		page = 1
+	}
+
+	var favorites []*domain.Favorite
+	var total int64
+	if limit == 0 {
+		// Старое поведение: все ID без пагинации
+		favorites, err = h.favoriteUsecase.GetFavorites(ctx, authenticatedUserID)
+		total = int64(len(favorites))
+	} else {
+		favorites, total, err = h.favoriteUsecase.GetFavoritesPage(ctx, authenticatedUserID, int(page), int(limit))
+	}
 	if err != nil {
 		h.logger.Error("GetFavorites: usecase failed", "user_id", authenticatedUserID, "error", err.Error())
 		span.RecordError(err)
@@ -566,7 +1269,284 @@ func (h *Handler) GetFavorites(ctx context.Context, req *pb.GetFavoritesRequest)
 		}
 	}
 	span.SetAttributes(attribute.Int("favorite_count", len(listingIDs)))
+	resp := &pb.GetFavoritesResponse{ListingIds: listingIDs, Total: total, Page: page, Limit: limit}
+
+	if req.GetIncludeListings() && len(listingIDs) > 0 {
+		// Объявления берутся из Redis, из Mongo читаются только промахи; удаленные пропускаются
+		listings, err := h.listingUsecase.GetListingsByIDs(ctx, listingIDs)
+		if err != nil {
+			h.logger.Error("GetFavorites: failed to load favorite listings", "user_id", authenticatedUserID, "error", err.Error())
+			span.RecordError(err)
+			return nil, status.Errorf(codes.Internal, "failed to get favorite listings: %v", err)
+		}
+		resp.Listings = make([]*pb.ListingResponse, 0, len(listings))
+		for _, l := range listings {
+			resp.Listings = append(resp.Listings, toProtoListingResponse(l))
+		}
+	}
+
+	h.logger.Info("GetFavorites: successful", "user_id", authenticatedUserID, "count", len(listingIDs), "total", total)
+	return resp, nil
+}
+
+// Размер страницы GetFavorites; максимум совпадает с лимитом пакетного чтения объявлений
+const (
+	defaultFavoritesPageSize = 20
+	maxFavoritesPageSize     = 100
+)
+
+// ---- Offer Methods ----
+
+func toProtoOfferResponse(offer *domain.Offer) *pb.OfferResponse {
+	return &pb.OfferResponse{
+		Id:            offer.ID,
+		ListingId:     offer.ListingID,
+		BuyerId:       offer.BuyerID,
+		SellerId:      offer.SellerID,
+		Amount:        offer.Amount,
+		CounterAmount: offer.CounterAmount,
+		AgreedPrice:   offer.AgreedPrice,
+		Status:        string(offer.Status),
+		LastActor:     offer.LastActor,
+		CreatedAt:     timestamppb.New(offer.CreatedAt),
+		UpdatedAt:     timestamppb.New(offer.UpdatedAt),
+	}
+}
+
+// offerErrorToStatus переводит ошибки OfferUsecase в gRPC статусы.
+func offerErrorToStatus(err error, action string) error {
+	switch {
+	case errors.Is(err, usecase.ErrListingNotFound), errors.Is(err, domain.ErrOfferNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, usecase.ErrForbidden):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, domain.ErrInvalidOffer):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrListingNotNegotiable), errors.Is(err, domain.ErrOfferClosed), errors.Is(err, domain.ErrNotYourTurn):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrOutOfStock):
+		return status.Error(codes.FailedPrecondition, "out of stock")
+	default:
+		return status.Errorf(codes.Internal, "failed to %s: %v", action, err)
+	}
+}
 
-	h.logger.Info("GetFavorites: successful", "user_id", authenticatedUserID, "count", len(listingIDs))
-	return &pb.GetFavoritesResponse{ListingIds: listingIDs}, nil
-}
\ No newline at end of file
+func (h *Handler) MakeOffer(ctx context.Context, req *pb.MakeOfferRequest) (*pb.OfferResponse, error) {
+	authenticatedUserID, err := getUserIDFromContext(ctx, h.logger, "MakeOffer")
+	if err != nil {
+		return nil, err
+	}
+	if req.GetUserId() != "" && req.GetUserId() != authenticatedUserID {
+		h.logger.Warn("MakeOffer: UserID in request body does not match authenticated UserID from token.",
+			"req_user_id", req.GetUserId(), "auth_user_id", authenticatedUserID, "listing_id", req.GetListingId())
+		return nil, status.Errorf(codes.PermissionDenied, "cannot make an offer for another user (user_id mismatch)")
+	}
+
+	ctx, span := tracer.Start(ctx, "Handler.MakeOffer", oteltrace.WithAttributes(
+		attribute.String("user_id", authenticatedUserID),
+		attribute.String("listing_id", req.GetListingId()),
+	))
+	defer span.End()
+
+	offer, err := h.offerUsecase.MakeOffer(ctx, req.GetListingId(), authenticatedUserID, req.GetAmount())
+	if err != nil {
+		h.logger.Warn("MakeOffer: usecase failed", "user_id", authenticatedUserID, "listing_id", req.GetListingId(), "error", err.Error())
+		span.RecordError(err)
+		return nil, offerErrorToStatus(err, "make offer")
+	}
+	span.SetAttributes(attribute.String("offer_id", offer.ID))
+
+	_, natsSpan := tracer.Start(ctx, "NATS.Publish.listing.offer.created")
+	h.natsPublisher.Publish(ctx, "listing.offer.created", map[string]string{
+		"id":         offer.ID,
+		"listing_id": offer.ListingID,
+		"buyer_id":   offer.BuyerID,
+		"seller_id":  offer.SellerID,
+		"amount":     fmt.Sprintf("%.2f", offer.Amount),
+	})
+	natsSpan.End()
+
+	h.logger.Info("MakeOffer: successful", "offer_id", offer.ID, "listing_id", offer.ListingID, "buyer_id", offer.BuyerID)
+	return toProtoOfferResponse(offer), nil
+}
+
+func (h *Handler) RespondToOffer(ctx context.Context, req *pb.RespondToOfferRequest) (*pb.OfferResponse, error) {
+	authenticatedUserID, err := getUserIDFromContext(ctx, h.logger, "RespondToOffer")
+	if err != nil {
+		return nil, err
+	}
+	if req.GetUserId() != "" && req.GetUserId() != authenticatedUserID {
+		h.logger.Warn("RespondToOffer: UserID in request body does not match authenticated UserID from token.",
+			"req_user_id", req.GetUserId(), "auth_user_id", authenticatedUserID, "offer_id", req.GetOfferId())
+		return nil, status.Errorf(codes.PermissionDenied, "cannot respond to an offer for another user (user_id mismatch)")
+	}
+
+	ctx, span := tracer.Start(ctx, "Handler.RespondToOffer", oteltrace.WithAttributes(
+		attribute.String("user_id", authenticatedUserID),
+		attribute.String("offer_id", req.GetOfferId()),
+		attribute.String("action", req.GetAction()),
+	))
+	defer span.End()
+
+	offer, err := h.offerUsecase.RespondToOffer(ctx, req.GetOfferId(), authenticatedUserID, domain.OfferAction(req.GetAction()), req.GetAmount())
+	if err != nil {
+		h.logger.Warn("RespondToOffer: usecase failed", "user_id", authenticatedUserID, "offer_id", req.GetOfferId(), "error", err.Error())
+		span.RecordError(err)
+		return nil, offerErrorToStatus(err, "respond to offer")
+	}
+
+	_, natsSpan := tracer.Start(ctx, "NATS.Publish.listing.offer.responded")
+	h.natsPublisher.Publish(ctx, "listing.offer.responded", map[string]string{
+		"id":             offer.ID,
+		"listing_id":     offer.ListingID,
+		"buyer_id":       offer.BuyerID,
+		"seller_id":      offer.SellerID,
+		"status":         string(offer.Status),
+		"last_actor":     offer.LastActor,
+		"amount":         fmt.Sprintf("%.2f", offer.Amount),
+		"counter_amount": fmt.Sprintf("%.2f", offer.CounterAmount),
+		"agreed_price":   fmt.Sprintf("%.2f", offer.AgreedPrice),
+	})
+	natsSpan.End()
+
+	h.logger.Info("RespondToOffer: successful", "offer_id", offer.ID, "status", offer.Status)
+	return toProtoOfferResponse(offer), nil
+}
+
+func (h *Handler) ListOffers(ctx context.Context, req *pb.ListOffersRequest) (*pb.ListOffersResponse, error) {
+	authenticatedUserID, err := getUserIDFromContext(ctx, h.logger, "ListOffers")
+	if err != nil {
+		return nil, err
+	}
+	if req.GetUserId() != "" && req.GetUserId() != authenticatedUserID {
+		h.logger.Warn("ListOffers: UserID in request body does not match authenticated UserID from token.",
+			"req_user_id", req.GetUserId(), "auth_user_id", authenticatedUserID)
+		return nil, status.Errorf(codes.PermissionDenied, "cannot list offers for another user (user_id mismatch)")
+	}
+
+	ctx, span := tracer.Start(ctx, "Handler.ListOffers", oteltrace.WithAttributes(
+		attribute.String("user_id", authenticatedUserID),
+		attribute.String("listing_id", req.GetListingId()),
+	))
+	defer span.End()
+
+	offers, err := h.offerUsecase.ListOffers(ctx, authenticatedUserID, req.GetListingId())
+	if err != nil {
+		h.logger.Error("ListOffers: usecase failed", "user_id", authenticatedUserID, "listing_id", req.GetListingId(), "error", err.Error())
+		span.RecordError(err)
+		return nil, offerErrorToStatus(err, "list offers")
+	}
+
+	resp := &pb.ListOffersResponse{Offers: make([]*pb.OfferResponse, 0, len(offers))}
+	for _, o := range offers {
+		resp.Offers = append(resp.Offers, toProtoOfferResponse(o))
+	}
+	span.SetAttributes(attribute.Int("offer_count", len(offers)))
+	return resp, nil
+}
+
+// ---- Category Methods ----
+// Чтение категорий публичное; Create/Update/Delete доступны только роли admin (см. requiredRoles в server.go).
+
+func toProtoCategoryResponse(category *domain.Category) *pb.CategoryResponse {
+	if category == nil {
+		return nil
+	}
+	return &pb.CategoryResponse{
+		Id:          category.ID,
+		Name:        category.Name,
+		Description: category.Description,
+		CreatedAt:   timestamppb.New(category.CreatedAt),
+		UpdatedAt:   timestamppb.New(category.UpdatedAt),
+	}
+}
+
+func categoryErrorToStatus(err error, action string) error {
+	switch {
+	case errors.Is(err, domain.ErrCategoryNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrInvalidCategory):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrDuplicateCategory):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, domain.ErrCategoryInUse):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "failed to %s: %v", action, err)
+	}
+}
+
+func (h *Handler) CreateCategory(ctx context.Context, req *pb.CreateCategoryRequest) (*pb.CategoryResponse, error) {
+	ctx, span := tracer.Start(ctx, "Handler.CreateCategory", oteltrace.WithAttributes(
+		attribute.String("name", req.GetName()),
+	))
+	defer span.End()
+
+	category, err := h.categoryUsecase.CreateCategory(ctx, req.GetName(), req.GetDescription())
+	if err != nil {
+		span.RecordError(err)
+		return nil, categoryErrorToStatus(err, "create category")
+	}
+	h.logger.Info("CreateCategory: successful", "category_id", category.ID, "name", category.Name)
+	return toProtoCategoryResponse(category), nil
+}
+
+func (h *Handler) GetCategory(ctx context.Context, req *pb.GetCategoryRequest) (*pb.CategoryResponse, error) {
+	ctx, span := tracer.Start(ctx, "Handler.GetCategory", oteltrace.WithAttributes(
+		attribute.String("category_id", req.GetId()),
+	))
+	defer span.End()
+
+	category, err := h.categoryUsecase.GetCategory(ctx, req.GetId())
+	if err != nil {
+		span.RecordError(err)
+		return nil, categoryErrorToStatus(err, "get category")
+	}
+	return toProtoCategoryResponse(category), nil
+}
+
+func (h *Handler) ListCategories(ctx context.Context, _ *pb.Empty) (*pb.ListCategoriesResponse, error) {
+	ctx, span := tracer.Start(ctx, "Handler.ListCategories")
+	defer span.End()
+
+	categories, err := h.categoryUsecase.ListCategories(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return nil, categoryErrorToStatus(err, "list categories")
+	}
+
+	resp := &pb.ListCategoriesResponse{Categories: make([]*pb.CategoryResponse, 0, len(categories))}
+	for _, c := range categories {
+		resp.Categories = append(resp.Categories, toProtoCategoryResponse(c))
+	}
+	return resp, nil
+}
+
+func (h *Handler) UpdateCategory(ctx context.Context, req *pb.UpdateCategoryRequest) (*pb.CategoryResponse, error) {
+	ctx, span := tracer.Start(ctx, "Handler.UpdateCategory", oteltrace.WithAttributes(
+		attribute.String("category_id", req.GetId()),
+	))
+	defer span.End()
+
+	category, err := h.categoryUsecase.UpdateCategory(ctx, req.GetId(), req.GetName(), req.GetDescription())
+	if err != nil {
+		span.RecordError(err)
+		return nil, categoryErrorToStatus(err, "update category")
+	}
+	h.logger.Info("UpdateCategory: successful", "category_id", category.ID)
+	return toProtoCategoryResponse(category), nil
+}
+
+func (h *Handler) DeleteCategory(ctx context.Context, req *pb.DeleteCategoryRequest) (*pb.Empty, error) {
+	ctx, span := tracer.Start(ctx, "Handler.DeleteCategory", oteltrace.WithAttributes(
+		attribute.String("category_id", req.GetId()),
+	))
+	defer span.End()
+
+	if err := h.categoryUsecase.DeleteCategory(ctx, req.GetId()); err != nil {
+		span.RecordError(err)
+		return nil, categoryErrorToStatus(err, "delete category")
+	}
+	h.logger.Info("DeleteCategory: successful", "category_id", req.GetId())
+	return &pb.Empty{}, nil
+}
@@ -0,0 +1,79 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"testing"
+
+	pb "github.com/Abdurahmanit/GroupProject/listing-service/genproto/listing_service"
+	"github.com/Abdurahmanit/GroupProject/listing-service/internal/adapter/grpc/middleware"
+	"github.com/Abdurahmanit/GroupProject/listing-service/internal/listing/domain"
+	"github.com/Abdurahmanit/GroupProject/listing-service/internal/listing/usecase"
+	"github.com/Abdurahmanit/GroupProject/listing-service/internal/platform/logger"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func viewerCtx(peerAddr, forwardedFor string) context.Context {
+	addr, _ := net.ResolveTCPAddr("tcp", peerAddr)
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: addr})
+	if forwardedFor != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-forwarded-for", forwardedFor))
+	}
+	return ctx
+}
+
+func TestViewerFromContext(t *testing.T) {
+	trusted := []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}
+
+	assert.Equal(t, "203.0.113.7", viewerFromContext(viewerCtx("203.0.113.7:5000", "198.51.100.1"), trusted),
+		"x-forwarded-for from an untrusted peer must be ignored")
+	assert.Equal(t, "198.51.100.1", viewerFromContext(viewerCtx("10.0.0.2:5000", "198.51.100.1"), trusted))
+	assert.Equal(t, "198.51.100.1", viewerFromContext(viewerCtx("10.0.0.2:5000", "1.2.3.4, 198.51.100.1"), trusted))
+	assert.Equal(t, "10.0.0.2", viewerFromContext(viewerCtx("10.0.0.2:5000", ""), trusted))
+	assert.Equal(t, "203.0.113.7", viewerFromContext(viewerCtx("203.0.113.7:5000", "198.51.100.1"), nil))
+
+	withUser := context.WithValue(viewerCtx("10.0.0.2:5000", "198.51.100.1"), middleware.UserIDKey, "u1")
+	assert.Equal(t, "user:u1", viewerFromContext(withUser, trusted))
+}
+
+type ownerListingRepo struct {
+	domain.ListingRepository
+	owners map[string]string
+}
+
+func (r *ownerListingRepo) FindByID(_ context.Context, id string) (*domain.Listing, error) {
+	owner, ok := r.owners[id]
+	if !ok {
+		return nil, domain.ErrListingNotFound
+	}
+	return &domain.Listing{ID: id, UserID: owner}, nil
+}
+
+func TestHandler_AddFavoriteErrors(t *testing.T) {
+	log := logger.NewLogger()
+	listings := &ownerListingRepo{owners: map[string]string{"listing-1": "seller"}}
+	h := &Handler{
+		favoriteUsecase: usecase.NewFavoriteUsecase(nil, listings, nil, usecase.FavoriteRules{BlockOwnListing: true}, log),
+		logger:          log,
+	}
+
+	tests := map[string]struct {
+		userID, listingID string
+		want              codes.Code
+	}{
+		"own listing":     {"seller", "listing-1", codes.FailedPrecondition},
+		"missing listing": {"buyer", "missing", codes.NotFound},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.WithValue(context.Background(), middleware.UserIDKey, tt.userID)
+			_, err := h.AddFavorite(ctx, &pb.AddFavoriteRequest{UserId: tt.userID, ListingId: tt.listingID})
+			assert.Equal(t, tt.want, status.Code(err))
+		})
+	}
+}
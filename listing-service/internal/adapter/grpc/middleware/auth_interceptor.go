@@ -2,7 +2,9 @@ package middleware
 
 import (
 	"context"
+	"slices"
 	"strings"
+	"sync"
 
 	"github.com/Abdurahmanit/GroupProject/listing-service/internal/platform/logger" // Путь к твоему логгеру
 	"github.com/golang-jwt/jwt/v5"
@@ -18,14 +20,62 @@ type UserIDKeyType string
 // UserIDKey — ключ, используемый для хранения и извлечения UserID из контекста.
 const UserIDKey UserIDKeyType = "authenticatedUserID"
 
+// UserRoleKey — ключ контекста для роли пользователя. Читайте роль через UserRole.
+const UserRoleKey UserIDKeyType = "authenticatedUserRole"
+
+// RoleResolver возвращает роль пользователя из его профиля. Access-токены user-service
+// не содержат claim "role", поэтому роль запрашивается у user-service.
+type RoleResolver interface {
+	GetUserRole(ctx context.Context, userID string) (string, error)
+}
+
+// roleLookup откладывает запрос роли до первого обращения: большинству методов роль не нужна.
+type roleLookup struct {
+	once   sync.Once
+	userID string
+	roles  RoleResolver
+	role   string
+	err    error
+}
+
+// withRole кладет в контекст роль пользователя: из claim "role", если он есть, иначе
+// отложенный запрос к roles (если roles == nil, роль пустая).
+func withRole(ctx context.Context, claims *Claims, roles RoleResolver) context.Context {
+	if claims.Role != "" || roles == nil {
+		return context.WithValue(ctx, UserRoleKey, claims.Role)
+	}
+	return context.WithValue(ctx, UserRoleKey, &roleLookup{userID: claims.UserID, roles: roles})
+}
+
+// UserRole возвращает роль аутентифицированного пользователя или пустую строку для анонимного
+// запроса. Ошибка означает, что роль не удалось получить из user-service.
+func UserRole(ctx context.Context) (string, error) {
+	switch v := ctx.Value(UserRoleKey).(type) {
+	case string:
+		return v, nil
+	case *roleLookup:
+		v.once.Do(func() { v.role, v.err = v.roles.GetUserRole(ctx, v.userID) })
+		return v.role, v.err
+	}
+	return "", nil
+}
+
+// accessTokenType - значение claim "type" в access-токенах user-service. Refresh-токены и
+// токены незавершенного входа с 2FA имеют другой тип и не дают доступа к методам.
+const accessTokenType = "access"
+
 // Claims определяет структуру claims в JWT, ожидаемую от user-service.
 type Claims struct {
 	UserID string `json:"user_id"`
+	Role   string `json:"role"`
+	Type   string `json:"type"`
 	jwt.RegisteredClaims
 }
 
 // AuthInterceptor создает gRPC унарный interceptor для аутентификации.
-func AuthInterceptor(jwtSecret string, log *logger.Logger, publicMethods map[string]bool) grpc.UnaryServerInterceptor {
+// Для методов из requiredRoles пользователь дополнительно должен иметь одну из перечисленных ролей;
+// роль берется из профиля через roles.
+func AuthInterceptor(jwtSecret string, log *logger.Logger, publicMethods map[string]bool, requiredRoles map[string][]string, roles RoleResolver) grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
 		req interface{},
@@ -34,9 +84,14 @@ func AuthInterceptor(jwtSecret string, log *logger.Logger, publicMethods map[str
 	) (interface{}, error) {
 		log.Debug("AuthInterceptor: processing request", "method", info.FullMethod)
 
-		// Проверяем, является ли метод публичным
+		// Проверяем, является ли метод публичным. Токен для них необязателен, но если он валиден,
+		// UserID попадает в контекст (например, для истории просмотров в GetListingByID).
 		if publicMethods[info.FullMethod] {
 			log.Debug("AuthInterceptor: public method, skipping authentication", "method", info.FullMethod)
+			if claims := optionalClaims(ctx, jwtSecret); claims != nil {
+				ctx = context.WithValue(ctx, UserIDKey, claims.UserID)
+				ctx = withRole(ctx, claims, roles)
+			}
 			return handler(ctx, req)
 		}
 		log.Debug("AuthInterceptor: protected method, proceeding with authentication", "method", info.FullMethod)
@@ -92,16 +147,62 @@ func AuthInterceptor(jwtSecret string, log *logger.Logger, publicMethods map[str
 			return nil, status.Errorf(codes.Unauthenticated, "token is not valid")
 		}
 
+		if claims.Type != accessTokenType {
+			log.Warn("AuthInterceptor: token is not an access token", "method", info.FullMethod, "token_type", claims.Type)
+			return nil, status.Errorf(codes.Unauthenticated, "token of type '%s' cannot be used for authorization", claims.Type)
+		}
 		if claims.UserID == "" {
 			log.Error("AuthInterceptor: UserID not found in token claims after successful validation", "method", info.FullMethod)
 			return nil, status.Errorf(codes.Unauthenticated, "UserID not found in token claims")
 		}
 
-		// Добавляем UserID в контекст
+		// Добавляем UserID и роль в контекст
 		newCtx := context.WithValue(ctx, UserIDKey, claims.UserID)
+		newCtx = withRole(newCtx, claims, roles)
+
+		if allowed, ok := requiredRoles[info.FullMethod]; ok {
+			role, err := UserRole(newCtx)
+			if err != nil {
+				log.Error("AuthInterceptor: failed to resolve user role", "method", info.FullMethod, "user_id", claims.UserID, "error", err.Error())
+				return nil, status.Errorf(codes.Unavailable, "failed to resolve user role")
+			}
+			if !slices.Contains(allowed, role) {
+				log.Warn("AuthInterceptor: user does not have required role",
+					"method", info.FullMethod, "user_id", claims.UserID, "user_role", role, "required_roles", allowed)
+				return nil, status.Errorf(codes.PermissionDenied, "user role '%s' not authorized for this action", role)
+			}
+		}
+
 		log.Info("AuthInterceptor: user successfully authenticated", "method", info.FullMethod, "user_id", claims.UserID)
 
 		// Передаем управление следующему обработчику или самому RPC методу
 		return handler(newCtx, req)
 	}
-}
\ No newline at end of file
+}
+// optionalClaims возвращает claims из заголовка authorization или nil, если токена нет или он невалиден.
+func optionalClaims(ctx context.Context, jwtSecret string) *Claims {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil
+	}
+	authHeaders := md.Get("authorization")
+	if len(authHeaders) == 0 {
+		return nil
+	}
+	parts := strings.Fields(authHeaders[0])
+	if len(parts) != 2 || !strings.EqualFold(parts[0], "bearer") {
+		return nil
+	}
+
+	claims := &Claims{}
+	token, err := jwt.ParseWithClaims(parts[1], claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, jwt.ErrTokenSignatureInvalid
+		}
+		return []byte(jwtSecret), nil
+	})
+	if err != nil || !token.Valid || claims.Type != accessTokenType || claims.UserID == "" {
+		return nil
+	}
+	return claims
+}
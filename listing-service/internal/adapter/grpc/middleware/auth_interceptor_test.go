@@ -0,0 +1,106 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Abdurahmanit/GroupProject/listing-service/internal/platform/logger"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const testSecret = "test-secret"
+
+type fakeRoles struct {
+	roles map[string]string
+	err   error
+	calls int
+}
+
+func (f *fakeRoles) GetUserRole(_ context.Context, userID string) (string, error) {
+	f.calls++
+	return f.roles[userID], f.err
+}
+
+// userToken выпускает access-токен в формате user-service: без claim "role".
+func userToken(t *testing.T, userID string) context.Context {
+	t.Helper()
+	return typedToken(t, userID, "access")
+}
+
+func typedToken(t *testing.T, userID, tokenType string) context.Context {
+	t.Helper()
+	claims := jwt.MapClaims{
+		"user_id": userID,
+		"exp":     time.Now().Add(time.Hour).Unix(),
+	}
+	if tokenType != "" {
+		claims["type"] = tokenType
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	signed, err := token.SignedString([]byte(testSecret))
+	require.NoError(t, err)
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+signed))
+}
+
+func TestAuthInterceptor_RequiredRoleFromProfile(t *testing.T) {
+	const method = "/listing.ListingService/CreateCategory"
+	roles := &fakeRoles{roles: map[string]string{"admin-1": "admin", "user-1": "user"}}
+	interceptor := AuthInterceptor(testSecret, logger.NewLogger(), nil, map[string][]string{method: {"admin"}}, roles)
+	handler := func(ctx context.Context, _ interface{}) (interface{}, error) { return "ok", nil }
+	info := &grpc.UnaryServerInfo{FullMethod: method}
+
+	resp, err := interceptor(userToken(t, "admin-1"), nil, info, handler)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+
+	_, err = interceptor(userToken(t, "user-1"), nil, info, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	roles.err = errors.New("user-service down")
+	_, err = interceptor(userToken(t, "admin-1"), nil, info, handler)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestAuthInterceptor_RoleResolvedLazily(t *testing.T) {
+	const method = "/listing.ListingService/UpdateListing"
+	roles := &fakeRoles{roles: map[string]string{"admin-1": "admin"}}
+	interceptor := AuthInterceptor(testSecret, logger.NewLogger(), nil, nil, roles)
+	info := &grpc.UnaryServerInfo{FullMethod: method}
+
+	_, err := interceptor(userToken(t, "admin-1"), nil, info, func(ctx context.Context, _ interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	require.NoError(t, err)
+	assert.Zero(t, roles.calls, "role must not be fetched when the handler does not ask for it")
+
+	_, err = interceptor(userToken(t, "admin-1"), nil, info, func(ctx context.Context, _ interface{}) (interface{}, error) {
+		for i := 0; i < 2; i++ {
+			role, err := UserRole(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, "admin", role)
+		}
+		return nil, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, roles.calls)
+}
+
+func TestAuthInterceptor_RejectsNonAccessTokens(t *testing.T) {
+	const method = "/listing.ListingService/UpdateListing"
+	interceptor := AuthInterceptor(testSecret, logger.NewLogger(), nil, nil, &fakeRoles{})
+	info := &grpc.UnaryServerInfo{FullMethod: method}
+	handler := func(ctx context.Context, _ interface{}) (interface{}, error) { return "ok", nil }
+
+	for _, tokenType := range []string{"refresh", "2fa_pending", ""} {
+		_, err := interceptor(typedToken(t, "user-1", tokenType), nil, info, handler)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err), "token type %q", tokenType)
+	}
+}
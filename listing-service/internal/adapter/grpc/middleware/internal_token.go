@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"context"
+	"crypto/subtle"
+
+	"github.com/Abdurahmanit/GroupProject/listing-service/internal/platform/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// InternalTokenHeader — заголовок метаданных, в котором другие сервисы передают общий токен.
+const InternalTokenHeader = "x-internal-token"
+
+// InternalTokenInterceptor пропускает к методам из internalMethods только вызовы с токеном
+// сервиса в InternalTokenHeader (например, ReserveStock от order-service). Эти методы вызываются
+// без токена пользователя. Пустой token закрывает их полностью.
+func InternalTokenInterceptor(token string, log *logger.Logger, internalMethods map[string]bool) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if !internalMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+		if token == "" {
+			log.Warn("InternalTokenInterceptor: internal token is not configured, rejecting call", "method", info.FullMethod)
+			return nil, status.Error(codes.Unauthenticated, "internal method is disabled")
+		}
+		md, _ := metadata.FromIncomingContext(ctx)
+		values := md.Get(InternalTokenHeader)
+		if len(values) == 0 || subtle.ConstantTimeCompare([]byte(values[0]), []byte(token)) != 1 {
+			log.Warn("InternalTokenInterceptor: missing or invalid internal token", "method", info.FullMethod)
+			return nil, status.Error(codes.Unauthenticated, "invalid internal token")
+		}
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Abdurahmanit/GroupProject/listing-service/internal/platform/logger"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestInternalTokenInterceptor(t *testing.T) {
+	const method = "/listing.ListingService/ReserveStock"
+	internal := map[string]bool{method: true}
+	handler := func(ctx context.Context, _ interface{}) (interface{}, error) { return "ok", nil }
+	withToken := func(token string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(InternalTokenHeader, token))
+	}
+
+	interceptor := InternalTokenInterceptor("s3cret", logger.NewLogger(), internal)
+	info := &grpc.UnaryServerInfo{FullMethod: method}
+
+	resp, err := interceptor(withToken("s3cret"), nil, info, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+
+	_, err = interceptor(withToken("wrong"), nil, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = interceptor(context.Background(), nil, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = InternalTokenInterceptor("", logger.NewLogger(), internal)(withToken(""), nil, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err), "an unset token must close internal methods")
+
+	_, err = interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/listing.ListingService/GetListingByID"}, handler)
+	assert.NoError(t, err, "other methods are not affected")
+}
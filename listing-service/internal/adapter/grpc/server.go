@@ -3,15 +3,20 @@ package grpc
 import (
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
 	"github.com/Abdurahmanit/GroupProject/listing-service/internal/adapter/grpc/middleware"
 	"github.com/Abdurahmanit/GroupProject/listing-service/internal/platform/logger" // Твой логгер
+	"github.com/Abdurahmanit/GroupProject/platform/grpcrecovery"
 	// sdktrace "go.opentelemetry.io/otel/sdk/trace" // Если передаешь TracerProvider
 )
 
-// NewGRPCServer теперь принимает логгер и jwtSecret
+// NewGRPCServer теперь принимает логгер, jwtSecret, источник ролей пользователей
+// и токен для вызовов от других сервисов
 func NewGRPCServer(
 	appLogger *logger.Logger,
 	jwtSecret string,
+	roles middleware.RoleResolver,
+	internalToken string,
 	// tracerProvider *sdktrace.TracerProvider, // Если трейсер инициализируется в main и передается
 ) (*grpc.Server, func()) { // cleanup для остановки сервера
 
@@ -22,22 +27,43 @@ func NewGRPCServer(
 	publicMethods := map[string]bool{
 		"/listing.ListingService/GetListingByID": true,
 		"/listing.ListingService/SearchListings": true,
+		"/listing.ListingService/GetListingsStatus": true, // Вызывается order-service без токена пользователя
+		"/listing.ListingService/ReserveStock":      true, // Без токена пользователя, но только с токеном сервиса (internalMethods)
+		"/listing.ListingService/ReleaseStock":      true,
+		"/listing.ListingService/GetCategory":       true,
+		"/listing.ListingService/ListCategories":    true,
+		grpc_health_v1.Health_Check_FullMethodName:  true, // Проверки готовности от оркестратора
 		// "/listing.ListingService/GetListingStatus": true, // Сделай публичным, если нужно
 		// "/listing.ListingService/GetPhotoURLs":   true, // Сделай публичным, если нужно
 		// Добавь сюда любые другие методы, которые должны быть доступны без токена.
 	}
 
+	// Методы, доступные только пользователям с указанной ролью (роль берется из профиля в user-service)
+	requiredRoles := map[string][]string{
+		"/listing.ListingService/CreateCategory": {"admin"},
+		"/listing.ListingService/UpdateCategory": {"admin"},
+		"/listing.ListingService/DeleteCategory": {"admin"},
+	}
+
+	// Методы для других сервисов: требуют общий токен INTERNAL_API_TOKEN в x-internal-token
+	internalMethods := map[string]bool{
+		"/listing.ListingService/ReserveStock": true,
+		"/listing.ListingService/ReleaseStock": true,
+	}
+
 	unaryInterceptors := []grpc.UnaryServerInterceptor{
 		middleware.TracingInterceptor(), // Предполагается, что он у тебя есть
+		grpcrecovery.UnaryServerInterceptor(appLogger, nil), // Паника в хендлере -> codes.Internal, а не падение процесса
 		middleware.LoggingInterceptor(appLogger),
-		middleware.AuthInterceptor(jwtSecret, appLogger, publicMethods), // Передаем карту публичных методов
+		middleware.InternalTokenInterceptor(internalToken, appLogger, internalMethods),
+		middleware.AuthInterceptor(jwtSecret, appLogger, publicMethods, requiredRoles, roles), // Передаем карту публичных методов
 	}
 
 	server := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(unaryInterceptors...),
 	)
 
-	appLogger.Info("gRPC server configured with interceptors: Tracing, Logging, Auth")
+	appLogger.Info("gRPC server configured with interceptors: Tracing, Recovery, Logging, InternalToken, Auth")
 
 	cleanup := func() {
 		appLogger.Info("Calling gRPC server's GracefulStop...")
@@ -73,6 +73,59 @@ func (p *Publisher) Publish(ctx context.Context, subject string, data interface{
 	return nil
 }
 
+// ListingChangeSubjects - события, после которых результаты поиска могут измениться
+var ListingChangeSubjects = []string{
+	"listing.created",
+	"listing.updated",
+	"listing.deleted",
+	"listing.status.updated",
+	"listing.unavailable",
+}
+
+// OnListingChange подписывается на ListingChangeSubjects и вызывает fn на каждое событие.
+// Подписки закрываются вместе с соединением в Close.
+func (p *Publisher) OnListingChange(fn func(ctx context.Context) error) error {
+	for _, subject := range ListingChangeSubjects {
+		subject := subject
+		_, err := p.conn.Subscribe(subject, func(msg *nats.Msg) {
+			if err := fn(context.Background()); err != nil {
+				p.logger.Error("NATS Subscriber: listing change handler failed", "subject", subject, "error", err)
+			}
+		})
+		if err != nil {
+			return fmt.Errorf("failed to subscribe to %s: %w", subject, err)
+		}
+	}
+	p.logger.Info("NATS Subscriber: subscribed to listing change events", "subjects", ListingChangeSubjects)
+	return nil
+}
+
+// Ping reports an error while the NATS connection is down or reconnecting.
+func (p *Publisher) Ping(ctx context.Context) error {
+	if p.conn == nil || !p.conn.IsConnected() {
+		return fmt.Errorf("nats connection is not established")
+	}
+	return nil
+}
+
+// PublishProbe публикует тестовое сообщение и дожидается подтверждения сервера (Flush),
+// чтобы отказ в публикации (например, нет прав на subject) проявился сразу, а не асинхронно.
+func (p *Publisher) PublishProbe(ctx context.Context, subject string) error {
+	if err := p.Ping(ctx); err != nil {
+		return err
+	}
+	if err := p.conn.Publish(subject, []byte(`{"probe":true}`)); err != nil {
+		return fmt.Errorf("publish to %s: %w", subject, err)
+	}
+	if err := p.conn.FlushWithContext(ctx); err != nil {
+		return fmt.Errorf("flush after publishing to %s: %w", subject, err)
+	}
+	if err := p.conn.LastError(); err != nil {
+		return fmt.Errorf("server rejected publish to %s: %w", subject, err)
+	}
+	return nil
+}
+
 func (p *Publisher) Close() {
 	p.logger.Info("NATS Publisher: closing connection...")
 	if p.conn != nil && !p.conn.IsClosed() {
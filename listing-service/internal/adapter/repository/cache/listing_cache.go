@@ -2,7 +2,10 @@ package cache
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"time"
 	"log"
 	"github.com/redis/go-redis/v9"
@@ -51,6 +54,103 @@ func (c *ListingCache) DeleteListing(ctx context.Context, id string) error {
 	return c.client.Del(ctx, "listing:"+id).Err()
 }
 
+// MarkViewed запоминает просмотр на window; повторный просмотр в этом окне вернет false.
+func (c *ListingCache) MarkViewed(ctx context.Context, listingID, viewerID string, window time.Duration) (bool, error) {
+	return c.client.SetNX(ctx, "listing:viewed:"+listingID+":"+viewerID, 1, window).Result()
+}
+
+// searchGenerationKey - номер поколения кэша поиска. Он входит в ключ каждого результата,
+// поэтому INCR разом делает все сохраненные результаты недоступными, а старые ключи
+// доживают свой TTL без SCAN/DEL.
+const searchGenerationKey = "listing:search:gen"
+
+func (c *ListingCache) searchKey(ctx context.Context, filter domain.Filter) (string, error) {
+	gen, err := c.client.Get(ctx, searchGenerationKey).Result()
+	if err == redis.Nil {
+		gen = "0"
+	} else if err != nil {
+		return "", err
+	}
+	// json.Marshal сериализует поля структуры в фиксированном порядке, так что ключ детерминирован
+	data, err := json.Marshal(filter)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return "listing:search:" + gen + ":" + hex.EncodeToString(sum[:]), nil
+}
+
+func (c *ListingCache) GetSearchResult(ctx context.Context, filter domain.Filter) (*domain.SearchResult, string, error) {
+	key, err := c.searchKey(ctx, filter)
+	if err != nil {
+		return nil, "", err
+	}
+	data, err := c.client.Get(ctx, key).Bytes()
+	if err == redis.Nil {
+		return nil, key, nil
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	var result domain.SearchResult
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, "", err
+	}
+	return &result, key, nil
+}
+
+func (c *ListingCache) SetSearchResult(ctx context.Context, key string, result *domain.SearchResult, ttl time.Duration) error {
+	data, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	return c.client.Set(ctx, key, data, ttl).Err()
+}
+
+func (c *ListingCache) InvalidateSearchResults(ctx context.Context) error {
+	return c.client.Incr(ctx, searchGenerationKey).Err()
+}
+
+// historyTTL - история пользователя, который долго ничего не смотрел, удаляется целиком
+const historyTTL = 30 * 24 * time.Hour
+
+func (c *ListingCache) AddViewed(ctx context.Context, userID, listingID string, maxLen int) error {
+	key := "listing:history:" + userID
+	_, err := c.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.LRem(ctx, key, 0, listingID)
+		pipe.LPush(ctx, key, listingID)
+		pipe.LTrim(ctx, key, 0, int64(maxLen-1))
+		pipe.Expire(ctx, key, historyTTL)
+		return nil
+	})
+	return err
+}
+
+func (c *ListingCache) RecentlyViewed(ctx context.Context, userID string, limit int) ([]string, error) {
+	return c.client.LRange(ctx, "listing:history:"+userID, 0, int64(limit-1)).Result()
+}
+
+func (c *ListingCache) Ping(ctx context.Context) error {
+	return c.client.Ping(ctx).Err()
+}
+
+// RoundTrip записывает короткоживущий ключ, читает его обратно и удаляет.
+// Используется в стартовом self-test: Ping не проверяет права на запись.
+func (c *ListingCache) RoundTrip(ctx context.Context) error {
+	key := "selftest:" + time.Now().UTC().Format(time.RFC3339Nano)
+	if err := c.client.Set(ctx, key, "ok", time.Minute).Err(); err != nil {
+		return fmt.Errorf("set canary key: %w", err)
+	}
+	got, err := c.client.Get(ctx, key).Result()
+	if err != nil {
+		return fmt.Errorf("get canary key: %w", err)
+	}
+	if got != "ok" {
+		return fmt.Errorf("canary key read back %q", got)
+	}
+	return c.client.Del(ctx, key).Err()
+}
+
 func (c *ListingCache) CloseClient(ctx context.Context) error {
     // Для go-redis v9, client.Close() закрывает все соединения в пуле.
     // Передача ctx здесь больше для консистентности, Close() в v9 не принимает context.
@@ -0,0 +1,173 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Abdurahmanit/GroupProject/listing-service/internal/listing/domain"
+	"github.com/Abdurahmanit/GroupProject/listing-service/internal/platform/logger"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type CategoryRepository struct {
+	collection *mongo.Collection
+	logger     *logger.Logger
+}
+
+func NewCategoryRepository(db *mongo.Database, log *logger.Logger) *CategoryRepository {
+	r := &CategoryRepository{
+		collection: db.Collection("categories"),
+		logger:     log,
+	}
+	r.ensureIndexes()
+	return r
+}
+
+// ensureIndexes создает уникальный индекс по имени категории.
+func (r *CategoryRepository) ensureIndexes() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_, err := r.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "name", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		r.logger.Warn("NewCategoryRepository: failed to create unique index on name", "error", err)
+	}
+}
+
+func (r *CategoryRepository) Create(ctx context.Context, category *domain.Category) error {
+	now := time.Now().UTC()
+	category.CreatedAt = now
+	category.UpdatedAt = now
+
+	doc, err := toCategoryDocument(category)
+	if err != nil {
+		r.logger.Error("CategoryRepository.Create: failed to convert domain to document", "error", err, "name", category.Name)
+		return fmt.Errorf("failed to prepare category for database: %w", err)
+	}
+
+	res, err := r.collection.InsertOne(ctx, doc)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return domain.ErrDuplicateCategory
+		}
+		r.logger.Error("CategoryRepository.Create: InsertOne failed", "error", err, "name", category.Name)
+		return err
+	}
+
+	oid, ok := res.InsertedID.(primitive.ObjectID)
+	if !ok {
+		r.logger.Error("CategoryRepository.Create: InsertOne returned unexpected ID type", "type", fmt.Sprintf("%T", res.InsertedID))
+		return errors.New("failed to retrieve generated category ID")
+	}
+	category.ID = oid.Hex()
+	r.logger.Info("Category created successfully", "id", category.ID, "name", category.Name)
+	return nil
+}
+
+func (r *CategoryRepository) Update(ctx context.Context, category *domain.Category) error {
+	if category.ID == "" {
+		r.logger.Error("CategoryRepository.Update: domain category ID is empty")
+		return errors.New("cannot update category without an ID")
+	}
+
+	category.UpdatedAt = time.Now().UTC()
+
+	doc, err := toCategoryDocument(category)
+	if err != nil {
+		r.logger.Error("CategoryRepository.Update: failed to convert domain to document", "error", err, "category_id", category.ID)
+		return fmt.Errorf("failed to prepare category for database update: %w", err)
+	}
+
+	updatePayload := bson.M{
+		"name":        doc.Name,
+		"description": doc.Description,
+		"updated_at":  doc.UpdatedAt,
+	}
+
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": doc.ID}, bson.M{"$set": updatePayload})
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return domain.ErrDuplicateCategory
+		}
+		r.logger.Error("CategoryRepository.Update: UpdateOne failed", "error", err, "category_id", category.ID)
+		return err
+	}
+	if result.MatchedCount == 0 {
+		r.logger.Warn("CategoryRepository.Update: category not found", "category_id", category.ID)
+		return domain.ErrCategoryNotFound
+	}
+	r.logger.Info("Category updated successfully", "id", category.ID)
+	return nil
+}
+
+func (r *CategoryRepository) Delete(ctx context.Context, id string) error {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return domain.ErrCategoryNotFound
+	}
+
+	result, err := r.collection.DeleteOne(ctx, bson.M{"_id": objID})
+	if err != nil {
+		r.logger.Error("CategoryRepository.Delete: DeleteOne failed", "error", err, "category_id", id)
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return domain.ErrCategoryNotFound
+	}
+	r.logger.Info("Category deleted successfully", "id", id)
+	return nil
+}
+
+func (r *CategoryRepository) FindByID(ctx context.Context, id string) (*domain.Category, error) {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		r.logger.Debug("CategoryRepository.FindByID: invalid ID format", "id", id, "error", err)
+		return nil, domain.ErrCategoryNotFound
+	}
+
+	var doc categoryDocument
+	if err := r.collection.FindOne(ctx, bson.M{"_id": objID}).Decode(&doc); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, domain.ErrCategoryNotFound
+		}
+		r.logger.Error("CategoryRepository.FindByID: FindOne failed", "error", err, "id", id)
+		return nil, err
+	}
+	return toDomainCategory(&doc), nil
+}
+
+func (r *CategoryRepository) FindAll(ctx context.Context) ([]*domain.Category, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{}, options.Find().SetSort(bson.D{{Key: "name", Value: 1}}))
+	if err != nil {
+		r.logger.Error("CategoryRepository.FindAll: Find failed", "error", err)
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []*categoryDocument
+	if err = cursor.All(ctx, &docs); err != nil {
+		r.logger.Error("CategoryRepository.FindAll: Cursor All failed", "error", err)
+		return nil, err
+	}
+	categories := make([]*domain.Category, 0, len(docs))
+	for _, doc := range docs {
+		categories = append(categories, toDomainCategory(doc))
+	}
+	return categories, nil
+}
+
+func (r *CategoryRepository) Count(ctx context.Context) (int64, error) {
+	count, err := r.collection.CountDocuments(ctx, bson.M{})
+	if err != nil {
+		r.logger.Error("CategoryRepository.Count: CountDocuments failed", "error", err)
+		return 0, err
+	}
+	return count, nil
+}
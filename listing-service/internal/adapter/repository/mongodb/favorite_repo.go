@@ -13,10 +13,11 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options" // Для опций поиска
 )
 
-// Определим специфичные для репозитория ошибки (уже были в предыдущей версии)
+// Определим специфичные для репозитория ошибки (уже были в предыдущей версии).
+// Они оборачивают доменные ошибки, чтобы usecase мог проверять их через errors.Is.
 var (
-	ErrFavoriteAlreadyExistsDB = errors.New("database: favorite already exists for this user and listing")
-	ErrFavoriteNotFoundDB      = errors.New("database: favorite not found")
+	ErrFavoriteAlreadyExistsDB = fmt.Errorf("database: %w for this user and listing", domain.ErrDuplicateFavorite)
+	ErrFavoriteNotFoundDB      = fmt.Errorf("database: %w", domain.ErrFavoriteNotFound)
 )
 // Эти ошибки уже должны быть определены в этом пакете или в общем месте для ошибок БД.
 
@@ -27,13 +28,28 @@ type FavoriteRepository struct {
 
 // NewFavoriteRepository теперь принимает логгер
 func NewFavoriteRepository(db *mongo.Database, log *logger.Logger) *FavoriteRepository {
-	// Рекомендуется создать уникальный индекс в MongoDB для предотвращения дубликатов
-	// db.collection("favorites").createIndex({ "user_id": 1, "listing_id": 1 }, { unique: true })
-	// Эту операцию лучше выполнять один раз при инициализации приложения или через миграции.
-	return &FavoriteRepository{
+	r := &FavoriteRepository{
 		collection: db.Collection("favorites"),
 		logger:     log,
 	}
+	r.ensureIndexes()
+	return r
+}
+
+// ensureIndexes создает уникальный индекс по (user_id, listing_id): одновременные upsert'ы
+// одной пары иначе могут вставить два документа. Проигравший получает duplicate key,
+// который Add превращает в ErrFavoriteAlreadyExistsDB.
+func (r *FavoriteRepository) ensureIndexes() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_, err := r.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "listing_id", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		// Обычно означает, что в коллекции уже есть дубликаты - их нужно удалить вручную
+		r.logger.Warn("NewFavoriteRepository: failed to create unique index on user_id, listing_id", "error", err)
+	}
 }
 
 func (r *FavoriteRepository) Add(ctx context.Context, favorite *domain.Favorite) error {
@@ -49,9 +65,12 @@ func (r *FavoriteRepository) Add(ctx context.Context, favorite *domain.Favorite)
 	}
 	// doc.ID будет primitive.NilObjectID, если favorite.ID был пуст.
 
-	res, err := r.collection.InsertOne(ctx, doc)
+	// Upsert с $setOnInsert делает повторное добавление безопасным: существующая запись не меняется,
+	// а вызывающий получает ErrFavoriteAlreadyExistsDB и не увеличивает счетчик избранного дважды
+	filter := bson.M{"user_id": doc.UserID, "listing_id": doc.ListingID}
+	res, err := r.collection.UpdateOne(ctx, filter, bson.M{"$setOnInsert": doc}, options.Update().SetUpsert(true))
 	if err != nil {
-		if mongo.IsDuplicateKeyError(err) { // Требует уникального индекса по user_id, listing_id
+		if mongo.IsDuplicateKeyError(err) { // Гонка двух upsert'ов, разрешенная уникальным индексом
 			r.logger.Warn("FavoriteRepository.Add: favorite already exists (duplicate key error)", "user_id", favorite.UserID, "listing_id", favorite.ListingID)
 			return ErrFavoriteAlreadyExistsDB // Используем ошибку, определенную в этом пакете
 		}
@@ -59,12 +78,17 @@ func (r *FavoriteRepository) Add(ctx context.Context, favorite *domain.Favorite)
 		return err
 	}
 
+	if res.UpsertedCount == 0 {
+		r.logger.Info("FavoriteRepository.Add: favorite already exists", "user_id", favorite.UserID, "listing_id", favorite.ListingID)
+		return ErrFavoriteAlreadyExistsDB
+	}
+
 	// Обновляем ID в переданном доменном объекте
-	if oid, ok := res.InsertedID.(primitive.ObjectID); ok {
+	if oid, ok := res.UpsertedID.(primitive.ObjectID); ok {
 		favorite.ID = oid.Hex()
 		r.logger.Info("Favorite added successfully", "id", favorite.ID, "user_id", favorite.UserID, "listing_id", favorite.ListingID)
 	} else {
-		r.logger.Error("FavoriteRepository.Add: InsertOne returned unexpected ID type", "type", fmt.Sprintf("%T", res.InsertedID))
+		r.logger.Error("FavoriteRepository.Add: upsert returned unexpected ID type", "type", fmt.Sprintf("%T", res.UpsertedID))
 		return errors.New("failed to retrieve generated favorite ID")
 	}
 	return nil
@@ -121,6 +145,34 @@ func (r *FavoriteRepository) FindByUserID(ctx context.Context, userID string) ([
 	return toDomainFavorites(docs), nil // Конвертируем в слайс доменных моделей
 }
 
+func (r *FavoriteRepository) FindPageByUserID(ctx context.Context, userID string, offset, limit int64) ([]*domain.Favorite, int64, error) {
+	filter := bson.M{"user_id": userID}
+	total, err := r.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		r.logger.Error("FavoriteRepository.FindPageByUserID: CountDocuments failed", "error", err, "user_id", userID)
+		return nil, 0, err
+	}
+
+	// _id вторым ключом, чтобы записи с одинаковым created_at не прыгали между страницами
+	findOptions := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}).
+		SetSkip(offset).
+		SetLimit(limit)
+	cursor, err := r.collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		r.logger.Error("FavoriteRepository.FindPageByUserID: Find failed", "error", err, "user_id", userID)
+		return nil, 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []*favoriteDocument
+	if err = cursor.All(ctx, &docs); err != nil {
+		r.logger.Error("FavoriteRepository.FindPageByUserID: Cursor All failed", "error", err, "user_id", userID)
+		return nil, 0, err
+	}
+	return toDomainFavorites(docs), total, nil
+}
+
 // FindOneByUserIDAndListingID - полезный метод для проверки существования
 func (r *FavoriteRepository) FindOneByUserIDAndListingID(ctx context.Context, userID, listingID string) (*domain.Favorite, error) {
 	r.logger.Debug("FavoriteRepository.FindOneByUserIDAndListingID: checking for favorite", "user_id", userID, "listing_id", listingID)
@@ -139,4 +191,41 @@ func (r *FavoriteRepository) FindOneByUserIDAndListingID(ctx context.Context, us
 		return nil, err
 	}
 	return toDomainFavorite(&doc), nil
-}
\ No newline at end of file
+}
+
+// CountFavoritesByListing считает, сколько пользователей добавили объявление в избранное.
+func (r *FavoriteRepository) CountFavoritesByListing(ctx context.Context, listingID string) (int64, error) {
+	count, err := r.collection.CountDocuments(ctx, bson.M{"listing_id": listingID})
+	if err != nil {
+		r.logger.Error("FavoriteRepository.CountFavoritesByListing: CountDocuments failed", "error", err, "listing_id", listingID)
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *FavoriteRepository) TopListingIDs(ctx context.Context, limit int) ([]string, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$group", Value: bson.M{"_id": "$listing_id", "count": bson.M{"$sum": 1}}}},
+		{{Key: "$sort", Value: bson.D{{Key: "count", Value: -1}, {Key: "_id", Value: 1}}}},
+		{{Key: "$limit", Value: limit}},
+	}
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		r.logger.Error("FavoriteRepository.TopListingIDs: Aggregate failed", "error", err, "limit", limit)
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var rows []struct {
+		ListingID string `bson:"_id"`
+	}
+	if err := cursor.All(ctx, &rows); err != nil {
+		r.logger.Error("FavoriteRepository.TopListingIDs: Cursor All failed", "error", err)
+		return nil, err
+	}
+	ids := make([]string, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.ListingID)
+	}
+	return ids, nil
+}
@@ -16,14 +16,230 @@ import (
 
 type ListingRepository struct {
 	collection *mongo.Collection
-	logger     *logger.Logger // Рекомендуется добавить логгер
+	// reservations - сколько единиц списано под каждый заказ (listing_id, order_number)
+	reservations *mongo.Collection
+	logger       *logger.Logger // Рекомендуется добавить логгер
 }
 
 // NewListingRepository принимает логгер
 func NewListingRepository(db *mongo.Database, log *logger.Logger) *ListingRepository {
-	return &ListingRepository{
-		collection: db.Collection("listings"),
-		logger:     log,
+	r := &ListingRepository{
+		collection:   db.Collection("listings"),
+		reservations: db.Collection("stock_reservations"),
+		logger:       log,
+	}
+	r.backfillTitleNormalized()
+	r.ensureIndexes()
+	r.backfillQuantity()
+	r.backfillFavoriteCount()
+	return r
+}
+
+// backfillFavoriteCount пересчитывает favorite_count по коллекции favorites для объявлений,
+// созданных до появления счетчика; иначе они отдают 0, пока кто-нибудь не добавит их в избранное.
+func (r *ListingRepository) backfillFavoriteCount() {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	cursor, err := r.collection.Find(ctx, bson.M{"favorite_count": bson.M{"$exists": false}}, options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		r.logger.Error("NewListingRepository: failed to find listings without favorite_count", "error", err)
+		return
+	}
+	var listings []struct {
+		ID primitive.ObjectID `bson:"_id"`
+	}
+	if err := cursor.All(ctx, &listings); err != nil {
+		r.logger.Error("NewListingRepository: failed to read listings without favorite_count", "error", err)
+		return
+	}
+	if len(listings) == 0 {
+		return
+	}
+
+	ids := make([]string, 0, len(listings))
+	for _, l := range listings {
+		ids = append(ids, l.ID.Hex())
+	}
+	// В favorites listing_id хранится hex-строкой
+	countCursor, err := r.collection.Database().Collection("favorites").Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"listing_id": bson.M{"$in": ids}}}},
+		{{Key: "$group", Value: bson.M{"_id": "$listing_id", "count": bson.M{"$sum": 1}}}},
+	})
+	if err != nil {
+		r.logger.Error("NewListingRepository: failed to count favorites for favorite_count backfill", "error", err)
+		return
+	}
+	var counts []struct {
+		ListingID string `bson:"_id"`
+		Count     int64  `bson:"count"`
+	}
+	if err := countCursor.All(ctx, &counts); err != nil {
+		r.logger.Error("NewListingRepository: failed to read favorite counts", "error", err)
+		return
+	}
+	byListing := make(map[string]int64, len(counts))
+	for _, c := range counts {
+		byListing[c.ListingID] = c.Count
+	}
+
+	models := make([]mongo.WriteModel, 0, len(listings))
+	for _, l := range listings {
+		// $exists в фильтре: не затираем счетчик, если AddFavorite успел его увеличить
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"_id": l.ID, "favorite_count": bson.M{"$exists": false}}).
+			SetUpdate(bson.M{"$set": bson.M{"favorite_count": byListing[l.ID.Hex()]}}))
+	}
+	res, err := r.collection.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	if err != nil {
+		r.logger.Error("NewListingRepository: failed to backfill favorite_count", "error", err)
+		return
+	}
+	r.logger.Info("NewListingRepository: backfilled favorite_count", "count", res.ModifiedCount)
+}
+
+// uniqueActiveTitleIndex - частичный уникальный индекс (user_id, title_normalized) по активным объявлениям.
+// Без него две одновременные публикации с одним заголовком обе проходят проверку FindActiveByTitle.
+const uniqueActiveTitleIndex = "user_title_active_unique"
+
+// backfillTitleNormalized заполняет title_normalized объявлениям, созданным до проверки дубликатов:
+// иначе FindActiveByTitle и уникальный индекс их не видят.
+func (r *ListingRepository) backfillTitleNormalized() {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	filter := bson.M{"$or": bson.A{
+		bson.M{"title_normalized": bson.M{"$exists": false}},
+		bson.M{"title_normalized": ""},
+	}}
+	cursor, err := r.collection.Find(ctx, filter, options.Find().SetProjection(bson.M{"_id": 1, "title": 1}))
+	if err != nil {
+		r.logger.Error("NewListingRepository: failed to find listings without title_normalized", "error", err)
+		return
+	}
+	defer cursor.Close(ctx)
+
+	var models []mongo.WriteModel
+	for cursor.Next(ctx) {
+		var doc struct {
+			ID    primitive.ObjectID `bson:"_id"`
+			Title string             `bson:"title"`
+		}
+		if err := cursor.Decode(&doc); err != nil {
+			r.logger.Error("NewListingRepository: failed to decode listing for title_normalized backfill", "error", err)
+			return
+		}
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"_id": doc.ID}).
+			SetUpdate(bson.M{"$set": bson.M{"title_normalized": domain.NormalizeTitle(doc.Title)}}))
+	}
+	if err := cursor.Err(); err != nil {
+		r.logger.Error("NewListingRepository: cursor failed during title_normalized backfill", "error", err)
+		return
+	}
+	if len(models) == 0 {
+		return
+	}
+	res, err := r.collection.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	if err != nil {
+		r.logger.Error("NewListingRepository: failed to backfill title_normalized", "error", err)
+		return
+	}
+	r.logger.Info("NewListingRepository: backfilled title_normalized", "count", res.ModifiedCount)
+}
+
+// EnsureUniqueActiveTitles создает уникальный индекс по заголовкам активных объявлений, если правило
+// LISTING_UNIQUE_TITLE_PER_SELLER включено, и удаляет его, если выключено: пока правило выключено,
+// продавец может опубликовать дубликаты, и индекс не должен ему мешать.
+// Если среди активных объявлений уже есть дубликаты, индекс не создастся; проверка в usecase
+// продолжит работать, но без защиты от гонки.
+func (r *ListingRepository) EnsureUniqueActiveTitles(enabled bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if !enabled {
+		if _, err := r.collection.Indexes().DropOne(ctx, uniqueActiveTitleIndex); err != nil {
+			var cmdErr mongo.CommandError
+			if errors.As(err, &cmdErr) && cmdErr.Name == "IndexNotFound" {
+				return
+			}
+			r.logger.Warn("EnsureUniqueActiveTitles: failed to drop unique title index", "error", err)
+		}
+		return
+	}
+	_, err := r.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "title_normalized", Value: 1}},
+		Options: options.Index().
+			SetName(uniqueActiveTitleIndex).
+			SetUnique(true).
+			SetPartialFilterExpression(bson.M{"status": domain.StatusActive}),
+	})
+	if err != nil {
+		r.logger.Error("EnsureUniqueActiveTitles: failed to create unique title index, resolve duplicate active titles", "error", err)
+	}
+}
+
+// duplicateTitleError превращает ошибку уникального индекса в DuplicateTitleError с ID
+// объявления, которое опередило текущую запись.
+func (r *ListingRepository) duplicateTitleError(ctx context.Context, listing *domain.Listing) error {
+	dupErr := &domain.DuplicateTitleError{}
+	existing, err := r.FindActiveByTitle(ctx, listing.UserID, domain.NormalizeTitle(listing.Title), listing.ID)
+	if err == nil {
+		dupErr.ListingID = existing.ID
+	}
+	return dupErr
+}
+
+// backfillQuantity проставляет quantity = 1 объявлениям, созданным до учета остатков:
+// раньше каждое объявление было одной единицей товара.
+func (r *ListingRepository) backfillQuantity() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := r.collection.UpdateMany(ctx, bson.M{"quantity": bson.M{"$exists": false}}, bson.M{"$set": bson.M{"quantity": 1}})
+	if err != nil {
+		r.logger.Error("NewListingRepository: failed to backfill quantity", "error", err)
+		return
+	}
+	if res.ModifiedCount > 0 {
+		r.logger.Info("NewListingRepository: backfilled quantity", "count", res.ModifiedCount)
+	}
+}
+
+// earthRadiusKm используется для перевода радиуса в радианы для $centerSphere
+const earthRadiusKm = 6378.1
+
+// ensureIndexes создает 2dsphere индекс для геопоиска, текстовый индекс для полнотекстового поиска
+// и индекс (user_id, title_normalized, status) для проверки дубликатов заголовков.
+// Объявления без location в 2dsphere индекс не попадают.
+// Ошибка 2dsphere не фатальна ($geoWithin работает и без индекса, но медленнее);
+// без текстового индекса запросы с Query будут падать, поэтому это логируется как Error.
+func (r *ListingRepository) ensureIndexes() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_, err := r.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "location", Value: "2dsphere"}},
+	})
+	if err != nil {
+		r.logger.Warn("NewListingRepository: failed to create 2dsphere index on location", "error", err)
+	}
+	_, err = r.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "title", Value: "text"}, {Key: "description", Value: "text"}},
+		// Совпадение в заголовке важнее, чем в описании
+		Options: options.Index().SetName("listing_text").SetWeights(bson.D{{Key: "title", Value: 3}, {Key: "description", Value: 1}}),
+	})
+	if err != nil {
+		r.logger.Error("NewListingRepository: failed to create text index on title/description", "error", err)
+	}
+	_, err = r.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "title_normalized", Value: 1}, {Key: "status", Value: 1}},
+	})
+	if err != nil {
+		r.logger.Warn("NewListingRepository: failed to create user_id/title_normalized/status index", "error", err)
+	}
+	// Без уникального индекса повторный ReserveStock того же заказа мог бы списать остаток дважды
+	_, err = r.reservations.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "listing_id", Value: 1}, {Key: "order_number", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		r.logger.Error("NewListingRepository: failed to create unique index on stock reservations", "error", err)
 	}
 }
 
@@ -44,6 +260,9 @@ func (r *ListingRepository) Create(ctx context.Context, listing *domain.Listing)
 
 	res, err := r.collection.InsertOne(ctx, doc)
 	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return r.duplicateTitleError(ctx, listing)
+		}
 		r.logger.Error("Create Listing: InsertOne failed", "error", err, "user_id", listing.UserID, "title", listing.Title)
 		return err
 	}
@@ -81,19 +300,28 @@ func (r *ListingRepository) Update(ctx context.Context, listing *domain.Listing)
 	// измененные поля, логика должна быть сложнее, или usecase должен передавать только изменения.
 	// Пока обновляем весь документ (кроме _id).
 	updatePayload := bson.M{
-		"user_id":     doc.UserID,
-		"category_id": doc.CategoryID,
-		"title":       doc.Title,
-		"description": doc.Description,
-		"price":       doc.Price,
-		"status":      doc.Status,
-		"photos":      doc.Photos,
+		"user_id":            doc.UserID,
+		"category_id":        doc.CategoryID,
+		"title":              doc.Title,
+		"title_normalized":   doc.TitleNormalized,
+		"description":        doc.Description,
+		"price":              doc.Price,
+		"negotiable":         doc.Negotiable,
+		"status":             doc.Status,
+		"unavailable_reason": doc.UnavailableReason,
+		"photos":             doc.Photos,
+		"location":           doc.Location,
+		"available_from":     doc.AvailableFrom,
+		"available_until":    doc.AvailableUntil,
 		// CreatedAt не обновляем
 		"updated_at": doc.UpdatedAt,
 	}
 
 	result, err := r.collection.UpdateOne(ctx, filter, bson.M{"$set": updatePayload})
 	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return r.duplicateTitleError(ctx, listing)
+		}
 		r.logger.Error("Update Listing: UpdateOne failed", "id", listing.ID, "error", err)
 		return err
 	}
@@ -111,29 +339,35 @@ func (r *ListingRepository) Update(ctx context.Context, listing *domain.Listing)
 	return nil
 }
 
-func (r *ListingRepository) Delete(ctx context.Context, id string) error {
+// SoftDelete помечает объявление удаленным; документ остается в коллекции для администраторов.
+func (r *ListingRepository) SoftDelete(ctx context.Context, id string, deletedAt time.Time) error {
 	if id == "" {
-		r.logger.Error("Delete Listing: ID is empty")
+		r.logger.Error("SoftDelete Listing: ID is empty")
 		return errors.New("cannot delete listing without an ID")
 	}
 	objID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
-		r.logger.Error("Delete Listing: Invalid ID format", "id", id, "error", err)
+		r.logger.Error("SoftDelete Listing: Invalid ID format", "id", id, "error", err)
 		return fmt.Errorf("invalid ID format for delete '%s': %w", id, err)
 	}
 
-	filter := bson.M{"_id": objID}
-	result, err := r.collection.DeleteOne(ctx, filter)
+	deletedAt = deletedAt.UTC()
+	update := bson.M{"$set": bson.M{
+		"status":     domain.StatusDeleted,
+		"deleted_at": deletedAt,
+		"updated_at": deletedAt,
+	}}
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": objID}, update)
 	if err != nil {
-		r.logger.Error("Delete Listing: DeleteOne failed", "id", id, "error", err)
+		r.logger.Error("SoftDelete Listing: UpdateOne failed", "id", id, "error", err)
 		return err
 	}
 
-	if result.DeletedCount == 0 {
-		r.logger.Warn("Delete Listing: No document matched for delete", "id", id)
+	if result.MatchedCount == 0 {
+		r.logger.Warn("SoftDelete Listing: No document matched for delete", "id", id)
 		return domain.ErrListingNotFound
 	}
-	r.logger.Info("Listing deleted successfully", "id", id)
+	r.logger.Info("Listing soft-deleted successfully", "id", id)
 	return nil
 }
 
@@ -202,23 +436,317 @@ func (r *ListingRepository) FindByID(ctx context.Context, id string) (*domain.Li
 	return toDomainListing(&doc), nil
 }
 
+func (r *ListingRepository) FindStatusesByIDs(ctx context.Context, ids []string) ([]*domain.Listing, error) {
+	objIDs := make([]primitive.ObjectID, 0, len(ids))
+	for _, id := range ids {
+		objID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			r.logger.Debug("FindStatusesByIDs: skipping invalid ID", "id", id)
+			continue
+		}
+		objIDs = append(objIDs, objID)
+	}
+	if len(objIDs) == 0 {
+		return []*domain.Listing{}, nil
+	}
+
+	findOptions := options.Find().SetProjection(bson.M{"status": 1, "price": 1, "user_id": 1, "available_from": 1, "available_until": 1, "quantity": 1})
+	cursor, err := r.collection.Find(ctx, bson.M{"_id": bson.M{"$in": objIDs}}, findOptions)
+	if err != nil {
+		r.logger.Error("FindStatusesByIDs: Find failed", "count", len(objIDs), "error", err)
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []*listingDocument
+	if err = cursor.All(ctx, &docs); err != nil {
+		r.logger.Error("FindStatusesByIDs: Cursor All failed", "error", err)
+		return nil, err
+	}
+	return toDomainListings(docs), nil
+}
+
+func (r *ListingRepository) FindByIDs(ctx context.Context, ids []string) ([]*domain.Listing, error) {
+	objIDs := make([]primitive.ObjectID, 0, len(ids))
+	for _, id := range ids {
+		objID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			r.logger.Debug("FindByIDs: skipping invalid ID", "id", id)
+			continue
+		}
+		objIDs = append(objIDs, objID)
+	}
+	if len(objIDs) == 0 {
+		return []*domain.Listing{}, nil
+	}
+
+	cursor, err := r.collection.Find(ctx, bson.M{"_id": bson.M{"$in": objIDs}})
+	if err != nil {
+		r.logger.Error("FindByIDs: Find failed", "count", len(objIDs), "error", err)
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []*listingDocument
+	if err = cursor.All(ctx, &docs); err != nil {
+		r.logger.Error("FindByIDs: Cursor All failed", "error", err)
+		return nil, err
+	}
+	return toDomainListings(docs), nil
+}
+
+func (r *ListingRepository) FindActiveByTitle(ctx context.Context, userID, normalizedTitle, excludeID string) (*domain.Listing, error) {
+	filter := bson.M{
+		"user_id":          userID,
+		"title_normalized": normalizedTitle,
+		"status":           domain.StatusActive,
+	}
+	if objID, err := primitive.ObjectIDFromHex(excludeID); err == nil {
+		filter["_id"] = bson.M{"$ne": objID}
+	}
+
+	var doc listingDocument
+	err := r.collection.FindOne(ctx, filter, options.FindOne().SetProjection(bson.M{"_id": 1, "user_id": 1, "title": 1, "status": 1})).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, domain.ErrListingNotFound
+		}
+		r.logger.Error("FindActiveByTitle: FindOne failed", "user_id", userID, "error", err)
+		return nil, err
+	}
+	return toDomainListing(&doc), nil
+}
+
+// CountByCategory считает все объявления категории, в том числе черновики и снятые с продажи.
+func (r *ListingRepository) CountByCategory(ctx context.Context, categoryID string) (int64, error) {
+	count, err := r.collection.CountDocuments(ctx, bson.M{"category_id": categoryID})
+	if err != nil {
+		r.logger.Error("CountByCategory: CountDocuments failed", "category_id", categoryID, "error", err)
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *ListingRepository) IncrementViewCount(ctx context.Context, listingID string) (int64, error) {
+	objID, err := primitive.ObjectIDFromHex(listingID)
+	if err != nil {
+		return 0, domain.ErrListingNotFound
+	}
+
+	opts := options.FindOneAndUpdate().
+		SetReturnDocument(options.After).
+		SetProjection(bson.M{"view_count": 1})
+	var doc struct {
+		ViewCount int64 `bson:"view_count"`
+	}
+	err = r.collection.FindOneAndUpdate(ctx, bson.M{"_id": objID}, bson.M{"$inc": bson.M{"view_count": 1}}, opts).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return 0, domain.ErrListingNotFound
+		}
+		r.logger.Error("IncrementViewCount: FindOneAndUpdate failed", "id", listingID, "error", err)
+		return 0, err
+	}
+	return doc.ViewCount, nil
+}
+
+func (r *ListingRepository) IncrementFavoriteCount(ctx context.Context, listingID string, delta int64) (int64, error) {
+	objID, err := primitive.ObjectIDFromHex(listingID)
+	if err != nil {
+		return 0, domain.ErrListingNotFound
+	}
+
+	opts := options.FindOneAndUpdate().
+		SetReturnDocument(options.After).
+		SetProjection(bson.M{"favorite_count": 1})
+	var doc struct {
+		FavoriteCount int64 `bson:"favorite_count"`
+	}
+	err = r.collection.FindOneAndUpdate(ctx, bson.M{"_id": objID}, bson.M{"$inc": bson.M{"favorite_count": delta}}, opts).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return 0, domain.ErrListingNotFound
+		}
+		r.logger.Error("IncrementFavoriteCount: FindOneAndUpdate failed", "id", listingID, "error", err)
+		return 0, err
+	}
+	return doc.FavoriteCount, nil
+}
+
+func (r *ListingRepository) SetFavoriteCount(ctx context.Context, listingID string, count int64) error {
+	objID, err := primitive.ObjectIDFromHex(listingID)
+	if err != nil {
+		return domain.ErrListingNotFound
+	}
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": objID}, bson.M{"$set": bson.M{"favorite_count": count}})
+	if err != nil {
+		r.logger.Error("SetFavoriteCount: UpdateOne failed", "id", listingID, "error", err)
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return domain.ErrListingNotFound
+	}
+	return nil
+}
+
+// stockReservation - единицы объявления, списанные под заказ.
+type stockReservation struct {
+	ListingID   string    `bson:"listing_id"`
+	OrderNumber string    `bson:"order_number"`
+	Quantity    int64     `bson:"quantity"`
+	Released    bool      `bson:"released"`
+	CreatedAt   time.Time `bson:"created_at"`
+}
+
+// ReserveStock списывает остаток одним $inc; условие quantity >= n в фильтре не дает уйти в минус
+// при одновременных заказах. Резерв записывается после списания: если процесс упадет между
+// этими шагами, остаток окажется занижен, но не продан дважды.
+func (r *ListingRepository) ReserveStock(ctx context.Context, listingID, orderNumber string, quantity int64) (int64, error) {
+	objID, err := primitive.ObjectIDFromHex(listingID)
+	if err != nil {
+		return 0, domain.ErrListingNotFound
+	}
+
+	key := bson.M{"listing_id": listingID, "order_number": orderNumber}
+	count, err := r.reservations.CountDocuments(ctx, key)
+	if err != nil {
+		r.logger.Error("ReserveStock: CountDocuments on reservations failed", "id", listingID, "order_number", orderNumber, "error", err)
+		return 0, err
+	}
+	if count > 0 {
+		// Повторный вызов для того же заказа: остаток уже списан
+		return r.currentQuantity(ctx, objID)
+	}
+
+	filter := bson.M{
+		"_id":      objID,
+		"status":   domain.StatusActive,
+		"quantity": bson.M{"$gte": quantity},
+	}
+	opts := options.FindOneAndUpdate().
+		SetReturnDocument(options.After).
+		SetProjection(bson.M{"quantity": 1})
+	var doc struct {
+		Quantity int64 `bson:"quantity"`
+	}
+	err = r.collection.FindOneAndUpdate(ctx, filter, bson.M{"$inc": bson.M{"quantity": -quantity}}, opts).Decode(&doc)
+	if err != nil {
+		if !errors.Is(err, mongo.ErrNoDocuments) {
+			r.logger.Error("ReserveStock: FindOneAndUpdate failed", "id", listingID, "error", err)
+			return 0, err
+		}
+		// Ничего не списано: отличаем несуществующее объявление от нехватки остатка
+		count, err := r.collection.CountDocuments(ctx, bson.M{"_id": objID})
+		if err != nil {
+			r.logger.Error("ReserveStock: CountDocuments failed", "id", listingID, "error", err)
+			return 0, err
+		}
+		if count == 0 {
+			return 0, domain.ErrListingNotFound
+		}
+		return 0, domain.ErrOutOfStock
+	}
+
+	_, err = r.reservations.InsertOne(ctx, stockReservation{
+		ListingID:   listingID,
+		OrderNumber: orderNumber,
+		Quantity:    quantity,
+		CreatedAt:   time.Now().UTC(),
+	})
+	if err != nil {
+		// Одновременный вызов для того же заказа успел записать резерв первым - возвращаем свое списание
+		if _, errInc := r.collection.UpdateOne(ctx, bson.M{"_id": objID}, bson.M{"$inc": bson.M{"quantity": quantity}}); errInc != nil {
+			r.logger.Error("ReserveStock: failed to return stock after reservation insert failed", "id", listingID, "order_number", orderNumber, "error", errInc)
+		}
+		if mongo.IsDuplicateKeyError(err) {
+			return r.currentQuantity(ctx, objID)
+		}
+		r.logger.Error("ReserveStock: InsertOne reservation failed", "id", listingID, "order_number", orderNumber, "error", err)
+		return 0, err
+	}
+	return doc.Quantity, nil
+}
+
+func (r *ListingRepository) currentQuantity(ctx context.Context, objID primitive.ObjectID) (int64, error) {
+	var doc struct {
+		Quantity int64 `bson:"quantity"`
+	}
+	err := r.collection.FindOne(ctx, bson.M{"_id": objID}, options.FindOne().SetProjection(bson.M{"quantity": 1})).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return 0, domain.ErrListingNotFound
+		}
+		return 0, err
+	}
+	return doc.Quantity, nil
+}
+
+// ReleaseStock сначала помечает резерв возвращенным, затем возвращает единицы, поэтому
+// повторные вызовы (отмена после неудачного оформления, возврат денег) ничего не добавляют.
+func (r *ListingRepository) ReleaseStock(ctx context.Context, listingID, orderNumber string) (int64, error) {
+	objID, err := primitive.ObjectIDFromHex(listingID)
+	if err != nil {
+		return 0, domain.ErrListingNotFound
+	}
+
+	var reservation stockReservation
+	err = r.reservations.FindOneAndUpdate(ctx,
+		bson.M{"listing_id": listingID, "order_number": orderNumber, "released": false},
+		bson.M{"$set": bson.M{"released": true, "released_at": time.Now().UTC()}},
+	).Decode(&reservation)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return 0, nil
+		}
+		r.logger.Error("ReleaseStock: FindOneAndUpdate on reservations failed", "id", listingID, "order_number", orderNumber, "error", err)
+		return 0, err
+	}
+
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": objID}, bson.M{"$inc": bson.M{"quantity": reservation.Quantity}})
+	if err != nil {
+		r.logger.Error("ReleaseStock: UpdateOne failed", "id", listingID, "order_number", orderNumber, "quantity", reservation.Quantity, "error", err)
+		return 0, err
+	}
+	if result.MatchedCount == 0 {
+		return 0, domain.ErrListingNotFound
+	}
+	return reservation.Quantity, nil
+}
+
+func (r *ListingRepository) SetQuantity(ctx context.Context, listingID string, quantity int64) error {
+	objID, err := primitive.ObjectIDFromHex(listingID)
+	if err != nil {
+		return domain.ErrListingNotFound
+	}
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": objID}, bson.M{"$set": bson.M{"quantity": quantity}})
+	if err != nil {
+		r.logger.Error("SetQuantity: UpdateOne failed", "id", listingID, "error", err)
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return domain.ErrListingNotFound
+	}
+	return nil
+}
+
 func (r *ListingRepository) FindByFilter(ctx context.Context, filter domain.Filter) ([]*domain.Listing, int64, error) {
 	r.logger.Info("FindByFilter: Searching listings", "filter", fmt.Sprintf("%+v", filter))
 	mongoFilter := bson.M{}
 	var filterParts []bson.M // Используем $and для надежного комбинирования
 
 	if filter.Query != "" {
-		// $text поиск требует текстового индекса. Если его нет, используй $regex.
-		// filterParts = append(filterParts, bson.M{"$text": bson.M{"$search": filter.Query}})
-		// Альтернатива с $regex для поиска по нескольким полям:
-		regexQuery := primitive.Regex{Pattern: filter.Query, Options: "i"}
-		filterParts = append(filterParts, bson.M{"$or": []bson.M{
-			{"title": regexQuery},
-			{"description": regexQuery},
-		}})
+		// Полнотекстовый поиск по индексу listing_text (см. ensureIndexes)
+		filterParts = append(filterParts, bson.M{"$text": bson.M{"$search": filter.Query}})
 	}
 	if filter.Status != "" {
 		filterParts = append(filterParts, bson.M{"status": filter.Status})
+	} else {
+		// Снятые с продажи объявления не показываем в поиске, если статус не запрошен явно
+		filterParts = append(filterParts, bson.M{"status": bson.M{"$nin": []domain.ListingStatus{domain.StatusSoldElsewhere, domain.StatusWithdrawn}}})
+	}
+	if !filter.IncludeDeleted {
+		// Удаленные объявления скрыты даже при явном запросе статуса, если это не администратор
+		filterParts = append(filterParts, bson.M{"status": bson.M{"$ne": domain.StatusDeleted}})
 	}
 	if filter.CategoryID != "" {
 		filterParts = append(filterParts, bson.M{"category_id": filter.CategoryID})
@@ -226,6 +754,17 @@ func (r *ListingRepository) FindByFilter(ctx context.Context, filter domain.Filt
 	if filter.UserID != "" {
 		filterParts = append(filterParts, bson.M{"user_id": filter.UserID})
 	}
+	if filter.ExcludeUserID != "" {
+		filterParts = append(filterParts, bson.M{"user_id": bson.M{"$ne": filter.ExcludeUserID}})
+	}
+	if !filter.IncludeScheduled {
+		// $not также пропускает документы без поля, т.е. объявления без ограничения
+		now := time.Now()
+		filterParts = append(filterParts,
+			bson.M{"available_from": bson.M{"$not": bson.M{"$gt": now}}},
+			bson.M{"available_until": bson.M{"$not": bson.M{"$lte": now}}},
+		)
+	}
 
 	priceConditions := bson.M{}
 	if filter.MinPrice > 0 {
@@ -237,6 +776,12 @@ func (r *ListingRepository) FindByFilter(ctx context.Context, filter domain.Filt
 	if len(priceConditions) > 0 {
 		filterParts = append(filterParts, bson.M{"price": priceConditions})
 	}
+	if filter.RadiusKm > 0 {
+		// $geoWithin (в отличие от $near) совместим с CountDocuments и заданной сортировкой
+		filterParts = append(filterParts, bson.M{"location": bson.M{"$geoWithin": bson.M{
+			"$centerSphere": bson.A{bson.A{filter.CenterLng, filter.CenterLat}, filter.RadiusKm / earthRadiusKm},
+		}}})
+	}
 	
 	if len(filterParts) > 0 {
 		mongoFilter["$and"] = filterParts
@@ -259,6 +804,11 @@ func (r *ListingRepository) FindByFilter(ctx context.Context, filter domain.Filt
 			sortOrderValue = -1 // DESC
 		}
 		findOptions.SetSort(bson.D{{Key: filter.SortBy, Value: sortOrderValue}})
+	} else if filter.Query != "" {
+		// Без явной сортировки текстовый поиск сортируется по релевантности
+		textScore := bson.M{"$meta": "textScore"}
+		findOptions.SetProjection(bson.M{"score": textScore})
+		findOptions.SetSort(bson.D{{Key: "score", Value: textScore}, {Key: "created_at", Value: -1}})
 	} else {
 		findOptions.SetSort(bson.D{{Key: "created_at", Value: -1}}) // Default sort
 	}
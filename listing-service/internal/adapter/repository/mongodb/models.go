@@ -11,16 +11,39 @@ import (
 
 // listingDocument - структура для хранения Listing в MongoDB
 type listingDocument struct {
-	ID          primitive.ObjectID   `bson:"_id,omitempty"` // Используем ObjectID
-	UserID      string               `bson:"user_id"`
-	CategoryID  string               `bson:"category_id"`
-	Title       string               `bson:"title"`
-	Description string               `bson:"description"`
-	Price       float64              `bson:"price"`
-	Status      domain.ListingStatus `bson:"status"`
-	Photos      []string             `bson:"photos,omitempty"`
-	CreatedAt   time.Time            `bson:"created_at"`
-	UpdatedAt   time.Time            `bson:"updated_at"`
+	ID                primitive.ObjectID   `bson:"_id,omitempty"` // Используем ObjectID
+	UserID            string               `bson:"user_id"`
+	CategoryID        string               `bson:"category_id"`
+	Title             string               `bson:"title"`
+	TitleNormalized   string               `bson:"title_normalized"` // domain.NormalizeTitle(Title), для проверки дубликатов
+	Description       string               `bson:"description"`
+	Price             float64              `bson:"price"`
+	Negotiable        bool                 `bson:"negotiable,omitempty"`
+	Status            domain.ListingStatus `bson:"status"`
+	UnavailableReason string               `bson:"unavailable_reason,omitempty"`
+	Photos            []string             `bson:"photos,omitempty"`
+	Location          *geoPoint            `bson:"location,omitempty"` // 2dsphere индекс
+	ViewCount         int64                `bson:"view_count"`         // Только $inc, в Update не перезаписывается
+	FavoriteCount     int64                `bson:"favorite_count"`     // Только $inc/SetFavoriteCount, в Update не перезаписывается
+	Quantity          int64                `bson:"quantity"`           // Только $inc в ReserveStock/ReleaseStock, в Update не перезаписывается
+	CreatedAt         time.Time            `bson:"created_at"`
+	UpdatedAt         time.Time            `bson:"updated_at"`
+	DeletedAt         *time.Time           `bson:"deleted_at,omitempty"` // Только SoftDelete, в Update не перезаписывается
+	AvailableFrom     *time.Time           `bson:"available_from,omitempty"`  // nil - без ограничения
+	AvailableUntil    *time.Time           `bson:"available_until,omitempty"` // nil - без ограничения
+}
+
+// geoPoint - GeoJSON Point; координаты в порядке [longitude, latitude]
+type geoPoint struct {
+	Type        string    `bson:"type"`
+	Coordinates []float64 `bson:"coordinates"`
+}
+
+func toGeoPoint(l *domain.Listing) *geoPoint {
+	if !l.HasLocation() {
+		return nil
+	}
+	return &geoPoint{Type: "Point", Coordinates: []float64{l.Longitude, l.Latitude}}
 }
 
 // favoriteDocument - структура для хранения Favorite в MongoDB
@@ -31,6 +54,30 @@ type favoriteDocument struct {
 	CreatedAt time.Time          `bson:"created_at"`
 }
 
+// offerDocument - структура для хранения Offer в MongoDB
+type offerDocument struct {
+	ID            primitive.ObjectID `bson:"_id,omitempty"`
+	ListingID     string             `bson:"listing_id"`
+	BuyerID       string             `bson:"buyer_id"`
+	SellerID      string             `bson:"seller_id"`
+	Amount        float64            `bson:"amount"`
+	CounterAmount float64            `bson:"counter_amount,omitempty"`
+	AgreedPrice   float64            `bson:"agreed_price,omitempty"`
+	Status        domain.OfferStatus `bson:"status"`
+	LastActor     string             `bson:"last_actor,omitempty"`
+	CreatedAt     time.Time          `bson:"created_at"`
+	UpdatedAt     time.Time          `bson:"updated_at"`
+}
+
+// categoryDocument - структура для хранения Category в MongoDB
+type categoryDocument struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty"`
+	Name        string             `bson:"name"`
+	Description string             `bson:"description,omitempty"`
+	CreatedAt   time.Time          `bson:"created_at"`
+	UpdatedAt   time.Time          `bson:"updated_at"`
+}
+
 // --- Конвертеры для Listing ---
 
 // toListingDocument конвертирует доменную модель Listing в listingDocument.
@@ -61,36 +108,72 @@ func toListingDocument(l *domain.Listing) (*listingDocument, error) {
 	}
 
 	return &listingDocument{
-		ID:          docID,
-		UserID:      l.UserID,
-		CategoryID:  l.CategoryID,
-		Title:       l.Title,
-		Description: l.Description,
-		Price:       l.Price,
-		Status:      l.Status,
-		Photos:      l.Photos,
-		CreatedAt:   l.CreatedAt, // Будет установлено/обновлено в репозитории
-		UpdatedAt:   l.UpdatedAt, // Будет установлено/обновлено в репозитории
+		ID:                docID,
+		UserID:            l.UserID,
+		CategoryID:        l.CategoryID,
+		Title:             l.Title,
+		TitleNormalized:   domain.NormalizeTitle(l.Title),
+		Description:       l.Description,
+		Price:             l.Price,
+		Negotiable:        l.Negotiable,
+		Status:            l.Status,
+		UnavailableReason: l.UnavailableReason,
+		Photos:            l.Photos,
+		Location:          toGeoPoint(l),
+		ViewCount:         l.ViewCount,
+		FavoriteCount:     l.FavoriteCount,
+		Quantity:          l.Quantity,
+		CreatedAt:         l.CreatedAt, // Будет установлено/обновлено в репозитории
+		UpdatedAt:         l.UpdatedAt, // Будет установлено/обновлено в репозитории
+		AvailableFrom:     optionalTime(l.Availability.From),
+		AvailableUntil:    optionalTime(l.Availability.Until),
 	}, nil
 }
 
+// optionalTime хранит нулевое время как отсутствующее поле.
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
 // toDomainListing конвертирует listingDocument из БД в доменную модель Listing.
 func toDomainListing(d *listingDocument) *domain.Listing {
 	if d == nil {
 		return nil
 	}
-	return &domain.Listing{
-		ID:          d.ID.Hex(), // Конвертируем ObjectID в строковое представление
-		UserID:      d.UserID,
-		CategoryID:  d.CategoryID,
-		Title:       d.Title,
-		Description: d.Description,
-		Price:       d.Price,
-		Status:      d.Status,
-		Photos:      d.Photos,
-		CreatedAt:   d.CreatedAt,
-		UpdatedAt:   d.UpdatedAt,
+	listing := &domain.Listing{
+		ID:                d.ID.Hex(), // Конвертируем ObjectID в строковое представление
+		UserID:            d.UserID,
+		CategoryID:        d.CategoryID,
+		Title:             d.Title,
+		Description:       d.Description,
+		Price:             d.Price,
+		Negotiable:        d.Negotiable,
+		Status:            d.Status,
+		UnavailableReason: d.UnavailableReason,
+		Photos:            d.Photos,
+		ViewCount:         d.ViewCount,
+		FavoriteCount:     d.FavoriteCount,
+		Quantity:          d.Quantity,
+		CreatedAt:         d.CreatedAt,
+		UpdatedAt:         d.UpdatedAt,
+	}
+	if d.Location != nil && len(d.Location.Coordinates) == 2 {
+		listing.Longitude = d.Location.Coordinates[0]
+		listing.Latitude = d.Location.Coordinates[1]
+	}
+	if d.DeletedAt != nil {
+		listing.DeletedAt = *d.DeletedAt
+	}
+	if d.AvailableFrom != nil {
+		listing.Availability.From = *d.AvailableFrom
+	}
+	if d.AvailableUntil != nil {
+		listing.Availability.Until = *d.AvailableUntil
 	}
+	return listing
 }
 
 // toDomainListings конвертирует слайс listingDocument в слайс доменных Listing.
@@ -156,4 +239,108 @@ func toDomainFavorites(docs []*favoriteDocument) []*domain.Favorite {
 		domainFavorites = append(domainFavorites, toDomainFavorite(doc))
 	}
 	return domainFavorites
-}
\ No newline at end of file
+}
+
+// --- Конвертеры для Offer ---
+
+// toOfferDocument конвертирует доменную модель Offer в offerDocument.
+func toOfferDocument(o *domain.Offer) (*offerDocument, error) {
+	if o == nil {
+		return nil, nil
+	}
+
+	docID := primitive.NilObjectID
+	if o.ID != "" {
+		var err error
+		docID, err = primitive.ObjectIDFromHex(o.ID)
+		if err != nil {
+			return nil, fmt.Errorf("toOfferDocument: invalid ID format '%s' for domain offer: %w", o.ID, err)
+		}
+	}
+
+	return &offerDocument{
+		ID:            docID,
+		ListingID:     o.ListingID,
+		BuyerID:       o.BuyerID,
+		SellerID:      o.SellerID,
+		Amount:        o.Amount,
+		CounterAmount: o.CounterAmount,
+		AgreedPrice:   o.AgreedPrice,
+		Status:        o.Status,
+		LastActor:     o.LastActor,
+		CreatedAt:     o.CreatedAt,
+		UpdatedAt:     o.UpdatedAt,
+	}, nil
+}
+
+// toDomainOffer конвертирует offerDocument из БД в доменную модель Offer.
+func toDomainOffer(d *offerDocument) *domain.Offer {
+	if d == nil {
+		return nil
+	}
+	return &domain.Offer{
+		ID:            d.ID.Hex(),
+		ListingID:     d.ListingID,
+		BuyerID:       d.BuyerID,
+		SellerID:      d.SellerID,
+		Amount:        d.Amount,
+		CounterAmount: d.CounterAmount,
+		AgreedPrice:   d.AgreedPrice,
+		Status:        d.Status,
+		LastActor:     d.LastActor,
+		CreatedAt:     d.CreatedAt,
+		UpdatedAt:     d.UpdatedAt,
+	}
+}
+
+// toDomainOffers конвертирует слайс offerDocument в слайс доменных Offer.
+func toDomainOffers(docs []*offerDocument) []*domain.Offer {
+	if docs == nil {
+		return nil
+	}
+	domainOffers := make([]*domain.Offer, 0, len(docs))
+	for _, doc := range docs {
+		domainOffers = append(domainOffers, toDomainOffer(doc))
+	}
+	return domainOffers
+}
+
+// --- Конвертеры для Category ---
+
+// toCategoryDocument конвертирует доменную модель Category в categoryDocument.
+func toCategoryDocument(c *domain.Category) (*categoryDocument, error) {
+	if c == nil {
+		return nil, nil
+	}
+
+	docID := primitive.NilObjectID
+	if c.ID != "" {
+		var err error
+		docID, err = primitive.ObjectIDFromHex(c.ID)
+		if err != nil {
+			return nil, fmt.Errorf("toCategoryDocument: invalid ID format '%s' for domain category: %w", c.ID, err)
+		}
+	}
+
+	return &categoryDocument{
+		ID:          docID,
+		Name:        c.Name,
+		Description: c.Description,
+		CreatedAt:   c.CreatedAt,
+		UpdatedAt:   c.UpdatedAt,
+	}, nil
+}
+
+// toDomainCategory конвертирует categoryDocument из БД в доменную модель Category.
+func toDomainCategory(d *categoryDocument) *domain.Category {
+	if d == nil {
+		return nil
+	}
+	return &domain.Category{
+		ID:          d.ID.Hex(),
+		Name:        d.Name,
+		Description: d.Description,
+		CreatedAt:   d.CreatedAt,
+		UpdatedAt:   d.UpdatedAt,
+	}
+}
@@ -0,0 +1,133 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Abdurahmanit/GroupProject/listing-service/internal/listing/domain"
+	"github.com/Abdurahmanit/GroupProject/listing-service/internal/platform/logger"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type OfferRepository struct {
+	collection *mongo.Collection
+	logger     *logger.Logger
+}
+
+func NewOfferRepository(db *mongo.Database, log *logger.Logger) *OfferRepository {
+	return &OfferRepository{
+		collection: db.Collection("offers"),
+		logger:     log,
+	}
+}
+
+func (r *OfferRepository) Create(ctx context.Context, offer *domain.Offer) error {
+	now := time.Now().UTC()
+	offer.CreatedAt = now
+	offer.UpdatedAt = now
+
+	doc, err := toOfferDocument(offer)
+	if err != nil {
+		r.logger.Error("OfferRepository.Create: failed to convert domain to document", "error", err, "listing_id", offer.ListingID)
+		return fmt.Errorf("failed to prepare offer for database: %w", err)
+	}
+
+	res, err := r.collection.InsertOne(ctx, doc)
+	if err != nil {
+		r.logger.Error("OfferRepository.Create: InsertOne failed", "error", err, "listing_id", offer.ListingID, "buyer_id", offer.BuyerID)
+		return err
+	}
+
+	oid, ok := res.InsertedID.(primitive.ObjectID)
+	if !ok {
+		r.logger.Error("OfferRepository.Create: InsertOne returned unexpected ID type", "type", fmt.Sprintf("%T", res.InsertedID))
+		return errors.New("failed to retrieve generated offer ID")
+	}
+	offer.ID = oid.Hex()
+	r.logger.Info("Offer created successfully", "id", offer.ID, "listing_id", offer.ListingID, "buyer_id", offer.BuyerID)
+	return nil
+}
+
+func (r *OfferRepository) Update(ctx context.Context, offer *domain.Offer) error {
+	if offer.ID == "" {
+		r.logger.Error("OfferRepository.Update: domain offer ID is empty")
+		return errors.New("cannot update offer without an ID")
+	}
+
+	offer.UpdatedAt = time.Now().UTC()
+
+	doc, err := toOfferDocument(offer)
+	if err != nil {
+		r.logger.Error("OfferRepository.Update: failed to convert domain to document", "error", err, "offer_id", offer.ID)
+		return fmt.Errorf("failed to prepare offer for database update: %w", err)
+	}
+
+	updatePayload := bson.M{
+		"counter_amount": doc.CounterAmount,
+		"agreed_price":   doc.AgreedPrice,
+		"amount":         doc.Amount,
+		"status":         doc.Status,
+		"last_actor":     doc.LastActor,
+		"updated_at":     doc.UpdatedAt,
+	}
+
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": doc.ID}, bson.M{"$set": updatePayload})
+	if err != nil {
+		r.logger.Error("OfferRepository.Update: UpdateOne failed", "error", err, "offer_id", offer.ID)
+		return err
+	}
+	if result.MatchedCount == 0 {
+		r.logger.Warn("OfferRepository.Update: offer not found", "offer_id", offer.ID)
+		return domain.ErrOfferNotFound
+	}
+	r.logger.Info("Offer updated successfully", "id", offer.ID, "status", offer.Status)
+	return nil
+}
+
+func (r *OfferRepository) FindByID(ctx context.Context, id string) (*domain.Offer, error) {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		r.logger.Debug("OfferRepository.FindByID: invalid ID format", "id", id, "error", err)
+		return nil, domain.ErrOfferNotFound
+	}
+
+	var doc offerDocument
+	if err := r.collection.FindOne(ctx, bson.M{"_id": objID}).Decode(&doc); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, domain.ErrOfferNotFound
+		}
+		r.logger.Error("OfferRepository.FindByID: FindOne failed", "error", err, "id", id)
+		return nil, err
+	}
+	return toDomainOffer(&doc), nil
+}
+
+func (r *OfferRepository) FindByFilter(ctx context.Context, filter domain.OfferFilter) ([]*domain.Offer, error) {
+	mongoFilter := bson.M{}
+	if filter.ListingID != "" {
+		mongoFilter["listing_id"] = filter.ListingID
+	}
+	if filter.BuyerID != "" {
+		mongoFilter["buyer_id"] = filter.BuyerID
+	}
+
+	findOptions := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+	cursor, err := r.collection.Find(ctx, mongoFilter, findOptions)
+	if err != nil {
+		r.logger.Error("OfferRepository.FindByFilter: Find failed", "error", err, "listing_id", filter.ListingID, "buyer_id", filter.BuyerID)
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []*offerDocument
+	if err = cursor.All(ctx, &docs); err != nil {
+		r.logger.Error("OfferRepository.FindByFilter: Cursor All failed", "error", err)
+		return nil, err
+	}
+	return toDomainOffers(docs), nil
+}
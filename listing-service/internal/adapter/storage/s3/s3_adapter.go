@@ -6,12 +6,16 @@ import (
 	"context"
 	// "log" // Заменим на кастомный логгер
 	"fmt" // Для формирования URL и ошибок
+	"io"
+	"time"
 
+	"github.com/Abdurahmanit/GroupProject/listing-service/internal/listing/domain"
 	"github.com/Abdurahmanit/GroupProject/listing-service/internal/platform/logger" // <--- ИМПОРТ ТВОЕГО ЛОГГЕРА
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
 	"github.com/google/uuid" // Для генерации уникальных имен файлов
 	"path/filepath" // Для работы с расширениями файлов
+	"strings"
 )
 
 type S3Storage struct {
@@ -101,8 +105,79 @@ func (s *S3Storage) Upload(ctx context.Context, originalFileName string, data []
 	// client.EndpointURL() возвращает URL с протоколом, который был использован при создании клиента.
 	// Если endpoint был "minio.example.com:9000", а Secure=false, то EndpointURL() вернет "http://minio.example.com:9000"
 	// Если Secure=true, то "https://minio.example.com:9000"
-	fileURL := fmt.Sprintf("%s/%s/%s", s.client.EndpointURL().String(), s.bucket, objectKey)
+	fileURL := s.fileURL(objectKey)
 
 	s.logger.Info("S3Storage.Upload: generated file URL", "url", fileURL)
 	return fileURL, nil
-}
\ No newline at end of file
+}
+
+func (s *S3Storage) fileURL(objectKey string) string {
+	return fmt.Sprintf("%s/%s/%s", s.client.EndpointURL().String(), s.bucket, objectKey)
+}
+
+// PresignUpload выдает presigned PUT URL; клиент загружает файл в MinIO напрямую, минуя сервис.
+func (s *S3Storage) PresignUpload(ctx context.Context, keyPrefix, originalFileName string, expiry time.Duration) (string, string, error) {
+	objectKey := fmt.Sprintf("photos/%s%s%s", keyPrefix, uuid.New().String(), filepath.Ext(originalFileName))
+	uploadURL, err := s.client.PresignedPutObject(ctx, s.bucket, objectKey, expiry)
+	if err != nil {
+		s.logger.Error("S3Storage.PresignUpload: PresignedPutObject failed", "bucket", s.bucket, "key", objectKey, "error", err)
+		return "", "", fmt.Errorf("failed to presign upload of %s to bucket %s: %w", objectKey, s.bucket, err)
+	}
+	s.logger.Info("S3Storage.PresignUpload: issued upload URL", "bucket", s.bucket, "key", objectKey, "expiry", expiry.String())
+	return uploadURL.String(), s.fileURL(objectKey), nil
+}
+
+// photoSniffLen - сколько первых байт читать для http.DetectContentType
+const photoSniffLen = 512
+
+func (s *S3Storage) Stat(ctx context.Context, fileURL string) (*domain.StoredObject, error) {
+	prefix := s.fileURL("")
+	if !strings.HasPrefix(fileURL, prefix) {
+		return nil, fmt.Errorf("%w: URL does not belong to bucket %s", domain.ErrPhotoNotUploaded, s.bucket)
+	}
+	objectKey := strings.TrimPrefix(fileURL, prefix)
+
+	info, err := s.client.StatObject(ctx, s.bucket, objectKey, minio.StatObjectOptions{})
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, domain.ErrPhotoNotUploaded
+		}
+		s.logger.Error("S3Storage.Stat: StatObject failed", "bucket", s.bucket, "key", objectKey, "error", err)
+		return nil, fmt.Errorf("failed to stat object %s in bucket %s: %w", objectKey, s.bucket, err)
+	}
+	obj := &domain.StoredObject{Key: objectKey, Size: info.Size}
+	if info.Size == 0 {
+		return obj, nil
+	}
+
+	opts := minio.GetObjectOptions{}
+	if err := opts.SetRange(0, min(info.Size, photoSniffLen)-1); err != nil {
+		return nil, err
+	}
+	reader, err := s.client.GetObject(ctx, s.bucket, objectKey, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read object %s from bucket %s: %w", objectKey, s.bucket, err)
+	}
+	defer reader.Close()
+	if obj.Head, err = io.ReadAll(reader); err != nil {
+		return nil, fmt.Errorf("failed to read object %s from bucket %s: %w", objectKey, s.bucket, err)
+	}
+	return obj, nil
+}
+
+// Delete удаляет объект по URL, выданному Upload. URL чужого хранилища или бакета не трогаем.
+func (s *S3Storage) Delete(ctx context.Context, fileURL string) error {
+	prefix := s.fileURL("")
+	if !strings.HasPrefix(fileURL, prefix) {
+		s.logger.Warn("S3Storage.Delete: URL does not belong to this bucket, skipping", "url", fileURL, "bucket", s.bucket)
+		return nil
+	}
+	objectKey := strings.TrimPrefix(fileURL, prefix)
+
+	if err := s.client.RemoveObject(ctx, s.bucket, objectKey, minio.RemoveObjectOptions{}); err != nil {
+		s.logger.Error("S3Storage.Delete: RemoveObject failed", "bucket", s.bucket, "key", objectKey, "error", err)
+		return fmt.Errorf("failed to delete object %s from bucket %s: %w", objectKey, s.bucket, err)
+	}
+	s.logger.Info("S3Storage.Delete: file deleted", "bucket", s.bucket, "key", objectKey)
+	return nil
+}
@@ -0,0 +1,88 @@
+package userclient
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Abdurahmanit/GroupProject/listing-service/internal/platform/logger"
+	userpb "github.com/Abdurahmanit/GroupProject/user-service/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	callTimeout = 3 * time.Second
+	// roleCacheTTL короткий: снятие роли администратора должно вступать в силу быстро.
+	roleCacheTTL = time.Minute
+	// maxCacheEntries ограничивает память; при достижении предела удаляются просроченные записи.
+	maxCacheEntries = 10000
+)
+
+type cachedRole struct {
+	role      string
+	expiresAt time.Time
+}
+
+// Client читает роль пользователя из профиля в user-service: access-токены user-service
+// не содержат claim "role". Реализует middleware.RoleResolver.
+type Client struct {
+	conn   *grpc.ClientConn
+	client userpb.UserServiceClient
+	logger *logger.Logger
+
+	mu    sync.Mutex
+	cache map[string]cachedRole
+}
+
+func NewClient(address string, log *logger.Logger) (*Client, error) {
+	if address == "" {
+		return nil, fmt.Errorf("user service address is not configured")
+	}
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create user service client for %s: %w", address, err)
+	}
+	return &Client{
+		conn:   conn,
+		client: userpb.NewUserServiceClient(conn),
+		logger: log,
+		cache:  make(map[string]cachedRole),
+	}, nil
+}
+
+// GetUserRole возвращает роль из профиля пользователя (например, "user" или "admin").
+func (c *Client) GetUserRole(ctx context.Context, userID string) (string, error) {
+	now := time.Now()
+	c.mu.Lock()
+	entry, ok := c.cache[userID]
+	c.mu.Unlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.role, nil
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, callTimeout)
+	defer cancel()
+	resp, err := c.client.GetProfile(callCtx, &userpb.GetProfileRequest{UserId: userID})
+	if err != nil {
+		c.logger.Warn("UserClient: GetProfile failed while resolving role", "user_id", userID, "error", err)
+		return "", fmt.Errorf("failed to get profile for user %s: %w", userID, err)
+	}
+
+	c.mu.Lock()
+	if len(c.cache) >= maxCacheEntries {
+		for id, e := range c.cache {
+			if now.After(e.expiresAt) {
+				delete(c.cache, id)
+			}
+		}
+	}
+	c.cache[userID] = cachedRole{role: resp.GetRole(), expiresAt: now.Add(roleCacheTTL)}
+	c.mu.Unlock()
+	return resp.GetRole(), nil
+}
+
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
@@ -2,8 +2,11 @@ package config
 
 import (
 	"log"
+	"net/netip"
 	"os"
 	"strconv" // Для конвертации строки в bool
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
@@ -19,6 +22,41 @@ type Config struct {
 	GRPCPort       string
 	RedisAddress   string
 	JWTSecret      string // <--- ДОБАВЛЕНО
+	// UserServiceAddress - адрес user-service, из профиля берется роль пользователя для админских методов
+	UserServiceAddress string
+	// InternalAPIToken - общий с order-service токен для ReserveStock/ReleaseStock; пустой - методы закрыты
+	InternalAPIToken string
+	// Publishing rules: when ListingRequirePhoto is set, a listing needs at least
+	// ListingMinPhotos photos before it can become active. Drafts are exempt.
+	ListingRequirePhoto bool
+	ListingMinPhotos    int
+	// ListingUniqueTitlePerSeller rejects activating a listing whose normalized title matches
+	// another active listing of the same seller.
+	ListingUniqueTitlePerSeller bool
+	// Cache warming: when CacheWarmEnabled is set, the CacheWarmTopN most favorited
+	// listings are loaded into Redis in the background at startup.
+	CacheWarmEnabled     bool
+	CacheWarmTopN        int
+	CacheWarmConcurrency int
+	// Photo upload limits (0 disables the check)
+	PhotoMaxBytes       int
+	MaxPhotosPerListing int
+	// PhotoUploadURLExpiry - срок действия presigned URL для прямой загрузки фото
+	PhotoUploadURLExpiry time.Duration
+	// ViewDebounce - окно, в котором повторные просмотры одного зрителя не засчитываются (0 - считать все)
+	ViewDebounce time.Duration
+	// ViewHistorySize - сколько последних просмотров хранить на пользователя (0 - не хранить)
+	ViewHistorySize int
+	// TrustedProxies - адреса и подсети api-gateway (TRUSTED_PROXIES через запятую); только от них
+	// принимается x-forwarded-for с адресом клиента для учета просмотров
+	TrustedProxies []netip.Prefix
+	// SearchCacheTTL - сколько кэшировать страницу результатов SearchListings (0 - не кэшировать)
+	SearchCacheTTL time.Duration
+	// FavoriteBlockOwnListing запрещает продавцу добавлять в избранное свои объявления
+	FavoriteBlockOwnListing bool
+	// StartupSelfTest: при старте записать/прочитать canary в MongoDB и Redis и опубликовать
+	// сообщение в listing.selftest; при любой ошибке сервис не запускается
+	StartupSelfTest bool
 	// AWSRegion      string // Добавь, если используешь AWS S3 SDK и нужен регион
 }
 
@@ -36,6 +74,122 @@ func Load() (*Config, error) {
 		minioUseSSL = false // Безопасное значение по умолчанию при ошибке парсинга
 	}
 
+	requirePhotoStr := getEnv("LISTING_REQUIRE_PHOTO", "false")
+	requirePhoto, err := strconv.ParseBool(requirePhotoStr)
+	if err != nil {
+		log.Printf("Warning: Invalid LISTING_REQUIRE_PHOTO value '%s', defaulting to false. Error: %v", requirePhotoStr, err)
+		requirePhoto = false
+	}
+
+	minPhotosStr := getEnv("LISTING_MIN_PHOTOS", "1")
+	minPhotos, err := strconv.Atoi(minPhotosStr)
+	if err != nil || minPhotos < 1 {
+		log.Printf("Warning: Invalid LISTING_MIN_PHOTOS value '%s', defaulting to 1.", minPhotosStr)
+		minPhotos = 1
+	}
+
+	uniqueTitleStr := getEnv("LISTING_UNIQUE_TITLE_PER_SELLER", "false")
+	uniqueTitle, err := strconv.ParseBool(uniqueTitleStr)
+	if err != nil {
+		log.Printf("Warning: Invalid LISTING_UNIQUE_TITLE_PER_SELLER value '%s', defaulting to false. Error: %v", uniqueTitleStr, err)
+		uniqueTitle = false
+	}
+
+	blockOwnFavoriteStr := getEnv("FAVORITE_BLOCK_OWN_LISTING", "false")
+	blockOwnFavorite, err := strconv.ParseBool(blockOwnFavoriteStr)
+	if err != nil {
+		log.Printf("Warning: Invalid FAVORITE_BLOCK_OWN_LISTING value '%s', defaulting to false. Error: %v", blockOwnFavoriteStr, err)
+		blockOwnFavorite = false
+	}
+
+	selfTestStr := getEnv("STARTUP_SELF_TEST", "false")
+	selfTest, err := strconv.ParseBool(selfTestStr)
+	if err != nil {
+		log.Printf("Warning: Invalid STARTUP_SELF_TEST value '%s', defaulting to false. Error: %v", selfTestStr, err)
+		selfTest = false
+	}
+
+	cacheWarmStr := getEnv("CACHE_WARM_ENABLED", "false")
+	cacheWarm, err := strconv.ParseBool(cacheWarmStr)
+	if err != nil {
+		log.Printf("Warning: Invalid CACHE_WARM_ENABLED value '%s', defaulting to false. Error: %v", cacheWarmStr, err)
+		cacheWarm = false
+	}
+
+	warmTopNStr := getEnv("CACHE_WARM_TOP_N", "200")
+	warmTopN, err := strconv.Atoi(warmTopNStr)
+	if err != nil || warmTopN < 1 {
+		log.Printf("Warning: Invalid CACHE_WARM_TOP_N value '%s', defaulting to 200.", warmTopNStr)
+		warmTopN = 200
+	}
+
+	warmConcurrencyStr := getEnv("CACHE_WARM_CONCURRENCY", "8")
+	warmConcurrency, err := strconv.Atoi(warmConcurrencyStr)
+	if err != nil || warmConcurrency < 1 {
+		log.Printf("Warning: Invalid CACHE_WARM_CONCURRENCY value '%s', defaulting to 8.", warmConcurrencyStr)
+		warmConcurrency = 8
+	}
+
+	photoMaxBytesStr := getEnv("PHOTO_MAX_BYTES", "10485760")
+	photoMaxBytes, err := strconv.Atoi(photoMaxBytesStr)
+	if err != nil || photoMaxBytes < 0 {
+		log.Printf("Warning: Invalid PHOTO_MAX_BYTES value '%s', defaulting to 10485760 (10MB).", photoMaxBytesStr)
+		photoMaxBytes = 10 << 20
+	}
+
+	maxPhotosStr := getEnv("LISTING_MAX_PHOTOS", "10")
+	maxPhotos, err := strconv.Atoi(maxPhotosStr)
+	if err != nil || maxPhotos < 0 {
+		log.Printf("Warning: Invalid LISTING_MAX_PHOTOS value '%s', defaulting to 10.", maxPhotosStr)
+		maxPhotos = 10
+	}
+
+	viewDebounceStr := getEnv("VIEW_DEBOUNCE_SECONDS", "1800")
+	viewDebounceSec, err := strconv.Atoi(viewDebounceStr)
+	if err != nil || viewDebounceSec < 0 {
+		log.Printf("Warning: Invalid VIEW_DEBOUNCE_SECONDS value '%s', defaulting to 1800 (30m).", viewDebounceStr)
+		viewDebounceSec = 1800
+	}
+
+	historySizeStr := getEnv("VIEW_HISTORY_SIZE", "50")
+	historySize, err := strconv.Atoi(historySizeStr)
+	if err != nil || historySize < 0 {
+		log.Printf("Warning: Invalid VIEW_HISTORY_SIZE value '%s', defaulting to 50.", historySizeStr)
+		historySize = 50
+	}
+
+	var trustedProxies []netip.Prefix
+	for _, item := range strings.Split(getEnv("TRUSTED_PROXIES", ""), ",") {
+		if item = strings.TrimSpace(item); item == "" {
+			continue
+		}
+		if !strings.Contains(item, "/") {
+			if addr, err := netip.ParseAddr(item); err == nil {
+				item = netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()).String()
+			}
+		}
+		prefix, err := netip.ParsePrefix(item)
+		if err != nil {
+			log.Printf("Warning: Invalid TRUSTED_PROXIES entry '%s' ignored: %v", item, err)
+			continue
+		}
+		trustedProxies = append(trustedProxies, prefix.Masked())
+	}
+
+	searchCacheTTLStr := getEnv("SEARCH_CACHE_TTL_SECONDS", "60")
+	searchCacheTTLSec, err := strconv.Atoi(searchCacheTTLStr)
+	if err != nil || searchCacheTTLSec < 0 {
+		log.Printf("Warning: Invalid SEARCH_CACHE_TTL_SECONDS value '%s', defaulting to 60.", searchCacheTTLStr)
+		searchCacheTTLSec = 60
+	}
+
+	uploadURLTTLStr := getEnv("PHOTO_UPLOAD_URL_TTL_SECONDS", "900")
+	uploadURLTTLSec, err := strconv.Atoi(uploadURLTTLStr)
+	if err != nil || uploadURLTTLSec < 1 {
+		log.Printf("Warning: Invalid PHOTO_UPLOAD_URL_TTL_SECONDS value '%s', defaulting to 900 (15m).", uploadURLTTLStr)
+		uploadURLTTLSec = 900
+	}
+
 	cfg := &Config{
 		MongoURI:       getEnv("MONGO_URI", "mongodb://localhost:27017"),
 		NATSURL:        getEnv("NATS_URL", "nats://localhost:4222"),
@@ -47,6 +201,23 @@ func Load() (*Config, error) {
 		GRPCPort:       getEnv("GRPC_PORT", "50052"), // Убедись, что этот порт не конфликтует с другими сервисами
 		RedisAddress:   getEnv("REDIS_ADDRESS", "localhost:6379"),
 		JWTSecret:      getEnv("JWT_SECRET", "your-secret-key"), // <--- УСТАНОВЛЕНО (ВАЖНО: измени дефолтное значение)
+		UserServiceAddress: getEnv("USER_SERVICE_ADDRESS", "localhost:50051"),
+		InternalAPIToken:   getEnv("INTERNAL_API_TOKEN", ""),
+		ListingRequirePhoto: requirePhoto,
+		ListingMinPhotos:    minPhotos,
+		ListingUniqueTitlePerSeller: uniqueTitle,
+		CacheWarmEnabled:     cacheWarm,
+		CacheWarmTopN:        warmTopN,
+		CacheWarmConcurrency: warmConcurrency,
+		PhotoMaxBytes:        photoMaxBytes,
+		MaxPhotosPerListing:  maxPhotos,
+		PhotoUploadURLExpiry: time.Duration(uploadURLTTLSec) * time.Second,
+		ViewDebounce:         time.Duration(viewDebounceSec) * time.Second,
+		ViewHistorySize:      historySize,
+		TrustedProxies:       trustedProxies,
+		SearchCacheTTL:       time.Duration(searchCacheTTLSec) * time.Second,
+		FavoriteBlockOwnListing: blockOwnFavorite,
+		StartupSelfTest:         selfTest,
 		// AWSRegion:      getEnv("AWS_REGION", "us-east-1"), // Если используешь AWS S3 SDK
 	}
 
@@ -54,6 +225,12 @@ func Load() (*Config, error) {
 	if cfg.JWTSecret == "your-secret-key" {
 		log.Println("Warning: JWT_SECRET is set to its default insecure value. Please set a strong secret in your environment or .env file.")
 	}
+	if cfg.InternalAPIToken == "" {
+		log.Println("Warning: INTERNAL_API_TOKEN is not set. ReserveStock/ReleaseStock will reject all calls, so order-service cannot place orders.")
+	}
+	if !cfg.ListingRequirePhoto {
+		cfg.ListingMinPhotos = 0
+	}
 	if cfg.JWTSecret == "" {
 	    // Можно завершить приложение, если JWT_SECRET обязателен и пуст
 	    log.Fatal("FATAL: JWT_SECRET is not set. This is required for security.")
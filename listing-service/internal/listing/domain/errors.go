@@ -1,11 +1,47 @@
 package domain
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
-	ErrListingNotFound     = errors.New("listing not found")
-	ErrFavoriteNotFound    = errors.New("favorite not found")
-	ErrInvalidListingData  = errors.New("invalid listing data")
-	ErrInvalidFilter       = errors.New("invalid filter parameters")
-	ErrDuplicateFavorite   = errors.New("favorite already exists")
-)
\ No newline at end of file
+	ErrListingNotFound      = errors.New("listing not found")
+	ErrFavoriteNotFound     = errors.New("favorite not found")
+	ErrInvalidListingData   = errors.New("invalid listing data")
+	ErrInvalidFilter        = errors.New("invalid filter parameters")
+	ErrDuplicateFavorite    = errors.New("favorite already exists")
+	ErrNotEnoughPhotos      = errors.New("listing does not have enough photos to be published")
+	ErrOfferNotFound        = errors.New("offer not found")
+	ErrListingNotNegotiable = errors.New("listing does not accept offers")
+	ErrInvalidOffer         = errors.New("invalid offer")
+	ErrOfferClosed          = errors.New("offer has already been accepted or rejected")
+	ErrNotYourTurn          = errors.New("offer is waiting for the other party")
+	ErrInvalidStatusChange  = errors.New("listing status cannot be changed")
+	ErrPhotoNotFound        = errors.New("photo not found in listing")
+	ErrInvalidPhotoOrder    = errors.New("photo order must list every listing photo exactly once")
+	ErrInvalidPhoto         = errors.New("invalid photo")
+	ErrTooManyPhotos        = errors.New("listing already has the maximum number of photos")
+	ErrCategoryNotFound     = errors.New("category not found")
+	ErrInvalidCategory      = errors.New("invalid category data")
+	ErrDuplicateCategory    = errors.New("category with this name already exists")
+	ErrCategoryInUse        = errors.New("category still has listings")
+	ErrPhotoNotUploaded     = errors.New("photo has not been uploaded to the presigned URL")
+	ErrDuplicateTitle       = errors.New("seller already has an active listing with this title")
+	ErrOwnListing           = errors.New("cannot favorite your own listing")
+	ErrOutOfStock           = errors.New("out of stock")
+)
+
+// DuplicateTitleError reports the seller's active listing that already uses the title.
+// It matches ErrDuplicateTitle with errors.Is.
+type DuplicateTitleError struct {
+	ListingID string
+}
+
+func (e *DuplicateTitleError) Error() string {
+	return fmt.Sprintf("%s (listing %s)", ErrDuplicateTitle, e.ListingID)
+}
+
+func (e *DuplicateTitleError) Unwrap() error {
+	return ErrDuplicateTitle
+}
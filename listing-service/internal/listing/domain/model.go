@@ -1,16 +1,45 @@
 package domain
 
-import "time" // Оставим time, т.к. это стандартная библиотека
+import (
+	"fmt"
+	"strings"
+	"time" // Оставим time, т.к. это стандартная библиотека
+)
 
 type ListingStatus string
 
 const (
-	StatusActive   ListingStatus = "active"
-	StatusSold     ListingStatus = "sold"
-	StatusReserved ListingStatus = "reserved" // Добавил из предыдущих обсуждений
-	StatusInactive ListingStatus = "inactive" // Добавил из предыдущих обсуждений
+	StatusActive        ListingStatus = "active"
+	StatusSold          ListingStatus = "sold"
+	StatusReserved      ListingStatus = "reserved"       // Добавил из предыдущих обсуждений
+	StatusInactive      ListingStatus = "inactive"       // Добавил из предыдущих обсуждений
+	StatusDraft         ListingStatus = "draft"          // Not published yet, photo requirements are not enforced
+	StatusSoldElsewhere ListingStatus = "sold_elsewhere" // Terminal: seller sold the item off-platform
+	StatusWithdrawn     ListingStatus = "withdrawn"      // Terminal: seller took the item off the market
+	StatusDeleted       ListingStatus = "deleted"        // Soft-deleted by the seller; visible to admins only
+	StatusNotFound      ListingStatus = "not_found"      // Only reported by batch status lookups for unknown IDs
 )
 
+// IsTerminal reports whether a listing in this status can no longer change status.
+// Terminal listings are also hidden from search unless their status is requested explicitly.
+func (s ListingStatus) IsTerminal() bool {
+	return s == StatusSoldElsewhere || s == StatusWithdrawn
+}
+
+// CanTransitionTo validates a status change: terminal statuses are final. A listing enters
+// and leaves StatusDeleted only through deletion, never through a status change.
+func (s ListingStatus) CanTransitionTo(next ListingStatus) bool {
+	if s == StatusDeleted || next == StatusDeleted {
+		return s == next
+	}
+	return !s.IsTerminal() || s == next
+}
+
+// CanPublish reports whether a listing in this status can be published (draft -> active).
+func (s ListingStatus) CanPublish() bool {
+	return s == StatusDraft
+}
+
 type Listing struct {
 	ID          string // ID обычно генерируется БД или usecase'ом перед сохранением
 	UserID      string // <--- ВАЖНО: Добавь это поле, если его еще нет
@@ -18,10 +47,68 @@ type Listing struct {
 	Title       string
 	Description string
 	Price       float64
+	Negotiable  bool // Buyers may send price offers only when this is set
 	Status      ListingStatus
-	Photos      []string // URLs to photos
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	// UnavailableReason is the seller's note when the listing was marked sold elsewhere or withdrawn
+	UnavailableReason string
+	Photos            []string // URLs to photos
+	// Latitude/Longitude - местоположение товара в градусах; 0/0 означает "не указано"
+	Latitude  float64
+	Longitude float64
+	// ViewCount - число просмотров карточки; меняется только через ListingRepository.IncrementViewCount
+	ViewCount int64
+	// FavoriteCount - сколько раз объявление добавили в избранное; ведет FavoriteUsecase
+	FavoriteCount int64
+	// Quantity - сколько единиц товара осталось; меняется через ReserveStock/ReleaseStock и
+	// продавцом при пополнении (UpdateListing)
+	Quantity int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	// DeletedAt - время мягкого удаления; нулевое значение, пока объявление не удалено
+	DeletedAt time.Time
+	// Availability - окно, в которое объявление видно в поиске и доступно для покупки
+	Availability AvailabilityWindow
+}
+
+// AvailabilityWindow limits when a listing can be found and bought, e.g. event tickets or
+// flash sales. A zero From or Until leaves that side of the window open.
+type AvailabilityWindow struct {
+	From  time.Time
+	Until time.Time
+}
+
+// Validate checks that the window is not empty when both ends are set.
+func (w AvailabilityWindow) Validate() error {
+	if !w.From.IsZero() && !w.Until.IsZero() && !w.From.Before(w.Until) {
+		return fmt.Errorf("%w: available_from must be before available_until", ErrInvalidListingData)
+	}
+	return nil
+}
+
+// Contains reports whether t falls within [From, Until).
+func (w AvailabilityWindow) Contains(t time.Time) bool {
+	return (w.From.IsZero() || !t.Before(w.From)) && (w.Until.IsZero() || t.Before(w.Until))
+}
+
+// IsDeleted reports whether the seller deleted the listing.
+func (l *Listing) IsDeleted() bool {
+	return l.Status == StatusDeleted
+}
+
+// NormalizeTitle приводит заголовок к виду для сравнения на дубликаты: нижний регистр,
+// без лишних пробелов.
+func NormalizeTitle(title string) string {
+	return strings.Join(strings.Fields(strings.ToLower(title)), " ")
+}
+
+// HasLocation reports whether the seller set a location for the listing.
+func (l *Listing) HasLocation() bool {
+	return l.Latitude != 0 || l.Longitude != 0
+}
+
+// ValidCoordinates reports whether lat/lng are within the WGS84 range.
+func ValidCoordinates(lat, lng float64) bool {
+	return lat >= -90 && lat <= 90 && lng >= -180 && lng <= 180
 }
 
 // Photo как доменная сущность может быть не нужна, если это просто URL в Listing.
@@ -41,6 +128,94 @@ type Favorite struct {
 	CreatedAt time.Time
 }
 
+// Category - категория объявлений; Listing.CategoryID ссылается на Category.ID
+type Category struct {
+	ID          string
+	Name        string
+	Description string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// ListingAvailability is the subset of a listing a cart needs to revalidate an item.
+// Available is false for an active listing outside its availability window.
+type ListingAvailability struct {
+	Status       ListingStatus
+	Price        float64
+	Available    bool
+	Availability AvailabilityWindow
+	UserID       string // Владелец объявления
+	Quantity     int64  // Остаток на складе
+}
+
+type OfferStatus string
+
+const (
+	OfferStatusPending   OfferStatus = "pending"   // Waiting for the seller
+	OfferStatusCountered OfferStatus = "countered" // A counter-offer is waiting for the other party
+	OfferStatusAccepted  OfferStatus = "accepted"  // AgreedPrice is final
+	OfferStatusRejected  OfferStatus = "rejected"
+)
+
+type OfferAction string
+
+const (
+	OfferActionAccept  OfferAction = "accept"
+	OfferActionReject  OfferAction = "reject"
+	OfferActionCounter OfferAction = "counter"
+)
+
+// Offer is a buyer's price proposal for a negotiable listing.
+type Offer struct {
+	ID            string
+	ListingID     string
+	BuyerID       string
+	SellerID      string
+	Amount        float64 // Buyer's latest price
+	CounterAmount float64 // Seller's latest price, set when the seller counters
+	AgreedPrice   float64 // Set once the offer is accepted; order placement can use it
+	Status        OfferStatus
+	// LastActor - кто из участников (BuyerID или SellerID) сделал последний ход; отвечает другой
+	LastActor string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// IsOpen reports whether the offer is still waiting for a response.
+func (o *Offer) IsOpen() bool {
+	return o.Status == OfferStatusPending || o.Status == OfferStatusCountered
+}
+
+// Responder returns the user expected to answer the open offer. Offers stored before
+// LastActor existed only had seller counters, so their turn follows from the status.
+func (o *Offer) Responder() string {
+	lastActor := o.LastActor
+	if lastActor == "" {
+		lastActor = o.BuyerID
+		if o.Status == OfferStatusCountered {
+			lastActor = o.SellerID
+		}
+	}
+	if lastActor == o.BuyerID {
+		return o.SellerID
+	}
+	return o.BuyerID
+}
+
+// ProposedPrice is the price the responder accepts: the last counter of the seller if it is
+// the buyer's turn, otherwise the buyer's amount.
+func (o *Offer) ProposedPrice() float64 {
+	if o.Responder() == o.BuyerID {
+		return o.CounterAmount
+	}
+	return o.Amount
+}
+
+type OfferFilter struct {
+	ListingID string
+	BuyerID   string
+}
+
 // Filter для поиска, как и раньше
 type Filter struct {
 	Query      string
@@ -53,6 +228,22 @@ type Filter struct {
 	Limit      int32
 	SortBy     string
 	SortOrder  string
+	// Геопоиск: при RadiusKm > 0 возвращаются только объявления в радиусе от центра
+	CenterLat float64
+	CenterLng float64
+	RadiusKm  float64
+	// ExcludeUserID скрывает объявления указанного пользователя (например, свои же при просмотре)
+	ExcludeUserID string
+	// IncludeDeleted включает удаленные объявления в выдачу (только для администраторов)
+	IncludeDeleted bool
+	// IncludeScheduled включает объявления вне окна доступности (владельцу и администраторам)
+	IncludeScheduled bool
+}
+
+// SearchResult - страница результатов поиска в кэше: ID в порядке выдачи и общее число совпадений
+type SearchResult struct {
+	IDs   []string
+	Total int64
 }
 
 // Ошибки доменного уровня, которые могут быть возвращены usecase'ами
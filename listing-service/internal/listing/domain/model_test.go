@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListingStatus_CanTransitionTo(t *testing.T) {
+	tests := []struct {
+		from, to ListingStatus
+		allowed  bool
+	}{
+		{StatusDraft, StatusActive, true},
+		{StatusActive, StatusSold, true},
+		{StatusActive, StatusDraft, true},
+		{StatusWithdrawn, StatusActive, false},
+		{StatusSoldElsewhere, StatusSoldElsewhere, true},
+		// Удаление и восстановление идут только через DeleteListing, не через смену статуса
+		{StatusActive, StatusDeleted, false},
+		{StatusDraft, StatusDeleted, false},
+		{StatusDeleted, StatusActive, false},
+		{StatusDeleted, StatusDraft, false},
+		{StatusDeleted, StatusDeleted, true},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.allowed, tt.from.CanTransitionTo(tt.to), "%s -> %s", tt.from, tt.to)
+	}
+}
+
+func TestListingStatus_CanPublish(t *testing.T) {
+	assert.True(t, StatusDraft.CanPublish())
+	for _, s := range []ListingStatus{StatusActive, StatusSold, StatusReserved, StatusInactive, StatusWithdrawn, StatusDeleted} {
+		assert.False(t, s.CanPublish(), "%s", s)
+	}
+}
+
+func TestListing_IsDeleted(t *testing.T) {
+	assert.True(t, (&Listing{Status: StatusDeleted}).IsDeleted())
+	assert.False(t, (&Listing{Status: StatusWithdrawn}).IsDeleted())
+}
+
+func TestNormalizeTitle(t *testing.T) {
+	assert.Equal(t, "iphone 13 pro", NormalizeTitle("  iPhone   13\tPRO "))
+	assert.Equal(t, NormalizeTitle("Велосипед Stels"), NormalizeTitle("велосипед  STELS"))
+}
+
+func TestAvailabilityWindow(t *testing.T) {
+	from := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+	until := from.Add(2 * time.Hour)
+	w := AvailabilityWindow{From: from, Until: until}
+
+	assert.NoError(t, w.Validate())
+	assert.False(t, w.Contains(from.Add(-time.Second)))
+	assert.True(t, w.Contains(from))
+	assert.False(t, w.Contains(until))
+	assert.True(t, AvailabilityWindow{Until: until}.Contains(from.Add(-time.Hour)))
+	assert.True(t, AvailabilityWindow{}.Contains(from))
+
+	assert.ErrorIs(t, AvailabilityWindow{From: until, Until: from}.Validate(), ErrInvalidListingData)
+	assert.ErrorIs(t, AvailabilityWindow{From: from, Until: from}.Validate(), ErrInvalidListingData)
+}
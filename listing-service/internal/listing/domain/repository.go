@@ -1,24 +1,120 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type ListingRepository interface {
 	Create(ctx context.Context, listing *Listing) error
 	Update(ctx context.Context, listing *Listing) error
-	Delete(ctx context.Context, id string) error
+	// SoftDelete sets StatusDeleted and DeletedAt; the document itself is kept.
+	SoftDelete(ctx context.Context, id string, deletedAt time.Time) error
+	// FindByID returns deleted listings too; callers decide whether to hide them.
 	FindByID(ctx context.Context, id string) (*Listing, error)
 	FindByFilter(ctx context.Context, filter Filter) (listings []*Listing, total int64, err error)
+	// FindStatusesByIDs returns listings with only ID, Status, Price and Availability populated; unknown IDs are omitted.
+	FindStatusesByIDs(ctx context.Context, ids []string) ([]*Listing, error)
+	// FindByIDs returns full listings for the given IDs in no particular order; unknown IDs are omitted.
+	FindByIDs(ctx context.Context, ids []string) ([]*Listing, error)
+	// CountByCategory returns how many listings reference the category.
+	CountByCategory(ctx context.Context, categoryID string) (int64, error)
+	// FindActiveByTitle returns an active listing of the seller with the given normalized title,
+	// other than excludeID, or ErrListingNotFound.
+	FindActiveByTitle(ctx context.Context, userID, normalizedTitle, excludeID string) (*Listing, error)
+	// IncrementViewCount atomically adds one view and returns the new total.
+	IncrementViewCount(ctx context.Context, listingID string) (int64, error)
+	// IncrementFavoriteCount atomically adds delta to the favorite count and returns the new total.
+	IncrementFavoriteCount(ctx context.Context, listingID string, delta int64) (int64, error)
+	// SetFavoriteCount overwrites the favorite count, e.g. after recounting.
+	SetFavoriteCount(ctx context.Context, listingID string, count int64) error
+	// ReserveStock atomically takes quantity units of an active listing for the order and returns
+	// what is left. It returns ErrOutOfStock if the listing is not active or has fewer units than
+	// requested. A second call for the same order does not take the units again.
+	ReserveStock(ctx context.Context, listingID, orderNumber string, quantity int64) (int64, error)
+	// ReleaseStock returns the units reserved for the order to the listing and reports how many
+	// were returned; a reservation is released at most once, later calls return 0.
+	ReleaseStock(ctx context.Context, listingID, orderNumber string) (int64, error)
+	// SetQuantity overwrites the stock left, e.g. when the seller restocks.
+	SetQuantity(ctx context.Context, listingID string, quantity int64) error
 	// DeleteListingWithFavoritesTx(ctx context.Context, listingID, userID string) error
 }
 
+// ListingCache - кэш объявлений по ID и результатов поиска. GetListing и GetSearchResult
+// возвращают nil при промахе.
+type ListingCache interface {
+	GetListing(ctx context.Context, id string) (*Listing, error)
+	SetListing(ctx context.Context, listing *Listing) error
+	DeleteListing(ctx context.Context, id string) error
+	// GetSearchResult also returns the key to store a fresh result under on a miss. The key is
+	// fixed at lookup time so a result computed before an invalidation is never cached after it.
+	GetSearchResult(ctx context.Context, filter Filter) (result *SearchResult, key string, err error)
+	SetSearchResult(ctx context.Context, key string, result *SearchResult, ttl time.Duration) error
+	// InvalidateSearchResults makes every cached search result stale at once.
+	InvalidateSearchResults(ctx context.Context) error
+}
+
+// ViewTracker помнит, кто недавно смотрел объявление, чтобы повторные обновления страницы
+// не накручивали счетчик просмотров.
+type ViewTracker interface {
+	// MarkViewed returns true if viewerID has not viewed the listing within window.
+	MarkViewed(ctx context.Context, listingID, viewerID string, window time.Duration) (bool, error)
+}
+
+// ViewHistory - недавно просмотренные пользователем объявления, от новых к старым, без повторов.
+type ViewHistory interface {
+	// AddViewed moves listingID to the front of the user's history and trims it to maxLen entries.
+	AddViewed(ctx context.Context, userID, listingID string, maxLen int) error
+	// RecentlyViewed returns up to limit listing IDs, most recent first.
+	RecentlyViewed(ctx context.Context, userID string, limit int) ([]string, error)
+}
+
 type FavoriteRepository interface {
 	Add(ctx context.Context, favorite *Favorite) error
 	Remove(ctx context.Context, userID, listingID string) error
 	FindByUserID(ctx context.Context, userID string) ([]*Favorite, error)
+	// FindPageByUserID returns limit favorites after skipping offset, newest first, and the total count.
+	FindPageByUserID(ctx context.Context, userID string, offset, limit int64) ([]*Favorite, int64, error)
+	// CountFavoritesByListing returns the exact number of favorites of the listing.
+	CountFavoritesByListing(ctx context.Context, listingID string) (int64, error)
+	// TopListingIDs returns up to limit listing IDs, most favorited first.
+	TopListingIDs(ctx context.Context, limit int) ([]string, error)
+}
+
+type CategoryRepository interface {
+	Create(ctx context.Context, category *Category) error
+	Update(ctx context.Context, category *Category) error
+	Delete(ctx context.Context, id string) error
+	FindByID(ctx context.Context, id string) (*Category, error)
+	// FindAll returns every category sorted by name.
+	FindAll(ctx context.Context) ([]*Category, error)
+	// Count returns how many categories exist.
+	Count(ctx context.Context) (int64, error)
+}
+
+type OfferRepository interface {
+	Create(ctx context.Context, offer *Offer) error
+	Update(ctx context.Context, offer *Offer) error
+	FindByID(ctx context.Context, id string) (*Offer, error)
+	FindByFilter(ctx context.Context, filter OfferFilter) ([]*Offer, error)
 }
 
 type Storage interface {
     Upload(ctx context.Context, fileName string, data []byte) (string, error)
-    // Delete(ctx context.Context, fileKey string) error // Возможно, другие методы
+    // Delete удаляет объект по URL, который вернул Upload
+    Delete(ctx context.Context, fileURL string) error
+    // PresignUpload returns a URL the client can PUT the file to directly until expiry, and the
+    // URL the object will be served from. The object key starts with keyPrefix.
+    PresignUpload(ctx context.Context, keyPrefix, fileName string, expiry time.Duration) (uploadURL, fileURL string, err error)
+    // Stat describes an uploaded object. It returns ErrPhotoNotUploaded if the object does not
+    // exist or fileURL does not point into this storage.
+    Stat(ctx context.Context, fileURL string) (*StoredObject, error)
+}
+
+// StoredObject - метаданные загруженного файла; Head содержит первые байты для определения типа
+type StoredObject struct {
+	Key  string
+	Size int64
+	Head []byte
 }
 
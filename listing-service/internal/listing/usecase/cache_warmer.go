@@ -0,0 +1,100 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Abdurahmanit/GroupProject/listing-service/internal/listing/domain"
+	"github.com/Abdurahmanit/GroupProject/listing-service/internal/platform/logger"
+)
+
+// CacheWarmer заполняет кэш самыми популярными (по избранному) объявлениями после старта,
+// чтобы первые запросы после деплоя не шли в Mongo.
+type CacheWarmer struct {
+	favorites   domain.FavoriteRepository
+	repo        domain.ListingRepository
+	cache       domain.ListingCache
+	logger      *logger.Logger
+	topN        int
+	concurrency int
+}
+
+func NewCacheWarmer(favorites domain.FavoriteRepository, repo domain.ListingRepository, cache domain.ListingCache, log *logger.Logger, topN, concurrency int) *CacheWarmer {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	return &CacheWarmer{
+		favorites:   favorites,
+		repo:        repo,
+		cache:       cache,
+		logger:      log,
+		topN:        topN,
+		concurrency: concurrency,
+	}
+}
+
+// Run загружает top-N объявлений в кэш, используя не более concurrency горутин.
+// Ошибки по отдельным объявлениям только логируются; ctx прерывает прогрев.
+func (w *CacheWarmer) Run(ctx context.Context) {
+	start := time.Now()
+	ids, err := w.favorites.TopListingIDs(ctx, w.topN)
+	if err != nil {
+		w.logger.Error("CacheWarmer: failed to load top listings", "error", err.Error())
+		return
+	}
+	w.logger.Info("CacheWarmer: warming listing cache", "listings", len(ids), "concurrency", w.concurrency)
+
+	jobs := make(chan string)
+	var warmed, failed atomic.Int64
+	var wg sync.WaitGroup
+	for i := 0; i < w.concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range jobs {
+				if err := w.warmOne(ctx, id); err != nil {
+					failed.Add(1)
+					continue
+				}
+				if n := warmed.Add(1); n%50 == 0 {
+					w.logger.Info("CacheWarmer: progress", "warmed", n, "total", len(ids))
+				}
+			}
+		}()
+	}
+
+feed:
+	for _, id := range ids {
+		select {
+		case jobs <- id:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	w.logger.Info("CacheWarmer: done", "warmed", warmed.Load(), "failed", failed.Load(),
+		"duration", time.Since(start).String(), "cancelled", ctx.Err() != nil)
+}
+
+func (w *CacheWarmer) warmOne(ctx context.Context, id string) error {
+	listing, err := w.repo.FindByID(ctx, id)
+	if err != nil {
+		if !errors.Is(err, domain.ErrListingNotFound) {
+			w.logger.Warn("CacheWarmer: failed to fetch listing", "listing_id", id, "error", err.Error())
+		}
+		return err
+	}
+	if listing.IsDeleted() {
+		return domain.ErrListingNotFound
+	}
+	if err := w.cache.SetListing(ctx, listing); err != nil {
+		w.logger.Warn("CacheWarmer: failed to cache listing", "listing_id", id, "error", err.Error())
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Abdurahmanit/GroupProject/listing-service/internal/listing/domain"
+	"github.com/Abdurahmanit/GroupProject/listing-service/internal/platform/logger"
+)
+
+const maxCategoryNameLength = 100
+
+type CategoryUsecase struct {
+	repo        domain.CategoryRepository
+	listingRepo domain.ListingRepository
+	logger      *logger.Logger
+}
+
+func NewCategoryUsecase(repo domain.CategoryRepository, listingRepo domain.ListingRepository, log *logger.Logger) *CategoryUsecase {
+	return &CategoryUsecase{
+		repo:        repo,
+		listingRepo: listingRepo,
+		logger:      log,
+	}
+}
+
+func validateCategoryName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", fmt.Errorf("%w: name is required", domain.ErrInvalidCategory)
+	}
+	if len([]rune(name)) > maxCategoryNameLength {
+		return "", fmt.Errorf("%w: name must be at most %d characters", domain.ErrInvalidCategory, maxCategoryNameLength)
+	}
+	return name, nil
+}
+
+func (uc *CategoryUsecase) CreateCategory(ctx context.Context, name, description string) (*domain.Category, error) {
+	uc.logger.Info("CategoryUsecase.CreateCategory: creating category", "name", name)
+	name, err := validateCategoryName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	category := &domain.Category{Name: name, Description: strings.TrimSpace(description)}
+	if err := uc.repo.Create(ctx, category); err != nil {
+		if !errors.Is(err, domain.ErrDuplicateCategory) {
+			uc.logger.Error("CategoryUsecase.CreateCategory: failed to create category", "name", name, "error", err.Error())
+		}
+		return nil, err
+	}
+	return category, nil
+}
+
+func (uc *CategoryUsecase) GetCategory(ctx context.Context, id string) (*domain.Category, error) {
+	return uc.repo.FindByID(ctx, id)
+}
+
+func (uc *CategoryUsecase) ListCategories(ctx context.Context) ([]*domain.Category, error) {
+	return uc.repo.FindAll(ctx)
+}
+
+// UpdateCategory переименовывает категорию и/или меняет описание; пустые значения не меняют поле.
+func (uc *CategoryUsecase) UpdateCategory(ctx context.Context, id, name, description string) (*domain.Category, error) {
+	uc.logger.Info("CategoryUsecase.UpdateCategory: updating category", "category_id", id)
+	category, err := uc.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if name != "" {
+		if category.Name, err = validateCategoryName(name); err != nil {
+			return nil, err
+		}
+	}
+	if description != "" {
+		category.Description = strings.TrimSpace(description)
+	}
+	if err := uc.repo.Update(ctx, category); err != nil {
+		if !errors.Is(err, domain.ErrDuplicateCategory) {
+			uc.logger.Error("CategoryUsecase.UpdateCategory: failed to update category", "category_id", id, "error", err.Error())
+		}
+		return nil, err
+	}
+	return category, nil
+}
+
+// DeleteCategory удаляет категорию, если на нее не ссылается ни одно объявление.
+func (uc *CategoryUsecase) DeleteCategory(ctx context.Context, id string) error {
+	uc.logger.Info("CategoryUsecase.DeleteCategory: deleting category", "category_id", id)
+	if _, err := uc.repo.FindByID(ctx, id); err != nil {
+		return err
+	}
+
+	count, err := uc.listingRepo.CountByCategory(ctx, id)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return fmt.Errorf("%w: %d listing(s) reference it", domain.ErrCategoryInUse, count)
+	}
+	return uc.repo.Delete(ctx, id)
+}
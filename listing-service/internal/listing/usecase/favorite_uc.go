@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/Abdurahmanit/GroupProject/listing-service/internal/listing/domain"
@@ -9,29 +10,57 @@ import (
 )
 
 type FavoriteUsecase struct {
-	repo   domain.FavoriteRepository
-	logger *logger.Logger // <--- ДОБАВЛЕНО
+	repo        domain.FavoriteRepository
+	listingRepo domain.ListingRepository // Для счетчика favorite_count на объявлении
+	cache       domain.ListingCache
+	logger      *logger.Logger // <--- ДОБАВЛЕНО
+	rules       FavoriteRules
 }
 
-func NewFavoriteUsecase(repo domain.FavoriteRepository, log *logger.Logger) *FavoriteUsecase { // <--- ДОБАВЛЕН ЛОГГЕР
+// FavoriteRules - ограничения на добавление в избранное. Нулевые значения отключают проверки.
+type FavoriteRules struct {
+	// BlockOwnListing запрещает продавцу добавлять в избранное свои объявления (накрутка favorite_count)
+	BlockOwnListing bool
+}
+
+func NewFavoriteUsecase(repo domain.FavoriteRepository, listingRepo domain.ListingRepository, cache domain.ListingCache, rules FavoriteRules, log *logger.Logger) *FavoriteUsecase { // <--- ДОБАВЛЕН ЛОГГЕР
 	return &FavoriteUsecase{
-		repo:   repo,
-		logger: log, // <--- СОХРАНЕН
+		repo:        repo,
+		listingRepo: listingRepo,
+		cache:       cache,
+		logger:      log, // <--- СОХРАНЕН
+		rules:       rules,
 	}
 }
 
 func (uc *FavoriteUsecase) AddFavorite(ctx context.Context, userID, listingID string) error {
 	uc.logger.Info("FavoriteUsecase.AddFavorite: adding favorite", "user_id", userID, "listing_id", listingID)
+	if uc.rules.BlockOwnListing {
+		listing, err := uc.listingRepo.FindByID(ctx, listingID)
+		if err != nil {
+			return err
+		}
+		if listing.UserID == userID {
+			uc.logger.Warn("FavoriteUsecase.AddFavorite: seller tried to favorite own listing", "user_id", userID, "listing_id", listingID)
+			return domain.ErrOwnListing
+		}
+	}
 	favorite := &domain.Favorite{
 		UserID:    userID,
 		ListingID: listingID,
 		CreatedAt: time.Now(),
 	}
 	err := uc.repo.Add(ctx, favorite)
+	if errors.Is(err, domain.ErrDuplicateFavorite) {
+		// Повторное добавление - не ошибка, но и счетчик не меняется
+		return nil
+	}
 	if err != nil {
 		uc.logger.Error("FavoriteUsecase.AddFavorite: failed to add favorite", "user_id", userID, "listing_id", listingID, "error", err.Error())
+		return err
 	}
-	return err
+	uc.updateFavoriteCount(ctx, listingID, 1)
+	return nil
 }
 
 func (uc *FavoriteUsecase) RemoveFavorite(ctx context.Context, userID, listingID string) error {
@@ -39,8 +68,45 @@ func (uc *FavoriteUsecase) RemoveFavorite(ctx context.Context, userID, listingID
 	err := uc.repo.Remove(ctx, userID, listingID)
 	if err != nil {
 		uc.logger.Error("FavoriteUsecase.RemoveFavorite: failed to remove favorite", "user_id", userID, "listing_id", listingID, "error", err.Error())
+		return err
+	}
+	uc.updateFavoriteCount(ctx, listingID, -1)
+	return nil
+}
+
+// updateFavoriteCount applies delta to the listing's favorite_count and drops the cached listing.
+// A negative result means the counter drifted (e.g. favorites added before it existed), so the
+// count is recomputed from the favorites collection. Errors are logged: the favorite itself is
+// already saved and the count is best effort.
+func (uc *FavoriteUsecase) updateFavoriteCount(ctx context.Context, listingID string, delta int64) {
+	count, err := uc.listingRepo.IncrementFavoriteCount(ctx, listingID, delta)
+	if err != nil {
+		uc.logger.Warn("FavoriteUsecase: failed to update favorite count", "listing_id", listingID, "error", err.Error())
+		return
+	}
+	if count < 0 {
+		if count, err = uc.repo.CountFavoritesByListing(ctx, listingID); err == nil {
+			err = uc.listingRepo.SetFavoriteCount(ctx, listingID, count)
+		}
+		if err != nil {
+			uc.logger.Warn("FavoriteUsecase: failed to recount favorites", "listing_id", listingID, "error", err.Error())
+		}
+	}
+	if err := uc.cache.DeleteListing(ctx, listingID); err != nil {
+		uc.logger.Warn("FavoriteUsecase: failed to invalidate cached listing", "listing_id", listingID, "error", err.Error())
+	}
+}
+
+// GetFavoritesPage returns one page of the user's favorites (page starts at 1) and their total count.
+func (uc *FavoriteUsecase) GetFavoritesPage(ctx context.Context, userID string, page, limit int) ([]*domain.Favorite, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	favorites, total, err := uc.repo.FindPageByUserID(ctx, userID, int64(page-1)*int64(limit), int64(limit))
+	if err != nil {
+		uc.logger.Error("FavoriteUsecase.GetFavoritesPage: failed to fetch favorites", "user_id", userID, "page", page, "error", err.Error())
 	}
-	return err
+	return favorites, total, err
 }
 
 func (uc *FavoriteUsecase) GetFavorites(ctx context.Context, userID string) ([]*domain.Favorite, error) {
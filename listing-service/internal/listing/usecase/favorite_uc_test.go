@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Abdurahmanit/GroupProject/listing-service/internal/listing/domain"
+	"github.com/Abdurahmanit/GroupProject/listing-service/internal/platform/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeFavoriteRepo ведет себя как коллекция с уникальным индексом по (user_id, listing_id).
+type fakeFavoriteRepo struct {
+	domain.FavoriteRepository
+	docs []*domain.Favorite
+}
+
+func (r *fakeFavoriteRepo) Add(_ context.Context, favorite *domain.Favorite) error {
+	for _, f := range r.docs {
+		if f.UserID == favorite.UserID && f.ListingID == favorite.ListingID {
+			return domain.ErrDuplicateFavorite
+		}
+	}
+	r.docs = append(r.docs, favorite)
+	return nil
+}
+
+type fakeFavoriteCountRepo struct {
+	domain.ListingRepository
+	counts map[string]int64
+	owners map[string]string
+}
+
+func (r *fakeFavoriteCountRepo) FindByID(_ context.Context, id string) (*domain.Listing, error) {
+	owner, ok := r.owners[id]
+	if !ok {
+		return nil, domain.ErrListingNotFound
+	}
+	return &domain.Listing{ID: id, UserID: owner}, nil
+}
+
+func (r *fakeFavoriteCountRepo) IncrementFavoriteCount(_ context.Context, id string, delta int64) (int64, error) {
+	r.counts[id] += delta
+	return r.counts[id], nil
+}
+
+type noopListingCache struct{ domain.ListingCache }
+
+func (noopListingCache) DeleteListing(context.Context, string) error { return nil }
+
+func TestFavoriteUsecase_AddFavoriteTwice(t *testing.T) {
+	repo := &fakeFavoriteRepo{}
+	listings := &fakeFavoriteCountRepo{counts: map[string]int64{}}
+	uc := NewFavoriteUsecase(repo, listings, noopListingCache{}, FavoriteRules{}, logger.NewLogger())
+
+	ctx := context.Background()
+	require.NoError(t, uc.AddFavorite(ctx, "user-1", "listing-1"))
+	require.NoError(t, uc.AddFavorite(ctx, "user-1", "listing-1"))
+
+	assert.Len(t, repo.docs, 1)
+	assert.Equal(t, int64(1), listings.counts["listing-1"])
+}
+
+func TestFavoriteUsecase_BlockOwnListing(t *testing.T) {
+	repo := &fakeFavoriteRepo{}
+	listings := &fakeFavoriteCountRepo{counts: map[string]int64{}, owners: map[string]string{"listing-1": "seller"}}
+	uc := NewFavoriteUsecase(repo, listings, noopListingCache{}, FavoriteRules{BlockOwnListing: true}, logger.NewLogger())
+
+	ctx := context.Background()
+	assert.ErrorIs(t, uc.AddFavorite(ctx, "seller", "listing-1"), domain.ErrOwnListing)
+	assert.ErrorIs(t, uc.AddFavorite(ctx, "user-1", "missing"), domain.ErrListingNotFound)
+	require.NoError(t, uc.AddFavorite(ctx, "user-1", "listing-1"))
+
+	assert.Len(t, repo.docs, 1)
+	assert.Equal(t, int64(1), listings.counts["listing-1"])
+}
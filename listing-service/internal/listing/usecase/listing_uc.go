@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"errors" // Для кастомных ошибок
+	"net/netip"
 	"time"
 	"fmt"
 	"github.com/Abdurahmanit/GroupProject/listing-service/internal/listing/domain"
@@ -13,46 +14,232 @@ import (
 var (
 	ErrListingNotFound = errors.New("listing not found")
 	ErrForbidden       = errors.New("user not authorized to perform this action")
+	ErrTooManyIDs      = errors.New("too many listing IDs requested")
 )
 
+// defaultQuantity - остаток нового объявления, если продавец его не указал: одна единица товара
+const defaultQuantity = 1
+
+// maxStatusBatchSize ограничивает количество ID в одном запросе GetListingsStatus и GetListingsByIDs
+const maxStatusBatchSize = 100
+
 type ListingUsecase struct {
-	repo   domain.ListingRepository
-	logger *logger.Logger // <--- ДОБАВЛЕНО
+	repo       domain.ListingRepository
+	categories domain.CategoryRepository
+	cache      domain.ListingCache
+	logger     *logger.Logger // <--- ДОБАВЛЕНО
+	publishRules PublishRules
+	views              domain.ViewTracker
+	history            domain.ViewHistory
+	viewSettings       ViewSettings
+	// searchCacheTTL - сколько хранить страницу результатов поиска (0 - не кэшировать)
+	searchCacheTTL time.Duration
+}
+
+// PublishRules - условия, при которых объявление может стать active. Нулевые значения отключают проверки.
+type PublishRules struct {
+	MinPhotos int // Сколько фото нужно для публикации; черновики не проверяются
+	// UniqueTitlePerSeller запрещает продавцу иметь два активных объявления с одинаковым заголовком
+	UniqueTitlePerSeller bool
 }
 
-func NewListingUsecase(repo domain.ListingRepository, log *logger.Logger) *ListingUsecase { // <--- ДОБАВЛЕН ЛОГГЕР
+// ViewSettings настраивает учет просмотров. Нулевые значения отключают соответствующую функцию.
+type ViewSettings struct {
+	Debounce    time.Duration // Повторный просмотр одним зрителем в пределах окна не засчитывается
+	HistorySize int           // Сколько последних просмотренных объявлений хранить на пользователя
+	// TrustedProxies - адреса api-gateway: только от них x-forwarded-for определяет анонимного зрителя
+	TrustedProxies []netip.Prefix
+}
+
+func NewListingUsecase(repo domain.ListingRepository, categories domain.CategoryRepository, cache domain.ListingCache, views domain.ViewTracker, history domain.ViewHistory, log *logger.Logger, publishRules PublishRules, viewSettings ViewSettings, searchCacheTTL time.Duration) *ListingUsecase { // <--- ДОБАВЛЕН ЛОГГЕР
 	return &ListingUsecase{
-		repo:   repo,
-		logger: log, // <--- СОХРАНЕН
+		repo:               repo,
+		categories:         categories,
+		cache:              cache,
+		logger:             log, // <--- СОХРАНЕН
+		publishRules:       publishRules,
+		views:              views,
+		history:            history,
+		viewSettings:       viewSettings,
+		searchCacheTTL:     searchCacheTTL,
+	}
+}
+
+// checkCategory возвращает ErrInvalidListingData, если категория с таким ID не существует.
+// Пустой categoryID допускается (объявление без категории). Пока коллекция категорий пуста
+// (администратор еще не завел справочник), ID не проверяются: существующие объявления
+// ссылаются на категории, которых в коллекции нет.
+func (uc *ListingUsecase) checkCategory(ctx context.Context, categoryID string) error {
+	if categoryID == "" {
+		return nil
+	}
+	if _, err := uc.categories.FindByID(ctx, categoryID); err != nil {
+		if errors.Is(err, domain.ErrCategoryNotFound) {
+			count, countErr := uc.categories.Count(ctx)
+			if countErr != nil {
+				return countErr
+			}
+			if count == 0 {
+				return nil
+			}
+			return fmt.Errorf("%w: unknown category %q", domain.ErrInvalidListingData, categoryID)
+		}
+		return err
+	}
+	return nil
+}
+
+// checkCanPublish returns ErrNotEnoughPhotos (wrapped with the required count) when the
+// listing would become active without the configured minimum number of photos.
+func (uc *ListingUsecase) checkCanPublish(listing *domain.Listing, newStatus domain.ListingStatus) error {
+	if newStatus != domain.StatusActive || len(listing.Photos) >= uc.publishRules.MinPhotos {
+		return nil
 	}
+	uc.logger.Warn("ListingUsecase: listing cannot be published without photos",
+		"listing_id", listing.ID, "photos", len(listing.Photos), "required_photos", uc.publishRules.MinPhotos)
+	return fmt.Errorf("%w: at least %d photo(s) required, listing has %d; add a photo or keep it as a draft",
+		domain.ErrNotEnoughPhotos, uc.publishRules.MinPhotos, len(listing.Photos))
 }
 
-// CreateListing теперь принимает userID и categoryID
-func (uc *ListingUsecase) CreateListing(ctx context.Context, userID, categoryID, title, description string, price float64) (*domain.Listing, error) {
+// checkUniqueTitle returns a DuplicateTitleError with the conflicting listing when the rule is
+// enabled and the seller already has another active listing with the same normalized title.
+// Call it only for listings that are (or are becoming) active.
+func (uc *ListingUsecase) checkUniqueTitle(ctx context.Context, listing *domain.Listing) error {
+	if !uc.publishRules.UniqueTitlePerSeller {
+		return nil
+	}
+	existing, err := uc.repo.FindActiveByTitle(ctx, listing.UserID, domain.NormalizeTitle(listing.Title), listing.ID)
+	if errors.Is(err, domain.ErrListingNotFound) {
+		return nil
+	}
+	if err != nil {
+		uc.logger.Error("ListingUsecase: failed to check for duplicate title", "user_id", listing.UserID, "error", err.Error())
+		return err
+	}
+	uc.logger.Warn("ListingUsecase: rejected duplicate title",
+		"listing_id", listing.ID, "user_id", listing.UserID, "conflicting_listing_id", existing.ID)
+	return &domain.DuplicateTitleError{ListingID: existing.ID}
+}
+
+// checkStatusChange returns ErrInvalidStatusChange when the listing is already in a terminal status.
+func (uc *ListingUsecase) checkStatusChange(listing *domain.Listing, newStatus domain.ListingStatus) error {
+	if listing.Status.CanTransitionTo(newStatus) {
+		return nil
+	}
+	uc.logger.Warn("ListingUsecase: rejected status change of a terminal listing",
+		"listing_id", listing.ID, "status", string(listing.Status), "new_status", string(newStatus))
+	return fmt.Errorf("%w: listing is %s", domain.ErrInvalidStatusChange, listing.Status)
+}
+
+// CreateListing теперь принимает userID и categoryID. При draft = true объявление создается
+// черновиком и публикуется позже через PublishListing.
+func (uc *ListingUsecase) CreateListing(ctx context.Context, userID, categoryID, title, description string, price float64, negotiable bool, latitude, longitude float64, draft bool, availability domain.AvailabilityWindow, quantity int64) (*domain.Listing, error) {
 	uc.logger.Info("ListingUsecase.CreateListing: creating new listing",
 		"user_id", userID, "category_id", categoryID, "title", title)
 
+	if !domain.ValidCoordinates(latitude, longitude) {
+		return nil, fmt.Errorf("%w: latitude must be in [-90, 90] and longitude in [-180, 180]", domain.ErrInvalidListingData)
+	}
+	if err := availability.Validate(); err != nil {
+		return nil, err
+	}
+	if quantity < 0 {
+		return nil, fmt.Errorf("%w: quantity must not be negative", domain.ErrInvalidListingData)
+	}
+	if quantity == 0 {
+		quantity = defaultQuantity
+	}
+	if err := uc.checkCategory(ctx, categoryID); err != nil {
+		return nil, err
+	}
+
+	// Новое объявление еще без фото, поэтому при включенном правиле оно создается как черновик
+	initialStatus := domain.StatusActive
+	if draft || uc.publishRules.MinPhotos > 0 {
+		initialStatus = domain.StatusDraft
+	}
+
 	listing := &domain.Listing{
-		UserID:      userID, // <--- СОХРАНЯЕМ
-		CategoryID:  categoryID, // <--- СОХРАНЯЕМ
-		Title:       title,
-		Description: description,
-		Price:       price,
-		Status:      domain.StatusActive, // Убедись, что StatusActive определен в domain
-		Photos:      []string{},          // Инициализируем пустым слайсом
-		CreatedAt:   time.Now(),
-		UpdatedAt:   time.Now(),
-	}
-	err := uc.repo.Create(ctx, listing)
-	if err != nil {
+		UserID:       userID,     // <--- СОХРАНЯЕМ
+		CategoryID:   categoryID, // <--- СОХРАНЯЕМ
+		Title:        title,
+		Description:  description,
+		Price:        price,
+		Negotiable:   negotiable,
+		Status:       initialStatus,
+		Latitude:     latitude,
+		Longitude:    longitude,
+		Availability: availability,
+		Quantity:     quantity,
+	}
+	if listing.Status == domain.StatusActive {
+		if err := uc.checkUniqueTitle(ctx, listing); err != nil {
+			return nil, err
+		}
+	}
+	if err := uc.insert(ctx, listing); err != nil {
 		uc.logger.Error("ListingUsecase.CreateListing: failed to create listing", "error", err.Error(), "user_id", userID)
 		return nil, err
 	}
 	return listing, nil
 }
 
+// insert сохраняет новое объявление без фото и с текущими временными метками.
+func (uc *ListingUsecase) insert(ctx context.Context, listing *domain.Listing) error {
+	now := time.Now()
+	listing.Photos = []string{}
+	listing.CreatedAt = now
+	listing.UpdatedAt = now
+	return uc.repo.Create(ctx, listing)
+}
+
+// DuplicateListing creates a draft copy of one of the seller's listings. Only the title, description,
+// category, price, negotiability and location are copied; photos, status history and stats start fresh,
+// and the copy has the default quantity.
+func (uc *ListingUsecase) DuplicateListing(ctx context.Context, id, userID string) (*domain.Listing, error) {
+	uc.logger.Info("ListingUsecase.DuplicateListing: duplicating listing",
+		"listing_id", id, "user_id_performing_action", userID)
+
+	source, err := uc.repo.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrListingNotFound) {
+			return nil, ErrListingNotFound
+		}
+		uc.logger.Error("ListingUsecase.DuplicateListing: failed to find listing", "listing_id", id, "error", err.Error())
+		return nil, err
+	}
+	if source.IsDeleted() {
+		return nil, ErrListingNotFound
+	}
+	if source.UserID != userID {
+		uc.logger.Warn("ListingUsecase.DuplicateListing: forbidden to duplicate listing",
+			"listing_id", id, "listing_owner_id", source.UserID, "user_id_performing_action", userID)
+		return nil, ErrForbidden
+	}
+
+	duplicate := &domain.Listing{
+		UserID:      userID,
+		CategoryID:  source.CategoryID,
+		Title:       source.Title,
+		Description: source.Description,
+		Price:       source.Price,
+		Negotiable:  source.Negotiable,
+		Status:      domain.StatusDraft,
+		Latitude:    source.Latitude,
+		Longitude:   source.Longitude,
+		Quantity:    defaultQuantity,
+	}
+	if err := uc.insert(ctx, duplicate); err != nil {
+		uc.logger.Error("ListingUsecase.DuplicateListing: failed to create duplicate", "listing_id", id, "error", err.Error())
+		return nil, err
+	}
+	uc.logger.Info("ListingUsecase.DuplicateListing: created draft", "source_listing_id", id, "new_listing_id", duplicate.ID)
+	return duplicate, nil
+}
+
 // UpdateListing теперь принимает userID для авторизации и categoryID
-func (uc *ListingUsecase) UpdateListing(ctx context.Context, id, userID, categoryID, title, description string, price float64, status domain.ListingStatus) (*domain.Listing, error) {
+// availability == nil оставляет окно доступности без изменений.
+func (uc *ListingUsecase) UpdateListing(ctx context.Context, id, userID, categoryID, title, description string, price float64, negotiable *bool, status domain.ListingStatus, availability *domain.AvailabilityWindow, quantity *int64) (*domain.Listing, error) {
 	uc.logger.Info("ListingUsecase.UpdateListing: updating listing",
 		"listing_id", id, "user_id_performing_action", userID)
 
@@ -64,7 +251,7 @@ func (uc *ListingUsecase) UpdateListing(ctx context.Context, id, userID, categor
 		}
 		return nil, err
 	}
-	if listing == nil { // Дополнительная проверка
+	if listing == nil || listing.IsDeleted() { // Дополнительная проверка
 		uc.logger.Warn("ListingUsecase.UpdateListing: listing not found by ID", "listing_id", id)
 		return nil, ErrListingNotFound
 	}
@@ -76,6 +263,10 @@ func (uc *ListingUsecase) UpdateListing(ctx context.Context, id, userID, categor
 		return nil, ErrForbidden
 	}
 
+	// Дубликат заголовка проверяем, только если меняется заголовок или объявление публикуется,
+	// чтобы правка цены не блокировалась из-за старых дубликатов
+	wasActive, oldTitle := listing.Status == domain.StatusActive, listing.Title
+
 	// Обновляем поля, если они переданы (проверка на пустые строки/значения по умолчанию может быть добавлена)
 	if title != "" {
 		listing.Title = title
@@ -86,12 +277,38 @@ func (uc *ListingUsecase) UpdateListing(ctx context.Context, id, userID, categor
 	if price > 0 { // Пример: цена должна быть больше 0 для обновления
 		listing.Price = price
 	}
-	if categoryID != "" {
+	if categoryID != "" && categoryID != listing.CategoryID {
+		if err := uc.checkCategory(ctx, categoryID); err != nil {
+			return nil, err
+		}
 		listing.CategoryID = categoryID
 	}
+	if negotiable != nil {
+		listing.Negotiable = *negotiable
+	}
+	if availability != nil {
+		if err := availability.Validate(); err != nil {
+			return nil, err
+		}
+		listing.Availability = *availability
+	}
 	if status != "" && status != listing.Status { // Обновляем статус, если он передан и отличается
+		if err := uc.checkStatusChange(listing, status); err != nil {
+			return nil, err
+		}
+		if err := uc.checkCanPublish(listing, status); err != nil {
+			return nil, err
+		}
 		listing.Status = status
 	}
+	if quantity != nil && *quantity < 0 {
+		return nil, fmt.Errorf("%w: quantity cannot be negative", domain.ErrInvalidListingData)
+	}
+	if listing.Status == domain.StatusActive && (!wasActive || listing.Title != oldTitle) {
+		if err := uc.checkUniqueTitle(ctx, listing); err != nil {
+			return nil, err
+		}
+	}
 	listing.UpdatedAt = time.Now()
 
 	err = uc.repo.Update(ctx, listing)
@@ -99,10 +316,20 @@ func (uc *ListingUsecase) UpdateListing(ctx context.Context, id, userID, categor
 		uc.logger.Error("ListingUsecase.UpdateListing: failed to update listing in repo", "listing_id", id, "error", err.Error())
 		return nil, err
 	}
+	// Остаток пишется отдельным $set: Update его не трогает, чтобы не затирать одновременные резервы
+	if quantity != nil {
+		if err := uc.repo.SetQuantity(ctx, id, *quantity); err != nil {
+			uc.logger.Error("ListingUsecase.UpdateListing: failed to set quantity", "listing_id", id, "quantity", *quantity, "error", err.Error())
+			return nil, err
+		}
+		listing.Quantity = *quantity
+		uc.dropCachedListing(ctx, id)
+	}
 	return listing, nil
 }
 
-// DeleteListing теперь принимает userID для авторизации
+// DeleteListing теперь принимает userID для авторизации. Удаление мягкое: объявление получает
+// статус deleted и пропадает из поиска и GetListingByID, но остается доступным администраторам.
 func (uc *ListingUsecase) DeleteListing(ctx context.Context, id, userID string) error {
 	uc.logger.Info("ListingUsecase.DeleteListing: deleting listing",
 		"listing_id", id, "user_id_performing_action", userID)
@@ -115,7 +342,7 @@ func (uc *ListingUsecase) DeleteListing(ctx context.Context, id, userID string)
 		}
 		return err
 	}
-    if listing == nil {
+    if listing == nil || listing.IsDeleted() {
 		uc.logger.Warn("ListingUsecase.DeleteListing: listing not found by ID", "listing_id", id)
 		return ErrListingNotFound
 	}
@@ -127,7 +354,7 @@ func (uc *ListingUsecase) DeleteListing(ctx context.Context, id, userID string)
 		return ErrForbidden
 	}
 
-	err = uc.repo.Delete(ctx, id)
+	err = uc.repo.SoftDelete(ctx, id, time.Now())
 	if err != nil {
 		uc.logger.Error("ListingUsecase.DeleteListing: failed to delete listing in repo", "listing_id", id, "error", err.Error())
 	}
@@ -139,7 +366,8 @@ func (uc *ListingUsecase) DeleteListing(ctx context.Context, id, userID string)
 	return err
 }
 
-func (uc *ListingUsecase) GetListingByID(ctx context.Context, id string) (*domain.Listing, error) {
+// GetListingByID hides deleted listings unless includeDeleted is set (admin requests only).
+func (uc *ListingUsecase) GetListingByID(ctx context.Context, id string, includeDeleted bool) (*domain.Listing, error) {
 	uc.logger.Info("ListingUsecase.GetListingByID: fetching listing", "listing_id", id)
 	listing, err := uc.repo.FindByID(ctx, id)
 	if err != nil {
@@ -149,16 +377,251 @@ func (uc *ListingUsecase) GetListingByID(ctx context.Context, id string) (*domai
 		}
 		return nil, err
 	}
-    if listing == nil {
+    if listing == nil || (listing.IsDeleted() && !includeDeleted) {
 		uc.logger.Warn("ListingUsecase.GetListingByID: listing not found by ID", "listing_id", id)
 		return nil, ErrListingNotFound
 	}
 	return listing, nil
 }
 
+// RecordView counts a view of listing by viewerID and updates listing.ViewCount with the new
+// total. Views repeated by the same viewer within the debounce window are not counted; an
+// empty viewerID or a zero window disables the debounce. Counting is best effort: errors are
+// logged and never fail the read.
+func (uc *ListingUsecase) RecordView(ctx context.Context, listing *domain.Listing, viewerID string) {
+	if viewerID != "" && uc.viewSettings.Debounce > 0 && uc.views != nil {
+		first, err := uc.views.MarkViewed(ctx, listing.ID, viewerID, uc.viewSettings.Debounce)
+		if err != nil {
+			uc.logger.Warn("ListingUsecase.RecordView: view tracker failed, counting view", "listing_id", listing.ID, "error", err.Error())
+		} else if !first {
+			return
+		}
+	}
+
+	count, err := uc.repo.IncrementViewCount(ctx, listing.ID)
+	if err != nil {
+		uc.logger.Warn("ListingUsecase.RecordView: failed to increment view count", "listing_id", listing.ID, "error", err.Error())
+		return
+	}
+	listing.ViewCount = count
+}
+
+// AddToViewHistory puts listing at the front of the user's recently viewed list. The user's
+// own listings are not recorded. Errors are logged and never fail the read.
+func (uc *ListingUsecase) AddToViewHistory(ctx context.Context, userID string, listing *domain.Listing) {
+	if userID == "" || listing.UserID == userID || uc.viewSettings.HistorySize <= 0 || uc.history == nil {
+		return
+	}
+	if err := uc.history.AddViewed(ctx, userID, listing.ID, uc.viewSettings.HistorySize); err != nil {
+		uc.logger.Warn("ListingUsecase.AddToViewHistory: failed to record view", "user_id", userID, "listing_id", listing.ID, "error", err.Error())
+	}
+}
+
+// GetRecentlyViewed returns up to limit listings the user viewed, most recent first. Listings
+// that were deleted since, or that now belong to the user, are skipped. A limit outside
+// 1..HistorySize returns the whole history.
+func (uc *ListingUsecase) GetRecentlyViewed(ctx context.Context, userID string, limit int) ([]*domain.Listing, error) {
+	if uc.viewSettings.HistorySize <= 0 || uc.history == nil {
+		return []*domain.Listing{}, nil
+	}
+	if limit <= 0 || limit > uc.viewSettings.HistorySize {
+		limit = uc.viewSettings.HistorySize
+	}
+
+	ids, err := uc.history.RecentlyViewed(ctx, userID, limit)
+	if err != nil {
+		uc.logger.Error("ListingUsecase.GetRecentlyViewed: failed to read history", "user_id", userID, "error", err.Error())
+		return nil, err
+	}
+	if len(ids) > maxStatusBatchSize {
+		ids = ids[:maxStatusBatchSize]
+	}
+
+	listings, err := uc.GetListingsByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	result := listings[:0]
+	for _, l := range listings {
+		if l.UserID != userID {
+			result = append(result, l)
+		}
+	}
+	return result, nil
+}
+
+// GetListingsStatus returns status, price, availability and owner for each requested ID.
+// IDs that do not exist are reported with StatusNotFound.
+func (uc *ListingUsecase) GetListingsStatus(ctx context.Context, ids []string) (map[string]*domain.ListingAvailability, error) {
+	if len(ids) > maxStatusBatchSize {
+		return nil, fmt.Errorf("%w: %d requested, at most %d allowed", ErrTooManyIDs, len(ids), maxStatusBatchSize)
+	}
+
+	listings, err := uc.repo.FindStatusesByIDs(ctx, ids)
+	if err != nil {
+		uc.logger.Error("ListingUsecase.GetListingsStatus: failed to fetch statuses", "count", len(ids), "error", err.Error())
+		return nil, err
+	}
+
+	result := make(map[string]*domain.ListingAvailability, len(ids))
+	for _, id := range ids {
+		result[id] = &domain.ListingAvailability{Status: domain.StatusNotFound}
+	}
+	now := time.Now()
+	for _, l := range listings {
+		result[l.ID] = &domain.ListingAvailability{
+			Status:       l.Status,
+			Price:        l.Price,
+			Available:    l.Status == domain.StatusActive && l.Availability.Contains(now) && l.Quantity > 0,
+			Availability: l.Availability,
+			UserID:       l.UserID,
+			Quantity:     l.Quantity,
+		}
+	}
+	return result, nil
+}
+
+// ReserveStock takes quantity units of an active listing for the order and returns the units left.
+// It fails with domain.ErrOutOfStock when there are not enough; callers undo it with ReleaseStock.
+// Repeating the call for the same order does not take the units again.
+func (uc *ListingUsecase) ReserveStock(ctx context.Context, id, orderNumber string, quantity int64) (int64, error) {
+	if quantity <= 0 {
+		return 0, fmt.Errorf("%w: quantity must be positive", domain.ErrInvalidListingData)
+	}
+	if orderNumber == "" {
+		return 0, fmt.Errorf("%w: order number is required", domain.ErrInvalidListingData)
+	}
+	remaining, err := uc.repo.ReserveStock(ctx, id, orderNumber, quantity)
+	if err != nil {
+		if errors.Is(err, domain.ErrListingNotFound) {
+			return 0, ErrListingNotFound
+		}
+		if !errors.Is(err, domain.ErrOutOfStock) {
+			uc.logger.Error("ListingUsecase.ReserveStock: failed to reserve stock", "listing_id", id, "order_number", orderNumber, "quantity", quantity, "error", err.Error())
+		}
+		return 0, err
+	}
+	uc.dropCachedListing(ctx, id)
+	uc.logger.Info("ListingUsecase.ReserveStock: reserved", "listing_id", id, "order_number", orderNumber, "quantity", quantity, "remaining", remaining)
+	return remaining, nil
+}
+
+// ReleaseStock returns the units reserved for the order, e.g. when placing it failed afterwards or
+// it was cancelled or refunded. Only what was reserved is returned, and only once.
+func (uc *ListingUsecase) ReleaseStock(ctx context.Context, id, orderNumber string) error {
+	if orderNumber == "" {
+		return fmt.Errorf("%w: order number is required", domain.ErrInvalidListingData)
+	}
+	released, err := uc.repo.ReleaseStock(ctx, id, orderNumber)
+	if err != nil {
+		if errors.Is(err, domain.ErrListingNotFound) {
+			return ErrListingNotFound
+		}
+		uc.logger.Error("ListingUsecase.ReleaseStock: failed to release stock", "listing_id", id, "order_number", orderNumber, "error", err.Error())
+		return err
+	}
+	if released == 0 {
+		uc.logger.Info("ListingUsecase.ReleaseStock: nothing reserved for order", "listing_id", id, "order_number", orderNumber)
+		return nil
+	}
+	uc.dropCachedListing(ctx, id)
+	uc.logger.Info("ListingUsecase.ReleaseStock: released", "listing_id", id, "order_number", orderNumber, "quantity", released)
+	return nil
+}
+
+// dropCachedListing убирает объявление из кэша после изменения остатка; ошибка кэша не фатальна.
+func (uc *ListingUsecase) dropCachedListing(ctx context.Context, id string) {
+	if err := uc.cache.DeleteListing(ctx, id); err != nil {
+		uc.logger.Warn("ListingUsecase: failed to drop listing from cache", "listing_id", id, "error", err.Error())
+	}
+}
+
+// GetListingsByIDs returns the listings for ids in request order, skipping IDs that do not exist
+// or were deleted, and duplicates. Cached listings are served from Redis; only the misses are read from Mongo and
+// then written back to the cache. Cache errors are logged and treated as misses.
+func (uc *ListingUsecase) GetListingsByIDs(ctx context.Context, ids []string) ([]*domain.Listing, error) {
+	if len(ids) > maxStatusBatchSize {
+		return nil, fmt.Errorf("%w: %d requested, at most %d allowed", ErrTooManyIDs, len(ids), maxStatusBatchSize)
+	}
+
+	found := make(map[string]*domain.Listing, len(ids))
+	var misses []string
+	for _, id := range ids {
+		if _, seen := found[id]; seen {
+			continue
+		}
+		cached, err := uc.cache.GetListing(ctx, id)
+		if err != nil {
+			uc.logger.Warn("ListingUsecase.GetListingsByIDs: cache lookup failed", "listing_id", id, "error", err.Error())
+		}
+		found[id] = cached
+		if cached == nil {
+			misses = append(misses, id)
+		}
+	}
+
+	if len(misses) > 0 {
+		listings, err := uc.repo.FindByIDs(ctx, misses)
+		if err != nil {
+			uc.logger.Error("ListingUsecase.GetListingsByIDs: failed to fetch listings", "count", len(misses), "error", err.Error())
+			return nil, err
+		}
+		for _, l := range listings {
+			if l.IsDeleted() {
+				continue
+			}
+			found[l.ID] = l
+			if err := uc.cache.SetListing(ctx, l); err != nil {
+				uc.logger.Warn("ListingUsecase.GetListingsByIDs: cache backfill failed", "listing_id", l.ID, "error", err.Error())
+			}
+		}
+	}
+	uc.logger.Debug("ListingUsecase.GetListingsByIDs: fetched listings",
+		"requested", len(ids), "cache_misses", len(misses))
+
+	result := make([]*domain.Listing, 0, len(found))
+	for _, id := range ids {
+		if l := found[id]; l != nil && !l.IsDeleted() {
+			result = append(result, l)
+			delete(found, id)
+		}
+	}
+	return result, nil
+}
+
 // SearchListings теперь возвращает (listings, total, error)
 func (uc *ListingUsecase) SearchListings(ctx context.Context, filter domain.Filter) ([]*domain.Listing, int64, error) {
 	uc.logger.Info("ListingUsecase.SearchListings: searching listings", "filter", fmt.Sprintf("%+v", filter))
+	if filter.RadiusKm < 0 {
+		return nil, 0, fmt.Errorf("%w: radius_km must not be negative", domain.ErrInvalidFilter)
+	}
+	if filter.RadiusKm > 0 && !domain.ValidCoordinates(filter.CenterLat, filter.CenterLng) {
+		return nil, 0, fmt.Errorf("%w: center_lat must be in [-90, 90] and center_lng in [-180, 180]", domain.ErrInvalidFilter)
+	}
+
+	// Кэшируются только страницы, которые потом можно поднять одним GetListingsByIDs. Запросы
+	// с ExcludeUserID зависят от зрителя и почти не повторяются, поэтому общий кэш ими не засоряем.
+	cacheable := uc.searchCacheTTL > 0 && filter.ExcludeUserID == "" && filter.Limit > 0 && filter.Limit <= maxStatusBatchSize
+	var cacheKey string
+	if cacheable {
+		cached, key, err := uc.cache.GetSearchResult(ctx, filter)
+		if err != nil {
+			uc.logger.Warn("ListingUsecase.SearchListings: search cache lookup failed", "error", err.Error())
+		}
+		if cached != nil {
+			listings, err := uc.GetListingsByIDs(ctx, cached.IDs)
+			if err == nil {
+				if !filter.IncludeScheduled {
+					// Окно доступности могло закрыться, пока результат лежал в кэше
+					listings = filterAvailableNow(listings, time.Now())
+				}
+				return listings, cached.Total, nil
+			}
+			uc.logger.Warn("ListingUsecase.SearchListings: failed to hydrate cached search result", "error", err.Error())
+		}
+		cacheKey = key
+	}
+
 	// Предполагаем, что FindByFilter в репозитории теперь возвращает (listings, total, error)
 	// Если нет, тебе нужно будет либо изменить репозиторий, либо сделать два запроса: один для данных, другой для count(*).
 	listings, total, err := uc.repo.FindByFilter(ctx, filter)
@@ -166,9 +629,35 @@ func (uc *ListingUsecase) SearchListings(ctx context.Context, filter domain.Filt
 		uc.logger.Error("ListingUsecase.SearchListings: failed to search listings", "filter", fmt.Sprintf("%+v", filter), "error", err.Error())
 		return nil, 0, err
 	}
+
+	if cacheKey != "" {
+		result := &domain.SearchResult{IDs: make([]string, 0, len(listings)), Total: total}
+		for _, l := range listings {
+			result.IDs = append(result.IDs, l.ID)
+		}
+		if err := uc.cache.SetSearchResult(ctx, cacheKey, result, uc.searchCacheTTL); err != nil {
+			uc.logger.Warn("ListingUsecase.SearchListings: failed to cache search result", "error", err.Error())
+		}
+	}
 	return listings, total, nil
 }
 
+func filterAvailableNow(listings []*domain.Listing, now time.Time) []*domain.Listing {
+	result := listings[:0]
+	for _, l := range listings {
+		if l.Availability.Contains(now) {
+			result = append(result, l)
+		}
+	}
+	return result
+}
+
+// InvalidateSearchCache drops every cached search result. It is called for each listing
+// change event, so results are never staler than the time it takes the event to arrive.
+func (uc *ListingUsecase) InvalidateSearchCache(ctx context.Context) error {
+	return uc.cache.InvalidateSearchResults(ctx)
+}
+
 // UpdateListingStatus - новый метод
 func (uc *ListingUsecase) UpdateListingStatus(ctx context.Context, id, userID string, status domain.ListingStatus) (*domain.Listing, error) {
 	uc.logger.Info("ListingUsecase.UpdateListingStatus: updating listing status",
@@ -182,7 +671,7 @@ func (uc *ListingUsecase) UpdateListingStatus(ctx context.Context, id, userID st
 		}
 		return nil, err
 	}
-    if listing == nil {
+    if listing == nil || listing.IsDeleted() {
 		uc.logger.Warn("ListingUsecase.UpdateListingStatus: listing not found by ID", "listing_id", id)
 		return nil, ErrListingNotFound
 	}
@@ -199,6 +688,18 @@ func (uc *ListingUsecase) UpdateListingStatus(ctx context.Context, id, userID st
 		return nil, errors.New("status cannot be empty") // Или более специфичная ошибка
 	}
 
+	if err := uc.checkStatusChange(listing, status); err != nil {
+		return nil, err
+	}
+	if err := uc.checkCanPublish(listing, status); err != nil {
+		return nil, err
+	}
+	if status == domain.StatusActive && listing.Status != domain.StatusActive {
+		if err := uc.checkUniqueTitle(ctx, listing); err != nil {
+			return nil, err
+		}
+	}
+
 	listing.Status = status
 	listing.UpdatedAt = time.Now()
 
@@ -208,4 +709,91 @@ func (uc *ListingUsecase) UpdateListingStatus(ctx context.Context, id, userID st
 		return nil, err
 	}
 	return listing, nil
+}
+
+// PublishListing makes a draft listing active. The photo requirement applies as for any
+// other activation.
+func (uc *ListingUsecase) PublishListing(ctx context.Context, id, userID string) (*domain.Listing, error) {
+	uc.logger.Info("ListingUsecase.PublishListing: publishing listing",
+		"listing_id", id, "user_id_performing_action", userID)
+
+	listing, err := uc.repo.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrListingNotFound) {
+			return nil, ErrListingNotFound
+		}
+		uc.logger.Error("ListingUsecase.PublishListing: failed to find listing", "listing_id", id, "error", err.Error())
+		return nil, err
+	}
+	if listing.IsDeleted() {
+		return nil, ErrListingNotFound
+	}
+
+	if listing.UserID != userID {
+		uc.logger.Warn("ListingUsecase.PublishListing: forbidden to publish listing",
+			"listing_id", id, "listing_owner_id", listing.UserID, "user_id_performing_action", userID)
+		return nil, ErrForbidden
+	}
+
+	if !listing.Status.CanPublish() {
+		return nil, fmt.Errorf("%w: only drafts can be published, listing is %s", domain.ErrInvalidStatusChange, listing.Status)
+	}
+	if err := uc.checkCanPublish(listing, domain.StatusActive); err != nil {
+		return nil, err
+	}
+	if err := uc.checkUniqueTitle(ctx, listing); err != nil {
+		return nil, err
+	}
+
+	listing.Status = domain.StatusActive
+	listing.UpdatedAt = time.Now()
+
+	if err := uc.repo.Update(ctx, listing); err != nil {
+		uc.logger.Error("ListingUsecase.PublishListing: failed to update listing in repo", "listing_id", id, "error", err.Error())
+		return nil, err
+	}
+	return listing, nil
+}
+
+// MarkUnavailable moves the listing into a terminal status (StatusSoldElsewhere or StatusWithdrawn)
+// with an optional reason from the seller. Terminal listings are hidden from search.
+func (uc *ListingUsecase) MarkUnavailable(ctx context.Context, id, userID string, status domain.ListingStatus, reason string) (*domain.Listing, error) {
+	uc.logger.Info("ListingUsecase.MarkUnavailable: marking listing unavailable",
+		"listing_id", id, "user_id_performing_action", userID, "new_status", string(status))
+
+	if !status.IsTerminal() {
+		return nil, fmt.Errorf("%w: status must be %s or %s", domain.ErrInvalidListingData, domain.StatusSoldElsewhere, domain.StatusWithdrawn)
+	}
+
+	listing, err := uc.repo.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrListingNotFound) {
+			return nil, ErrListingNotFound
+		}
+		uc.logger.Error("ListingUsecase.MarkUnavailable: failed to find listing", "listing_id", id, "error", err.Error())
+		return nil, err
+	}
+	if listing.IsDeleted() {
+		return nil, ErrListingNotFound
+	}
+
+	if listing.UserID != userID {
+		uc.logger.Warn("ListingUsecase.MarkUnavailable: forbidden to mark listing unavailable",
+			"listing_id", id, "listing_owner_id", listing.UserID, "user_id_performing_action", userID)
+		return nil, ErrForbidden
+	}
+
+	if listing.Status == domain.StatusSold || listing.Status.IsTerminal() {
+		return nil, fmt.Errorf("%w: listing is already %s", domain.ErrInvalidStatusChange, listing.Status)
+	}
+
+	listing.Status = status
+	listing.UnavailableReason = reason
+	listing.UpdatedAt = time.Now()
+
+	if err := uc.repo.Update(ctx, listing); err != nil {
+		uc.logger.Error("ListingUsecase.MarkUnavailable: failed to update listing in repo", "listing_id", id, "error", err.Error())
+		return nil, err
+	}
+	return listing, nil
 }
\ No newline at end of file
@@ -0,0 +1,227 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Abdurahmanit/GroupProject/listing-service/internal/listing/domain"
+	"github.com/Abdurahmanit/GroupProject/listing-service/internal/platform/logger"
+)
+
+type OfferUsecase struct {
+	offerRepo   domain.OfferRepository
+	listingRepo domain.ListingRepository
+	cache       domain.ListingCache
+	logger      *logger.Logger
+}
+
+func NewOfferUsecase(offerRepo domain.OfferRepository, listingRepo domain.ListingRepository, cache domain.ListingCache, log *logger.Logger) *OfferUsecase {
+	return &OfferUsecase{
+		offerRepo:   offerRepo,
+		listingRepo: listingRepo,
+		cache:       cache,
+		logger:      log,
+	}
+}
+
+// MakeOffer creates a pending offer from buyerID on a negotiable, active listing.
+func (uc *OfferUsecase) MakeOffer(ctx context.Context, listingID, buyerID string, amount float64) (*domain.Offer, error) {
+	uc.logger.Info("OfferUsecase.MakeOffer: creating offer", "listing_id", listingID, "buyer_id", buyerID, "amount", amount)
+	if amount <= 0 {
+		return nil, fmt.Errorf("%w: amount must be greater than 0", domain.ErrInvalidOffer)
+	}
+
+	listing, err := uc.listingRepo.FindByID(ctx, listingID)
+	if err != nil {
+		if errors.Is(err, domain.ErrListingNotFound) {
+			return nil, ErrListingNotFound
+		}
+		uc.logger.Error("OfferUsecase.MakeOffer: failed to find listing", "listing_id", listingID, "error", err.Error())
+		return nil, err
+	}
+	if !listing.Negotiable {
+		return nil, domain.ErrListingNotNegotiable
+	}
+	if listing.Status != domain.StatusActive {
+		return nil, fmt.Errorf("%w: listing is %s", domain.ErrListingNotNegotiable, listing.Status)
+	}
+	if listing.UserID == buyerID {
+		return nil, fmt.Errorf("%w: cannot make an offer on your own listing", domain.ErrInvalidOffer)
+	}
+
+	offer := &domain.Offer{
+		ListingID: listing.ID,
+		BuyerID:   buyerID,
+		SellerID:  listing.UserID,
+		Amount:    amount,
+		Status:    domain.OfferStatusPending,
+		LastActor: buyerID,
+	}
+	if err := uc.offerRepo.Create(ctx, offer); err != nil {
+		uc.logger.Error("OfferUsecase.MakeOffer: failed to create offer", "listing_id", listingID, "buyer_id", buyerID, "error", err.Error())
+		return nil, err
+	}
+	return offer, nil
+}
+
+// RespondToOffer lets the party whose turn it is accept, reject or counter an open offer: the
+// seller answers the buyer's amount, the buyer answers the seller's counter, and so on.
+// amount is only used for OfferActionCounter. Accepting reserves offerQuantity units of the
+// listing atomically, so concurrent accepts cannot take more than is in stock; once the last
+// unit is gone the other open offers on the listing are rejected.
+func (uc *OfferUsecase) RespondToOffer(ctx context.Context, offerID, userID string, action domain.OfferAction, amount float64) (*domain.Offer, error) {
+	uc.logger.Info("OfferUsecase.RespondToOffer: responding to offer", "offer_id", offerID, "user_id", userID, "action", action)
+
+	offer, err := uc.offerRepo.FindByID(ctx, offerID)
+	if err != nil {
+		return nil, err
+	}
+	if userID != offer.SellerID && userID != offer.BuyerID {
+		uc.logger.Warn("OfferUsecase.RespondToOffer: forbidden to respond to offer",
+			"offer_id", offerID, "seller_id", offer.SellerID, "buyer_id", offer.BuyerID, "user_id_performing_action", userID)
+		return nil, ErrForbidden
+	}
+	if !offer.IsOpen() {
+		return nil, domain.ErrOfferClosed
+	}
+	if offer.Responder() != userID {
+		return nil, domain.ErrNotYourTurn
+	}
+
+	var remaining int64
+	reserved := false
+	switch action {
+	case domain.OfferActionAccept:
+		listing, err := uc.listingRepo.FindByID(ctx, offer.ListingID)
+		if err != nil {
+			if errors.Is(err, domain.ErrListingNotFound) {
+				return nil, ErrListingNotFound
+			}
+			uc.logger.Error("OfferUsecase.RespondToOffer: failed to find listing", "listing_id", offer.ListingID, "error", err.Error())
+			return nil, err
+		}
+		// Проверка статуса дает понятную ошибку; от гонок защищает условное списание в ReserveStock
+		if listing.Status != domain.StatusActive {
+			return nil, fmt.Errorf("%w: listing is %s", domain.ErrListingNotNegotiable, listing.Status)
+		}
+		remaining, err = uc.reserveOfferedQuantity(ctx, offer)
+		if err != nil {
+			return nil, err
+		}
+		reserved = true
+		offer.AgreedPrice = offer.ProposedPrice()
+		offer.Status = domain.OfferStatusAccepted
+	case domain.OfferActionReject:
+		offer.Status = domain.OfferStatusRejected
+	case domain.OfferActionCounter:
+		if amount <= 0 {
+			return nil, fmt.Errorf("%w: counter amount must be greater than 0", domain.ErrInvalidOffer)
+		}
+		if userID == offer.SellerID {
+			offer.CounterAmount = amount
+		} else {
+			offer.Amount = amount
+		}
+		offer.Status = domain.OfferStatusCountered
+	default:
+		return nil, fmt.Errorf("%w: unknown action %q", domain.ErrInvalidOffer, action)
+	}
+	offer.LastActor = userID
+
+	if err := uc.offerRepo.Update(ctx, offer); err != nil {
+		uc.logger.Error("OfferUsecase.RespondToOffer: failed to update offer", "offer_id", offerID, "error", err.Error())
+		if reserved {
+			uc.releaseOfferedQuantity(ctx, offer)
+		}
+		return nil, err
+	}
+	if reserved && remaining == 0 {
+		uc.rejectOtherOffers(ctx, offer)
+	}
+	return offer, nil
+}
+
+// offerQuantity - сколько единиц товара покрывает одно предложение; MakeOffer количество не принимает.
+const offerQuantity = 1
+
+// offerReservationKey - ключ резерва остатка под принятое предложение, в том же пространстве
+// ключей, что и номера заказов в ReserveStock.
+func offerReservationKey(offerID string) string {
+	return "offer:" + offerID
+}
+
+// reserveOfferedQuantity атомарно списывает offerQuantity единиц активного объявления под
+// предложение и возвращает остаток. Если объявление уже не активно или товар закончился
+// (например, параллельно приняли другое предложение), возвращает domain.ErrOutOfStock.
+func (uc *OfferUsecase) reserveOfferedQuantity(ctx context.Context, offer *domain.Offer) (int64, error) {
+	remaining, err := uc.listingRepo.ReserveStock(ctx, offer.ListingID, offerReservationKey(offer.ID), offerQuantity)
+	if err != nil {
+		if errors.Is(err, domain.ErrListingNotFound) {
+			return 0, ErrListingNotFound
+		}
+		if !errors.Is(err, domain.ErrOutOfStock) {
+			uc.logger.Error("OfferUsecase.RespondToOffer: failed to reserve stock", "listing_id", offer.ListingID, "offer_id", offer.ID, "error", err.Error())
+		}
+		return 0, err
+	}
+	if err := uc.cache.DeleteListing(ctx, offer.ListingID); err != nil {
+		uc.logger.Warn("OfferUsecase.RespondToOffer: failed to drop listing from cache", "listing_id", offer.ListingID, "error", err.Error())
+	}
+	return remaining, nil
+}
+
+// releaseOfferedQuantity возвращает резерв, если принять предложение не удалось; ошибки только логируются.
+func (uc *OfferUsecase) releaseOfferedQuantity(ctx context.Context, offer *domain.Offer) {
+	if _, err := uc.listingRepo.ReleaseStock(ctx, offer.ListingID, offerReservationKey(offer.ID)); err != nil {
+		uc.logger.Error("OfferUsecase.RespondToOffer: failed to release reserved stock", "listing_id", offer.ListingID, "offer_id", offer.ID, "error", err.Error())
+		return
+	}
+	if err := uc.cache.DeleteListing(ctx, offer.ListingID); err != nil {
+		uc.logger.Warn("OfferUsecase.RespondToOffer: failed to drop listing from cache", "listing_id", offer.ListingID, "error", err.Error())
+	}
+}
+
+// rejectOtherOffers закрывает остальные открытые предложения по объявлению принятого offer.
+func (uc *OfferUsecase) rejectOtherOffers(ctx context.Context, accepted *domain.Offer) {
+	offers, err := uc.offerRepo.FindByFilter(ctx, domain.OfferFilter{ListingID: accepted.ListingID})
+	if err != nil {
+		uc.logger.Error("OfferUsecase.RespondToOffer: failed to fetch other offers", "listing_id", accepted.ListingID, "error", err.Error())
+		return
+	}
+	for _, o := range offers {
+		if o.ID == accepted.ID || !o.IsOpen() {
+			continue
+		}
+		o.Status = domain.OfferStatusRejected
+		o.LastActor = accepted.SellerID
+		if err := uc.offerRepo.Update(ctx, o); err != nil {
+			uc.logger.Error("OfferUsecase.RespondToOffer: failed to reject other offer", "offer_id", o.ID, "error", err.Error())
+		}
+	}
+}
+
+// ListOffers returns the offers visible to requesterID. The seller of listingID sees every
+// offer on it, anyone else only their own; an empty listingID lists the requester's offers.
+func (uc *OfferUsecase) ListOffers(ctx context.Context, requesterID, listingID string) ([]*domain.Offer, error) {
+	filter := domain.OfferFilter{ListingID: listingID, BuyerID: requesterID}
+	if listingID != "" {
+		listing, err := uc.listingRepo.FindByID(ctx, listingID)
+		if err != nil {
+			if errors.Is(err, domain.ErrListingNotFound) {
+				return nil, ErrListingNotFound
+			}
+			return nil, err
+		}
+		if listing.UserID == requesterID {
+			filter.BuyerID = ""
+		}
+	}
+
+	offers, err := uc.offerRepo.FindByFilter(ctx, filter)
+	if err != nil {
+		uc.logger.Error("OfferUsecase.ListOffers: failed to fetch offers", "listing_id", listingID, "user_id", requesterID, "error", err.Error())
+		return nil, err
+	}
+	return offers, nil
+}
@@ -0,0 +1,212 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Abdurahmanit/GroupProject/listing-service/internal/listing/domain"
+	"github.com/Abdurahmanit/GroupProject/listing-service/internal/platform/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeOfferRepo struct {
+	domain.OfferRepository
+	offers map[string]*domain.Offer
+}
+
+func (r *fakeOfferRepo) FindByID(_ context.Context, id string) (*domain.Offer, error) {
+	o, ok := r.offers[id]
+	if !ok {
+		return nil, domain.ErrOfferNotFound
+	}
+	copied := *o
+	return &copied, nil
+}
+
+func (r *fakeOfferRepo) Update(_ context.Context, offer *domain.Offer) error {
+	copied := *offer
+	r.offers[offer.ID] = &copied
+	return nil
+}
+
+func (r *fakeOfferRepo) FindByFilter(_ context.Context, filter domain.OfferFilter) ([]*domain.Offer, error) {
+	var result []*domain.Offer
+	for _, o := range r.offers {
+		if o.ListingID == filter.ListingID {
+			copied := *o
+			result = append(result, &copied)
+		}
+	}
+	return result, nil
+}
+
+type fakeOfferListingRepo struct {
+	domain.ListingRepository
+	listings     map[string]*domain.Listing
+	reservations map[string]int64 // по ключу резерва
+}
+
+// ReserveStock повторяет условное списание Mongo: только у активного объявления и только при достаточном остатке.
+func (r *fakeOfferListingRepo) ReserveStock(_ context.Context, listingID, key string, quantity int64) (int64, error) {
+	l, ok := r.listings[listingID]
+	if !ok {
+		return 0, domain.ErrListingNotFound
+	}
+	if _, done := r.reservations[key]; done {
+		return l.Quantity, nil
+	}
+	if l.Status != domain.StatusActive || l.Quantity < quantity {
+		return 0, domain.ErrOutOfStock
+	}
+	l.Quantity -= quantity
+	if r.reservations == nil {
+		r.reservations = map[string]int64{}
+	}
+	r.reservations[key] = quantity
+	return l.Quantity, nil
+}
+
+func (r *fakeOfferListingRepo) ReleaseStock(_ context.Context, listingID, key string) (int64, error) {
+	quantity := r.reservations[key]
+	delete(r.reservations, key)
+	r.listings[listingID].Quantity += quantity
+	return quantity, nil
+}
+
+func (r *fakeOfferListingRepo) FindByID(_ context.Context, id string) (*domain.Listing, error) {
+	l, ok := r.listings[id]
+	if !ok {
+		return nil, domain.ErrListingNotFound
+	}
+	copied := *l
+	return &copied, nil
+}
+
+func (r *fakeOfferListingRepo) Update(_ context.Context, listing *domain.Listing) error {
+	copied := *listing
+	r.listings[listing.ID] = &copied
+	return nil
+}
+
+func newOfferTestUsecase(offers ...*domain.Offer) (*OfferUsecase, *fakeOfferRepo, *fakeOfferListingRepo) {
+	offerRepo := &fakeOfferRepo{offers: map[string]*domain.Offer{}}
+	for _, o := range offers {
+		offerRepo.offers[o.ID] = o
+	}
+	listingRepo := &fakeOfferListingRepo{listings: map[string]*domain.Listing{
+		"listing-1": {ID: "listing-1", UserID: "seller", Negotiable: true, Status: domain.StatusActive, Quantity: 1},
+	}}
+	return NewOfferUsecase(offerRepo, listingRepo, noopListingCache{}, logger.NewLogger()), offerRepo, listingRepo
+}
+
+func pendingOffer(id, buyerID string, amount float64) *domain.Offer {
+	return &domain.Offer{
+		ID:        id,
+		ListingID: "listing-1",
+		BuyerID:   buyerID,
+		SellerID:  "seller",
+		Amount:    amount,
+		Status:    domain.OfferStatusPending,
+		LastActor: buyerID,
+	}
+}
+
+func TestOfferUsecase_RespondToOffer_TurnsAlternate(t *testing.T) {
+	uc, _, _ := newOfferTestUsecase(pendingOffer("offer-1", "buyer", 80))
+	ctx := context.Background()
+
+	_, err := uc.RespondToOffer(ctx, "offer-1", "buyer", domain.OfferActionAccept, 0)
+	assert.ErrorIs(t, err, domain.ErrNotYourTurn, "buyer cannot accept their own amount")
+
+	offer, err := uc.RespondToOffer(ctx, "offer-1", "seller", domain.OfferActionCounter, 95)
+	require.NoError(t, err)
+	assert.Equal(t, domain.OfferStatusCountered, offer.Status)
+	assert.Equal(t, 95.0, offer.CounterAmount)
+
+	_, err = uc.RespondToOffer(ctx, "offer-1", "seller", domain.OfferActionAccept, 0)
+	assert.ErrorIs(t, err, domain.ErrNotYourTurn, "seller cannot accept their own counter")
+
+	offer, err = uc.RespondToOffer(ctx, "offer-1", "buyer", domain.OfferActionCounter, 90)
+	require.NoError(t, err)
+	assert.Equal(t, 90.0, offer.Amount)
+	assert.Equal(t, "buyer", offer.LastActor)
+
+	offer, err = uc.RespondToOffer(ctx, "offer-1", "seller", domain.OfferActionAccept, 0)
+	require.NoError(t, err)
+	assert.Equal(t, domain.OfferStatusAccepted, offer.Status)
+	assert.Equal(t, 90.0, offer.AgreedPrice)
+}
+
+func TestOfferUsecase_RespondToOffer_BuyerAcceptsCounter(t *testing.T) {
+	offer := pendingOffer("offer-1", "buyer", 80)
+	offer.CounterAmount = 95
+	offer.Status = domain.OfferStatusCountered
+	offer.LastActor = "" // stored before LastActor existed
+	uc, _, _ := newOfferTestUsecase(offer)
+
+	got, err := uc.RespondToOffer(context.Background(), "offer-1", "buyer", domain.OfferActionAccept, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 95.0, got.AgreedPrice)
+}
+
+func TestOfferUsecase_RespondToOffer_AcceptTakesLastUnitAndRejectsOthers(t *testing.T) {
+	accepted := pendingOffer("offer-1", "buyer-1", 80)
+	other := pendingOffer("offer-2", "buyer-2", 70)
+	closed := pendingOffer("offer-3", "buyer-3", 60)
+	closed.Status = domain.OfferStatusRejected
+	uc, offers, listings := newOfferTestUsecase(accepted, other, closed)
+
+	_, err := uc.RespondToOffer(context.Background(), "offer-1", "seller", domain.OfferActionAccept, 0)
+	require.NoError(t, err)
+
+	assert.Equal(t, domain.StatusActive, listings.listings["listing-1"].Status)
+	assert.Zero(t, listings.listings["listing-1"].Quantity)
+	assert.Equal(t, domain.OfferStatusAccepted, offers.offers["offer-1"].Status)
+	assert.Equal(t, domain.OfferStatusRejected, offers.offers["offer-2"].Status)
+	assert.Equal(t, domain.OfferStatusRejected, offers.offers["offer-3"].Status)
+
+	_, err = uc.RespondToOffer(context.Background(), "offer-2", "seller", domain.OfferActionAccept, 0)
+	assert.ErrorIs(t, err, domain.ErrOfferClosed)
+}
+
+func TestOfferUsecase_RespondToOffer_AcceptReservesOnlyOfferedQuantity(t *testing.T) {
+	uc, offers, listings := newOfferTestUsecase(pendingOffer("offer-1", "buyer-1", 80), pendingOffer("offer-2", "buyer-2", 70))
+	listings.listings["listing-1"].Quantity = 3
+
+	_, err := uc.RespondToOffer(context.Background(), "offer-1", "seller", domain.OfferActionAccept, 0)
+	require.NoError(t, err)
+
+	assert.EqualValues(t, 2, listings.listings["listing-1"].Quantity)
+	assert.Equal(t, domain.OfferStatusPending, offers.offers["offer-2"].Status, "units are left, so other offers stay open")
+
+	_, err = uc.RespondToOffer(context.Background(), "offer-2", "seller", domain.OfferActionAccept, 0)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, listings.listings["listing-1"].Quantity)
+}
+
+func TestOfferUsecase_RespondToOffer_AcceptWhenSoldOut(t *testing.T) {
+	// Another offer took the last unit after this accept read the listing as active.
+	uc, offers, listings := newOfferTestUsecase(pendingOffer("offer-1", "buyer", 80))
+	listings.listings["listing-1"].Quantity = 0
+
+	_, err := uc.RespondToOffer(context.Background(), "offer-1", "seller", domain.OfferActionAccept, 0)
+	assert.ErrorIs(t, err, domain.ErrOutOfStock)
+	assert.Equal(t, domain.OfferStatusPending, offers.offers["offer-1"].Status)
+}
+
+func TestOfferUsecase_RespondToOffer_AcceptOnReservedListing(t *testing.T) {
+	uc, offers, listings := newOfferTestUsecase(pendingOffer("offer-1", "buyer", 80))
+	listings.listings["listing-1"].Status = domain.StatusReserved
+
+	_, err := uc.RespondToOffer(context.Background(), "offer-1", "seller", domain.OfferActionAccept, 0)
+	assert.ErrorIs(t, err, domain.ErrListingNotNegotiable)
+	assert.Equal(t, domain.OfferStatusPending, offers.offers["offer-1"].Status)
+}
+
+func TestOfferUsecase_RespondToOffer_Forbidden(t *testing.T) {
+	uc, _, _ := newOfferTestUsecase(pendingOffer("offer-1", "buyer", 80))
+
+	_, err := uc.RespondToOffer(context.Background(), "offer-1", "stranger", domain.OfferActionReject, 0)
+	assert.ErrorIs(t, err, ErrForbidden)
+}
@@ -3,23 +3,48 @@ package usecase
 import (
 	"context"
 	"errors" // Для кастомных ошибок
+	"fmt"
+	"net/http"
+	"strings"
 	"time"
 
 	"github.com/Abdurahmanit/GroupProject/listing-service/internal/listing/domain"
 	"github.com/Abdurahmanit/GroupProject/listing-service/internal/platform/logger" // <--- ДОБАВИТЬ ИМПОРТ ЛОГГЕРА
 )
 
+// allowedPhotoTypes - типы, определяемые http.DetectContentType, которые принимаются как фото
+var allowedPhotoTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/webp": true,
+}
+
+// PhotoLimits ограничивает загрузку фото. Нулевые значения отключают соответствующую проверку.
+type PhotoLimits struct {
+	MaxBytes      int // Максимальный размер одного файла
+	MaxPerListing int // Максимальное количество фото в объявлении
+	// MinPerActiveListing - сколько фото должно остаться у активного объявления после удаления
+	// (то же, что PublishRules.MinPhotos); черновики не проверяются
+	MinPerActiveListing int
+	// UploadURLExpiry - срок действия presigned URL для прямой загрузки в хранилище
+	UploadURLExpiry time.Duration
+}
+
 type PhotoUsecase struct {
 	storage domain.Storage // Интерфейс Storage остается
 	repo    domain.ListingRepository
+	cache   domain.ListingCache
+	limits  PhotoLimits
 	logger  *logger.Logger // <--- ДОБАВЛЕНО
 }
 
 
-func NewPhotoUsecase(storage domain.Storage, repo domain.ListingRepository, log *logger.Logger) *PhotoUsecase { // <--- ДОБАВЛЕН ЛОГГЕР
+func NewPhotoUsecase(storage domain.Storage, repo domain.ListingRepository, cache domain.ListingCache, limits PhotoLimits, log *logger.Logger) *PhotoUsecase { // <--- ДОБАВЛЕН ЛОГГЕР
 	return &PhotoUsecase{
 		storage: storage,
 		repo:    repo,
+		cache:   cache,
+		limits:  limits,
 		logger:  log, // <--- СОХРАНЕН
 	}
 }
@@ -29,6 +54,11 @@ func (uc *PhotoUsecase) UploadPhoto(ctx context.Context, listingID, userID, file
 	uc.logger.Info("PhotoUsecase.UploadPhoto: uploading photo",
 		"listing_id", listingID, "user_id_performing_action", userID, "filename", fileName)
 
+	if err := uc.validatePhoto(data); err != nil {
+		uc.logger.Warn("PhotoUsecase.UploadPhoto: rejected photo", "listing_id", listingID, "filename", fileName, "error", err.Error())
+		return "", err
+	}
+
 	listing, err := uc.repo.FindByID(ctx, listingID)
 	if err != nil {
 		uc.logger.Error("PhotoUsecase.UploadPhoto: failed to find listing", "listing_id", listingID, "error", err.Error())
@@ -37,7 +67,7 @@ func (uc *PhotoUsecase) UploadPhoto(ctx context.Context, listingID, userID, file
 		}
 		return "", err
 	}
-    if listing == nil {
+    if listing == nil || listing.IsDeleted() {
 		uc.logger.Warn("PhotoUsecase.UploadPhoto: listing not found by ID", "listing_id", listingID)
 		return "", ErrListingNotFound
 	}
@@ -49,6 +79,12 @@ func (uc *PhotoUsecase) UploadPhoto(ctx context.Context, listingID, userID, file
 		return "", ErrForbidden // Используем ошибку usecase-уровня
 	}
 
+	if uc.limits.MaxPerListing > 0 && len(listing.Photos) >= uc.limits.MaxPerListing {
+		uc.logger.Warn("PhotoUsecase.UploadPhoto: photo limit reached",
+			"listing_id", listingID, "photos", len(listing.Photos), "max_photos", uc.limits.MaxPerListing)
+		return "", fmt.Errorf("%w: at most %d photos allowed; delete one before uploading another", domain.ErrTooManyPhotos, uc.limits.MaxPerListing)
+	}
+
 	url, err := uc.storage.Upload(ctx, fileName, data) // fileName должен быть уникальным или генерироваться хранилищем
 	if err != nil {
 		uc.logger.Error("PhotoUsecase.UploadPhoto: storage upload failed", "listing_id", listingID, "filename", fileName, "error", err.Error())
@@ -69,4 +105,215 @@ func (uc *PhotoUsecase) UploadPhoto(ctx context.Context, listingID, userID, file
 		return "", err
 	}
 	return url, nil
-}
\ No newline at end of file
+}
+
+// validatePhoto проверяет размер и реальный (по содержимому, а не по имени файла) тип изображения.
+func (uc *PhotoUsecase) validatePhoto(data []byte) error {
+	return uc.validatePhotoHead(int64(len(data)), data)
+}
+
+// validatePhotoHead - то же для файла размером size, от которого известны только первые байты head.
+func (uc *PhotoUsecase) validatePhotoHead(size int64, head []byte) error {
+	if size == 0 {
+		return fmt.Errorf("%w: file is empty", domain.ErrInvalidPhoto)
+	}
+	if uc.limits.MaxBytes > 0 && size > int64(uc.limits.MaxBytes) {
+		return fmt.Errorf("%w: file is %d bytes, at most %d allowed", domain.ErrInvalidPhoto, size, uc.limits.MaxBytes)
+	}
+	if contentType := http.DetectContentType(head); !allowedPhotoTypes[contentType] {
+		return fmt.Errorf("%w: unsupported content type %s, expected jpeg, png or webp", domain.ErrInvalidPhoto, contentType)
+	}
+	return nil
+}
+
+// ownedListing returns the listing if userID owns it, ErrListingNotFound or ErrForbidden otherwise.
+// Deleted listings are reported as not found.
+func (uc *PhotoUsecase) ownedListing(ctx context.Context, listingID, userID string) (*domain.Listing, error) {
+	listing, err := uc.repo.FindByID(ctx, listingID)
+	if err != nil {
+		if errors.Is(err, domain.ErrListingNotFound) {
+			return nil, ErrListingNotFound
+		}
+		uc.logger.Error("PhotoUsecase: failed to find listing", "listing_id", listingID, "error", err.Error())
+		return nil, err
+	}
+	if listing.IsDeleted() {
+		return nil, ErrListingNotFound
+	}
+	if listing.UserID != userID {
+		uc.logger.Warn("PhotoUsecase: forbidden to change photos",
+			"listing_id", listingID, "listing_owner_id", listing.UserID, "user_id_performing_action", userID)
+		return nil, ErrForbidden
+	}
+	return listing, nil
+}
+
+func (uc *PhotoUsecase) checkPhotoLimit(listing *domain.Listing) error {
+	if uc.limits.MaxPerListing > 0 && len(listing.Photos) >= uc.limits.MaxPerListing {
+		return fmt.Errorf("%w: at most %d photos allowed; delete one before uploading another", domain.ErrTooManyPhotos, uc.limits.MaxPerListing)
+	}
+	return nil
+}
+
+// photoKeyPrefix - префикс ключей объектов, загружаемых напрямую для объявления; по нему
+// ConfirmPhotoUpload проверяет, что файл загружен по URL, выданному для этого объявления.
+func photoKeyPrefix(listingID string) string {
+	return listingID + "/"
+}
+
+// GeneratePhotoUploadURL returns a presigned PUT URL the owner can upload a photo to directly,
+// and the URL to pass to ConfirmPhotoUpload once the upload has finished.
+func (uc *PhotoUsecase) GeneratePhotoUploadURL(ctx context.Context, listingID, userID, fileName string) (uploadURL, finalURL string, err error) {
+	uc.logger.Info("PhotoUsecase.GeneratePhotoUploadURL: issuing upload URL",
+		"listing_id", listingID, "user_id_performing_action", userID, "filename", fileName)
+
+	listing, err := uc.ownedListing(ctx, listingID, userID)
+	if err != nil {
+		return "", "", err
+	}
+	if err := uc.checkPhotoLimit(listing); err != nil {
+		return "", "", err
+	}
+
+	uploadURL, finalURL, err = uc.storage.PresignUpload(ctx, photoKeyPrefix(listingID), fileName, uc.limits.UploadURLExpiry)
+	if err != nil {
+		uc.logger.Error("PhotoUsecase.GeneratePhotoUploadURL: presign failed", "listing_id", listingID, "error", err.Error())
+		return "", "", err
+	}
+	return uploadURL, finalURL, nil
+}
+
+// ConfirmPhotoUpload attaches a photo uploaded through GeneratePhotoUploadURL to the listing.
+// The object is validated like UploadPhoto does; an invalid file is deleted from storage.
+// Confirming an already attached photo is a no-op.
+func (uc *PhotoUsecase) ConfirmPhotoUpload(ctx context.Context, listingID, userID, finalURL string) error {
+	uc.logger.Info("PhotoUsecase.ConfirmPhotoUpload: attaching uploaded photo",
+		"listing_id", listingID, "user_id_performing_action", userID, "photo_url", finalURL)
+
+	listing, err := uc.ownedListing(ctx, listingID, userID)
+	if err != nil {
+		return err
+	}
+	for _, p := range listing.Photos {
+		if p == finalURL {
+			return nil
+		}
+	}
+	if err := uc.checkPhotoLimit(listing); err != nil {
+		return err
+	}
+
+	obj, err := uc.storage.Stat(ctx, finalURL)
+	if err != nil {
+		return err
+	}
+	if !strings.HasPrefix(obj.Key, "photos/"+photoKeyPrefix(listingID)) {
+		return fmt.Errorf("%w: URL was not issued for this listing", domain.ErrPhotoNotUploaded)
+	}
+	if err := uc.validatePhotoHead(obj.Size, obj.Head); err != nil {
+		uc.logger.Warn("PhotoUsecase.ConfirmPhotoUpload: rejected photo", "listing_id", listingID, "photo_url", finalURL, "error", err.Error())
+		if errDelete := uc.storage.Delete(ctx, finalURL); errDelete != nil {
+			uc.logger.Error("PhotoUsecase.ConfirmPhotoUpload: failed to delete rejected photo", "photo_url", finalURL, "error", errDelete.Error())
+		}
+		return err
+	}
+
+	listing.Photos = append(listing.Photos, finalURL)
+	listing.UpdatedAt = time.Now()
+	if err := uc.repo.Update(ctx, listing); err != nil {
+		uc.logger.Error("PhotoUsecase.ConfirmPhotoUpload: failed to update listing", "listing_id", listingID, "error", err.Error())
+		return err
+	}
+	if err := uc.cache.DeleteListing(ctx, listingID); err != nil {
+		uc.logger.Warn("PhotoUsecase.ConfirmPhotoUpload: failed to invalidate listing cache", "listing_id", listingID, "error", err.Error())
+	}
+	return nil
+}
+
+// DeletePhoto удаляет фото из объявления владельца: сначала из списка Photos, затем из хранилища,
+// чтобы при ошибке обновления в объявлении не осталось ссылки на удаленный объект.
+// Возвращает domain.ErrPhotoNotFound, если photoURL не принадлежит объявлению, и
+// domain.ErrNotEnoughPhotos, если у активного объявления останется меньше MinPerActiveListing фото.
+func (uc *PhotoUsecase) DeletePhoto(ctx context.Context, listingID, userID, photoURL string) error {
+	uc.logger.Info("PhotoUsecase.DeletePhoto: deleting photo",
+		"listing_id", listingID, "user_id_performing_action", userID, "photo_url", photoURL)
+
+	listing, err := uc.ownedListing(ctx, listingID, userID)
+	if err != nil {
+		return err
+	}
+
+	idx := -1
+	for i, p := range listing.Photos {
+		if p == photoURL {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		uc.logger.Warn("PhotoUsecase.DeletePhoto: photo is not part of listing", "listing_id", listingID, "photo_url", photoURL)
+		return domain.ErrPhotoNotFound
+	}
+
+	if listing.Status == domain.StatusActive && len(listing.Photos)-1 < uc.limits.MinPerActiveListing {
+		uc.logger.Warn("PhotoUsecase.DeletePhoto: active listing would drop below the photo minimum",
+			"listing_id", listingID, "photos", len(listing.Photos), "required_photos", uc.limits.MinPerActiveListing)
+		return fmt.Errorf("%w: an active listing needs at least %d photo(s); add another photo first or move the listing to draft",
+			domain.ErrNotEnoughPhotos, uc.limits.MinPerActiveListing)
+	}
+
+	listing.Photos = append(listing.Photos[:idx], listing.Photos[idx+1:]...)
+	listing.UpdatedAt = time.Now()
+	if err := uc.repo.Update(ctx, listing); err != nil {
+		uc.logger.Error("PhotoUsecase.DeletePhoto: failed to update listing", "listing_id", listingID, "error", err.Error())
+		return err
+	}
+
+	if err := uc.cache.DeleteListing(ctx, listingID); err != nil {
+		uc.logger.Warn("PhotoUsecase.DeletePhoto: failed to invalidate listing cache", "listing_id", listingID, "error", err.Error())
+	}
+
+	// Фото уже убрано из объявления; объект, который не удалось удалить, лишь занимает место в хранилище
+	if err := uc.storage.Delete(ctx, photoURL); err != nil {
+		uc.logger.Warn("PhotoUsecase.DeletePhoto: storage delete failed, object left orphaned", "listing_id", listingID, "photo_url", photoURL, "error", err.Error())
+	}
+	return nil
+}
+
+// ReorderPhotos задает новый порядок фото; первое фото становится обложкой.
+// orderedURLs должен содержать ровно те же URL, что и объявление, иначе domain.ErrInvalidPhotoOrder.
+func (uc *PhotoUsecase) ReorderPhotos(ctx context.Context, listingID, userID string, orderedURLs []string) error {
+	uc.logger.Info("PhotoUsecase.ReorderPhotos: reordering photos",
+		"listing_id", listingID, "user_id_performing_action", userID, "photos", len(orderedURLs))
+
+	listing, err := uc.ownedListing(ctx, listingID, userID)
+	if err != nil {
+		return err
+	}
+
+	if len(orderedURLs) != len(listing.Photos) {
+		return fmt.Errorf("%w: got %d URLs, listing has %d photos", domain.ErrInvalidPhotoOrder, len(orderedURLs), len(listing.Photos))
+	}
+	remaining := make(map[string]int, len(listing.Photos))
+	for _, p := range listing.Photos {
+		remaining[p]++
+	}
+	for _, u := range orderedURLs {
+		if remaining[u] == 0 {
+			return fmt.Errorf("%w: unexpected or repeated URL %s", domain.ErrInvalidPhotoOrder, u)
+		}
+		remaining[u]--
+	}
+
+	listing.Photos = append([]string(nil), orderedURLs...)
+	listing.UpdatedAt = time.Now()
+	if err := uc.repo.Update(ctx, listing); err != nil {
+		uc.logger.Error("PhotoUsecase.ReorderPhotos: failed to update listing", "listing_id", listingID, "error", err.Error())
+		return err
+	}
+
+	if err := uc.cache.DeleteListing(ctx, listingID); err != nil {
+		uc.logger.Warn("PhotoUsecase.ReorderPhotos: failed to invalidate listing cache", "listing_id", listingID, "error", err.Error())
+	}
+	return nil
+}
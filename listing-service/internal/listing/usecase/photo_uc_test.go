@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Abdurahmanit/GroupProject/listing-service/internal/listing/domain"
+	"github.com/Abdurahmanit/GroupProject/listing-service/internal/platform/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakePhotoStorage struct {
+	domain.Storage
+	deleteErr error
+	deleted   []string
+}
+
+func (s *fakePhotoStorage) Delete(_ context.Context, fileURL string) error {
+	s.deleted = append(s.deleted, fileURL)
+	return s.deleteErr
+}
+
+type failingUpdateListingRepo struct {
+	*fakeOfferListingRepo
+}
+
+func (failingUpdateListingRepo) Update(context.Context, *domain.Listing) error {
+	return errors.New("mongo unavailable")
+}
+
+func newPhotoTestListings(status domain.ListingStatus, photos ...string) *fakeOfferListingRepo {
+	return &fakeOfferListingRepo{listings: map[string]*domain.Listing{
+		"listing-1": {ID: "listing-1", UserID: "seller", Status: status, Photos: photos},
+	}}
+}
+
+func TestPhotoUsecase_DeletePhoto_UpdatesListingThenStorage(t *testing.T) {
+	listings := newPhotoTestListings(domain.StatusActive, "a.jpg", "b.jpg")
+	storage := &fakePhotoStorage{deleteErr: errors.New("s3 unavailable")}
+	uc := NewPhotoUsecase(storage, listings, noopListingCache{}, PhotoLimits{MinPerActiveListing: 1}, logger.NewLogger())
+
+	err := uc.DeletePhoto(context.Background(), "listing-1", "seller", "a.jpg")
+
+	require.NoError(t, err, "a failed storage delete only orphans the object")
+	assert.Equal(t, []string{"b.jpg"}, listings.listings["listing-1"].Photos)
+	assert.Equal(t, []string{"a.jpg"}, storage.deleted)
+}
+
+func TestPhotoUsecase_DeletePhoto_FailedUpdateKeepsObject(t *testing.T) {
+	listings := failingUpdateListingRepo{newPhotoTestListings(domain.StatusActive, "a.jpg", "b.jpg")}
+	storage := &fakePhotoStorage{}
+	uc := NewPhotoUsecase(storage, listings, noopListingCache{}, PhotoLimits{}, logger.NewLogger())
+
+	err := uc.DeletePhoto(context.Background(), "listing-1", "seller", "a.jpg")
+
+	require.Error(t, err)
+	assert.Empty(t, storage.deleted)
+}
+
+func TestPhotoUsecase_DeletePhoto_MinPhotos(t *testing.T) {
+	active := newPhotoTestListings(domain.StatusActive, "a.jpg")
+	storage := &fakePhotoStorage{}
+	uc := NewPhotoUsecase(storage, active, noopListingCache{}, PhotoLimits{MinPerActiveListing: 1}, logger.NewLogger())
+
+	err := uc.DeletePhoto(context.Background(), "listing-1", "seller", "a.jpg")
+	assert.ErrorIs(t, err, domain.ErrNotEnoughPhotos)
+	assert.Equal(t, []string{"a.jpg"}, active.listings["listing-1"].Photos)
+	assert.Empty(t, storage.deleted)
+
+	draft := newPhotoTestListings(domain.StatusDraft, "a.jpg")
+	uc = NewPhotoUsecase(storage, draft, noopListingCache{}, PhotoLimits{MinPerActiveListing: 1}, logger.NewLogger())
+
+	require.NoError(t, uc.DeletePhoto(context.Background(), "listing-1", "seller", "a.jpg"))
+	assert.Empty(t, draft.listings["listing-1"].Photos)
+}
+
+func TestPhotoUsecase_DeletedListingIsNotFound(t *testing.T) {
+	listings := newPhotoTestListings(domain.StatusDeleted, "a.jpg", "b.jpg")
+	storage := &fakePhotoStorage{}
+	uc := NewPhotoUsecase(storage, listings, noopListingCache{}, PhotoLimits{}, logger.NewLogger())
+	ctx := context.Background()
+	jpeg := []byte{0xFF, 0xD8, 0xFF, 0xE0, 0, 0x10, 'J', 'F', 'I', 'F', 0}
+
+	_, err := uc.UploadPhoto(ctx, "listing-1", "seller", "c.jpg", jpeg)
+	assert.ErrorIs(t, err, ErrListingNotFound, "UploadPhoto")
+	_, _, err = uc.GeneratePhotoUploadURL(ctx, "listing-1", "seller", "c.jpg")
+	assert.ErrorIs(t, err, ErrListingNotFound, "GeneratePhotoUploadURL")
+	assert.ErrorIs(t, uc.ConfirmPhotoUpload(ctx, "listing-1", "seller", "c.jpg"), ErrListingNotFound, "ConfirmPhotoUpload")
+	assert.ErrorIs(t, uc.DeletePhoto(ctx, "listing-1", "seller", "a.jpg"), ErrListingNotFound, "DeletePhoto")
+	assert.ErrorIs(t, uc.ReorderPhotos(ctx, "listing-1", "seller", []string{"b.jpg", "a.jpg"}), ErrListingNotFound, "ReorderPhotos")
+
+	assert.Equal(t, []string{"a.jpg", "b.jpg"}, listings.listings["listing-1"].Photos)
+	assert.Empty(t, storage.deleted)
+}
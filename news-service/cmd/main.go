@@ -2,11 +2,14 @@ package main
 
 import (
 	"context"
+	"errors"
 	"log"
+	"net/http"
 	"os"
 	"os/signal"
 	"strconv"
 	"syscall"
+	"time"
 
 	redisAdapter "github.com/Abdurahmanit/GroupProject/news-service/internal/adapter/cache/redis"
 	emailAdapter "github.com/Abdurahmanit/GroupProject/news-service/internal/adapter/email"
@@ -14,8 +17,14 @@ import (
 	mongoAdapter "github.com/Abdurahmanit/GroupProject/news-service/internal/adapter/mongo"
 	natsAdapter "github.com/Abdurahmanit/GroupProject/news-service/internal/adapter/nats"
 	"github.com/Abdurahmanit/GroupProject/news-service/internal/config"
+	"github.com/Abdurahmanit/GroupProject/news-service/internal/feed"
 	grpcPort "github.com/Abdurahmanit/GroupProject/news-service/internal/port/grpc"
 	"github.com/Abdurahmanit/GroupProject/news-service/internal/usecase"
+	newspb "github.com/Abdurahmanit/GroupProject/news-service/proto"
+	"github.com/Abdurahmanit/GroupProject/platform/health"
+	"github.com/Abdurahmanit/GroupProject/platform/logkv"
+	"github.com/Abdurahmanit/GroupProject/platform/natsdedup"
+	"github.com/Abdurahmanit/GroupProject/platform/selftest"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 )
@@ -134,6 +143,16 @@ func main() {
 		}
 	}()
 
+	if cfg.StartupSelfTest {
+		selfTest := selftest.NewRunner(logkv.FromZap(logger))
+		selfTest.Add("mongodb", selftest.MongoRoundTrip(mongoClient.Database(cfg.Mongo.Database)))
+		selfTest.Add("redis", selftest.RedisRoundTrip(redisClient))
+		selfTest.Add("nats", func(ctx context.Context) error { return natsPublisher.PublishProbe(ctx, "news.selftest") })
+		if err := selfTest.Run(context.Background()); err != nil {
+			logger.Fatal("Startup self-test failed", zap.Error(err))
+		}
+	}
+
 	userServiceClient, err := grpcClientAdapter.NewUserServiceGRPCClient(cfg.UserServiceAddress, logger)
 	if err != nil {
 		logger.Fatal("Failed to create User Service client", zap.Error(err))
@@ -147,30 +166,69 @@ func main() {
 	newsRepo := mongoAdapter.NewNewsMongoRepository(mongoClient, cfg.Mongo.Database)
 	commentRepo := mongoAdapter.NewCommentMongoRepository(mongoClient, cfg.Mongo.Database)
 	likeRepo := mongoAdapter.NewLikeMongoRepository(mongoClient, cfg.Mongo.Database)
+	announcementRepo := mongoAdapter.NewAnnouncementMongoRepository(mongoClient, cfg.Mongo.Database)
+	subscriberRepo := mongoAdapter.NewSubscriberMongoRepository(mongoClient, cfg.Mongo.Database)
 
 	cacheRepo := redisAdapter.NewRedisCacheRepository(redisClient, logger)
+	viewCounter := redisAdapter.NewViewCounter(redisClient, cfg.Views.BucketSize, cfg.Views.Retention, logger)
 	emailSender := emailAdapter.NewSMTPSender(&cfg.SMTP, logger)
 
 	logger.Info("Repositories (DB & Cache), Email Sender and UserServiceClient initialized")
 
-	newsUC := usecase.NewNewsUseCase(
-		mongoClient,
-		newsRepo,
-		commentRepo,
-		likeRepo,
-		natsPublisher,
-		cacheRepo,
-		emailSender,
-		userServiceClient,
-		logger,
-	)
-	commentUC := usecase.NewCommentUseCase(commentRepo, newsRepo)
+	newsUC := usecase.NewNewsUseCase(newsRepo, usecase.NewsUseCaseDeps{
+		MongoClient:       mongoClient,
+		CommentRepo:       commentRepo,
+		LikeRepo:          likeRepo,
+		NATSPublisher:     natsPublisher,
+		CacheRepo:         cacheRepo,
+		EmailSender:       emailSender,
+		UserServiceClient: userServiceClient,
+		ViewCounter:       viewCounter,
+	}, logger)
+	commentUC := usecase.NewCommentUseCase(commentRepo, newsRepo, cfg.Comments.OnAuthorDeleted == config.AuthorDeletedActionAnonymize, cfg.Comments.MaxReplyDepth, userServiceClient, natsPublisher, logger)
 	likeUC := usecase.NewLikeUseCase(likeRepo, newsRepo, commentRepo)
+	announcementUC := usecase.NewAnnouncementUseCase(announcementRepo, cacheRepo, userServiceClient, logger)
+	newsletterUC := usecase.NewNewsletterUseCase(subscriberRepo, newsRepo, emailSender, cfg.Newsletter.UnsubscribeURL, logger)
 
 	logger.Info("Use cases initialized")
 
-	newsGRPCHandler := grpcPort.NewNewsHandler(newsUC, commentUC, likeUC)
-	grpcServer := grpcPort.NewServer(&cfg.GRPC, logger, newsGRPCHandler)
+	var natsDedup *natsdedup.Deduplicator
+	if cfg.NATS.DedupEnabled {
+		natsDedup = natsdedup.New(natsdedup.NewRedisStore(redisClient), cfg.NATS.DedupTTL, logkv.FromZap(logger))
+	}
+	natsSubscriber, err := natsAdapter.NewNATSSubscriber(&cfg.NATS, natsDedup, logger)
+	if err != nil {
+		logger.Fatal("Failed to create NATS subscriber", zap.Error(err))
+	}
+	defer natsSubscriber.Close()
+	if err := natsSubscriber.SubscribeUserDeleted(commentUC); err != nil {
+		logger.Fatal("Failed to subscribe to user deletion events", zap.Error(err))
+	}
+	logger.Info("NATS subscriptions initialized",
+		zap.String("comments_on_author_deleted", cfg.Comments.OnAuthorDeleted),
+		zap.Bool("dedup_enabled", cfg.NATS.DedupEnabled),
+		zap.Duration("dedup_ttl", cfg.NATS.DedupTTL),
+	)
+
+	newsGRPCHandler := grpcPort.NewNewsHandler(newsUC, commentUC, likeUC, announcementUC, newsletterUC)
+	healthManager := health.NewManager(logkv.FromZap(logger), newspb.NewsService_ServiceDesc.ServiceName)
+	healthManager.AddCheck("mongodb", func(ctx context.Context) error { return mongoClient.Ping(ctx, nil) })
+	healthManager.AddCheck("redis", func(ctx context.Context) error { return redisClient.Ping(ctx).Err() })
+	healthManager.AddCheck("nats", natsPublisher.Ping)
+	healthCtx, stopHealthChecks := context.WithCancel(context.Background())
+	defer stopHealthChecks()
+	go healthManager.Run(healthCtx, health.DefaultInterval)
+
+	schedulerCtx, stopScheduler := context.WithCancel(context.Background())
+	defer stopScheduler()
+	go newsUC.RunScheduledPublisher(schedulerCtx, cfg.Scheduling.PollInterval)
+	logger.Info("Scheduled news publisher started", zap.Duration("poll_interval", cfg.Scheduling.PollInterval))
+	go newsUC.RunViewCountFlusher(schedulerCtx, cfg.Views.FlushInterval)
+	logger.Info("View count flusher started", zap.Duration("flush_interval", cfg.Views.FlushInterval))
+	go newsletterUC.RunDigest(schedulerCtx, cfg.Newsletter.DigestInterval)
+	logger.Info("News digest scheduled", zap.Duration("digest_interval", cfg.Newsletter.DigestInterval))
+
+	grpcServer := grpcPort.NewServer(&cfg.GRPC, logger, newsGRPCHandler, healthManager)
 
 	logger.Info("Starting gRPC server...", zap.String("port", cfg.GRPC.Port))
 	go func() {
@@ -179,12 +237,40 @@ func main() {
 		}
 	}()
 
+	feedGenerator := feed.NewGenerator(newsUC, feed.Channel{
+		Title:       cfg.Feed.Title,
+		Link:        cfg.Feed.Link,
+		Description: cfg.Feed.Description,
+	}, logger)
+	feedMux := http.NewServeMux()
+	feedMux.Handle("/rss", feedGenerator)
+	feedServer := &http.Server{
+		Addr:              ":" + cfg.Feed.HTTPPort,
+		Handler:           feedMux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+	logger.Info("Starting RSS feed HTTP server...", zap.String("port", cfg.Feed.HTTPPort))
+	go func() {
+		if err := feedServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Fatal("RSS feed HTTP server failed to run", zap.Error(err))
+		}
+	}()
+
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 
 	sig := <-quit
 	logger.Info("Received shutdown signal", zap.String("signal", sig.String()))
 
+	stopScheduler()
+	stopHealthChecks()
+	shutdownCtx, cancelShutdown := context.WithTimeout(context.Background(), 5*time.Second)
+	if err := feedServer.Shutdown(shutdownCtx); err != nil {
+		logger.Error("Failed to shut down RSS feed HTTP server", zap.Error(err))
+	}
+	cancelShutdown()
+	healthManager.Shutdown()
+
 	logger.Info("Shutting down gRPC server (will stop on its own after listener closes or by OS signal)...")
 
 	logger.Info("News Service shut down gracefully.")
@@ -3,6 +3,7 @@ module github.com/Abdurahmanit/GroupProject/news-service
 go 1.24.2
 
 require (
+	github.com/Abdurahmanit/GroupProject/platform v0.0.0
 	github.com/nats-io/nats.go v1.42.0
 	github.com/redis/go-redis/v9 v9.9.0
 	github.com/spf13/viper v1.20.1
@@ -37,6 +38,8 @@ require (
 	github.com/xdg-go/scram v1.1.2 // indirect
 	github.com/xdg-go/stringprep v1.0.4 // indirect
 	github.com/youmark/pkcs8 v0.0.0-20240726163527-a2c0da244d78 // indirect
+	go.opentelemetry.io/otel v1.36.0 // indirect
+	go.opentelemetry.io/otel/trace v1.36.0 // indirect
 	go.uber.org/multierr v1.10.0 // indirect
 	golang.org/x/crypto v0.37.0 // indirect
 	golang.org/x/net v0.35.0 // indirect
@@ -46,3 +49,5 @@ require (
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20250218202821-56aae31c358a // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
+
+replace github.com/Abdurahmanit/GroupProject/platform => ../platform
@@ -0,0 +1,120 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Abdurahmanit/GroupProject/news-service/internal/port/cache"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+const (
+	pendingViewsKey       = "news:views:pending"
+	viewBucketKeyPrefix   = "news:views:bucket:"
+	trendingScratchKeyFmt = "news:views:trending:%d"
+)
+
+// ViewCounter keeps pending view increments in a Redis hash and the views of the recent past in
+// one sorted set per time bucket. Trending articles for a window are the union of the buckets it
+// covers, so the window is rounded up to whole buckets and cannot exceed the retention.
+type ViewCounter struct {
+	client    *redis.Client
+	bucket    time.Duration
+	retention time.Duration
+	logger    *zap.Logger
+}
+
+func NewViewCounter(client *redis.Client, bucket, retention time.Duration, logger *zap.Logger) cache.ViewCounter {
+	return &ViewCounter{
+		client:    client,
+		bucket:    bucket,
+		retention: retention,
+		logger:    logger,
+	}
+}
+
+func (v *ViewCounter) bucketKey(t time.Time) string {
+	return viewBucketKeyPrefix + strconv.FormatInt(t.Truncate(v.bucket).Unix(), 10)
+}
+
+func (v *ViewCounter) RecordView(ctx context.Context, newsID string, at time.Time) error {
+	bucketKey := v.bucketKey(at)
+	pipe := v.client.TxPipeline()
+	pipe.HIncrBy(ctx, pendingViewsKey, newsID, 1)
+	pipe.ZIncrBy(ctx, bucketKey, 1, newsID)
+	pipe.Expire(ctx, bucketKey, v.retention+v.bucket)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("ViewCounter.RecordView for news '%s': %w", newsID, err)
+	}
+	return nil
+}
+
+func (v *ViewCounter) DrainPending(ctx context.Context) (map[string]int64, error) {
+	// Renaming first makes the drain atomic: views recorded meanwhile go to a fresh hash.
+	drainKey := fmt.Sprintf("%s:draining:%d", pendingViewsKey, time.Now().UnixNano())
+	if err := v.client.Rename(ctx, pendingViewsKey, drainKey).Err(); err != nil {
+		if isNoSuchKey(err) {
+			return map[string]int64{}, nil
+		}
+		return nil, fmt.Errorf("ViewCounter.DrainPending: %w", err)
+	}
+
+	raw, err := v.client.HGetAll(ctx, drainKey).Result()
+	if err != nil {
+		return nil, fmt.Errorf("ViewCounter.DrainPending: read drained views: %w", err)
+	}
+	if err := v.client.Del(ctx, drainKey).Err(); err != nil {
+		v.logger.Warn("Failed to delete drained view counts", zap.String("key", drainKey), zap.Error(err))
+	}
+
+	counts := make(map[string]int64, len(raw))
+	for newsID, value := range raw {
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			v.logger.Warn("Skipping malformed pending view count", zap.String("news_id", newsID), zap.String("value", value))
+			continue
+		}
+		counts[newsID] = n
+	}
+	return counts, nil
+}
+
+func (v *ViewCounter) RestorePending(ctx context.Context, counts map[string]int64) error {
+	pipe := v.client.TxPipeline()
+	for newsID, n := range counts {
+		pipe.HIncrBy(ctx, pendingViewsKey, newsID, n)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("ViewCounter.RestorePending: %w", err)
+	}
+	return nil
+}
+
+func (v *ViewCounter) TopViewed(ctx context.Context, window time.Duration, now time.Time, limit int) ([]string, error) {
+	if window > v.retention {
+		window = v.retention
+	}
+	var keys []string
+	for t := now.Add(-window).Truncate(v.bucket); !t.After(now); t = t.Add(v.bucket) {
+		keys = append(keys, v.bucketKey(t))
+	}
+
+	scratchKey := fmt.Sprintf(trendingScratchKeyFmt, now.UnixNano())
+	pipe := v.client.TxPipeline()
+	pipe.ZUnionStore(ctx, scratchKey, &redis.ZStore{Keys: keys})
+	top := pipe.ZRevRange(ctx, scratchKey, 0, int64(limit-1))
+	pipe.Del(ctx, scratchKey)
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return nil, fmt.Errorf("ViewCounter.TopViewed: %w", err)
+	}
+	return top.Val(), nil
+}
+
+func isNoSuchKey(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "no such key")
+}
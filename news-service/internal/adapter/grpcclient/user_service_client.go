@@ -15,6 +15,7 @@ import (
 
 type UserServiceClient interface {
 	GetAuthorEmail(ctx context.Context, authorID string) (string, error)
+	GetUserRole(ctx context.Context, userID string) (string, error)
 	Close() error
 }
 
@@ -94,6 +95,19 @@ func (c *userServiceGRPCClient) GetAuthorEmail(ctx context.Context, authorID str
 	return userEmail, nil
 }
 
+// GetUserRole returns the role stored in the user's profile (e.g. "user" or "admin").
+func (c *userServiceGRPCClient) GetUserRole(ctx context.Context, userID string) (string, error) {
+	callCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := c.client.GetProfile(callCtx, &usergrpc.GetProfileRequest{UserId: userID})
+	if err != nil {
+		c.logger.Error("User Service GetProfile RPC failed while resolving role", zap.String("user_id", userID), zap.Error(err))
+		return "", fmt.Errorf("user service GetProfile failed for user %s: %w", userID, err)
+	}
+	return resp.GetRole(), nil
+}
+
 func (c *userServiceGRPCClient) Close() error {
 	if c.conn != nil {
 		c.logger.Info("Closing User Service gRPC client connection")
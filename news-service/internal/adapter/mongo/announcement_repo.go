@@ -0,0 +1,148 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Abdurahmanit/GroupProject/news-service/internal/entity"
+	"github.com/Abdurahmanit/GroupProject/news-service/internal/port/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const announcementsCollectionName = "announcements"
+
+type AnnouncementMongoRepository struct {
+	db *mongo.Database
+}
+
+func NewAnnouncementMongoRepository(client *mongo.Client, dbName string) repository.AnnouncementRepository {
+	return &AnnouncementMongoRepository{
+		db: client.Database(dbName),
+	}
+}
+
+type announcementDocument struct {
+	ID        primitive.ObjectID  `bson:"_id,omitempty"`
+	Type      string              `bson:"type"`
+	Message   string              `bson:"message"`
+	Active    bool                `bson:"active"`
+	StartsAt  primitive.DateTime  `bson:"starts_at"`
+	EndsAt    *primitive.DateTime `bson:"ends_at,omitempty"`
+	CreatedBy string              `bson:"created_by"`
+	CreatedAt primitive.DateTime  `bson:"created_at"`
+	UpdatedAt primitive.DateTime  `bson:"updated_at"`
+}
+
+func toAnnouncementDocument(a *entity.Announcement) *announcementDocument {
+	doc := &announcementDocument{
+		Type:      a.Type,
+		Message:   a.Message,
+		Active:    a.Active,
+		StartsAt:  primitive.NewDateTimeFromTime(a.StartsAt),
+		CreatedBy: a.CreatedBy,
+		CreatedAt: primitive.NewDateTimeFromTime(a.CreatedAt),
+		UpdatedAt: primitive.NewDateTimeFromTime(a.UpdatedAt),
+	}
+	if !a.EndsAt.IsZero() {
+		endsAt := primitive.NewDateTimeFromTime(a.EndsAt)
+		doc.EndsAt = &endsAt
+	}
+	return doc
+}
+
+func toAnnouncementEntity(doc *announcementDocument) *entity.Announcement {
+	a := &entity.Announcement{
+		ID:        doc.ID.Hex(),
+		Type:      doc.Type,
+		Message:   doc.Message,
+		Active:    doc.Active,
+		StartsAt:  doc.StartsAt.Time(),
+		CreatedBy: doc.CreatedBy,
+		CreatedAt: doc.CreatedAt.Time(),
+		UpdatedAt: doc.UpdatedAt.Time(),
+	}
+	if doc.EndsAt != nil {
+		a.EndsAt = doc.EndsAt.Time()
+	}
+	return a
+}
+
+func (r *AnnouncementMongoRepository) Create(ctx context.Context, announcement *entity.Announcement) (string, error) {
+	res, err := r.db.Collection(announcementsCollectionName).InsertOne(ctx, toAnnouncementDocument(announcement))
+	if err != nil {
+		return "", fmt.Errorf("failed to insert announcement into mongo: %w", err)
+	}
+	oid, ok := res.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return "", fmt.Errorf("failed to convert inserted ID to ObjectID")
+	}
+	return oid.Hex(), nil
+}
+
+func (r *AnnouncementMongoRepository) GetByID(ctx context.Context, id string) (*entity.Announcement, error) {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, repository.ErrNotFound
+	}
+	var doc announcementDocument
+	err = r.db.Collection(announcementsCollectionName).FindOne(ctx, bson.M{"_id": objID}).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, repository.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get announcement from mongo: %w", err)
+	}
+	return toAnnouncementEntity(&doc), nil
+}
+
+func (r *AnnouncementMongoRepository) SetActive(ctx context.Context, id string, active bool) error {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return repository.ErrNotFound
+	}
+	update := bson.M{"$set": bson.M{
+		"active":     active,
+		"updated_at": primitive.NewDateTimeFromTime(time.Now()),
+	}}
+	res, err := r.db.Collection(announcementsCollectionName).UpdateOne(ctx, bson.M{"_id": objID}, update)
+	if err != nil {
+		return fmt.Errorf("failed to update announcement in mongo: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return repository.ErrNotFound
+	}
+	return nil
+}
+
+func (r *AnnouncementMongoRepository) ListLive(ctx context.Context, now time.Time) ([]*entity.Announcement, error) {
+	nowDT := primitive.NewDateTimeFromTime(now)
+	filter := bson.M{
+		"active":    true,
+		"starts_at": bson.M{"$lte": nowDT},
+		"$or": bson.A{
+			bson.M{"ends_at": bson.M{"$exists": false}},
+			bson.M{"ends_at": bson.M{"$gt": nowDT}},
+		},
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "starts_at", Value: -1}})
+	cursor, err := r.db.Collection(announcementsCollectionName).Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list announcements from mongo: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var docs []*announcementDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("failed to decode announcements: %w", err)
+	}
+	announcements := make([]*entity.Announcement, 0, len(docs))
+	for _, doc := range docs {
+		announcements = append(announcements, toAnnouncementEntity(doc))
+	}
+	return announcements, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/Abdurahmanit/GroupProject/news-service/internal/entity"
 	"github.com/Abdurahmanit/GroupProject/news-service/internal/port/repository"
@@ -26,22 +27,43 @@ func NewCommentMongoRepository(client *mongo.Client, dbName string) repository.C
 }
 
 type commentDocument struct {
-	ID        primitive.ObjectID `bson:"_id,omitempty"`
-	NewsID    string             `bson:"news_id"`
-	UserID    string             `bson:"user_id"`
-	Content   string             `bson:"content"`
-	CreatedAt primitive.DateTime `bson:"created_at"`
-	UpdatedAt primitive.DateTime `bson:"updated_at"`
+	ID        primitive.ObjectID  `bson:"_id,omitempty"`
+	NewsID    string              `bson:"news_id"`
+	ParentID  string              `bson:"parent_id,omitempty"`
+	Depth     int                 `bson:"depth,omitempty"`
+	UserID    string              `bson:"user_id"`
+	Content   string              `bson:"content"`
+	CreatedAt primitive.DateTime  `bson:"created_at"`
+	UpdatedAt primitive.DateTime  `bson:"updated_at"`
+	EditedAt  *primitive.DateTime `bson:"edited_at,omitempty"`
+	RemovedAt *primitive.DateTime `bson:"removed_at,omitempty"`
+	DeletedAt *primitive.DateTime `bson:"deleted_at,omitempty"`
 }
 
 func toCommentDocument(c *entity.Comment) (*commentDocument, error) {
 	doc := &commentDocument{
 		NewsID:    c.NewsID,
+		Depth:     c.Depth,
 		UserID:    c.UserID,
 		Content:   c.Content,
 		CreatedAt: primitive.NewDateTimeFromTime(c.CreatedAt),
 		UpdatedAt: primitive.NewDateTimeFromTime(c.UpdatedAt),
 	}
+	if c.ParentID != nil {
+		doc.ParentID = *c.ParentID
+	}
+	if c.EditedAt != nil {
+		editedAt := primitive.NewDateTimeFromTime(*c.EditedAt)
+		doc.EditedAt = &editedAt
+	}
+	if c.RemovedAt != nil {
+		removedAt := primitive.NewDateTimeFromTime(*c.RemovedAt)
+		doc.RemovedAt = &removedAt
+	}
+	if c.DeletedAt != nil {
+		deletedAt := primitive.NewDateTimeFromTime(*c.DeletedAt)
+		doc.DeletedAt = &deletedAt
+	}
 	if c.ID != "" {
 		objID, err := primitive.ObjectIDFromHex(c.ID)
 		if err != nil {
@@ -53,16 +75,37 @@ func toCommentDocument(c *entity.Comment) (*commentDocument, error) {
 }
 
 func toCommentEntity(doc *commentDocument) *entity.Comment {
-	return &entity.Comment{
+	c := &entity.Comment{
 		ID:        doc.ID.Hex(),
 		NewsID:    doc.NewsID,
+		Depth:     doc.Depth,
 		UserID:    doc.UserID,
 		Content:   doc.Content,
 		CreatedAt: doc.CreatedAt.Time(),
 		UpdatedAt: doc.UpdatedAt.Time(),
 	}
+	if doc.ParentID != "" {
+		parentID := doc.ParentID
+		c.ParentID = &parentID
+	}
+	if doc.EditedAt != nil {
+		editedAt := doc.EditedAt.Time()
+		c.EditedAt = &editedAt
+	}
+	if doc.RemovedAt != nil {
+		removedAt := doc.RemovedAt.Time()
+		c.RemovedAt = &removedAt
+	}
+	if doc.DeletedAt != nil {
+		deletedAt := doc.DeletedAt.Time()
+		c.DeletedAt = &deletedAt
+	}
+	return c
 }
 
+// notDeletedFilter matches comments that have not been soft-deleted.
+var notDeletedFilter = bson.M{"$exists": false}
+
 func (r *CommentMongoRepository) Create(ctx context.Context, comment *entity.Comment) (string, error) {
 	doc, err := toCommentDocument(comment)
 	if err != nil {
@@ -88,7 +131,7 @@ func (r *CommentMongoRepository) GetByID(ctx context.Context, id string) (*entit
 	}
 
 	var doc commentDocument
-	err = r.db.Collection(commentCollectionName).FindOne(ctx, bson.M{"_id": objID}).Decode(&doc)
+	err = r.db.Collection(commentCollectionName).FindOne(ctx, bson.M{"_id": objID, "deleted_at": notDeletedFilter}).Decode(&doc)
 	if err != nil {
 		if errors.Is(err, mongo.ErrNoDocuments) {
 			return nil, repository.ErrNotFound
@@ -99,6 +142,25 @@ func (r *CommentMongoRepository) GetByID(ctx context.Context, id string) (*entit
 }
 
 func (r *CommentMongoRepository) GetByNewsID(ctx context.Context, newsID string, page, pageSize int) ([]*entity.Comment, int, error) {
+	mongoFilter := bson.M{"news_id": newsID, "parent_id": bson.M{"$exists": false}, "deleted_at": notDeletedFilter}
+	comments, total, err := r.list(ctx, mongoFilter, page, pageSize)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list comments by news_id from mongo: %w", err)
+	}
+	return comments, total, nil
+}
+
+func (r *CommentMongoRepository) GetReplies(ctx context.Context, parentID string, page, pageSize int) ([]*entity.Comment, int, error) {
+	mongoFilter := bson.M{"parent_id": parentID, "deleted_at": notDeletedFilter}
+	comments, total, err := r.list(ctx, mongoFilter, page, pageSize)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list comment replies from mongo: %w", err)
+	}
+	return comments, total, nil
+}
+
+// list returns one page of the comments matching mongoFilter, oldest first, and their total count.
+func (r *CommentMongoRepository) list(ctx context.Context, mongoFilter bson.M, page, pageSize int) ([]*entity.Comment, int, error) {
 	skip := int64((page - 1) * pageSize)
 	limit := int64(pageSize)
 
@@ -107,11 +169,9 @@ func (r *CommentMongoRepository) GetByNewsID(ctx context.Context, newsID string,
 	findOptions.SetLimit(limit)
 	findOptions.SetSort(bson.D{{"created_at", 1}})
 
-	mongoFilter := bson.M{"news_id": newsID}
-
 	cursor, err := r.db.Collection(commentCollectionName).Find(ctx, mongoFilter, findOptions)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to list comments by news_id from mongo: %w", err)
+		return nil, 0, err
 	}
 	defer cursor.Close(ctx)
 
@@ -142,14 +202,19 @@ func (r *CommentMongoRepository) Update(ctx context.Context, comment *entity.Com
 		return fmt.Errorf("comment ID is required for update")
 	}
 
-	updateFields := bson.M{
-		"$set": bson.M{
-			"content":    doc.Content,
-			"updated_at": doc.UpdatedAt,
-		},
+	setFields := bson.M{
+		"content":    doc.Content,
+		"updated_at": doc.UpdatedAt,
 	}
+	if doc.EditedAt != nil {
+		setFields["edited_at"] = *doc.EditedAt
+	}
+	if doc.RemovedAt != nil {
+		setFields["removed_at"] = *doc.RemovedAt
+	}
+	updateFields := bson.M{"$set": setFields}
 
-	res, err := r.db.Collection(commentCollectionName).UpdateOne(ctx, bson.M{"_id": doc.ID}, updateFields)
+	res, err := r.db.Collection(commentCollectionName).UpdateOne(ctx, bson.M{"_id": doc.ID, "deleted_at": notDeletedFilter}, updateFields)
 	if err != nil {
 		return fmt.Errorf("failed to update comment in mongo: %w", err)
 	}
@@ -187,3 +252,51 @@ func (r *CommentMongoRepository) DeleteByNewsID(ctx context.Context, newsID stri
 	}
 	return res.DeletedCount, nil
 }
+
+func (r *CommentMongoRepository) DeleteByAuthorID(ctx context.Context, userID string) (map[string]int64, error) {
+	collection := r.db.Collection(commentCollectionName)
+	// Comments already removed by DeleteComment were taken off comment_count back then, so
+	// only live comments are counted here.
+	liveFilter := bson.M{"user_id": userID, "deleted_at": notDeletedFilter, "removed_at": bson.M{"$exists": false}}
+
+	newsIDs, err := collection.Distinct(ctx, "news_id", liveFilter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list news ids for comments by user_id from mongo: %w", err)
+	}
+
+	now := primitive.NewDateTimeFromTime(time.Now())
+	update := bson.M{"$set": bson.M{"deleted_at": now, "updated_at": now}}
+	deletedPerNews := make(map[string]int64, len(newsIDs))
+	for _, rawNewsID := range newsIDs {
+		newsID, ok := rawNewsID.(string)
+		if !ok {
+			continue
+		}
+		// Only comments that are still live are matched, so re-running the deletion
+		// for the same user reports zero and keeps news counters consistent.
+		filter := bson.M{"user_id": userID, "news_id": newsID, "deleted_at": notDeletedFilter, "removed_at": bson.M{"$exists": false}}
+		res, err := collection.UpdateMany(ctx, filter, update)
+		if err != nil {
+			return deletedPerNews, fmt.Errorf("failed to soft-delete comments by user_id from mongo: %w", err)
+		}
+		if res.ModifiedCount > 0 {
+			deletedPerNews[newsID] = res.ModifiedCount
+		}
+	}
+
+	removedFilter := bson.M{"user_id": userID, "deleted_at": notDeletedFilter, "removed_at": bson.M{"$exists": true}}
+	if _, err := collection.UpdateMany(ctx, removedFilter, update); err != nil {
+		return deletedPerNews, fmt.Errorf("failed to soft-delete removed comments by user_id from mongo: %w", err)
+	}
+	return deletedPerNews, nil
+}
+
+func (r *CommentMongoRepository) AnonymizeByAuthorID(ctx context.Context, userID string) (int64, error) {
+	filter := bson.M{"user_id": userID}
+	update := bson.M{"$set": bson.M{"user_id": entity.DeletedAuthorID}}
+	res, err := r.db.Collection(commentCollectionName).UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, fmt.Errorf("failed to anonymize comments by user_id in mongo: %w", err)
+	}
+	return res.ModifiedCount, nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/Abdurahmanit/GroupProject/news-service/internal/config"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.mongodb.org/mongo-driver/mongo/readpref"
@@ -53,6 +54,11 @@ func NewMongoDBConnection(cfg *config.MongoConfig) (*mongo.Client, error) {
 		return nil, fmt.Errorf("failed to setup mongo indexes: %w", err)
 	}
 
+	if err := backfillCommentCounts(ctx, db); err != nil {
+		client.Disconnect(ctx)
+		return nil, fmt.Errorf("failed to backfill comment counts: %w", err)
+	}
+
 	return client, nil
 }
 
@@ -67,10 +73,23 @@ func setupMongoIndexes(ctx context.Context, db *mongo.Database) error {
 			Keys:    bson.D{{Key: "created_at", Value: -1}},
 			Options: options.Index().SetName("created_at_desc_idx"),
 		},
+		{
+			Keys:    bson.D{{Key: "category", Value: 1}, {Key: "created_at", Value: -1}},
+			Options: options.Index().SetName("category_created_at_desc_idx"),
+		},
 		{
 			Keys:    bson.D{{Key: "author_id", Value: 1}},
 			Options: options.Index().SetName("author_id_idx"),
 		},
+		{
+			Keys:    bson.D{{Key: "tags", Value: 1}, {Key: "created_at", Value: -1}},
+			Options: options.Index().SetName("tags_created_at_desc_idx"),
+		},
+		{
+			Keys: bson.D{{Key: "title", Value: "text"}, {Key: "content", Value: "text"}},
+			Options: options.Index().SetName("title_content_text_idx").
+				SetWeights(bson.D{{Key: "title", Value: 3}, {Key: "content", Value: 1}}),
+		},
 	}
 	_, err := newsCollection.Indexes().CreateMany(ctx, newsIndexes)
 	if err != nil {
@@ -87,6 +106,10 @@ func setupMongoIndexes(ctx context.Context, db *mongo.Database) error {
 			Keys:    bson.D{{Key: "created_at", Value: 1}},
 			Options: options.Index().SetName("comments_created_at_asc_idx"),
 		},
+		{
+			Keys:    bson.D{{Key: "parent_id", Value: 1}, {Key: "created_at", Value: 1}},
+			Options: options.Index().SetName("comments_parent_id_created_at_asc_idx"),
+		},
 	}
 	_, err = commentsCollection.Indexes().CreateMany(ctx, commentsIndexes)
 	if err != nil {
@@ -116,5 +139,82 @@ func setupMongoIndexes(ctx context.Context, db *mongo.Database) error {
 		return fmt.Errorf("failed to create indexes for likes collection: %w", err)
 	}
 
+	subscribersCollection := db.Collection("subscribers")
+	subscribersIndexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "email", Value: 1}},
+			Options: options.Index().SetName("subscribers_email_unique_idx").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "unsubscribe_token", Value: 1}},
+			Options: options.Index().SetName("subscribers_unsubscribe_token_unique_idx").SetUnique(true),
+		},
+	}
+	_, err = subscribersCollection.Indexes().CreateMany(ctx, subscribersIndexes)
+	if err != nil {
+		return fmt.Errorf("failed to create indexes for subscribers collection: %w", err)
+	}
+
+	return nil
+}
+
+// backfillCommentCounts sets comment_count on articles stored before the counter existed,
+// counting their comments that are neither removed nor deleted. Articles that already have
+// the field are skipped, so after the first run this is a single empty query.
+func backfillCommentCounts(ctx context.Context, db *mongo.Database) error {
+	newsCollection := db.Collection("news")
+	missing := bson.M{"comment_count": bson.M{"$exists": false}}
+
+	cursor, err := newsCollection.Find(ctx, missing, options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		return fmt.Errorf("failed to find news without comment_count: %w", err)
+	}
+	var docs []struct {
+		ID primitive.ObjectID `bson:"_id"`
+	}
+	if err := cursor.All(ctx, &docs); err != nil {
+		return fmt.Errorf("failed to decode news without comment_count: %w", err)
+	}
+	if len(docs) == 0 {
+		return nil
+	}
+
+	newsIDs := make([]string, len(docs))
+	for i, doc := range docs {
+		newsIDs[i] = doc.ID.Hex()
+	}
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"news_id":    bson.M{"$in": newsIDs},
+			"removed_at": bson.M{"$exists": false},
+			"deleted_at": bson.M{"$exists": false},
+		}}},
+		{{Key: "$group", Value: bson.M{"_id": "$news_id", "count": bson.M{"$sum": 1}}}},
+	}
+	countCursor, err := db.Collection("comments").Aggregate(ctx, pipeline)
+	if err != nil {
+		return fmt.Errorf("failed to count comments per news: %w", err)
+	}
+	var counts []struct {
+		NewsID string `bson:"_id"`
+		Count  int64  `bson:"count"`
+	}
+	if err := countCursor.All(ctx, &counts); err != nil {
+		return fmt.Errorf("failed to decode comment counts: %w", err)
+	}
+	countByNews := make(map[string]int64, len(counts))
+	for _, c := range counts {
+		countByNews[c.NewsID] = c.Count
+	}
+
+	models := make([]mongo.WriteModel, 0, len(docs))
+	for _, doc := range docs {
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"_id": doc.ID, "comment_count": bson.M{"$exists": false}}).
+			SetUpdate(bson.M{"$set": bson.M{"comment_count": countByNews[doc.ID.Hex()]}}))
+	}
+	if _, err := newsCollection.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false)); err != nil {
+		return fmt.Errorf("failed to set comment_count on news: %w", err)
+	}
 	return nil
 }
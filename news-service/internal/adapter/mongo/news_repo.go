@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/Abdurahmanit/GroupProject/news-service/internal/entity"
 	"github.com/Abdurahmanit/GroupProject/news-service/internal/port/repository"
@@ -26,25 +27,43 @@ func NewNewsMongoRepository(client *mongo.Client, dbName string) repository.News
 }
 
 type newsDocument struct {
-	ID        primitive.ObjectID `bson:"_id,omitempty"`
-	Title     string             `bson:"title"`
-	Content   string             `bson:"content"`
-	AuthorID  string             `bson:"author_id"`
-	ImageURL  string             `bson:"image_url,omitempty"`
-	Category  string             `bson:"category,omitempty"`
-	CreatedAt primitive.DateTime `bson:"created_at"`
-	UpdatedAt primitive.DateTime `bson:"updated_at"`
+	ID           primitive.ObjectID  `bson:"_id,omitempty"`
+	Title        string              `bson:"title"`
+	Content      string              `bson:"content"`
+	AuthorID     string              `bson:"author_id"`
+	ImageURL     string              `bson:"image_url,omitempty"`
+	Category     string              `bson:"category,omitempty"`
+	Tags         []string            `bson:"tags,omitempty"`
+	CommentCount int64               `bson:"comment_count"`
+	ViewCount    int64               `bson:"view_count"`
+	Status       string              `bson:"status,omitempty"`
+	PublishAt    *primitive.DateTime `bson:"publish_at,omitempty"`
+	PublishedAt  *primitive.DateTime `bson:"published_at,omitempty"`
+	CreatedAt    primitive.DateTime  `bson:"created_at"`
+	UpdatedAt    primitive.DateTime  `bson:"updated_at"`
 }
 
 func toNewsDocument(n *entity.News) (*newsDocument, error) {
 	doc := &newsDocument{
-		Title:     n.Title,
-		Content:   n.Content,
-		AuthorID:  n.AuthorID,
-		ImageURL:  n.ImageURL,
-		Category:  n.Category,
-		CreatedAt: primitive.NewDateTimeFromTime(n.CreatedAt),
-		UpdatedAt: primitive.NewDateTimeFromTime(n.UpdatedAt),
+		Title:        n.Title,
+		Content:      n.Content,
+		AuthorID:     n.AuthorID,
+		ImageURL:     n.ImageURL,
+		Category:     n.Category,
+		Tags:         n.Tags,
+		CommentCount: n.CommentCount,
+		ViewCount:    n.ViewCount,
+		Status:       string(n.Status),
+		CreatedAt:    primitive.NewDateTimeFromTime(n.CreatedAt),
+		UpdatedAt:    primitive.NewDateTimeFromTime(n.UpdatedAt),
+	}
+	if n.PublishAt != nil {
+		publishAt := primitive.NewDateTimeFromTime(*n.PublishAt)
+		doc.PublishAt = &publishAt
+	}
+	if n.PublishedAt != nil {
+		publishedAt := primitive.NewDateTimeFromTime(*n.PublishedAt)
+		doc.PublishedAt = &publishedAt
 	}
 	if n.ID != "" {
 		objID, err := primitive.ObjectIDFromHex(n.ID)
@@ -57,16 +76,29 @@ func toNewsDocument(n *entity.News) (*newsDocument, error) {
 }
 
 func toNewsEntity(doc *newsDocument) *entity.News {
-	return &entity.News{
-		ID:        doc.ID.Hex(),
-		Title:     doc.Title,
-		Content:   doc.Content,
-		AuthorID:  doc.AuthorID,
-		ImageURL:  doc.ImageURL,
-		Category:  doc.Category,
-		CreatedAt: doc.CreatedAt.Time(),
-		UpdatedAt: doc.UpdatedAt.Time(),
+	news := &entity.News{
+		ID:           doc.ID.Hex(),
+		Title:        doc.Title,
+		Content:      doc.Content,
+		AuthorID:     doc.AuthorID,
+		ImageURL:     doc.ImageURL,
+		Category:     doc.Category,
+		Tags:         doc.Tags,
+		CommentCount: doc.CommentCount,
+		ViewCount:    doc.ViewCount,
+		Status:       entity.NewsStatus(doc.Status),
+		CreatedAt:    doc.CreatedAt.Time(),
+		UpdatedAt:    doc.UpdatedAt.Time(),
+	}
+	if doc.PublishAt != nil {
+		publishAt := doc.PublishAt.Time()
+		news.PublishAt = &publishAt
 	}
+	if doc.PublishedAt != nil {
+		publishedAt := doc.PublishedAt.Time()
+		news.PublishedAt = &publishedAt
+	}
+	return news
 }
 
 func (r *NewsMongoRepository) Create(ctx context.Context, news *entity.News) (string, error) {
@@ -120,6 +152,7 @@ func (r *NewsMongoRepository) Update(ctx context.Context, news *entity.News) err
 			"author_id":  doc.AuthorID,
 			"image_url":  doc.ImageURL,
 			"category":   doc.Category,
+			"tags":       doc.Tags,
 			"updated_at": doc.UpdatedAt,
 		},
 	}
@@ -154,6 +187,47 @@ func (r *NewsMongoRepository) Delete(ctx context.Context, id string, sessionCont
 	return nil
 }
 
+func (r *NewsMongoRepository) IncrementCommentCount(ctx context.Context, id string, delta int64) error {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return repository.ErrNotFound
+	}
+
+	// The counter is clamped at zero so a decrement can't push a miscounted article negative.
+	update := mongo.Pipeline{{{Key: "$set", Value: bson.M{
+		"comment_count": bson.M{"$max": bson.A{0, bson.M{"$add": bson.A{bson.M{"$ifNull": bson.A{"$comment_count", 0}}, delta}}}},
+	}}}}
+	res, err := r.db.Collection(newsCollectionName).UpdateOne(ctx, bson.M{"_id": objID}, update)
+	if err != nil {
+		return fmt.Errorf("failed to increment comment count in mongo: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return repository.ErrNotFound
+	}
+	return nil
+}
+
+func (r *NewsMongoRepository) IncrementViewCounts(ctx context.Context, counts map[string]int64) error {
+	models := make([]mongo.WriteModel, 0, len(counts))
+	for id, delta := range counts {
+		objID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			continue
+		}
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"_id": objID}).
+			SetUpdate(bson.M{"$inc": bson.M{"view_count": delta}}))
+	}
+	if len(models) == 0 {
+		return nil
+	}
+
+	if _, err := r.db.Collection(newsCollectionName).BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false)); err != nil {
+		return fmt.Errorf("failed to increment view counts in mongo: %w", err)
+	}
+	return nil
+}
+
 func (r *NewsMongoRepository) List(ctx context.Context, page, pageSize int, filter map[string]interface{}) ([]*entity.News, int, error) {
 	skip := int64((page - 1) * pageSize)
 	limit := int64(pageSize)
@@ -202,3 +276,127 @@ func (r *NewsMongoRepository) List(ctx context.Context, page, pageSize int, filt
 
 	return newsEntities, int(totalCount), nil
 }
+
+func (r *NewsMongoRepository) GetAdjacent(ctx context.Context, news *entity.News, category string) (*entity.News, *entity.News, error) {
+	objID, err := primitive.ObjectIDFromHex(news.ID)
+	if err != nil {
+		return nil, nil, repository.ErrNotFound
+	}
+	createdAt := primitive.NewDateTimeFromTime(news.CreatedAt)
+
+	// Articles sharing a created_at are ordered by _id so navigation never skips or loops.
+	find := func(op string, sortDir int) (*entity.News, error) {
+		filter := bson.M{"$or": bson.A{
+			bson.M{"created_at": bson.M{op: createdAt}},
+			bson.M{"created_at": createdAt, "_id": bson.M{op: objID}},
+		}}
+		if category != "" {
+			filter["category"] = category
+		}
+		filter["status"] = bson.M{"$ne": entity.NewsStatusScheduled}
+		opts := options.FindOne().SetSort(bson.D{{Key: "created_at", Value: sortDir}, {Key: "_id", Value: sortDir}})
+
+		var doc newsDocument
+		if err := r.db.Collection(newsCollectionName).FindOne(ctx, filter, opts).Decode(&doc); err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		return toNewsEntity(&doc), nil
+	}
+
+	newer, err := find("$gt", 1)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get newer news from mongo: %w", err)
+	}
+	older, err := find("$lt", -1)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get older news from mongo: %w", err)
+	}
+	return newer, older, nil
+}
+
+func (r *NewsMongoRepository) Search(ctx context.Context, query, category string, page, pageSize int) ([]*entity.News, int, error) {
+	filter := bson.M{
+		"$text":  bson.M{"$search": query},
+		"status": bson.M{"$ne": entity.NewsStatusScheduled},
+	}
+	if category != "" {
+		filter["category"] = category
+	}
+
+	score := bson.M{"$meta": "textScore"}
+	findOptions := options.Find().
+		SetProjection(bson.M{"score": score}).
+		SetSort(bson.D{{Key: "score", Value: score}, {Key: "created_at", Value: -1}}).
+		SetSkip(int64((page - 1) * pageSize)).
+		SetLimit(int64(pageSize))
+
+	cursor, err := r.db.Collection(newsCollectionName).Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to search news in mongo: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var newsDocs []newsDocument
+	if err = cursor.All(ctx, &newsDocs); err != nil {
+		return nil, 0, fmt.Errorf("failed to decode news search results from mongo: %w", err)
+	}
+
+	newsEntities := make([]*entity.News, len(newsDocs))
+	for i, doc := range newsDocs {
+		newsEntities[i] = toNewsEntity(&doc)
+	}
+
+	totalCount, err := r.db.Collection(newsCollectionName).CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count news search results in mongo: %w", err)
+	}
+
+	return newsEntities, int(totalCount), nil
+}
+
+func (r *NewsMongoRepository) ListDueScheduled(ctx context.Context, now time.Time) ([]*entity.News, error) {
+	filter := bson.M{
+		"status":     entity.NewsStatusScheduled,
+		"publish_at": bson.M{"$lte": primitive.NewDateTimeFromTime(now)},
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "publish_at", Value: 1}})
+
+	cursor, err := r.db.Collection(newsCollectionName).Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list due scheduled news from mongo: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var newsDocs []newsDocument
+	if err = cursor.All(ctx, &newsDocs); err != nil {
+		return nil, fmt.Errorf("failed to decode due scheduled news from mongo: %w", err)
+	}
+	newsEntities := make([]*entity.News, len(newsDocs))
+	for i, doc := range newsDocs {
+		newsEntities[i] = toNewsEntity(&doc)
+	}
+	return newsEntities, nil
+}
+
+func (r *NewsMongoRepository) MarkPublished(ctx context.Context, id string, publishedAt time.Time) (bool, error) {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return false, repository.ErrNotFound
+	}
+
+	// Matching on the status makes the switch happen once even with several instances polling.
+	filter := bson.M{"_id": objID, "status": entity.NewsStatusScheduled}
+	update := bson.M{"$set": bson.M{
+		"status":       entity.NewsStatusPublished,
+		"published_at": primitive.NewDateTimeFromTime(publishedAt),
+		"updated_at":   primitive.NewDateTimeFromTime(publishedAt),
+	}}
+	res, err := r.db.Collection(newsCollectionName).UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, fmt.Errorf("failed to mark news as published in mongo: %w", err)
+	}
+	return res.ModifiedCount == 1, nil
+}
@@ -0,0 +1,204 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Abdurahmanit/GroupProject/news-service/internal/entity"
+	"github.com/Abdurahmanit/GroupProject/news-service/internal/port/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const subscribersCollectionName = "subscribers"
+
+type SubscriberMongoRepository struct {
+	db *mongo.Database
+}
+
+func NewSubscriberMongoRepository(client *mongo.Client, dbName string) repository.SubscriberRepository {
+	return &SubscriberMongoRepository{
+		db: client.Database(dbName),
+	}
+}
+
+type subscriberDocument struct {
+	ID                        primitive.ObjectID  `bson:"_id,omitempty"`
+	Email                     string              `bson:"email"`
+	Confirmed                 bool                `bson:"confirmed"`
+	VerificationCode          string              `bson:"verification_code,omitempty"`
+	VerificationCodeExpiresAt *primitive.DateTime `bson:"verification_code_expires_at,omitempty"`
+	VerificationAttempts      int                 `bson:"verification_attempts,omitempty"`
+	VerificationEmailsSent    int                 `bson:"verification_emails_sent,omitempty"`
+	VerificationCodeSentAt    *primitive.DateTime `bson:"verification_code_sent_at,omitempty"`
+	UnsubscribeToken          string              `bson:"unsubscribe_token"`
+	CreatedAt                 primitive.DateTime  `bson:"created_at"`
+	ConfirmedAt               *primitive.DateTime `bson:"confirmed_at,omitempty"`
+}
+
+func toSubscriberDocument(s *entity.Subscriber) *subscriberDocument {
+	doc := &subscriberDocument{
+		Email:                  s.Email,
+		Confirmed:              s.Confirmed,
+		VerificationCode:       s.VerificationCode,
+		VerificationAttempts:   s.VerificationAttempts,
+		VerificationEmailsSent: s.VerificationEmailsSent,
+		UnsubscribeToken:       s.UnsubscribeToken,
+		CreatedAt:              primitive.NewDateTimeFromTime(s.CreatedAt),
+	}
+	if s.VerificationCodeExpiresAt != nil {
+		expiresAt := primitive.NewDateTimeFromTime(*s.VerificationCodeExpiresAt)
+		doc.VerificationCodeExpiresAt = &expiresAt
+	}
+	if s.VerificationCodeSentAt != nil {
+		sentAt := primitive.NewDateTimeFromTime(*s.VerificationCodeSentAt)
+		doc.VerificationCodeSentAt = &sentAt
+	}
+	if s.ConfirmedAt != nil {
+		confirmedAt := primitive.NewDateTimeFromTime(*s.ConfirmedAt)
+		doc.ConfirmedAt = &confirmedAt
+	}
+	return doc
+}
+
+func toSubscriberEntity(doc *subscriberDocument) *entity.Subscriber {
+	s := &entity.Subscriber{
+		ID:                     doc.ID.Hex(),
+		Email:                  doc.Email,
+		Confirmed:              doc.Confirmed,
+		VerificationCode:       doc.VerificationCode,
+		VerificationAttempts:   doc.VerificationAttempts,
+		VerificationEmailsSent: doc.VerificationEmailsSent,
+		UnsubscribeToken:       doc.UnsubscribeToken,
+		CreatedAt:              doc.CreatedAt.Time(),
+	}
+	if doc.VerificationCodeExpiresAt != nil {
+		expiresAt := doc.VerificationCodeExpiresAt.Time()
+		s.VerificationCodeExpiresAt = &expiresAt
+	}
+	if doc.VerificationCodeSentAt != nil {
+		sentAt := doc.VerificationCodeSentAt.Time()
+		s.VerificationCodeSentAt = &sentAt
+	}
+	if doc.ConfirmedAt != nil {
+		confirmedAt := doc.ConfirmedAt.Time()
+		s.ConfirmedAt = &confirmedAt
+	}
+	return s
+}
+
+func (r *SubscriberMongoRepository) Create(ctx context.Context, subscriber *entity.Subscriber) (string, error) {
+	res, err := r.db.Collection(subscribersCollectionName).InsertOne(ctx, toSubscriberDocument(subscriber))
+	if err != nil {
+		return "", fmt.Errorf("failed to insert subscriber into mongo: %w", err)
+	}
+	oid, ok := res.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return "", fmt.Errorf("failed to convert inserted ID to ObjectID")
+	}
+	return oid.Hex(), nil
+}
+
+func (r *SubscriberMongoRepository) GetByEmail(ctx context.Context, email string) (*entity.Subscriber, error) {
+	var doc subscriberDocument
+	err := r.db.Collection(subscribersCollectionName).FindOne(ctx, bson.M{"email": email}).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, repository.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get subscriber from mongo: %w", err)
+	}
+	return toSubscriberEntity(&doc), nil
+}
+
+func (r *SubscriberMongoRepository) Update(ctx context.Context, subscriber *entity.Subscriber) error {
+	objID, err := primitive.ObjectIDFromHex(subscriber.ID)
+	if err != nil {
+		return repository.ErrNotFound
+	}
+	doc := toSubscriberDocument(subscriber)
+
+	setFields := bson.M{
+		"confirmed":                doc.Confirmed,
+		"verification_attempts":    doc.VerificationAttempts,
+		"verification_emails_sent": doc.VerificationEmailsSent,
+	}
+	unsetFields := bson.M{}
+	if doc.VerificationCode != "" {
+		setFields["verification_code"] = doc.VerificationCode
+		setFields["verification_code_expires_at"] = doc.VerificationCodeExpiresAt
+	} else {
+		unsetFields["verification_code"] = ""
+		unsetFields["verification_code_expires_at"] = ""
+	}
+	if doc.VerificationCodeSentAt != nil {
+		setFields["verification_code_sent_at"] = doc.VerificationCodeSentAt
+	}
+	if doc.ConfirmedAt != nil {
+		setFields["confirmed_at"] = doc.ConfirmedAt
+	}
+	update := bson.M{"$set": setFields}
+	if len(unsetFields) > 0 {
+		update["$unset"] = unsetFields
+	}
+
+	res, err := r.db.Collection(subscribersCollectionName).UpdateOne(ctx, bson.M{"_id": objID}, update)
+	if err != nil {
+		return fmt.Errorf("failed to update subscriber in mongo: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return repository.ErrNotFound
+	}
+	return nil
+}
+
+func (r *SubscriberMongoRepository) IncrementVerificationAttempts(ctx context.Context, id string) (int, error) {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return 0, repository.ErrNotFound
+	}
+
+	var doc subscriberDocument
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	err = r.db.Collection(subscribersCollectionName).FindOneAndUpdate(ctx, bson.M{"_id": objID},
+		bson.M{"$inc": bson.M{"verification_attempts": 1}}, opts).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return 0, repository.ErrNotFound
+		}
+		return 0, fmt.Errorf("failed to count verification attempt in mongo: %w", err)
+	}
+	return doc.VerificationAttempts, nil
+}
+
+func (r *SubscriberMongoRepository) DeleteByUnsubscribeToken(ctx context.Context, token string) error {
+	res, err := r.db.Collection(subscribersCollectionName).DeleteOne(ctx, bson.M{"unsubscribe_token": token})
+	if err != nil {
+		return fmt.Errorf("failed to delete subscriber from mongo: %w", err)
+	}
+	if res.DeletedCount == 0 {
+		return repository.ErrNotFound
+	}
+	return nil
+}
+
+func (r *SubscriberMongoRepository) ListConfirmed(ctx context.Context) ([]*entity.Subscriber, error) {
+	cursor, err := r.db.Collection(subscribersCollectionName).Find(ctx, bson.M{"confirmed": true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list subscribers from mongo: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var docs []*subscriberDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("failed to decode subscribers: %w", err)
+	}
+	subscribers := make([]*entity.Subscriber, 0, len(docs))
+	for _, doc := range docs {
+		subscribers = append(subscribers, toSubscriberEntity(doc))
+	}
+	return subscribers, nil
+}
@@ -14,6 +14,9 @@ const (
 	NewsCreatedSubject = "news.created"
 	NewsUpdatedSubject = "news.updated"
 	NewsDeletedSubject = "news.deleted"
+
+	CommentUpdatedSubject = "comment.updated"
+	CommentDeletedSubject = "comment.deleted"
 )
 
 type Publisher struct {
@@ -130,6 +133,67 @@ func (p *Publisher) PublishNewsDeleted(ctx context.Context, newsID string) error
 	return nil
 }
 
+func (p *Publisher) PublishCommentUpdated(ctx context.Context, comment *entity.Comment) error {
+	return p.publishComment(CommentUpdatedSubject, comment)
+}
+
+// PublishCommentDeleted publishes the tombstoned comment, so consumers still see its news and parent.
+func (p *Publisher) PublishCommentDeleted(ctx context.Context, comment *entity.Comment) error {
+	return p.publishComment(CommentDeletedSubject, comment)
+}
+
+func (p *Publisher) publishComment(subject string, comment *entity.Comment) error {
+	data, err := json.Marshal(comment)
+	if err != nil {
+		p.logger.Error("Failed to marshal comment for NATS publishing",
+			zap.Error(err),
+			zap.String("comment_id", comment.ID),
+			zap.String("subject", subject),
+		)
+		return fmt.Errorf("failed to marshal comment for %s: %w", subject, err)
+	}
+
+	if err := p.nc.Publish(subject, data); err != nil {
+		p.logger.Error("Failed to publish NATS message",
+			zap.String("subject", subject),
+			zap.Error(err),
+			zap.String("comment_id", comment.ID),
+		)
+		return fmt.Errorf("failed to publish NATS message for %s: %w", subject, err)
+	}
+	p.logger.Info("Published NATS message",
+		zap.String("subject", subject),
+		zap.String("comment_id", comment.ID),
+	)
+	return nil
+}
+
+// Ping reports an error while the NATS connection is down or reconnecting.
+func (p *Publisher) Ping(ctx context.Context) error {
+	if p.nc == nil || !p.nc.IsConnected() {
+		return fmt.Errorf("nats connection is not established")
+	}
+	return nil
+}
+
+// PublishProbe publishes a test message to subject and flushes, so a rejected publish
+// (e.g. missing permissions) is reported now rather than through the async error handler.
+func (p *Publisher) PublishProbe(ctx context.Context, subject string) error {
+	if err := p.Ping(ctx); err != nil {
+		return err
+	}
+	if err := p.nc.Publish(subject, []byte(`{"probe":true}`)); err != nil {
+		return fmt.Errorf("publish to %s: %w", subject, err)
+	}
+	if err := p.nc.FlushWithContext(ctx); err != nil {
+		return fmt.Errorf("flush after publishing to %s: %w", subject, err)
+	}
+	if err := p.nc.LastError(); err != nil {
+		return fmt.Errorf("server rejected publish to %s: %w", subject, err)
+	}
+	return nil
+}
+
 func (p *Publisher) Close() {
 	if p.nc != nil && !p.nc.IsClosed() {
 		if err := p.nc.Drain(); err != nil { // Drain ensures all buffered messages are sent
@@ -0,0 +1,110 @@
+package nats
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Abdurahmanit/GroupProject/news-service/internal/config"
+	"github.com/Abdurahmanit/GroupProject/platform/natsdedup"
+	"github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+)
+
+const (
+	UserDeletedSubject = "user.deleted"
+
+	newsServiceQueueGroup = "news-service"
+	eventHandlerTimeout   = 30 * time.Second
+)
+
+type UserDeletedEventPayload struct {
+	UserID string `json:"user_id"`
+}
+
+type UserDeletedHandler interface {
+	HandleAuthorDeleted(ctx context.Context, userID string) error
+}
+
+type Subscriber struct {
+	nc     *nats.Conn
+	subs   []*nats.Subscription
+	dedup  *natsdedup.Deduplicator
+	logger *zap.Logger
+}
+
+// NewNATSSubscriber connects to NATS. Handlers are wrapped with dedup; pass nil to disable
+// deduplication.
+func NewNATSSubscriber(cfg *config.NATSConfig, dedup *natsdedup.Deduplicator, logger *zap.Logger) (*Subscriber, error) {
+	nc, err := nats.Connect(cfg.URL,
+		nats.Timeout(cfg.ConnectTimeout),
+		nats.DisconnectErrHandler(func(nc *nats.Conn, err error) {
+			logger.Warn("NATS subscriber disconnected", zap.Error(err))
+		}),
+		nats.ReconnectHandler(func(nc *nats.Conn) {
+			logger.Info("NATS subscriber reconnected", zap.String("url", nc.ConnectedUrl()))
+		}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to NATS for subscriptions: %w", err)
+	}
+	return &Subscriber{nc: nc, dedup: dedup, logger: logger}, nil
+}
+
+// SubscribeUserDeleted consumes user.deleted events in a queue group so that each event is
+// handled by a single news-service instance.
+func (s *Subscriber) SubscribeUserDeleted(handler UserDeletedHandler) error {
+	sub, err := s.nc.QueueSubscribe(UserDeletedSubject, newsServiceQueueGroup, s.dedup.Wrap(newsServiceQueueGroup, func(msg *nats.Msg) error {
+		var payload UserDeletedEventPayload
+		if err := json.Unmarshal(msg.Data, &payload); err != nil {
+			s.logger.Error("Failed to unmarshal NATS message",
+				zap.String("subject", msg.Subject),
+				zap.Error(err),
+			)
+			return nil
+		}
+		if payload.UserID == "" {
+			s.logger.Warn("Received NATS message without user_id", zap.String("subject", msg.Subject))
+			return nil
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), eventHandlerTimeout)
+		defer cancel()
+
+		if err := handler.HandleAuthorDeleted(ctx, payload.UserID); err != nil {
+			s.logger.Error("Failed to process NATS message",
+				zap.String("subject", msg.Subject),
+				zap.String("user_id", payload.UserID),
+				zap.Error(err),
+			)
+			return err
+		}
+		s.logger.Info("Processed NATS message",
+			zap.String("subject", msg.Subject),
+			zap.String("user_id", payload.UserID),
+		)
+		return nil
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to %s: %w", UserDeletedSubject, err)
+	}
+	s.subs = append(s.subs, sub)
+	s.logger.Info("Subscribed to NATS subject", zap.String("subject", UserDeletedSubject), zap.String("queue", newsServiceQueueGroup))
+	return nil
+}
+
+func (s *Subscriber) Close() {
+	for _, sub := range s.subs {
+		if err := sub.Unsubscribe(); err != nil {
+			s.logger.Warn("Error unsubscribing from NATS subject", zap.String("subject", sub.Subject), zap.Error(err))
+		}
+	}
+	if s.nc != nil && !s.nc.IsClosed() {
+		if err := s.nc.Drain(); err != nil {
+			s.logger.Error("Error draining NATS subscriber connection", zap.Error(err))
+		}
+		s.nc.Close()
+		s.logger.Info("NATS subscriber connection closed")
+	}
+}
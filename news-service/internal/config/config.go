@@ -20,12 +20,62 @@ type SMTPConfig struct {
 }
 
 type Config struct {
-	GRPC               GRPCConfig  `mapstructure:"grpc"`
-	Mongo              MongoConfig `mapstructure:"mongo"`
-	NATS               NATSConfig  `mapstructure:"nats"`
-	Redis              RedisConfig `mapstructure:"redis"`
-	SMTP               SMTPConfig  `mapstructure:"smtp"`
-	UserServiceAddress string      `mapstructure:"user_service_address"`
+	GRPC               GRPCConfig       `mapstructure:"grpc"`
+	Mongo              MongoConfig      `mapstructure:"mongo"`
+	NATS               NATSConfig       `mapstructure:"nats"`
+	Redis              RedisConfig      `mapstructure:"redis"`
+	SMTP               SMTPConfig       `mapstructure:"smtp"`
+	Comments           CommentsConfig   `mapstructure:"comments"`
+	Scheduling         SchedulingConfig `mapstructure:"scheduling"`
+	Views              ViewsConfig      `mapstructure:"views"`
+	Newsletter         NewsletterConfig `mapstructure:"newsletter"`
+	Feed               FeedConfig       `mapstructure:"feed"`
+	UserServiceAddress string           `mapstructure:"user_service_address"`
+	// StartupSelfTest writes and reads a canary in MongoDB and Redis and publishes to
+	// "news.selftest" on boot; the service exits if any step fails.
+	StartupSelfTest bool `mapstructure:"startup_self_test"`
+}
+
+const (
+	AuthorDeletedActionDelete    = "delete"
+	AuthorDeletedActionAnonymize = "anonymize"
+)
+
+// CommentsConfig controls what happens to the comments of a user whose account was deleted:
+// "delete" hides them from threads, "anonymize" keeps them but drops the link to the author.
+// MaxReplyDepth caps how deeply replies can nest; 1 allows replies to top-level comments only.
+type CommentsConfig struct {
+	OnAuthorDeleted string `mapstructure:"on_author_deleted"`
+	MaxReplyDepth   int    `mapstructure:"max_reply_depth"`
+}
+
+// SchedulingConfig controls how often scheduled articles are checked for publication.
+type SchedulingConfig struct {
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+}
+
+// ViewsConfig controls view counting. Views are buffered in Redis and written to MongoDB every
+// FlushInterval. Trending lists count views per BucketSize and can look back at most Retention.
+type ViewsConfig struct {
+	FlushInterval time.Duration `mapstructure:"flush_interval"`
+	BucketSize    time.Duration `mapstructure:"bucket_size"`
+	Retention     time.Duration `mapstructure:"retention"`
+}
+
+// NewsletterConfig controls the news digest: it is mailed to confirmed subscribers every
+// DigestInterval, and UnsubscribeURL is the page linked from each email for one-click unsubscribes.
+type NewsletterConfig struct {
+	DigestInterval time.Duration `mapstructure:"digest_interval"`
+	UnsubscribeURL string        `mapstructure:"unsubscribe_url"`
+}
+
+// FeedConfig controls the RSS feed served over HTTP at /rss on HTTPPort. Link is the public
+// site URL used for the channel and article links.
+type FeedConfig struct {
+	HTTPPort    string `mapstructure:"http_port"`
+	Title       string `mapstructure:"title"`
+	Link        string `mapstructure:"link"`
+	Description string `mapstructure:"description"`
 }
 
 type GRPCConfig struct {
@@ -48,6 +98,10 @@ type MongoConfig struct {
 type NATSConfig struct {
 	URL            string        `mapstructure:"url"`
 	ConnectTimeout time.Duration `mapstructure:"connect_timeout"`
+	// DedupEnabled makes consumers skip messages already processed, tracked in Redis for DedupTTL.
+	// DedupTTL must exceed the longest redelivery window.
+	DedupEnabled bool          `mapstructure:"dedup_enabled"`
+	DedupTTL     time.Duration `mapstructure:"dedup_ttl"`
 }
 
 type RedisConfig struct {
@@ -72,13 +126,34 @@ func LoadConfig(path string) (*Config, error) {
 
 	viper.SetDefault("nats.url", "nats://localhost:4222")
 	viper.SetDefault("nats.connect_timeout", "5s")
+	viper.SetDefault("nats.dedup_enabled", true)
+	viper.SetDefault("nats.dedup_ttl", "24h")
 
 	viper.SetDefault("redis.address", "localhost:6379")
 	viper.SetDefault("redis.password", "")
 	viper.SetDefault("redis.db", 0)
 
+	viper.SetDefault("comments.on_author_deleted", AuthorDeletedActionAnonymize)
+	viper.SetDefault("comments.max_reply_depth", 3)
+
+	viper.SetDefault("scheduling.poll_interval", "30s")
+
+	viper.SetDefault("views.flush_interval", "30s")
+	viper.SetDefault("views.bucket_size", "10m")
+	viper.SetDefault("views.retention", "168h")
+
+	viper.SetDefault("newsletter.digest_interval", "168h")
+	viper.SetDefault("newsletter.unsubscribe_url", "http://localhost:8080/newsletter/unsubscribe")
+
+	viper.SetDefault("feed.http_port", "8095")
+	viper.SetDefault("feed.title", "News")
+	viper.SetDefault("feed.link", "http://localhost:8080")
+	viper.SetDefault("feed.description", "The latest published news")
+
 	viper.SetDefault("user_service_address", "localhost:50051")
 
+	viper.SetDefault("startup_self_test", false)
+
 	viper.SetConfigName(".env")
 	viper.SetConfigType("env")
 	viper.AddConfigPath(".")
@@ -150,6 +225,36 @@ func LoadConfig(path string) (*Config, error) {
 	cfg.SMTP.Password = os.Getenv("NEWS_SMTP_PASSWORD")
 	cfg.SMTP.SenderEmail = os.Getenv("NEWS_SMTP_SENDER_EMAIL")
 
+	switch cfg.Comments.OnAuthorDeleted {
+	case AuthorDeletedActionDelete, AuthorDeletedActionAnonymize:
+	default:
+		return nil, fmt.Errorf("invalid comments.on_author_deleted value %q: expected %q or %q",
+			cfg.Comments.OnAuthorDeleted, AuthorDeletedActionDelete, AuthorDeletedActionAnonymize)
+	}
+
+	if cfg.Comments.MaxReplyDepth < 1 {
+		return nil, fmt.Errorf("invalid comments.max_reply_depth %d: must be at least 1", cfg.Comments.MaxReplyDepth)
+	}
+
+	if cfg.Scheduling.PollInterval <= 0 {
+		return nil, fmt.Errorf("invalid scheduling.poll_interval %s: must be positive", cfg.Scheduling.PollInterval)
+	}
+
+	if cfg.Views.FlushInterval <= 0 {
+		return nil, fmt.Errorf("invalid views.flush_interval %s: must be positive", cfg.Views.FlushInterval)
+	}
+	if cfg.Views.BucketSize <= 0 || cfg.Views.Retention < cfg.Views.BucketSize {
+		return nil, fmt.Errorf("invalid views.bucket_size %s / views.retention %s: bucket size must be positive and not exceed retention",
+			cfg.Views.BucketSize, cfg.Views.Retention)
+	}
+
+	if cfg.Newsletter.DigestInterval <= 0 {
+		return nil, fmt.Errorf("invalid newsletter.digest_interval %s: must be positive", cfg.Newsletter.DigestInterval)
+	}
+	if cfg.Newsletter.UnsubscribeURL == "" {
+		return nil, fmt.Errorf("newsletter.unsubscribe_url is required")
+	}
+
 	if cfg.UserServiceAddress == "" {
 		cfg.UserServiceAddress = os.Getenv("NEWS_USER_SERVICE_ADDRESS")
 		if cfg.UserServiceAddress == "" {
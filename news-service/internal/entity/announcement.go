@@ -0,0 +1,31 @@
+package entity
+
+import "time"
+
+const (
+	AnnouncementTypeInfo        = "info"
+	AnnouncementTypeMaintenance = "maintenance"
+	AnnouncementTypePromotion   = "promotion"
+)
+
+// Announcement is a site-wide banner message. It is shown while Active is set and the current
+// time is within [StartsAt, EndsAt); a zero EndsAt means the announcement never expires.
+type Announcement struct {
+	ID        string
+	Type      string
+	Message   string
+	Active    bool
+	StartsAt  time.Time
+	EndsAt    time.Time
+	CreatedBy string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// IsLive reports whether the announcement should be displayed at the given time.
+func (a *Announcement) IsLive(now time.Time) bool {
+	if !a.Active || now.Before(a.StartsAt) {
+		return false
+	}
+	return a.EndsAt.IsZero() || now.Before(a.EndsAt)
+}
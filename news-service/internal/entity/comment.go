@@ -2,11 +2,32 @@ package entity
 
 import "time"
 
+// DeletedAuthorID replaces the author of comments whose user account was removed
+// when comments are anonymized instead of deleted.
+const DeletedAuthorID = "deleted-user"
+
+// DeletedCommentContent replaces the text of a comment removed by its author or an admin.
+const DeletedCommentContent = "[deleted]"
+
 type Comment struct {
-	ID        string
-	NewsID    string
+	ID     string
+	NewsID string
+	// ParentID is set for replies; Depth is 0 for top-level comments and grows by one per reply level.
+	ParentID  *string
+	Depth     int
 	UserID    string
 	Content   string
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	// EditedAt is set when the content was changed after creation.
+	EditedAt *time.Time
+	// RemovedAt marks a tombstoned comment: its content is replaced but it stays in the
+	// thread so replies keep their parent. DeletedAt instead hides the comment entirely.
+	RemovedAt *time.Time
+	DeletedAt *time.Time
+}
+
+// IsRemoved reports whether the comment has been replaced by a tombstone.
+func (c *Comment) IsRemoved() bool {
+	return c.RemovedAt != nil
 }
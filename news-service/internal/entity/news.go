@@ -2,13 +2,36 @@ package entity
 
 import "time"
 
+type NewsStatus string
+
+const (
+	// NewsStatusScheduled articles are hidden from readers until PublishAt.
+	NewsStatusScheduled NewsStatus = "scheduled"
+	NewsStatusPublished NewsStatus = "published"
+)
+
 type News struct {
-	ID        string
-	Title     string
-	Content   string
-	AuthorID  string
-	ImageURL  string
-	Category  string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID           string
+	Title        string
+	Content      string
+	AuthorID     string
+	ImageURL     string
+	Category     string
+	Tags         []string
+	CommentCount int64
+	// ViewCount is flushed from the cache periodically, so it lags behind the latest views.
+	ViewCount int64
+	// Status is empty for articles created before scheduling existed; they count as published.
+	Status    NewsStatus
+	PublishAt *time.Time
+	// PublishedAt is when readers could first see the article; nil for articles stored before it
+	// was recorded, whose CreatedAt stands in for it.
+	PublishedAt *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// IsScheduled reports whether the article is still waiting for its publish time.
+func (n *News) IsScheduled() bool {
+	return n.Status == NewsStatusScheduled
 }
@@ -0,0 +1,22 @@
+package entity
+
+import "time"
+
+// Subscriber is an email address signed up for the news digest. It only receives digests once
+// Confirmed, i.e. after the verification code mailed on sign-up was entered. UnsubscribeToken is
+// included in every email and removes the subscription without any further check.
+type Subscriber struct {
+	ID                        string
+	Email                     string
+	Confirmed                 bool
+	VerificationCode          string
+	VerificationCodeExpiresAt *time.Time
+	// VerificationAttempts counts wrong guesses of the current code.
+	VerificationAttempts int
+	// VerificationEmailsSent and VerificationCodeSentAt pace the confirmation emails to the address.
+	VerificationEmailsSent int
+	VerificationCodeSentAt *time.Time
+	UnsubscribeToken       string
+	CreatedAt              time.Time
+	ConfirmedAt            *time.Time
+}
@@ -0,0 +1,177 @@
+// Package feed renders published news as syndication feeds.
+package feed
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Abdurahmanit/GroupProject/news-service/internal/entity"
+	"github.com/Abdurahmanit/GroupProject/news-service/internal/usecase"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultLimit   = 20
+	maxLimit       = 100
+	rssContentType = "application/rss+xml; charset=utf-8"
+)
+
+// NewsLister is the part of usecase.NewsUseCase the feed reads from.
+type NewsLister interface {
+	ListNews(ctx context.Context, input usecase.ListNewsInput) (*usecase.ListNewsOutput, error)
+}
+
+// Channel describes the feed itself. Link is the site's base URL; article links are built as
+// Link + "/news/" + ID.
+type Channel struct {
+	Title       string
+	Link        string
+	Description string
+}
+
+type Generator struct {
+	news    NewsLister
+	channel Channel
+	logger  *zap.Logger
+}
+
+func NewGenerator(news NewsLister, channel Channel, log *zap.Logger) *Generator {
+	channel.Link = strings.TrimRight(channel.Link, "/")
+	return &Generator{
+		news:    news,
+		channel: channel,
+		logger:  log,
+	}
+}
+
+type rssDocument struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string  `xml:"title"`
+	Link        string  `xml:"link"`
+	Description string  `xml:"description"`
+	Category    string  `xml:"category,omitempty"`
+	GUID        rssGUID `xml:"guid"`
+	PubDate     string  `xml:"pubDate"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+// GenerateRSS renders the latest limit published articles as an RSS 2.0 document.
+func (g *Generator) GenerateRSS(ctx context.Context, limit int) (string, error) {
+	return g.GenerateCategoryRSS(ctx, "", limit)
+}
+
+// GenerateCategoryRSS is GenerateRSS limited to one category; an empty category includes all.
+func (g *Generator) GenerateCategoryRSS(ctx context.Context, category string, limit int) (string, error) {
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+
+	input := usecase.ListNewsInput{Page: 1, PageSize: limit}
+	if category != "" {
+		input.Filter = map[string]interface{}{"category": category}
+	}
+	output, err := g.news.ListNews(ctx, input)
+	if err != nil {
+		return "", fmt.Errorf("feed.GenerateRSS: failed to list news: %w", err)
+	}
+
+	channel := rssChannel{
+		Title:       g.channel.Title,
+		Link:        g.channel.Link,
+		Description: g.channel.Description,
+		Items:       make([]rssItem, 0, len(output.News)),
+	}
+	if category != "" {
+		channel.Title = fmt.Sprintf("%s: %s", g.channel.Title, category)
+	}
+	for _, n := range output.News {
+		channel.Items = append(channel.Items, g.item(n))
+	}
+	if len(output.News) > 0 {
+		channel.LastBuildDate = channel.Items[0].PubDate
+	}
+
+	body, err := xml.MarshalIndent(rssDocument{Version: "2.0", Channel: channel}, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("feed.GenerateRSS: failed to encode feed: %w", err)
+	}
+	return xml.Header + string(body), nil
+}
+
+func (g *Generator) item(n *entity.News) rssItem {
+	return rssItem{
+		Title:       n.Title,
+		Link:        g.channel.Link + "/news/" + n.ID,
+		Description: n.Content,
+		Category:    n.Category,
+		GUID:        rssGUID{IsPermaLink: false, Value: n.ID},
+		PubDate:     publishedAt(n).UTC().Format(time.RFC1123Z),
+	}
+}
+
+// publishedAt is when the article became visible: its publish time if it was scheduled,
+// otherwise its creation time.
+func publishedAt(n *entity.News) time.Time {
+	if n.PublishAt != nil {
+		return *n.PublishAt
+	}
+	return n.CreatedAt
+}
+
+// ServeHTTP serves the feed. The optional "category" query parameter scopes it to one category
+// and "limit" sets the number of articles.
+func (g *Generator) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	limit := 0
+	if raw := r.URL.Query().Get("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed <= 0 {
+			http.Error(w, "limit must be a positive integer", http.StatusBadRequest)
+			return
+		}
+		limit = parsed
+	}
+
+	rss, err := g.GenerateCategoryRSS(r.Context(), r.URL.Query().Get("category"), limit)
+	if err != nil {
+		g.logger.Error("Failed to generate RSS feed", zap.Error(err))
+		http.Error(w, "failed to generate feed", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", rssContentType)
+	w.WriteHeader(http.StatusOK)
+	if r.Method == http.MethodGet {
+		_, _ = w.Write([]byte(rss))
+	}
+}
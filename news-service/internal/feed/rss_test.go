@@ -0,0 +1,72 @@
+package feed
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Abdurahmanit/GroupProject/news-service/internal/entity"
+	"github.com/Abdurahmanit/GroupProject/news-service/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type stubNewsLister struct {
+	lastInput usecase.ListNewsInput
+	news      []*entity.News
+}
+
+func (s *stubNewsLister) ListNews(ctx context.Context, input usecase.ListNewsInput) (*usecase.ListNewsOutput, error) {
+	s.lastInput = input
+	return &usecase.ListNewsOutput{News: s.news, TotalCount: len(s.news)}, nil
+}
+
+func TestGenerator_GenerateRSS(t *testing.T) {
+	createdAt := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	publishAt := time.Date(2024, 3, 2, 8, 30, 0, 0, time.UTC)
+	lister := &stubNewsLister{news: []*entity.News{
+		{ID: "n2", Title: "Fast & <light> frames", Content: "Carbon \"vs\" steel", Category: "bikes", CreatedAt: createdAt, PublishAt: &publishAt},
+		{ID: "n1", Title: "Opening day", Content: "Hello", CreatedAt: createdAt},
+	}}
+	g := NewGenerator(lister, Channel{Title: "Shop news", Link: "https://shop.example/", Description: "Latest news"}, zap.NewNop())
+
+	rss, err := g.GenerateRSS(context.Background(), 0)
+	require.NoError(t, err)
+	assert.Equal(t, defaultLimit, lister.lastInput.PageSize)
+	assert.Nil(t, lister.lastInput.Filter)
+
+	var doc rssDocument
+	require.NoError(t, xml.Unmarshal([]byte(rss), &doc))
+	assert.Equal(t, "2.0", doc.Version)
+	require.Len(t, doc.Channel.Items, 2)
+
+	first := doc.Channel.Items[0]
+	assert.Equal(t, "Fast & <light> frames", first.Title)
+	assert.Equal(t, "Carbon \"vs\" steel", first.Description)
+	assert.Equal(t, "https://shop.example/news/n2", first.Link)
+	assert.Equal(t, "n2", first.GUID.Value)
+	assert.False(t, first.GUID.IsPermaLink)
+	assert.Equal(t, "Sat, 02 Mar 2024 08:30:00 +0000", first.PubDate)
+	assert.Equal(t, "Fri, 01 Mar 2024 10:00:00 +0000", doc.Channel.Items[1].PubDate)
+}
+
+func TestGenerator_ServeHTTP(t *testing.T) {
+	lister := &stubNewsLister{}
+	g := NewGenerator(lister, Channel{Title: "Shop news", Link: "https://shop.example"}, zap.NewNop())
+
+	rec := httptest.NewRecorder()
+	g.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/rss?category=bikes&limit=5", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, rssContentType, rec.Header().Get("Content-Type"))
+	assert.Equal(t, map[string]interface{}{"category": "bikes"}, lister.lastInput.Filter)
+	assert.Equal(t, 5, lister.lastInput.PageSize)
+
+	rec = httptest.NewRecorder()
+	g.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/rss?limit=abc", nil))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
@@ -0,0 +1,20 @@
+package cache
+
+import (
+	"context"
+	"time"
+)
+
+// ViewCounter records article views in the cache. Views are buffered until drained so the
+// database sees one increment per article and flush instead of one write per view.
+type ViewCounter interface {
+	// RecordView counts one view of newsID at the given time.
+	RecordView(ctx context.Context, newsID string, at time.Time) error
+	// DrainPending returns the views recorded since the previous drain, per article, and resets them.
+	DrainPending(ctx context.Context) (map[string]int64, error)
+	// RestorePending adds drained counts back to the pending views, e.g. after a failed flush.
+	RestorePending(ctx context.Context, counts map[string]int64) error
+	// TopViewed returns up to limit article IDs with the most views in the window ending at now,
+	// most viewed first.
+	TopViewed(ctx context.Context, window time.Duration, now time.Time, limit int) ([]string, error)
+}
@@ -0,0 +1,91 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Abdurahmanit/GroupProject/news-service/internal/entity"
+	"github.com/Abdurahmanit/GroupProject/news-service/internal/port/repository"
+	"github.com/Abdurahmanit/GroupProject/news-service/internal/usecase"
+	newspb "github.com/Abdurahmanit/GroupProject/news-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func announcementEntityToProto(a *entity.Announcement) *newspb.Announcement {
+	if a == nil {
+		return nil
+	}
+	pb := &newspb.Announcement{
+		Id:        a.ID,
+		Type:      a.Type,
+		Message:   a.Message,
+		Active:    a.Active,
+		StartsAt:  timestamppb.New(a.StartsAt),
+		CreatedBy: a.CreatedBy,
+		CreatedAt: timestamppb.New(a.CreatedAt),
+		UpdatedAt: timestamppb.New(a.UpdatedAt),
+	}
+	if !a.EndsAt.IsZero() {
+		pb.EndsAt = timestamppb.New(a.EndsAt)
+	}
+	return pb
+}
+
+func optionalTime(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
+
+func announcementErrorToStatus(err error, action string) error {
+	switch {
+	case errors.Is(err, usecase.ErrAdminRequired):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, usecase.ErrInvalidAnnouncement):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, repository.ErrNotFound):
+		return status.Error(codes.NotFound, "announcement not found")
+	default:
+		return status.Errorf(codes.Internal, "failed to %s: %v", action, err)
+	}
+}
+
+func (h *NewsHandler) CreateAnnouncement(ctx context.Context, req *newspb.CreateAnnouncementRequest) (*newspb.CreateAnnouncementResponse, error) {
+	input := usecase.CreateAnnouncementInput{
+		AdminID:  req.GetAdminId(),
+		Type:     req.GetType(),
+		Message:  req.GetMessage(),
+		StartsAt: optionalTime(req.GetStartsAt()),
+		EndsAt:   optionalTime(req.GetEndsAt()),
+		Active:   req.GetActive(),
+	}
+	announcement, err := h.announcementUseCase.CreateAnnouncement(ctx, input)
+	if err != nil {
+		return nil, announcementErrorToStatus(err, "create announcement")
+	}
+	return &newspb.CreateAnnouncementResponse{Announcement: announcementEntityToProto(announcement)}, nil
+}
+
+func (h *NewsHandler) SetAnnouncementActive(ctx context.Context, req *newspb.SetAnnouncementActiveRequest) (*newspb.SetAnnouncementActiveResponse, error) {
+	announcement, err := h.announcementUseCase.SetAnnouncementActive(ctx, req.GetAdminId(), req.GetId(), req.GetActive())
+	if err != nil {
+		return nil, announcementErrorToStatus(err, "update announcement")
+	}
+	return &newspb.SetAnnouncementActiveResponse{Announcement: announcementEntityToProto(announcement)}, nil
+}
+
+func (h *NewsHandler) GetActiveAnnouncements(ctx context.Context, _ *newspb.GetActiveAnnouncementsRequest) (*newspb.GetActiveAnnouncementsResponse, error) {
+	announcements, err := h.announcementUseCase.GetActiveAnnouncements(ctx)
+	if err != nil {
+		return nil, announcementErrorToStatus(err, "get active announcements")
+	}
+	resp := &newspb.GetActiveAnnouncementsResponse{Announcements: make([]*newspb.Announcement, 0, len(announcements))}
+	for _, a := range announcements {
+		resp.Announcements = append(resp.Announcements, announcementEntityToProto(a))
+	}
+	return resp, nil
+}
@@ -3,6 +3,7 @@ package grpc
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/Abdurahmanit/GroupProject/news-service/internal/entity"
 	"github.com/Abdurahmanit/GroupProject/news-service/internal/port/repository"
@@ -15,16 +16,20 @@ import (
 
 type NewsHandler struct {
 	newspb.UnimplementedNewsServiceServer
-	newsUseCase    *usecase.NewsUseCase
-	commentUseCase *usecase.CommentUseCase
-	likeUseCase    *usecase.LikeUseCase
+	newsUseCase         *usecase.NewsUseCase
+	commentUseCase      *usecase.CommentUseCase
+	likeUseCase         *usecase.LikeUseCase
+	announcementUseCase *usecase.AnnouncementUseCase
+	newsletterUseCase   *usecase.NewsletterUseCase
 }
 
-func NewNewsHandler(newsUC *usecase.NewsUseCase, commentUC *usecase.CommentUseCase, likeUC *usecase.LikeUseCase) *NewsHandler {
+func NewNewsHandler(newsUC *usecase.NewsUseCase, commentUC *usecase.CommentUseCase, likeUC *usecase.LikeUseCase, announcementUC *usecase.AnnouncementUseCase, newsletterUC *usecase.NewsletterUseCase) *NewsHandler {
 	return &NewsHandler{
-		newsUseCase:    newsUC,
-		commentUseCase: commentUC,
-		likeUseCase:    likeUC,
+		newsUseCase:         newsUC,
+		commentUseCase:      commentUC,
+		likeUseCase:         likeUC,
+		announcementUseCase: announcementUC,
+		newsletterUseCase:   newsletterUC,
 	}
 }
 
@@ -32,30 +37,47 @@ func newsEntityToProto(n *entity.News) *newspb.News {
 	if n == nil {
 		return nil
 	}
-	return &newspb.News{
-		Id:        n.ID,
-		Title:     n.Title,
-		Content:   n.Content,
-		AuthorId:  n.AuthorID,
-		ImageUrl:  n.ImageURL,
-		Category:  n.Category,
-		CreatedAt: timestamppb.New(n.CreatedAt),
-		UpdatedAt: timestamppb.New(n.UpdatedAt),
-	}
+	pbNews := &newspb.News{
+		Id:           n.ID,
+		Title:        n.Title,
+		Content:      n.Content,
+		AuthorId:     n.AuthorID,
+		ImageUrl:     n.ImageURL,
+		Category:     n.Category,
+		Tags:         n.Tags,
+		CommentCount: n.CommentCount,
+		ViewCount:    n.ViewCount,
+		Status:       string(n.Status),
+		CreatedAt:    timestamppb.New(n.CreatedAt),
+		UpdatedAt:    timestamppb.New(n.UpdatedAt),
+	}
+	if n.PublishAt != nil {
+		pbNews.PublishAt = timestamppb.New(*n.PublishAt)
+	}
+	return pbNews
 }
 
 func commentEntityToProto(c *entity.Comment) *newspb.Comment {
 	if c == nil {
 		return nil
 	}
-	return &newspb.Comment{
+	pbComment := &newspb.Comment{
 		Id:        c.ID,
 		NewsId:    c.NewsID,
 		UserId:    c.UserID,
 		Content:   c.Content,
+		Depth:     int32(c.Depth),
 		CreatedAt: timestamppb.New(c.CreatedAt),
 		UpdatedAt: timestamppb.New(c.UpdatedAt),
+		Deleted:   c.IsRemoved(),
+	}
+	if c.ParentID != nil {
+		pbComment.ParentId = *c.ParentID
 	}
+	if c.EditedAt != nil {
+		pbComment.EditedAt = timestamppb.New(*c.EditedAt)
+	}
+	return pbComment
 }
 
 func (h *NewsHandler) CreateNews(ctx context.Context, req *newspb.CreateNewsRequest) (*newspb.CreateNewsResponse, error) {
@@ -65,6 +87,14 @@ func (h *NewsHandler) CreateNews(ctx context.Context, req *newspb.CreateNewsRequ
 		AuthorID: req.GetAuthorId(),
 		ImageURL: req.GetImageUrl(),
 		Category: req.GetCategory(),
+		Tags:     req.GetTags(),
+	}
+	if req.GetPublishAt() != nil {
+		if err := req.GetPublishAt().CheckValid(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid publish_at: %v", err)
+		}
+		publishAt := req.GetPublishAt().AsTime()
+		input.PublishAt = &publishAt
 	}
 	createdNews, err := h.newsUseCase.CreateNews(ctx, input)
 	if err != nil {
@@ -74,8 +104,11 @@ func (h *NewsHandler) CreateNews(ctx context.Context, req *newspb.CreateNewsRequ
 }
 
 func (h *NewsHandler) GetNews(ctx context.Context, req *newspb.GetNewsRequest) (*newspb.GetNewsResponse, error) {
-	newsEntity, err := h.newsUseCase.GetNewsByID(ctx, req.GetId())
+	newsEntity, err := h.newsUseCase.GetNewsByID(ctx, req.GetId(), req.GetIncludeScheduled(), req.GetRequesterId())
 	if err != nil {
+		if errors.Is(err, usecase.ErrAdminRequired) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, status.Errorf(codes.NotFound, "news with id %s not found", req.GetId())
 		}
@@ -84,14 +117,33 @@ func (h *NewsHandler) GetNews(ctx context.Context, req *newspb.GetNewsRequest) (
 	return &newspb.GetNewsResponse{News: newsEntityToProto(newsEntity)}, nil
 }
 
+func (h *NewsHandler) GetAdjacentNews(ctx context.Context, req *newspb.GetAdjacentNewsRequest) (*newspb.GetAdjacentNewsResponse, error) {
+	output, err := h.newsUseCase.GetAdjacentNews(ctx, req.GetNewsId(), req.GetSameCategory())
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "news with id %s not found", req.GetNewsId())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get adjacent news: %v", err)
+	}
+	return &newspb.GetAdjacentNewsResponse{
+		Newer: newsEntityToProto(output.Newer),
+		Older: newsEntityToProto(output.Older),
+	}, nil
+}
+
 func (h *NewsHandler) ListNews(ctx context.Context, req *newspb.ListNewsRequest) (*newspb.ListNewsResponse, error) {
 	input := usecase.ListNewsInput{
-		Page:     int(req.GetPage()),
-		PageSize: int(req.GetPageSize()),
-		Filter:   nil,
+		Page:             int(req.GetPage()),
+		PageSize:         int(req.GetPageSize()),
+		Filter:           nil,
+		IncludeScheduled: req.GetIncludeScheduled(),
+		RequesterID:      req.GetRequesterId(),
 	}
 	output, err := h.newsUseCase.ListNews(ctx, input)
 	if err != nil {
+		if errors.Is(err, usecase.ErrAdminRequired) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
 		return nil, status.Errorf(codes.Internal, "failed to list news: %v", err)
 	}
 	pbNewsList := make([]*newspb.News, len(output.News))
@@ -115,6 +167,10 @@ func (h *NewsHandler) UpdateNews(ctx context.Context, req *newspb.UpdateNewsRequ
 	if req.Category != nil {
 		input.Category = req.Category
 	}
+	if req.GetReplaceTags() {
+		tags := req.GetTags()
+		input.Tags = &tags
+	}
 	updatedNews, err := h.newsUseCase.UpdateNews(ctx, input)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
@@ -142,8 +198,20 @@ func (h *NewsHandler) CreateComment(ctx context.Context, req *newspb.CreateComme
 		UserID:  req.GetUserId(),
 		Content: req.GetContent(),
 	}
+	if req.GetParentId() != "" {
+		parentID := req.GetParentId()
+		input.ParentID = &parentID
+	}
 	createdComment, err := h.commentUseCase.CreateComment(ctx, input)
 	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrNotFound):
+			return nil, status.Errorf(codes.NotFound, "failed to create comment: %v", err)
+		case errors.Is(err, usecase.ErrInvalidParentComment), errors.Is(err, usecase.ErrReplyTooDeep):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, usecase.ErrCommentRemoved):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, status.Errorf(codes.Internal, "failed to create comment: %v", err)
 	}
 	return &newspb.CreateCommentResponse{Id: createdComment.ID}, nil
@@ -166,21 +234,57 @@ func (h *NewsHandler) GetCommentsForNews(ctx context.Context, req *newspb.GetCom
 	return &newspb.GetCommentsForNewsResponse{Comments: pbCommentList, TotalCount: int32(output.TotalCount)}, nil
 }
 
-func (h *NewsHandler) DeleteComment(ctx context.Context, req *newspb.DeleteCommentRequest) (*newspb.DeleteCommentResponse, error) {
-	input := usecase.DeleteCommentInput{
+func (h *NewsHandler) ListCommentReplies(ctx context.Context, req *newspb.ListCommentRepliesRequest) (*newspb.ListCommentRepliesResponse, error) {
+	input := usecase.ListCommentRepliesInput{
 		CommentID: req.GetCommentId(),
-		UserID:    req.GetUserId(),
+		Page:      int(req.GetPage()),
+		PageSize:  int(req.GetPageSize()),
 	}
-	err := h.commentUseCase.DeleteComment(ctx, input)
+	output, err := h.commentUseCase.ListCommentReplies(ctx, input)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
-			return nil, status.Errorf(codes.NotFound, "comment with id %s not found for delete", req.GetCommentId())
+			return nil, status.Errorf(codes.NotFound, "comment with id %s not found", req.GetCommentId())
 		}
-		return nil, status.Errorf(codes.Internal, "failed to delete comment: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to list comment replies: %v", err)
+	}
+	pbReplies := make([]*newspb.Comment, len(output.Comments))
+	for i, c := range output.Comments {
+		pbReplies[i] = commentEntityToProto(c)
+	}
+	return &newspb.ListCommentRepliesResponse{Replies: pbReplies, TotalCount: int32(output.TotalCount)}, nil
+}
+
+func (h *NewsHandler) EditComment(ctx context.Context, req *newspb.EditCommentRequest) (*newspb.EditCommentResponse, error) {
+	comment, err := h.commentUseCase.EditComment(ctx, req.GetCommentId(), req.GetUserId(), req.GetContent())
+	if err != nil {
+		return nil, commentErrorToStatus(err, "edit comment")
+	}
+	return &newspb.EditCommentResponse{Comment: commentEntityToProto(comment)}, nil
+}
+
+func (h *NewsHandler) DeleteComment(ctx context.Context, req *newspb.DeleteCommentRequest) (*newspb.DeleteCommentResponse, error) {
+	if err := h.commentUseCase.DeleteComment(ctx, req.GetCommentId(), req.GetUserId()); err != nil {
+		return nil, commentErrorToStatus(err, "delete comment")
 	}
 	return &newspb.DeleteCommentResponse{Success: true}, nil
 }
 
+// commentErrorToStatus maps errors from comment modifications to gRPC status codes.
+func commentErrorToStatus(err error, action string) error {
+	switch {
+	case errors.Is(err, usecase.ErrCommentPermissionDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, usecase.ErrEmptyComment):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, usecase.ErrCommentRemoved):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, repository.ErrNotFound):
+		return status.Error(codes.NotFound, "comment not found")
+	default:
+		return status.Errorf(codes.Internal, "failed to %s: %v", action, err)
+	}
+}
+
 func (h *NewsHandler) LikeNews(ctx context.Context, req *newspb.LikeNewsRequest) (*newspb.LikeNewsResponse, error) {
 	input := usecase.AddLikeInput{
 		ContentType: usecase.ContentTypeNews,
@@ -251,3 +355,49 @@ func (h *NewsHandler) ListNewsByCategory(ctx context.Context, req *newspb.ListNe
 	}
 	return &newspb.ListNewsResponse{News: pbNewsList, TotalCount: int32(output.TotalCount)}, nil
 }
+
+func (h *NewsHandler) ListNewsByTag(ctx context.Context, req *newspb.ListNewsByTagRequest) (*newspb.ListNewsResponse, error) {
+	output, err := h.newsUseCase.ListNewsByTags(ctx, req.GetTags(), int(req.GetPage()), int(req.GetPageSize()))
+	if err != nil {
+		if errors.Is(err, usecase.ErrNoTags) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to list news by tag: %v", err)
+	}
+	pbNewsList := make([]*newspb.News, len(output.News))
+	for i, n := range output.News {
+		pbNewsList[i] = newsEntityToProto(n)
+	}
+	return &newspb.ListNewsResponse{News: pbNewsList, TotalCount: int32(output.TotalCount)}, nil
+}
+
+func (h *NewsHandler) SearchNews(ctx context.Context, req *newspb.SearchNewsRequest) (*newspb.ListNewsResponse, error) {
+	output, err := h.newsUseCase.SearchNewsInCategory(ctx, req.GetQuery(), req.GetCategory(), int(req.GetPage()), int(req.GetPageSize()))
+	if err != nil {
+		if errors.Is(err, usecase.ErrEmptySearchQuery) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to search news: %v", err)
+	}
+	pbNewsList := make([]*newspb.News, len(output.News))
+	for i, n := range output.News {
+		pbNewsList[i] = newsEntityToProto(n)
+	}
+	return &newspb.ListNewsResponse{News: pbNewsList, TotalCount: int32(output.TotalCount)}, nil
+}
+
+func (h *NewsHandler) ListTrendingNews(ctx context.Context, req *newspb.ListTrendingNewsRequest) (*newspb.ListNewsResponse, error) {
+	window := time.Duration(req.GetWindowSeconds()) * time.Second
+	trending, err := h.newsUseCase.ListTrendingNews(ctx, window, int(req.GetLimit()))
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidTrendingWindow) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to list trending news: %v", err)
+	}
+	pbNewsList := make([]*newspb.News, len(trending))
+	for i, n := range trending {
+		pbNewsList[i] = newsEntityToProto(n)
+	}
+	return &newspb.ListNewsResponse{News: pbNewsList, TotalCount: int32(len(trending))}, nil
+}
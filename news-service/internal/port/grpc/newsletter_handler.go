@@ -0,0 +1,48 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Abdurahmanit/GroupProject/news-service/internal/port/repository"
+	"github.com/Abdurahmanit/GroupProject/news-service/internal/usecase"
+	newspb "github.com/Abdurahmanit/GroupProject/news-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newsletterErrorToStatus(err error, action string) error {
+	switch {
+	case errors.Is(err, usecase.ErrInvalidEmail), errors.Is(err, usecase.ErrInvalidSubscriptionCode):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, usecase.ErrAlreadySubscribed):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, usecase.ErrSubscriptionThrottled):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, repository.ErrNotFound):
+		return status.Error(codes.NotFound, "subscription not found")
+	default:
+		return status.Errorf(codes.Internal, "failed to %s: %v", action, err)
+	}
+}
+
+func (h *NewsHandler) Subscribe(ctx context.Context, req *newspb.SubscribeRequest) (*newspb.SubscribeResponse, error) {
+	if err := h.newsletterUseCase.Subscribe(ctx, req.GetEmail()); err != nil {
+		return nil, newsletterErrorToStatus(err, "subscribe")
+	}
+	return &newspb.SubscribeResponse{Success: true}, nil
+}
+
+func (h *NewsHandler) ConfirmSubscription(ctx context.Context, req *newspb.ConfirmSubscriptionRequest) (*newspb.ConfirmSubscriptionResponse, error) {
+	if err := h.newsletterUseCase.ConfirmSubscription(ctx, req.GetEmail(), req.GetCode()); err != nil {
+		return nil, newsletterErrorToStatus(err, "confirm subscription")
+	}
+	return &newspb.ConfirmSubscriptionResponse{Success: true}, nil
+}
+
+func (h *NewsHandler) Unsubscribe(ctx context.Context, req *newspb.UnsubscribeRequest) (*newspb.UnsubscribeResponse, error) {
+	if err := h.newsletterUseCase.Unsubscribe(ctx, req.GetToken()); err != nil {
+		return nil, newsletterErrorToStatus(err, "unsubscribe")
+	}
+	return &newspb.UnsubscribeResponse{Success: true}, nil
+}
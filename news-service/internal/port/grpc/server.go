@@ -6,6 +6,9 @@ import (
 
 	"github.com/Abdurahmanit/GroupProject/news-service/internal/config"
 	newspb "github.com/Abdurahmanit/GroupProject/news-service/proto"
+	"github.com/Abdurahmanit/GroupProject/platform/grpcrecovery"
+	"github.com/Abdurahmanit/GroupProject/platform/health"
+	"github.com/Abdurahmanit/GroupProject/platform/logkv"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
@@ -15,17 +18,20 @@ type Server struct {
 	cfg         *config.GRPCConfig
 	logger      *zap.Logger
 	newsService newspb.NewsServiceServer
+	health      *health.Manager
 }
 
 func NewServer(
 	cfg *config.GRPCConfig,
 	logger *zap.Logger,
 	newsService newspb.NewsServiceServer,
+	healthManager *health.Manager,
 ) *Server {
 	return &Server{
 		cfg:         cfg,
 		logger:      logger,
 		newsService: newsService,
+		health:      healthManager,
 	}
 }
 
@@ -40,9 +46,11 @@ func (s *Server) Run() error {
 	grpcServer := grpc.NewServer(
 		grpc.MaxRecvMsgSize(s.cfg.MaxRecvMsgSize),
 		grpc.MaxSendMsgSize(s.cfg.MaxSendMsgSize),
+		grpc.UnaryInterceptor(grpcrecovery.UnaryServerInterceptor(logkv.FromZap(s.logger), nil)),
 	)
 
 	newspb.RegisterNewsServiceServer(grpcServer, s.newsService)
+	s.health.Register(grpcServer)
 	reflection.Register(grpcServer)
 
 	s.logger.Info("gRPC server started", zap.String("address", addr))
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/Abdurahmanit/GroupProject/news-service/internal/entity"
+)
+
+type AnnouncementRepository interface {
+	Create(ctx context.Context, announcement *entity.Announcement) (string, error)
+	GetByID(ctx context.Context, id string) (*entity.Announcement, error)
+	SetActive(ctx context.Context, id string, active bool) error
+	// ListLive returns active announcements whose window contains now, newest first.
+	ListLive(ctx context.Context, now time.Time) ([]*entity.Announcement, error)
+}
@@ -10,8 +10,13 @@ import (
 type CommentRepository interface {
 	Create(ctx context.Context, comment *entity.Comment) (string, error)
 	GetByID(ctx context.Context, id string) (*entity.Comment, error)
+	// GetByNewsID lists the top-level comments of a news article; replies are fetched with GetReplies.
 	GetByNewsID(ctx context.Context, newsID string, page, pageSize int) ([]*entity.Comment, int, error)
+	// GetReplies lists the direct replies to a comment, oldest first.
+	GetReplies(ctx context.Context, parentID string, page, pageSize int) ([]*entity.Comment, int, error)
 	Update(ctx context.Context, comment *entity.Comment) error
 	Delete(ctx context.Context, id string) error
 	DeleteByNewsID(ctx context.Context, newsID string, sessionContext mongo.SessionContext) (int64, error)
+	DeleteByAuthorID(ctx context.Context, userID string) (map[string]int64, error)
+	AnonymizeByAuthorID(ctx context.Context, userID string) (int64, error)
 }
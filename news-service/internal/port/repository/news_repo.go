@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/Abdurahmanit/GroupProject/news-service/internal/entity"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -12,5 +13,19 @@ type NewsRepository interface {
 	GetByID(ctx context.Context, id string) (*entity.News, error)
 	Update(ctx context.Context, news *entity.News) error
 	Delete(ctx context.Context, id string, sessionContext mongo.SessionContext) error
+	IncrementCommentCount(ctx context.Context, id string, delta int64) error
+	// IncrementViewCounts adds the given number of views to each article; unknown IDs are ignored.
+	IncrementViewCounts(ctx context.Context, counts map[string]int64) error
 	List(ctx context.Context, page, pageSize int, filter map[string]interface{}) ([]*entity.News, int, error)
+	// GetAdjacent returns the articles published immediately after (newer) and before (older) news.
+	// An empty category means all categories. Either result is nil at the end of the list.
+	GetAdjacent(ctx context.Context, news *entity.News, category string) (newer, older *entity.News, err error)
+	// Search returns published articles matching the full-text query, best matches first.
+	// An empty category means all categories.
+	Search(ctx context.Context, query, category string, page, pageSize int) ([]*entity.News, int, error)
+	// ListDueScheduled returns scheduled articles whose publish time is not after now.
+	ListDueScheduled(ctx context.Context, now time.Time) ([]*entity.News, error)
+	// MarkPublished switches a scheduled article to published. It returns false if the
+	// article was no longer scheduled, e.g. because another instance published it first.
+	MarkPublished(ctx context.Context, id string, publishedAt time.Time) (bool, error)
 }
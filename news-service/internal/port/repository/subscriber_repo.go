@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Abdurahmanit/GroupProject/news-service/internal/entity"
+)
+
+type SubscriberRepository interface {
+	Create(ctx context.Context, subscriber *entity.Subscriber) (string, error)
+	GetByEmail(ctx context.Context, email string) (*entity.Subscriber, error)
+	// Update stores the confirmation state and verification code of the subscriber.
+	Update(ctx context.Context, subscriber *entity.Subscriber) error
+	// IncrementVerificationAttempts atomically counts a wrong code guess and returns the new count.
+	IncrementVerificationAttempts(ctx context.Context, id string) (int, error)
+	DeleteByUnsubscribeToken(ctx context.Context, token string) error
+	ListConfirmed(ctx context.Context) ([]*entity.Subscriber, error)
+}
@@ -0,0 +1,193 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Abdurahmanit/GroupProject/news-service/internal/entity"
+	"github.com/Abdurahmanit/GroupProject/news-service/internal/port/cache"
+	"github.com/Abdurahmanit/GroupProject/news-service/internal/port/repository"
+	"go.uber.org/zap"
+)
+
+const (
+	adminRole = "admin"
+
+	activeAnnouncementsCacheKey = "announcements:active"
+	activeAnnouncementsCacheTTL = 30 * time.Second
+	maxAnnouncementMessageLen   = 500
+)
+
+var (
+	ErrAdminRequired       = errors.New("admin role required")
+	ErrInvalidAnnouncement = errors.New("invalid announcement")
+)
+
+type UserRoleResolver interface {
+	GetUserRole(ctx context.Context, userID string) (string, error)
+}
+
+type AnnouncementUseCase struct {
+	repo      repository.AnnouncementRepository
+	cacheRepo cache.CacheRepository
+	users     UserRoleResolver
+	logger    *zap.Logger
+	now       func() time.Time
+}
+
+func NewAnnouncementUseCase(ar repository.AnnouncementRepository, cr cache.CacheRepository, users UserRoleResolver, log *zap.Logger) *AnnouncementUseCase {
+	return &AnnouncementUseCase{
+		repo:      ar,
+		cacheRepo: cr,
+		users:     users,
+		logger:    log,
+		now:       time.Now,
+	}
+}
+
+type CreateAnnouncementInput struct {
+	AdminID  string
+	Type     string
+	Message  string
+	StartsAt time.Time // zero means now
+	EndsAt   time.Time // zero means no end
+	Active   bool
+}
+
+func (uc *AnnouncementUseCase) requireAdmin(ctx context.Context, adminID string) error {
+	if adminID == "" {
+		return ErrAdminRequired
+	}
+	role, err := uc.users.GetUserRole(ctx, adminID)
+	if err != nil {
+		return fmt.Errorf("AnnouncementUseCase: failed to resolve role of %s: %w", adminID, err)
+	}
+	if role != adminRole {
+		return ErrAdminRequired
+	}
+	return nil
+}
+
+func (uc *AnnouncementUseCase) CreateAnnouncement(ctx context.Context, input CreateAnnouncementInput) (*entity.Announcement, error) {
+	if err := uc.requireAdmin(ctx, input.AdminID); err != nil {
+		return nil, err
+	}
+
+	message := strings.TrimSpace(input.Message)
+	if message == "" || len([]rune(message)) > maxAnnouncementMessageLen {
+		return nil, fmt.Errorf("%w: message must be 1-%d characters", ErrInvalidAnnouncement, maxAnnouncementMessageLen)
+	}
+	switch input.Type {
+	case entity.AnnouncementTypeInfo, entity.AnnouncementTypeMaintenance, entity.AnnouncementTypePromotion:
+	case "":
+		input.Type = entity.AnnouncementTypeInfo
+	default:
+		return nil, fmt.Errorf("%w: unknown type %q", ErrInvalidAnnouncement, input.Type)
+	}
+
+	now := uc.now()
+	if input.StartsAt.IsZero() {
+		input.StartsAt = now
+	}
+	if !input.EndsAt.IsZero() && !input.EndsAt.After(input.StartsAt) {
+		return nil, fmt.Errorf("%w: ends_at must be after starts_at", ErrInvalidAnnouncement)
+	}
+
+	announcement := &entity.Announcement{
+		Type:      input.Type,
+		Message:   message,
+		Active:    input.Active,
+		StartsAt:  input.StartsAt,
+		EndsAt:    input.EndsAt,
+		CreatedBy: input.AdminID,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	id, err := uc.repo.Create(ctx, announcement)
+	if err != nil {
+		return nil, fmt.Errorf("AnnouncementUseCase.CreateAnnouncement: %w", err)
+	}
+	announcement.ID = id
+	uc.invalidateActive(ctx)
+
+	uc.logger.Info("Announcement created", zap.String("announcement_id", id), zap.String("type", announcement.Type), zap.String("admin_id", input.AdminID))
+	return announcement, nil
+}
+
+// SetAnnouncementActive activates or deactivates an announcement.
+func (uc *AnnouncementUseCase) SetAnnouncementActive(ctx context.Context, adminID, id string, active bool) (*entity.Announcement, error) {
+	if err := uc.requireAdmin(ctx, adminID); err != nil {
+		return nil, err
+	}
+	if err := uc.repo.SetActive(ctx, id, active); err != nil {
+		return nil, err
+	}
+	uc.invalidateActive(ctx)
+
+	uc.logger.Info("Announcement active flag changed", zap.String("announcement_id", id), zap.Bool("active", active), zap.String("admin_id", adminID))
+	return uc.repo.GetByID(ctx, id)
+}
+
+// GetActiveAnnouncements returns the announcements to display right now. The list is cached
+// briefly; announcements whose window ends while cached are filtered out on read.
+func (uc *AnnouncementUseCase) GetActiveAnnouncements(ctx context.Context) ([]*entity.Announcement, error) {
+	now := uc.now()
+	if announcements, ok := uc.cachedActive(ctx); ok {
+		return liveAnnouncements(announcements, now), nil
+	}
+
+	announcements, err := uc.repo.ListLive(ctx, now)
+	if err != nil {
+		return nil, fmt.Errorf("AnnouncementUseCase.GetActiveAnnouncements: %w", err)
+	}
+	if uc.cacheRepo != nil {
+		if data, err := json.Marshal(announcements); err == nil {
+			if err := uc.cacheRepo.Set(ctx, activeAnnouncementsCacheKey, data, activeAnnouncementsCacheTTL); err != nil {
+				uc.logger.Warn("Failed to cache active announcements", zap.Error(err))
+			}
+		}
+	}
+	return announcements, nil
+}
+
+func (uc *AnnouncementUseCase) cachedActive(ctx context.Context) ([]*entity.Announcement, bool) {
+	if uc.cacheRepo == nil {
+		return nil, false
+	}
+	data, err := uc.cacheRepo.Get(ctx, activeAnnouncementsCacheKey)
+	if err != nil {
+		if !errors.Is(err, cache.ErrNotFound) {
+			uc.logger.Warn("Failed to read active announcements from cache", zap.Error(err))
+		}
+		return nil, false
+	}
+	var announcements []*entity.Announcement
+	if err := json.Unmarshal(data, &announcements); err != nil {
+		uc.logger.Warn("Failed to decode cached active announcements", zap.Error(err))
+		return nil, false
+	}
+	return announcements, true
+}
+
+func (uc *AnnouncementUseCase) invalidateActive(ctx context.Context) {
+	if uc.cacheRepo == nil {
+		return
+	}
+	if err := uc.cacheRepo.Delete(ctx, activeAnnouncementsCacheKey); err != nil {
+		uc.logger.Warn("Failed to invalidate active announcements cache", zap.Error(err))
+	}
+}
+
+func liveAnnouncements(announcements []*entity.Announcement, now time.Time) []*entity.Announcement {
+	live := make([]*entity.Announcement, 0, len(announcements))
+	for _, a := range announcements {
+		if a.IsLive(now) {
+			live = append(live, a)
+		}
+	}
+	return live
+}
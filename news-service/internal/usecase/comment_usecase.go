@@ -4,42 +4,101 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/Abdurahmanit/GroupProject/news-service/internal/entity"
 	"github.com/Abdurahmanit/GroupProject/news-service/internal/port/repository"
+	"go.uber.org/zap"
 )
 
+var (
+	ErrInvalidParentComment    = errors.New("parent comment belongs to another news article")
+	ErrReplyTooDeep            = errors.New("reply nesting limit reached")
+	ErrCommentPermissionDenied = errors.New("only the author or an admin can modify this comment")
+	ErrEmptyComment            = errors.New("comment content must not be empty")
+	ErrCommentRemoved          = errors.New("comment has been deleted")
+)
+
+type CommentEventPublisher interface {
+	PublishCommentUpdated(ctx context.Context, comment *entity.Comment) error
+	PublishCommentDeleted(ctx context.Context, comment *entity.Comment) error
+}
+
 type CommentUseCase struct {
-	commentRepo repository.CommentRepository
-	newsRepo    repository.NewsRepository
+	commentRepo              repository.CommentRepository
+	newsRepo                 repository.NewsRepository
+	anonymizeOnAuthorDeleted bool
+	maxReplyDepth            int
+	users                    UserRoleResolver
+	publisher                CommentEventPublisher
+	logger                   *zap.Logger
 }
 
-func NewCommentUseCase(cr repository.CommentRepository, nr repository.NewsRepository) *CommentUseCase {
+// NewCommentUseCase creates the comment use case. maxReplyDepth is the deepest reply level
+// allowed; replies to a top-level comment are at depth 1. users is consulted when someone
+// other than the author edits or deletes a comment.
+func NewCommentUseCase(cr repository.CommentRepository, nr repository.NewsRepository, anonymizeOnAuthorDeleted bool, maxReplyDepth int, users UserRoleResolver, publisher CommentEventPublisher, log *zap.Logger) *CommentUseCase {
 	return &CommentUseCase{
-		commentRepo: cr,
-		newsRepo:    nr,
+		commentRepo:              cr,
+		newsRepo:                 nr,
+		anonymizeOnAuthorDeleted: anonymizeOnAuthorDeleted,
+		maxReplyDepth:            maxReplyDepth,
+		users:                    users,
+		publisher:                publisher,
+		logger:                   log,
 	}
 }
 
 type CreateCommentInput struct {
-	NewsID  string
-	UserID  string
-	Content string
+	NewsID string
+	// ParentID makes the comment a reply; the parent must belong to the same news article.
+	ParentID *string
+	UserID   string
+	Content  string
 }
 
+// CreateComment adds a comment or reply to a published article. Scheduled articles are hidden
+// from readers, so commenting on them fails with repository.ErrNotFound like reading does.
+// Deleted (tombstoned) comments cannot be replied to.
 func (uc *CommentUseCase) CreateComment(ctx context.Context, input CreateCommentInput) (*entity.Comment, error) {
-	_, err := uc.newsRepo.GetByID(ctx, input.NewsID)
+	news, err := uc.newsRepo.GetByID(ctx, input.NewsID)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, fmt.Errorf("news with id %s not found: %w", input.NewsID, err)
 		}
 		return nil, fmt.Errorf("failed to check news existence: %w", err)
 	}
+	if news.IsScheduled() {
+		return nil, fmt.Errorf("news %s is scheduled: %w", input.NewsID, repository.ErrNotFound)
+	}
+
+	depth := 0
+	if input.ParentID != nil {
+		parent, err := uc.commentRepo.GetByID(ctx, *input.ParentID)
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return nil, fmt.Errorf("parent comment with id %s not found: %w", *input.ParentID, err)
+			}
+			return nil, fmt.Errorf("failed to get parent comment: %w", err)
+		}
+		if parent.NewsID != input.NewsID {
+			return nil, ErrInvalidParentComment
+		}
+		if parent.IsRemoved() {
+			return nil, ErrCommentRemoved
+		}
+		depth = parent.Depth + 1
+		if depth > uc.maxReplyDepth {
+			return nil, fmt.Errorf("%w: replies are limited to %d levels", ErrReplyTooDeep, uc.maxReplyDepth)
+		}
+	}
 
 	now := time.Now()
 	comment := &entity.Comment{
 		NewsID:    input.NewsID,
+		ParentID:  input.ParentID,
+		Depth:     depth,
 		UserID:    input.UserID,
 		Content:   input.Content,
 		CreatedAt: now,
@@ -52,6 +111,10 @@ func (uc *CommentUseCase) CreateComment(ctx context.Context, input CreateComment
 	}
 	comment.ID = createdID
 
+	if err := uc.newsRepo.IncrementCommentCount(ctx, input.NewsID, 1); err != nil {
+		return nil, fmt.Errorf("failed to increment comment count: %w", err)
+	}
+
 	return comment, nil
 }
 
@@ -82,26 +145,168 @@ func (uc *CommentUseCase) GetCommentsByNewsID(ctx context.Context, input ListCom
 	return &ListCommentsOutput{Comments: comments, TotalCount: total}, nil
 }
 
-type DeleteCommentInput struct {
+type ListCommentRepliesInput struct {
 	CommentID string
-	UserID    string
+	Page      int
+	PageSize  int
 }
 
-func (uc *CommentUseCase) DeleteComment(ctx context.Context, input DeleteCommentInput) error {
-	_, err := uc.commentRepo.GetByID(ctx, input.CommentID) // Используем _ для comment
+// ListCommentReplies returns the direct replies to a comment, oldest first.
+func (uc *CommentUseCase) ListCommentReplies(ctx context.Context, input ListCommentRepliesInput) (*ListCommentsOutput, error) {
+	if input.Page <= 0 {
+		input.Page = 1
+	}
+	if input.PageSize <= 0 {
+		input.PageSize = 10
+	}
+
+	if _, err := uc.commentRepo.GetByID(ctx, input.CommentID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, repository.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get comment for replies: %w", err)
+	}
+
+	replies, total, err := uc.commentRepo.GetReplies(ctx, input.CommentID, input.Page, input.PageSize)
 	if err != nil {
+		return nil, fmt.Errorf("failed to get comment replies: %w", err)
+	}
+
+	return &ListCommentsOutput{Comments: replies, TotalCount: total}, nil
+}
+
+// EditComment replaces the content of a comment. Only its author or an admin may edit it,
+// and tombstoned comments cannot be edited.
+func (uc *CommentUseCase) EditComment(ctx context.Context, commentID, authorID, newText string) (*entity.Comment, error) {
+	newText = strings.TrimSpace(newText)
+	if newText == "" {
+		return nil, ErrEmptyComment
+	}
+
+	comment, err := uc.getModifiableComment(ctx, commentID, authorID)
+	if err != nil {
+		return nil, err
+	}
+	if comment.IsRemoved() {
+		return nil, ErrCommentRemoved
+	}
+	if comment.Content == newText {
+		return comment, nil
+	}
+
+	now := time.Now()
+	comment.Content = newText
+	comment.EditedAt = &now
+	comment.UpdatedAt = now
+	if err := uc.commentRepo.Update(ctx, comment); err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
-			return repository.ErrNotFound
+			return nil, repository.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to update comment: %w", err)
+	}
+
+	if uc.publisher != nil {
+		if errPub := uc.publisher.PublishCommentUpdated(ctx, comment); errPub != nil {
+			uc.logger.Warn("Failed to publish NATS event for comment updated",
+				zap.Error(errPub),
+				zap.String("comment_id", comment.ID),
+			)
 		}
-		return fmt.Errorf("failed to get comment for deletion check: %w", err)
 	}
+	return comment, nil
+}
 
-	err = uc.commentRepo.Delete(ctx, input.CommentID)
+// DeleteComment soft-deletes a comment by replacing its content with a tombstone, so replies
+// stay attached to the thread. Only its author or an admin may delete it; deleting an already
+// removed comment is a no-op.
+func (uc *CommentUseCase) DeleteComment(ctx context.Context, commentID, authorID string) error {
+	comment, err := uc.getModifiableComment(ctx, commentID, authorID)
 	if err != nil {
+		return err
+	}
+	if comment.IsRemoved() {
+		return nil
+	}
+
+	now := time.Now()
+	comment.Content = entity.DeletedCommentContent
+	comment.RemovedAt = &now
+	comment.UpdatedAt = now
+	if err := uc.commentRepo.Update(ctx, comment); err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return repository.ErrNotFound
 		}
 		return fmt.Errorf("failed to delete comment: %w", err)
 	}
+
+	if err := uc.newsRepo.IncrementCommentCount(ctx, comment.NewsID, -1); err != nil && !errors.Is(err, repository.ErrNotFound) {
+		return fmt.Errorf("failed to decrement comment count: %w", err)
+	}
+
+	if uc.publisher != nil {
+		if errPub := uc.publisher.PublishCommentDeleted(ctx, comment); errPub != nil {
+			uc.logger.Warn("Failed to publish NATS event for comment deleted",
+				zap.Error(errPub),
+				zap.String("comment_id", comment.ID),
+			)
+		}
+	}
+	return nil
+}
+
+// getModifiableComment loads a comment and checks that userID is its author or an admin.
+func (uc *CommentUseCase) getModifiableComment(ctx context.Context, commentID, userID string) (*entity.Comment, error) {
+	if userID == "" {
+		return nil, ErrCommentPermissionDenied
+	}
+
+	comment, err := uc.commentRepo.GetByID(ctx, commentID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, repository.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get comment: %w", err)
+	}
+	if comment.UserID == userID {
+		return comment, nil
+	}
+
+	if uc.users == nil {
+		return nil, ErrCommentPermissionDenied
+	}
+	role, err := uc.users.GetUserRole(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve role of %s: %w", userID, err)
+	}
+	if role != adminRole {
+		return nil, ErrCommentPermissionDenied
+	}
+	return comment, nil
+}
+
+// HandleAuthorDeleted cleans up the comments of a deleted user. Depending on configuration the
+// comments are either anonymized or soft-deleted; in the latter case the comment counters of the
+// affected news are decremented. Processing the same user twice is a no-op.
+func (uc *CommentUseCase) HandleAuthorDeleted(ctx context.Context, userID string) error {
+	if userID == "" {
+		return fmt.Errorf("user id is required")
+	}
+
+	if uc.anonymizeOnAuthorDeleted {
+		if _, err := uc.commentRepo.AnonymizeByAuthorID(ctx, userID); err != nil {
+			return fmt.Errorf("failed to anonymize comments of deleted user: %w", err)
+		}
+		return nil
+	}
+
+	deletedPerNews, err := uc.commentRepo.DeleteByAuthorID(ctx, userID)
+	for newsID, count := range deletedPerNews {
+		if incErr := uc.newsRepo.IncrementCommentCount(ctx, newsID, -count); incErr != nil && !errors.Is(incErr, repository.ErrNotFound) {
+			return fmt.Errorf("failed to decrement comment count for news %s: %w", newsID, incErr)
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete comments of deleted user: %w", err)
+	}
 	return nil
 }
@@ -0,0 +1,262 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Abdurahmanit/GroupProject/news-service/internal/entity"
+	"github.com/Abdurahmanit/GroupProject/news-service/internal/port/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+type MockUserRoleResolver struct{ mock.Mock }
+
+func (m *MockUserRoleResolver) GetUserRole(ctx context.Context, userID string) (string, error) {
+	args := m.Called(ctx, userID)
+	return args.String(0), args.Error(1)
+}
+
+type MockCommentEventPublisher struct{ mock.Mock }
+
+func (m *MockCommentEventPublisher) PublishCommentUpdated(ctx context.Context, comment *entity.Comment) error {
+	args := m.Called(ctx, comment)
+	return args.Error(0)
+}
+func (m *MockCommentEventPublisher) PublishCommentDeleted(ctx context.Context, comment *entity.Comment) error {
+	args := m.Called(ctx, comment)
+	return args.Error(0)
+}
+
+func TestCommentUseCase_HandleAuthorDeleted(t *testing.T) {
+	ctx := context.Background()
+	userID := "user123"
+
+	t.Run("DeleteMode_DecrementsCommentCounts", func(t *testing.T) {
+		mockCommentRepo := new(MockCommentRepository)
+		mockNewsRepo := new(MockNewsRepository)
+		uc := NewCommentUseCase(mockCommentRepo, mockNewsRepo, false, 3, nil, nil, zap.NewNop())
+
+		mockCommentRepo.On("DeleteByAuthorID", ctx, userID).Return(map[string]int64{"news1": 2, "news2": 1}, nil).Once()
+		mockNewsRepo.On("IncrementCommentCount", ctx, "news1", int64(-2)).Return(nil).Once()
+		mockNewsRepo.On("IncrementCommentCount", ctx, "news2", int64(-1)).Return(repository.ErrNotFound).Once()
+
+		err := uc.HandleAuthorDeleted(ctx, userID)
+
+		assert.NoError(t, err)
+		mockCommentRepo.AssertExpectations(t)
+		mockNewsRepo.AssertExpectations(t)
+		mockCommentRepo.AssertNotCalled(t, "AnonymizeByAuthorID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("DeleteMode_AlreadyProcessedIsNoop", func(t *testing.T) {
+		mockCommentRepo := new(MockCommentRepository)
+		mockNewsRepo := new(MockNewsRepository)
+		uc := NewCommentUseCase(mockCommentRepo, mockNewsRepo, false, 3, nil, nil, zap.NewNop())
+
+		mockCommentRepo.On("DeleteByAuthorID", ctx, userID).Return(map[string]int64{}, nil).Once()
+
+		err := uc.HandleAuthorDeleted(ctx, userID)
+
+		assert.NoError(t, err)
+		mockNewsRepo.AssertNotCalled(t, "IncrementCommentCount", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("AnonymizeMode_KeepsCounts", func(t *testing.T) {
+		mockCommentRepo := new(MockCommentRepository)
+		mockNewsRepo := new(MockNewsRepository)
+		uc := NewCommentUseCase(mockCommentRepo, mockNewsRepo, true, 3, nil, nil, zap.NewNop())
+
+		mockCommentRepo.On("AnonymizeByAuthorID", ctx, userID).Return(int64(3), nil).Once()
+
+		err := uc.HandleAuthorDeleted(ctx, userID)
+
+		assert.NoError(t, err)
+		mockCommentRepo.AssertExpectations(t)
+		mockCommentRepo.AssertNotCalled(t, "DeleteByAuthorID", mock.Anything, mock.Anything)
+		mockNewsRepo.AssertNotCalled(t, "IncrementCommentCount", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("RepositoryError", func(t *testing.T) {
+		mockCommentRepo := new(MockCommentRepository)
+		mockNewsRepo := new(MockNewsRepository)
+		uc := NewCommentUseCase(mockCommentRepo, mockNewsRepo, true, 3, nil, nil, zap.NewNop())
+
+		mockCommentRepo.On("AnonymizeByAuthorID", ctx, userID).Return(int64(0), errors.New("mongo down")).Once()
+
+		err := uc.HandleAuthorDeleted(ctx, userID)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestCommentUseCase_CreateComment_ScheduledNewsIsNotFound(t *testing.T) {
+	ctx := context.Background()
+	mockCommentRepo := new(MockCommentRepository)
+	mockNewsRepo := new(MockNewsRepository)
+	uc := NewCommentUseCase(mockCommentRepo, mockNewsRepo, false, 3, nil, nil, zap.NewNop())
+
+	mockNewsRepo.On("GetByID", ctx, "news1").Return(&entity.News{ID: "news1", Status: entity.NewsStatusScheduled}, nil).Once()
+
+	_, err := uc.CreateComment(ctx, CreateCommentInput{NewsID: "news1", UserID: "u1", Content: "first"})
+
+	assert.ErrorIs(t, err, repository.ErrNotFound)
+	mockCommentRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	mockNewsRepo.AssertNotCalled(t, "IncrementCommentCount", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCommentUseCase_CreateReply(t *testing.T) {
+	ctx := context.Background()
+	parentID := "parent1"
+
+	t.Run("NestsUnderParent", func(t *testing.T) {
+		mockCommentRepo := new(MockCommentRepository)
+		mockNewsRepo := new(MockNewsRepository)
+		uc := NewCommentUseCase(mockCommentRepo, mockNewsRepo, false, 3, nil, nil, zap.NewNop())
+
+		mockNewsRepo.On("GetByID", ctx, "news1").Return(&entity.News{ID: "news1"}, nil).Once()
+		mockCommentRepo.On("GetByID", ctx, parentID).Return(&entity.Comment{ID: parentID, NewsID: "news1", Depth: 1}, nil).Once()
+		mockCommentRepo.On("Create", ctx, mock.MatchedBy(func(c *entity.Comment) bool {
+			return c.ParentID != nil && *c.ParentID == parentID && c.Depth == 2
+		})).Return("reply1", nil).Once()
+		mockNewsRepo.On("IncrementCommentCount", ctx, "news1", int64(1)).Return(nil).Once()
+
+		reply, err := uc.CreateComment(ctx, CreateCommentInput{NewsID: "news1", ParentID: &parentID, UserID: "u1", Content: "agreed"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "reply1", reply.ID)
+		mockCommentRepo.AssertExpectations(t)
+		mockNewsRepo.AssertExpectations(t)
+	})
+
+	t.Run("ParentFromOtherNews", func(t *testing.T) {
+		mockCommentRepo := new(MockCommentRepository)
+		mockNewsRepo := new(MockNewsRepository)
+		uc := NewCommentUseCase(mockCommentRepo, mockNewsRepo, false, 3, nil, nil, zap.NewNop())
+
+		mockNewsRepo.On("GetByID", ctx, "news1").Return(&entity.News{ID: "news1"}, nil).Once()
+		mockCommentRepo.On("GetByID", ctx, parentID).Return(&entity.Comment{ID: parentID, NewsID: "news2"}, nil).Once()
+
+		_, err := uc.CreateComment(ctx, CreateCommentInput{NewsID: "news1", ParentID: &parentID, UserID: "u1", Content: "hi"})
+
+		assert.ErrorIs(t, err, ErrInvalidParentComment)
+		mockCommentRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("DeletedParent", func(t *testing.T) {
+		mockCommentRepo := new(MockCommentRepository)
+		mockNewsRepo := new(MockNewsRepository)
+		uc := NewCommentUseCase(mockCommentRepo, mockNewsRepo, false, 3, nil, nil, zap.NewNop())
+		removedAt := time.Now()
+
+		mockNewsRepo.On("GetByID", ctx, "news1").Return(&entity.News{ID: "news1"}, nil).Once()
+		mockCommentRepo.On("GetByID", ctx, parentID).Return(&entity.Comment{ID: parentID, NewsID: "news1", Content: entity.DeletedCommentContent, RemovedAt: &removedAt}, nil).Once()
+
+		_, err := uc.CreateComment(ctx, CreateCommentInput{NewsID: "news1", ParentID: &parentID, UserID: "u1", Content: "hi"})
+
+		assert.ErrorIs(t, err, ErrCommentRemoved)
+		mockCommentRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("DepthLimit", func(t *testing.T) {
+		mockCommentRepo := new(MockCommentRepository)
+		mockNewsRepo := new(MockNewsRepository)
+		uc := NewCommentUseCase(mockCommentRepo, mockNewsRepo, false, 2, nil, nil, zap.NewNop())
+
+		mockNewsRepo.On("GetByID", ctx, "news1").Return(&entity.News{ID: "news1"}, nil).Once()
+		mockCommentRepo.On("GetByID", ctx, parentID).Return(&entity.Comment{ID: parentID, NewsID: "news1", Depth: 2}, nil).Once()
+
+		_, err := uc.CreateComment(ctx, CreateCommentInput{NewsID: "news1", ParentID: &parentID, UserID: "u1", Content: "hi"})
+
+		assert.ErrorIs(t, err, ErrReplyTooDeep)
+		mockCommentRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestCommentUseCase_EditAndDelete(t *testing.T) {
+	ctx := context.Background()
+
+	newComment := func() *entity.Comment {
+		return &entity.Comment{ID: "c1", NewsID: "news1", UserID: "author1", Content: "original"}
+	}
+
+	t.Run("AuthorEdits", func(t *testing.T) {
+		mockCommentRepo := new(MockCommentRepository)
+		mockPublisher := new(MockCommentEventPublisher)
+		uc := NewCommentUseCase(mockCommentRepo, new(MockNewsRepository), false, 3, new(MockUserRoleResolver), mockPublisher, zap.NewNop())
+
+		mockCommentRepo.On("GetByID", ctx, "c1").Return(newComment(), nil).Once()
+		mockCommentRepo.On("Update", ctx, mock.MatchedBy(func(c *entity.Comment) bool {
+			return c.Content == "fixed" && c.EditedAt != nil
+		})).Return(nil).Once()
+		mockPublisher.On("PublishCommentUpdated", ctx, mock.Anything).Return(nil).Once()
+
+		comment, err := uc.EditComment(ctx, "c1", "author1", "  fixed ")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "fixed", comment.Content)
+		assert.NotNil(t, comment.EditedAt)
+		mockCommentRepo.AssertExpectations(t)
+		mockPublisher.AssertExpectations(t)
+	})
+
+	t.Run("OtherUserIsDenied", func(t *testing.T) {
+		mockCommentRepo := new(MockCommentRepository)
+		mockUsers := new(MockUserRoleResolver)
+		uc := NewCommentUseCase(mockCommentRepo, new(MockNewsRepository), false, 3, mockUsers, new(MockCommentEventPublisher), zap.NewNop())
+
+		mockCommentRepo.On("GetByID", ctx, "c1").Return(newComment(), nil).Twice()
+		mockUsers.On("GetUserRole", ctx, "intruder").Return("user", nil).Twice()
+
+		_, err := uc.EditComment(ctx, "c1", "intruder", "hijacked")
+		assert.ErrorIs(t, err, ErrCommentPermissionDenied)
+
+		err = uc.DeleteComment(ctx, "c1", "intruder")
+		assert.ErrorIs(t, err, ErrCommentPermissionDenied)
+
+		mockCommentRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("AdminDeletesWithTombstone", func(t *testing.T) {
+		mockCommentRepo := new(MockCommentRepository)
+		mockNewsRepo := new(MockNewsRepository)
+		mockUsers := new(MockUserRoleResolver)
+		mockPublisher := new(MockCommentEventPublisher)
+		uc := NewCommentUseCase(mockCommentRepo, mockNewsRepo, false, 3, mockUsers, mockPublisher, zap.NewNop())
+
+		mockCommentRepo.On("GetByID", ctx, "c1").Return(newComment(), nil).Once()
+		mockUsers.On("GetUserRole", ctx, "admin1").Return(adminRole, nil).Once()
+		mockCommentRepo.On("Update", ctx, mock.MatchedBy(func(c *entity.Comment) bool {
+			return c.Content == entity.DeletedCommentContent && c.IsRemoved() && c.UserID == "author1"
+		})).Return(nil).Once()
+		mockNewsRepo.On("IncrementCommentCount", ctx, "news1", int64(-1)).Return(nil).Once()
+		mockPublisher.On("PublishCommentDeleted", ctx, mock.Anything).Return(nil).Once()
+
+		err := uc.DeleteComment(ctx, "c1", "admin1")
+
+		assert.NoError(t, err)
+		mockCommentRepo.AssertExpectations(t)
+		mockNewsRepo.AssertExpectations(t)
+		mockPublisher.AssertExpectations(t)
+		mockCommentRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("DeletedCommentCannotBeEdited", func(t *testing.T) {
+		mockCommentRepo := new(MockCommentRepository)
+		uc := NewCommentUseCase(mockCommentRepo, new(MockNewsRepository), false, 3, nil, nil, zap.NewNop())
+
+		removed := newComment()
+		removedAt := removed.CreatedAt
+		removed.Content = entity.DeletedCommentContent
+		removed.RemovedAt = &removedAt
+		mockCommentRepo.On("GetByID", ctx, "c1").Return(removed, nil).Once()
+
+		_, err := uc.EditComment(ctx, "c1", "author1", "back again")
+
+		assert.ErrorIs(t, err, ErrCommentRemoved)
+		mockCommentRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
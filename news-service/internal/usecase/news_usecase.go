@@ -5,11 +5,14 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/Abdurahmanit/GroupProject/news-service/internal/entity"
 	"github.com/Abdurahmanit/GroupProject/news-service/internal/port/cache"
 	"github.com/Abdurahmanit/GroupProject/news-service/internal/port/repository"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.mongodb.org/mongo-driver/mongo/readconcern"
@@ -17,6 +20,17 @@ import (
 	"go.uber.org/zap"
 )
 
+// ErrNoTags is returned when a tag listing is requested without any usable tag.
+var ErrNoTags = errors.New("at least one tag is required")
+
+// ErrEmptySearchQuery is returned when a search is requested without any search terms.
+var ErrEmptySearchQuery = errors.New("search query is required")
+
+// ErrInvalidTrendingWindow is returned when trending news are requested for a non-positive window.
+var ErrInvalidTrendingWindow = errors.New("trending window must be positive")
+
+const maxTrendingLimit = 100
+
 type NATSPublisherInterface interface {
 	PublishNewsCreated(ctx context.Context, news *entity.News) error
 	PublishNewsUpdated(ctx context.Context, news *entity.News) error
@@ -29,6 +43,7 @@ type EmailSenderInterface interface {
 
 type UserServiceClientInterface interface {
 	GetAuthorEmail(ctx context.Context, authorID string) (string, error)
+	GetUserRole(ctx context.Context, userID string) (string, error)
 	Close() error
 }
 
@@ -41,43 +56,59 @@ type NewsUseCase struct {
 	cacheRepo         cache.CacheRepository
 	emailSender       EmailSenderInterface
 	userServiceClient UserServiceClientInterface
+	viewCounter       cache.ViewCounter
 	logger            *zap.Logger
 }
 
-func NewNewsUseCase(
-	mc *mongo.Client,
-	nr repository.NewsRepository,
-	cmr repository.CommentRepository,
-	lr repository.LikeRepository,
-	np NATSPublisherInterface,
-	cr cache.CacheRepository,
-	es EmailSenderInterface,
-	usc UserServiceClientInterface,
-	log *zap.Logger,
-) *NewsUseCase {
+// NewsUseCaseDeps holds the collaborators of a NewsUseCase besides the news repository.
+// Tests may leave out the ones the code under test doesn't reach.
+type NewsUseCaseDeps struct {
+	MongoClient       *mongo.Client
+	CommentRepo       repository.CommentRepository
+	LikeRepo          repository.LikeRepository
+	NATSPublisher     NATSPublisherInterface
+	CacheRepo         cache.CacheRepository
+	EmailSender       EmailSenderInterface
+	UserServiceClient UserServiceClientInterface
+	ViewCounter       cache.ViewCounter
+}
+
+func NewNewsUseCase(nr repository.NewsRepository, deps NewsUseCaseDeps, log *zap.Logger) *NewsUseCase {
 	return &NewsUseCase{
-		mongoClient:       mc,
+		mongoClient:       deps.MongoClient,
 		newsRepo:          nr,
-		commentRepo:       cmr,
-		likeRepo:          lr,
-		natsPublisher:     np,
-		cacheRepo:         cr,
-		emailSender:       es,
-		userServiceClient: usc,
+		commentRepo:       deps.CommentRepo,
+		likeRepo:          deps.LikeRepo,
+		natsPublisher:     deps.NATSPublisher,
+		cacheRepo:         deps.CacheRepo,
+		emailSender:       deps.EmailSender,
+		userServiceClient: deps.UserServiceClient,
+		viewCounter:       deps.ViewCounter,
 		logger:            log,
 	}
 }
 
+// CreateNews publishes the article right away, or schedules it when input.PublishAt is in the
+// future. A scheduled article is announced by PublishDueNews once its time comes.
 func (uc *NewsUseCase) CreateNews(ctx context.Context, input CreateNewsInput) (*entity.News, error) {
 	now := time.Now()
 	news := &entity.News{
-		Title:     input.Title,
-		Content:   input.Content,
-		AuthorID:  input.AuthorID,
-		ImageURL:  input.ImageURL,
-		Category:  input.Category,
-		CreatedAt: now,
-		UpdatedAt: now,
+		Title:       input.Title,
+		Content:     input.Content,
+		AuthorID:    input.AuthorID,
+		ImageURL:    input.ImageURL,
+		Category:    input.Category,
+		Tags:        normalizeTags(input.Tags),
+		Status:      entity.NewsStatusPublished,
+		PublishedAt: &now,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if input.PublishAt != nil && input.PublishAt.After(now) {
+		publishAt := input.PublishAt.UTC()
+		news.Status = entity.NewsStatusScheduled
+		news.PublishAt = &publishAt
+		news.PublishedAt = nil
 	}
 
 	createdID, err := uc.newsRepo.Create(ctx, news)
@@ -105,6 +136,19 @@ func (uc *NewsUseCase) CreateNews(ctx context.Context, input CreateNewsInput) (*
 		}
 	}
 
+	if news.IsScheduled() {
+		uc.logger.Info("News scheduled for publication",
+			zap.String("news_id", news.ID),
+			zap.Time("publish_at", *news.PublishAt),
+		)
+		return news, nil
+	}
+	uc.announcePublished(ctx, news)
+	return news, nil
+}
+
+// announcePublished emits news.created and emails the author. Failures are only logged.
+func (uc *NewsUseCase) announcePublished(ctx context.Context, news *entity.News) {
 	if uc.natsPublisher != nil {
 		if errPub := uc.natsPublisher.PublishNewsCreated(ctx, news); errPub != nil {
 			uc.logger.Warn("Failed to publish NATS event for news created",
@@ -147,8 +191,6 @@ func (uc *NewsUseCase) CreateNews(ctx context.Context, input CreateNewsInput) (*
 			)
 		}
 	}
-
-	return news, nil
 }
 
 func newsCacheKey(newsID string) string {
@@ -157,12 +199,26 @@ func newsCacheKey(newsID string) string {
 
 const newsCacheTTL = 5 * time.Minute
 
+func adjacentNewsCacheKey(newsID string, sameCategory bool) string {
+	if sameCategory {
+		return fmt.Sprintf("news:adjacent:%s:category", newsID)
+	}
+	return fmt.Sprintf("news:adjacent:%s", newsID)
+}
+
+// adjacentNewsCacheTTL is kept short because publishing or deleting an article
+// changes its neighbours' links and those keys are not invalidated explicitly.
+const adjacentNewsCacheTTL = time.Minute
+
 type CreateNewsInput struct {
 	Title    string
 	Content  string
 	AuthorID string
 	ImageURL string
 	Category string
+	Tags     []string
+	// PublishAt schedules the article; nil or a time in the past publishes it immediately.
+	PublishAt *time.Time
 }
 
 type UpdateNewsInput struct {
@@ -171,9 +227,69 @@ type UpdateNewsInput struct {
 	Content  *string
 	ImageURL *string
 	Category *string
+	// Tags replaces the article's tags when set; an empty slice removes them all.
+	Tags *[]string
+}
+
+// GetNewsByID returns repository.ErrNotFound for scheduled articles unless includeScheduled
+// is set, so readers cannot see them before their publish time. Only an admin requester may
+// set includeScheduled; anyone else gets ErrAdminRequired.
+func (uc *NewsUseCase) GetNewsByID(ctx context.Context, id string, includeScheduled bool, requesterID string) (*entity.News, error) {
+	if includeScheduled {
+		if err := uc.requireAdmin(ctx, requesterID); err != nil {
+			return nil, err
+		}
+	}
+	news, err := uc.getVisibleNews(ctx, id, includeScheduled)
+	if err != nil {
+		return nil, err
+	}
+	if !news.IsScheduled() {
+		uc.recordView(ctx, news.ID)
+	}
+	return news, nil
+}
+
+// getVisibleNews is GetNewsByID without counting a view.
+func (uc *NewsUseCase) getVisibleNews(ctx context.Context, id string, includeScheduled bool) (*entity.News, error) {
+	news, err := uc.getNews(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if news.IsScheduled() && !includeScheduled {
+		return nil, fmt.Errorf("NewsUseCase.GetNewsByID: news %s is scheduled: %w", id, repository.ErrNotFound)
+	}
+	return news, nil
+}
+
+// requireAdmin fails closed: without a user service client nobody is treated as an admin.
+func (uc *NewsUseCase) requireAdmin(ctx context.Context, userID string) error {
+	if userID == "" || uc.userServiceClient == nil {
+		return ErrAdminRequired
+	}
+	role, err := uc.userServiceClient.GetUserRole(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("NewsUseCase: failed to resolve role of %s: %w", userID, err)
+	}
+	if role != adminRole {
+		return ErrAdminRequired
+	}
+	return nil
+}
+
+// recordView counts a view in the cache; the database is updated by FlushViewCounts.
+// Failures are only logged so reading an article never fails because of the counter.
+func (uc *NewsUseCase) recordView(ctx context.Context, newsID string) {
+	if uc.viewCounter == nil {
+		return
+	}
+	if err := uc.viewCounter.RecordView(ctx, newsID, time.Now()); err != nil {
+		uc.logger.Warn("Failed to record news view", zap.Error(err), zap.String("news_id", newsID))
+	}
 }
 
-func (uc *NewsUseCase) GetNewsByID(ctx context.Context, id string) (*entity.News, error) {
+// getNews reads the article through the cache regardless of its status.
+func (uc *NewsUseCase) getNews(ctx context.Context, id string) (*entity.News, error) {
 	if uc.cacheRepo != nil {
 		key := newsCacheKey(id)
 		cachedBytes, err := uc.cacheRepo.Get(ctx, key)
@@ -253,6 +369,12 @@ func (uc *NewsUseCase) UpdateNews(ctx context.Context, input UpdateNewsInput) (*
 		news.Category = *input.Category
 		updated = true
 	}
+	if input.Tags != nil {
+		if tags := normalizeTags(*input.Tags); !slices.Equal(news.Tags, tags) {
+			news.Tags = tags
+			updated = true
+		}
+	}
 
 	if !updated {
 		uc.logger.Info("No actual changes detected for news update", zap.String("news_id", input.ID))
@@ -279,7 +401,8 @@ func (uc *NewsUseCase) UpdateNews(ctx context.Context, input UpdateNewsInput) (*
 		}
 	}
 
-	if uc.natsPublisher != nil {
+	// Readers have not seen a scheduled article yet, so there is nothing to update for them.
+	if uc.natsPublisher != nil && !news.IsScheduled() {
 		if errPub := uc.natsPublisher.PublishNewsUpdated(ctx, news); errPub != nil {
 			uc.logger.Warn("Failed to publish NATS event for news updated",
 				zap.Error(errPub),
@@ -367,7 +490,7 @@ func (uc *NewsUseCase) DeleteNewsAndAssociatedData(ctx context.Context, newsID s
 }
 
 func (uc *NewsUseCase) DeleteNews(ctx context.Context, id string) error {
-	_, err := uc.GetNewsByID(ctx, id)
+	_, err := uc.getNews(ctx, id)
 	if err != nil {
 		return fmt.Errorf("NewsUseCase.DeleteNews: news to delete not found or error getting it: %w", err)
 	}
@@ -407,6 +530,9 @@ type ListNewsInput struct {
 	Page     int
 	PageSize int
 	Filter   map[string]interface{}
+	// IncludeScheduled also lists articles waiting for their publish time; only for an admin RequesterID.
+	IncludeScheduled bool
+	RequesterID      string
 }
 
 type ListNewsByCategoryInput struct {
@@ -420,6 +546,55 @@ type ListNewsOutput struct {
 	TotalCount int
 }
 
+type AdjacentNewsOutput struct {
+	Newer *entity.News `json:"newer,omitempty"`
+	Older *entity.News `json:"older,omitempty"`
+}
+
+// GetAdjacentNews returns the articles published immediately before and after newsID,
+// optionally limited to its category. Newer or Older is nil at the ends of the list.
+func (uc *NewsUseCase) GetAdjacentNews(ctx context.Context, newsID string, sameCategory bool) (*AdjacentNewsOutput, error) {
+	key := adjacentNewsCacheKey(newsID, sameCategory)
+	if uc.cacheRepo != nil {
+		cachedBytes, err := uc.cacheRepo.Get(ctx, key)
+		if err == nil {
+			var output AdjacentNewsOutput
+			if unmarshalErr := json.Unmarshal(cachedBytes, &output); unmarshalErr == nil {
+				uc.logger.Debug("Adjacent news fetched from cache", zap.String("key", key))
+				return &output, nil
+			}
+			uc.logger.Warn("Failed to unmarshal adjacent news from cache", zap.String("key", key))
+		} else if !errors.Is(err, cache.ErrNotFound) {
+			uc.logger.Warn("Failed to get adjacent news from cache (not a cache miss)", zap.Error(err), zap.String("key", key))
+		}
+	}
+
+	news, err := uc.getVisibleNews(ctx, newsID, false)
+	if err != nil {
+		return nil, fmt.Errorf("NewsUseCase.GetAdjacentNews: %w", err)
+	}
+
+	category := ""
+	if sameCategory {
+		category = news.Category
+	}
+	newer, older, err := uc.newsRepo.GetAdjacent(ctx, news, category)
+	if err != nil {
+		uc.logger.Error("Failed to get adjacent news from repository", zap.Error(err), zap.String("news_id", newsID))
+		return nil, fmt.Errorf("NewsUseCase.GetAdjacentNews: failed to get adjacent news from repo: %w", err)
+	}
+	output := &AdjacentNewsOutput{Newer: newer, Older: older}
+
+	if uc.cacheRepo != nil {
+		if outputBytes, marshalErr := json.Marshal(output); marshalErr == nil {
+			if setErr := uc.cacheRepo.Set(ctx, key, outputBytes, adjacentNewsCacheTTL); setErr != nil {
+				uc.logger.Warn("Failed to set adjacent news in cache", zap.Error(setErr), zap.String("key", key))
+			}
+		}
+	}
+	return output, nil
+}
+
 func (uc *NewsUseCase) ListNews(ctx context.Context, input ListNewsInput) (*ListNewsOutput, error) {
 	if input.Page <= 0 {
 		input.Page = 1
@@ -428,7 +603,16 @@ func (uc *NewsUseCase) ListNews(ctx context.Context, input ListNewsInput) (*List
 		input.PageSize = 10
 	}
 
-	newsList, total, err := uc.newsRepo.List(ctx, input.Page, input.PageSize, input.Filter)
+	filter := input.Filter
+	if input.IncludeScheduled {
+		if err := uc.requireAdmin(ctx, input.RequesterID); err != nil {
+			return nil, err
+		}
+	} else {
+		filter = withoutScheduled(filter)
+	}
+
+	newsList, total, err := uc.newsRepo.List(ctx, input.Page, input.PageSize, filter)
 	if err != nil {
 		uc.logger.Error("Failed to list news from repository", zap.Error(err), zap.Any("input", input))
 		return nil, fmt.Errorf("NewsUseCase.ListNews: failed to list news from repo: %w", err)
@@ -452,6 +636,7 @@ func (uc *NewsUseCase) ListNewsByCategory(ctx context.Context, input ListNewsByC
 		uc.logger.Warn("Listing news by empty category, will fetch all if category filter is not strictly enforced by DB")
 		delete(filter, "category")
 	}
+	filter = withoutScheduled(filter)
 
 	newsList, total, err := uc.newsRepo.List(ctx, input.Page, input.PageSize, filter)
 	if err != nil {
@@ -461,3 +646,233 @@ func (uc *NewsUseCase) ListNewsByCategory(ctx context.Context, input ListNewsByC
 
 	return &ListNewsOutput{News: newsList, TotalCount: total}, nil
 }
+
+// ListNewsByTag lists published articles carrying tag, newest first.
+func (uc *NewsUseCase) ListNewsByTag(ctx context.Context, tag string, page, pageSize int) (*ListNewsOutput, error) {
+	return uc.ListNewsByTags(ctx, []string{tag}, page, pageSize)
+}
+
+// ListNewsByTags lists published articles carrying any of tags, newest first.
+func (uc *NewsUseCase) ListNewsByTags(ctx context.Context, tags []string, page, pageSize int) (*ListNewsOutput, error) {
+	tags = normalizeTags(tags)
+	if len(tags) == 0 {
+		return nil, fmt.Errorf("NewsUseCase.ListNewsByTags: %w", ErrNoTags)
+	}
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	filter := withoutScheduled(map[string]interface{}{
+		"tags": bson.M{"$in": tags},
+	})
+
+	newsList, total, err := uc.newsRepo.List(ctx, page, pageSize, filter)
+	if err != nil {
+		uc.logger.Error("Failed to list news by tags from repository", zap.Error(err), zap.Strings("tags", tags))
+		return nil, fmt.Errorf("NewsUseCase.ListNewsByTags: failed to list news: %w", err)
+	}
+
+	return &ListNewsOutput{News: newsList, TotalCount: total}, nil
+}
+
+// SearchNews runs a full-text search over the titles and contents of published articles and
+// returns one page of results ordered by relevance. No match yields an empty page.
+func (uc *NewsUseCase) SearchNews(ctx context.Context, query string, page, pageSize int) (*ListNewsOutput, error) {
+	return uc.SearchNewsInCategory(ctx, query, "", page, pageSize)
+}
+
+// SearchNewsInCategory is SearchNews limited to one category; an empty category searches all.
+func (uc *NewsUseCase) SearchNewsInCategory(ctx context.Context, query, category string, page, pageSize int) (*ListNewsOutput, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, fmt.Errorf("NewsUseCase.SearchNews: %w", ErrEmptySearchQuery)
+	}
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	newsList, total, err := uc.newsRepo.Search(ctx, query, category, page, pageSize)
+	if err != nil {
+		uc.logger.Error("Failed to search news in repository", zap.Error(err), zap.String("query", query), zap.String("category", category))
+		return nil, fmt.Errorf("NewsUseCase.SearchNews: failed to search news: %w", err)
+	}
+	if newsList == nil {
+		newsList = []*entity.News{}
+	}
+
+	return &ListNewsOutput{News: newsList, TotalCount: total}, nil
+}
+
+// normalizeTags lowercases and trims tags, dropping empty ones and duplicates while keeping
+// the original order.
+func normalizeTags(tags []string) []string {
+	if len(tags) == 0 {
+		return nil
+	}
+	seen := make(map[string]struct{}, len(tags))
+	result := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" {
+			continue
+		}
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		result = append(result, tag)
+	}
+	if len(result) == 0 {
+		return nil
+	}
+	return result
+}
+
+// withoutScheduled returns a copy of filter that also excludes scheduled articles. Articles
+// without a status predate scheduling and stay visible.
+func withoutScheduled(filter map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(filter)+1)
+	for k, v := range filter {
+		result[k] = v
+	}
+	result["status"] = bson.M{"$ne": entity.NewsStatusScheduled}
+	return result
+}
+
+// PublishDueNews publishes every scheduled article whose time has come and announces it
+// like a freshly created one. It returns how many articles this call published.
+func (uc *NewsUseCase) PublishDueNews(ctx context.Context) (int, error) {
+	now := time.Now()
+	due, err := uc.newsRepo.ListDueScheduled(ctx, now)
+	if err != nil {
+		return 0, fmt.Errorf("NewsUseCase.PublishDueNews: failed to list due news: %w", err)
+	}
+
+	published := 0
+	for _, news := range due {
+		switched, err := uc.newsRepo.MarkPublished(ctx, news.ID, now)
+		if err != nil {
+			uc.logger.Error("Failed to publish scheduled news", zap.Error(err), zap.String("news_id", news.ID))
+			continue
+		}
+		if !switched {
+			// Another instance got there first and has announced it.
+			continue
+		}
+		news.Status = entity.NewsStatusPublished
+		news.PublishedAt = &now
+		news.UpdatedAt = now
+		published++
+
+		if uc.cacheRepo != nil {
+			key := newsCacheKey(news.ID)
+			if delErr := uc.cacheRepo.Delete(ctx, key); delErr != nil {
+				uc.logger.Warn("Failed to delete news from cache after scheduled publication",
+					zap.Error(delErr),
+					zap.String("key", key),
+				)
+			}
+		}
+		uc.logger.Info("Scheduled news published", zap.String("news_id", news.ID))
+		uc.announcePublished(ctx, news)
+	}
+	return published, nil
+}
+
+// RunScheduledPublisher calls PublishDueNews every interval until ctx is cancelled.
+func (uc *NewsUseCase) RunScheduledPublisher(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := uc.PublishDueNews(ctx); err != nil {
+			uc.logger.Error("Scheduled publication run failed", zap.Error(err))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ListTrendingNews returns up to limit published articles with the most views within the last
+// window, most viewed first.
+func (uc *NewsUseCase) ListTrendingNews(ctx context.Context, window time.Duration, limit int) ([]*entity.News, error) {
+	if window <= 0 {
+		return nil, fmt.Errorf("NewsUseCase.ListTrendingNews: %w", ErrInvalidTrendingWindow)
+	}
+	if limit <= 0 {
+		limit = 10
+	}
+	if limit > maxTrendingLimit {
+		limit = maxTrendingLimit
+	}
+	if uc.viewCounter == nil {
+		return []*entity.News{}, nil
+	}
+
+	ids, err := uc.viewCounter.TopViewed(ctx, window, time.Now(), limit)
+	if err != nil {
+		return nil, fmt.Errorf("NewsUseCase.ListTrendingNews: failed to get most viewed news: %w", err)
+	}
+
+	trending := make([]*entity.News, 0, len(ids))
+	for _, id := range ids {
+		news, err := uc.getVisibleNews(ctx, id, false)
+		if err != nil {
+			// Articles deleted or unpublished since they were viewed are left out.
+			if !errors.Is(err, repository.ErrNotFound) {
+				uc.logger.Warn("Failed to get trending news", zap.Error(err), zap.String("news_id", id))
+			}
+			continue
+		}
+		trending = append(trending, news)
+	}
+	return trending, nil
+}
+
+// FlushViewCounts moves the views buffered in the cache to the stored view counts and returns
+// how many articles were updated. If the database write fails, the views go back to the cache.
+func (uc *NewsUseCase) FlushViewCounts(ctx context.Context) (int, error) {
+	if uc.viewCounter == nil {
+		return 0, nil
+	}
+	counts, err := uc.viewCounter.DrainPending(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("NewsUseCase.FlushViewCounts: failed to drain pending views: %w", err)
+	}
+	if len(counts) == 0 {
+		return 0, nil
+	}
+	if err := uc.newsRepo.IncrementViewCounts(ctx, counts); err != nil {
+		// Put the views back so the next flush retries them. The unordered bulk write may have
+		// applied some of them already; counting those twice is better than losing all of them.
+		if restoreErr := uc.viewCounter.RestorePending(ctx, counts); restoreErr != nil {
+			uc.logger.Error("Dropping drained view counts after failed flush", zap.Any("counts", counts), zap.Error(restoreErr))
+		}
+		return 0, fmt.Errorf("NewsUseCase.FlushViewCounts: failed to store view counts: %w", err)
+	}
+	return len(counts), nil
+}
+
+// RunViewCountFlusher calls FlushViewCounts every interval until ctx is cancelled.
+func (uc *NewsUseCase) RunViewCountFlusher(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if _, err := uc.FlushViewCounts(ctx); err != nil {
+			uc.logger.Error("View count flush failed", zap.Error(err))
+		}
+	}
+}
@@ -8,8 +8,10 @@ import (
 	"time"
 
 	"github.com/Abdurahmanit/GroupProject/news-service/internal/entity"
+	"github.com/Abdurahmanit/GroupProject/news-service/internal/port/repository"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.uber.org/zap"
 )
@@ -35,6 +37,14 @@ func (m *MockNewsRepository) Delete(ctx context.Context, id string, sc mongo.Ses
 	args := m.Called(ctx, id, sc)
 	return args.Error(0)
 }
+func (m *MockNewsRepository) IncrementCommentCount(ctx context.Context, id string, delta int64) error {
+	args := m.Called(ctx, id, delta)
+	return args.Error(0)
+}
+func (m *MockNewsRepository) IncrementViewCounts(ctx context.Context, counts map[string]int64) error {
+	args := m.Called(ctx, counts)
+	return args.Error(0)
+}
 func (m *MockNewsRepository) List(ctx context.Context, page, pageSize int, filter map[string]interface{}) ([]*entity.News, int, error) {
 	args := m.Called(ctx, page, pageSize, filter)
 	if args.Get(0) == nil {
@@ -43,6 +53,34 @@ func (m *MockNewsRepository) List(ctx context.Context, page, pageSize int, filte
 	return args.Get(0).([]*entity.News), args.Int(1), args.Error(2)
 }
 
+func (m *MockNewsRepository) GetAdjacent(ctx context.Context, news *entity.News, category string) (*entity.News, *entity.News, error) {
+	args := m.Called(ctx, news, category)
+	newer, _ := args.Get(0).(*entity.News)
+	older, _ := args.Get(1).(*entity.News)
+	return newer, older, args.Error(2)
+}
+
+func (m *MockNewsRepository) Search(ctx context.Context, query, category string, page, pageSize int) ([]*entity.News, int, error) {
+	args := m.Called(ctx, query, category, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*entity.News), args.Int(1), args.Error(2)
+}
+
+func (m *MockNewsRepository) ListDueScheduled(ctx context.Context, now time.Time) ([]*entity.News, error) {
+	args := m.Called(ctx, now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.News), args.Error(1)
+}
+
+func (m *MockNewsRepository) MarkPublished(ctx context.Context, id string, publishedAt time.Time) (bool, error) {
+	args := m.Called(ctx, id, publishedAt)
+	return args.Bool(0), args.Error(1)
+}
+
 type MockCommentRepository struct{ mock.Mock }
 
 func (m *MockCommentRepository) Create(ctx context.Context, comment *entity.Comment) (string, error) {
@@ -63,6 +101,13 @@ func (m *MockCommentRepository) GetByNewsID(ctx context.Context, newsID string,
 	}
 	return args.Get(0).([]*entity.Comment), args.Int(1), args.Error(2)
 }
+func (m *MockCommentRepository) GetReplies(ctx context.Context, parentID string, page, pageSize int) ([]*entity.Comment, int, error) {
+	args := m.Called(ctx, parentID, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Int(1), args.Error(2)
+	}
+	return args.Get(0).([]*entity.Comment), args.Int(1), args.Error(2)
+}
 func (m *MockCommentRepository) Update(ctx context.Context, comment *entity.Comment) error {
 	args := m.Called(ctx, comment)
 	return args.Error(0)
@@ -75,6 +120,17 @@ func (m *MockCommentRepository) DeleteByNewsID(ctx context.Context, newsID strin
 	args := m.Called(ctx, newsID, sessionContext)
 	return args.Get(0).(int64), args.Error(1)
 }
+func (m *MockCommentRepository) DeleteByAuthorID(ctx context.Context, userID string) (map[string]int64, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]int64), args.Error(1)
+}
+func (m *MockCommentRepository) AnonymizeByAuthorID(ctx context.Context, userID string) (int64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
 
 type MockLikeRepository struct{ mock.Mock }
 
@@ -132,6 +188,31 @@ func (m *MockCacheRepository) Delete(ctx context.Context, key string) error {
 	return args.Error(0)
 }
 
+type MockViewCounter struct{ mock.Mock }
+
+func (m *MockViewCounter) RecordView(ctx context.Context, newsID string, at time.Time) error {
+	args := m.Called(ctx, newsID, at)
+	return args.Error(0)
+}
+func (m *MockViewCounter) DrainPending(ctx context.Context) (map[string]int64, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]int64), args.Error(1)
+}
+func (m *MockViewCounter) RestorePending(ctx context.Context, counts map[string]int64) error {
+	args := m.Called(ctx, counts)
+	return args.Error(0)
+}
+func (m *MockViewCounter) TopViewed(ctx context.Context, window time.Duration, now time.Time, limit int) ([]string, error) {
+	args := m.Called(ctx, window, now, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
 type MockEmailSender struct{ mock.Mock }
 
 func (m *MockEmailSender) SendEmail(to []string, subject, body string) error {
@@ -145,6 +226,10 @@ func (m *MockUserServiceClient) GetAuthorEmail(ctx context.Context, authorID str
 	args := m.Called(ctx, authorID)
 	return args.String(0), args.Error(1)
 }
+func (m *MockUserServiceClient) GetUserRole(ctx context.Context, userID string) (string, error) {
+	args := m.Called(ctx, userID)
+	return args.String(0), args.Error(1)
+}
 func (m *MockUserServiceClient) Close() error {
 	args := m.Called()
 	return args.Error(0)
@@ -160,17 +245,14 @@ func TestNewsUseCase_CreateNews_EmailFlow(t *testing.T) {
 	mockEmail := new(MockEmailSender)
 	mockUserSvc := new(MockUserServiceClient)
 
-	uc := NewNewsUseCase(
-		nil,
-		mockNewsRepo,
-		mockCommentRepo,
-		mockLikeRepo,
-		mockNatsPub,
-		mockCache,
-		mockEmail,
-		mockUserSvc,
-		logger,
-	)
+	uc := NewNewsUseCase(mockNewsRepo, NewsUseCaseDeps{
+		CommentRepo:       mockCommentRepo,
+		LikeRepo:          mockLikeRepo,
+		NATSPublisher:     mockNatsPub,
+		CacheRepo:         mockCache,
+		EmailSender:       mockEmail,
+		UserServiceClient: mockUserSvc,
+	}, logger)
 
 	ctx := context.Background()
 	input := CreateNewsInput{
@@ -259,3 +341,183 @@ func TestNewsUseCase_CreateNews_EmailFlow(t *testing.T) {
 		mockEmail.Mock = mock.Mock{}
 	})
 }
+
+func TestNewsUseCase_GetAdjacentNews_ScopesToCategory(t *testing.T) {
+	mockNewsRepo := new(MockNewsRepository)
+	uc := NewNewsUseCase(mockNewsRepo, NewsUseCaseDeps{}, zap.NewNop())
+
+	ctx := context.Background()
+	current := &entity.News{ID: "n2", Category: "sport", CreatedAt: time.Now()}
+	older := &entity.News{ID: "n1", Category: "sport"}
+	mockNewsRepo.On("GetByID", ctx, "n2").Return(current, nil)
+	mockNewsRepo.On("GetAdjacent", ctx, current, "sport").Return(nil, older, nil).Once()
+	mockNewsRepo.On("GetAdjacent", ctx, current, "").Return(nil, nil, nil).Once()
+
+	output, err := uc.GetAdjacentNews(ctx, "n2", true)
+	assert.NoError(t, err)
+	assert.Nil(t, output.Newer)
+	assert.Equal(t, older, output.Older)
+
+	output, err = uc.GetAdjacentNews(ctx, "n2", false)
+	assert.NoError(t, err)
+	assert.Nil(t, output.Newer)
+	assert.Nil(t, output.Older)
+	mockNewsRepo.AssertExpectations(t)
+}
+
+func TestNewsUseCase_ScheduledNewsHiddenUntilPublished(t *testing.T) {
+	mockNewsRepo := new(MockNewsRepository)
+	mockNatsPub := new(MockNATSPublisher)
+	mockUserSvc := new(MockUserServiceClient)
+	uc := NewNewsUseCase(mockNewsRepo, NewsUseCaseDeps{NATSPublisher: mockNatsPub, UserServiceClient: mockUserSvc}, zap.NewNop())
+	ctx := context.Background()
+	mockUserSvc.On("GetUserRole", ctx, "admin1").Return(adminRole, nil)
+	mockUserSvc.On("GetUserRole", ctx, "reader1").Return("user", nil)
+
+	publishAt := time.Now().Add(time.Hour)
+	mockNewsRepo.On("Create", ctx, mock.AnythingOfType("*entity.News")).Return("n1", nil).Once()
+	created, err := uc.CreateNews(ctx, CreateNewsInput{Title: "Later", AuthorID: "author1", PublishAt: &publishAt})
+	assert.NoError(t, err)
+	assert.Equal(t, entity.NewsStatusScheduled, created.Status)
+	mockNatsPub.AssertNotCalled(t, "PublishNewsCreated", mock.Anything, mock.Anything)
+
+	// Readers' listings exclude scheduled articles at the query level.
+	readerFilter := map[string]interface{}{"status": bson.M{"$ne": entity.NewsStatusScheduled}}
+	mockNewsRepo.On("List", ctx, 1, 10, readerFilter).Return([]*entity.News{}, 0, nil).Once()
+	output, err := uc.ListNews(ctx, ListNewsInput{})
+	assert.NoError(t, err)
+	assert.Empty(t, output.News)
+
+	mockNewsRepo.On("GetByID", ctx, "n1").Return(created, nil)
+	_, err = uc.GetNewsByID(ctx, "n1", false, "")
+	assert.ErrorIs(t, err, repository.ErrNotFound)
+	found, err := uc.GetNewsByID(ctx, "n1", true, "admin1")
+	assert.NoError(t, err)
+	assert.Equal(t, "n1", found.ID)
+
+	// Only admins may ask for scheduled articles.
+	_, err = uc.GetNewsByID(ctx, "n1", true, "reader1")
+	assert.ErrorIs(t, err, ErrAdminRequired)
+	_, err = uc.GetNewsByID(ctx, "n1", true, "")
+	assert.ErrorIs(t, err, ErrAdminRequired)
+	_, err = uc.ListNews(ctx, ListNewsInput{IncludeScheduled: true, RequesterID: "reader1"})
+	assert.ErrorIs(t, err, ErrAdminRequired)
+
+	// Once due, the article is switched to published and news.created goes out.
+	mockNewsRepo.On("ListDueScheduled", ctx, mock.AnythingOfType("time.Time")).Return([]*entity.News{created}, nil).Once()
+	mockNewsRepo.On("MarkPublished", ctx, "n1", mock.AnythingOfType("time.Time")).Return(true, nil).Once()
+	mockNatsPub.On("PublishNewsCreated", ctx, created).Return(nil).Once()
+	published, err := uc.PublishDueNews(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, published)
+	assert.Equal(t, entity.NewsStatusPublished, created.Status)
+	mockNewsRepo.AssertExpectations(t)
+	mockNatsPub.AssertExpectations(t)
+}
+
+func TestNewsUseCase_Tags(t *testing.T) {
+	mockNewsRepo := new(MockNewsRepository)
+	mockCache := new(MockCacheRepository)
+	uc := NewNewsUseCase(mockNewsRepo, NewsUseCaseDeps{CacheRepo: mockCache}, zap.NewNop())
+	ctx := context.Background()
+
+	// Tags are normalized and any of them matches.
+	tagFilter := map[string]interface{}{
+		"tags":   bson.M{"$in": []string{"bikes", "racing"}},
+		"status": bson.M{"$ne": entity.NewsStatusScheduled},
+	}
+	mockNewsRepo.On("List", ctx, 1, 10, tagFilter).Return([]*entity.News{{ID: "n1"}}, 1, nil).Once()
+	output, err := uc.ListNewsByTags(ctx, []string{" Bikes", "racing", "bikes", ""}, 0, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, output.TotalCount)
+
+	_, err = uc.ListNewsByTag(ctx, "  ", 1, 10)
+	assert.ErrorIs(t, err, ErrNoTags)
+
+	// Changing tags is an update and drops the cached article.
+	existing := &entity.News{ID: "n1", Status: entity.NewsStatusPublished, Tags: []string{"bikes"}}
+	mockNewsRepo.On("GetByID", ctx, "n1").Return(existing, nil).Once()
+	mockNewsRepo.On("Update", ctx, mock.MatchedBy(func(n *entity.News) bool {
+		return assert.ObjectsAreEqual([]string{"bikes", "racing"}, n.Tags)
+	})).Return(nil).Once()
+	mockCache.On("Delete", ctx, newsCacheKey("n1")).Return(nil).Once()
+	tags := []string{"bikes", "Racing"}
+	updated, err := uc.UpdateNews(ctx, UpdateNewsInput{ID: "n1", Tags: &tags})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"bikes", "racing"}, updated.Tags)
+
+	mockNewsRepo.AssertExpectations(t)
+	mockCache.AssertExpectations(t)
+}
+
+func TestNewsUseCase_SearchNews(t *testing.T) {
+	mockNewsRepo := new(MockNewsRepository)
+	uc := NewNewsUseCase(mockNewsRepo, NewsUseCaseDeps{}, zap.NewNop())
+	ctx := context.Background()
+
+	mockNewsRepo.On("Search", ctx, "carbon frame", "", 1, 10).Return([]*entity.News{{ID: "n1"}, {ID: "n2"}}, 2, nil).Once()
+	output, err := uc.SearchNews(ctx, " carbon frame ", 0, 0)
+	assert.NoError(t, err)
+	assert.Len(t, output.News, 2)
+
+	// No match is an empty page, not an error.
+	mockNewsRepo.On("Search", ctx, "unicycle", "bikes", 2, 5).Return(nil, 0, nil).Once()
+	output, err = uc.SearchNewsInCategory(ctx, "unicycle", "bikes", 2, 5)
+	assert.NoError(t, err)
+	assert.NotNil(t, output.News)
+	assert.Empty(t, output.News)
+	assert.Equal(t, 0, output.TotalCount)
+
+	_, err = uc.SearchNews(ctx, "   ", 1, 10)
+	assert.ErrorIs(t, err, ErrEmptySearchQuery)
+
+	mockNewsRepo.AssertExpectations(t)
+}
+
+func TestNewsUseCase_Views(t *testing.T) {
+	mockNewsRepo := new(MockNewsRepository)
+	mockViews := new(MockViewCounter)
+	mockUserSvc := new(MockUserServiceClient)
+	uc := NewNewsUseCase(mockNewsRepo, NewsUseCaseDeps{UserServiceClient: mockUserSvc, ViewCounter: mockViews}, zap.NewNop())
+	ctx := context.Background()
+	mockUserSvc.On("GetUserRole", ctx, "admin1").Return(adminRole, nil)
+
+	// Reading a published article counts a view; a scheduled one does not.
+	mockNewsRepo.On("GetByID", ctx, "n1").Return(&entity.News{ID: "n1", Status: entity.NewsStatusPublished}, nil)
+	mockNewsRepo.On("GetByID", ctx, "n2").Return(&entity.News{ID: "n2", Status: entity.NewsStatusScheduled}, nil)
+	mockViews.On("RecordView", ctx, "n1", mock.Anything).Return(nil).Once()
+	_, err := uc.GetNewsByID(ctx, "n1", false, "")
+	assert.NoError(t, err)
+	_, err = uc.GetNewsByID(ctx, "n2", true, "admin1")
+	assert.NoError(t, err)
+
+	// Trending skips articles that are gone or no longer published.
+	mockNewsRepo.On("GetByID", ctx, "gone").Return(nil, repository.ErrNotFound)
+	mockViews.On("TopViewed", ctx, time.Hour, mock.Anything, 10).Return([]string{"gone", "n2", "n1"}, nil).Once()
+	trending, err := uc.ListTrendingNews(ctx, time.Hour, 0)
+	assert.NoError(t, err)
+	if assert.Len(t, trending, 1) {
+		assert.Equal(t, "n1", trending[0].ID)
+	}
+
+	_, err = uc.ListTrendingNews(ctx, 0, 10)
+	assert.ErrorIs(t, err, ErrInvalidTrendingWindow)
+
+	// Buffered views are written in one go.
+	mockViews.On("DrainPending", ctx).Return(map[string]int64{"n1": 5}, nil).Once()
+	mockNewsRepo.On("IncrementViewCounts", ctx, map[string]int64{"n1": 5}).Return(nil).Once()
+	flushed, err := uc.FlushViewCounts(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, flushed)
+
+	// A failed write puts the drained views back for the next flush.
+	mockViews.On("DrainPending", ctx).Return(map[string]int64{"n1": 3}, nil).Once()
+	mockNewsRepo.On("IncrementViewCounts", ctx, map[string]int64{"n1": 3}).Return(errors.New("mongo down")).Once()
+	mockViews.On("RestorePending", ctx, map[string]int64{"n1": 3}).Return(nil).Once()
+	flushed, err = uc.FlushViewCounts(ctx)
+	assert.Error(t, err)
+	assert.Equal(t, 0, flushed)
+
+	mockNewsRepo.AssertExpectations(t)
+	mockViews.AssertExpectations(t)
+}
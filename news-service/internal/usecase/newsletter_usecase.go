@@ -0,0 +1,320 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/mail"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Abdurahmanit/GroupProject/news-service/internal/entity"
+	"github.com/Abdurahmanit/GroupProject/news-service/internal/port/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.uber.org/zap"
+)
+
+const (
+	subscriptionCodeLength = 6
+	subscriptionCodeExpiry = 15 * time.Minute
+	// maxSubscriptionCodeAttempts wrong guesses discard the code; Subscribe has to send a new one.
+	maxSubscriptionCodeAttempts = 5
+	// A confirmation email may be resent after subscriptionResendInterval, doubling with every
+	// email sent to the address up to maxSubscriptionResendInterval.
+	subscriptionResendInterval    = time.Minute
+	maxSubscriptionResendInterval = 24 * time.Hour
+	unsubscribeTokenBytes         = 16
+	maxDigestArticles             = 10
+)
+
+var (
+	ErrInvalidEmail            = errors.New("invalid email address")
+	ErrAlreadySubscribed       = errors.New("email is already subscribed")
+	ErrInvalidSubscriptionCode = errors.New("invalid or expired subscription code")
+	ErrSubscriptionThrottled   = errors.New("a confirmation email was sent recently, try again later")
+)
+
+type NewsletterUseCase struct {
+	subscriberRepo repository.SubscriberRepository
+	newsRepo       repository.NewsRepository
+	emailSender    EmailSenderInterface
+	unsubscribeURL string
+	logger         *zap.Logger
+	now            func() time.Time
+}
+
+// NewNewsletterUseCase creates the newsletter use case. unsubscribeURL is the page that handles
+// one-click unsubscribes; the subscriber's token is appended as the "token" query parameter.
+func NewNewsletterUseCase(sr repository.SubscriberRepository, nr repository.NewsRepository, es EmailSenderInterface, unsubscribeURL string, log *zap.Logger) *NewsletterUseCase {
+	return &NewsletterUseCase{
+		subscriberRepo: sr,
+		newsRepo:       nr,
+		emailSender:    es,
+		unsubscribeURL: unsubscribeURL,
+		logger:         log,
+		now:            time.Now,
+	}
+}
+
+// Subscribe starts the double opt-in for email: it stores the address as unconfirmed and mails a
+// verification code that ConfirmSubscription expects. Subscribing again before confirming sends a
+// fresh code, but no sooner than resendWait allows; until then it returns ErrSubscriptionThrottled.
+func (uc *NewsletterUseCase) Subscribe(ctx context.Context, email string) error {
+	email, err := normalizeEmail(email)
+	if err != nil {
+		return err
+	}
+
+	code, err := generateSubscriptionCode(subscriptionCodeLength)
+	if err != nil {
+		return fmt.Errorf("NewsletterUseCase.Subscribe: %w", err)
+	}
+	now := uc.now()
+	expiresAt := now.Add(subscriptionCodeExpiry)
+
+	subscriber, err := uc.subscriberRepo.GetByEmail(ctx, email)
+	switch {
+	case err == nil:
+		if subscriber.Confirmed {
+			return ErrAlreadySubscribed
+		}
+		if subscriber.VerificationCodeSentAt != nil && now.Before(subscriber.VerificationCodeSentAt.Add(resendWait(subscriber.VerificationEmailsSent))) {
+			return ErrSubscriptionThrottled
+		}
+		subscriber.VerificationCode = code
+		subscriber.VerificationCodeExpiresAt = &expiresAt
+		subscriber.VerificationAttempts = 0
+		subscriber.VerificationEmailsSent++
+		subscriber.VerificationCodeSentAt = &now
+		if err := uc.subscriberRepo.Update(ctx, subscriber); err != nil {
+			return fmt.Errorf("NewsletterUseCase.Subscribe: failed to refresh verification code: %w", err)
+		}
+	case errors.Is(err, repository.ErrNotFound):
+		token, err := generateUnsubscribeToken()
+		if err != nil {
+			return fmt.Errorf("NewsletterUseCase.Subscribe: %w", err)
+		}
+		subscriber = &entity.Subscriber{
+			Email:                     email,
+			VerificationCode:          code,
+			VerificationCodeExpiresAt: &expiresAt,
+			VerificationEmailsSent:    1,
+			VerificationCodeSentAt:    &now,
+			UnsubscribeToken:          token,
+			CreatedAt:                 now,
+		}
+		if subscriber.ID, err = uc.subscriberRepo.Create(ctx, subscriber); err != nil {
+			return fmt.Errorf("NewsletterUseCase.Subscribe: failed to create subscriber: %w", err)
+		}
+	default:
+		return fmt.Errorf("NewsletterUseCase.Subscribe: failed to get subscriber: %w", err)
+	}
+
+	body := fmt.Sprintf("Your confirmation code for the news digest is %s. It expires in %d minutes.\n\n"+
+		"If you did not ask to subscribe, ignore this email or unsubscribe here: %s",
+		code, int(subscriptionCodeExpiry.Minutes()), uc.unsubscribeLink(subscriber))
+	if err := uc.emailSender.SendEmail([]string{email}, "Confirm your news digest subscription", body); err != nil {
+		uc.logger.Error("Failed to send subscription confirmation email", zap.Error(err), zap.String("email", email))
+		return fmt.Errorf("NewsletterUseCase.Subscribe: failed to send confirmation email: %w", err)
+	}
+	return nil
+}
+
+// ConfirmSubscription completes the double opt-in with the code mailed by Subscribe. After
+// maxSubscriptionCodeAttempts wrong codes the code is discarded, so guessing it is not feasible.
+func (uc *NewsletterUseCase) ConfirmSubscription(ctx context.Context, email, code string) error {
+	email, err := normalizeEmail(email)
+	if err != nil {
+		return err
+	}
+
+	subscriber, err := uc.subscriberRepo.GetByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrInvalidSubscriptionCode
+		}
+		return fmt.Errorf("NewsletterUseCase.ConfirmSubscription: failed to get subscriber: %w", err)
+	}
+	if subscriber.Confirmed {
+		return ErrAlreadySubscribed
+	}
+	if subscriber.VerificationCode == "" || subscriber.VerificationCodeExpiresAt == nil ||
+		subscriber.VerificationAttempts >= maxSubscriptionCodeAttempts || uc.now().After(*subscriber.VerificationCodeExpiresAt) {
+		return ErrInvalidSubscriptionCode
+	}
+	if subscriber.VerificationCode != code {
+		return uc.recordFailedConfirmation(ctx, subscriber)
+	}
+
+	now := uc.now()
+	subscriber.Confirmed = true
+	subscriber.ConfirmedAt = &now
+	subscriber.VerificationCode = ""
+	subscriber.VerificationCodeExpiresAt = nil
+	if err := uc.subscriberRepo.Update(ctx, subscriber); err != nil {
+		return fmt.Errorf("NewsletterUseCase.ConfirmSubscription: failed to confirm subscriber: %w", err)
+	}
+	uc.logger.Info("Newsletter subscription confirmed", zap.String("subscriber_id", subscriber.ID))
+	return nil
+}
+
+// recordFailedConfirmation counts a wrong code and discards the code once the limit is reached.
+// It returns ErrInvalidSubscriptionCode unless storing the attempt fails.
+func (uc *NewsletterUseCase) recordFailedConfirmation(ctx context.Context, subscriber *entity.Subscriber) error {
+	attempts, err := uc.subscriberRepo.IncrementVerificationAttempts(ctx, subscriber.ID)
+	if err != nil {
+		return fmt.Errorf("NewsletterUseCase.ConfirmSubscription: failed to count attempt: %w", err)
+	}
+	if attempts < maxSubscriptionCodeAttempts {
+		return ErrInvalidSubscriptionCode
+	}
+
+	subscriber.VerificationCode = ""
+	subscriber.VerificationCodeExpiresAt = nil
+	subscriber.VerificationAttempts = attempts
+	if err := uc.subscriberRepo.Update(ctx, subscriber); err != nil {
+		return fmt.Errorf("NewsletterUseCase.ConfirmSubscription: failed to discard code: %w", err)
+	}
+	uc.logger.Warn("Subscription code discarded after too many attempts", zap.String("subscriber_id", subscriber.ID))
+	return ErrInvalidSubscriptionCode
+}
+
+// Unsubscribe removes the subscription owning token. It returns repository.ErrNotFound for
+// unknown tokens, including ones already used.
+func (uc *NewsletterUseCase) Unsubscribe(ctx context.Context, token string) error {
+	if token == "" {
+		return repository.ErrNotFound
+	}
+	if err := uc.subscriberRepo.DeleteByUnsubscribeToken(ctx, token); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return repository.ErrNotFound
+		}
+		return fmt.Errorf("NewsletterUseCase.Unsubscribe: %w", err)
+	}
+	return nil
+}
+
+// SendDigest emails every confirmed subscriber the articles published since the given time and
+// returns how many emails were sent. Nothing is sent when there are no new articles.
+func (uc *NewsletterUseCase) SendDigest(ctx context.Context, since time.Time) (int, error) {
+	// Scheduled articles go out when they are published, not when they were written; articles
+	// stored without published_at were published on creation.
+	filter := withoutScheduled(map[string]interface{}{
+		"$or": bson.A{
+			bson.M{"published_at": bson.M{"$gte": since}},
+			bson.M{"published_at": bson.M{"$exists": false}, "created_at": bson.M{"$gte": since}},
+		},
+	})
+	news, _, err := uc.newsRepo.List(ctx, 1, maxDigestArticles, filter)
+	if err != nil {
+		return 0, fmt.Errorf("NewsletterUseCase.SendDigest: failed to list recent news: %w", err)
+	}
+	if len(news) == 0 {
+		return 0, nil
+	}
+
+	subscribers, err := uc.subscriberRepo.ListConfirmed(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("NewsletterUseCase.SendDigest: failed to list subscribers: %w", err)
+	}
+
+	summary := digestSummary(news)
+	sent := 0
+	for _, subscriber := range subscribers {
+		body := fmt.Sprintf("%s\nTo stop receiving the news digest, unsubscribe with one click: %s",
+			summary, uc.unsubscribeLink(subscriber))
+		if err := uc.emailSender.SendEmail([]string{subscriber.Email}, "Your news digest", body); err != nil {
+			uc.logger.Warn("Failed to send news digest", zap.Error(err), zap.String("subscriber_id", subscriber.ID))
+			continue
+		}
+		sent++
+	}
+	return sent, nil
+}
+
+// RunDigest sends a digest of the previous interval's news every interval until ctx is cancelled.
+func (uc *NewsletterUseCase) RunDigest(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case tick := <-ticker.C:
+			sent, err := uc.SendDigest(ctx, tick.Add(-interval))
+			if err != nil {
+				uc.logger.Error("News digest run failed", zap.Error(err))
+				continue
+			}
+			uc.logger.Info("News digest sent", zap.Int("emails", sent))
+		}
+	}
+}
+
+func (uc *NewsletterUseCase) unsubscribeLink(subscriber *entity.Subscriber) string {
+	separator := "?"
+	if strings.Contains(uc.unsubscribeURL, "?") {
+		separator = "&"
+	}
+	return uc.unsubscribeURL + separator + "token=" + url.QueryEscape(subscriber.UnsubscribeToken)
+}
+
+// resendWait is how long after the last confirmation email the next one may be sent.
+func resendWait(emailsSent int) time.Duration {
+	wait := subscriptionResendInterval
+	for i := 1; i < emailsSent && wait < maxSubscriptionResendInterval; i++ {
+		wait *= 2
+	}
+	if wait > maxSubscriptionResendInterval {
+		wait = maxSubscriptionResendInterval
+	}
+	return wait
+}
+
+func digestSummary(news []*entity.News) string {
+	var sb strings.Builder
+	sb.WriteString("Here is what's new:\n\n")
+	for _, n := range news {
+		sb.WriteString("- ")
+		sb.WriteString(n.Title)
+		if n.Category != "" {
+			sb.WriteString(" [" + n.Category + "]")
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+func normalizeEmail(email string) (string, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email {
+		return "", ErrInvalidEmail
+	}
+	return email, nil
+}
+
+func generateSubscriptionCode(length int) (string, error) {
+	const charset = "0123456789"
+	code := make([]byte, length)
+	for i := range code {
+		num, err := rand.Int(rand.Reader, big.NewInt(int64(len(charset))))
+		if err != nil {
+			return "", fmt.Errorf("failed to generate random number for code: %w", err)
+		}
+		code[i] = charset[num.Int64()]
+	}
+	return string(code), nil
+}
+
+func generateUnsubscribeToken() (string, error) {
+	b := make([]byte, unsubscribeTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate unsubscribe token: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,174 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Abdurahmanit/GroupProject/news-service/internal/entity"
+	"github.com/Abdurahmanit/GroupProject/news-service/internal/port/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.uber.org/zap"
+)
+
+type MockSubscriberRepository struct{ mock.Mock }
+
+func (m *MockSubscriberRepository) Create(ctx context.Context, subscriber *entity.Subscriber) (string, error) {
+	args := m.Called(ctx, subscriber)
+	return args.String(0), args.Error(1)
+}
+func (m *MockSubscriberRepository) GetByEmail(ctx context.Context, email string) (*entity.Subscriber, error) {
+	args := m.Called(ctx, email)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Subscriber), args.Error(1)
+}
+func (m *MockSubscriberRepository) Update(ctx context.Context, subscriber *entity.Subscriber) error {
+	args := m.Called(ctx, subscriber)
+	return args.Error(0)
+}
+func (m *MockSubscriberRepository) IncrementVerificationAttempts(ctx context.Context, id string) (int, error) {
+	args := m.Called(ctx, id)
+	return args.Int(0), args.Error(1)
+}
+func (m *MockSubscriberRepository) DeleteByUnsubscribeToken(ctx context.Context, token string) error {
+	args := m.Called(ctx, token)
+	return args.Error(0)
+}
+func (m *MockSubscriberRepository) ListConfirmed(ctx context.Context) ([]*entity.Subscriber, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Subscriber), args.Error(1)
+}
+
+func TestNewsletterUseCase_DoubleOptIn(t *testing.T) {
+	ctx := context.Background()
+	mockSubscribers := new(MockSubscriberRepository)
+	mockEmail := new(MockEmailSender)
+	uc := NewNewsletterUseCase(mockSubscribers, new(MockNewsRepository), mockEmail, "https://news.example/unsubscribe", zap.NewNop())
+
+	var stored *entity.Subscriber
+	mockSubscribers.On("GetByEmail", ctx, "reader@example.com").Return(nil, repository.ErrNotFound).Once()
+	mockSubscribers.On("Create", ctx, mock.MatchedBy(func(s *entity.Subscriber) bool {
+		stored = s
+		return !s.Confirmed && len(s.VerificationCode) == subscriptionCodeLength && s.UnsubscribeToken != ""
+	})).Return("sub1", nil).Once()
+	mockEmail.On("SendEmail", []string{"reader@example.com"}, mock.Anything, mock.MatchedBy(func(body string) bool {
+		return strings.Contains(body, stored.VerificationCode) && strings.Contains(body, "?token="+stored.UnsubscribeToken)
+	})).Return(nil).Once()
+
+	assert.NoError(t, uc.Subscribe(ctx, " Reader@Example.com "))
+
+	assert.ErrorIs(t, uc.Subscribe(ctx, "not-an-email"), ErrInvalidEmail)
+
+	// A wrong code keeps the subscription pending.
+	mockSubscribers.On("GetByEmail", ctx, "reader@example.com").Return(stored, nil).Twice()
+	mockSubscribers.On("IncrementVerificationAttempts", ctx, "sub1").Return(1, nil).Once()
+	assert.ErrorIs(t, uc.ConfirmSubscription(ctx, "reader@example.com", "wrong"), ErrInvalidSubscriptionCode)
+
+	mockSubscribers.On("Update", ctx, mock.MatchedBy(func(s *entity.Subscriber) bool {
+		return s.Confirmed && s.ConfirmedAt != nil && s.VerificationCode == ""
+	})).Return(nil).Once()
+	assert.NoError(t, uc.ConfirmSubscription(ctx, "reader@example.com", stored.VerificationCode))
+
+	mockSubscribers.AssertExpectations(t)
+	mockEmail.AssertExpectations(t)
+}
+
+func TestNewsletterUseCase_ConfirmationLimits(t *testing.T) {
+	ctx := context.Background()
+	mockSubscribers := new(MockSubscriberRepository)
+	mockEmail := new(MockEmailSender)
+	uc := NewNewsletterUseCase(mockSubscribers, new(MockNewsRepository), mockEmail, "https://news.example/unsubscribe", zap.NewNop())
+	now := time.Now()
+	uc.now = func() time.Time { return now }
+
+	expiresAt := now.Add(subscriptionCodeExpiry)
+	sentAt := now.Add(-30 * time.Second)
+	pending := &entity.Subscriber{
+		ID:                        "sub1",
+		Email:                     "reader@example.com",
+		VerificationCode:          "123456",
+		VerificationCodeExpiresAt: &expiresAt,
+		VerificationAttempts:      maxSubscriptionCodeAttempts - 1,
+		VerificationEmailsSent:    1,
+		VerificationCodeSentAt:    &sentAt,
+	}
+	mockSubscribers.On("GetByEmail", ctx, "reader@example.com").Return(pending, nil)
+
+	// The last allowed wrong guess discards the code, so even the right one fails afterwards.
+	mockSubscribers.On("IncrementVerificationAttempts", ctx, "sub1").Return(maxSubscriptionCodeAttempts, nil).Once()
+	mockSubscribers.On("Update", ctx, mock.MatchedBy(func(s *entity.Subscriber) bool {
+		return s.VerificationCode == "" && s.VerificationCodeExpiresAt == nil
+	})).Return(nil).Once()
+	assert.ErrorIs(t, uc.ConfirmSubscription(ctx, "reader@example.com", "000000"), ErrInvalidSubscriptionCode)
+	assert.ErrorIs(t, uc.ConfirmSubscription(ctx, "reader@example.com", "123456"), ErrInvalidSubscriptionCode)
+
+	// A new code is not mailed until the resend interval has passed.
+	assert.ErrorIs(t, uc.Subscribe(ctx, "reader@example.com"), ErrSubscriptionThrottled)
+	mockEmail.AssertNotCalled(t, "SendEmail", mock.Anything, mock.Anything, mock.Anything)
+
+	now = now.Add(subscriptionResendInterval)
+	mockSubscribers.On("Update", ctx, mock.MatchedBy(func(s *entity.Subscriber) bool {
+		return s.VerificationCode != "" && s.VerificationAttempts == 0 && s.VerificationEmailsSent == 2
+	})).Return(nil).Once()
+	mockEmail.On("SendEmail", []string{"reader@example.com"}, mock.Anything, mock.Anything).Return(nil).Once()
+	assert.NoError(t, uc.Subscribe(ctx, "reader@example.com"))
+
+	// Each further email doubles the wait.
+	assert.ErrorIs(t, uc.Subscribe(ctx, "reader@example.com"), ErrSubscriptionThrottled)
+	assert.Equal(t, 2*subscriptionResendInterval, resendWait(2))
+	assert.Equal(t, maxSubscriptionResendInterval, resendWait(100))
+
+	mockSubscribers.AssertExpectations(t)
+	mockEmail.AssertExpectations(t)
+}
+
+func TestNewsletterUseCase_SendDigestAndUnsubscribe(t *testing.T) {
+	ctx := context.Background()
+	mockSubscribers := new(MockSubscriberRepository)
+	mockNewsRepo := new(MockNewsRepository)
+	mockEmail := new(MockEmailSender)
+	uc := NewNewsletterUseCase(mockSubscribers, mockNewsRepo, mockEmail, "https://news.example/unsubscribe", zap.NewNop())
+	since := time.Now().Add(-24 * time.Hour)
+
+	// Articles count from when they were published, so scheduled ones written earlier are included.
+	digestFilter := mock.MatchedBy(func(filter map[string]interface{}) bool {
+		or, ok := filter["$or"].(bson.A)
+		return ok && len(or) == 2 && assert.ObjectsAreEqual(bson.M{"published_at": bson.M{"$gte": since}}, or[0])
+	})
+	mockNewsRepo.On("List", ctx, 1, maxDigestArticles, digestFilter).Return([]*entity.News{{ID: "n1", Title: "New bike lanes"}}, 1, nil).Once()
+	mockSubscribers.On("ListConfirmed", ctx).Return([]*entity.Subscriber{
+		{ID: "sub1", Email: "a@example.com", Confirmed: true, UnsubscribeToken: "tok-a"},
+		{ID: "sub2", Email: "b@example.com", Confirmed: true, UnsubscribeToken: "tok-b"},
+	}, nil).Once()
+	mockEmail.On("SendEmail", []string{"a@example.com"}, "Your news digest", mock.MatchedBy(func(body string) bool {
+		return strings.Contains(body, "New bike lanes") && strings.Contains(body, "https://news.example/unsubscribe?token=tok-a")
+	})).Return(nil).Once()
+	mockEmail.On("SendEmail", []string{"b@example.com"}, "Your news digest", mock.Anything).Return(assert.AnError).Once()
+
+	sent, err := uc.SendDigest(ctx, since)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, sent)
+
+	// No new articles means no emails.
+	mockNewsRepo.On("List", ctx, 1, maxDigestArticles, mock.Anything).Return([]*entity.News{}, 0, nil).Once()
+	sent, err = uc.SendDigest(ctx, since)
+	assert.NoError(t, err)
+	assert.Zero(t, sent)
+
+	mockSubscribers.On("DeleteByUnsubscribeToken", ctx, "tok-a").Return(nil).Once()
+	mockSubscribers.On("DeleteByUnsubscribeToken", ctx, "used").Return(repository.ErrNotFound).Once()
+	assert.NoError(t, uc.Unsubscribe(ctx, "tok-a"))
+	assert.ErrorIs(t, uc.Unsubscribe(ctx, "used"), repository.ErrNotFound)
+
+	mockNewsRepo.AssertExpectations(t)
+	mockSubscribers.AssertExpectations(t)
+	mockEmail.AssertExpectations(t)
+}
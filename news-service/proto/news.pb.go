@@ -23,15 +23,21 @@ const (
 )
 
 type News struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
-	AuthorId      string                 `protobuf:"bytes,4,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	ImageUrl      string                 `protobuf:"bytes,7,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
-	Category      string                 `protobuf:"bytes,8,opt,name=category,proto3" json:"category,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title        string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Content      string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	AuthorId     string                 `protobuf:"bytes,4,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ImageUrl     string                 `protobuf:"bytes,7,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Category     string                 `protobuf:"bytes,8,opt,name=category,proto3" json:"category,omitempty"`
+	CommentCount int64                  `protobuf:"varint,9,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`
+	// "scheduled" or "published"; empty for articles created before scheduling existed.
+	Status        string                 `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+	PublishAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	Tags          []string               `protobuf:"bytes,12,rep,name=tags,proto3" json:"tags,omitempty"`
+	ViewCount     int64                  `protobuf:"varint,13,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -122,13 +128,51 @@ func (x *News) GetCategory() string {
 	return ""
 }
 
+func (x *News) GetCommentCount() int64 {
+	if x != nil {
+		return x.CommentCount
+	}
+	return 0
+}
+
+func (x *News) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *News) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+func (x *News) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *News) GetViewCount() int64 {
+	if x != nil {
+		return x.ViewCount
+	}
+	return 0
+}
+
 type CreateNewsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	AuthorId      string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
-	ImageUrl      string                 `protobuf:"bytes,4,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
-	Category      string                 `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Title    string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Content  string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	AuthorId string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	ImageUrl string                 `protobuf:"bytes,4,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Category string                 `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
+	// Publishes the article at this time instead of immediately. Ignored if not in the future.
+	PublishAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	Tags          []string               `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -198,6 +242,20 @@ func (x *CreateNewsRequest) GetCategory() string {
 	return ""
 }
 
+func (x *CreateNewsRequest) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+func (x *CreateNewsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
 type CreateNewsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -243,10 +301,13 @@ func (x *CreateNewsResponse) GetId() string {
 }
 
 type GetNewsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Return the article even if it is still scheduled; requires requester_id to be an admin.
+	IncludeScheduled bool   `protobuf:"varint,2,opt,name=include_scheduled,json=includeScheduled,proto3" json:"include_scheduled,omitempty"`
+	RequesterId      string `protobuf:"bytes,3,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *GetNewsRequest) Reset() {
@@ -286,6 +347,20 @@ func (x *GetNewsRequest) GetId() string {
 	return ""
 }
 
+func (x *GetNewsRequest) GetIncludeScheduled() bool {
+	if x != nil {
+		return x.IncludeScheduled
+	}
+	return false
+}
+
+func (x *GetNewsRequest) GetRequesterId() string {
+	if x != nil {
+		return x.RequesterId
+	}
+	return ""
+}
+
 type GetNewsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	News          *News                  `protobuf:"bytes,1,opt,name=news,proto3" json:"news,omitempty"`
@@ -331,12 +406,15 @@ func (x *GetNewsResponse) GetNews() *News {
 }
 
 type UpdateNewsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
-	Content       *string                `protobuf:"bytes,3,opt,name=content,proto3,oneof" json:"content,omitempty"`
-	ImageUrl      *string                `protobuf:"bytes,4,opt,name=image_url,json=imageUrl,proto3,oneof" json:"image_url,omitempty"`
-	Category      *string                `protobuf:"bytes,5,opt,name=category,proto3,oneof" json:"category,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title    *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Content  *string                `protobuf:"bytes,3,opt,name=content,proto3,oneof" json:"content,omitempty"`
+	ImageUrl *string                `protobuf:"bytes,4,opt,name=image_url,json=imageUrl,proto3,oneof" json:"image_url,omitempty"`
+	Category *string                `protobuf:"bytes,5,opt,name=category,proto3,oneof" json:"category,omitempty"`
+	// tags replace the current tags only when replace_tags is set, so an empty list can clear them.
+	Tags          []string `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	ReplaceTags   bool     `protobuf:"varint,7,opt,name=replace_tags,json=replaceTags,proto3" json:"replace_tags,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -406,6 +484,20 @@ func (x *UpdateNewsRequest) GetCategory() string {
 	return ""
 }
 
+func (x *UpdateNewsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateNewsRequest) GetReplaceTags() bool {
+	if x != nil {
+		return x.ReplaceTags
+	}
+	return false
+}
+
 type UpdateNewsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	News          *News                  `protobuf:"bytes,1,opt,name=news,proto3" json:"news,omitempty"`
@@ -450,6 +542,111 @@ func (x *UpdateNewsResponse) GetNews() *News {
 	return nil
 }
 
+type GetAdjacentNewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NewsId        string                 `protobuf:"bytes,1,opt,name=news_id,json=newsId,proto3" json:"news_id,omitempty"`
+	SameCategory  bool                   `protobuf:"varint,2,opt,name=same_category,json=sameCategory,proto3" json:"same_category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAdjacentNewsRequest) Reset() {
+	*x = GetAdjacentNewsRequest{}
+	mi := &file_news_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAdjacentNewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAdjacentNewsRequest) ProtoMessage() {}
+
+func (x *GetAdjacentNewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAdjacentNewsRequest.ProtoReflect.Descriptor instead.
+func (*GetAdjacentNewsRequest) Descriptor() ([]byte, []int) {
+	return file_news_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetAdjacentNewsRequest) GetNewsId() string {
+	if x != nil {
+		return x.NewsId
+	}
+	return ""
+}
+
+func (x *GetAdjacentNewsRequest) GetSameCategory() bool {
+	if x != nil {
+		return x.SameCategory
+	}
+	return false
+}
+
+// newer and older are unset at the ends of the list.
+type GetAdjacentNewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Newer         *News                  `protobuf:"bytes,1,opt,name=newer,proto3" json:"newer,omitempty"`
+	Older         *News                  `protobuf:"bytes,2,opt,name=older,proto3" json:"older,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAdjacentNewsResponse) Reset() {
+	*x = GetAdjacentNewsResponse{}
+	mi := &file_news_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAdjacentNewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAdjacentNewsResponse) ProtoMessage() {}
+
+func (x *GetAdjacentNewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_news_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAdjacentNewsResponse.ProtoReflect.Descriptor instead.
+func (*GetAdjacentNewsResponse) Descriptor() ([]byte, []int) {
+	return file_news_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetAdjacentNewsResponse) GetNewer() *News {
+	if x != nil {
+		return x.Newer
+	}
+	return nil
+}
+
+func (x *GetAdjacentNewsResponse) GetOlder() *News {
+	if x != nil {
+		return x.Older
+	}
+	return nil
+}
+
 type DeleteNewsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -459,7 +656,7 @@ type DeleteNewsRequest struct {
 
 func (x *DeleteNewsRequest) Reset() {
 	*x = DeleteNewsRequest{}
-	mi := &file_news_proto_msgTypes[7]
+	mi := &file_news_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -471,7 +668,7 @@ func (x *DeleteNewsRequest) String() string {
 func (*DeleteNewsRequest) ProtoMessage() {}
 
 func (x *DeleteNewsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_news_proto_msgTypes[7]
+	mi := &file_news_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -484,7 +681,7 @@ func (x *DeleteNewsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteNewsRequest.ProtoReflect.Descriptor instead.
 func (*DeleteNewsRequest) Descriptor() ([]byte, []int) {
-	return file_news_proto_rawDescGZIP(), []int{7}
+	return file_news_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *DeleteNewsRequest) GetId() string {
@@ -503,7 +700,7 @@ type DeleteNewsResponse struct {
 
 func (x *DeleteNewsResponse) Reset() {
 	*x = DeleteNewsResponse{}
-	mi := &file_news_proto_msgTypes[8]
+	mi := &file_news_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -515,7 +712,7 @@ func (x *DeleteNewsResponse) String() string {
 func (*DeleteNewsResponse) ProtoMessage() {}
 
 func (x *DeleteNewsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_news_proto_msgTypes[8]
+	mi := &file_news_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -528,7 +725,7 @@ func (x *DeleteNewsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteNewsResponse.ProtoReflect.Descriptor instead.
 func (*DeleteNewsResponse) Descriptor() ([]byte, []int) {
-	return file_news_proto_rawDescGZIP(), []int{8}
+	return file_news_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *DeleteNewsResponse) GetSuccess() bool {
@@ -539,16 +736,19 @@ func (x *DeleteNewsResponse) GetSuccess() bool {
 }
 
 type ListNewsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Page     int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// List scheduled articles too; requires requester_id to be an admin.
+	IncludeScheduled bool   `protobuf:"varint,3,opt,name=include_scheduled,json=includeScheduled,proto3" json:"include_scheduled,omitempty"`
+	RequesterId      string `protobuf:"bytes,4,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *ListNewsRequest) Reset() {
 	*x = ListNewsRequest{}
-	mi := &file_news_proto_msgTypes[9]
+	mi := &file_news_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -560,7 +760,7 @@ func (x *ListNewsRequest) String() string {
 func (*ListNewsRequest) ProtoMessage() {}
 
 func (x *ListNewsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_news_proto_msgTypes[9]
+	mi := &file_news_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -573,7 +773,7 @@ func (x *ListNewsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListNewsRequest.ProtoReflect.Descriptor instead.
 func (*ListNewsRequest) Descriptor() ([]byte, []int) {
-	return file_news_proto_rawDescGZIP(), []int{9}
+	return file_news_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListNewsRequest) GetPage() int32 {
@@ -590,6 +790,20 @@ func (x *ListNewsRequest) GetPageSize() int32 {
 	return 0
 }
 
+func (x *ListNewsRequest) GetIncludeScheduled() bool {
+	if x != nil {
+		return x.IncludeScheduled
+	}
+	return false
+}
+
+func (x *ListNewsRequest) GetRequesterId() string {
+	if x != nil {
+		return x.RequesterId
+	}
+	return ""
+}
+
 type ListNewsByCategoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
@@ -601,7 +815,7 @@ type ListNewsByCategoryRequest struct {
 
 func (x *ListNewsByCategoryRequest) Reset() {
 	*x = ListNewsByCategoryRequest{}
-	mi := &file_news_proto_msgTypes[10]
+	mi := &file_news_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -613,7 +827,7 @@ func (x *ListNewsByCategoryRequest) String() string {
 func (*ListNewsByCategoryRequest) ProtoMessage() {}
 
 func (x *ListNewsByCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_news_proto_msgTypes[10]
+	mi := &file_news_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -626,7 +840,7 @@ func (x *ListNewsByCategoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListNewsByCategoryRequest.ProtoReflect.Descriptor instead.
 func (*ListNewsByCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_news_proto_rawDescGZIP(), []int{10}
+	return file_news_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ListNewsByCategoryRequest) GetCategory() string {
@@ -650,6 +864,189 @@ func (x *ListNewsByCategoryRequest) GetPageSize() int32 {
 	return 0
 }
 
+// Lists articles carrying any of the given tags.
+type ListNewsByTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []string               `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNewsByTagRequest) Reset() {
+	*x = ListNewsByTagRequest{}
+	mi := &file_news_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNewsByTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNewsByTagRequest) ProtoMessage() {}
+
+func (x *ListNewsByTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNewsByTagRequest.ProtoReflect.Descriptor instead.
+func (*ListNewsByTagRequest) Descriptor() ([]byte, []int) {
+	return file_news_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListNewsByTagRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListNewsByTagRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListNewsByTagRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type SearchNewsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Query string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Optional; limits results to one category.
+	Category      string `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	Page          int32  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchNewsRequest) Reset() {
+	*x = SearchNewsRequest{}
+	mi := &file_news_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchNewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchNewsRequest) ProtoMessage() {}
+
+func (x *SearchNewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchNewsRequest.ProtoReflect.Descriptor instead.
+func (*SearchNewsRequest) Descriptor() ([]byte, []int) {
+	return file_news_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SearchNewsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchNewsRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SearchNewsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchNewsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListTrendingNewsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only views within this many seconds before now are counted.
+	WindowSeconds int64 `protobuf:"varint,1,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrendingNewsRequest) Reset() {
+	*x = ListTrendingNewsRequest{}
+	mi := &file_news_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrendingNewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrendingNewsRequest) ProtoMessage() {}
+
+func (x *ListTrendingNewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_news_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrendingNewsRequest.ProtoReflect.Descriptor instead.
+func (*ListTrendingNewsRequest) Descriptor() ([]byte, []int) {
+	return file_news_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListTrendingNewsRequest) GetWindowSeconds() int64 {
+	if x != nil {
+		return x.WindowSeconds
+	}
+	return 0
+}
+
+func (x *ListTrendingNewsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
 type ListNewsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	News          []*News                `protobuf:"bytes,1,rep,name=news,proto3" json:"news,omitempty"`
@@ -660,7 +1057,7 @@ type ListNewsResponse struct {
 
 func (x *ListNewsResponse) Reset() {
 	*x = ListNewsResponse{}
-	mi := &file_news_proto_msgTypes[11]
+	mi := &file_news_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -672,7 +1069,7 @@ func (x *ListNewsResponse) String() string {
 func (*ListNewsResponse) ProtoMessage() {}
 
 func (x *ListNewsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_news_proto_msgTypes[11]
+	mi := &file_news_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -685,7 +1082,7 @@ func (x *ListNewsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListNewsResponse.ProtoReflect.Descriptor instead.
 func (*ListNewsResponse) Descriptor() ([]byte, []int) {
-	return file_news_proto_rawDescGZIP(), []int{11}
+	return file_news_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ListNewsResponse) GetNews() []*News {
@@ -707,7 +1104,7 @@ var File_news_proto protoreflect.FileDescriptor
 const file_news_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"news.proto\x12\x04news\x1a\x1fgoogle/protobuf/timestamp.proto\"\x92\x02\n" +
+	"news.proto\x12\x04news\x1a\x1fgoogle/protobuf/timestamp.proto\"\xbd\x03\n" +
 	"\x04News\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
@@ -718,26 +1115,41 @@ const file_news_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1b\n" +
 	"\timage_url\x18\a \x01(\tR\bimageUrl\x12\x1a\n" +
-	"\bcategory\x18\b \x01(\tR\bcategory\"\x99\x01\n" +
+	"\bcategory\x18\b \x01(\tR\bcategory\x12#\n" +
+	"\rcomment_count\x18\t \x01(\x03R\fcommentCount\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"publish_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tpublishAt\x12\x12\n" +
+	"\x04tags\x18\f \x03(\tR\x04tags\x12\x1d\n" +
+	"\n" +
+	"view_count\x18\r \x01(\x03R\tviewCount\"\xe8\x01\n" +
 	"\x11CreateNewsRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12\x18\n" +
 	"\acontent\x18\x02 \x01(\tR\acontent\x12\x1b\n" +
 	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12\x1b\n" +
 	"\timage_url\x18\x04 \x01(\tR\bimageUrl\x12\x1a\n" +
-	"\bcategory\x18\x05 \x01(\tR\bcategory\"$\n" +
+	"\bcategory\x18\x05 \x01(\tR\bcategory\x129\n" +
+	"\n" +
+	"publish_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tpublishAt\x12\x12\n" +
+	"\x04tags\x18\a \x03(\tR\x04tags\"$\n" +
 	"\x12CreateNewsResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\" \n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"p\n" +
 	"\x0eGetNewsRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"1\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12+\n" +
+	"\x11include_scheduled\x18\x02 \x01(\bR\x10includeScheduled\x12!\n" +
+	"\frequester_id\x18\x03 \x01(\tR\vrequesterId\"1\n" +
 	"\x0fGetNewsResponse\x12\x1e\n" +
 	"\x04news\x18\x01 \x01(\v2\n" +
-	".news.NewsR\x04news\"\xd1\x01\n" +
+	".news.NewsR\x04news\"\x88\x02\n" +
 	"\x11UpdateNewsRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12\x1d\n" +
 	"\acontent\x18\x03 \x01(\tH\x01R\acontent\x88\x01\x01\x12 \n" +
 	"\timage_url\x18\x04 \x01(\tH\x02R\bimageUrl\x88\x01\x01\x12\x1f\n" +
-	"\bcategory\x18\x05 \x01(\tH\x03R\bcategory\x88\x01\x01B\b\n" +
+	"\bcategory\x18\x05 \x01(\tH\x03R\bcategory\x88\x01\x01\x12\x12\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tags\x12!\n" +
+	"\freplace_tags\x18\a \x01(\bR\vreplaceTagsB\b\n" +
 	"\x06_titleB\n" +
 	"\n" +
 	"\b_contentB\f\n" +
@@ -746,18 +1158,40 @@ const file_news_proto_rawDesc = "" +
 	"\t_category\"4\n" +
 	"\x12UpdateNewsResponse\x12\x1e\n" +
 	"\x04news\x18\x01 \x01(\v2\n" +
-	".news.NewsR\x04news\"#\n" +
+	".news.NewsR\x04news\"V\n" +
+	"\x16GetAdjacentNewsRequest\x12\x17\n" +
+	"\anews_id\x18\x01 \x01(\tR\x06newsId\x12#\n" +
+	"\rsame_category\x18\x02 \x01(\bR\fsameCategory\"]\n" +
+	"\x17GetAdjacentNewsResponse\x12 \n" +
+	"\x05newer\x18\x01 \x01(\v2\n" +
+	".news.NewsR\x05newer\x12 \n" +
+	"\x05older\x18\x02 \x01(\v2\n" +
+	".news.NewsR\x05older\"#\n" +
 	"\x11DeleteNewsRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\".\n" +
 	"\x12DeleteNewsResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"B\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x92\x01\n" +
 	"\x0fListNewsRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"h\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12+\n" +
+	"\x11include_scheduled\x18\x03 \x01(\bR\x10includeScheduled\x12!\n" +
+	"\frequester_id\x18\x04 \x01(\tR\vrequesterId\"h\n" +
 	"\x19ListNewsByCategoryRequest\x12\x1a\n" +
 	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"S\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"[\n" +
+	"\x14ListNewsByTagRequest\x12\x12\n" +
+	"\x04tags\x18\x01 \x03(\tR\x04tags\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"v\n" +
+	"\x11SearchNewsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"V\n" +
+	"\x17ListTrendingNewsRequest\x12%\n" +
+	"\x0ewindow_seconds\x18\x01 \x01(\x03R\rwindowSeconds\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"S\n" +
 	"\x10ListNewsResponse\x12\x1e\n" +
 	"\x04news\x18\x01 \x03(\v2\n" +
 	".news.NewsR\x04news\x12\x1f\n" +
@@ -776,7 +1210,7 @@ func file_news_proto_rawDescGZIP() []byte {
 	return file_news_proto_rawDescData
 }
 
-var file_news_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_news_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_news_proto_goTypes = []any{
 	(*News)(nil),                      // 0: news.News
 	(*CreateNewsRequest)(nil),         // 1: news.CreateNewsRequest
@@ -785,24 +1219,33 @@ var file_news_proto_goTypes = []any{
 	(*GetNewsResponse)(nil),           // 4: news.GetNewsResponse
 	(*UpdateNewsRequest)(nil),         // 5: news.UpdateNewsRequest
 	(*UpdateNewsResponse)(nil),        // 6: news.UpdateNewsResponse
-	(*DeleteNewsRequest)(nil),         // 7: news.DeleteNewsRequest
-	(*DeleteNewsResponse)(nil),        // 8: news.DeleteNewsResponse
-	(*ListNewsRequest)(nil),           // 9: news.ListNewsRequest
-	(*ListNewsByCategoryRequest)(nil), // 10: news.ListNewsByCategoryRequest
-	(*ListNewsResponse)(nil),          // 11: news.ListNewsResponse
-	(*timestamppb.Timestamp)(nil),     // 12: google.protobuf.Timestamp
+	(*GetAdjacentNewsRequest)(nil),    // 7: news.GetAdjacentNewsRequest
+	(*GetAdjacentNewsResponse)(nil),   // 8: news.GetAdjacentNewsResponse
+	(*DeleteNewsRequest)(nil),         // 9: news.DeleteNewsRequest
+	(*DeleteNewsResponse)(nil),        // 10: news.DeleteNewsResponse
+	(*ListNewsRequest)(nil),           // 11: news.ListNewsRequest
+	(*ListNewsByCategoryRequest)(nil), // 12: news.ListNewsByCategoryRequest
+	(*ListNewsByTagRequest)(nil),      // 13: news.ListNewsByTagRequest
+	(*SearchNewsRequest)(nil),         // 14: news.SearchNewsRequest
+	(*ListTrendingNewsRequest)(nil),   // 15: news.ListTrendingNewsRequest
+	(*ListNewsResponse)(nil),          // 16: news.ListNewsResponse
+	(*timestamppb.Timestamp)(nil),     // 17: google.protobuf.Timestamp
 }
 var file_news_proto_depIdxs = []int32{
-	12, // 0: news.News.created_at:type_name -> google.protobuf.Timestamp
-	12, // 1: news.News.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 2: news.GetNewsResponse.news:type_name -> news.News
-	0,  // 3: news.UpdateNewsResponse.news:type_name -> news.News
-	0,  // 4: news.ListNewsResponse.news:type_name -> news.News
-	5,  // [5:5] is the sub-list for method output_type
-	5,  // [5:5] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	17, // 0: news.News.created_at:type_name -> google.protobuf.Timestamp
+	17, // 1: news.News.updated_at:type_name -> google.protobuf.Timestamp
+	17, // 2: news.News.publish_at:type_name -> google.protobuf.Timestamp
+	17, // 3: news.CreateNewsRequest.publish_at:type_name -> google.protobuf.Timestamp
+	0,  // 4: news.GetNewsResponse.news:type_name -> news.News
+	0,  // 5: news.UpdateNewsResponse.news:type_name -> news.News
+	0,  // 6: news.GetAdjacentNewsResponse.newer:type_name -> news.News
+	0,  // 7: news.GetAdjacentNewsResponse.older:type_name -> news.News
+	0,  // 8: news.ListNewsResponse.news:type_name -> news.News
+	9,  // [9:9] is the sub-list for method output_type
+	9,  // [9:9] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_news_proto_init() }
@@ -817,7 +1260,7 @@ func file_news_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_news_proto_rawDesc), len(file_news_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -26,7 +26,7 @@ const file_service_proto_rawDesc = "" +
 	"\n" +
 	"\rservice.proto\x12\x04news\x1a\n" +
 	"news.proto\x1a\rcomment.proto\x1a\n" +
-	"like.proto2\xc5\x06\n" +
+	"like.proto\x1a\x12announcement.proto\x1a\x10newsletter.proto2\xff\r\n" +
 	"\vNewsService\x12?\n" +
 	"\n" +
 	"CreateNews\x12\x17.news.CreateNewsRequest\x1a\x18.news.CreateNewsResponse\x126\n" +
@@ -35,40 +35,74 @@ const file_service_proto_rawDesc = "" +
 	"\n" +
 	"UpdateNews\x12\x17.news.UpdateNewsRequest\x1a\x18.news.UpdateNewsResponse\x12?\n" +
 	"\n" +
-	"DeleteNews\x12\x17.news.DeleteNewsRequest\x1a\x18.news.DeleteNewsResponse\x12H\n" +
+	"DeleteNews\x12\x17.news.DeleteNewsRequest\x1a\x18.news.DeleteNewsResponse\x12N\n" +
+	"\x0fGetAdjacentNews\x12\x1c.news.GetAdjacentNewsRequest\x1a\x1d.news.GetAdjacentNewsResponse\x12H\n" +
 	"\rCreateComment\x12\x1a.news.CreateCommentRequest\x1a\x1b.news.CreateCommentResponse\x12W\n" +
-	"\x12GetCommentsForNews\x12\x1f.news.GetCommentsForNewsRequest\x1a .news.GetCommentsForNewsResponse\x12H\n" +
+	"\x12GetCommentsForNews\x12\x1f.news.GetCommentsForNewsRequest\x1a .news.GetCommentsForNewsResponse\x12W\n" +
+	"\x12ListCommentReplies\x12\x1f.news.ListCommentRepliesRequest\x1a .news.ListCommentRepliesResponse\x12B\n" +
+	"\vEditComment\x12\x18.news.EditCommentRequest\x1a\x19.news.EditCommentResponse\x12H\n" +
 	"\rDeleteComment\x12\x1a.news.DeleteCommentRequest\x1a\x1b.news.DeleteCommentResponse\x129\n" +
 	"\bLikeNews\x12\x15.news.LikeNewsRequest\x1a\x16.news.LikeNewsResponse\x12?\n" +
 	"\n" +
 	"UnlikeNews\x12\x17.news.UnlikeNewsRequest\x1a\x18.news.UnlikeNewsResponse\x12H\n" +
 	"\rGetLikesCount\x12\x1a.news.GetLikesCountRequest\x1a\x1b.news.GetLikesCountResponse\x12M\n" +
-	"\x12ListNewsByCategory\x12\x1f.news.ListNewsByCategoryRequest\x1a\x16.news.ListNewsResponseB@Z>github.com/Abdurahmanit/GroupProject/news-service/proto;newspbb\x06proto3"
+	"\x12ListNewsByCategory\x12\x1f.news.ListNewsByCategoryRequest\x1a\x16.news.ListNewsResponse\x12C\n" +
+	"\rListNewsByTag\x12\x1a.news.ListNewsByTagRequest\x1a\x16.news.ListNewsResponse\x12=\n" +
+	"\n" +
+	"SearchNews\x12\x17.news.SearchNewsRequest\x1a\x16.news.ListNewsResponse\x12I\n" +
+	"\x10ListTrendingNews\x12\x1d.news.ListTrendingNewsRequest\x1a\x16.news.ListNewsResponse\x12W\n" +
+	"\x12CreateAnnouncement\x12\x1f.news.CreateAnnouncementRequest\x1a .news.CreateAnnouncementResponse\x12`\n" +
+	"\x15SetAnnouncementActive\x12\".news.SetAnnouncementActiveRequest\x1a#.news.SetAnnouncementActiveResponse\x12c\n" +
+	"\x16GetActiveAnnouncements\x12#.news.GetActiveAnnouncementsRequest\x1a$.news.GetActiveAnnouncementsResponse\x12<\n" +
+	"\tSubscribe\x12\x16.news.SubscribeRequest\x1a\x17.news.SubscribeResponse\x12Z\n" +
+	"\x13ConfirmSubscription\x12 .news.ConfirmSubscriptionRequest\x1a!.news.ConfirmSubscriptionResponse\x12B\n" +
+	"\vUnsubscribe\x12\x18.news.UnsubscribeRequest\x1a\x19.news.UnsubscribeResponseB@Z>github.com/Abdurahmanit/GroupProject/news-service/proto;newspbb\x06proto3"
 
 var file_service_proto_goTypes = []any{
-	(*CreateNewsRequest)(nil),          // 0: news.CreateNewsRequest
-	(*GetNewsRequest)(nil),             // 1: news.GetNewsRequest
-	(*ListNewsRequest)(nil),            // 2: news.ListNewsRequest
-	(*UpdateNewsRequest)(nil),          // 3: news.UpdateNewsRequest
-	(*DeleteNewsRequest)(nil),          // 4: news.DeleteNewsRequest
-	(*CreateCommentRequest)(nil),       // 5: news.CreateCommentRequest
-	(*GetCommentsForNewsRequest)(nil),  // 6: news.GetCommentsForNewsRequest
-	(*DeleteCommentRequest)(nil),       // 7: news.DeleteCommentRequest
-	(*LikeNewsRequest)(nil),            // 8: news.LikeNewsRequest
-	(*UnlikeNewsRequest)(nil),          // 9: news.UnlikeNewsRequest
-	(*GetLikesCountRequest)(nil),       // 10: news.GetLikesCountRequest
-	(*ListNewsByCategoryRequest)(nil),  // 11: news.ListNewsByCategoryRequest
-	(*CreateNewsResponse)(nil),         // 12: news.CreateNewsResponse
-	(*GetNewsResponse)(nil),            // 13: news.GetNewsResponse
-	(*ListNewsResponse)(nil),           // 14: news.ListNewsResponse
-	(*UpdateNewsResponse)(nil),         // 15: news.UpdateNewsResponse
-	(*DeleteNewsResponse)(nil),         // 16: news.DeleteNewsResponse
-	(*CreateCommentResponse)(nil),      // 17: news.CreateCommentResponse
-	(*GetCommentsForNewsResponse)(nil), // 18: news.GetCommentsForNewsResponse
-	(*DeleteCommentResponse)(nil),      // 19: news.DeleteCommentResponse
-	(*LikeNewsResponse)(nil),           // 20: news.LikeNewsResponse
-	(*UnlikeNewsResponse)(nil),         // 21: news.UnlikeNewsResponse
-	(*GetLikesCountResponse)(nil),      // 22: news.GetLikesCountResponse
+	(*CreateNewsRequest)(nil),              // 0: news.CreateNewsRequest
+	(*GetNewsRequest)(nil),                 // 1: news.GetNewsRequest
+	(*ListNewsRequest)(nil),                // 2: news.ListNewsRequest
+	(*UpdateNewsRequest)(nil),              // 3: news.UpdateNewsRequest
+	(*DeleteNewsRequest)(nil),              // 4: news.DeleteNewsRequest
+	(*GetAdjacentNewsRequest)(nil),         // 5: news.GetAdjacentNewsRequest
+	(*CreateCommentRequest)(nil),           // 6: news.CreateCommentRequest
+	(*GetCommentsForNewsRequest)(nil),      // 7: news.GetCommentsForNewsRequest
+	(*ListCommentRepliesRequest)(nil),      // 8: news.ListCommentRepliesRequest
+	(*EditCommentRequest)(nil),             // 9: news.EditCommentRequest
+	(*DeleteCommentRequest)(nil),           // 10: news.DeleteCommentRequest
+	(*LikeNewsRequest)(nil),                // 11: news.LikeNewsRequest
+	(*UnlikeNewsRequest)(nil),              // 12: news.UnlikeNewsRequest
+	(*GetLikesCountRequest)(nil),           // 13: news.GetLikesCountRequest
+	(*ListNewsByCategoryRequest)(nil),      // 14: news.ListNewsByCategoryRequest
+	(*ListNewsByTagRequest)(nil),           // 15: news.ListNewsByTagRequest
+	(*SearchNewsRequest)(nil),              // 16: news.SearchNewsRequest
+	(*ListTrendingNewsRequest)(nil),        // 17: news.ListTrendingNewsRequest
+	(*CreateAnnouncementRequest)(nil),      // 18: news.CreateAnnouncementRequest
+	(*SetAnnouncementActiveRequest)(nil),   // 19: news.SetAnnouncementActiveRequest
+	(*GetActiveAnnouncementsRequest)(nil),  // 20: news.GetActiveAnnouncementsRequest
+	(*SubscribeRequest)(nil),               // 21: news.SubscribeRequest
+	(*ConfirmSubscriptionRequest)(nil),     // 22: news.ConfirmSubscriptionRequest
+	(*UnsubscribeRequest)(nil),             // 23: news.UnsubscribeRequest
+	(*CreateNewsResponse)(nil),             // 24: news.CreateNewsResponse
+	(*GetNewsResponse)(nil),                // 25: news.GetNewsResponse
+	(*ListNewsResponse)(nil),               // 26: news.ListNewsResponse
+	(*UpdateNewsResponse)(nil),             // 27: news.UpdateNewsResponse
+	(*DeleteNewsResponse)(nil),             // 28: news.DeleteNewsResponse
+	(*GetAdjacentNewsResponse)(nil),        // 29: news.GetAdjacentNewsResponse
+	(*CreateCommentResponse)(nil),          // 30: news.CreateCommentResponse
+	(*GetCommentsForNewsResponse)(nil),     // 31: news.GetCommentsForNewsResponse
+	(*ListCommentRepliesResponse)(nil),     // 32: news.ListCommentRepliesResponse
+	(*EditCommentResponse)(nil),            // 33: news.EditCommentResponse
+	(*DeleteCommentResponse)(nil),          // 34: news.DeleteCommentResponse
+	(*LikeNewsResponse)(nil),               // 35: news.LikeNewsResponse
+	(*UnlikeNewsResponse)(nil),             // 36: news.UnlikeNewsResponse
+	(*GetLikesCountResponse)(nil),          // 37: news.GetLikesCountResponse
+	(*CreateAnnouncementResponse)(nil),     // 38: news.CreateAnnouncementResponse
+	(*SetAnnouncementActiveResponse)(nil),  // 39: news.SetAnnouncementActiveResponse
+	(*GetActiveAnnouncementsResponse)(nil), // 40: news.GetActiveAnnouncementsResponse
+	(*SubscribeResponse)(nil),              // 41: news.SubscribeResponse
+	(*ConfirmSubscriptionResponse)(nil),    // 42: news.ConfirmSubscriptionResponse
+	(*UnsubscribeResponse)(nil),            // 43: news.UnsubscribeResponse
 }
 var file_service_proto_depIdxs = []int32{
 	0,  // 0: news.NewsService.CreateNews:input_type -> news.CreateNewsRequest
@@ -76,27 +110,51 @@ var file_service_proto_depIdxs = []int32{
 	2,  // 2: news.NewsService.ListNews:input_type -> news.ListNewsRequest
 	3,  // 3: news.NewsService.UpdateNews:input_type -> news.UpdateNewsRequest
 	4,  // 4: news.NewsService.DeleteNews:input_type -> news.DeleteNewsRequest
-	5,  // 5: news.NewsService.CreateComment:input_type -> news.CreateCommentRequest
-	6,  // 6: news.NewsService.GetCommentsForNews:input_type -> news.GetCommentsForNewsRequest
-	7,  // 7: news.NewsService.DeleteComment:input_type -> news.DeleteCommentRequest
-	8,  // 8: news.NewsService.LikeNews:input_type -> news.LikeNewsRequest
-	9,  // 9: news.NewsService.UnlikeNews:input_type -> news.UnlikeNewsRequest
-	10, // 10: news.NewsService.GetLikesCount:input_type -> news.GetLikesCountRequest
-	11, // 11: news.NewsService.ListNewsByCategory:input_type -> news.ListNewsByCategoryRequest
-	12, // 12: news.NewsService.CreateNews:output_type -> news.CreateNewsResponse
-	13, // 13: news.NewsService.GetNews:output_type -> news.GetNewsResponse
-	14, // 14: news.NewsService.ListNews:output_type -> news.ListNewsResponse
-	15, // 15: news.NewsService.UpdateNews:output_type -> news.UpdateNewsResponse
-	16, // 16: news.NewsService.DeleteNews:output_type -> news.DeleteNewsResponse
-	17, // 17: news.NewsService.CreateComment:output_type -> news.CreateCommentResponse
-	18, // 18: news.NewsService.GetCommentsForNews:output_type -> news.GetCommentsForNewsResponse
-	19, // 19: news.NewsService.DeleteComment:output_type -> news.DeleteCommentResponse
-	20, // 20: news.NewsService.LikeNews:output_type -> news.LikeNewsResponse
-	21, // 21: news.NewsService.UnlikeNews:output_type -> news.UnlikeNewsResponse
-	22, // 22: news.NewsService.GetLikesCount:output_type -> news.GetLikesCountResponse
-	14, // 23: news.NewsService.ListNewsByCategory:output_type -> news.ListNewsResponse
-	12, // [12:24] is the sub-list for method output_type
-	0,  // [0:12] is the sub-list for method input_type
+	5,  // 5: news.NewsService.GetAdjacentNews:input_type -> news.GetAdjacentNewsRequest
+	6,  // 6: news.NewsService.CreateComment:input_type -> news.CreateCommentRequest
+	7,  // 7: news.NewsService.GetCommentsForNews:input_type -> news.GetCommentsForNewsRequest
+	8,  // 8: news.NewsService.ListCommentReplies:input_type -> news.ListCommentRepliesRequest
+	9,  // 9: news.NewsService.EditComment:input_type -> news.EditCommentRequest
+	10, // 10: news.NewsService.DeleteComment:input_type -> news.DeleteCommentRequest
+	11, // 11: news.NewsService.LikeNews:input_type -> news.LikeNewsRequest
+	12, // 12: news.NewsService.UnlikeNews:input_type -> news.UnlikeNewsRequest
+	13, // 13: news.NewsService.GetLikesCount:input_type -> news.GetLikesCountRequest
+	14, // 14: news.NewsService.ListNewsByCategory:input_type -> news.ListNewsByCategoryRequest
+	15, // 15: news.NewsService.ListNewsByTag:input_type -> news.ListNewsByTagRequest
+	16, // 16: news.NewsService.SearchNews:input_type -> news.SearchNewsRequest
+	17, // 17: news.NewsService.ListTrendingNews:input_type -> news.ListTrendingNewsRequest
+	18, // 18: news.NewsService.CreateAnnouncement:input_type -> news.CreateAnnouncementRequest
+	19, // 19: news.NewsService.SetAnnouncementActive:input_type -> news.SetAnnouncementActiveRequest
+	20, // 20: news.NewsService.GetActiveAnnouncements:input_type -> news.GetActiveAnnouncementsRequest
+	21, // 21: news.NewsService.Subscribe:input_type -> news.SubscribeRequest
+	22, // 22: news.NewsService.ConfirmSubscription:input_type -> news.ConfirmSubscriptionRequest
+	23, // 23: news.NewsService.Unsubscribe:input_type -> news.UnsubscribeRequest
+	24, // 24: news.NewsService.CreateNews:output_type -> news.CreateNewsResponse
+	25, // 25: news.NewsService.GetNews:output_type -> news.GetNewsResponse
+	26, // 26: news.NewsService.ListNews:output_type -> news.ListNewsResponse
+	27, // 27: news.NewsService.UpdateNews:output_type -> news.UpdateNewsResponse
+	28, // 28: news.NewsService.DeleteNews:output_type -> news.DeleteNewsResponse
+	29, // 29: news.NewsService.GetAdjacentNews:output_type -> news.GetAdjacentNewsResponse
+	30, // 30: news.NewsService.CreateComment:output_type -> news.CreateCommentResponse
+	31, // 31: news.NewsService.GetCommentsForNews:output_type -> news.GetCommentsForNewsResponse
+	32, // 32: news.NewsService.ListCommentReplies:output_type -> news.ListCommentRepliesResponse
+	33, // 33: news.NewsService.EditComment:output_type -> news.EditCommentResponse
+	34, // 34: news.NewsService.DeleteComment:output_type -> news.DeleteCommentResponse
+	35, // 35: news.NewsService.LikeNews:output_type -> news.LikeNewsResponse
+	36, // 36: news.NewsService.UnlikeNews:output_type -> news.UnlikeNewsResponse
+	37, // 37: news.NewsService.GetLikesCount:output_type -> news.GetLikesCountResponse
+	26, // 38: news.NewsService.ListNewsByCategory:output_type -> news.ListNewsResponse
+	26, // 39: news.NewsService.ListNewsByTag:output_type -> news.ListNewsResponse
+	26, // 40: news.NewsService.SearchNews:output_type -> news.ListNewsResponse
+	26, // 41: news.NewsService.ListTrendingNews:output_type -> news.ListNewsResponse
+	38, // 42: news.NewsService.CreateAnnouncement:output_type -> news.CreateAnnouncementResponse
+	39, // 43: news.NewsService.SetAnnouncementActive:output_type -> news.SetAnnouncementActiveResponse
+	40, // 44: news.NewsService.GetActiveAnnouncements:output_type -> news.GetActiveAnnouncementsResponse
+	41, // 45: news.NewsService.Subscribe:output_type -> news.SubscribeResponse
+	42, // 46: news.NewsService.ConfirmSubscription:output_type -> news.ConfirmSubscriptionResponse
+	43, // 47: news.NewsService.Unsubscribe:output_type -> news.UnsubscribeResponse
+	24, // [24:48] is the sub-list for method output_type
+	0,  // [0:24] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -110,6 +168,8 @@ func file_service_proto_init() {
 	file_news_proto_init()
 	file_comment_proto_init()
 	file_like_proto_init()
+	file_announcement_proto_init()
+	file_newsletter_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -19,18 +19,30 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	NewsService_CreateNews_FullMethodName         = "/news.NewsService/CreateNews"
-	NewsService_GetNews_FullMethodName            = "/news.NewsService/GetNews"
-	NewsService_ListNews_FullMethodName           = "/news.NewsService/ListNews"
-	NewsService_UpdateNews_FullMethodName         = "/news.NewsService/UpdateNews"
-	NewsService_DeleteNews_FullMethodName         = "/news.NewsService/DeleteNews"
-	NewsService_CreateComment_FullMethodName      = "/news.NewsService/CreateComment"
-	NewsService_GetCommentsForNews_FullMethodName = "/news.NewsService/GetCommentsForNews"
-	NewsService_DeleteComment_FullMethodName      = "/news.NewsService/DeleteComment"
-	NewsService_LikeNews_FullMethodName           = "/news.NewsService/LikeNews"
-	NewsService_UnlikeNews_FullMethodName         = "/news.NewsService/UnlikeNews"
-	NewsService_GetLikesCount_FullMethodName      = "/news.NewsService/GetLikesCount"
-	NewsService_ListNewsByCategory_FullMethodName = "/news.NewsService/ListNewsByCategory"
+	NewsService_CreateNews_FullMethodName             = "/news.NewsService/CreateNews"
+	NewsService_GetNews_FullMethodName                = "/news.NewsService/GetNews"
+	NewsService_ListNews_FullMethodName               = "/news.NewsService/ListNews"
+	NewsService_UpdateNews_FullMethodName             = "/news.NewsService/UpdateNews"
+	NewsService_DeleteNews_FullMethodName             = "/news.NewsService/DeleteNews"
+	NewsService_GetAdjacentNews_FullMethodName        = "/news.NewsService/GetAdjacentNews"
+	NewsService_CreateComment_FullMethodName          = "/news.NewsService/CreateComment"
+	NewsService_GetCommentsForNews_FullMethodName     = "/news.NewsService/GetCommentsForNews"
+	NewsService_ListCommentReplies_FullMethodName     = "/news.NewsService/ListCommentReplies"
+	NewsService_EditComment_FullMethodName            = "/news.NewsService/EditComment"
+	NewsService_DeleteComment_FullMethodName          = "/news.NewsService/DeleteComment"
+	NewsService_LikeNews_FullMethodName               = "/news.NewsService/LikeNews"
+	NewsService_UnlikeNews_FullMethodName             = "/news.NewsService/UnlikeNews"
+	NewsService_GetLikesCount_FullMethodName          = "/news.NewsService/GetLikesCount"
+	NewsService_ListNewsByCategory_FullMethodName     = "/news.NewsService/ListNewsByCategory"
+	NewsService_ListNewsByTag_FullMethodName          = "/news.NewsService/ListNewsByTag"
+	NewsService_SearchNews_FullMethodName             = "/news.NewsService/SearchNews"
+	NewsService_ListTrendingNews_FullMethodName       = "/news.NewsService/ListTrendingNews"
+	NewsService_CreateAnnouncement_FullMethodName     = "/news.NewsService/CreateAnnouncement"
+	NewsService_SetAnnouncementActive_FullMethodName  = "/news.NewsService/SetAnnouncementActive"
+	NewsService_GetActiveAnnouncements_FullMethodName = "/news.NewsService/GetActiveAnnouncements"
+	NewsService_Subscribe_FullMethodName              = "/news.NewsService/Subscribe"
+	NewsService_ConfirmSubscription_FullMethodName    = "/news.NewsService/ConfirmSubscription"
+	NewsService_Unsubscribe_FullMethodName            = "/news.NewsService/Unsubscribe"
 )
 
 // NewsServiceClient is the client API for NewsService service.
@@ -42,13 +54,25 @@ type NewsServiceClient interface {
 	ListNews(ctx context.Context, in *ListNewsRequest, opts ...grpc.CallOption) (*ListNewsResponse, error)
 	UpdateNews(ctx context.Context, in *UpdateNewsRequest, opts ...grpc.CallOption) (*UpdateNewsResponse, error)
 	DeleteNews(ctx context.Context, in *DeleteNewsRequest, opts ...grpc.CallOption) (*DeleteNewsResponse, error)
+	GetAdjacentNews(ctx context.Context, in *GetAdjacentNewsRequest, opts ...grpc.CallOption) (*GetAdjacentNewsResponse, error)
 	CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*CreateCommentResponse, error)
 	GetCommentsForNews(ctx context.Context, in *GetCommentsForNewsRequest, opts ...grpc.CallOption) (*GetCommentsForNewsResponse, error)
+	ListCommentReplies(ctx context.Context, in *ListCommentRepliesRequest, opts ...grpc.CallOption) (*ListCommentRepliesResponse, error)
+	EditComment(ctx context.Context, in *EditCommentRequest, opts ...grpc.CallOption) (*EditCommentResponse, error)
 	DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error)
 	LikeNews(ctx context.Context, in *LikeNewsRequest, opts ...grpc.CallOption) (*LikeNewsResponse, error)
 	UnlikeNews(ctx context.Context, in *UnlikeNewsRequest, opts ...grpc.CallOption) (*UnlikeNewsResponse, error)
 	GetLikesCount(ctx context.Context, in *GetLikesCountRequest, opts ...grpc.CallOption) (*GetLikesCountResponse, error)
 	ListNewsByCategory(ctx context.Context, in *ListNewsByCategoryRequest, opts ...grpc.CallOption) (*ListNewsResponse, error)
+	ListNewsByTag(ctx context.Context, in *ListNewsByTagRequest, opts ...grpc.CallOption) (*ListNewsResponse, error)
+	SearchNews(ctx context.Context, in *SearchNewsRequest, opts ...grpc.CallOption) (*ListNewsResponse, error)
+	ListTrendingNews(ctx context.Context, in *ListTrendingNewsRequest, opts ...grpc.CallOption) (*ListNewsResponse, error)
+	CreateAnnouncement(ctx context.Context, in *CreateAnnouncementRequest, opts ...grpc.CallOption) (*CreateAnnouncementResponse, error)
+	SetAnnouncementActive(ctx context.Context, in *SetAnnouncementActiveRequest, opts ...grpc.CallOption) (*SetAnnouncementActiveResponse, error)
+	GetActiveAnnouncements(ctx context.Context, in *GetActiveAnnouncementsRequest, opts ...grpc.CallOption) (*GetActiveAnnouncementsResponse, error)
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (*SubscribeResponse, error)
+	ConfirmSubscription(ctx context.Context, in *ConfirmSubscriptionRequest, opts ...grpc.CallOption) (*ConfirmSubscriptionResponse, error)
+	Unsubscribe(ctx context.Context, in *UnsubscribeRequest, opts ...grpc.CallOption) (*UnsubscribeResponse, error)
 }
 
 type newsServiceClient struct {
@@ -109,6 +133,16 @@ func (c *newsServiceClient) DeleteNews(ctx context.Context, in *DeleteNewsReques
 	return out, nil
 }
 
+func (c *newsServiceClient) GetAdjacentNews(ctx context.Context, in *GetAdjacentNewsRequest, opts ...grpc.CallOption) (*GetAdjacentNewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAdjacentNewsResponse)
+	err := c.cc.Invoke(ctx, NewsService_GetAdjacentNews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *newsServiceClient) CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*CreateCommentResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateCommentResponse)
@@ -129,6 +163,26 @@ func (c *newsServiceClient) GetCommentsForNews(ctx context.Context, in *GetComme
 	return out, nil
 }
 
+func (c *newsServiceClient) ListCommentReplies(ctx context.Context, in *ListCommentRepliesRequest, opts ...grpc.CallOption) (*ListCommentRepliesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCommentRepliesResponse)
+	err := c.cc.Invoke(ctx, NewsService_ListCommentReplies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsServiceClient) EditComment(ctx context.Context, in *EditCommentRequest, opts ...grpc.CallOption) (*EditCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EditCommentResponse)
+	err := c.cc.Invoke(ctx, NewsService_EditComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *newsServiceClient) DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteCommentResponse)
@@ -179,6 +233,96 @@ func (c *newsServiceClient) ListNewsByCategory(ctx context.Context, in *ListNews
 	return out, nil
 }
 
+func (c *newsServiceClient) ListNewsByTag(ctx context.Context, in *ListNewsByTagRequest, opts ...grpc.CallOption) (*ListNewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNewsResponse)
+	err := c.cc.Invoke(ctx, NewsService_ListNewsByTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsServiceClient) SearchNews(ctx context.Context, in *SearchNewsRequest, opts ...grpc.CallOption) (*ListNewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNewsResponse)
+	err := c.cc.Invoke(ctx, NewsService_SearchNews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsServiceClient) ListTrendingNews(ctx context.Context, in *ListTrendingNewsRequest, opts ...grpc.CallOption) (*ListNewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNewsResponse)
+	err := c.cc.Invoke(ctx, NewsService_ListTrendingNews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsServiceClient) CreateAnnouncement(ctx context.Context, in *CreateAnnouncementRequest, opts ...grpc.CallOption) (*CreateAnnouncementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAnnouncementResponse)
+	err := c.cc.Invoke(ctx, NewsService_CreateAnnouncement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsServiceClient) SetAnnouncementActive(ctx context.Context, in *SetAnnouncementActiveRequest, opts ...grpc.CallOption) (*SetAnnouncementActiveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetAnnouncementActiveResponse)
+	err := c.cc.Invoke(ctx, NewsService_SetAnnouncementActive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsServiceClient) GetActiveAnnouncements(ctx context.Context, in *GetActiveAnnouncementsRequest, opts ...grpc.CallOption) (*GetActiveAnnouncementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetActiveAnnouncementsResponse)
+	err := c.cc.Invoke(ctx, NewsService_GetActiveAnnouncements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsServiceClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (*SubscribeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubscribeResponse)
+	err := c.cc.Invoke(ctx, NewsService_Subscribe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsServiceClient) ConfirmSubscription(ctx context.Context, in *ConfirmSubscriptionRequest, opts ...grpc.CallOption) (*ConfirmSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmSubscriptionResponse)
+	err := c.cc.Invoke(ctx, NewsService_ConfirmSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsServiceClient) Unsubscribe(ctx context.Context, in *UnsubscribeRequest, opts ...grpc.CallOption) (*UnsubscribeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnsubscribeResponse)
+	err := c.cc.Invoke(ctx, NewsService_Unsubscribe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NewsServiceServer is the server API for NewsService service.
 // All implementations must embed UnimplementedNewsServiceServer
 // for forward compatibility.
@@ -188,13 +332,25 @@ type NewsServiceServer interface {
 	ListNews(context.Context, *ListNewsRequest) (*ListNewsResponse, error)
 	UpdateNews(context.Context, *UpdateNewsRequest) (*UpdateNewsResponse, error)
 	DeleteNews(context.Context, *DeleteNewsRequest) (*DeleteNewsResponse, error)
+	GetAdjacentNews(context.Context, *GetAdjacentNewsRequest) (*GetAdjacentNewsResponse, error)
 	CreateComment(context.Context, *CreateCommentRequest) (*CreateCommentResponse, error)
 	GetCommentsForNews(context.Context, *GetCommentsForNewsRequest) (*GetCommentsForNewsResponse, error)
+	ListCommentReplies(context.Context, *ListCommentRepliesRequest) (*ListCommentRepliesResponse, error)
+	EditComment(context.Context, *EditCommentRequest) (*EditCommentResponse, error)
 	DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error)
 	LikeNews(context.Context, *LikeNewsRequest) (*LikeNewsResponse, error)
 	UnlikeNews(context.Context, *UnlikeNewsRequest) (*UnlikeNewsResponse, error)
 	GetLikesCount(context.Context, *GetLikesCountRequest) (*GetLikesCountResponse, error)
 	ListNewsByCategory(context.Context, *ListNewsByCategoryRequest) (*ListNewsResponse, error)
+	ListNewsByTag(context.Context, *ListNewsByTagRequest) (*ListNewsResponse, error)
+	SearchNews(context.Context, *SearchNewsRequest) (*ListNewsResponse, error)
+	ListTrendingNews(context.Context, *ListTrendingNewsRequest) (*ListNewsResponse, error)
+	CreateAnnouncement(context.Context, *CreateAnnouncementRequest) (*CreateAnnouncementResponse, error)
+	SetAnnouncementActive(context.Context, *SetAnnouncementActiveRequest) (*SetAnnouncementActiveResponse, error)
+	GetActiveAnnouncements(context.Context, *GetActiveAnnouncementsRequest) (*GetActiveAnnouncementsResponse, error)
+	Subscribe(context.Context, *SubscribeRequest) (*SubscribeResponse, error)
+	ConfirmSubscription(context.Context, *ConfirmSubscriptionRequest) (*ConfirmSubscriptionResponse, error)
+	Unsubscribe(context.Context, *UnsubscribeRequest) (*UnsubscribeResponse, error)
 	mustEmbedUnimplementedNewsServiceServer()
 }
 
@@ -220,12 +376,21 @@ func (UnimplementedNewsServiceServer) UpdateNews(context.Context, *UpdateNewsReq
 func (UnimplementedNewsServiceServer) DeleteNews(context.Context, *DeleteNewsRequest) (*DeleteNewsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteNews not implemented")
 }
+func (UnimplementedNewsServiceServer) GetAdjacentNews(context.Context, *GetAdjacentNewsRequest) (*GetAdjacentNewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAdjacentNews not implemented")
+}
 func (UnimplementedNewsServiceServer) CreateComment(context.Context, *CreateCommentRequest) (*CreateCommentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateComment not implemented")
 }
 func (UnimplementedNewsServiceServer) GetCommentsForNews(context.Context, *GetCommentsForNewsRequest) (*GetCommentsForNewsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCommentsForNews not implemented")
 }
+func (UnimplementedNewsServiceServer) ListCommentReplies(context.Context, *ListCommentRepliesRequest) (*ListCommentRepliesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCommentReplies not implemented")
+}
+func (UnimplementedNewsServiceServer) EditComment(context.Context, *EditCommentRequest) (*EditCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditComment not implemented")
+}
 func (UnimplementedNewsServiceServer) DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
 }
@@ -241,6 +406,33 @@ func (UnimplementedNewsServiceServer) GetLikesCount(context.Context, *GetLikesCo
 func (UnimplementedNewsServiceServer) ListNewsByCategory(context.Context, *ListNewsByCategoryRequest) (*ListNewsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListNewsByCategory not implemented")
 }
+func (UnimplementedNewsServiceServer) ListNewsByTag(context.Context, *ListNewsByTagRequest) (*ListNewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNewsByTag not implemented")
+}
+func (UnimplementedNewsServiceServer) SearchNews(context.Context, *SearchNewsRequest) (*ListNewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchNews not implemented")
+}
+func (UnimplementedNewsServiceServer) ListTrendingNews(context.Context, *ListTrendingNewsRequest) (*ListNewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrendingNews not implemented")
+}
+func (UnimplementedNewsServiceServer) CreateAnnouncement(context.Context, *CreateAnnouncementRequest) (*CreateAnnouncementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAnnouncement not implemented")
+}
+func (UnimplementedNewsServiceServer) SetAnnouncementActive(context.Context, *SetAnnouncementActiveRequest) (*SetAnnouncementActiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAnnouncementActive not implemented")
+}
+func (UnimplementedNewsServiceServer) GetActiveAnnouncements(context.Context, *GetActiveAnnouncementsRequest) (*GetActiveAnnouncementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActiveAnnouncements not implemented")
+}
+func (UnimplementedNewsServiceServer) Subscribe(context.Context, *SubscribeRequest) (*SubscribeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (UnimplementedNewsServiceServer) ConfirmSubscription(context.Context, *ConfirmSubscriptionRequest) (*ConfirmSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmSubscription not implemented")
+}
+func (UnimplementedNewsServiceServer) Unsubscribe(context.Context, *UnsubscribeRequest) (*UnsubscribeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unsubscribe not implemented")
+}
 func (UnimplementedNewsServiceServer) mustEmbedUnimplementedNewsServiceServer() {}
 func (UnimplementedNewsServiceServer) testEmbeddedByValue()                     {}
 
@@ -352,6 +544,24 @@ func _NewsService_DeleteNews_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NewsService_GetAdjacentNews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAdjacentNewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsServiceServer).GetAdjacentNews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsService_GetAdjacentNews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsServiceServer).GetAdjacentNews(ctx, req.(*GetAdjacentNewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _NewsService_CreateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateCommentRequest)
 	if err := dec(in); err != nil {
@@ -388,6 +598,42 @@ func _NewsService_GetCommentsForNews_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NewsService_ListCommentReplies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommentRepliesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsServiceServer).ListCommentReplies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsService_ListCommentReplies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsServiceServer).ListCommentReplies(ctx, req.(*ListCommentRepliesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsService_EditComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsServiceServer).EditComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsService_EditComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsServiceServer).EditComment(ctx, req.(*EditCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _NewsService_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteCommentRequest)
 	if err := dec(in); err != nil {
@@ -478,6 +724,168 @@ func _NewsService_ListNewsByCategory_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NewsService_ListNewsByTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNewsByTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsServiceServer).ListNewsByTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsService_ListNewsByTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsServiceServer).ListNewsByTag(ctx, req.(*ListNewsByTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsService_SearchNews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchNewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsServiceServer).SearchNews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsService_SearchNews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsServiceServer).SearchNews(ctx, req.(*SearchNewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsService_ListTrendingNews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrendingNewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsServiceServer).ListTrendingNews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsService_ListTrendingNews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsServiceServer).ListTrendingNews(ctx, req.(*ListTrendingNewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsService_CreateAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAnnouncementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsServiceServer).CreateAnnouncement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsService_CreateAnnouncement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsServiceServer).CreateAnnouncement(ctx, req.(*CreateAnnouncementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsService_SetAnnouncementActive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAnnouncementActiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsServiceServer).SetAnnouncementActive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsService_SetAnnouncementActive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsServiceServer).SetAnnouncementActive(ctx, req.(*SetAnnouncementActiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsService_GetActiveAnnouncements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActiveAnnouncementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsServiceServer).GetActiveAnnouncements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsService_GetActiveAnnouncements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsServiceServer).GetActiveAnnouncements(ctx, req.(*GetActiveAnnouncementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsService_Subscribe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsServiceServer).Subscribe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsService_Subscribe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsServiceServer).Subscribe(ctx, req.(*SubscribeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsService_ConfirmSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsServiceServer).ConfirmSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsService_ConfirmSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsServiceServer).ConfirmSubscription(ctx, req.(*ConfirmSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsService_Unsubscribe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubscribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsServiceServer).Unsubscribe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsService_Unsubscribe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsServiceServer).Unsubscribe(ctx, req.(*UnsubscribeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NewsService_ServiceDesc is the grpc.ServiceDesc for NewsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -505,6 +913,10 @@ var NewsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteNews",
 			Handler:    _NewsService_DeleteNews_Handler,
 		},
+		{
+			MethodName: "GetAdjacentNews",
+			Handler:    _NewsService_GetAdjacentNews_Handler,
+		},
 		{
 			MethodName: "CreateComment",
 			Handler:    _NewsService_CreateComment_Handler,
@@ -513,6 +925,14 @@ var NewsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCommentsForNews",
 			Handler:    _NewsService_GetCommentsForNews_Handler,
 		},
+		{
+			MethodName: "ListCommentReplies",
+			Handler:    _NewsService_ListCommentReplies_Handler,
+		},
+		{
+			MethodName: "EditComment",
+			Handler:    _NewsService_EditComment_Handler,
+		},
 		{
 			MethodName: "DeleteComment",
 			Handler:    _NewsService_DeleteComment_Handler,
@@ -533,6 +953,42 @@ var NewsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListNewsByCategory",
 			Handler:    _NewsService_ListNewsByCategory_Handler,
 		},
+		{
+			MethodName: "ListNewsByTag",
+			Handler:    _NewsService_ListNewsByTag_Handler,
+		},
+		{
+			MethodName: "SearchNews",
+			Handler:    _NewsService_SearchNews_Handler,
+		},
+		{
+			MethodName: "ListTrendingNews",
+			Handler:    _NewsService_ListTrendingNews_Handler,
+		},
+		{
+			MethodName: "CreateAnnouncement",
+			Handler:    _NewsService_CreateAnnouncement_Handler,
+		},
+		{
+			MethodName: "SetAnnouncementActive",
+			Handler:    _NewsService_SetAnnouncementActive_Handler,
+		},
+		{
+			MethodName: "GetActiveAnnouncements",
+			Handler:    _NewsService_GetActiveAnnouncements_Handler,
+		},
+		{
+			MethodName: "Subscribe",
+			Handler:    _NewsService_Subscribe_Handler,
+		},
+		{
+			MethodName: "ConfirmSubscription",
+			Handler:    _NewsService_ConfirmSubscription_Handler,
+		},
+		{
+			MethodName: "Unsubscribe",
+			Handler:    _NewsService_Unsubscribe_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service.proto",
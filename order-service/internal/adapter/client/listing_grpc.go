@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -8,6 +9,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/metadata"
 )
 
 const (
@@ -16,6 +18,9 @@ const (
 
 type ListingServiceClientConfig struct {
 	Address string // Например, "localhost:50053" или "listing-service:50053" в Docker
+	// InternalToken отправляется в x-internal-token; без него listing-service отклоняет
+	// ReserveStock и ReleaseStock
+	InternalToken string
 }
 
 func NewListingServiceClient(cfg ListingServiceClientConfig) (listingpb.ListingServiceClient, *grpc.ClientConn, error) {
@@ -31,6 +36,9 @@ func NewListingServiceClient(cfg ListingServiceClientConfig) (listingpb.ListingS
 			PermitWithoutStream: true,
 		}),
 	}
+	if cfg.InternalToken != "" {
+		dialOpts = append(dialOpts, grpc.WithUnaryInterceptor(internalTokenInterceptor(cfg.InternalToken)))
+	}
 
 	conn, err := grpc.Dial(cfg.Address, dialOpts...)
 	if err != nil {
@@ -41,3 +49,11 @@ func NewListingServiceClient(cfg ListingServiceClientConfig) (listingpb.ListingS
 
 	return client, conn, nil
 }
+
+// internalTokenInterceptor добавляет токен сервиса в метаданные каждого вызова listing-service.
+func internalTokenInterceptor(token string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx = metadata.AppendToOutgoingContext(ctx, "x-internal-token", token)
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
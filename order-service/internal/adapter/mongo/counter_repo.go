@@ -0,0 +1,42 @@
+package mongo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Abdurahmanit/GroupProject/order-service/internal/app/config"
+	"github.com/Abdurahmanit/GroupProject/order-service/internal/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	counterCollectionName = "counters"
+)
+
+type counterRepository struct {
+	collection *mongo.Collection
+}
+
+func NewCounterRepository(db *mongo.Client, cfg config.MongoDBConfig) repository.CounterRepository {
+	return &counterRepository{
+		collection: db.Database(cfg.Database).Collection(counterCollectionName),
+	}
+}
+
+// Next atomically increments the named counter, creating it on first use.
+func (r *counterRepository) Next(ctx context.Context, name string) (int64, error) {
+	opts := options.FindOneAndUpdate().
+		SetUpsert(true).
+		SetReturnDocument(options.After)
+
+	var counter struct {
+		Seq int64 `bson:"seq"`
+	}
+	err := r.collection.FindOneAndUpdate(ctx, bson.M{"_id": name}, bson.M{"$inc": bson.M{"seq": 1}}, opts).Decode(&counter)
+	if err != nil {
+		return 0, fmt.Errorf("failed to increment counter %s: %w", name, err)
+	}
+	return counter.Seq, nil
+}
@@ -0,0 +1,136 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Abdurahmanit/GroupProject/order-service/internal/app/config"
+	"github.com/Abdurahmanit/GroupProject/order-service/internal/domain/entity"
+	"github.com/Abdurahmanit/GroupProject/order-service/internal/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	couponCollectionName           = "coupons"
+	couponRedemptionCollectionName = "coupon_redemptions"
+)
+
+type couponRedemption struct {
+	CouponID  string    `bson:"coupon_id"`
+	UserID    string    `bson:"user_id"`
+	OrderID   string    `bson:"order_id"`
+	CreatedAt time.Time `bson:"created_at"`
+}
+
+type couponRepository struct {
+	coupons     *mongo.Collection
+	redemptions *mongo.Collection
+}
+
+func NewCouponRepository(db *mongo.Client, cfg config.MongoDBConfig) repository.CouponRepository {
+	database := db.Database(cfg.Database)
+	return &couponRepository{
+		coupons:     database.Collection(couponCollectionName),
+		redemptions: database.Collection(couponRedemptionCollectionName),
+	}
+}
+
+// EnsureCouponIndexes makes coupon codes unique and indexes redemptions for the per-user
+// usage check.
+func EnsureCouponIndexes(ctx context.Context, db *mongo.Client, cfg config.MongoDBConfig) error {
+	database := db.Database(cfg.Database)
+	_, err := database.Collection(couponCollectionName).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "code", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create coupon code index: %w", err)
+	}
+	_, err = database.Collection(couponRedemptionCollectionName).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "coupon_id", Value: 1}, {Key: "user_id", Value: 1}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create coupon_id/user_id redemption index: %w", err)
+	}
+	return nil
+}
+
+func (r *couponRepository) GetByCode(ctx context.Context, code string) (*entity.Coupon, error) {
+	var coupon entity.Coupon
+	err := r.coupons.FindOne(ctx, bson.M{"code": code}).Decode(&coupon)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, repository.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get coupon %s: %w", code, err)
+	}
+	return &coupon, nil
+}
+
+func (r *couponRepository) CountUserRedemptions(ctx context.Context, couponID, userID string) (int, error) {
+	count, err := r.redemptions.CountDocuments(ctx, bson.M{"coupon_id": couponID, "user_id": userID})
+	if err != nil {
+		return 0, fmt.Errorf("failed to count redemptions of coupon %s by user %s: %w", couponID, userID, err)
+	}
+	return int(count), nil
+}
+
+func (r *couponRepository) ClaimUse(ctx context.Context, couponID string) (bool, error) {
+	objID, err := primitive.ObjectIDFromHex(couponID)
+	if err != nil {
+		return false, fmt.Errorf("invalid coupon ID format %s: %w", couponID, repository.ErrUpdateFailed)
+	}
+	filter := bson.M{
+		"_id": objID,
+		"$or": bson.A{
+			bson.M{"max_uses": bson.M{"$exists": false}},
+			bson.M{"max_uses": 0},
+			bson.M{"$expr": bson.M{"$lt": bson.A{"$used_count", "$max_uses"}}},
+		},
+	}
+	result, err := r.coupons.UpdateOne(ctx, filter, bson.M{"$inc": bson.M{"used_count": 1}})
+	if err != nil {
+		return false, fmt.Errorf("failed to claim use of coupon %s: %w", couponID, err)
+	}
+	if result.MatchedCount == 0 {
+		count, err := r.coupons.CountDocuments(ctx, bson.M{"_id": objID})
+		if err != nil {
+			return false, fmt.Errorf("failed to check coupon %s: %w", couponID, err)
+		}
+		if count == 0 {
+			return false, repository.ErrNotFound
+		}
+		return false, nil
+	}
+	return true, nil
+}
+
+func (r *couponRepository) ReleaseUse(ctx context.Context, couponID string) error {
+	objID, err := primitive.ObjectIDFromHex(couponID)
+	if err != nil {
+		return fmt.Errorf("invalid coupon ID format %s: %w", couponID, repository.ErrUpdateFailed)
+	}
+	_, err = r.coupons.UpdateOne(ctx, bson.M{"_id": objID, "used_count": bson.M{"$gt": 0}}, bson.M{"$inc": bson.M{"used_count": -1}})
+	if err != nil {
+		return fmt.Errorf("failed to release use of coupon %s: %w", couponID, err)
+	}
+	return nil
+}
+
+func (r *couponRepository) RecordRedemption(ctx context.Context, couponID, userID, orderID string) error {
+	_, err := r.redemptions.InsertOne(ctx, couponRedemption{
+		CouponID:  couponID,
+		UserID:    userID,
+		OrderID:   orderID,
+		CreatedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record redemption of coupon %s for order %s: %w", couponID, orderID, err)
+	}
+	return nil
+}
@@ -36,6 +36,7 @@ func NewOrderRepository(db *mongo.Client, cfg config.MongoDBConfig) repository.O
 func (r *orderRepository) Create(ctx context.Context, params repository.CreateOrderParams) (string, error) {
 	now := time.Now().UTC()
 	order := entity.Order{
+		OrderNumber:     params.OrderNumber,
 		UserID:          params.UserID,
 		Items:           params.Items,
 		TotalAmount:     params.TotalAmount,
@@ -43,6 +44,7 @@ func (r *orderRepository) Create(ctx context.Context, params repository.CreateOr
 		ShippingAddress: params.ShippingAddress,
 		BillingAddress:  params.BillingAddress,
 		PaymentDetails:  params.PaymentDetails,
+		Coupon:          params.Coupon,
 		CreatedAt:       now,
 		UpdatedAt:       now,
 		Version:         1,
@@ -50,6 +52,9 @@ func (r *orderRepository) Create(ctx context.Context, params repository.CreateOr
 
 	res, err := r.collection.InsertOne(ctx, order)
 	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return "", fmt.Errorf("order number %s is already taken: %w", params.OrderNumber, repository.ErrAlreadyExists)
+		}
 		return "", fmt.Errorf("failed to create order: %w", err)
 	}
 
@@ -78,6 +83,58 @@ func (r *orderRepository) GetByID(ctx context.Context, orderID string) (*entity.
 	return &order, nil
 }
 
+func (r *orderRepository) GetByOrderNumber(ctx context.Context, orderNumber string) (*entity.Order, error) {
+	var order entity.Order
+	err := r.collection.FindOne(ctx, bson.M{"order_number": orderNumber}).Decode(&order)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, repository.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get order by number %s: %w", orderNumber, err)
+	}
+	return &order, nil
+}
+
+func (r *orderRepository) FindEarliestWithProduct(ctx context.Context, userID, productID string, statuses []entity.OrderStatus) (*entity.Order, error) {
+	filter := bson.M{
+		"user_id":          userID,
+		"items.product_id": productID,
+		"status":           bson.M{"$in": statuses},
+	}
+	opts := options.FindOne().SetSort(bson.D{{Key: "created_at", Value: 1}})
+
+	var order entity.Order
+	err := r.collection.FindOne(ctx, filter, opts).Decode(&order)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, repository.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to find order of product %s for user %s: %w", productID, userID, err)
+	}
+	return &order, nil
+}
+
+// EnsureOrderIndexes creates the unique index backing order numbers and the index used to
+// verify purchases. Orders created before order numbers existed have no order_number field,
+// so that index is sparse.
+func EnsureOrderIndexes(ctx context.Context, db *mongo.Client, cfg config.MongoDBConfig) error {
+	collection := db.Database(cfg.Database).Collection(orderCollectionName)
+	_, err := collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "order_number", Value: 1}},
+		Options: options.Index().SetUnique(true).SetSparse(true),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create order_number index: %w", err)
+	}
+	_, err = collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "items.product_id", Value: 1}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create user_id/items.product_id index: %w", err)
+	}
+	return nil
+}
+
 func (r *orderRepository) UpdateStatus(ctx context.Context, params repository.UpdateOrderStatusParams) error {
 	objID, err := primitive.ObjectIDFromHex(params.OrderID)
 	if err != nil {
@@ -160,6 +217,44 @@ func (r *orderRepository) UpdatePaymentDetails(ctx context.Context, params repos
 	return nil
 }
 
+func (r *orderRepository) UpdateRefund(ctx context.Context, params repository.UpdateOrderRefundParams) error {
+	objID, err := primitive.ObjectIDFromHex(params.OrderID)
+	if err != nil {
+		return fmt.Errorf("invalid order ID format for update refund: %w", repository.ErrUpdateFailed)
+	}
+
+	filter := bson.M{
+		"_id":     objID,
+		"version": params.Version,
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"refund":     params.Refund,
+			"status":     params.Status,
+			"updated_at": time.Now().UTC(),
+		},
+		"$inc": bson.M{"version": 1},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("failed to update order refund for ID %s: %w", params.OrderID, err)
+	}
+
+	if result.MatchedCount == 0 {
+		var existingOrder entity.Order
+		errFind := r.collection.FindOne(ctx, bson.M{"_id": objID}).Decode(&existingOrder)
+		if errors.Is(errFind, mongo.ErrNoDocuments) {
+			return repository.ErrNotFound
+		}
+		if errFind == nil && existingOrder.Version != params.Version {
+			return repository.ErrOptimisticLock
+		}
+		return repository.ErrUpdateFailed
+	}
+	return nil
+}
+
 func (r *orderRepository) List(ctx context.Context, params repository.ListOrdersParams) (*repository.ListOrdersResult, error) {
 	filter := bson.M{}
 	if params.UserID != "" {
@@ -168,6 +263,26 @@ func (r *orderRepository) List(ctx context.Context, params repository.ListOrders
 	if params.Status != "" {
 		filter["status"] = params.Status
 	}
+	if params.CreatedAfter != nil || params.CreatedBefore != nil {
+		createdAt := bson.M{}
+		if params.CreatedAfter != nil {
+			createdAt["$gte"] = *params.CreatedAfter
+		}
+		if params.CreatedBefore != nil {
+			createdAt["$lt"] = *params.CreatedBefore
+		}
+		filter["created_at"] = createdAt
+	}
+	if params.MinTotal != nil || params.MaxTotal != nil {
+		total := bson.M{}
+		if params.MinTotal != nil {
+			total["$gte"] = *params.MinTotal
+		}
+		if params.MaxTotal != nil {
+			total["$lte"] = *params.MaxTotal
+		}
+		filter["total_amount"] = total
+	}
 
 	findOptions := options.Find()
 	if params.PageSize > 0 {
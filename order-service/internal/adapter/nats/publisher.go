@@ -5,12 +5,16 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/Abdurahmanit/GroupProject/platform/natsdedup"
 	"github.com/nats-io/nats.go"
 )
 
 type MessagePublisher interface {
 	Publish(ctx context.Context, subject string, message interface{}) error
 	PublishRaw(ctx context.Context, subject string, data []byte) error
+	// PublishWithID publishes message with msgID in the Nats-Msg-Id header so consumers can
+	// drop redeliveries and retried publishes of the same event.
+	PublishWithID(ctx context.Context, subject, msgID string, message interface{}) error
 }
 
 type natsPublisher struct {
@@ -50,3 +54,20 @@ func (p *natsPublisher) PublishRaw(ctx context.Context, subject string, data []b
 
 	return nil
 }
+
+func (p *natsPublisher) PublishWithID(ctx context.Context, subject, msgID string, message interface{}) error {
+	if p.conn == nil {
+		return fmt.Errorf("NATS connection is not initialized")
+	}
+
+	data, err := json.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("failed to marshal message to JSON for subject %s: %w", subject, err)
+	}
+
+	if err := p.conn.PublishMsg(natsdedup.NewMsg(subject, msgID, data)); err != nil {
+		return fmt.Errorf("failed to publish message to NATS subject %s: %w", subject, err)
+	}
+
+	return nil
+}
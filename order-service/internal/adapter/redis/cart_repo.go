@@ -14,6 +14,9 @@ import (
 
 const (
 	cartKeyPrefix = "cart:"
+	// cartsByUpdateKey is a sorted set of user IDs scored by the cart's last update (unix seconds).
+	// Cart keys expire on their own, so members may outlive their carts and are pruned on read.
+	cartsByUpdateKey = "carts:updated_at"
 )
 
 type cartRepository struct {
@@ -61,8 +64,10 @@ func (r *cartRepository) Save(ctx context.Context, cart *entity.Cart, ttl time.D
 		return fmt.Errorf("failed to marshal cart for user %s: %w", cart.UserID, err)
 	}
 
-	err = r.client.Set(ctx, key, data, ttl).Err()
-	if err != nil {
+	pipe := r.client.TxPipeline()
+	pipe.Set(ctx, key, data, ttl)
+	pipe.ZAdd(ctx, cartsByUpdateKey, redis.Z{Score: float64(cart.UpdatedAt.Unix()), Member: cart.UserID})
+	if _, err = pipe.Exec(ctx); err != nil {
 		return fmt.Errorf("failed to save cart for user %s to redis: %w", cart.UserID, err)
 	}
 	return nil
@@ -70,9 +75,61 @@ func (r *cartRepository) Save(ctx context.Context, cart *entity.Cart, ttl time.D
 
 func (r *cartRepository) DeleteByUserID(ctx context.Context, userID string) error {
 	key := r.getCartKey(userID)
-	err := r.client.Del(ctx, key).Err()
-	if err != nil {
+	pipe := r.client.TxPipeline()
+	pipe.Del(ctx, key)
+	pipe.ZRem(ctx, cartsByUpdateKey, userID)
+	if _, err := pipe.Exec(ctx); err != nil {
 		return fmt.Errorf("failed to delete cart for user %s from redis: %w", userID, err)
 	}
 	return nil
 }
+
+func (r *cartRepository) ListUpdatedBefore(ctx context.Context, before time.Time, limit int) ([]*entity.Cart, error) {
+	if limit <= 0 {
+		return []*entity.Cart{}, nil
+	}
+	userIDs, err := r.client.ZRangeByScore(ctx, cartsByUpdateKey, &redis.ZRangeBy{
+		Min:   "-inf",
+		Max:   fmt.Sprintf("(%d", before.Unix()),
+		Count: int64(limit),
+	}).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list carts updated before %s: %w", before.Format(time.RFC3339), err)
+	}
+	if len(userIDs) == 0 {
+		return []*entity.Cart{}, nil
+	}
+
+	keys := make([]string, len(userIDs))
+	for i, userID := range userIDs {
+		keys[i] = r.getCartKey(userID)
+	}
+	values, err := r.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load carts updated before %s: %w", before.Format(time.RFC3339), err)
+	}
+
+	carts := make([]*entity.Cart, 0, len(values))
+	var stale []interface{}
+	for i, val := range values {
+		raw, ok := val.(string)
+		if !ok {
+			// Корзина истекла по TTL, а запись в индексе осталась
+			stale = append(stale, userIDs[i])
+			continue
+		}
+		var cart entity.Cart
+		if err := json.Unmarshal([]byte(raw), &cart); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal cart data for user %s: %w", userIDs[i], err)
+		}
+		if len(cart.Items) == 0 {
+			continue
+		}
+		carts = append(carts, &cart)
+	}
+	if len(stale) > 0 {
+		// Ошибка очистки не критична: устаревшие записи будут удалены при следующем чтении
+		_ = r.client.ZRem(ctx, cartsByUpdateKey, stale...).Err()
+	}
+	return carts, nil
+}
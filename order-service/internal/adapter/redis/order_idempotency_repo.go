@@ -0,0 +1,47 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Abdurahmanit/GroupProject/order-service/internal/repository"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	orderIdempotencyKeyPrefix = "order_idempotency:"
+)
+
+type orderIdempotencyStore struct {
+	client *redis.Client
+}
+
+func NewOrderIdempotencyStore(client *redis.Client) repository.OrderIdempotencyStore {
+	return &orderIdempotencyStore{
+		client: client,
+	}
+}
+
+func (s *orderIdempotencyStore) getKey(userID, key string) string {
+	return orderIdempotencyKeyPrefix + userID + ":" + key
+}
+
+func (s *orderIdempotencyStore) Get(ctx context.Context, userID, key string) (string, error) {
+	orderID, err := s.client.Get(ctx, s.getKey(userID, key)).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", repository.ErrNotFound
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get idempotency key for user %s: %w", userID, err)
+	}
+	return orderID, nil
+}
+
+func (s *orderIdempotencyStore) Save(ctx context.Context, userID, key, orderID string, ttl time.Duration) error {
+	if err := s.client.Set(ctx, s.getKey(userID, key), orderID, ttl).Err(); err != nil {
+		return fmt.Errorf("failed to save idempotency key for user %s: %w", userID, err)
+	}
+	return nil
+}
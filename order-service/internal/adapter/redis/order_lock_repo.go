@@ -0,0 +1,63 @@
+package redis
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/Abdurahmanit/GroupProject/order-service/internal/repository"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	orderLockKeyPrefix = "order_lock:"
+)
+
+// releaseLockScript deletes the lock only if it still holds our token, so a placement that
+// outlived its TTL cannot release a lock taken by the next one.
+var releaseLockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+type orderPlacementLock struct {
+	client *redis.Client
+}
+
+func NewOrderPlacementLock(client *redis.Client) repository.OrderPlacementLock {
+	return &orderPlacementLock{
+		client: client,
+	}
+}
+
+func (l *orderPlacementLock) getLockKey(userID string) string {
+	return orderLockKeyPrefix + userID
+}
+
+func (l *orderPlacementLock) Acquire(ctx context.Context, userID string, ttl time.Duration) (string, bool, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", false, fmt.Errorf("failed to generate lock token: %w", err)
+	}
+	token := hex.EncodeToString(buf)
+
+	acquired, err := l.client.SetNX(ctx, l.getLockKey(userID), token, ttl).Result()
+	if err != nil {
+		return "", false, fmt.Errorf("failed to acquire order lock for user %s: %w", userID, err)
+	}
+	if !acquired {
+		return "", false, nil
+	}
+	return token, true, nil
+}
+
+func (l *orderPlacementLock) Release(ctx context.Context, userID, token string) error {
+	if err := releaseLockScript.Run(ctx, l.client, []string{l.getLockKey(userID)}, token).Err(); err != nil {
+		return fmt.Errorf("failed to release order lock for user %s: %w", userID, err)
+	}
+	return nil
+}
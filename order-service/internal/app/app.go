@@ -19,6 +19,7 @@ import (
 	"github.com/Abdurahmanit/GroupProject/order-service/internal/service"
 
 	listingpb "github.com/Abdurahmanit/GroupProject/listing-service/genproto/listing_service"
+	"github.com/Abdurahmanit/GroupProject/platform/selftest"
 
 	"github.com/nats-io/nats.go"
 	"github.com/redis/go-redis/v9"
@@ -96,9 +97,25 @@ func New(cfg *config.Config) (*App, error) {
 	}
 	appLogger.Info("NATS MessagePublisher initialized")
 
+	if cfg.StartupSelfTest {
+		appLogger.Info("Running startup self-test...")
+		selfTest := selftest.NewRunner(logger.KV(appLogger))
+		selfTest.Add("mongodb", selftest.MongoRoundTrip(mongoClient.Database(cfg.MongoDB.Database)))
+		selfTest.Add("redis", selftest.RedisRoundTrip(redisClient))
+		selfTest.Add("nats", selftest.NATSPublish(natsConn, "order.selftest"))
+		if err := selfTest.Run(ctx); err != nil {
+			natsConn.Close()
+			mongoClient.Disconnect(ctx)
+			redisClient.Close()
+			return nil, fmt.Errorf("startup self-test failed: %w", err)
+		}
+		appLogger.Info("Startup self-test passed")
+	}
+
 	appLogger.Info("Initializing ListingService gRPC client...")
 	listingServiceClientCfg := listingserviceclient.ListingServiceClientConfig{
-		Address: cfg.Services.ListingService.Address,
+		Address:       cfg.Services.ListingService.Address,
+		InternalToken: cfg.Services.ListingService.InternalToken,
 	}
 	listingServiceCl, listingServiceConn, err := listingserviceclient.NewListingServiceClient(listingServiceClientCfg)
 	if err != nil {
@@ -110,8 +127,18 @@ func New(cfg *config.Config) (*App, error) {
 	}
 	appLogger.Info("ListingService gRPC client initialized successfully")
 
+	if err := mongoadapter.EnsureOrderIndexes(ctx, mongoClient, cfg.MongoDB); err != nil {
+		appLogger.Warnf("Failed to ensure order indexes: %v", err)
+	}
 	orderRepo := mongoadapter.NewOrderRepository(mongoClient, cfg.MongoDB)
 	appLogger.Info("OrderRepository initialized")
+	if err := mongoadapter.EnsureCouponIndexes(ctx, mongoClient, cfg.MongoDB); err != nil {
+		appLogger.Warnf("Failed to ensure coupon indexes: %v", err)
+	}
+	couponRepo := mongoadapter.NewCouponRepository(mongoClient, cfg.MongoDB)
+	appLogger.Info("CouponRepository initialized")
+	counterRepo := mongoadapter.NewCounterRepository(mongoClient, cfg.MongoDB)
+	appLogger.Info("CounterRepository initialized")
 	cartRepo := redisadapter.NewCartRepository(redisClient)
 	appLogger.Info("CartRepository initialized")
 	productCache := redisadapter.NewProductDetailCacheRepository(redisClient)
@@ -124,13 +151,50 @@ func New(cfg *config.Config) (*App, error) {
 	cartSvc := service.NewCartService(cartRepo, productCache, listingServiceCl, appLogger, cartServiceCfg)
 	appLogger.Info("CartService initialized")
 
-	orderSvc := service.NewOrderService(orderRepo, cartSvc, listingServiceCl, msgPublisher, appLogger)
+	abandonedCartSvc := service.NewAbandonedCartService(cartRepo, msgPublisher, appLogger, service.AbandonedCartServiceConfig{
+		AllowedCallers: cfg.Cart.AbandonedAllowedCallers,
+		DefaultAfter:   cfg.Cart.AbandonedAfter,
+		MaxResults:     cfg.Cart.AbandonedMaxResults,
+	})
+	appLogger.Info("AbandonedCartService initialized")
+
+	orderNumberGen, err := service.NewOrderNumberGenerator(counterRepo, service.OrderNumberConfig{
+		Prefix: cfg.OrderNumber.Prefix,
+		Format: cfg.OrderNumber.Format,
+	})
+	if err != nil {
+		appLogger.Errorf("Invalid order number configuration: %v", err)
+		listingServiceConn.Close()
+		natsConn.Close()
+		mongoClient.Disconnect(ctx)
+		redisClient.Close()
+		return nil, fmt.Errorf("invalid order number configuration: %w", err)
+	}
+
+	addressValidator, err := service.NewAddressValidator(cfg.Addresses.PostalCodeRules)
+	if err != nil {
+		appLogger.Errorf("Invalid address validation configuration: %v", err)
+		listingServiceConn.Close()
+		natsConn.Close()
+		mongoClient.Disconnect(ctx)
+		redisClient.Close()
+		return nil, fmt.Errorf("invalid address validation configuration: %w", err)
+	}
+
+	orderSvc := service.NewOrderService(orderRepo, couponRepo, cartSvc, listingServiceCl, msgPublisher, service.OrderPlacement{
+		OrderNumbers:   orderNumberGen,
+		Lock:           redisadapter.NewOrderPlacementLock(redisClient),
+		LockTTL:        cfg.Placement.LockTTL,
+		Idempotency:    redisadapter.NewOrderIdempotencyStore(redisClient),
+		IdempotencyTTL: cfg.Placement.IdempotencyTTL,
+		Addresses:      addressValidator,
+	}, appLogger)
 	appLogger.Info("OrderService initialized")
 
 	receiptSvc := service.NewReceiptService(orderRepo, appLogger)
 	appLogger.Info("ReceiptService initialized")
 
-	orderGRPCHandler := grpcport.NewOrderGRPCHandler(cartSvc, orderSvc, receiptSvc, appLogger)
+	orderGRPCHandler := grpcport.NewOrderGRPCHandler(cartSvc, abandonedCartSvc, orderSvc, receiptSvc, appLogger)
 	appLogger.Info("OrderGRPCHandler initialized")
 
 	grpcSrv := grpcport.NewServer(
@@ -27,10 +27,42 @@ type ProductCacheConfig struct {
 
 type CartConfig struct {
 	TTL time.Duration `yaml:"ttl" env:"CART_TTL" env-default:"24h"`
+	// AbandonedAfter is how long a cart must be untouched to count as abandoned when
+	// GetAbandonedCarts does not specify a period. Keep it below TTL, or carts expire first.
+	AbandonedAfter      time.Duration `yaml:"abandoned_after" env:"CART_ABANDONED_AFTER" env-default:"2h"`
+	AbandonedMaxResults int           `yaml:"abandoned_max_results" env:"CART_ABANDONED_MAX_RESULTS" env-default:"100"`
+	// AbandonedAllowedCallers lists admin and system (e.g. marketing worker) IDs allowed to
+	// call GetAbandonedCarts. When empty the RPC rejects every caller.
+	AbandonedAllowedCallers []string `yaml:"abandoned_allowed_callers" env:"CART_ABANDONED_ALLOWED_CALLERS" env-separator:","`
+}
+
+// OrderNumberConfig controls customer-facing order numbers. Format is "yearly"
+// (ORD-2024-000123, sequence restarts every year) or "sequential" (ORD-000123).
+type OrderNumberConfig struct {
+	Prefix string `yaml:"prefix" env:"ORDER_NUMBER_PREFIX" env-default:"ORD"`
+	Format string `yaml:"format" env:"ORDER_NUMBER_FORMAT" env-default:"yearly"`
+}
+
+// OrderPlacementConfig bounds how long a per-user placement lock is held if the
+// placing request never releases it (e.g. the process crashes mid-placement), and how long
+// a PlaceOrder idempotency key keeps returning the order it created.
+type OrderPlacementConfig struct {
+	LockTTL        time.Duration `yaml:"lock_ttl" env:"ORDER_PLACEMENT_LOCK_TTL" env-default:"30s"`
+	IdempotencyTTL time.Duration `yaml:"idempotency_ttl" env:"ORDER_IDEMPOTENCY_KEY_TTL" env-default:"24h"`
+}
+
+// AddressValidationConfig holds per-country postal code patterns, keyed by country code or
+// name. Countries without a rule only require a non-empty postal code. From the environment:
+// ADDRESS_POSTAL_CODE_RULES="KZ:\d{6};US:\d{5}(-\d{4})?".
+type AddressValidationConfig struct {
+	PostalCodeRules map[string]string `yaml:"postal_code_rules" env:"ADDRESS_POSTAL_CODE_RULES" env-separator:";"`
 }
 
 type ServiceClientConfig struct {
 	Address string `yaml:"address" env:"LISTING_SERVICE_ADDRESS" env-required:"true"`
+	// InternalToken is listing-service's INTERNAL_API_TOKEN; ReserveStock and ReleaseStock
+	// reject calls without it.
+	InternalToken string `yaml:"internal_token" env:"LISTING_SERVICE_INTERNAL_TOKEN"`
 }
 
 type ServicesConfig struct {
@@ -38,16 +70,22 @@ type ServicesConfig struct {
 }
 
 type Config struct {
-	Env          string             `yaml:"env" env:"ENV" env-default:"local"`
-	GRPCServer   GRPCServerConfig   `yaml:"grpc_server"`
-	MongoDB      MongoDBConfig      `yaml:"mongo"`
-	Redis        RedisConfig        `yaml:"redis"`
-	NATS         NATSConfig         `yaml:"nats"`
-	Logger       LoggerConfig       `yaml:"logger"`
-	Services     ServicesConfig     `yaml:"services"`
-	Cart         CartConfig         `yaml:"cart"`
-	ProductCache ProductCacheConfig `yaml:"product_cache"`
-	SMTP         SMTPConfig         `yaml:"smtp"`
+	Env          string                  `yaml:"env" env:"ENV" env-default:"local"`
+	GRPCServer   GRPCServerConfig        `yaml:"grpc_server"`
+	MongoDB      MongoDBConfig           `yaml:"mongo"`
+	Redis        RedisConfig             `yaml:"redis"`
+	NATS         NATSConfig              `yaml:"nats"`
+	Logger       LoggerConfig            `yaml:"logger"`
+	Services     ServicesConfig          `yaml:"services"`
+	Cart         CartConfig              `yaml:"cart"`
+	ProductCache ProductCacheConfig      `yaml:"product_cache"`
+	SMTP         SMTPConfig              `yaml:"smtp"`
+	OrderNumber  OrderNumberConfig       `yaml:"order_number"`
+	Placement    OrderPlacementConfig    `yaml:"order_placement"`
+	Addresses    AddressValidationConfig `yaml:"address_validation"`
+	// StartupSelfTest writes and reads a canary in MongoDB and Redis and publishes to
+	// "order.selftest" on boot; the service does not start if any step fails.
+	StartupSelfTest bool `yaml:"startup_self_test" env:"STARTUP_SELF_TEST" env-default:"false"`
 }
 
 type GRPCServerConfig struct {
@@ -0,0 +1,94 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+type CouponType string
+
+const (
+	CouponTypePercentage CouponType = "PERCENTAGE"
+	CouponTypeFixed      CouponType = "FIXED"
+)
+
+var (
+	ErrCouponNotFound          = errors.New("coupon not found")
+	ErrCouponExpired           = errors.New("coupon has expired")
+	ErrCouponNotYetValid       = errors.New("coupon is not valid yet")
+	ErrCouponUsageLimitReached = errors.New("coupon has been used the maximum number of times")
+	ErrCouponUserLimitReached  = errors.New("coupon has already been used the maximum number of times by this user")
+	ErrCouponMinOrderNotMet    = errors.New("order total is below the coupon minimum")
+)
+
+// Coupon is a discount code. Limits of zero mean "no limit"; UsedCount is maintained by the
+// repository when a coupon is redeemed.
+type Coupon struct {
+	ID             string     `bson:"_id,omitempty"`
+	Code           string     `bson:"code"`
+	Type           CouponType `bson:"type"`
+	Value          float64    `bson:"value"` // Percent (0-100] for PERCENTAGE, currency amount for FIXED
+	MinOrderAmount float64    `bson:"min_order_amount,omitempty"`
+	StartsAt       *time.Time `bson:"starts_at,omitempty"`
+	ExpiresAt      *time.Time `bson:"expires_at,omitempty"`
+	MaxUses        int        `bson:"max_uses,omitempty"`
+	MaxUsesPerUser int        `bson:"max_uses_per_user,omitempty"`
+	UsedCount      int        `bson:"used_count"`
+	CreatedAt      time.Time  `bson:"created_at"`
+}
+
+// AppliedCoupon records the coupon used for an order and the amount it took off.
+type AppliedCoupon struct {
+	CouponID string  `bson:"coupon_id"`
+	Code     string  `bson:"code"`
+	Discount float64 `bson:"discount"`
+}
+
+// NormalizeCouponCode makes codes case-insensitive and ignores surrounding spaces.
+func NormalizeCouponCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// CheckApplicable returns a typed error if the coupon cannot be used at now for an order of
+// orderTotal by a user who has already redeemed it userUses times.
+func (c *Coupon) CheckApplicable(now time.Time, orderTotal float64, userUses int) error {
+	if c.StartsAt != nil && now.Before(*c.StartsAt) {
+		return ErrCouponNotYetValid
+	}
+	if c.ExpiresAt != nil && !now.Before(*c.ExpiresAt) {
+		return ErrCouponExpired
+	}
+	if c.MaxUses > 0 && c.UsedCount >= c.MaxUses {
+		return ErrCouponUsageLimitReached
+	}
+	if c.MaxUsesPerUser > 0 && userUses >= c.MaxUsesPerUser {
+		return ErrCouponUserLimitReached
+	}
+	if orderTotal < c.MinOrderAmount {
+		return fmt.Errorf("%w: minimum is %.2f, order total is %.2f", ErrCouponMinOrderNotMet, c.MinOrderAmount, orderTotal)
+	}
+	return nil
+}
+
+// Discount returns the amount the coupon takes off orderTotal, rounded to cents and never
+// more than the total itself.
+func (c *Coupon) Discount(orderTotal float64) float64 {
+	var discount float64
+	switch c.Type {
+	case CouponTypePercentage:
+		discount = orderTotal * c.Value / 100
+	case CouponTypeFixed:
+		discount = c.Value
+	}
+	discount = math.Round(discount*100) / 100
+	if discount < 0 {
+		return 0
+	}
+	if discount > orderTotal {
+		return orderTotal
+	}
+	return discount
+}
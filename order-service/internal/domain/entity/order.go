@@ -3,6 +3,7 @@ package entity
 import (
 	"errors"
 	"fmt"
+	"math"
 	"time"
 )
 
@@ -16,6 +17,28 @@ const (
 	StatusDelivered      OrderStatus = "DELIVERED"
 	StatusCancelled      OrderStatus = "CANCELLED"
 	StatusFailed         OrderStatus = "FAILED"
+	StatusRefunded       OrderStatus = "REFUNDED"
+)
+
+// allowedStatusTransitions is the order lifecycle: PENDING_PAYMENT → PAID → PROCESSING →
+// SHIPPED → DELIVERED, with CANCELLED possible until the order ships and REFUNDED once it has
+// been paid. A failed payment can be retried. CANCELLED, REFUNDED and DELIVERED (apart from
+// a refund) are final.
+var allowedStatusTransitions = map[OrderStatus][]OrderStatus{
+	StatusPendingPayment: {StatusPaid, StatusCancelled, StatusFailed},
+	StatusPaid:           {StatusProcessing, StatusCancelled, StatusRefunded},
+	StatusProcessing:     {StatusShipped, StatusCancelled, StatusRefunded},
+	StatusShipped:        {StatusDelivered, StatusRefunded},
+	StatusDelivered:      {StatusRefunded},
+	StatusCancelled:      {},
+	StatusRefunded:       {},
+	StatusFailed:         {StatusPendingPayment},
+}
+
+var (
+	ErrInvalidStatusTransition = errors.New("invalid order status transition")
+	ErrOrderNotRefundable      = errors.New("order cannot be refunded in its current status")
+	ErrInvalidRefundAmount     = errors.New("refund amount must be positive and must not exceed the order total")
 )
 
 type Address struct {
@@ -61,8 +84,17 @@ type PaymentDetails struct {
 	PaymentStatus   string `bson:"payment_status,omitempty"`
 }
 
+// RefundDetails records who refunded an order, how much and why.
+type RefundDetails struct {
+	Amount     float64   `bson:"amount"`
+	Reason     string    `bson:"reason,omitempty"`
+	RefundedBy string    `bson:"refunded_by"`
+	RefundedAt time.Time `bson:"refunded_at"`
+}
+
 type Order struct {
 	ID              string         `bson:"_id,omitempty"`
+	OrderNumber     string         `bson:"order_number,omitempty"`
 	UserID          string         `bson:"user_id"`
 	Items           []OrderItem    `bson:"items"`
 	TotalAmount     float64        `bson:"total_amount"`
@@ -70,6 +102,8 @@ type Order struct {
 	ShippingAddress Address        `bson:"shipping_address,omitempty"`
 	BillingAddress  Address        `bson:"billing_address,omitempty"`
 	PaymentDetails  PaymentDetails `bson:"payment_details,omitempty"`
+	Refund          *RefundDetails `bson:"refund,omitempty"`
+	Coupon          *AppliedCoupon `bson:"coupon,omitempty"` // TotalAmount already has the discount taken off
 	CreatedAt       time.Time      `bson:"created_at"`
 	UpdatedAt       time.Time      `bson:"updated_at"`
 	Version         int            `bson:"version"`
@@ -105,41 +139,66 @@ func (o *Order) CalculateTotalAmount() {
 	o.TotalAmount = total
 }
 
+// CanTransitionTo reports whether the order may move from its current status to newStatus.
+func (o *Order) CanTransitionTo(newStatus OrderStatus) bool {
+	for _, s := range allowedStatusTransitions[o.Status] {
+		if s == newStatus {
+			return true
+		}
+	}
+	return false
+}
+
 func (o *Order) CanBeCancelled() bool {
-	switch o.Status {
-	case StatusPendingPayment, StatusPaid, StatusProcessing:
-		return true
-	default:
-		return false
+	return o.CanTransitionTo(StatusCancelled)
+}
+
+// CanBeRefunded reports whether the order has been paid for and not cancelled or refunded yet.
+func (o *Order) CanBeRefunded() bool {
+	return o.CanTransitionTo(StatusRefunded)
+}
+
+// MarkRefunded records the refund and moves the order to StatusRefunded. The amount may be
+// less than the order total for a partial refund, but never more.
+func (o *Order) MarkRefunded(amount float64, reason, refundedBy string) error {
+	if !o.CanBeRefunded() {
+		return fmt.Errorf("%w: %s", ErrOrderNotRefundable, o.Status)
+	}
+	if amount <= 0 || toCents(amount) > toCents(o.TotalAmount) {
+		return fmt.Errorf("%w: got %.2f, order total is %.2f", ErrInvalidRefundAmount, amount, o.TotalAmount)
 	}
+	now := time.Now().UTC()
+	o.Refund = &RefundDetails{
+		Amount:     amount,
+		Reason:     reason,
+		RefundedBy: refundedBy,
+		RefundedAt: now,
+	}
+	o.Status = StatusRefunded
+	o.UpdatedAt = now
+	o.Version++
+	return nil
+}
+
+// IsFullyRefunded reports whether the recorded refund covers the whole order total.
+// Amounts are compared in cents, so float rounding cannot turn a full refund into a partial one.
+func (o *Order) IsFullyRefunded() bool {
+	return o.Refund != nil && toCents(o.Refund.Amount) == toCents(o.TotalAmount)
 }
 
+// toCents converts a money amount to whole cents.
+func toCents(amount float64) int64 {
+	return int64(math.Round(amount * 100))
+}
+
+// UpdateStatus moves the order to newStatus if allowedStatusTransitions permits it and
+// returns ErrInvalidStatusTransition otherwise. Setting the current status again is a no-op.
 func (o *Order) UpdateStatus(newStatus OrderStatus) error {
 	if o.Status == newStatus {
 		return nil
 	}
-	validTransitions := map[OrderStatus][]OrderStatus{
-		StatusPendingPayment: {StatusPaid, StatusCancelled, StatusFailed},
-		StatusPaid:           {StatusProcessing, StatusCancelled},
-		StatusProcessing:     {StatusShipped, StatusCancelled},
-		StatusShipped:        {StatusDelivered, StatusCancelled},
-		StatusDelivered:      {},
-		StatusCancelled:      {},
-		StatusFailed:         {StatusPendingPayment},
-	}
-	allowed, ok := validTransitions[o.Status]
-	if !ok {
-		return fmt.Errorf("cannot transition from unknown status %s", o.Status)
-	}
-	canTransition := false
-	for _, s := range allowed {
-		if s == newStatus {
-			canTransition = true
-			break
-		}
-	}
-	if !canTransition && newStatus != StatusFailed {
-		return fmt.Errorf("invalid status transition from %s to %s", o.Status, newStatus)
+	if !o.CanTransitionTo(newStatus) {
+		return fmt.Errorf("%w: from %s to %s", ErrInvalidStatusTransition, o.Status, newStatus)
 	}
 	o.Status = newStatus
 	o.UpdatedAt = time.Now().UTC()
@@ -0,0 +1,94 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+)
+
+var allStatuses = []OrderStatus{
+	StatusPendingPayment,
+	StatusPaid,
+	StatusProcessing,
+	StatusShipped,
+	StatusDelivered,
+	StatusCancelled,
+	StatusFailed,
+	StatusRefunded,
+}
+
+func TestOrder_UpdateStatus_TransitionMatrix(t *testing.T) {
+	legal := map[OrderStatus]map[OrderStatus]bool{
+		StatusPendingPayment: {StatusPaid: true, StatusCancelled: true, StatusFailed: true},
+		StatusPaid:           {StatusProcessing: true, StatusCancelled: true, StatusRefunded: true},
+		StatusProcessing:     {StatusShipped: true, StatusCancelled: true, StatusRefunded: true},
+		StatusShipped:        {StatusDelivered: true, StatusRefunded: true},
+		StatusDelivered:      {StatusRefunded: true},
+		StatusFailed:         {StatusPendingPayment: true},
+	}
+
+	for _, from := range allStatuses {
+		for _, to := range allStatuses {
+			order := &Order{Status: from, Version: 1}
+			err := order.UpdateStatus(to)
+
+			switch {
+			case from == to:
+				if err != nil || order.Version != 1 {
+					t.Errorf("%s -> %s: expected a no-op, got err=%v version=%d", from, to, err, order.Version)
+				}
+			case legal[from][to]:
+				if err != nil {
+					t.Errorf("%s -> %s: expected legal transition, got %v", from, to, err)
+				}
+				if order.Status != to || order.Version != 2 {
+					t.Errorf("%s -> %s: status=%s version=%d after transition", from, to, order.Status, order.Version)
+				}
+			default:
+				if !errors.Is(err, ErrInvalidStatusTransition) {
+					t.Errorf("%s -> %s: expected ErrInvalidStatusTransition, got %v", from, to, err)
+				}
+				if order.Status != from || order.Version != 1 {
+					t.Errorf("%s -> %s: rejected transition changed the order", from, to)
+				}
+			}
+		}
+	}
+}
+
+func TestOrder_UpdateStatus_UnknownStatus(t *testing.T) {
+	order := &Order{Status: OrderStatus("LOST")}
+	if err := order.UpdateStatus(StatusPaid); !errors.Is(err, ErrInvalidStatusTransition) {
+		t.Fatalf("expected ErrInvalidStatusTransition from an unknown status, got %v", err)
+	}
+}
+
+func TestOrder_MarkRefunded_ComparesInCents(t *testing.T) {
+	// 0.1+0.2 != 0.3 in float64; the total and refund below differ only by such rounding noise.
+	total := 0.1 + 0.2
+	cases := []struct {
+		name     string
+		amount   float64
+		wantErr  bool
+		wantFull bool
+	}{
+		{"full refund with rounding noise", 0.3, false, true},
+		{"partial refund", 0.29, false, false},
+		{"more than the total", 0.31, true, false},
+	}
+	for _, c := range cases {
+		o := &Order{Status: StatusDelivered, TotalAmount: total}
+		err := o.MarkRefunded(c.amount, "damaged", "admin-1")
+		if c.wantErr {
+			if !errors.Is(err, ErrInvalidRefundAmount) {
+				t.Errorf("%s: err = %v, want ErrInvalidRefundAmount", c.name, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", c.name, err)
+		}
+		if got := o.IsFullyRefunded(); got != c.wantFull {
+			t.Errorf("%s: IsFullyRefunded = %v, want %v", c.name, got, c.wantFull)
+		}
+	}
+}
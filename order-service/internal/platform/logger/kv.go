@@ -0,0 +1,24 @@
+package logger
+
+import "github.com/Abdurahmanit/GroupProject/platform/logkv"
+
+type kvLogger struct {
+	l Logger
+}
+
+// KV adapts l to the key/value logger used by the shared platform packages.
+func KV(l Logger) logkv.Logger {
+	return kvLogger{l: l}
+}
+
+func (k kvLogger) Info(msg string, keysAndValues ...interface{}) {
+	k.l.With(keysAndValues...).Info(msg)
+}
+
+func (k kvLogger) Warn(msg string, keysAndValues ...interface{}) {
+	k.l.With(keysAndValues...).Warn(msg)
+}
+
+func (k kvLogger) Error(msg string, keysAndValues ...interface{}) {
+	k.l.With(keysAndValues...).Error(msg)
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
+	"github.com/Abdurahmanit/GroupProject/order-service/internal/domain/entity"
 	"github.com/Abdurahmanit/GroupProject/order-service/internal/platform/logger"
 	"github.com/Abdurahmanit/GroupProject/order-service/internal/repository"
 	"github.com/Abdurahmanit/GroupProject/order-service/internal/service"
@@ -12,6 +14,7 @@ import (
 	commonpb "github.com/Abdurahmanit/GroupProject/order-service/proto/common"
 	orderpb "github.com/Abdurahmanit/GroupProject/order-service/proto/order"
 	orderservicepb "github.com/Abdurahmanit/GroupProject/order-service/proto/service"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -19,23 +22,26 @@ import (
 
 type OrderGRPCHandler struct {
 	orderservicepb.UnimplementedOrderServiceServer
-	cartService    service.CartService
-	orderService   service.OrderService
-	receiptService service.ReceiptService
-	log            logger.Logger
+	cartService          service.CartService
+	abandonedCartService service.AbandonedCartService
+	orderService         service.OrderService
+	receiptService       service.ReceiptService
+	log                  logger.Logger
 }
 
 func NewOrderGRPCHandler(
 	cartService service.CartService,
+	abandonedCartService service.AbandonedCartService,
 	orderService service.OrderService,
 	receiptService service.ReceiptService,
 	log logger.Logger,
 ) *OrderGRPCHandler {
 	return &OrderGRPCHandler{
-		cartService:    cartService,
-		orderService:   orderService,
-		receiptService: receiptService,
-		log:            log,
+		cartService:          cartService,
+		abandonedCartService: abandonedCartService,
+		orderService:         orderService,
+		receiptService:       receiptService,
+		log:                  log,
 	}
 }
 
@@ -43,6 +49,9 @@ func (h *OrderGRPCHandler) AddItemToCart(ctx context.Context, req *orderservicep
 	cartProto, err := h.cartService.AddItem(ctx, req.GetUserId(), req.GetProductId(), int(req.GetQuantity()))
 	if err != nil {
 		h.log.Errorf("AddItemToCart failed: %v", err)
+		if errors.Is(err, service.ErrOutsideAvailabilityWindow) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, status.Errorf(codes.Internal, "failed to add item to cart: %v", err)
 	}
 	return cartProto, nil
@@ -87,15 +96,58 @@ func (h *OrderGRPCHandler) ClearCart(ctx context.Context, req *orderservicepb.Cl
 	return &emptypb.Empty{}, nil
 }
 
+const maxIdempotencyKeyLength = 128
+
 func (h *OrderGRPCHandler) PlaceOrder(ctx context.Context, req *orderservicepb.PlaceOrderRequest) (*orderpb.OrderProto, error) {
-	orderProto, err := h.orderService.PlaceOrder(ctx, req.GetUserId(), req.GetShippingAddress(), req.GetBillingAddress())
+	if len(req.GetIdempotencyKey()) > maxIdempotencyKeyLength {
+		return nil, status.Errorf(codes.InvalidArgument, "idempotency_key must be at most %d characters", maxIdempotencyKeyLength)
+	}
+	orderProto, err := h.orderService.PlaceOrder(ctx, req.GetUserId(), req.GetShippingAddress(), req.GetBillingAddress(), req.GetIdempotencyKey(), req.GetCouponCode())
 	if err != nil {
 		h.log.Errorf("PlaceOrder failed: %v", err)
+		if errors.Is(err, service.ErrOrderInProgress) {
+			return nil, status.Error(codes.Aborted, err.Error())
+		}
+		if errors.Is(err, service.ErrOutsideAvailabilityWindow) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		if errors.Is(err, service.ErrOutOfStock) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		if errors.Is(err, entity.ErrCouponNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, entity.ErrCouponExpired) || errors.Is(err, entity.ErrCouponNotYetValid) ||
+			errors.Is(err, entity.ErrCouponUsageLimitReached) || errors.Is(err, entity.ErrCouponUserLimitReached) ||
+			errors.Is(err, entity.ErrCouponMinOrderNotMet) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		var addrErr *service.AddressValidationError
+		if errors.As(err, &addrErr) {
+			return nil, addressValidationStatus(addrErr)
+		}
 		return nil, status.Errorf(codes.Internal, "failed to place order: %v", err)
 	}
 	return orderProto, nil
 }
 
+// addressValidationStatus reports every violation as google.rpc.BadRequest details so
+// clients can highlight the offending fields.
+func addressValidationStatus(addrErr *service.AddressValidationError) error {
+	st := status.New(codes.InvalidArgument, addrErr.Error())
+	badRequest := &errdetails.BadRequest{}
+	for _, v := range addrErr.Violations {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field,
+			Description: v.Description,
+		})
+	}
+	if withDetails, err := st.WithDetails(badRequest); err == nil {
+		return withDetails.Err()
+	}
+	return st.Err()
+}
+
 func (h *OrderGRPCHandler) GetOrder(ctx context.Context, req *orderservicepb.GetOrderRequest) (*orderpb.OrderProto, error) {
 	userIDFromAuth := ""
 	isAdminFromAuth := false
@@ -114,6 +166,25 @@ func (h *OrderGRPCHandler) GetOrder(ctx context.Context, req *orderservicepb.Get
 	return orderProto, nil
 }
 
+func (h *OrderGRPCHandler) VerifyPurchase(ctx context.Context, req *orderservicepb.VerifyPurchaseRequest) (*orderservicepb.VerifyPurchaseResponse, error) {
+	if req.GetUserId() == "" || req.GetProductId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id and product_id are required")
+	}
+	order, purchased, err := h.orderService.VerifyPurchase(ctx, req.GetUserId(), req.GetProductId())
+	if err != nil {
+		h.log.Errorf("VerifyPurchase failed for userID %s, productID %s: %v", req.GetUserId(), req.GetProductId(), err)
+		return nil, status.Errorf(codes.Internal, "failed to verify purchase: %v", err)
+	}
+	if !purchased {
+		return &orderservicepb.VerifyPurchaseResponse{Purchased: false}, nil
+	}
+	return &orderservicepb.VerifyPurchaseResponse{
+		Purchased:   true,
+		OrderId:     order.GetId(),
+		PurchasedAt: order.GetCreatedAt(),
+	}, nil
+}
+
 func (h *OrderGRPCHandler) ListUserOrders(ctx context.Context, req *orderservicepb.ListUserOrdersRequest) (*orderservicepb.ListUserOrdersResponse, error) {
 	orders, total, err := h.orderService.ListUserOrders(ctx, req.GetUserId(), req.GetPagination())
 	if err != nil {
@@ -151,6 +222,33 @@ func (h *OrderGRPCHandler) CancelOrder(ctx context.Context, req *orderservicepb.
 	return orderProto, nil
 }
 
+func (h *OrderGRPCHandler) Reorder(ctx context.Context, req *orderservicepb.ReorderRequest) (*orderservicepb.ReorderResponse, error) {
+	if req.GetOrderId() == "" || req.GetUserId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "order_id and user_id are required")
+	}
+	orderProto, skipped, err := h.orderService.Reorder(ctx, req.GetOrderId(), req.GetUserId())
+	if err != nil {
+		h.log.Errorf("Reorder failed for orderID %s by userID %s: %v", req.GetOrderId(), req.GetUserId(), err)
+		switch {
+		case errors.Is(err, repository.ErrNotFound):
+			return nil, status.Errorf(codes.NotFound, "order %s not found", req.GetOrderId())
+		case errors.Is(err, service.ErrNotOrderOwner):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		case errors.Is(err, service.ErrOrderInProgress):
+			return nil, status.Error(codes.Aborted, err.Error())
+		case errors.Is(err, service.ErrCartNotEmpty), errors.Is(err, service.ErrNothingToReorder), errors.Is(err, service.ErrOutsideAvailabilityWindow),
+			errors.Is(err, service.ErrOutOfStock):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		var addrErr *service.AddressValidationError
+		if errors.As(err, &addrErr) {
+			return nil, addressValidationStatus(addrErr)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to reorder: %v", err)
+	}
+	return &orderservicepb.ReorderResponse{Order: orderProto, SkippedItems: skipped}, nil
+}
+
 func (h *OrderGRPCHandler) UpdateOrderStatus(ctx context.Context, req *orderservicepb.UpdateOrderStatusRequest) (*orderpb.OrderProto, error) {
 	orderProto, err := h.orderService.UpdateOrderStatusByAdmin(ctx, req.GetOrderId(), req.GetNewStatus(), req.GetUpdatedById())
 	if err != nil {
@@ -158,17 +256,51 @@ func (h *OrderGRPCHandler) UpdateOrderStatus(ctx context.Context, req *orderserv
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, status.Errorf(codes.NotFound, "order %s not found", req.GetOrderId())
 		}
+		if errors.Is(err, entity.ErrInvalidStatusTransition) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		if errors.Is(err, repository.ErrOptimisticLock) {
+			return nil, status.Errorf(codes.Aborted, "order %s was modified concurrently, retry the update", req.GetOrderId())
+		}
 		return nil, status.Errorf(codes.Internal, "failed to update order status: %v", err)
 	}
 	return orderProto, nil
 }
 
+func (h *OrderGRPCHandler) RefundOrder(ctx context.Context, req *orderservicepb.RefundOrderRequest) (*orderpb.OrderProto, error) {
+	if req.GetOrderId() == "" || req.GetAdminId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "order_id and admin_id are required")
+	}
+	orderProto, err := h.orderService.RefundOrder(ctx, req.GetOrderId(), req.GetAdminId(), req.GetAmount(), req.GetReason())
+	if err != nil {
+		h.log.Errorf("RefundOrder failed for orderID %s by adminID %s: %v", req.GetOrderId(), req.GetAdminId(), err)
+		switch {
+		case errors.Is(err, repository.ErrNotFound):
+			return nil, status.Errorf(codes.NotFound, "order %s not found", req.GetOrderId())
+		case errors.Is(err, entity.ErrInvalidRefundAmount):
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		case errors.Is(err, entity.ErrOrderNotRefundable):
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		case errors.Is(err, repository.ErrOptimisticLock):
+			return nil, status.Errorf(codes.Aborted, "order %s was modified concurrently, retry the refund", req.GetOrderId())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to refund order: %v", err)
+	}
+	return orderProto, nil
+}
+
 func (h *OrderGRPCHandler) ListAllOrders(ctx context.Context, req *orderservicepb.ListAllOrdersAdminRequest) (*orderservicepb.ListAllOrdersAdminResponse, error) {
-	filters := make(map[string]string)
+	filters := make(map[string]string, len(req.GetFilters()))
+	for key, value := range req.GetFilters() {
+		filters[key] = value
+	}
 
 	orders, total, err := h.orderService.ListAllOrdersAdmin(ctx, req.GetAdminId(), req.GetPagination(), filters)
 	if err != nil {
 		h.log.Errorf("ListAllOrders failed for adminID %s: %v", req.GetAdminId(), err)
+		if errors.Is(err, service.ErrInvalidFilter) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Errorf(codes.Internal, "failed to list all orders: %v", err)
 	}
 
@@ -190,6 +322,23 @@ func (h *OrderGRPCHandler) ListAllOrders(ctx context.Context, req *orderservicep
 	}, nil
 }
 
+func (h *OrderGRPCHandler) GetAbandonedCarts(ctx context.Context, req *orderservicepb.GetAbandonedCartsRequest) (*orderservicepb.GetAbandonedCartsResponse, error) {
+	if req.GetOlderThanSeconds() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "older_than_seconds must not be negative")
+	}
+	olderThan := time.Duration(req.GetOlderThanSeconds()) * time.Second
+
+	carts, err := h.abandonedCartService.GetAbandonedCarts(ctx, req.GetAdminId(), olderThan, int(req.GetLimit()), req.GetPublishEvents())
+	if err != nil {
+		h.log.Errorf("GetAbandonedCarts failed for adminID %s: %v", req.GetAdminId(), err)
+		if errors.Is(err, service.ErrCallerNotAllowed) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get abandoned carts: %v", err)
+	}
+	return &orderservicepb.GetAbandonedCartsResponse{Carts: carts}, nil
+}
+
 func (h *OrderGRPCHandler) GenerateOrderReceipt(ctx context.Context, req *orderservicepb.GenerateOrderReceiptRequest) (*orderservicepb.GenerateOrderReceiptResponse, error) {
 	pdfBytes, fileName, err := h.receiptService.GenerateOrderReceiptPDF(ctx, req.GetOrderId(), req.GetUserId())
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 
 	"github.com/Abdurahmanit/GroupProject/order-service/internal/platform/logger"
 	orderservicepb "github.com/Abdurahmanit/GroupProject/order-service/proto/service"
+	"github.com/Abdurahmanit/GroupProject/platform/grpcrecovery"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/reflection"
@@ -36,6 +37,7 @@ func NewServer(
 			Time:                  maxConnectionIdle,
 			MaxConnectionAgeGrace: 5 * time.Second,
 		}),
+		grpc.UnaryInterceptor(grpcrecovery.UnaryServerInterceptor(logger.KV(log), nil)),
 	}
 
 	grpcServer := grpc.NewServer(serverOpts...)
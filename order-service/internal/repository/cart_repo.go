@@ -11,4 +11,6 @@ type CartRepository interface {
 	GetByUserID(ctx context.Context, userID string) (*entity.Cart, error)
 	Save(ctx context.Context, cart *entity.Cart, ttl time.Duration) error
 	DeleteByUserID(ctx context.Context, userID string) error
+	// ListUpdatedBefore returns non-empty carts last saved before the given time, oldest first.
+	ListUpdatedBefore(ctx context.Context, before time.Time, limit int) ([]*entity.Cart, error)
 }
@@ -0,0 +1,9 @@
+package repository
+
+import "context"
+
+// CounterRepository hands out monotonically increasing sequence values per counter name.
+// Implementations must be safe for concurrent use across service instances.
+type CounterRepository interface {
+	Next(ctx context.Context, name string) (int64, error)
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Abdurahmanit/GroupProject/order-service/internal/domain/entity"
+)
+
+type CouponRepository interface {
+	// GetByCode returns the coupon with the normalized code, or ErrNotFound.
+	GetByCode(ctx context.Context, code string) (*entity.Coupon, error)
+	// CountUserRedemptions returns how many orders of the user used the coupon.
+	CountUserRedemptions(ctx context.Context, couponID, userID string) (int, error)
+	// ClaimUse atomically counts one use of the coupon unless its MaxUses is reached, in which
+	// case it returns false.
+	ClaimUse(ctx context.Context, couponID string) (bool, error)
+	// ReleaseUse gives back a use claimed for an order that was not created.
+	ReleaseUse(ctx context.Context, couponID string) error
+	// RecordRedemption remembers that the user's order used the coupon.
+	RecordRedemption(ctx context.Context, couponID, userID, orderID string) error
+}
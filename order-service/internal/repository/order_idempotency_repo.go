@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// OrderIdempotencyStore remembers which order a PlaceOrder idempotency key produced, so a
+// repeated request returns that order instead of placing another one. Keys are per user.
+type OrderIdempotencyStore interface {
+	// Get returns the order ID stored for the user's key, or ErrNotFound.
+	Get(ctx context.Context, userID, key string) (string, error)
+	// Save stores orderID under the user's key for ttl.
+	Save(ctx context.Context, userID, key, orderID string, ttl time.Duration) error
+}
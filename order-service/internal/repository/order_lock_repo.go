@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// OrderPlacementLock serializes order placement per user so that two concurrent
+// placements cannot both consume the same cart.
+type OrderPlacementLock interface {
+	// Acquire takes the lock for userID for at most ttl. It returns a token to pass to
+	// Release and false when another placement already holds the lock.
+	Acquire(ctx context.Context, userID string, ttl time.Duration) (string, bool, error)
+	// Release drops the lock only if it is still held with token.
+	Release(ctx context.Context, userID, token string) error
+}
@@ -2,11 +2,13 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/Abdurahmanit/GroupProject/order-service/internal/domain/entity"
 )
 
 type CreateOrderParams struct {
+	OrderNumber     string
 	UserID          string
 	Items           []entity.OrderItem
 	TotalAmount     float64
@@ -14,6 +16,7 @@ type CreateOrderParams struct {
 	ShippingAddress entity.Address
 	BillingAddress  entity.Address
 	PaymentDetails  entity.PaymentDetails
+	Coupon          *entity.AppliedCoupon
 }
 
 type UpdateOrderPaymentDetailsParams struct {
@@ -23,6 +26,13 @@ type UpdateOrderPaymentDetailsParams struct {
 	Version        int
 }
 
+type UpdateOrderRefundParams struct {
+	OrderID string
+	Refund  entity.RefundDetails
+	Status  entity.OrderStatus
+	Version int
+}
+
 type UpdateOrderStatusParams struct {
 	OrderID string
 	Status  entity.OrderStatus
@@ -36,6 +46,12 @@ type ListOrdersParams struct {
 	PageSize  int
 	SortBy    string
 	SortOrder string
+	// CreatedAfter and CreatedBefore bound created_at (inclusive lower, exclusive upper bound).
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	// MinTotal and MaxTotal bound total_amount, both inclusive.
+	MinTotal *float64
+	MaxTotal *float64
 }
 
 type ListOrdersResult struct {
@@ -49,7 +65,13 @@ type ListOrdersResult struct {
 type OrderRepository interface {
 	Create(ctx context.Context, params CreateOrderParams) (string, error)
 	GetByID(ctx context.Context, orderID string) (*entity.Order, error)
+	GetByOrderNumber(ctx context.Context, orderNumber string) (*entity.Order, error)
 	UpdateStatus(ctx context.Context, params UpdateOrderStatusParams) error
 	UpdatePaymentDetails(ctx context.Context, params UpdateOrderPaymentDetailsParams) error
+	// UpdateRefund stores the refund details and status if the order is still at params.Version.
+	UpdateRefund(ctx context.Context, params UpdateOrderRefundParams) error
 	List(ctx context.Context, params ListOrdersParams) (*ListOrdersResult, error)
+	// FindEarliestWithProduct returns the user's oldest order in one of statuses that contains
+	// productID, or ErrNotFound.
+	FindEarliestWithProduct(ctx context.Context, userID, productID string, statuses []entity.OrderStatus) (*entity.Order, error)
 }
@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Abdurahmanit/GroupProject/order-service/internal/adapter/nats"
+	"github.com/Abdurahmanit/GroupProject/order-service/internal/domain/entity"
+	"github.com/Abdurahmanit/GroupProject/order-service/internal/platform/logger"
+	"github.com/Abdurahmanit/GroupProject/order-service/internal/repository"
+	cartpb "github.com/Abdurahmanit/GroupProject/order-service/proto/cart"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	natsSubjectCartAbandoned = "cart.abandoned"
+
+	defaultAbandonedAfter      = 2 * time.Hour
+	defaultAbandonedMaxResults = 100
+)
+
+// ErrCallerNotAllowed is returned when an admin-only operation is called by an ID
+// that is not on the configured allowlist.
+var ErrCallerNotAllowed = errors.New("caller is not allowed to perform this operation")
+
+// AbandonedCartService finds carts that have not been touched for a while so that a
+// marketing worker can send reminder emails.
+type AbandonedCartService interface {
+	// GetAbandonedCarts returns up to limit non-empty carts not updated for olderThan, oldest
+	// first. Zero olderThan or limit fall back to the configured defaults. When publishEvents
+	// is set a cart.abandoned event is published for every returned cart.
+	GetAbandonedCarts(ctx context.Context, adminID string, olderThan time.Duration, limit int, publishEvents bool) ([]*cartpb.AbandonedCartProto, error)
+}
+
+type AbandonedCartServiceConfig struct {
+	// AllowedCallers lists admin and system IDs that may query abandoned carts.
+	AllowedCallers []string
+	DefaultAfter   time.Duration
+	MaxResults     int
+}
+
+type abandonedCartService struct {
+	cartRepo       repository.CartRepository
+	msgPublisher   nats.MessagePublisher
+	log            logger.Logger
+	allowedCallers map[string]struct{}
+	defaultAfter   time.Duration
+	maxResults     int
+}
+
+func NewAbandonedCartService(
+	cartRepo repository.CartRepository,
+	msgPublisher nats.MessagePublisher,
+	log logger.Logger,
+	cfg AbandonedCartServiceConfig,
+) AbandonedCartService {
+	allowed := make(map[string]struct{}, len(cfg.AllowedCallers))
+	for _, id := range cfg.AllowedCallers {
+		if id != "" {
+			allowed[id] = struct{}{}
+		}
+	}
+	defaultAfter := cfg.DefaultAfter
+	if defaultAfter <= 0 {
+		defaultAfter = defaultAbandonedAfter
+	}
+	maxResults := cfg.MaxResults
+	if maxResults <= 0 {
+		maxResults = defaultAbandonedMaxResults
+	}
+
+	return &abandonedCartService{
+		cartRepo:       cartRepo,
+		msgPublisher:   msgPublisher,
+		log:            log,
+		allowedCallers: allowed,
+		defaultAfter:   defaultAfter,
+		maxResults:     maxResults,
+	}
+}
+
+func (s *abandonedCartService) GetAbandonedCarts(ctx context.Context, adminID string, olderThan time.Duration, limit int, publishEvents bool) ([]*cartpb.AbandonedCartProto, error) {
+	if _, ok := s.allowedCallers[adminID]; !ok {
+		return nil, ErrCallerNotAllowed
+	}
+	if olderThan < 0 {
+		return nil, fmt.Errorf("olderThan must not be negative, got %s", olderThan)
+	}
+	if olderThan == 0 {
+		olderThan = s.defaultAfter
+	}
+	if limit <= 0 || limit > s.maxResults {
+		limit = s.maxResults
+	}
+
+	before := time.Now().UTC().Add(-olderThan)
+	carts, err := s.cartRepo.ListUpdatedBefore(ctx, before, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list abandoned carts: %w", err)
+	}
+
+	result := make([]*cartpb.AbandonedCartProto, 0, len(carts))
+	for _, cart := range carts {
+		cartProto := mapEntityCartToAbandonedProto(cart)
+		result = append(result, cartProto)
+		if publishEvents {
+			if err := s.msgPublisher.Publish(ctx, natsSubjectCartAbandoned, cartProto); err != nil {
+				s.log.Warnf("Failed to publish cart abandoned event for user ID %s: %v", cart.UserID, err)
+			}
+		}
+	}
+
+	s.log.Infof("Caller %s listed %d abandoned carts older than %s (events published: %t)", adminID, len(result), olderThan, publishEvents)
+	return result, nil
+}
+
+func mapEntityCartToAbandonedProto(cart *entity.Cart) *cartpb.AbandonedCartProto {
+	cartProto := &cartpb.AbandonedCartProto{
+		UserId:    cart.UserID,
+		Items:     make([]*cartpb.AbandonedCartItemProto, 0, len(cart.Items)),
+		UpdatedAt: timestamppb.New(cart.UpdatedAt),
+	}
+	for _, item := range cart.Items {
+		cartProto.Items = append(cartProto.Items, &cartpb.AbandonedCartItemProto{
+			ProductId: item.ProductID,
+			Quantity:  int32(item.Quantity),
+		})
+		cartProto.TotalQuantity += int32(item.Quantity)
+	}
+	return cartProto
+}
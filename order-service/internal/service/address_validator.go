@@ -0,0 +1,144 @@
+package service
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/Abdurahmanit/GroupProject/order-service/internal/domain/entity"
+)
+
+// FieldViolation describes one invalid field of a request, e.g. "shipping_address.postal_code".
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// AddressValidationError is returned by PlaceOrder when the shipping or billing address is
+// incomplete or invalid. It lists every violation, not just the first one.
+type AddressValidationError struct {
+	Violations []FieldViolation
+}
+
+func (e *AddressValidationError) Error() string {
+	parts := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		parts[i] = v.Field + ": " + v.Description
+	}
+	return "invalid address: " + strings.Join(parts, "; ")
+}
+
+// countryAliases maps common country names and ISO 3166-1 alpha-3 codes to alpha-2 codes.
+// Any other two-letter value is accepted as an alpha-2 code as is.
+var countryAliases = map[string]string{
+	"KAZAKHSTAN": "KZ", "KAZ": "KZ", "КАЗАХСТАН": "KZ",
+	"RUSSIA": "RU", "RUSSIAN FEDERATION": "RU", "RUS": "RU", "РОССИЯ": "RU",
+	"KYRGYZSTAN": "KG", "KGZ": "KG",
+	"UZBEKISTAN": "UZ", "UZB": "UZ",
+	"TAJIKISTAN": "TJ", "TJK": "TJ",
+	"TURKMENISTAN": "TM", "TKM": "TM",
+	"BELARUS": "BY", "BLR": "BY",
+	"UKRAINE": "UA", "UKR": "UA",
+	"ARMENIA": "AM", "ARM": "AM",
+	"AZERBAIJAN": "AZ", "AZE": "AZ",
+	"GEORGIA": "GE", "GEO": "GE",
+	"CHINA": "CN", "CHN": "CN",
+	"TURKEY": "TR", "TURKIYE": "TR", "TUR": "TR",
+	"GERMANY": "DE", "DEU": "DE",
+	"FRANCE": "FR", "FRA": "FR",
+	"ITALY": "IT", "ITA": "IT",
+	"SPAIN": "ES", "ESP": "ES",
+	"POLAND": "PL", "POL": "PL",
+	"NETHERLANDS": "NL", "NLD": "NL",
+	"UNITED KINGDOM": "GB", "UK": "GB", "GREAT BRITAIN": "GB", "GBR": "GB",
+	"UNITED STATES": "US", "UNITED STATES OF AMERICA": "US", "USA": "US",
+	"CANADA": "CA", "CAN": "CA",
+	"JAPAN": "JP", "JPN": "JP",
+	"SOUTH KOREA": "KR", "KOREA": "KR", "KOR": "KR",
+	"INDIA": "IN", "IND": "IN",
+	"UNITED ARAB EMIRATES": "AE", "UAE": "AE", "ARE": "AE",
+}
+
+var alpha2Pattern = regexp.MustCompile(`^[A-Z]{2}$`)
+
+// AddressValidator checks order addresses and normalizes their country to an ISO 3166-1
+// alpha-2 code. Postal codes are matched against a per-country pattern when one is
+// configured; countries without a pattern only require a non-empty postal code.
+type AddressValidator struct {
+	postalCodeRules map[string]*regexp.Regexp
+}
+
+// NewAddressValidator compiles postalCodeRules, keyed by country (code or name) with a
+// regular expression the whole postal code must match.
+func NewAddressValidator(postalCodeRules map[string]string) (*AddressValidator, error) {
+	rules := make(map[string]*regexp.Regexp, len(postalCodeRules))
+	for country, pattern := range postalCodeRules {
+		code, ok := NormalizeCountry(country)
+		if !ok {
+			return nil, fmt.Errorf("postal code rule: unknown country %q", country)
+		}
+		re, err := regexp.Compile(`^(?:` + pattern + `)$`)
+		if err != nil {
+			return nil, fmt.Errorf("postal code rule for %s: %w", code, err)
+		}
+		rules[code] = re
+	}
+	return &AddressValidator{postalCodeRules: rules}, nil
+}
+
+// NormalizeCountry returns the ISO 3166-1 alpha-2 code for a country code or name.
+func NormalizeCountry(country string) (string, bool) {
+	c := strings.ToUpper(strings.Join(strings.Fields(country), " "))
+	if code, ok := countryAliases[c]; ok {
+		return code, true
+	}
+	if alpha2Pattern.MatchString(c) {
+		return c, true
+	}
+	return "", false
+}
+
+// Validate returns addr with trimmed fields and a normalized country, plus the violations
+// found. Field names are prefixed with field, e.g. "shipping_address".
+func (v *AddressValidator) Validate(field string, addr entity.Address) (entity.Address, []FieldViolation) {
+	var violations []FieldViolation
+	violate := func(name, description string) {
+		violations = append(violations, FieldViolation{Field: field + "." + name, Description: description})
+	}
+
+	addr.Street = strings.TrimSpace(addr.Street)
+	addr.City = strings.TrimSpace(addr.City)
+	addr.PostalCode = strings.TrimSpace(addr.PostalCode)
+	addr.Country = strings.TrimSpace(addr.Country)
+
+	if addr.Street == "" {
+		violate("street", "street is required")
+	}
+	if addr.City == "" {
+		violate("city", "city is required")
+	}
+
+	countryKnown := false
+	if addr.Country == "" {
+		violate("country", "country is required")
+	} else if code, ok := NormalizeCountry(addr.Country); ok {
+		addr.Country = code
+		countryKnown = true
+	} else {
+		violate("country", "country must be an ISO 3166-1 alpha-2 code or a known country name")
+	}
+
+	if addr.PostalCode == "" {
+		violate("postal_code", "postal code is required")
+	} else if countryKnown {
+		if re, ok := v.postalCodeRules[addr.Country]; ok && !re.MatchString(addr.PostalCode) {
+			violate("postal_code", fmt.Sprintf("postal code %q is not valid for %s", addr.PostalCode, addr.Country))
+		}
+	}
+	return addr, violations
+}
+
+func isEmptyAddress(addr entity.Address) bool {
+	return strings.TrimSpace(addr.Street) == "" && strings.TrimSpace(addr.City) == "" &&
+		strings.TrimSpace(addr.PostalCode) == "" && strings.TrimSpace(addr.Country) == ""
+}
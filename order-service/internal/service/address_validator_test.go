@@ -0,0 +1,44 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/Abdurahmanit/GroupProject/order-service/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddressValidator_NormalizesCountry(t *testing.T) {
+	v, err := NewAddressValidator(nil)
+	require.NoError(t, err)
+
+	addr, violations := v.Validate("shipping_address", entity.Address{
+		Street: " Abay 10 ", City: "Almaty", PostalCode: "050000", Country: "kazakhstan",
+	})
+	assert.Empty(t, violations)
+	assert.Equal(t, "KZ", addr.Country)
+	assert.Equal(t, "Abay 10", addr.Street)
+}
+
+func TestAddressValidator_ReportsAllViolations(t *testing.T) {
+	v, err := NewAddressValidator(map[string]string{"KZ": `\d{6}`})
+	require.NoError(t, err)
+
+	_, violations := v.Validate("shipping_address", entity.Address{PostalCode: "ABC", Country: "KZ"})
+	fields := make([]string, len(violations))
+	for i, violation := range violations {
+		fields[i] = violation.Field
+	}
+	assert.ElementsMatch(t, []string{
+		"shipping_address.street", "shipping_address.city", "shipping_address.postal_code",
+	}, fields)
+
+	_, violations = v.Validate("billing_address", entity.Address{Street: "s", City: "c", PostalCode: "1", Country: "Atlantis"})
+	require.Len(t, violations, 1)
+	assert.Equal(t, "billing_address.country", violations[0].Field)
+}
+
+func TestNewAddressValidator_RejectsInvalidRule(t *testing.T) {
+	_, err := NewAddressValidator(map[string]string{"KZ": `(`})
+	assert.Error(t, err)
+}
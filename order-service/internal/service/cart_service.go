@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -23,6 +24,26 @@ type CartService interface {
 	RemoveItem(ctx context.Context, userID, productID string) (*cartpb.CartProto, error)
 	GetCart(ctx context.Context, userID string) (*cartpb.CartProto, error)
 	ClearCart(ctx context.Context, userID string) error
+	// CheckAvailabilityWindows returns ErrOutsideAvailabilityWindow for the first cart item that is
+	// active but cannot be bought right now. GetCart silently drops such items, so PlaceOrder
+	// calls this first to reject the order instead of placing it without them.
+	CheckAvailabilityWindows(ctx context.Context, userID string) error
+}
+
+// ErrOutsideAvailabilityWindow is returned when a listing can only be bought during a
+// window (e.g. a flash sale) that is not open now.
+var ErrOutsideAvailabilityWindow = errors.New("listing is outside its availability window")
+
+// checkAvailabilityWindow returns ErrOutsideAvailabilityWindow when now is not within w.
+// A nil window or an unset bound does not restrict purchases.
+func checkAvailabilityWindow(productID string, w *listingpb.AvailabilityWindow, now time.Time) error {
+	if from := w.GetFrom(); from != nil && now.Before(from.AsTime()) {
+		return fmt.Errorf("%w: product %s is available from %s", ErrOutsideAvailabilityWindow, productID, from.AsTime().Format(time.RFC3339))
+	}
+	if until := w.GetUntil(); until != nil && !now.Before(until.AsTime()) {
+		return fmt.Errorf("%w: product %s was available until %s", ErrOutsideAvailabilityWindow, productID, until.AsTime().Format(time.RFC3339))
+	}
+	return nil
 }
 
 type cartService struct {
@@ -65,6 +86,24 @@ func NewCartService(
 	}
 }
 
+// fetchListingStatuses revalidates every cart item with a single batch call to ListingService.
+// It returns nil when the batch call fails; callers then fall back to per-item lookups.
+func (s *cartService) fetchListingStatuses(ctx context.Context, items []entity.CartItem) map[string]*listingpb.ListingAvailability {
+	if len(items) == 0 {
+		return nil
+	}
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.ProductID)
+	}
+	resp, err := s.listingClient.GetListingsStatus(ctx, &listingpb.GetListingsStatusRequest{Ids: ids})
+	if err != nil {
+		s.log.Warnf("fetchListingStatuses: batch status lookup failed, falling back to per-item lookups: %v", err)
+		return nil
+	}
+	return resp.GetStatuses()
+}
+
 func (s *cartService) enrichAndConvertCart(ctx context.Context, cartEntity *entity.Cart) (*cartpb.CartProto, error) {
 	if cartEntity == nil {
 		return &cartpb.CartProto{UserId: "", Items: []*cartpb.CartItemProto{}, TotalAmount: 0}, nil
@@ -75,8 +114,18 @@ func (s *cartService) enrichAndConvertCart(ctx context.Context, cartEntity *enti
 		Items:  make([]*cartpb.CartItemProto, 0, len(cartEntity.Items)),
 	}
 	var totalAmount float64
+	statuses := s.fetchListingStatuses(ctx, cartEntity.Items)
 
 	for _, itemEntity := range cartEntity.Items {
+		var availability *listingpb.ListingAvailability
+		if statuses != nil {
+			availability = statuses[itemEntity.ProductID]
+			if availability == nil || !availability.Available {
+				s.log.Warnf("enrichAndConvertCart: Product %s is no longer available (status: %s). Skipping item.", itemEntity.ProductID, availability.GetStatus())
+				continue
+			}
+		}
+
 		var listingResp *listingpb.ListingResponse
 		var err error
 
@@ -99,12 +148,15 @@ func (s *cartService) enrichAndConvertCart(ctx context.Context, cartEntity *enti
 			}
 		}
 
-		if listingResp.Status != "ACTIVE" {
+		itemPrice := listingResp.Price
+		if availability != nil {
+			// Batch status is fresh; cached details may carry a stale price
+			itemPrice = availability.Price
+		} else if listingResp.Status != "ACTIVE" {
 			s.log.Warnf("enrichAndConvertCart: Product %s (ID: %s) is not active, status: %s. Skipping item.", listingResp.Title, itemEntity.ProductID, listingResp.Status)
 			continue
 		}
 
-		itemPrice := listingResp.Price
 		itemTotalPrice := itemPrice * float64(itemEntity.Quantity)
 		totalAmount += itemTotalPrice
 
@@ -151,6 +203,10 @@ func (s *cartService) AddItem(ctx context.Context, userID, productID string, qua
 		s.log.Warnf("Attempted to add inactive product %s (ID: %s) to cart", listingResp.Title, productID)
 		return nil, fmt.Errorf("product %s is not available for purchase", listingResp.Title)
 	}
+	if err := checkAvailabilityWindow(productID, listingResp.GetAvailability(), time.Now()); err != nil {
+		s.log.Warnf("Attempted to add product %s outside its availability window: %v", productID, err)
+		return nil, err
+	}
 
 	if err := cartEntity.AddItem(productID, quantity); err != nil {
 		s.log.Errorf("Error adding item to cart entity for user %s: %v", productID, userID, err)
@@ -202,6 +258,30 @@ func (s *cartService) RemoveItem(ctx context.Context, userID, productID string)
 	return s.enrichAndConvertCart(ctx, cartEntity)
 }
 
+func (s *cartService) CheckAvailabilityWindows(ctx context.Context, userID string) error {
+	cartEntity, err := s.cartRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		s.log.Errorf("Error getting cart for user %s: %v", userID, err)
+		return fmt.Errorf("could not retrieve cart: %w", err)
+	}
+	if cartEntity == nil {
+		return nil
+	}
+	statuses := s.fetchListingStatuses(ctx, cartEntity.Items)
+	now := time.Now()
+	for _, item := range cartEntity.Items {
+		// Inactive items are dropped by GetCart as before; only the window is enforced here
+		availability := statuses[item.ProductID]
+		if availability == nil || availability.GetAvailable() || availability.GetStatus() != "active" {
+			continue
+		}
+		if err := checkAvailabilityWindow(item.ProductID, availability.GetAvailability(), now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *cartService) GetCart(ctx context.Context, userID string) (*cartpb.CartProto, error) {
 	s.log.Infof("Getting cart for user: UserID=%s", userID)
 	cartEntity, err := s.cartRepo.GetByUserID(ctx, userID)
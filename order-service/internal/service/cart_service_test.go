@@ -14,6 +14,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type MockCartRepository struct {
@@ -38,6 +39,14 @@ func (m *MockCartRepository) DeleteByUserID(ctx context.Context, userID string)
 	return args.Error(0)
 }
 
+func (m *MockCartRepository) ListUpdatedBefore(ctx context.Context, before time.Time, limit int) ([]*entity.Cart, error) {
+	args := m.Called(ctx, before, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Cart), args.Error(1)
+}
+
 type MockProductDetailCache struct {
 	mock.Mock
 }
@@ -114,6 +123,84 @@ func (m *MockListingServiceClient) GetPhotoURLs(ctx context.Context, in *listing
 func (m *MockListingServiceClient) UpdateListingStatus(ctx context.Context, in *listingpb.UpdateListingStatusRequest, opts ...grpc.CallOption) (*listingpb.ListingResponse, error) {
 	panic("UpdateListingStatus not implemented in mock")
 }
+func (m *MockListingServiceClient) MakeOffer(ctx context.Context, in *listingpb.MakeOfferRequest, opts ...grpc.CallOption) (*listingpb.OfferResponse, error) {
+	panic("MakeOffer not implemented in mock")
+}
+func (m *MockListingServiceClient) RespondToOffer(ctx context.Context, in *listingpb.RespondToOfferRequest, opts ...grpc.CallOption) (*listingpb.OfferResponse, error) {
+	panic("RespondToOffer not implemented in mock")
+}
+func (m *MockListingServiceClient) ListOffers(ctx context.Context, in *listingpb.ListOffersRequest, opts ...grpc.CallOption) (*listingpb.ListOffersResponse, error) {
+	panic("ListOffers not implemented in mock")
+}
+
+// GetListingsStatus fails so the cart service uses its per-item GetListingByID fallback.
+func (m *MockListingServiceClient) GetListingsStatus(ctx context.Context, in *listingpb.GetListingsStatusRequest, opts ...grpc.CallOption) (*listingpb.GetListingsStatusResponse, error) {
+	return nil, errors.New("GetListingsStatus not implemented in mock")
+}
+
+func (m *MockListingServiceClient) ReserveStock(ctx context.Context, in *listingpb.ReserveStockRequest, opts ...grpc.CallOption) (*listingpb.ReserveStockResponse, error) {
+	panic("ReserveStock not implemented in mock")
+}
+
+func (m *MockListingServiceClient) ReleaseStock(ctx context.Context, in *listingpb.ReleaseStockRequest, opts ...grpc.CallOption) (*listingpb.Empty, error) {
+	panic("ReleaseStock not implemented in mock")
+}
+
+func (m *MockListingServiceClient) MarkUnavailable(ctx context.Context, in *listingpb.MarkUnavailableRequest, opts ...grpc.CallOption) (*listingpb.ListingResponse, error) {
+	panic("MarkUnavailable not implemented in mock")
+}
+
+func (m *MockListingServiceClient) PublishListing(ctx context.Context, in *listingpb.PublishListingRequest, opts ...grpc.CallOption) (*listingpb.ListingResponse, error) {
+	panic("PublishListing not implemented in mock")
+}
+
+func (m *MockListingServiceClient) DuplicateListing(ctx context.Context, in *listingpb.DuplicateListingRequest, opts ...grpc.CallOption) (*listingpb.ListingResponse, error) {
+	panic("DuplicateListing not implemented in mock")
+}
+
+func (m *MockListingServiceClient) BatchGetListings(ctx context.Context, in *listingpb.BatchGetListingsRequest, opts ...grpc.CallOption) (*listingpb.BatchGetListingsResponse, error) {
+	panic("BatchGetListings not implemented in mock")
+}
+
+func (m *MockListingServiceClient) GetRecentlyViewed(ctx context.Context, in *listingpb.GetRecentlyViewedRequest, opts ...grpc.CallOption) (*listingpb.GetRecentlyViewedResponse, error) {
+	panic("GetRecentlyViewed not implemented in mock")
+}
+
+func (m *MockListingServiceClient) GeneratePhotoUploadURL(ctx context.Context, in *listingpb.GeneratePhotoUploadURLRequest, opts ...grpc.CallOption) (*listingpb.GeneratePhotoUploadURLResponse, error) {
+	panic("GeneratePhotoUploadURL not implemented in mock")
+}
+
+func (m *MockListingServiceClient) ConfirmPhotoUpload(ctx context.Context, in *listingpb.ConfirmPhotoUploadRequest, opts ...grpc.CallOption) (*listingpb.UploadPhotoResponse, error) {
+	panic("ConfirmPhotoUpload not implemented in mock")
+}
+
+func (m *MockListingServiceClient) CreateCategory(ctx context.Context, in *listingpb.CreateCategoryRequest, opts ...grpc.CallOption) (*listingpb.CategoryResponse, error) {
+	panic("CreateCategory not implemented in mock")
+}
+
+func (m *MockListingServiceClient) GetCategory(ctx context.Context, in *listingpb.GetCategoryRequest, opts ...grpc.CallOption) (*listingpb.CategoryResponse, error) {
+	panic("GetCategory not implemented in mock")
+}
+
+func (m *MockListingServiceClient) ListCategories(ctx context.Context, in *listingpb.Empty, opts ...grpc.CallOption) (*listingpb.ListCategoriesResponse, error) {
+	panic("ListCategories not implemented in mock")
+}
+
+func (m *MockListingServiceClient) UpdateCategory(ctx context.Context, in *listingpb.UpdateCategoryRequest, opts ...grpc.CallOption) (*listingpb.CategoryResponse, error) {
+	panic("UpdateCategory not implemented in mock")
+}
+
+func (m *MockListingServiceClient) DeleteCategory(ctx context.Context, in *listingpb.DeleteCategoryRequest, opts ...grpc.CallOption) (*listingpb.Empty, error) {
+	panic("DeleteCategory not implemented in mock")
+}
+
+func (m *MockListingServiceClient) DeletePhoto(ctx context.Context, in *listingpb.DeletePhotoRequest, opts ...grpc.CallOption) (*listingpb.Empty, error) {
+	panic("DeletePhoto not implemented in mock")
+}
+
+func (m *MockListingServiceClient) ReorderPhotos(ctx context.Context, in *listingpb.ReorderPhotosRequest, opts ...grpc.CallOption) (*listingpb.Empty, error) {
+	panic("ReorderPhotos not implemented in mock")
+}
 
 type NoOpLogger struct{}
 
@@ -291,3 +378,16 @@ func TestCartService_AddItem_Fail_ProductNotActive(t *testing.T) {
 	mockProductCache.AssertExpectations(t)
 	mockListingClient.AssertExpectations(t)
 }
+
+func TestCheckAvailabilityWindow(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	window := &listingpb.AvailabilityWindow{
+		From:  timestamppb.New(now.Add(-time.Hour)),
+		Until: timestamppb.New(now.Add(time.Hour)),
+	}
+
+	assert.NoError(t, checkAvailabilityWindow("p1", nil, now))
+	assert.NoError(t, checkAvailabilityWindow("p1", window, now))
+	assert.ErrorIs(t, checkAvailabilityWindow("p1", window, now.Add(-2*time.Hour)), ErrOutsideAvailabilityWindow)
+	assert.ErrorIs(t, checkAvailabilityWindow("p1", window, now.Add(time.Hour)), ErrOutsideAvailabilityWindow)
+}
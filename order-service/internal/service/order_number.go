@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Abdurahmanit/GroupProject/order-service/internal/repository"
+)
+
+const (
+	OrderNumberFormatSequential = "sequential"
+	OrderNumberFormatYearly     = "yearly"
+)
+
+type OrderNumberConfig struct {
+	Prefix string
+	Format string
+}
+
+// OrderNumberGenerator builds customer-facing order references such as ORD-2024-000123.
+// Uniqueness relies on the counter repository's atomic increment.
+type OrderNumberGenerator interface {
+	Next(ctx context.Context) (string, error)
+}
+
+type orderNumberGenerator struct {
+	counters repository.CounterRepository
+	cfg      OrderNumberConfig
+	now      func() time.Time
+}
+
+func NewOrderNumberGenerator(counters repository.CounterRepository, cfg OrderNumberConfig) (OrderNumberGenerator, error) {
+	switch cfg.Format {
+	case OrderNumberFormatSequential, OrderNumberFormatYearly:
+	default:
+		return nil, fmt.Errorf("unknown order number format %q: expected %q or %q", cfg.Format, OrderNumberFormatSequential, OrderNumberFormatYearly)
+	}
+	return &orderNumberGenerator{
+		counters: counters,
+		cfg:      cfg,
+		now:      func() time.Time { return time.Now().UTC() },
+	}, nil
+}
+
+func (g *orderNumberGenerator) Next(ctx context.Context) (string, error) {
+	if g.cfg.Format == OrderNumberFormatYearly {
+		year := g.now().Year()
+		seq, err := g.counters.Next(ctx, fmt.Sprintf("order_number_%d", year))
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s-%d-%06d", g.cfg.Prefix, year, seq), nil
+	}
+
+	seq, err := g.counters.Next(ctx, "order_number")
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s-%06d", g.cfg.Prefix, seq), nil
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
+	"strconv"
+	"time"
 
 	listingpb "github.com/Abdurahmanit/GroupProject/listing-service/genproto/listing_service"
 	"github.com/Abdurahmanit/GroupProject/order-service/internal/adapter/nats"
@@ -12,47 +15,130 @@ import (
 	"github.com/Abdurahmanit/GroupProject/order-service/internal/repository"
 	commonpb "github.com/Abdurahmanit/GroupProject/order-service/proto/common"
 	orderpb "github.com/Abdurahmanit/GroupProject/order-service/proto/order"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// ErrOrderInProgress is returned by PlaceOrder while another placement for the same user is running.
+var ErrOrderInProgress = errors.New("order in progress")
+
+var (
+	// ErrNotOrderOwner is returned when a user acts on an order placed by someone else.
+	ErrNotOrderOwner = errors.New("order belongs to another user")
+	// ErrCartNotEmpty is returned by Reorder, which places the order from the cart and must not
+	// mix in items the user added separately.
+	ErrCartNotEmpty = errors.New("cart must be empty to reorder")
+	// ErrNothingToReorder is returned when none of the original items can be bought any more.
+	ErrNothingToReorder = errors.New("none of the items of the order are available any more")
+	// ErrInvalidFilter is returned by ListAllOrdersAdmin for malformed or contradictory filters.
+	ErrInvalidFilter = errors.New("invalid order filter")
+	// ErrOutOfStock is returned by PlaceOrder when listing-service cannot reserve an item.
+	ErrOutOfStock = errors.New("out of stock")
+)
+
 const (
 	natsSubjectOrderCreated       = "order.created"
 	natsSubjectOrderStatusUpdated = "order.status.updated"
+	natsSubjectOrderRefunded      = "order.refunded"
 )
 
 type OrderService interface {
-	PlaceOrder(ctx context.Context, userID string, shippingAddr *commonpb.AddressProto, billingAddr *commonpb.AddressProto) (*orderpb.OrderProto, error)
+	// PlaceOrder turns the user's cart into an order. A non-empty idempotencyKey makes the call
+	// safe to repeat: a later call with the same key returns the order the first one created.
+	// A non-empty couponCode is applied to the total; coupon problems are returned as the
+	// entity.ErrCoupon* errors. Stock of every item is reserved in listing-service; ErrOutOfStock
+	// is returned when that fails.
+	PlaceOrder(ctx context.Context, userID string, shippingAddr *commonpb.AddressProto, billingAddr *commonpb.AddressProto, idempotencyKey, couponCode string) (*orderpb.OrderProto, error)
 	GetOrderByID(ctx context.Context, orderID, userID string, isAdmin bool) (*orderpb.OrderProto, error)
 	ListUserOrders(ctx context.Context, userID string, pagination *commonpb.PaginationRequest) ([]*orderpb.OrderProto, int64, error)
 	CancelUserOrder(ctx context.Context, orderID, userID string) (*orderpb.OrderProto, error)
 	UpdateOrderStatusByAdmin(ctx context.Context, orderID string, newStatus orderpb.OrderStatusProto, adminID string) (*orderpb.OrderProto, error)
+	// RefundOrder refunds amount (at most the order total) of a paid order and moves it to
+	// REFUNDED. A full refund also returns the order's reserved stock to listing-service.
+	// It fails with entity.ErrOrderNotRefundable or entity.ErrInvalidRefundAmount.
+	RefundOrder(ctx context.Context, orderID, adminID string, amount float64, reason string) (*orderpb.OrderProto, error)
+	// ListAllOrdersAdmin lists orders of all users. Accepted filters are status, user_id,
+	// sort_by, sort_order, created_after and created_before (RFC3339), min_total and max_total;
+	// malformed values fail with ErrInvalidFilter.
 	ListAllOrdersAdmin(ctx context.Context, adminID string, pagination *commonpb.PaginationRequest, filters map[string]string) ([]*orderpb.OrderProto, int64, error)
+	// Reorder places a new order with the items of one of the user's previous orders, shipped
+	// to the same addresses. Items that are no longer available are skipped and returned.
+	Reorder(ctx context.Context, orderID, userID string) (*orderpb.OrderProto, []*orderpb.SkippedItemProto, error)
+	// VerifyPurchase reports whether the user has a paid (or later) order containing the
+	// product, and returns the earliest such order. Other services use it to gate reviews.
+	VerifyPurchase(ctx context.Context, userID, productID string) (*orderpb.OrderProto, bool, error)
+}
+
+// purchasedStatuses are the order statuses that count as a completed purchase.
+var purchasedStatuses = []entity.OrderStatus{
+	entity.StatusPaid,
+	entity.StatusProcessing,
+	entity.StatusShipped,
+	entity.StatusDelivered,
 }
 
 type orderService struct {
 	orderRepo     repository.OrderRepository
+	couponRepo    repository.CouponRepository
 	cartService   CartService
 	listingClient listingpb.ListingServiceClient
 	msgPublisher  nats.MessagePublisher
+	orderNumbers  OrderNumberGenerator
+	placementLock repository.OrderPlacementLock
+	lockTTL       time.Duration
+	idempotency   repository.OrderIdempotencyStore
+	keyTTL        time.Duration
+	addresses     *AddressValidator
 	log           logger.Logger
 }
 
+// OrderPlacement holds what PlaceOrder needs besides the order and cart stores. Lock keeps a
+// user from placing two orders at once for up to LockTTL; Idempotency remembers the order
+// placed for an Idempotency-Key for IdempotencyTTL.
+type OrderPlacement struct {
+	OrderNumbers   OrderNumberGenerator
+	Lock           repository.OrderPlacementLock
+	LockTTL        time.Duration
+	Idempotency    repository.OrderIdempotencyStore
+	IdempotencyTTL time.Duration
+	Addresses      *AddressValidator
+}
+
 func NewOrderService(
 	orderRepo repository.OrderRepository,
+	couponRepo repository.CouponRepository,
 	cartService CartService,
 	listingClient listingpb.ListingServiceClient,
 	msgPublisher nats.MessagePublisher,
+	placement OrderPlacement,
 	log logger.Logger,
 ) OrderService {
 	return &orderService{
 		orderRepo:     orderRepo,
+		couponRepo:    couponRepo,
 		cartService:   cartService,
 		listingClient: listingClient,
 		msgPublisher:  msgPublisher,
+		orderNumbers:  placement.OrderNumbers,
+		placementLock: placement.Lock,
+		lockTTL:       placement.LockTTL,
+		idempotency:   placement.Idempotency,
+		keyTTL:        placement.IdempotencyTTL,
+		addresses:     placement.Addresses,
 		log:           log,
 	}
 }
 
+// findOrderByReference resolves either an order ID or a customer-facing order number.
+func findOrderByReference(ctx context.Context, orderRepo repository.OrderRepository, ref string) (*entity.Order, error) {
+	orderEntity, err := orderRepo.GetByID(ctx, ref)
+	if errors.Is(err, repository.ErrNotFound) {
+		return orderRepo.GetByOrderNumber(ctx, ref)
+	}
+	return orderEntity, err
+}
+
 func mapEntityAddressToProto(addr entity.Address) *commonpb.AddressProto {
 	return &commonpb.AddressProto{
 		Street:     addr.Street,
@@ -98,6 +184,23 @@ func mapEntityOrderToProto(orderEntity *entity.Order) *orderpb.OrderProto {
 		}
 	}
 
+	var refundProto *orderpb.RefundDetailsProto
+	if orderEntity.Refund != nil {
+		refundProto = &orderpb.RefundDetailsProto{
+			Amount:     orderEntity.Refund.Amount,
+			Reason:     orderEntity.Refund.Reason,
+			RefundedBy: orderEntity.Refund.RefundedBy,
+			RefundedAt: timestamppb.New(orderEntity.Refund.RefundedAt),
+		}
+	}
+
+	var couponCode string
+	var discountAmount float64
+	if orderEntity.Coupon != nil {
+		couponCode = orderEntity.Coupon.Code
+		discountAmount = orderEntity.Coupon.Discount
+	}
+
 	var statusProto orderpb.OrderStatusProto
 	statusValue, ok := orderpb.OrderStatusProto_value[string(orderEntity.Status)]
 	if ok {
@@ -108,6 +211,7 @@ func mapEntityOrderToProto(orderEntity *entity.Order) *orderpb.OrderProto {
 
 	return &orderpb.OrderProto{
 		Id:              orderEntity.ID,
+		OrderNumber:     orderEntity.OrderNumber,
 		UserId:          orderEntity.UserID,
 		Items:           itemsProto,
 		TotalAmount:     orderEntity.TotalAmount,
@@ -115,14 +219,147 @@ func mapEntityOrderToProto(orderEntity *entity.Order) *orderpb.OrderProto {
 		ShippingAddress: mapEntityAddressToProto(orderEntity.ShippingAddress),
 		BillingAddress:  mapEntityAddressToProto(orderEntity.BillingAddress),
 		PaymentDetails:  paymentDetailsProto,
+		Refund:          refundProto,
+		CouponCode:      couponCode,
+		DiscountAmount:  discountAmount,
 		CreatedAt:       timestamppb.New(orderEntity.CreatedAt),
 		UpdatedAt:       timestamppb.New(orderEntity.UpdatedAt),
 	}
 }
 
-func (s *orderService) PlaceOrder(ctx context.Context, userID string, shippingAddrProto *commonpb.AddressProto, billingAddrProto *commonpb.AddressProto) (*orderpb.OrderProto, error) {
+// validateAddresses checks the shipping address and, when given, the billing address.
+// An omitted billing address stays empty.
+func (s *orderService) validateAddresses(shipping, billing entity.Address) (entity.Address, entity.Address, error) {
+	shipping, violations := s.addresses.Validate("shipping_address", shipping)
+	if !isEmptyAddress(billing) {
+		var billingViolations []FieldViolation
+		billing, billingViolations = s.addresses.Validate("billing_address", billing)
+		violations = append(violations, billingViolations...)
+	}
+	if len(violations) > 0 {
+		return entity.Address{}, entity.Address{}, &AddressValidationError{Violations: violations}
+	}
+	return shipping, billing, nil
+}
+
+// findIdempotentOrder returns the order already placed with the user's idempotency key, or
+// nil if the key is new.
+func (s *orderService) findIdempotentOrder(ctx context.Context, userID, idempotencyKey string) (*entity.Order, error) {
+	orderID, err := s.idempotency.Get(ctx, userID, idempotencyKey)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up idempotency key: %w", err)
+	}
+	orderEntity, err := s.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load order %s for idempotency key: %w", orderID, err)
+	}
+	return orderEntity, nil
+}
+
+// applyCoupon validates the coupon for the user's order of subtotal and claims one of its
+// uses. The caller must release the use if the order is not created.
+func (s *orderService) applyCoupon(ctx context.Context, userID, code string, subtotal float64) (*entity.Coupon, float64, error) {
+	coupon, err := s.couponRepo.GetByCode(ctx, entity.NormalizeCouponCode(code))
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, 0, entity.ErrCouponNotFound
+	}
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get coupon: %w", err)
+	}
+	userUses, err := s.couponRepo.CountUserRedemptions(ctx, coupon.ID, userID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to check coupon usage: %w", err)
+	}
+	if err := coupon.CheckApplicable(time.Now().UTC(), subtotal, userUses); err != nil {
+		return nil, 0, err
+	}
+	claimed, err := s.couponRepo.ClaimUse(ctx, coupon.ID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to claim coupon use: %w", err)
+	}
+	if !claimed {
+		return nil, 0, entity.ErrCouponUsageLimitReached
+	}
+	return coupon, coupon.Discount(subtotal), nil
+}
+
+// reserveStock takes the ordered quantity of every item from listing-service under the order
+// number (the order ID does not exist yet). If any item cannot be reserved, the items reserved
+// so far are released and ErrOutOfStock is returned.
+func (s *orderService) reserveStock(ctx context.Context, orderNumber string, items []entity.OrderItem) error {
+	for i, item := range items {
+		_, err := s.listingClient.ReserveStock(ctx, &listingpb.ReserveStockRequest{ListingId: item.ProductID, Quantity: int64(item.Quantity), OrderNumber: orderNumber})
+		if err == nil {
+			continue
+		}
+		s.releaseStock(ctx, orderNumber, items[:i])
+		switch status.Code(err) {
+		case codes.FailedPrecondition, codes.NotFound:
+			return fmt.Errorf("%w: product %s (%s)", ErrOutOfStock, item.ProductID, item.ProductName)
+		}
+		return fmt.Errorf("failed to reserve stock for product %s: %w", item.ProductID, err)
+	}
+	return nil
+}
+
+// releaseStock hands the stock reserved for the order back to listing-service, which returns
+// each reservation at most once. Failures are only logged: the order outcome is already decided
+// and the stock can be corrected by the seller.
+func (s *orderService) releaseStock(ctx context.Context, orderNumber string, items []entity.OrderItem) {
+	ctx = context.WithoutCancel(ctx)
+	for _, item := range items {
+		if _, err := s.listingClient.ReleaseStock(ctx, &listingpb.ReleaseStockRequest{ListingId: item.ProductID, OrderNumber: orderNumber}); err != nil {
+			s.log.Warnf("Failed to release stock of product %s for order %s: %v", item.ProductID, orderNumber, err)
+		}
+	}
+}
+
+func (s *orderService) PlaceOrder(ctx context.Context, userID string, shippingAddrProto *commonpb.AddressProto, billingAddrProto *commonpb.AddressProto, idempotencyKey, couponCode string) (*orderpb.OrderProto, error) {
 	s.log.Infof("Placing order for user ID: %s", userID)
 
+	shippingAddr, billingAddr, err := s.validateAddresses(mapProtoAddressToEntity(shippingAddrProto), mapProtoAddressToEntity(billingAddrProto))
+	if err != nil {
+		s.log.Warnf("Rejected order placement for user ID %s: %v", userID, err)
+		return nil, err
+	}
+
+	lockToken, acquired, err := s.placementLock.Acquire(ctx, userID, s.lockTTL)
+	if err != nil {
+		s.log.Errorf("Failed to acquire order placement lock for user ID %s: %v", userID, err)
+		return nil, fmt.Errorf("failed to acquire order placement lock: %w", err)
+	}
+	if !acquired {
+		s.log.Warnf("Rejected concurrent order placement for user ID %s", userID)
+		return nil, ErrOrderInProgress
+	}
+	defer func() {
+		// The request context may already be cancelled; the lock must still be released.
+		if err := s.placementLock.Release(context.WithoutCancel(ctx), userID, lockToken); err != nil {
+			s.log.Warnf("Failed to release order placement lock for user ID %s: %v", userID, err)
+		}
+	}()
+
+	// Checked under the lock, so a retry that raced the original request sees its key.
+	if idempotencyKey != "" {
+		existing, err := s.findIdempotentOrder(ctx, userID, idempotencyKey)
+		if err != nil {
+			s.log.Errorf("Failed to check idempotency key for user ID %s: %v", userID, err)
+			return nil, err
+		}
+		if existing != nil {
+			s.log.Infof("Returning order %s already placed with the same idempotency key for user ID %s", existing.ID, userID)
+			return mapEntityOrderToProto(existing), nil
+		}
+	}
+
+	if err := s.cartService.CheckAvailabilityWindows(ctx, userID); err != nil {
+		s.log.Warnf("Rejected order placement for user ID %s: %v", userID, err)
+		return nil, err
+	}
+
 	cartPbProto, err := s.cartService.GetCart(ctx, userID)
 	if err != nil {
 		s.log.Errorf("Failed to get cart for user ID %s: %v", userID, err)
@@ -149,9 +386,6 @@ func (s *orderService) PlaceOrder(ctx context.Context, userID string, shippingAd
 		orderItems[i] = *newOrderItem
 	}
 
-	shippingAddr := mapProtoAddressToEntity(shippingAddrProto)
-	billingAddr := mapProtoAddressToEntity(billingAddrProto)
-
 	orderEntity, err := entity.NewOrder(userID, orderItems, shippingAddr, billingAddr)
 	if err != nil {
 		s.log.Errorf("Failed to create new order entity for user ID %s: %v", userID, err)
@@ -159,35 +393,86 @@ func (s *orderService) PlaceOrder(ctx context.Context, userID string, shippingAd
 	}
 	orderEntity.TotalAmount = cartPbProto.TotalAmount
 
+	orderNumber, err := s.orderNumbers.Next(ctx)
+	if err != nil {
+		s.log.Errorf("Failed to generate order number for user ID %s: %v", userID, err)
+		return nil, fmt.Errorf("failed to generate order number: %w", err)
+	}
+	orderEntity.OrderNumber = orderNumber
+
+	var coupon *entity.Coupon
+	if couponCode != "" {
+		var discount float64
+		coupon, discount, err = s.applyCoupon(ctx, userID, couponCode, orderEntity.TotalAmount)
+		if err != nil {
+			s.log.Warnf("Rejected coupon %q for user ID %s: %v", couponCode, userID, err)
+			return nil, err
+		}
+		orderEntity.Coupon = &entity.AppliedCoupon{CouponID: coupon.ID, Code: coupon.Code, Discount: discount}
+		orderEntity.TotalAmount = math.Round((orderEntity.TotalAmount-discount)*100) / 100
+	}
+	releaseCoupon := func() {
+		if coupon == nil {
+			return
+		}
+		if errRelease := s.couponRepo.ReleaseUse(context.WithoutCancel(ctx), coupon.ID); errRelease != nil {
+			s.log.Warnf("Failed to release use of coupon %s: %v", coupon.Code, errRelease)
+		}
+	}
+
+	// Reserved last, right before the order is saved, so fewer failures have to hand stock back.
+	if err := s.reserveStock(ctx, orderEntity.OrderNumber, orderEntity.Items); err != nil {
+		s.log.Warnf("Failed to reserve stock for order of user ID %s: %v", userID, err)
+		releaseCoupon()
+		return nil, err
+	}
+
 	orderID, err := s.orderRepo.Create(ctx, repository.CreateOrderParams{
+		OrderNumber:     orderEntity.OrderNumber,
 		UserID:          orderEntity.UserID,
 		Items:           orderEntity.Items,
 		TotalAmount:     orderEntity.TotalAmount,
 		Status:          orderEntity.Status,
 		ShippingAddress: orderEntity.ShippingAddress,
 		BillingAddress:  orderEntity.BillingAddress,
+		Coupon:          orderEntity.Coupon,
 	})
 	if err != nil {
 		s.log.Errorf("Failed to save order for user ID %s to repository: %v", userID, err)
+		s.releaseStock(ctx, orderEntity.OrderNumber, orderEntity.Items)
+		releaseCoupon()
 		return nil, fmt.Errorf("failed to save order: %w", err)
 	}
 	orderEntity.ID = orderID
 
+	if coupon != nil {
+		if err := s.couponRepo.RecordRedemption(ctx, coupon.ID, userID, orderID); err != nil {
+			s.log.Warnf("Failed to record redemption of coupon %s for order %s: %v", coupon.Code, orderID, err)
+		}
+	}
+
+	if idempotencyKey != "" {
+		if err := s.idempotency.Save(ctx, userID, idempotencyKey, orderID, s.keyTTL); err != nil {
+			s.log.Warnf("Failed to save idempotency key for order %s of user ID %s: %v", orderID, userID, err)
+		}
+	}
+
 	if err := s.cartService.ClearCart(ctx, userID); err != nil {
 		s.log.Warnf("Failed to clear cart for user ID %s after placing order %s: %v", userID, orderID, err)
 	}
 
-	if err := s.msgPublisher.Publish(ctx, natsSubjectOrderCreated, mapEntityOrderToProto(orderEntity)); err != nil {
+	if err := s.msgPublisher.PublishWithID(ctx, natsSubjectOrderCreated, natsSubjectOrderCreated+":"+orderID, mapEntityOrderToProto(orderEntity)); err != nil {
 		s.log.Warnf("Failed to publish order created event for order ID %s: %v", orderID, err)
 	}
 
-	s.log.Infof("Order %s placed successfully for user ID %s", orderID, userID)
+	s.log.Infof("Order %s (%s) placed successfully for user ID %s", orderID, orderNumber, userID)
 	return mapEntityOrderToProto(orderEntity), nil
 }
 
+// GetOrderByID accepts either the order ID or its order number.
 func (s *orderService) GetOrderByID(ctx context.Context, orderID, userID string, isAdmin bool) (*orderpb.OrderProto, error) {
 	s.log.Infof("Getting order by ID: %s, UserID: %s, IsAdmin: %t", orderID, userID, isAdmin)
-	orderEntity, err := s.orderRepo.GetByID(ctx, orderID)
+	orderEntity, err := findOrderByReference(ctx, s.orderRepo, orderID)
 	if err != nil {
 		s.log.Errorf("Failed to get order by ID %s from repository: %v", orderID, err)
 		if errors.Is(err, repository.ErrNotFound) {
@@ -264,6 +549,7 @@ func (s *orderService) CancelUserOrder(ctx context.Context, orderID, userID stri
 		return nil, fmt.Errorf("failed to update order status in repository: %w", err)
 	}
 	orderEntity.Version = currentVersion + 1
+	s.releaseStock(ctx, orderEntity.OrderNumber, orderEntity.Items)
 
 	if errPub := s.msgPublisher.Publish(ctx, natsSubjectOrderStatusUpdated, mapEntityOrderToProto(orderEntity)); errPub != nil {
 		s.log.Warnf("Failed to publish order status updated event for order ID %s: %v", orderID, errPub)
@@ -287,6 +573,10 @@ func (s *orderService) UpdateOrderStatusByAdmin(ctx context.Context, orderID str
 		return nil, fmt.Errorf("invalid new status: %s", newStatusProto.String())
 	}
 	newStatusEntity := entity.OrderStatus(newStatusString)
+	if newStatusEntity == entity.StatusRefunded {
+		// A bare status change would leave the refund unrecorded.
+		return nil, fmt.Errorf("%w: use RefundOrder to refund order %s", entity.ErrInvalidStatusTransition, orderID)
+	}
 
 	currentVersion := orderEntity.Version
 	err = orderEntity.UpdateStatus(newStatusEntity)
@@ -306,6 +596,9 @@ func (s *orderService) UpdateOrderStatusByAdmin(ctx context.Context, orderID str
 		return nil, fmt.Errorf("failed to update order status in repository: %w", err)
 	}
 	orderEntity.Version = currentVersion + 1
+	if newStatusEntity == entity.StatusCancelled {
+		s.releaseStock(ctx, orderEntity.OrderNumber, orderEntity.Items)
+	}
 
 	if errPub := s.msgPublisher.Publish(ctx, natsSubjectOrderStatusUpdated, mapEntityOrderToProto(orderEntity)); errPub != nil {
 		s.log.Warnf("Failed to publish order status updated event for order ID %s: %v", orderID, errPub)
@@ -315,6 +608,48 @@ func (s *orderService) UpdateOrderStatusByAdmin(ctx context.Context, orderID str
 	return mapEntityOrderToProto(orderEntity), nil
 }
 
+func (s *orderService) RefundOrder(ctx context.Context, orderID, adminID string, amount float64, reason string) (*orderpb.OrderProto, error) {
+	s.log.Infof("Admin %s refunding %.2f of order %s", adminID, amount, orderID)
+	if adminID == "" {
+		return nil, errors.New("admin ID is required to refund an order")
+	}
+	orderEntity, err := s.orderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		s.log.Errorf("Failed to get order %s for refund by admin %s: %v", orderID, adminID, err)
+		return nil, fmt.Errorf("order %s not found: %w", orderID, err)
+	}
+
+	currentVersion := orderEntity.Version
+	if err := orderEntity.MarkRefunded(amount, reason, adminID); err != nil {
+		s.log.Warnf("Order %s cannot be refunded by admin %s: %v", orderID, adminID, err)
+		return nil, err
+	}
+
+	updateParams := repository.UpdateOrderRefundParams{
+		OrderID: orderEntity.ID,
+		Refund:  *orderEntity.Refund,
+		Status:  orderEntity.Status,
+		Version: currentVersion,
+	}
+	if err := s.orderRepo.UpdateRefund(ctx, updateParams); err != nil {
+		s.log.Errorf("Failed to save refund of order %s to repository by admin %s: %v", orderID, adminID, err)
+		return nil, fmt.Errorf("failed to save order refund in repository: %w", err)
+	}
+	// A full refund means the goods are not sold after all; a partial one (a discount, a damaged
+	// part) leaves them with the buyer.
+	if orderEntity.IsFullyRefunded() {
+		s.releaseStock(ctx, orderEntity.OrderNumber, orderEntity.Items)
+	}
+
+	orderProto := mapEntityOrderToProto(orderEntity)
+	if errPub := s.msgPublisher.Publish(ctx, natsSubjectOrderRefunded, orderProto); errPub != nil {
+		s.log.Warnf("Failed to publish order refunded event for order ID %s: %v", orderID, errPub)
+	}
+
+	s.log.Infof("Order %s refunded (%.2f of %.2f) by admin %s", orderID, amount, orderEntity.TotalAmount, adminID)
+	return orderProto, nil
+}
+
 func (s *orderService) ListAllOrdersAdmin(ctx context.Context, adminID string, paginationProto *commonpb.PaginationRequest, filters map[string]string) ([]*orderpb.OrderProto, int64, error) {
 	s.log.Infof("Admin %s listing all orders with pagination and filters: %+v", adminID, filters)
 
@@ -334,6 +669,10 @@ func (s *orderService) ListAllOrdersAdmin(ctx context.Context, adminID string, p
 	if sortOrder, ok := filters["sort_order"]; ok {
 		listParams.SortOrder = sortOrder
 	}
+	if err := applyRangeFilters(&listParams, filters); err != nil {
+		s.log.Warnf("Admin %s passed invalid order filters: %v", adminID, err)
+		return nil, 0, err
+	}
 
 	result, err := s.orderRepo.List(ctx, listParams)
 	if err != nil {
@@ -349,3 +688,131 @@ func (s *orderService) ListAllOrdersAdmin(ctx context.Context, adminID string, p
 	s.log.Infof("Listed %d total orders for admin %s", result.TotalCount, adminID)
 	return ordersProto, result.TotalCount, nil
 }
+
+// applyRangeFilters parses the created_after/created_before (RFC3339) and min_total/max_total
+// filters into params and checks that each range is not inverted.
+func applyRangeFilters(params *repository.ListOrdersParams, filters map[string]string) error {
+	for key, dst := range map[string]**time.Time{"created_after": &params.CreatedAfter, "created_before": &params.CreatedBefore} {
+		value, ok := filters[key]
+		if !ok || value == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return fmt.Errorf("%w: %s must be an RFC3339 timestamp, got %q", ErrInvalidFilter, key, value)
+		}
+		t = t.UTC()
+		*dst = &t
+	}
+	if params.CreatedAfter != nil && params.CreatedBefore != nil && !params.CreatedAfter.Before(*params.CreatedBefore) {
+		return fmt.Errorf("%w: created_after must precede created_before", ErrInvalidFilter)
+	}
+
+	for key, dst := range map[string]**float64{"min_total": &params.MinTotal, "max_total": &params.MaxTotal} {
+		value, ok := filters[key]
+		if !ok || value == "" {
+			continue
+		}
+		amount, err := strconv.ParseFloat(value, 64)
+		if err != nil || amount < 0 || math.IsNaN(amount) || math.IsInf(amount, 0) {
+			return fmt.Errorf("%w: %s must be a non-negative number, got %q", ErrInvalidFilter, key, value)
+		}
+		*dst = &amount
+	}
+	if params.MinTotal != nil && params.MaxTotal != nil && *params.MinTotal > *params.MaxTotal {
+		return fmt.Errorf("%w: min_total must not exceed max_total", ErrInvalidFilter)
+	}
+	return nil
+}
+
+func (s *orderService) Reorder(ctx context.Context, orderID, userID string) (*orderpb.OrderProto, []*orderpb.SkippedItemProto, error) {
+	s.log.Infof("User %s reordering order %s", userID, orderID)
+	previous, err := findOrderByReference(ctx, s.orderRepo, orderID)
+	if err != nil {
+		s.log.Errorf("Failed to get order %s for reorder: %v", orderID, err)
+		return nil, nil, fmt.Errorf("order %s not found: %w", orderID, err)
+	}
+	if previous.UserID != userID {
+		s.log.Warnf("User %s attempted to reorder order %s belonging to user %s", userID, orderID, previous.UserID)
+		return nil, nil, ErrNotOrderOwner
+	}
+
+	cart, err := s.cartService.GetCart(ctx, userID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to retrieve cart for reorder: %w", err)
+	}
+	if len(cart.GetItems()) > 0 {
+		return nil, nil, ErrCartNotEmpty
+	}
+
+	ids := make([]string, len(previous.Items))
+	for i, item := range previous.Items {
+		ids[i] = item.ProductID
+	}
+	// If the batch lookup fails, AddItem still rejects products that are not active.
+	var statuses map[string]*listingpb.ListingAvailability
+	if resp, err := s.listingClient.GetListingsStatus(ctx, &listingpb.GetListingsStatusRequest{Ids: ids}); err != nil {
+		s.log.Warnf("Reorder: batch status lookup for order %s failed, relying on cart checks: %v", orderID, err)
+	} else {
+		statuses = resp.GetStatuses()
+	}
+
+	var skipped []*orderpb.SkippedItemProto
+	skip := func(item entity.OrderItem, reason string) {
+		skipped = append(skipped, &orderpb.SkippedItemProto{
+			ProductId:   item.ProductID,
+			ProductName: item.ProductName,
+			Quantity:    int32(item.Quantity),
+			Reason:      reason,
+		})
+	}
+	added := 0
+	for _, item := range previous.Items {
+		if statuses != nil {
+			availability, ok := statuses[item.ProductID]
+			if !ok || availability == nil {
+				skip(item, "product no longer exists")
+				continue
+			}
+			if !availability.GetAvailable() {
+				skip(item, fmt.Sprintf("product is not available (status: %s)", availability.GetStatus()))
+				continue
+			}
+		}
+		if _, err := s.cartService.AddItem(ctx, userID, item.ProductID, item.Quantity); err != nil {
+			s.log.Warnf("Reorder: could not add product %s of order %s to the cart: %v", item.ProductID, orderID, err)
+			skip(item, err.Error())
+			continue
+		}
+		added++
+	}
+	if added == 0 {
+		return nil, skipped, ErrNothingToReorder
+	}
+
+	orderProto, err := s.PlaceOrder(ctx, userID, mapEntityAddressToProto(previous.ShippingAddress), mapEntityAddressToProto(previous.BillingAddress), "", "")
+	if err != nil {
+		// The cart was empty before, so clearing it restores what the user had.
+		if errClear := s.cartService.ClearCart(context.WithoutCancel(ctx), userID); errClear != nil {
+			s.log.Warnf("Failed to clear cart of user %s after failed reorder: %v", userID, errClear)
+		}
+		return nil, skipped, err
+	}
+	s.log.Infof("Order %s reordered as %s by user %s, %d item(s) skipped", orderID, orderProto.GetId(), userID, len(skipped))
+	return orderProto, skipped, nil
+}
+
+func (s *orderService) VerifyPurchase(ctx context.Context, userID, productID string) (*orderpb.OrderProto, bool, error) {
+	if userID == "" || productID == "" {
+		return nil, false, errors.New("user ID and product ID are required")
+	}
+	orderEntity, err := s.orderRepo.FindEarliestWithProduct(ctx, userID, productID, purchasedStatuses)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, false, nil
+		}
+		s.log.Errorf("Failed to verify purchase of product %s by user %s: %v", productID, userID, err)
+		return nil, false, err
+	}
+	return mapEntityOrderToProto(orderEntity), true, nil
+}
@@ -0,0 +1,442 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	listingpb "github.com/Abdurahmanit/GroupProject/listing-service/genproto/listing_service"
+	"github.com/Abdurahmanit/GroupProject/order-service/internal/domain/entity"
+	"github.com/Abdurahmanit/GroupProject/order-service/internal/repository"
+	cartpb "github.com/Abdurahmanit/GroupProject/order-service/proto/cart"
+	commonpb "github.com/Abdurahmanit/GroupProject/order-service/proto/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeOrderRepository keeps orders in memory; only the methods PlaceOrder needs are implemented.
+type fakeOrderRepository struct {
+	repository.OrderRepository
+	mu     sync.Mutex
+	orders map[string]*entity.Order
+}
+
+func (r *fakeOrderRepository) Create(ctx context.Context, params repository.CreateOrderParams) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	id := fmt.Sprintf("order-%d", len(r.orders)+1)
+	r.orders[id] = &entity.Order{
+		ID:          id,
+		OrderNumber: params.OrderNumber,
+		UserID:      params.UserID,
+		Items:       params.Items,
+		TotalAmount: params.TotalAmount,
+		Status:      params.Status,
+		Version:     1,
+	}
+	return id, nil
+}
+
+func (r *fakeOrderRepository) GetByID(ctx context.Context, orderID string) (*entity.Order, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	order, ok := r.orders[orderID]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	return order, nil
+}
+
+func (r *fakeOrderRepository) UpdateRefund(ctx context.Context, params repository.UpdateOrderRefundParams) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	order, ok := r.orders[params.OrderID]
+	if !ok {
+		return repository.ErrNotFound
+	}
+	refund := params.Refund
+	order.Refund = &refund
+	order.Status = params.Status
+	return nil
+}
+
+// fakeCartService serves one cart per user; ClearCart empties it like the real service.
+type fakeCartService struct {
+	CartService
+	carts       map[string]*cartpb.CartProto
+	unavailable map[string]bool
+	cleared     int
+}
+
+func (c *fakeCartService) GetCart(ctx context.Context, userID string) (*cartpb.CartProto, error) {
+	if cart, ok := c.carts[userID]; ok {
+		return cart, nil
+	}
+	return &cartpb.CartProto{UserId: userID}, nil
+}
+
+// AddItem rejects products listed in unavailable, like the real service does for inactive listings.
+func (c *fakeCartService) AddItem(ctx context.Context, userID, productID string, quantity int) (*cartpb.CartProto, error) {
+	if c.unavailable[productID] {
+		return nil, fmt.Errorf("product %s is not available for purchase", productID)
+	}
+	cart, ok := c.carts[userID]
+	if !ok {
+		cart = &cartpb.CartProto{UserId: userID}
+		c.carts[userID] = cart
+	}
+	cart.Items = append(cart.Items, &cartpb.CartItemProto{ProductId: productID, ProductName: productID, Quantity: int32(quantity), PricePerUnit: 10, TotalPrice: 10 * float64(quantity)})
+	cart.TotalAmount += 10 * float64(quantity)
+	return cart, nil
+}
+
+func (c *fakeCartService) ClearCart(ctx context.Context, userID string) error {
+	delete(c.carts, userID)
+	c.cleared++
+	return nil
+}
+
+func (c *fakeCartService) CheckAvailabilityWindows(ctx context.Context, userID string) error {
+	return nil
+}
+
+// fakeStockClient reserves stock from an in-memory map; products missing from it have plenty.
+// Like listing-service, it releases each order's reservation at most once.
+// It fails the batch status lookup so Reorder relies on AddItem, like MockListingServiceClient.
+type fakeStockClient struct {
+	listingpb.ListingServiceClient
+	stock    map[string]int64
+	reserved map[string]int64 // listing ID + "/" + order number -> units
+}
+
+func (c *fakeStockClient) GetListingsStatus(ctx context.Context, in *listingpb.GetListingsStatusRequest, opts ...grpc.CallOption) (*listingpb.GetListingsStatusResponse, error) {
+	return nil, status.Error(codes.Unavailable, "not implemented in fake")
+}
+
+func (c *fakeStockClient) ReserveStock(ctx context.Context, in *listingpb.ReserveStockRequest, opts ...grpc.CallOption) (*listingpb.ReserveStockResponse, error) {
+	left, ok := c.stock[in.GetListingId()]
+	if !ok {
+		return &listingpb.ReserveStockResponse{Remaining: 100}, nil
+	}
+	if left < in.GetQuantity() {
+		return nil, status.Error(codes.FailedPrecondition, "out of stock")
+	}
+	c.stock[in.GetListingId()] = left - in.GetQuantity()
+	if c.reserved == nil {
+		c.reserved = make(map[string]int64)
+	}
+	c.reserved[in.GetListingId()+"/"+in.GetOrderNumber()] = in.GetQuantity()
+	return &listingpb.ReserveStockResponse{Remaining: left - in.GetQuantity()}, nil
+}
+
+func (c *fakeStockClient) ReleaseStock(ctx context.Context, in *listingpb.ReleaseStockRequest, opts ...grpc.CallOption) (*listingpb.Empty, error) {
+	key := in.GetListingId() + "/" + in.GetOrderNumber()
+	c.stock[in.GetListingId()] += c.reserved[key]
+	delete(c.reserved, key)
+	return &listingpb.Empty{}, nil
+}
+
+type fakePublisher struct{}
+
+func (fakePublisher) Publish(ctx context.Context, subject string, message interface{}) error {
+	return nil
+}
+
+func (fakePublisher) PublishRaw(ctx context.Context, subject string, data []byte) error {
+	return nil
+}
+
+func (fakePublisher) PublishWithID(ctx context.Context, subject, msgID string, message interface{}) error {
+	return nil
+}
+
+type fakeOrderNumbers struct{ n int }
+
+func (g *fakeOrderNumbers) Next(ctx context.Context) (string, error) {
+	g.n++
+	return fmt.Sprintf("ORD-%06d", g.n), nil
+}
+
+type fakePlacementLock struct{}
+
+func (fakePlacementLock) Acquire(ctx context.Context, userID string, ttl time.Duration) (string, bool, error) {
+	return "token", true, nil
+}
+
+func (fakePlacementLock) Release(ctx context.Context, userID, token string) error {
+	return nil
+}
+
+type fakeIdempotencyStore struct {
+	keys map[string]string
+}
+
+func (s *fakeIdempotencyStore) Get(ctx context.Context, userID, key string) (string, error) {
+	orderID, ok := s.keys[userID+":"+key]
+	if !ok {
+		return "", repository.ErrNotFound
+	}
+	return orderID, nil
+}
+
+func (s *fakeIdempotencyStore) Save(ctx context.Context, userID, key, orderID string, ttl time.Duration) error {
+	s.keys[userID+":"+key] = orderID
+	return nil
+}
+
+func newTestPlacement(addresses *AddressValidator) OrderPlacement {
+	return OrderPlacement{
+		OrderNumbers:   &fakeOrderNumbers{},
+		Lock:           fakePlacementLock{},
+		LockTTL:        time.Minute,
+		Idempotency:    &fakeIdempotencyStore{keys: make(map[string]string)},
+		IdempotencyTTL: time.Hour,
+		Addresses:      addresses,
+	}
+}
+
+func TestOrderService_PlaceOrder_IdempotencyKey(t *testing.T) {
+	ctx := context.Background()
+	const userID = "user-1"
+	newCart := func() *cartpb.CartProto {
+		return &cartpb.CartProto{
+			UserId:      userID,
+			Items:       []*cartpb.CartItemProto{{ProductId: "product-1", ProductName: "Bike", Quantity: 1, PricePerUnit: 100, TotalPrice: 100}},
+			TotalAmount: 100,
+		}
+	}
+	orders := &fakeOrderRepository{orders: make(map[string]*entity.Order)}
+	carts := &fakeCartService{carts: map[string]*cartpb.CartProto{userID: newCart()}}
+	addresses, err := NewAddressValidator(nil)
+	require.NoError(t, err)
+	svc := NewOrderService(orders, nil, carts, &fakeStockClient{}, fakePublisher{}, newTestPlacement(addresses), NewNoOpLogger())
+	shipping := &commonpb.AddressProto{Street: "Abay 1", City: "Almaty", PostalCode: "050000", Country: "KZ"}
+
+	first, err := svc.PlaceOrder(ctx, userID, shipping, nil, "key-1", "")
+	require.NoError(t, err)
+	second, err := svc.PlaceOrder(ctx, userID, shipping, nil, "key-1", "")
+	require.NoError(t, err)
+
+	assert.Equal(t, first.GetId(), second.GetId())
+	assert.Equal(t, first.GetOrderNumber(), second.GetOrderNumber())
+	assert.Len(t, orders.orders, 1)
+	assert.Equal(t, 1, carts.cleared)
+
+	// A different key places a new order.
+	carts.carts[userID] = newCart()
+	third, err := svc.PlaceOrder(ctx, userID, shipping, nil, "key-2", "")
+	require.NoError(t, err)
+	assert.NotEqual(t, first.GetId(), third.GetId())
+	assert.Len(t, orders.orders, 2)
+}
+
+// fakeCouponRepository holds a single coupon and counts redemptions per user.
+type fakeCouponRepository struct {
+	coupon      entity.Coupon
+	redemptions map[string]int
+}
+
+func (r *fakeCouponRepository) GetByCode(ctx context.Context, code string) (*entity.Coupon, error) {
+	if code != r.coupon.Code {
+		return nil, repository.ErrNotFound
+	}
+	coupon := r.coupon
+	return &coupon, nil
+}
+
+func (r *fakeCouponRepository) CountUserRedemptions(ctx context.Context, couponID, userID string) (int, error) {
+	return r.redemptions[userID], nil
+}
+
+func (r *fakeCouponRepository) ClaimUse(ctx context.Context, couponID string) (bool, error) {
+	if r.coupon.MaxUses > 0 && r.coupon.UsedCount >= r.coupon.MaxUses {
+		return false, nil
+	}
+	r.coupon.UsedCount++
+	return true, nil
+}
+
+func (r *fakeCouponRepository) ReleaseUse(ctx context.Context, couponID string) error {
+	r.coupon.UsedCount--
+	return nil
+}
+
+func (r *fakeCouponRepository) RecordRedemption(ctx context.Context, couponID, userID, orderID string) error {
+	r.redemptions[userID]++
+	return nil
+}
+
+func TestOrderService_PlaceOrder_Coupon(t *testing.T) {
+	ctx := context.Background()
+	const userID = "user-1"
+	newCart := func() *cartpb.CartProto {
+		return &cartpb.CartProto{
+			UserId:      userID,
+			Items:       []*cartpb.CartItemProto{{ProductId: "product-1", ProductName: "Bike", Quantity: 2, PricePerUnit: 50, TotalPrice: 100}},
+			TotalAmount: 100,
+		}
+	}
+	coupons := &fakeCouponRepository{
+		coupon:      entity.Coupon{ID: "coupon-1", Code: "SPRING10", Type: entity.CouponTypePercentage, Value: 10, MinOrderAmount: 50, MaxUsesPerUser: 1},
+		redemptions: make(map[string]int),
+	}
+	orders := &fakeOrderRepository{orders: make(map[string]*entity.Order)}
+	carts := &fakeCartService{carts: map[string]*cartpb.CartProto{userID: newCart()}}
+	addresses, err := NewAddressValidator(nil)
+	require.NoError(t, err)
+	svc := NewOrderService(orders, coupons, carts, &fakeStockClient{}, fakePublisher{}, newTestPlacement(addresses), NewNoOpLogger())
+	shipping := &commonpb.AddressProto{Street: "Abay 1", City: "Almaty", PostalCode: "050000", Country: "KZ"}
+
+	order, err := svc.PlaceOrder(ctx, userID, shipping, nil, "", " spring10 ")
+	require.NoError(t, err)
+	assert.Equal(t, 90.0, order.GetTotalAmount())
+	assert.Equal(t, 10.0, order.GetDiscountAmount())
+	assert.Equal(t, "SPRING10", order.GetCouponCode())
+
+	carts.carts[userID] = newCart()
+	_, err = svc.PlaceOrder(ctx, userID, shipping, nil, "", "SPRING10")
+	assert.ErrorIs(t, err, entity.ErrCouponUserLimitReached)
+	assert.Equal(t, 1, coupons.coupon.UsedCount)
+
+	_, err = svc.PlaceOrder(ctx, userID, shipping, nil, "", "UNKNOWN")
+	assert.ErrorIs(t, err, entity.ErrCouponNotFound)
+	assert.Len(t, orders.orders, 1)
+}
+
+func TestOrderService_PlaceOrder_OutOfStock(t *testing.T) {
+	ctx := context.Background()
+	const userID = "user-1"
+	newCart := func() *cartpb.CartProto {
+		return &cartpb.CartProto{
+			UserId: userID,
+			Items: []*cartpb.CartItemProto{
+				{ProductId: "product-1", ProductName: "Bike", Quantity: 1, PricePerUnit: 100, TotalPrice: 100},
+				{ProductId: "product-2", ProductName: "Helmet", Quantity: 2, PricePerUnit: 20, TotalPrice: 40},
+			},
+			TotalAmount: 140,
+		}
+	}
+	stock := &fakeStockClient{stock: map[string]int64{"product-1": 1, "product-2": 1}}
+	orders := &fakeOrderRepository{orders: make(map[string]*entity.Order)}
+	carts := &fakeCartService{carts: map[string]*cartpb.CartProto{userID: newCart()}}
+	addresses, err := NewAddressValidator(nil)
+	require.NoError(t, err)
+	svc := NewOrderService(orders, nil, carts, stock, fakePublisher{}, newTestPlacement(addresses), NewNoOpLogger())
+	shipping := &commonpb.AddressProto{Street: "Abay 1", City: "Almaty", PostalCode: "050000", Country: "KZ"}
+
+	_, err = svc.PlaceOrder(ctx, userID, shipping, nil, "", "")
+	assert.ErrorIs(t, err, ErrOutOfStock)
+	assert.Empty(t, orders.orders)
+	// The bike reserved before the helmet failed is handed back.
+	assert.Equal(t, map[string]int64{"product-1": 1, "product-2": 1}, stock.stock)
+
+	stock.stock["product-2"] = 2
+	_, err = svc.PlaceOrder(ctx, userID, shipping, nil, "", "")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"product-1": 0, "product-2": 0}, stock.stock)
+}
+
+func TestOrderService_RefundOrder_ReleasesStock(t *testing.T) {
+	ctx := context.Background()
+	const userID = "user-1"
+	newCart := func() *cartpb.CartProto {
+		return &cartpb.CartProto{
+			UserId:      userID,
+			Items:       []*cartpb.CartItemProto{{ProductId: "product-1", ProductName: "Bike", Quantity: 2, PricePerUnit: 100, TotalPrice: 200}},
+			TotalAmount: 200,
+		}
+	}
+	stock := &fakeStockClient{stock: map[string]int64{"product-1": 5}}
+	orders := &fakeOrderRepository{orders: make(map[string]*entity.Order)}
+	carts := &fakeCartService{carts: map[string]*cartpb.CartProto{userID: newCart()}}
+	addresses, err := NewAddressValidator(nil)
+	require.NoError(t, err)
+	svc := NewOrderService(orders, nil, carts, stock, fakePublisher{}, newTestPlacement(addresses), NewNoOpLogger())
+	shipping := &commonpb.AddressProto{Street: "Abay 1", City: "Almaty", PostalCode: "050000", Country: "KZ"}
+
+	full, err := svc.PlaceOrder(ctx, userID, shipping, nil, "", "")
+	require.NoError(t, err)
+	carts.carts[userID] = newCart()
+	partial, err := svc.PlaceOrder(ctx, userID, shipping, nil, "", "")
+	require.NoError(t, err)
+	require.Equal(t, int64(1), stock.stock["product-1"])
+	for _, o := range orders.orders {
+		o.Status = entity.StatusDelivered
+	}
+
+	_, err = svc.RefundOrder(ctx, partial.GetId(), "admin-1", 50, "scratched frame")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), stock.stock["product-1"], "a partial refund leaves the goods with the buyer")
+
+	_, err = svc.RefundOrder(ctx, full.GetId(), "admin-1", 200, "returned")
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), stock.stock["product-1"])
+
+	// A second release of the same order (e.g. a retried call) returns nothing more.
+	svc.(*orderService).releaseStock(ctx, orders.orders[full.GetId()].OrderNumber, orders.orders[full.GetId()].Items)
+	assert.Equal(t, int64(3), stock.stock["product-1"])
+}
+
+func TestOrderService_Reorder_SkipsUnavailableItems(t *testing.T) {
+	ctx := context.Background()
+	const userID = "user-1"
+	orders := &fakeOrderRepository{orders: map[string]*entity.Order{
+		"order-1": {
+			ID:     "order-1",
+			UserID: userID,
+			Items: []entity.OrderItem{
+				{ProductID: "product-1", ProductName: "Bike", Quantity: 1, PricePerUnit: 10, TotalPrice: 10},
+				{ProductID: "product-2", ProductName: "Helmet", Quantity: 2, PricePerUnit: 10, TotalPrice: 20},
+			},
+			ShippingAddress: entity.Address{Street: "Abay 1", City: "Almaty", PostalCode: "050000", Country: "KZ"},
+		},
+	}}
+	carts := &fakeCartService{carts: make(map[string]*cartpb.CartProto), unavailable: map[string]bool{"product-2": true}}
+	addresses, err := NewAddressValidator(nil)
+	require.NoError(t, err)
+	// The fake listing client fails batch lookups, so availability comes from AddItem alone.
+	svc := NewOrderService(orders, nil, carts, &fakeStockClient{}, fakePublisher{}, newTestPlacement(addresses), NewNoOpLogger())
+
+	_, _, err = svc.Reorder(ctx, "order-1", "someone-else")
+	assert.ErrorIs(t, err, ErrNotOrderOwner)
+
+	order, skipped, err := svc.Reorder(ctx, "order-1", userID)
+	require.NoError(t, err)
+	assert.NotEqual(t, "order-1", order.GetId())
+	require.Len(t, order.GetItems(), 1)
+	assert.Equal(t, "product-1", order.GetItems()[0].GetProductId())
+	require.Len(t, skipped, 1)
+	assert.Equal(t, "product-2", skipped[0].GetProductId())
+	assert.Equal(t, int32(2), skipped[0].GetQuantity())
+}
+
+func TestApplyRangeFilters(t *testing.T) {
+	var params repository.ListOrdersParams
+	err := applyRangeFilters(&params, map[string]string{
+		"created_after":  "2024-05-01T00:00:00Z",
+		"created_before": "2024-06-01T00:00:00+05:00",
+		"min_total":      "10",
+		"max_total":      "99.5",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), *params.CreatedAfter)
+	assert.Equal(t, time.Date(2024, 5, 31, 19, 0, 0, 0, time.UTC), *params.CreatedBefore)
+	assert.Equal(t, 10.0, *params.MinTotal)
+	assert.Equal(t, 99.5, *params.MaxTotal)
+
+	for name, filters := range map[string]map[string]string{
+		"bad date":       {"created_after": "2024-05-01"},
+		"inverted dates": {"created_after": "2024-06-01T00:00:00Z", "created_before": "2024-05-01T00:00:00Z"},
+		"bad amount":     {"min_total": "ten"},
+		"negative":       {"max_total": "-1"},
+		"inverted total": {"min_total": "50", "max_total": "10"},
+	} {
+		err := applyRangeFilters(&repository.ListOrdersParams{}, filters)
+		assert.ErrorIs(t, err, ErrInvalidFilter, name)
+	}
+}
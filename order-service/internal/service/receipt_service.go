@@ -31,7 +31,7 @@ func NewReceiptService(
 func (s *receiptService) GenerateOrderReceiptPDF(ctx context.Context, orderID, userID string) ([]byte, string, error) {
 	s.log.Infof("Generating PDF receipt for order ID: %s, requested by User ID: %s", orderID, userID)
 
-	orderEntity, err := s.orderRepo.GetByID(ctx, orderID)
+	orderEntity, err := findOrderByReference(ctx, s.orderRepo, orderID)
 	if err != nil {
 		s.log.Errorf("Failed to get order by ID %s for PDF generation: %v", orderID, err)
 		if errors.Is(err, repository.ErrNotFound) {
@@ -46,7 +46,8 @@ func (s *receiptService) GenerateOrderReceiptPDF(ctx context.Context, orderID, u
 	}
 
 	receiptContent := fmt.Sprintf(
-		"Order ID: %s\nUser ID: %s\nTotal Amount: %.2f\nStatus: %s\n\nItems:\n",
+		"Order Number: %s\nOrder ID: %s\nUser ID: %s\nTotal Amount: %.2f\nStatus: %s\n\nItems:\n",
+		orderEntity.OrderNumber,
 		orderEntity.ID,
 		orderEntity.UserID,
 		orderEntity.TotalAmount,
@@ -60,7 +61,11 @@ func (s *receiptService) GenerateOrderReceiptPDF(ctx context.Context, orderID, u
 			item.TotalPrice,
 		)
 	}
-	fileName := fmt.Sprintf("receipt_%s.txt", orderID)
+	receiptRef := orderEntity.OrderNumber
+	if receiptRef == "" {
+		receiptRef = orderEntity.ID
+	}
+	fileName := fmt.Sprintf("receipt_%s.txt", receiptRef)
 
 	s.log.Infof("Generated temporary text receipt for order ID %s", orderID)
 	return []byte(receiptContent), fileName, nil
@@ -9,6 +9,7 @@ package cartpb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -157,11 +158,133 @@ func (x *CartProto) GetTotalAmount() float64 {
 	return 0
 }
 
+// AbandonedCartItemProto summarises a cart line for re-engagement emails; it is not
+// enriched with listing data, so prices may have changed since the cart was saved.
+type AbandonedCartItemProto struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbandonedCartItemProto) Reset() {
+	*x = AbandonedCartItemProto{}
+	mi := &file_cart_messages_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbandonedCartItemProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbandonedCartItemProto) ProtoMessage() {}
+
+func (x *AbandonedCartItemProto) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_messages_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbandonedCartItemProto.ProtoReflect.Descriptor instead.
+func (*AbandonedCartItemProto) Descriptor() ([]byte, []int) {
+	return file_cart_messages_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AbandonedCartItemProto) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *AbandonedCartItemProto) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type AbandonedCartProto struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	UserId        string                    `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Items         []*AbandonedCartItemProto `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	TotalQuantity int32                     `protobuf:"varint,3,opt,name=total_quantity,json=totalQuantity,proto3" json:"total_quantity,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp    `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbandonedCartProto) Reset() {
+	*x = AbandonedCartProto{}
+	mi := &file_cart_messages_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbandonedCartProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbandonedCartProto) ProtoMessage() {}
+
+func (x *AbandonedCartProto) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_messages_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbandonedCartProto.ProtoReflect.Descriptor instead.
+func (*AbandonedCartProto) Descriptor() ([]byte, []int) {
+	return file_cart_messages_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AbandonedCartProto) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AbandonedCartProto) GetItems() []*AbandonedCartItemProto {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *AbandonedCartProto) GetTotalQuantity() int32 {
+	if x != nil {
+		return x.TotalQuantity
+	}
+	return 0
+}
+
+func (x *AbandonedCartProto) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
 var File_cart_messages_proto protoreflect.FileDescriptor
 
 const file_cart_messages_proto_rawDesc = "" +
 	"\n" +
-	"\x13cart_messages.proto\x12\x04cart\"\xb4\x01\n" +
+	"\x13cart_messages.proto\x12\x04cart\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb4\x01\n" +
 	"\rCartItemProto\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
@@ -173,7 +296,17 @@ const file_cart_messages_proto_rawDesc = "" +
 	"\tCartProto\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12)\n" +
 	"\x05items\x18\x02 \x03(\v2\x13.cart.CartItemProtoR\x05items\x12!\n" +
-	"\ftotal_amount\x18\x03 \x01(\x01R\vtotalAmountBFZDgithub.com/Abdurahmanit/GroupProject/order-service/proto/cart;cartpbb\x06proto3"
+	"\ftotal_amount\x18\x03 \x01(\x01R\vtotalAmount\"S\n" +
+	"\x16AbandonedCartItemProto\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\"\xc3\x01\n" +
+	"\x12AbandonedCartProto\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x122\n" +
+	"\x05items\x18\x02 \x03(\v2\x1c.cart.AbandonedCartItemProtoR\x05items\x12%\n" +
+	"\x0etotal_quantity\x18\x03 \x01(\x05R\rtotalQuantity\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtBFZDgithub.com/Abdurahmanit/GroupProject/order-service/proto/cart;cartpbb\x06proto3"
 
 var (
 	file_cart_messages_proto_rawDescOnce sync.Once
@@ -187,18 +320,23 @@ func file_cart_messages_proto_rawDescGZIP() []byte {
 	return file_cart_messages_proto_rawDescData
 }
 
-var file_cart_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cart_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_cart_messages_proto_goTypes = []any{
-	(*CartItemProto)(nil), // 0: cart.CartItemProto
-	(*CartProto)(nil),     // 1: cart.CartProto
+	(*CartItemProto)(nil),          // 0: cart.CartItemProto
+	(*CartProto)(nil),              // 1: cart.CartProto
+	(*AbandonedCartItemProto)(nil), // 2: cart.AbandonedCartItemProto
+	(*AbandonedCartProto)(nil),     // 3: cart.AbandonedCartProto
+	(*timestamppb.Timestamp)(nil),  // 4: google.protobuf.Timestamp
 }
 var file_cart_messages_proto_depIdxs = []int32{
 	0, // 0: cart.CartProto.items:type_name -> cart.CartItemProto
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2, // 1: cart.AbandonedCartProto.items:type_name -> cart.AbandonedCartItemProto
+	4, // 2: cart.AbandonedCartProto.updated_at:type_name -> google.protobuf.Timestamp
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_cart_messages_proto_init() }
@@ -212,7 +350,7 @@ func file_cart_messages_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cart_messages_proto_rawDesc), len(file_cart_messages_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -34,6 +34,7 @@ const (
 	OrderStatusProto_DELIVERED                      OrderStatusProto = 5
 	OrderStatusProto_CANCELLED                      OrderStatusProto = 6
 	OrderStatusProto_FAILED                         OrderStatusProto = 7
+	OrderStatusProto_REFUNDED                       OrderStatusProto = 8
 )
 
 // Enum value maps for OrderStatusProto.
@@ -47,6 +48,7 @@ var (
 		5: "DELIVERED",
 		6: "CANCELLED",
 		7: "FAILED",
+		8: "REFUNDED",
 	}
 	OrderStatusProto_value = map[string]int32{
 		"ORDER_STATUS_PROTO_UNSPECIFIED": 0,
@@ -57,6 +59,7 @@ var (
 		"DELIVERED":                      5,
 		"CANCELLED":                      6,
 		"FAILED":                         7,
+		"REFUNDED":                       8,
 	}
 )
 
@@ -223,6 +226,143 @@ func (x *PaymentDetailsProto) GetPaymentStatus() string {
 	return ""
 }
 
+// SkippedItemProto is an item of a previous order that could not be bought again.
+type SkippedItemProto struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductName   string                 `protobuf:"bytes,2,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkippedItemProto) Reset() {
+	*x = SkippedItemProto{}
+	mi := &file_order_messages_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkippedItemProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkippedItemProto) ProtoMessage() {}
+
+func (x *SkippedItemProto) ProtoReflect() protoreflect.Message {
+	mi := &file_order_messages_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkippedItemProto.ProtoReflect.Descriptor instead.
+func (*SkippedItemProto) Descriptor() ([]byte, []int) {
+	return file_order_messages_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SkippedItemProto) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SkippedItemProto) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *SkippedItemProto) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *SkippedItemProto) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RefundDetailsProto struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        float64                `protobuf:"fixed64,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	RefundedBy    string                 `protobuf:"bytes,3,opt,name=refunded_by,json=refundedBy,proto3" json:"refunded_by,omitempty"`
+	RefundedAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=refunded_at,json=refundedAt,proto3" json:"refunded_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundDetailsProto) Reset() {
+	*x = RefundDetailsProto{}
+	mi := &file_order_messages_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundDetailsProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundDetailsProto) ProtoMessage() {}
+
+func (x *RefundDetailsProto) ProtoReflect() protoreflect.Message {
+	mi := &file_order_messages_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundDetailsProto.ProtoReflect.Descriptor instead.
+func (*RefundDetailsProto) Descriptor() ([]byte, []int) {
+	return file_order_messages_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RefundDetailsProto) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RefundDetailsProto) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RefundDetailsProto) GetRefundedBy() string {
+	if x != nil {
+		return x.RefundedBy
+	}
+	return ""
+}
+
+func (x *RefundDetailsProto) GetRefundedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RefundedAt
+	}
+	return nil
+}
+
 type OrderProto struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -235,13 +375,17 @@ type OrderProto struct {
 	PaymentDetails  *PaymentDetailsProto   `protobuf:"bytes,8,opt,name=payment_details,json=paymentDetails,proto3" json:"payment_details,omitempty"`
 	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	OrderNumber     string                 `protobuf:"bytes,11,opt,name=order_number,json=orderNumber,proto3" json:"order_number,omitempty"`            // customer-facing reference, e.g. ORD-2024-000123
+	Refund          *RefundDetailsProto    `protobuf:"bytes,12,opt,name=refund,proto3" json:"refund,omitempty"`                                         // set once the order is refunded
+	CouponCode      string                 `protobuf:"bytes,13,opt,name=coupon_code,json=couponCode,proto3" json:"coupon_code,omitempty"`               // empty when no coupon was applied
+	DiscountAmount  float64                `protobuf:"fixed64,14,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"` // already subtracted from total_amount
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
 func (x *OrderProto) Reset() {
 	*x = OrderProto{}
-	mi := &file_order_messages_proto_msgTypes[2]
+	mi := &file_order_messages_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -253,7 +397,7 @@ func (x *OrderProto) String() string {
 func (*OrderProto) ProtoMessage() {}
 
 func (x *OrderProto) ProtoReflect() protoreflect.Message {
-	mi := &file_order_messages_proto_msgTypes[2]
+	mi := &file_order_messages_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -266,7 +410,7 @@ func (x *OrderProto) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrderProto.ProtoReflect.Descriptor instead.
 func (*OrderProto) Descriptor() ([]byte, []int) {
-	return file_order_messages_proto_rawDescGZIP(), []int{2}
+	return file_order_messages_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *OrderProto) GetId() string {
@@ -339,6 +483,34 @@ func (x *OrderProto) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *OrderProto) GetOrderNumber() string {
+	if x != nil {
+		return x.OrderNumber
+	}
+	return ""
+}
+
+func (x *OrderProto) GetRefund() *RefundDetailsProto {
+	if x != nil {
+		return x.Refund
+	}
+	return nil
+}
+
+func (x *OrderProto) GetCouponCode() string {
+	if x != nil {
+		return x.CouponCode
+	}
+	return ""
+}
+
+func (x *OrderProto) GetDiscountAmount() float64 {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return 0
+}
+
 var File_order_messages_proto protoreflect.FileDescriptor
 
 const file_order_messages_proto_rawDesc = "" +
@@ -355,7 +527,20 @@ const file_order_messages_proto_rawDesc = "" +
 	"\x13PaymentDetailsProto\x12*\n" +
 	"\x11payment_method_id\x18\x01 \x01(\tR\x0fpaymentMethodId\x12%\n" +
 	"\x0etransaction_id\x18\x02 \x01(\tR\rtransactionId\x12%\n" +
-	"\x0epayment_status\x18\x03 \x01(\tR\rpaymentStatus\"\xf1\x03\n" +
+	"\x0epayment_status\x18\x03 \x01(\tR\rpaymentStatus\"\x88\x01\n" +
+	"\x10SkippedItemProto\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12!\n" +
+	"\fproduct_name\x18\x02 \x01(\tR\vproductName\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\xa2\x01\n" +
+	"\x12RefundDetailsProto\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x01R\x06amount\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x1f\n" +
+	"\vrefunded_by\x18\x03 \x01(\tR\n" +
+	"refundedBy\x12;\n" +
+	"\vrefunded_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"refundedAt\"\x91\x05\n" +
 	"\n" +
 	"OrderProto\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
@@ -370,7 +555,12 @@ const file_order_messages_proto_rawDesc = "" +
 	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
 	"updated_at\x18\n" +
-	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt*\x9c\x01\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12!\n" +
+	"\forder_number\x18\v \x01(\tR\vorderNumber\x121\n" +
+	"\x06refund\x18\f \x01(\v2\x19.order.RefundDetailsProtoR\x06refund\x12\x1f\n" +
+	"\vcoupon_code\x18\r \x01(\tR\n" +
+	"couponCode\x12'\n" +
+	"\x0fdiscount_amount\x18\x0e \x01(\x01R\x0ediscountAmount*\xaa\x01\n" +
 	"\x10OrderStatusProto\x12\"\n" +
 	"\x1eORDER_STATUS_PROTO_UNSPECIFIED\x10\x00\x12\x13\n" +
 	"\x0fPENDING_PAYMENT\x10\x01\x12\b\n" +
@@ -381,7 +571,8 @@ const file_order_messages_proto_rawDesc = "" +
 	"\tDELIVERED\x10\x05\x12\r\n" +
 	"\tCANCELLED\x10\x06\x12\n" +
 	"\n" +
-	"\x06FAILED\x10\aBHZFgithub.com/Abdurahmanit/GroupProject/order-service/proto/order;orderpbb\x06proto3"
+	"\x06FAILED\x10\a\x12\f\n" +
+	"\bREFUNDED\x10\bBHZFgithub.com/Abdurahmanit/GroupProject/order-service/proto/order;orderpbb\x06proto3"
 
 var (
 	file_order_messages_proto_rawDescOnce sync.Once
@@ -396,28 +587,32 @@ func file_order_messages_proto_rawDescGZIP() []byte {
 }
 
 var file_order_messages_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_order_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_order_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_order_messages_proto_goTypes = []any{
 	(OrderStatusProto)(0),         // 0: order.OrderStatusProto
 	(*OrderItemProto)(nil),        // 1: order.OrderItemProto
 	(*PaymentDetailsProto)(nil),   // 2: order.PaymentDetailsProto
-	(*OrderProto)(nil),            // 3: order.OrderProto
-	(*common.AddressProto)(nil),   // 4: common.AddressProto
-	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+	(*SkippedItemProto)(nil),      // 3: order.SkippedItemProto
+	(*RefundDetailsProto)(nil),    // 4: order.RefundDetailsProto
+	(*OrderProto)(nil),            // 5: order.OrderProto
+	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
+	(*common.AddressProto)(nil),   // 7: common.AddressProto
 }
 var file_order_messages_proto_depIdxs = []int32{
-	1, // 0: order.OrderProto.items:type_name -> order.OrderItemProto
-	0, // 1: order.OrderProto.status:type_name -> order.OrderStatusProto
-	4, // 2: order.OrderProto.shipping_address:type_name -> common.AddressProto
-	4, // 3: order.OrderProto.billing_address:type_name -> common.AddressProto
-	2, // 4: order.OrderProto.payment_details:type_name -> order.PaymentDetailsProto
-	5, // 5: order.OrderProto.created_at:type_name -> google.protobuf.Timestamp
-	5, // 6: order.OrderProto.updated_at:type_name -> google.protobuf.Timestamp
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	6, // 0: order.RefundDetailsProto.refunded_at:type_name -> google.protobuf.Timestamp
+	1, // 1: order.OrderProto.items:type_name -> order.OrderItemProto
+	0, // 2: order.OrderProto.status:type_name -> order.OrderStatusProto
+	7, // 3: order.OrderProto.shipping_address:type_name -> common.AddressProto
+	7, // 4: order.OrderProto.billing_address:type_name -> common.AddressProto
+	2, // 5: order.OrderProto.payment_details:type_name -> order.PaymentDetailsProto
+	6, // 6: order.OrderProto.created_at:type_name -> google.protobuf.Timestamp
+	6, // 7: order.OrderProto.updated_at:type_name -> google.protobuf.Timestamp
+	4, // 8: order.OrderProto.refund:type_name -> order.RefundDetailsProto
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_order_messages_proto_init() }
@@ -431,7 +626,7 @@ func file_order_messages_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_order_messages_proto_rawDesc), len(file_order_messages_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   3,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -13,7 +13,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
-	_ "google.golang.org/protobuf/types/known/timestamppb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -291,8 +291,11 @@ type PlaceOrderRequest struct {
 	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	ShippingAddress *common.AddressProto   `protobuf:"bytes,2,opt,name=shipping_address,json=shippingAddress,proto3" json:"shipping_address,omitempty"`
 	BillingAddress  *common.AddressProto   `protobuf:"bytes,3,opt,name=billing_address,json=billingAddress,proto3" json:"billing_address,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Необязательный ключ клиента: повтор с тем же ключом возвращает уже созданный заказ
+	IdempotencyKey string `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	CouponCode     string `protobuf:"bytes,5,opt,name=coupon_code,json=couponCode,proto3" json:"coupon_code,omitempty"` // необязательный код купона на скидку
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *PlaceOrderRequest) Reset() {
@@ -346,9 +349,23 @@ func (x *PlaceOrderRequest) GetBillingAddress() *common.AddressProto {
 	return nil
 }
 
+func (x *PlaceOrderRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *PlaceOrderRequest) GetCouponCode() string {
+	if x != nil {
+		return x.CouponCode
+	}
+	return ""
+}
+
 type GetOrderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"` // order ID or order number
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -390,6 +407,120 @@ func (x *GetOrderRequest) GetOrderId() string {
 	return ""
 }
 
+// VerifyPurchaseRequest asks whether the user has a paid, processing, shipped or delivered
+// order that contains the product.
+type VerifyPurchaseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyPurchaseRequest) Reset() {
+	*x = VerifyPurchaseRequest{}
+	mi := &file_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyPurchaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyPurchaseRequest) ProtoMessage() {}
+
+func (x *VerifyPurchaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyPurchaseRequest.ProtoReflect.Descriptor instead.
+func (*VerifyPurchaseRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *VerifyPurchaseRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *VerifyPurchaseRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type VerifyPurchaseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Purchased     bool                   `protobuf:"varint,1,opt,name=purchased,proto3" json:"purchased,omitempty"`
+	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"` // Earliest qualifying order; empty when purchased is false
+	PurchasedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=purchased_at,json=purchasedAt,proto3" json:"purchased_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyPurchaseResponse) Reset() {
+	*x = VerifyPurchaseResponse{}
+	mi := &file_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyPurchaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyPurchaseResponse) ProtoMessage() {}
+
+func (x *VerifyPurchaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyPurchaseResponse.ProtoReflect.Descriptor instead.
+func (*VerifyPurchaseResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *VerifyPurchaseResponse) GetPurchased() bool {
+	if x != nil {
+		return x.Purchased
+	}
+	return false
+}
+
+func (x *VerifyPurchaseResponse) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *VerifyPurchaseResponse) GetPurchasedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PurchasedAt
+	}
+	return nil
+}
+
 type ListUserOrdersRequest struct {
 	state         protoimpl.MessageState    `protogen:"open.v1"`
 	UserId        string                    `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -400,7 +531,7 @@ type ListUserOrdersRequest struct {
 
 func (x *ListUserOrdersRequest) Reset() {
 	*x = ListUserOrdersRequest{}
-	mi := &file_service_proto_msgTypes[7]
+	mi := &file_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -412,7 +543,7 @@ func (x *ListUserOrdersRequest) String() string {
 func (*ListUserOrdersRequest) ProtoMessage() {}
 
 func (x *ListUserOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[7]
+	mi := &file_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -425,7 +556,7 @@ func (x *ListUserOrdersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserOrdersRequest.ProtoReflect.Descriptor instead.
 func (*ListUserOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{7}
+	return file_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ListUserOrdersRequest) GetUserId() string {
@@ -452,7 +583,7 @@ type ListUserOrdersResponse struct {
 
 func (x *ListUserOrdersResponse) Reset() {
 	*x = ListUserOrdersResponse{}
-	mi := &file_service_proto_msgTypes[8]
+	mi := &file_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -464,7 +595,7 @@ func (x *ListUserOrdersResponse) String() string {
 func (*ListUserOrdersResponse) ProtoMessage() {}
 
 func (x *ListUserOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[8]
+	mi := &file_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -477,7 +608,7 @@ func (x *ListUserOrdersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserOrdersResponse.ProtoReflect.Descriptor instead.
 func (*ListUserOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{8}
+	return file_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListUserOrdersResponse) GetOrders() []*order.OrderProto {
@@ -504,7 +635,7 @@ type CancelOrderRequest struct {
 
 func (x *CancelOrderRequest) Reset() {
 	*x = CancelOrderRequest{}
-	mi := &file_service_proto_msgTypes[9]
+	mi := &file_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -516,7 +647,7 @@ func (x *CancelOrderRequest) String() string {
 func (*CancelOrderRequest) ProtoMessage() {}
 
 func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[9]
+	mi := &file_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -529,7 +660,7 @@ func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
 func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{9}
+	return file_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *CancelOrderRequest) GetOrderId() string {
@@ -546,6 +677,110 @@ func (x *CancelOrderRequest) GetUserId() string {
 	return ""
 }
 
+type ReorderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"` // ID или номер прошлого заказа
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderRequest) Reset() {
+	*x = ReorderRequest{}
+	mi := &file_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderRequest) ProtoMessage() {}
+
+func (x *ReorderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderRequest.ProtoReflect.Descriptor instead.
+func (*ReorderRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ReorderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ReorderRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ReorderResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Order         *order.OrderProto         `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	SkippedItems  []*order.SkippedItemProto `protobuf:"bytes,2,rep,name=skipped_items,json=skippedItems,proto3" json:"skipped_items,omitempty"` // товары, которые больше нельзя купить
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderResponse) Reset() {
+	*x = ReorderResponse{}
+	mi := &file_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderResponse) ProtoMessage() {}
+
+func (x *ReorderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderResponse.ProtoReflect.Descriptor instead.
+func (*ReorderResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ReorderResponse) GetOrder() *order.OrderProto {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *ReorderResponse) GetSkippedItems() []*order.SkippedItemProto {
+	if x != nil {
+		return x.SkippedItems
+	}
+	return nil
+}
+
 type UpdateOrderStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
@@ -557,7 +792,7 @@ type UpdateOrderStatusRequest struct {
 
 func (x *UpdateOrderStatusRequest) Reset() {
 	*x = UpdateOrderStatusRequest{}
-	mi := &file_service_proto_msgTypes[10]
+	mi := &file_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -569,7 +804,7 @@ func (x *UpdateOrderStatusRequest) String() string {
 func (*UpdateOrderStatusRequest) ProtoMessage() {}
 
 func (x *UpdateOrderStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[10]
+	mi := &file_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -582,7 +817,7 @@ func (x *UpdateOrderStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateOrderStatusRequest.ProtoReflect.Descriptor instead.
 func (*UpdateOrderStatusRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{10}
+	return file_service_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *UpdateOrderStatusRequest) GetOrderId() string {
@@ -606,17 +841,88 @@ func (x *UpdateOrderStatusRequest) GetUpdatedById() string {
 	return ""
 }
 
+type RefundOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	AdminId       string                 `protobuf:"bytes,2,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"` // не больше total_amount заказа; меньше — частичный возврат
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundOrderRequest) Reset() {
+	*x = RefundOrderRequest{}
+	mi := &file_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundOrderRequest) ProtoMessage() {}
+
+func (x *RefundOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundOrderRequest.ProtoReflect.Descriptor instead.
+func (*RefundOrderRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RefundOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *RefundOrderRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+func (x *RefundOrderRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RefundOrderRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 type ListAllOrdersAdminRequest struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	AdminId       string                    `protobuf:"bytes,1,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"` // ID админа для проверки прав
-	Pagination    *common.PaginationRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	state      protoimpl.MessageState    `protogen:"open.v1"`
+	AdminId    string                    `protobuf:"bytes,1,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"` // ID админа для проверки прав
+	Pagination *common.PaginationRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// Фильтры: status, user_id, sort_by, sort_order, created_after, created_before (RFC3339,
+	// например 2024-05-01T00:00:00Z), min_total, max_total.
+	Filters       map[string]string `protobuf:"bytes,3,rep,name=filters,proto3" json:"filters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListAllOrdersAdminRequest) Reset() {
 	*x = ListAllOrdersAdminRequest{}
-	mi := &file_service_proto_msgTypes[11]
+	mi := &file_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -628,7 +934,7 @@ func (x *ListAllOrdersAdminRequest) String() string {
 func (*ListAllOrdersAdminRequest) ProtoMessage() {}
 
 func (x *ListAllOrdersAdminRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[11]
+	mi := &file_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -641,7 +947,7 @@ func (x *ListAllOrdersAdminRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAllOrdersAdminRequest.ProtoReflect.Descriptor instead.
 func (*ListAllOrdersAdminRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{11}
+	return file_service_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ListAllOrdersAdminRequest) GetAdminId() string {
@@ -658,6 +964,13 @@ func (x *ListAllOrdersAdminRequest) GetPagination() *common.PaginationRequest {
 	return nil
 }
 
+func (x *ListAllOrdersAdminRequest) GetFilters() map[string]string {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
 type ListAllOrdersAdminResponse struct {
 	state         protoimpl.MessageState     `protogen:"open.v1"`
 	Orders        []*order.OrderProto        `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
@@ -668,7 +981,7 @@ type ListAllOrdersAdminResponse struct {
 
 func (x *ListAllOrdersAdminResponse) Reset() {
 	*x = ListAllOrdersAdminResponse{}
-	mi := &file_service_proto_msgTypes[12]
+	mi := &file_service_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -680,7 +993,7 @@ func (x *ListAllOrdersAdminResponse) String() string {
 func (*ListAllOrdersAdminResponse) ProtoMessage() {}
 
 func (x *ListAllOrdersAdminResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[12]
+	mi := &file_service_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -693,7 +1006,7 @@ func (x *ListAllOrdersAdminResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAllOrdersAdminResponse.ProtoReflect.Descriptor instead.
 func (*ListAllOrdersAdminResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{12}
+	return file_service_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ListAllOrdersAdminResponse) GetOrders() []*order.OrderProto {
@@ -710,6 +1023,118 @@ func (x *ListAllOrdersAdminResponse) GetPagination() *common.PaginationResponse
 	return nil
 }
 
+type GetAbandonedCartsRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AdminId          string                 `protobuf:"bytes,1,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`                               // ID админа или системного воркера, должен быть в CART_ABANDONED_ALLOWED_CALLERS
+	OlderThanSeconds int64                  `protobuf:"varint,2,opt,name=older_than_seconds,json=olderThanSeconds,proto3" json:"older_than_seconds,omitempty"` // 0 — значение по умолчанию из CART_ABANDONED_AFTER
+	Limit            int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                                                 // 0 — значение по умолчанию из CART_ABANDONED_MAX_RESULTS
+	PublishEvents    bool                   `protobuf:"varint,4,opt,name=publish_events,json=publishEvents,proto3" json:"publish_events,omitempty"`            // опубликовать cart.abandoned для каждой найденной корзины
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetAbandonedCartsRequest) Reset() {
+	*x = GetAbandonedCartsRequest{}
+	mi := &file_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAbandonedCartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAbandonedCartsRequest) ProtoMessage() {}
+
+func (x *GetAbandonedCartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAbandonedCartsRequest.ProtoReflect.Descriptor instead.
+func (*GetAbandonedCartsRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetAbandonedCartsRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+func (x *GetAbandonedCartsRequest) GetOlderThanSeconds() int64 {
+	if x != nil {
+		return x.OlderThanSeconds
+	}
+	return 0
+}
+
+func (x *GetAbandonedCartsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetAbandonedCartsRequest) GetPublishEvents() bool {
+	if x != nil {
+		return x.PublishEvents
+	}
+	return false
+}
+
+type GetAbandonedCartsResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Carts         []*cart.AbandonedCartProto `protobuf:"bytes,1,rep,name=carts,proto3" json:"carts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAbandonedCartsResponse) Reset() {
+	*x = GetAbandonedCartsResponse{}
+	mi := &file_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAbandonedCartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAbandonedCartsResponse) ProtoMessage() {}
+
+func (x *GetAbandonedCartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAbandonedCartsResponse.ProtoReflect.Descriptor instead.
+func (*GetAbandonedCartsResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetAbandonedCartsResponse) GetCarts() []*cart.AbandonedCartProto {
+	if x != nil {
+		return x.Carts
+	}
+	return nil
+}
+
 type GenerateOrderReceiptRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
@@ -720,7 +1145,7 @@ type GenerateOrderReceiptRequest struct {
 
 func (x *GenerateOrderReceiptRequest) Reset() {
 	*x = GenerateOrderReceiptRequest{}
-	mi := &file_service_proto_msgTypes[13]
+	mi := &file_service_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -732,7 +1157,7 @@ func (x *GenerateOrderReceiptRequest) String() string {
 func (*GenerateOrderReceiptRequest) ProtoMessage() {}
 
 func (x *GenerateOrderReceiptRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[13]
+	mi := &file_service_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -745,7 +1170,7 @@ func (x *GenerateOrderReceiptRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateOrderReceiptRequest.ProtoReflect.Descriptor instead.
 func (*GenerateOrderReceiptRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{13}
+	return file_service_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GenerateOrderReceiptRequest) GetOrderId() string {
@@ -772,7 +1197,7 @@ type GenerateOrderReceiptResponse struct {
 
 func (x *GenerateOrderReceiptResponse) Reset() {
 	*x = GenerateOrderReceiptResponse{}
-	mi := &file_service_proto_msgTypes[14]
+	mi := &file_service_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -784,7 +1209,7 @@ func (x *GenerateOrderReceiptResponse) String() string {
 func (*GenerateOrderReceiptResponse) ProtoMessage() {}
 
 func (x *GenerateOrderReceiptResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[14]
+	mi := &file_service_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -797,7 +1222,7 @@ func (x *GenerateOrderReceiptResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateOrderReceiptResponse.ProtoReflect.Descriptor instead.
 func (*GenerateOrderReceiptResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{14}
+	return file_service_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *GenerateOrderReceiptResponse) GetPdfContent() []byte {
@@ -836,13 +1261,24 @@ const file_service_proto_rawDesc = "" +
 	"\x0eGetCartRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\"+\n" +
 	"\x10ClearCartRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xac\x01\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xf6\x01\n" +
 	"\x11PlaceOrderRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12?\n" +
 	"\x10shipping_address\x18\x02 \x01(\v2\x14.common.AddressProtoR\x0fshippingAddress\x12=\n" +
-	"\x0fbilling_address\x18\x03 \x01(\v2\x14.common.AddressProtoR\x0ebillingAddress\",\n" +
+	"\x0fbilling_address\x18\x03 \x01(\v2\x14.common.AddressProtoR\x0ebillingAddress\x12'\n" +
+	"\x0fidempotency_key\x18\x04 \x01(\tR\x0eidempotencyKey\x12\x1f\n" +
+	"\vcoupon_code\x18\x05 \x01(\tR\n" +
+	"couponCode\",\n" +
 	"\x0fGetOrderRequest\x12\x19\n" +
-	"\border_id\x18\x01 \x01(\tR\aorderId\"k\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\"O\n" +
+	"\x15VerifyPurchaseRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\"\x90\x01\n" +
+	"\x16VerifyPurchaseResponse\x12\x1c\n" +
+	"\tpurchased\x18\x01 \x01(\bR\tpurchased\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12=\n" +
+	"\fpurchased_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vpurchasedAt\"k\n" +
 	"\x15ListUserOrdersRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x129\n" +
 	"\n" +
@@ -855,29 +1291,51 @@ const file_service_proto_rawDesc = "" +
 	"pagination\"H\n" +
 	"\x12CancelOrderRequest\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x91\x01\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"D\n" +
+	"\x0eReorderRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"x\n" +
+	"\x0fReorderResponse\x12'\n" +
+	"\x05order\x18\x01 \x01(\v2\x11.order.OrderProtoR\x05order\x12<\n" +
+	"\rskipped_items\x18\x02 \x03(\v2\x17.order.SkippedItemProtoR\fskippedItems\"\x91\x01\n" +
 	"\x18UpdateOrderStatusRequest\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\tR\aorderId\x126\n" +
 	"\n" +
 	"new_status\x18\x02 \x01(\x0e2\x17.order.OrderStatusProtoR\tnewStatus\x12\"\n" +
-	"\rupdated_by_id\x18\x03 \x01(\tR\vupdatedById\"q\n" +
+	"\rupdated_by_id\x18\x03 \x01(\tR\vupdatedById\"z\n" +
+	"\x12RefundOrderRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x19\n" +
+	"\badmin_id\x18\x02 \x01(\tR\aadminId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\xf8\x01\n" +
 	"\x19ListAllOrdersAdminRequest\x12\x19\n" +
 	"\badmin_id\x18\x01 \x01(\tR\aadminId\x129\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2\x19.common.PaginationRequestR\n" +
-	"pagination\"\x83\x01\n" +
+	"pagination\x12I\n" +
+	"\afilters\x18\x03 \x03(\v2/.service.ListAllOrdersAdminRequest.FiltersEntryR\afilters\x1a:\n" +
+	"\fFiltersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x83\x01\n" +
 	"\x1aListAllOrdersAdminResponse\x12)\n" +
 	"\x06orders\x18\x01 \x03(\v2\x11.order.OrderProtoR\x06orders\x12:\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2\x1a.common.PaginationResponseR\n" +
-	"pagination\"Q\n" +
+	"pagination\"\xa0\x01\n" +
+	"\x18GetAbandonedCartsRequest\x12\x19\n" +
+	"\badmin_id\x18\x01 \x01(\tR\aadminId\x12,\n" +
+	"\x12older_than_seconds\x18\x02 \x01(\x03R\x10olderThanSeconds\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12%\n" +
+	"\x0epublish_events\x18\x04 \x01(\bR\rpublishEvents\"K\n" +
+	"\x19GetAbandonedCartsResponse\x12.\n" +
+	"\x05carts\x18\x01 \x03(\v2\x18.cart.AbandonedCartProtoR\x05carts\"Q\n" +
 	"\x1bGenerateOrderReceiptRequest\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\"\\\n" +
 	"\x1cGenerateOrderReceiptResponse\x12\x1f\n" +
 	"\vpdf_content\x18\x01 \x01(\fR\n" +
 	"pdfContent\x12\x1b\n" +
-	"\tfile_name\x18\x02 \x01(\tR\bfileName2\xf4\x06\n" +
+	"\tfile_name\x18\x02 \x01(\tR\bfileName2\xa0\t\n" +
 	"\fOrderService\x12?\n" +
 	"\rAddItemToCart\x12\x1d.service.AddItemToCartRequest\x1a\x0f.cart.CartProto\x12Q\n" +
 	"\x16UpdateCartItemQuantity\x12&.service.UpdateCartItemQuantityRequest\x1a\x0f.cart.CartProto\x12I\n" +
@@ -888,9 +1346,13 @@ const file_service_proto_rawDesc = "" +
 	"PlaceOrder\x12\x1a.service.PlaceOrderRequest\x1a\x11.order.OrderProto\x127\n" +
 	"\bGetOrder\x12\x18.service.GetOrderRequest\x1a\x11.order.OrderProto\x12Q\n" +
 	"\x0eListUserOrders\x12\x1e.service.ListUserOrdersRequest\x1a\x1f.service.ListUserOrdersResponse\x12=\n" +
-	"\vCancelOrder\x12\x1b.service.CancelOrderRequest\x1a\x11.order.OrderProto\x12I\n" +
-	"\x11UpdateOrderStatus\x12!.service.UpdateOrderStatusRequest\x1a\x11.order.OrderProto\x12X\n" +
-	"\rListAllOrders\x12\".service.ListAllOrdersAdminRequest\x1a#.service.ListAllOrdersAdminResponse\x12c\n" +
+	"\vCancelOrder\x12\x1b.service.CancelOrderRequest\x1a\x11.order.OrderProto\x12<\n" +
+	"\aReorder\x12\x17.service.ReorderRequest\x1a\x18.service.ReorderResponse\x12Q\n" +
+	"\x0eVerifyPurchase\x12\x1e.service.VerifyPurchaseRequest\x1a\x1f.service.VerifyPurchaseResponse\x12I\n" +
+	"\x11UpdateOrderStatus\x12!.service.UpdateOrderStatusRequest\x1a\x11.order.OrderProto\x12=\n" +
+	"\vRefundOrder\x12\x1b.service.RefundOrderRequest\x1a\x11.order.OrderProto\x12X\n" +
+	"\rListAllOrders\x12\".service.ListAllOrdersAdminRequest\x1a#.service.ListAllOrdersAdminResponse\x12Z\n" +
+	"\x11GetAbandonedCarts\x12!.service.GetAbandonedCartsRequest\x1a\".service.GetAbandonedCartsResponse\x12c\n" +
 	"\x14GenerateOrderReceipt\x12$.service.GenerateOrderReceiptRequest\x1a%.service.GenerateOrderReceiptResponseBLZJgithub.com/Abdurahmanit/GroupProject/order-service/proto/service;servicepbb\x06proto3"
 
 var (
@@ -905,7 +1367,7 @@ func file_service_proto_rawDescGZIP() []byte {
 	return file_service_proto_rawDescData
 }
 
-var file_service_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_service_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_service_proto_goTypes = []any{
 	(*AddItemToCartRequest)(nil),          // 0: service.AddItemToCartRequest
 	(*UpdateCartItemQuantityRequest)(nil), // 1: service.UpdateCartItemQuantityRequest
@@ -914,61 +1376,85 @@ var file_service_proto_goTypes = []any{
 	(*ClearCartRequest)(nil),              // 4: service.ClearCartRequest
 	(*PlaceOrderRequest)(nil),             // 5: service.PlaceOrderRequest
 	(*GetOrderRequest)(nil),               // 6: service.GetOrderRequest
-	(*ListUserOrdersRequest)(nil),         // 7: service.ListUserOrdersRequest
-	(*ListUserOrdersResponse)(nil),        // 8: service.ListUserOrdersResponse
-	(*CancelOrderRequest)(nil),            // 9: service.CancelOrderRequest
-	(*UpdateOrderStatusRequest)(nil),      // 10: service.UpdateOrderStatusRequest
-	(*ListAllOrdersAdminRequest)(nil),     // 11: service.ListAllOrdersAdminRequest
-	(*ListAllOrdersAdminResponse)(nil),    // 12: service.ListAllOrdersAdminResponse
-	(*GenerateOrderReceiptRequest)(nil),   // 13: service.GenerateOrderReceiptRequest
-	(*GenerateOrderReceiptResponse)(nil),  // 14: service.GenerateOrderReceiptResponse
-	(*common.AddressProto)(nil),           // 15: common.AddressProto
-	(*common.PaginationRequest)(nil),      // 16: common.PaginationRequest
-	(*order.OrderProto)(nil),              // 17: order.OrderProto
-	(*common.PaginationResponse)(nil),     // 18: common.PaginationResponse
-	(order.OrderStatusProto)(0),           // 19: order.OrderStatusProto
-	(*cart.CartProto)(nil),                // 20: cart.CartProto
-	(*emptypb.Empty)(nil),                 // 21: google.protobuf.Empty
+	(*VerifyPurchaseRequest)(nil),         // 7: service.VerifyPurchaseRequest
+	(*VerifyPurchaseResponse)(nil),        // 8: service.VerifyPurchaseResponse
+	(*ListUserOrdersRequest)(nil),         // 9: service.ListUserOrdersRequest
+	(*ListUserOrdersResponse)(nil),        // 10: service.ListUserOrdersResponse
+	(*CancelOrderRequest)(nil),            // 11: service.CancelOrderRequest
+	(*ReorderRequest)(nil),                // 12: service.ReorderRequest
+	(*ReorderResponse)(nil),               // 13: service.ReorderResponse
+	(*UpdateOrderStatusRequest)(nil),      // 14: service.UpdateOrderStatusRequest
+	(*RefundOrderRequest)(nil),            // 15: service.RefundOrderRequest
+	(*ListAllOrdersAdminRequest)(nil),     // 16: service.ListAllOrdersAdminRequest
+	(*ListAllOrdersAdminResponse)(nil),    // 17: service.ListAllOrdersAdminResponse
+	(*GetAbandonedCartsRequest)(nil),      // 18: service.GetAbandonedCartsRequest
+	(*GetAbandonedCartsResponse)(nil),     // 19: service.GetAbandonedCartsResponse
+	(*GenerateOrderReceiptRequest)(nil),   // 20: service.GenerateOrderReceiptRequest
+	(*GenerateOrderReceiptResponse)(nil),  // 21: service.GenerateOrderReceiptResponse
+	nil,                                   // 22: service.ListAllOrdersAdminRequest.FiltersEntry
+	(*common.AddressProto)(nil),           // 23: common.AddressProto
+	(*timestamppb.Timestamp)(nil),         // 24: google.protobuf.Timestamp
+	(*common.PaginationRequest)(nil),      // 25: common.PaginationRequest
+	(*order.OrderProto)(nil),              // 26: order.OrderProto
+	(*common.PaginationResponse)(nil),     // 27: common.PaginationResponse
+	(*order.SkippedItemProto)(nil),        // 28: order.SkippedItemProto
+	(order.OrderStatusProto)(0),           // 29: order.OrderStatusProto
+	(*cart.AbandonedCartProto)(nil),       // 30: cart.AbandonedCartProto
+	(*cart.CartProto)(nil),                // 31: cart.CartProto
+	(*emptypb.Empty)(nil),                 // 32: google.protobuf.Empty
 }
 var file_service_proto_depIdxs = []int32{
-	15, // 0: service.PlaceOrderRequest.shipping_address:type_name -> common.AddressProto
-	15, // 1: service.PlaceOrderRequest.billing_address:type_name -> common.AddressProto
-	16, // 2: service.ListUserOrdersRequest.pagination:type_name -> common.PaginationRequest
-	17, // 3: service.ListUserOrdersResponse.orders:type_name -> order.OrderProto
-	18, // 4: service.ListUserOrdersResponse.pagination:type_name -> common.PaginationResponse
-	19, // 5: service.UpdateOrderStatusRequest.new_status:type_name -> order.OrderStatusProto
-	16, // 6: service.ListAllOrdersAdminRequest.pagination:type_name -> common.PaginationRequest
-	17, // 7: service.ListAllOrdersAdminResponse.orders:type_name -> order.OrderProto
-	18, // 8: service.ListAllOrdersAdminResponse.pagination:type_name -> common.PaginationResponse
-	0,  // 9: service.OrderService.AddItemToCart:input_type -> service.AddItemToCartRequest
-	1,  // 10: service.OrderService.UpdateCartItemQuantity:input_type -> service.UpdateCartItemQuantityRequest
-	2,  // 11: service.OrderService.RemoveItemFromCart:input_type -> service.RemoveItemFromCartRequest
-	3,  // 12: service.OrderService.GetCart:input_type -> service.GetCartRequest
-	4,  // 13: service.OrderService.ClearCart:input_type -> service.ClearCartRequest
-	5,  // 14: service.OrderService.PlaceOrder:input_type -> service.PlaceOrderRequest
-	6,  // 15: service.OrderService.GetOrder:input_type -> service.GetOrderRequest
-	7,  // 16: service.OrderService.ListUserOrders:input_type -> service.ListUserOrdersRequest
-	9,  // 17: service.OrderService.CancelOrder:input_type -> service.CancelOrderRequest
-	10, // 18: service.OrderService.UpdateOrderStatus:input_type -> service.UpdateOrderStatusRequest
-	11, // 19: service.OrderService.ListAllOrders:input_type -> service.ListAllOrdersAdminRequest
-	13, // 20: service.OrderService.GenerateOrderReceipt:input_type -> service.GenerateOrderReceiptRequest
-	20, // 21: service.OrderService.AddItemToCart:output_type -> cart.CartProto
-	20, // 22: service.OrderService.UpdateCartItemQuantity:output_type -> cart.CartProto
-	20, // 23: service.OrderService.RemoveItemFromCart:output_type -> cart.CartProto
-	20, // 24: service.OrderService.GetCart:output_type -> cart.CartProto
-	21, // 25: service.OrderService.ClearCart:output_type -> google.protobuf.Empty
-	17, // 26: service.OrderService.PlaceOrder:output_type -> order.OrderProto
-	17, // 27: service.OrderService.GetOrder:output_type -> order.OrderProto
-	8,  // 28: service.OrderService.ListUserOrders:output_type -> service.ListUserOrdersResponse
-	17, // 29: service.OrderService.CancelOrder:output_type -> order.OrderProto
-	17, // 30: service.OrderService.UpdateOrderStatus:output_type -> order.OrderProto
-	12, // 31: service.OrderService.ListAllOrders:output_type -> service.ListAllOrdersAdminResponse
-	14, // 32: service.OrderService.GenerateOrderReceipt:output_type -> service.GenerateOrderReceiptResponse
-	21, // [21:33] is the sub-list for method output_type
-	9,  // [9:21] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	23, // 0: service.PlaceOrderRequest.shipping_address:type_name -> common.AddressProto
+	23, // 1: service.PlaceOrderRequest.billing_address:type_name -> common.AddressProto
+	24, // 2: service.VerifyPurchaseResponse.purchased_at:type_name -> google.protobuf.Timestamp
+	25, // 3: service.ListUserOrdersRequest.pagination:type_name -> common.PaginationRequest
+	26, // 4: service.ListUserOrdersResponse.orders:type_name -> order.OrderProto
+	27, // 5: service.ListUserOrdersResponse.pagination:type_name -> common.PaginationResponse
+	26, // 6: service.ReorderResponse.order:type_name -> order.OrderProto
+	28, // 7: service.ReorderResponse.skipped_items:type_name -> order.SkippedItemProto
+	29, // 8: service.UpdateOrderStatusRequest.new_status:type_name -> order.OrderStatusProto
+	25, // 9: service.ListAllOrdersAdminRequest.pagination:type_name -> common.PaginationRequest
+	22, // 10: service.ListAllOrdersAdminRequest.filters:type_name -> service.ListAllOrdersAdminRequest.FiltersEntry
+	26, // 11: service.ListAllOrdersAdminResponse.orders:type_name -> order.OrderProto
+	27, // 12: service.ListAllOrdersAdminResponse.pagination:type_name -> common.PaginationResponse
+	30, // 13: service.GetAbandonedCartsResponse.carts:type_name -> cart.AbandonedCartProto
+	0,  // 14: service.OrderService.AddItemToCart:input_type -> service.AddItemToCartRequest
+	1,  // 15: service.OrderService.UpdateCartItemQuantity:input_type -> service.UpdateCartItemQuantityRequest
+	2,  // 16: service.OrderService.RemoveItemFromCart:input_type -> service.RemoveItemFromCartRequest
+	3,  // 17: service.OrderService.GetCart:input_type -> service.GetCartRequest
+	4,  // 18: service.OrderService.ClearCart:input_type -> service.ClearCartRequest
+	5,  // 19: service.OrderService.PlaceOrder:input_type -> service.PlaceOrderRequest
+	6,  // 20: service.OrderService.GetOrder:input_type -> service.GetOrderRequest
+	9,  // 21: service.OrderService.ListUserOrders:input_type -> service.ListUserOrdersRequest
+	11, // 22: service.OrderService.CancelOrder:input_type -> service.CancelOrderRequest
+	12, // 23: service.OrderService.Reorder:input_type -> service.ReorderRequest
+	7,  // 24: service.OrderService.VerifyPurchase:input_type -> service.VerifyPurchaseRequest
+	14, // 25: service.OrderService.UpdateOrderStatus:input_type -> service.UpdateOrderStatusRequest
+	15, // 26: service.OrderService.RefundOrder:input_type -> service.RefundOrderRequest
+	16, // 27: service.OrderService.ListAllOrders:input_type -> service.ListAllOrdersAdminRequest
+	18, // 28: service.OrderService.GetAbandonedCarts:input_type -> service.GetAbandonedCartsRequest
+	20, // 29: service.OrderService.GenerateOrderReceipt:input_type -> service.GenerateOrderReceiptRequest
+	31, // 30: service.OrderService.AddItemToCart:output_type -> cart.CartProto
+	31, // 31: service.OrderService.UpdateCartItemQuantity:output_type -> cart.CartProto
+	31, // 32: service.OrderService.RemoveItemFromCart:output_type -> cart.CartProto
+	31, // 33: service.OrderService.GetCart:output_type -> cart.CartProto
+	32, // 34: service.OrderService.ClearCart:output_type -> google.protobuf.Empty
+	26, // 35: service.OrderService.PlaceOrder:output_type -> order.OrderProto
+	26, // 36: service.OrderService.GetOrder:output_type -> order.OrderProto
+	10, // 37: service.OrderService.ListUserOrders:output_type -> service.ListUserOrdersResponse
+	26, // 38: service.OrderService.CancelOrder:output_type -> order.OrderProto
+	13, // 39: service.OrderService.Reorder:output_type -> service.ReorderResponse
+	8,  // 40: service.OrderService.VerifyPurchase:output_type -> service.VerifyPurchaseResponse
+	26, // 41: service.OrderService.UpdateOrderStatus:output_type -> order.OrderProto
+	26, // 42: service.OrderService.RefundOrder:output_type -> order.OrderProto
+	17, // 43: service.OrderService.ListAllOrders:output_type -> service.ListAllOrdersAdminResponse
+	19, // 44: service.OrderService.GetAbandonedCarts:output_type -> service.GetAbandonedCartsResponse
+	21, // 45: service.OrderService.GenerateOrderReceipt:output_type -> service.GenerateOrderReceiptResponse
+	30, // [30:46] is the sub-list for method output_type
+	14, // [14:30] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_service_proto_init() }
@@ -982,7 +1468,7 @@ func file_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_service_proto_rawDesc), len(file_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -31,8 +31,12 @@ const (
 	OrderService_GetOrder_FullMethodName               = "/service.OrderService/GetOrder"
 	OrderService_ListUserOrders_FullMethodName         = "/service.OrderService/ListUserOrders"
 	OrderService_CancelOrder_FullMethodName            = "/service.OrderService/CancelOrder"
+	OrderService_Reorder_FullMethodName                = "/service.OrderService/Reorder"
+	OrderService_VerifyPurchase_FullMethodName         = "/service.OrderService/VerifyPurchase"
 	OrderService_UpdateOrderStatus_FullMethodName      = "/service.OrderService/UpdateOrderStatus"
+	OrderService_RefundOrder_FullMethodName            = "/service.OrderService/RefundOrder"
 	OrderService_ListAllOrders_FullMethodName          = "/service.OrderService/ListAllOrders"
+	OrderService_GetAbandonedCarts_FullMethodName      = "/service.OrderService/GetAbandonedCarts"
 	OrderService_GenerateOrderReceipt_FullMethodName   = "/service.OrderService/GenerateOrderReceipt"
 )
 
@@ -49,8 +53,12 @@ type OrderServiceClient interface {
 	GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*order.OrderProto, error)
 	ListUserOrders(ctx context.Context, in *ListUserOrdersRequest, opts ...grpc.CallOption) (*ListUserOrdersResponse, error)
 	CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*order.OrderProto, error)
+	Reorder(ctx context.Context, in *ReorderRequest, opts ...grpc.CallOption) (*ReorderResponse, error)
+	VerifyPurchase(ctx context.Context, in *VerifyPurchaseRequest, opts ...grpc.CallOption) (*VerifyPurchaseResponse, error)
 	UpdateOrderStatus(ctx context.Context, in *UpdateOrderStatusRequest, opts ...grpc.CallOption) (*order.OrderProto, error)
+	RefundOrder(ctx context.Context, in *RefundOrderRequest, opts ...grpc.CallOption) (*order.OrderProto, error)
 	ListAllOrders(ctx context.Context, in *ListAllOrdersAdminRequest, opts ...grpc.CallOption) (*ListAllOrdersAdminResponse, error)
+	GetAbandonedCarts(ctx context.Context, in *GetAbandonedCartsRequest, opts ...grpc.CallOption) (*GetAbandonedCartsResponse, error)
 	GenerateOrderReceipt(ctx context.Context, in *GenerateOrderReceiptRequest, opts ...grpc.CallOption) (*GenerateOrderReceiptResponse, error)
 }
 
@@ -152,6 +160,26 @@ func (c *orderServiceClient) CancelOrder(ctx context.Context, in *CancelOrderReq
 	return out, nil
 }
 
+func (c *orderServiceClient) Reorder(ctx context.Context, in *ReorderRequest, opts ...grpc.CallOption) (*ReorderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderResponse)
+	err := c.cc.Invoke(ctx, OrderService_Reorder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) VerifyPurchase(ctx context.Context, in *VerifyPurchaseRequest, opts ...grpc.CallOption) (*VerifyPurchaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyPurchaseResponse)
+	err := c.cc.Invoke(ctx, OrderService_VerifyPurchase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orderServiceClient) UpdateOrderStatus(ctx context.Context, in *UpdateOrderStatusRequest, opts ...grpc.CallOption) (*order.OrderProto, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(order.OrderProto)
@@ -162,6 +190,16 @@ func (c *orderServiceClient) UpdateOrderStatus(ctx context.Context, in *UpdateOr
 	return out, nil
 }
 
+func (c *orderServiceClient) RefundOrder(ctx context.Context, in *RefundOrderRequest, opts ...grpc.CallOption) (*order.OrderProto, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(order.OrderProto)
+	err := c.cc.Invoke(ctx, OrderService_RefundOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orderServiceClient) ListAllOrders(ctx context.Context, in *ListAllOrdersAdminRequest, opts ...grpc.CallOption) (*ListAllOrdersAdminResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListAllOrdersAdminResponse)
@@ -172,6 +210,16 @@ func (c *orderServiceClient) ListAllOrders(ctx context.Context, in *ListAllOrder
 	return out, nil
 }
 
+func (c *orderServiceClient) GetAbandonedCarts(ctx context.Context, in *GetAbandonedCartsRequest, opts ...grpc.CallOption) (*GetAbandonedCartsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAbandonedCartsResponse)
+	err := c.cc.Invoke(ctx, OrderService_GetAbandonedCarts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orderServiceClient) GenerateOrderReceipt(ctx context.Context, in *GenerateOrderReceiptRequest, opts ...grpc.CallOption) (*GenerateOrderReceiptResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GenerateOrderReceiptResponse)
@@ -195,8 +243,12 @@ type OrderServiceServer interface {
 	GetOrder(context.Context, *GetOrderRequest) (*order.OrderProto, error)
 	ListUserOrders(context.Context, *ListUserOrdersRequest) (*ListUserOrdersResponse, error)
 	CancelOrder(context.Context, *CancelOrderRequest) (*order.OrderProto, error)
+	Reorder(context.Context, *ReorderRequest) (*ReorderResponse, error)
+	VerifyPurchase(context.Context, *VerifyPurchaseRequest) (*VerifyPurchaseResponse, error)
 	UpdateOrderStatus(context.Context, *UpdateOrderStatusRequest) (*order.OrderProto, error)
+	RefundOrder(context.Context, *RefundOrderRequest) (*order.OrderProto, error)
 	ListAllOrders(context.Context, *ListAllOrdersAdminRequest) (*ListAllOrdersAdminResponse, error)
+	GetAbandonedCarts(context.Context, *GetAbandonedCartsRequest) (*GetAbandonedCartsResponse, error)
 	GenerateOrderReceipt(context.Context, *GenerateOrderReceiptRequest) (*GenerateOrderReceiptResponse, error)
 	mustEmbedUnimplementedOrderServiceServer()
 }
@@ -235,12 +287,24 @@ func (UnimplementedOrderServiceServer) ListUserOrders(context.Context, *ListUser
 func (UnimplementedOrderServiceServer) CancelOrder(context.Context, *CancelOrderRequest) (*order.OrderProto, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
 }
+func (UnimplementedOrderServiceServer) Reorder(context.Context, *ReorderRequest) (*ReorderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reorder not implemented")
+}
+func (UnimplementedOrderServiceServer) VerifyPurchase(context.Context, *VerifyPurchaseRequest) (*VerifyPurchaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyPurchase not implemented")
+}
 func (UnimplementedOrderServiceServer) UpdateOrderStatus(context.Context, *UpdateOrderStatusRequest) (*order.OrderProto, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrderStatus not implemented")
 }
+func (UnimplementedOrderServiceServer) RefundOrder(context.Context, *RefundOrderRequest) (*order.OrderProto, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundOrder not implemented")
+}
 func (UnimplementedOrderServiceServer) ListAllOrders(context.Context, *ListAllOrdersAdminRequest) (*ListAllOrdersAdminResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAllOrders not implemented")
 }
+func (UnimplementedOrderServiceServer) GetAbandonedCarts(context.Context, *GetAbandonedCartsRequest) (*GetAbandonedCartsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAbandonedCarts not implemented")
+}
 func (UnimplementedOrderServiceServer) GenerateOrderReceipt(context.Context, *GenerateOrderReceiptRequest) (*GenerateOrderReceiptResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateOrderReceipt not implemented")
 }
@@ -427,6 +491,42 @@ func _OrderService_CancelOrder_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_Reorder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).Reorder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_Reorder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).Reorder(ctx, req.(*ReorderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_VerifyPurchase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyPurchaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).VerifyPurchase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_VerifyPurchase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).VerifyPurchase(ctx, req.(*VerifyPurchaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OrderService_UpdateOrderStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateOrderStatusRequest)
 	if err := dec(in); err != nil {
@@ -445,6 +545,24 @@ func _OrderService_UpdateOrderStatus_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_RefundOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).RefundOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_RefundOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).RefundOrder(ctx, req.(*RefundOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OrderService_ListAllOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListAllOrdersAdminRequest)
 	if err := dec(in); err != nil {
@@ -463,6 +581,24 @@ func _OrderService_ListAllOrders_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_GetAbandonedCarts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAbandonedCartsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetAbandonedCarts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetAbandonedCarts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetAbandonedCarts(ctx, req.(*GetAbandonedCartsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OrderService_GenerateOrderReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GenerateOrderReceiptRequest)
 	if err := dec(in); err != nil {
@@ -524,14 +660,30 @@ var OrderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelOrder",
 			Handler:    _OrderService_CancelOrder_Handler,
 		},
+		{
+			MethodName: "Reorder",
+			Handler:    _OrderService_Reorder_Handler,
+		},
+		{
+			MethodName: "VerifyPurchase",
+			Handler:    _OrderService_VerifyPurchase_Handler,
+		},
 		{
 			MethodName: "UpdateOrderStatus",
 			Handler:    _OrderService_UpdateOrderStatus_Handler,
 		},
+		{
+			MethodName: "RefundOrder",
+			Handler:    _OrderService_RefundOrder_Handler,
+		},
 		{
 			MethodName: "ListAllOrders",
 			Handler:    _OrderService_ListAllOrders_Handler,
 		},
+		{
+			MethodName: "GetAbandonedCarts",
+			Handler:    _OrderService_GetAbandonedCarts_Handler,
+		},
 		{
 			MethodName: "GenerateOrderReceipt",
 			Handler:    _OrderService_GenerateOrderReceipt_Handler,
@@ -0,0 +1,69 @@
+// Package grpcrecovery turns a panic in a gRPC handler into a codes.Internal error instead of
+// crashing the process. The panic value and stack trace are logged with the method, trace
+// ID, request ID and peer.
+package grpcrecovery
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+
+	"github.com/Abdurahmanit/GroupProject/platform/logkv"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// PanicHook is called with the full method name after a handler panic has been recovered,
+// e.g. to increment a metric. It may be nil.
+type PanicHook func(method string)
+
+func UnaryServerInterceptor(logger logkv.Logger, onPanic PanicHook) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		defer func() {
+			if p := recover(); p != nil {
+				err = handlePanic(ctx, logger, onPanic, info.FullMethod, p)
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+func StreamServerInterceptor(logger logkv.Logger, onPanic PanicHook) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if p := recover(); p != nil {
+				err = handlePanic(ss.Context(), logger, onPanic, info.FullMethod, p)
+			}
+		}()
+		return handler(srv, ss)
+	}
+}
+
+func handlePanic(ctx context.Context, logger logkv.Logger, onPanic PanicHook, method string, p interface{}) error {
+	keysAndValues := []interface{}{
+		"method", method,
+		"panic", fmt.Sprint(p),
+		"stack", string(debug.Stack()),
+	}
+	if sc := trace.SpanFromContext(ctx).SpanContext(); sc.IsValid() {
+		keysAndValues = append(keysAndValues, "trace_id", sc.TraceID().String())
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ids := md.Get("x-request-id"); len(ids) > 0 {
+			keysAndValues = append(keysAndValues, "request_id", ids[0])
+		}
+	}
+	if pr, ok := peer.FromContext(ctx); ok && pr.Addr != nil {
+		keysAndValues = append(keysAndValues, "peer", pr.Addr.String())
+	}
+	logger.Error("Recovered from panic in gRPC handler", keysAndValues...)
+
+	if onPanic != nil {
+		onPanic(method)
+	}
+	return status.Error(codes.Internal, "internal server error")
+}
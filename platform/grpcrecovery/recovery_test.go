@@ -0,0 +1,52 @@
+package grpcrecovery
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Abdurahmanit/GroupProject/platform/logkv"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeServerStream struct {
+	grpc.ServerStream
+}
+
+func (fakeServerStream) Context() context.Context { return context.Background() }
+
+func TestInterceptors_RecoverPanics(t *testing.T) {
+	var recovered []string
+	onPanic := func(method string) { recovered = append(recovered, method) }
+	logger := logkv.FromZap(zap.NewNop())
+
+	unary := UnaryServerInterceptor(logger, onPanic)
+	_, err := unary(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/svc/Unary"},
+		func(context.Context, interface{}) (interface{}, error) { panic("boom") })
+	if status.Code(err) != codes.Internal {
+		t.Errorf("unary error = %v, want Internal", err)
+	}
+
+	stream := StreamServerInterceptor(logger, onPanic)
+	err = stream(nil, fakeServerStream{}, &grpc.StreamServerInfo{FullMethod: "/svc/Stream"},
+		func(interface{}, grpc.ServerStream) error { panic("boom") })
+	if status.Code(err) != codes.Internal {
+		t.Errorf("stream error = %v, want Internal", err)
+	}
+
+	if len(recovered) != 2 || recovered[0] != "/svc/Unary" || recovered[1] != "/svc/Stream" {
+		t.Errorf("hook called with %v, want both methods", recovered)
+	}
+}
+
+func TestUnaryServerInterceptor_PassesThrough(t *testing.T) {
+	unary := UnaryServerInterceptor(logkv.FromZap(zap.NewNop()), nil)
+	want := status.Error(codes.NotFound, "missing")
+	resp, err := unary(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/svc/Unary"},
+		func(context.Context, interface{}) (interface{}, error) { return "ok", want })
+	if resp != "ok" || err != want {
+		t.Errorf("got (%v, %v), want the handler's result", resp, err)
+	}
+}
@@ -0,0 +1,123 @@
+// Package health serves the standard gRPC health service (grpc.health.v1.Health) and
+// drives its status from dependency checks: SERVING only while every check passes,
+// NOT_SERVING when a dependency is lost and for good once Shutdown is called.
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Abdurahmanit/GroupProject/platform/logkv"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// DefaultInterval is how often Run re-checks the dependencies.
+const DefaultInterval = 10 * time.Second
+
+const checkTimeout = 3 * time.Second
+
+// Check probes a single dependency, e.g. a MongoDB or Redis ping.
+type Check func(ctx context.Context) error
+
+type namedCheck struct {
+	name  string
+	check Check
+}
+
+type Manager struct {
+	server   *health.Server
+	services []string
+	logger   logkv.Logger
+
+	mu       sync.Mutex
+	checks   []namedCheck
+	status   grpc_health_v1.HealthCheckResponse_ServingStatus
+	shutdown bool
+}
+
+// NewManager reports NOT_SERVING for the whole server ("") and each of services until
+// the first successful round of checks.
+func NewManager(logger logkv.Logger, services ...string) *Manager {
+	m := &Manager{
+		server:   health.NewServer(),
+		services: append([]string{""}, services...),
+		logger:   logger,
+	}
+	m.setStatus(grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+	return m
+}
+
+// AddCheck registers a dependency check under a name used in logs.
+func (m *Manager) AddCheck(name string, check Check) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.checks = append(m.checks, namedCheck{name: name, check: check})
+}
+
+// Register adds the health service to s.
+func (m *Manager) Register(s *grpc.Server) {
+	grpc_health_v1.RegisterHealthServer(s, m.server)
+}
+
+// Run checks the dependencies right away and then every interval until ctx is done.
+func (m *Manager) Run(ctx context.Context, interval time.Duration) {
+	m.CheckNow(ctx)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.CheckNow(ctx)
+		}
+	}
+}
+
+// CheckNow runs every check once, updates the serving status and reports whether all passed.
+func (m *Manager) CheckNow(ctx context.Context) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.shutdown {
+		return false
+	}
+
+	for _, c := range m.checks {
+		checkCtx, cancel := context.WithTimeout(ctx, checkTimeout)
+		err := c.check(checkCtx)
+		cancel()
+		if err != nil {
+			if m.status != grpc_health_v1.HealthCheckResponse_NOT_SERVING {
+				m.logger.Warn("gRPC health status set to NOT_SERVING", "dependency", c.name, "error", err)
+			}
+			m.setStatus(grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+			return false
+		}
+	}
+	if m.status != grpc_health_v1.HealthCheckResponse_SERVING {
+		m.logger.Info("gRPC health status set to SERVING")
+	}
+	m.setStatus(grpc_health_v1.HealthCheckResponse_SERVING)
+	return true
+}
+
+// Shutdown switches to NOT_SERVING permanently so that load balancers stop sending
+// new requests before the gRPC server is stopped.
+func (m *Manager) Shutdown() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.shutdown = true
+	m.status = grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	m.server.Shutdown()
+	m.logger.Info("gRPC health status set to NOT_SERVING")
+}
+
+func (m *Manager) setStatus(status grpc_health_v1.HealthCheckResponse_ServingStatus) {
+	m.status = status
+	for _, service := range m.services {
+		m.server.SetServingStatus(service, status)
+	}
+}
@@ -0,0 +1,59 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Abdurahmanit/GroupProject/platform/logkv"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func servingStatus(t *testing.T, m *Manager, service string) grpc_health_v1.HealthCheckResponse_ServingStatus {
+	t.Helper()
+	resp, err := m.server.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: service})
+	if err != nil {
+		t.Fatalf("Check(%q) failed: %v", service, err)
+	}
+	return resp.Status
+}
+
+func TestManager_StatusFollowsChecks(t *testing.T) {
+	m := NewManager(logkv.FromZap(zap.NewNop()), "user.UserService")
+	if got := servingStatus(t, m, "user.UserService"); got != grpc_health_v1.HealthCheckResponse_NOT_SERVING {
+		t.Fatalf("initial status = %v, want NOT_SERVING", got)
+	}
+
+	var redisErr error
+	m.AddCheck("redis", func(context.Context) error { return redisErr })
+
+	if !m.CheckNow(context.Background()) {
+		t.Fatal("expected checks to pass")
+	}
+	for _, service := range []string{"", "user.UserService"} {
+		if got := servingStatus(t, m, service); got != grpc_health_v1.HealthCheckResponse_SERVING {
+			t.Errorf("status of %q = %v, want SERVING", service, got)
+		}
+	}
+
+	redisErr = errors.New("connection refused")
+	if m.CheckNow(context.Background()) {
+		t.Fatal("expected checks to fail")
+	}
+	if got := servingStatus(t, m, "user.UserService"); got != grpc_health_v1.HealthCheckResponse_NOT_SERVING {
+		t.Errorf("status after dependency loss = %v, want NOT_SERVING", got)
+	}
+}
+
+func TestManager_ShutdownIsFinal(t *testing.T) {
+	m := NewManager(logkv.FromZap(zap.NewNop()), "user.UserService")
+	m.CheckNow(context.Background())
+	m.Shutdown()
+	if m.CheckNow(context.Background()) {
+		t.Fatal("CheckNow must not report healthy after Shutdown")
+	}
+	if got := servingStatus(t, m, "user.UserService"); got != grpc_health_v1.HealthCheckResponse_NOT_SERVING {
+		t.Errorf("status after Shutdown = %v, want NOT_SERVING", got)
+	}
+}
@@ -0,0 +1,33 @@
+// Package logkv is the logger the platform packages write to: a message followed by
+// alternating keys and values. listing-service's logger implements it as is; services
+// logging with zap wrap their logger with FromZap.
+package logkv
+
+import "go.uber.org/zap"
+
+type Logger interface {
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+}
+
+// FromZap adapts a *zap.Logger to Logger.
+func FromZap(logger *zap.Logger) Logger {
+	return zapLogger{sugar: logger.Sugar()}
+}
+
+type zapLogger struct {
+	sugar *zap.SugaredLogger
+}
+
+func (l zapLogger) Info(msg string, keysAndValues ...interface{}) {
+	l.sugar.Infow(msg, keysAndValues...)
+}
+
+func (l zapLogger) Warn(msg string, keysAndValues ...interface{}) {
+	l.sugar.Warnw(msg, keysAndValues...)
+}
+
+func (l zapLogger) Error(msg string, keysAndValues ...interface{}) {
+	l.sugar.Errorw(msg, keysAndValues...)
+}
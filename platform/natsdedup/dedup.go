@@ -0,0 +1,124 @@
+// Package natsdedup turns NATS at-least-once delivery into effectively-once handling.
+// Publishers give each event a stable ID with NewMsg; consumers wrap their handlers with
+// Deduplicator.Wrap, which skips IDs they have already processed.
+package natsdedup
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/Abdurahmanit/GroupProject/platform/logkv"
+	"github.com/nats-io/nats.go"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	keyPrefix    = "nats:processed:"
+	storeTimeout = 2 * time.Second
+)
+
+// Store remembers which messages a consumer has already handled.
+type Store interface {
+	// Claim marks key as processed for ttl. It returns false if the key was already claimed.
+	Claim(ctx context.Context, key string, ttl time.Duration) (bool, error)
+	// Release forgets key so that a redelivery of the message is handled again.
+	Release(ctx context.Context, key string) error
+}
+
+// Deduplicator skips messages that a consumer has already processed. A nil *Deduplicator
+// is valid and disables deduplication.
+type Deduplicator struct {
+	store  Store
+	ttl    time.Duration
+	logger logkv.Logger
+}
+
+// New returns a Deduplicator that remembers message IDs for ttl. The ttl must be longer
+// than the longest window in which a message can be redelivered or republished.
+func New(store Store, ttl time.Duration, logger logkv.Logger) *Deduplicator {
+	return &Deduplicator{store: store, ttl: ttl, logger: logger}
+}
+
+// NewMsg builds a message carrying id in the Nats-Msg-Id header. The id must identify the
+// event rather than the publish attempt, e.g. "order.created:<order ID>", so that a retried
+// publish is recognised as a duplicate.
+func NewMsg(subject, id string, data []byte) *nats.Msg {
+	msg := nats.NewMsg(subject)
+	msg.Header.Set(nats.MsgIdHdr, id)
+	msg.Data = data
+	return msg
+}
+
+// MessageID returns the publisher-assigned Nats-Msg-Id header, or a hash of the subject and
+// payload when the header is absent, so identical redeliveries map to the same ID.
+func MessageID(msg *nats.Msg) string {
+	if id := msg.Header.Get(nats.MsgIdHdr); id != "" {
+		return id
+	}
+	sum := sha256.Sum256(append([]byte(msg.Subject+"\x00"), msg.Data...))
+	return hex.EncodeToString(sum[:])
+}
+
+// Wrap returns a NATS handler that runs handle at most once per message ID for the given
+// consumer. If handle fails the message is released so a redelivery is processed again.
+// When the store is unavailable the message is handled anyway: a duplicate is preferred
+// over a lost event.
+func (d *Deduplicator) Wrap(consumer string, handle func(msg *nats.Msg) error) nats.MsgHandler {
+	if d == nil {
+		return func(msg *nats.Msg) {
+			_ = handle(msg)
+		}
+	}
+	return func(msg *nats.Msg) {
+		key := keyPrefix + consumer + ":" + MessageID(msg)
+
+		ctx, cancel := context.WithTimeout(context.Background(), storeTimeout)
+		claimed, err := d.store.Claim(ctx, key, d.ttl)
+		cancel()
+		if err != nil {
+			d.logger.Warn("Deduplication store unavailable, processing message without deduplication",
+				"subject", msg.Subject, "consumer", consumer, "error", err)
+			_ = handle(msg)
+			return
+		}
+		if !claimed {
+			d.logger.Info("Skipping already processed NATS message", "subject", msg.Subject, "consumer", consumer, "key", key)
+			return
+		}
+
+		if err := handle(msg); err != nil {
+			ctx, cancel := context.WithTimeout(context.Background(), storeTimeout)
+			defer cancel()
+			if errRelease := d.store.Release(ctx, key); errRelease != nil {
+				d.logger.Error("Failed to release processed NATS message", "subject", msg.Subject, "key", key, "error", errRelease)
+			}
+		}
+	}
+}
+
+// RedisStore is a Store on a go-redis v9 client.
+type RedisStore struct {
+	client redis.Cmdable
+}
+
+func NewRedisStore(client redis.Cmdable) *RedisStore {
+	return &RedisStore{client: client}
+}
+
+func (s *RedisStore) Claim(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	ok, err := s.client.SetNX(ctx, key, 1, ttl).Result()
+	if err != nil {
+		return false, fmt.Errorf("claim %s: %w", key, err)
+	}
+	return ok, nil
+}
+
+func (s *RedisStore) Release(ctx context.Context, key string) error {
+	if err := s.client.Del(ctx, key).Err(); err != nil {
+		return fmt.Errorf("release %s: %w", key, err)
+	}
+	return nil
+}
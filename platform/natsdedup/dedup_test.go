@@ -0,0 +1,99 @@
+package natsdedup
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Abdurahmanit/GroupProject/platform/logkv"
+	"github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+)
+
+type fakeStore struct {
+	claimed map[string]bool
+	err     error
+}
+
+func (s *fakeStore) Claim(_ context.Context, key string, _ time.Duration) (bool, error) {
+	if s.err != nil {
+		return false, s.err
+	}
+	if s.claimed[key] {
+		return false, nil
+	}
+	s.claimed[key] = true
+	return true, nil
+}
+
+func (s *fakeStore) Release(_ context.Context, key string) error {
+	delete(s.claimed, key)
+	return nil
+}
+
+func TestDeduplicator_Wrap(t *testing.T) {
+	store := &fakeStore{claimed: map[string]bool{}}
+	d := New(store, time.Hour, logkv.FromZap(zap.NewNop()))
+
+	handled := 0
+	var failWith error
+	handler := d.Wrap("consumer", func(*nats.Msg) error {
+		handled++
+		return failWith
+	})
+
+	msg := NewMsg("order.created", "order.created:o1", []byte(`{"id":"o1"}`))
+	handler(msg)
+	handler(msg)
+	if handled != 1 {
+		t.Fatalf("handled %d times, want the duplicate skipped", handled)
+	}
+
+	// Another consumer keeps its own record.
+	d.Wrap("other", func(*nats.Msg) error { handled++; return nil })(msg)
+	if handled != 2 {
+		t.Fatalf("handled %d times, want the other consumer to see the message", handled)
+	}
+
+	// A failed message is released so that a redelivery is processed again.
+	failWith = errors.New("smtp down")
+	retry := NewMsg("order.created", "order.created:o2", nil)
+	handler(retry)
+	failWith = nil
+	handler(retry)
+	if handled != 4 {
+		t.Fatalf("handled %d times, want the failed message retried", handled)
+	}
+
+	// Without a store every message is handled.
+	store.err = errors.New("redis down")
+	handler(msg)
+	if handled != 5 {
+		t.Fatalf("handled %d times, want the message handled while the store is down", handled)
+	}
+}
+
+func TestDeduplicator_NilHandlesEverything(t *testing.T) {
+	var d *Deduplicator
+	handled := 0
+	handler := d.Wrap("consumer", func(*nats.Msg) error { handled++; return nil })
+	msg := NewMsg("user.deleted", "user.deleted:u1", nil)
+	handler(msg)
+	handler(msg)
+	if handled != 2 {
+		t.Errorf("handled %d times, want 2", handled)
+	}
+}
+
+func TestMessageID(t *testing.T) {
+	if got := MessageID(NewMsg("order.created", "order.created:o1", nil)); got != "order.created:o1" {
+		t.Errorf("MessageID = %q, want the Nats-Msg-Id header", got)
+	}
+	a := &nats.Msg{Subject: "order.created", Data: []byte("x")}
+	b := &nats.Msg{Subject: "order.created", Data: []byte("x")}
+	c := &nats.Msg{Subject: "order.created", Data: []byte("y")}
+	if MessageID(a) != MessageID(b) || MessageID(a) == MessageID(c) {
+		t.Error("messages without a header should be identified by subject and payload")
+	}
+}
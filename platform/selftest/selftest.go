@@ -0,0 +1,152 @@
+// Package selftest exercises each dependency with a real operation at startup. Connecting
+// and pinging succeed even with a wrong database name or read-only credentials; a canary
+// write and read does not, so such misconfigurations stop the service at boot.
+package selftest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Abdurahmanit/GroupProject/platform/logkv"
+	"github.com/nats-io/nats.go"
+	"github.com/redis/go-redis/v9"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// CanaryCollection holds the documents written by MongoRoundTrip until they are read back.
+const CanaryCollection = "selftest_canaries"
+
+const stepTimeout = 5 * time.Second
+
+// Step exercises one dependency and returns an error if it is unusable.
+type Step func(ctx context.Context) error
+
+type namedStep struct {
+	name string
+	step Step
+}
+
+// Runner collects steps while dependencies are set up and runs them all at once.
+type Runner struct {
+	logger logkv.Logger
+	steps  []namedStep
+}
+
+func NewRunner(logger logkv.Logger) *Runner {
+	return &Runner{logger: logger}
+}
+
+func (r *Runner) Add(name string, step Step) {
+	r.steps = append(r.steps, namedStep{name: name, step: step})
+}
+
+// Run executes every step, even after one fails, and returns the joined errors.
+func (r *Runner) Run(ctx context.Context) error {
+	var errs []error
+	for _, s := range r.steps {
+		stepCtx, cancel := context.WithTimeout(ctx, stepTimeout)
+		start := time.Now()
+		err := s.step(stepCtx)
+		cancel()
+		if err != nil {
+			r.logger.Error("Startup self-test step failed", "step", s.name, "duration", time.Since(start).String(), "error", err)
+			errs = append(errs, fmt.Errorf("%s: %w", s.name, err))
+			continue
+		}
+		r.logger.Info("Startup self-test step passed", "step", s.name, "duration", time.Since(start).String())
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	r.logger.Info("Startup self-test passed", "steps", len(r.steps))
+	return nil
+}
+
+// MongoRoundTrip inserts a canary document into db, reads it back and deletes it.
+func MongoRoundTrip(db *mongo.Database) Step {
+	return func(ctx context.Context) error {
+		coll := db.Collection(CanaryCollection)
+		id := primitive.NewObjectID()
+		if _, err := coll.InsertOne(ctx, bson.M{"_id": id, "created_at": time.Now().UTC()}); err != nil {
+			return fmt.Errorf("insert canary into %s.%s: %w", db.Name(), CanaryCollection, err)
+		}
+		if err := coll.FindOne(ctx, bson.M{"_id": id}).Err(); err != nil {
+			return fmt.Errorf("read canary back: %w", err)
+		}
+		if _, err := coll.DeleteOne(ctx, bson.M{"_id": id}); err != nil {
+			return fmt.Errorf("delete canary: %w", err)
+		}
+		return nil
+	}
+}
+
+// KeyValueStore is what KeyValueRoundTrip needs from a Redis client. RedisRoundTrip covers
+// go-redis v9; a service on another client version implements these three methods itself.
+type KeyValueStore interface {
+	Set(ctx context.Context, key, value string, ttl time.Duration) error
+	Get(ctx context.Context, key string) (string, error)
+	Del(ctx context.Context, key string) error
+}
+
+// KeyValueRoundTrip writes a canary key, reads it back and deletes it.
+func KeyValueRoundTrip(store KeyValueStore) Step {
+	return func(ctx context.Context) error {
+		key := "selftest:" + primitive.NewObjectID().Hex()
+		if err := store.Set(ctx, key, "ok", time.Minute); err != nil {
+			return fmt.Errorf("set canary key: %w", err)
+		}
+		got, err := store.Get(ctx, key)
+		if err != nil {
+			return fmt.Errorf("get canary key: %w", err)
+		}
+		if got != "ok" {
+			return fmt.Errorf("canary key read back %q", got)
+		}
+		if err := store.Del(ctx, key); err != nil {
+			return fmt.Errorf("delete canary key: %w", err)
+		}
+		return nil
+	}
+}
+
+// RedisRoundTrip is KeyValueRoundTrip for a go-redis v9 client.
+func RedisRoundTrip(client redis.Cmdable) Step {
+	return KeyValueRoundTrip(redisStore{client: client})
+}
+
+type redisStore struct {
+	client redis.Cmdable
+}
+
+func (s redisStore) Set(ctx context.Context, key, value string, ttl time.Duration) error {
+	return s.client.Set(ctx, key, value, ttl).Err()
+}
+
+func (s redisStore) Get(ctx context.Context, key string) (string, error) {
+	return s.client.Get(ctx, key).Result()
+}
+
+func (s redisStore) Del(ctx context.Context, key string) error {
+	return s.client.Del(ctx, key).Err()
+}
+
+// NATSPublish publishes to subject and flushes so that a rejected publish is reported
+// here instead of through the asynchronous error handler.
+func NATSPublish(conn *nats.Conn, subject string) Step {
+	return func(ctx context.Context) error {
+		if err := conn.Publish(subject, []byte(`{"probe":true}`)); err != nil {
+			return fmt.Errorf("publish to %s: %w", subject, err)
+		}
+		if err := conn.FlushWithContext(ctx); err != nil {
+			return fmt.Errorf("flush after publishing to %s: %w", subject, err)
+		}
+		if err := conn.LastError(); err != nil {
+			return fmt.Errorf("server rejected publish to %s: %w", subject, err)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,74 @@
+package selftest
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Abdurahmanit/GroupProject/platform/logkv"
+	"go.uber.org/zap"
+)
+
+func TestRunner_RunsEveryStep(t *testing.T) {
+	r := NewRunner(logkv.FromZap(zap.NewNop()))
+	var ran []string
+	r.Add("mongodb", func(context.Context) error { ran = append(ran, "mongodb"); return errors.New("not authorized") })
+	r.Add("redis", func(context.Context) error { ran = append(ran, "redis"); return nil })
+	r.Add("nats", func(context.Context) error { ran = append(ran, "nats"); return errors.New("permissions violation") })
+
+	err := r.Run(context.Background())
+	if err == nil {
+		t.Fatal("Run() = nil, want the failed steps")
+	}
+	if len(ran) != 3 {
+		t.Errorf("ran %v, want every step even after a failure", ran)
+	}
+	for _, want := range []string{"mongodb: not authorized", "nats: permissions violation"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Run() = %q, want it to mention %q", err, want)
+		}
+	}
+
+	if err := NewRunner(logkv.FromZap(zap.NewNop())).Run(context.Background()); err != nil {
+		t.Errorf("Run() without steps = %v, want nil", err)
+	}
+}
+
+type fakeStore struct {
+	values  map[string]string
+	corrupt bool
+}
+
+func (s *fakeStore) Set(_ context.Context, key, value string, _ time.Duration) error {
+	if s.corrupt {
+		value = "stale"
+	}
+	s.values[key] = value
+	return nil
+}
+
+func (s *fakeStore) Get(_ context.Context, key string) (string, error) {
+	return s.values[key], nil
+}
+
+func (s *fakeStore) Del(_ context.Context, key string) error {
+	delete(s.values, key)
+	return nil
+}
+
+func TestKeyValueRoundTrip(t *testing.T) {
+	store := &fakeStore{values: map[string]string{}}
+	if err := KeyValueRoundTrip(store)(context.Background()); err != nil {
+		t.Fatalf("round trip failed: %v", err)
+	}
+	if len(store.values) != 0 {
+		t.Errorf("canary key left behind: %v", store.values)
+	}
+
+	store.corrupt = true
+	if err := KeyValueRoundTrip(store)(context.Background()); err == nil {
+		t.Error("round trip passed although the value read back differs")
+	}
+}
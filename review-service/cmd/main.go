@@ -8,22 +8,34 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
 	grpcAdapter "github.com/Abdurahmanit/GroupProject/review-service/internal/adapter/grpc"
+	"github.com/Abdurahmanit/GroupProject/review-service/internal/adapter/listingclient"
 	natsAdapter "github.com/Abdurahmanit/GroupProject/review-service/internal/adapter/messaging/nats"
+	"github.com/Abdurahmanit/GroupProject/review-service/internal/adapter/orderclient"
 	mongoRepo "github.com/Abdurahmanit/GroupProject/review-service/internal/adapter/repository/mongodb"
+	"github.com/Abdurahmanit/GroupProject/review-service/internal/adapter/translator"
+	"github.com/Abdurahmanit/GroupProject/review-service/internal/adapter/userclient"
 
 	"github.com/Abdurahmanit/GroupProject/review-service/internal/config"
+	"github.com/Abdurahmanit/GroupProject/review-service/internal/domain"
+	"github.com/Abdurahmanit/GroupProject/review-service/internal/platform/cache"
 	"github.com/Abdurahmanit/GroupProject/review-service/internal/platform/logger"
 	"github.com/Abdurahmanit/GroupProject/review-service/internal/platform/metrics"
 	"github.com/Abdurahmanit/GroupProject/review-service/internal/platform/tracer"
 	"github.com/Abdurahmanit/GroupProject/review-service/internal/usecase"
 
+	"github.com/Abdurahmanit/GroupProject/platform/grpcrecovery"
+	"github.com/Abdurahmanit/GroupProject/platform/health"
+	"github.com/Abdurahmanit/GroupProject/platform/logkv"
+	"github.com/Abdurahmanit/GroupProject/platform/selftest"
 	pb "github.com/Abdurahmanit/GroupProject/review-service"
 
 	"github.com/joho/godotenv"
+	"github.com/redis/go-redis/v9"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
@@ -105,6 +117,36 @@ func main() {
 	defer natsPublisher.Close()
 	appLogger.Info("NATS Publisher initialized.")
 
+	if cfg.StartupSelfTest {
+		selfTest := selftest.NewRunner(logkv.FromZap(appLogger.Logger))
+		selfTest.Add("mongodb", selftest.MongoRoundTrip(db))
+		selfTest.Add("nats", func(ctx context.Context) error { return natsPublisher.PublishProbe(ctx, "review.selftest") })
+		if err := selfTest.Run(context.Background()); err != nil {
+			appLogger.Fatal("Startup self-test failed", zap.Error(err))
+		}
+	}
+
+	var redisClient *redis.Client
+	if cfg.RedisAddr != "" {
+		redisClient = redis.NewClient(&redis.Options{Addr: cfg.RedisAddr, Password: cfg.RedisPassword, DB: cfg.RedisDB})
+		ctxPingRedis, cancelPingRedis := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancelPingRedis()
+		if err := redisClient.Ping(ctxPingRedis).Err(); err != nil {
+			appLogger.Fatal("Failed to ping Redis", zap.String("redis_addr", cfg.RedisAddr), zap.Error(err))
+		}
+		defer redisClient.Close()
+		appLogger.Info("Successfully connected to Redis.", zap.String("redis_addr", cfg.RedisAddr))
+	}
+
+	// Metrics are created up front so the recovery interceptor can count panics and the
+	// rating cache can count its hits and misses.
+	var metricsManager *metrics.MetricsManager
+	var onPanic grpcrecovery.PanicHook
+	if cfg.PrometheusMetricsPort != "" {
+		metricsManager = metrics.NewMetricsManager(serviceName)
+		onPanic = func(method string) { metricsManager.PanicsRecoveredTotal.WithLabelValues(method).Inc() }
+	}
+
 	// 6. Initialize Repositories
 	reviewRepo, err := mongoRepo.NewReviewRepository(db, appLogger)
 	if err != nil {
@@ -113,7 +155,71 @@ func main() {
 	appLogger.Info("ReviewRepository initialized.")
 
 	// 7. Initialize Usecases
-	reviewUsecase := usecase.NewReviewUsecase(reviewRepo, natsPublisher, appLogger) // Pass NATS publisher
+	autoApprove := usecase.AutoApprovePolicy{
+		Enabled:            cfg.AutoApproveReviews,
+		MinApprovedReviews: cfg.AutoApproveMinApprovedReviews,
+	}
+	for _, id := range strings.Split(cfg.AutoApproveTrustedUserIDs, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			autoApprove.TrustedUserIDs = append(autoApprove.TrustedUserIDs, id)
+		}
+	}
+	accountAge := usecase.AccountAgeRule{MinAge: cfg.ReviewMinAccountAge}
+	if cfg.ReviewMinAccountAge > 0 {
+		userClient, err := userclient.NewClient(cfg.UserServiceAddress, appLogger)
+		if err != nil {
+			appLogger.Fatal("Failed to initialize user service client", zap.Error(err))
+		}
+		defer userClient.Close()
+		accountAge.Users = userClient
+		appLogger.Info("Minimum account age for reviews enabled", zap.Duration("min_age", cfg.ReviewMinAccountAge), zap.String("user_service_address", cfg.UserServiceAddress))
+	}
+	// Product owners are needed both for the self-review guard and to let sellers reply to
+	// reviews of their products
+	var products domain.ProductDirectory
+	if cfg.ListingServiceAddress != "" {
+		listingClient, err := listingclient.NewClient(cfg.ListingServiceAddress, appLogger)
+		if err != nil {
+			appLogger.Fatal("Failed to initialize listing service client", zap.Error(err))
+		}
+		defer listingClient.Close()
+		products = listingClient
+	} else {
+		appLogger.Warn("LISTING_SERVICE_ADDRESS is not set, only admins can reply to product reviews")
+	}
+	selfReview := usecase.SelfReviewRule{Enabled: cfg.BlockSelfReview}
+	if cfg.BlockSelfReview {
+		selfReview.Products = products
+		appLogger.Info("Self-review guard enabled", zap.String("listing_service_address", cfg.ListingServiceAddress))
+	}
+	purchase := usecase.VerifiedPurchaseRule{Required: cfg.RequireVerifiedPurchase}
+	if cfg.RequireVerifiedPurchase {
+		orderClient, err := orderclient.NewClient(cfg.OrderServiceAddress, appLogger)
+		if err != nil {
+			appLogger.Fatal("Failed to initialize order service client", zap.Error(err))
+		}
+		defer orderClient.Close()
+		purchase.Orders = orderClient
+		appLogger.Info("Verified purchase requirement enabled", zap.String("order_service_address", cfg.OrderServiceAddress))
+	}
+	commentRule := usecase.CommentLengthRule{MaxRating: cfg.LowRatingThreshold, MinLength: cfg.LowRatingMinCommentLength}
+	var ratingCache domain.RatingCache
+	if redisClient != nil {
+		ratingCache = cache.NewRatingCache(redisClient, cfg.RatingCacheTTL, appLogger, metricsManager)
+		appLogger.Info("Average rating cache enabled", zap.Duration("ttl", cfg.RatingCacheTTL))
+	}
+	// Only the no-op translator exists for now; providers plug in here, selected by REVIEW_TRANSLATOR
+	reviewUsecase := usecase.NewReviewUsecase(reviewRepo, natsPublisher, usecase.ReviewUsecaseOptions{
+		AutoApprove:   autoApprove,
+		AccountAge:    accountAge,
+		SelfReview:    selfReview,
+		Purchase:      purchase,
+		CommentRule:   commentRule,
+		FlagThreshold: cfg.ReviewFlagThreshold,
+		Translator:    translator.Noop{},
+		RatingCache:   ratingCache,
+		Products:      products,
+	}, appLogger)
 	appLogger.Info("ReviewUsecase initialized.")
 
 	// 8. Initialize gRPC Handler
@@ -127,9 +233,20 @@ func main() {
 	}
 
 	// Create gRPC server with interceptors
-	grpcSrv := grpcAdapter.NewGRPCServer(appLogger, cfg.JWTSecret, tp) // This now returns *grpc.Server
+	grpcSrv := grpcAdapter.NewGRPCServer(appLogger, cfg.JWTSecret, tp, onPanic) // This now returns *grpc.Server
 	pb.RegisterReviewServiceServer(grpcSrv, reviewGRPCHandler)
 
+	healthManager := health.NewManager(logkv.FromZap(appLogger.Logger), pb.ReviewService_ServiceDesc.ServiceName)
+	healthManager.AddCheck("mongodb", func(ctx context.Context) error { return mongoClient.Ping(ctx, nil) })
+	healthManager.AddCheck("nats", natsPublisher.Ping)
+	if redisClient != nil {
+		healthManager.AddCheck("redis", func(ctx context.Context) error { return redisClient.Ping(ctx).Err() })
+	}
+	healthManager.Register(grpcSrv)
+	healthCtx, stopHealthChecks := context.WithCancel(context.Background())
+	defer stopHealthChecks()
+	go healthManager.Run(healthCtx, health.DefaultInterval)
+
 	go func() {
 		appLogger.Info("Starting gRPC server", zap.String("port", cfg.GRPCPort))
 		if err := grpcSrv.Serve(lis); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
@@ -138,8 +255,7 @@ func main() {
 	}()
 
 	// 10. Start Prometheus Metrics Server
-	if cfg.PrometheusMetricsPort != "" {
-		metricsManager := metrics.NewMetricsManager(serviceName) // Initialize metrics
+	if metricsManager != nil {
 		go func() {
 			appLogger.Info("Starting Prometheus metrics server", zap.String("port", cfg.PrometheusMetricsPort))
 			if err := metrics.StartMetricsServer(cfg.PrometheusMetricsPort, appLogger, metricsManager.Registry); err != nil && !errors.Is(err, http.ErrServerClosed) {
@@ -157,7 +273,8 @@ func main() {
 	sig := <-quit
 	appLogger.Info("Received shutdown signal", zap.String("signal", sig.String()))
 
-	appLogger.Info("gRPC health status set to NOT_SERVING")
+	stopHealthChecks()
+	healthManager.Shutdown()
 
 	// Gracefully stop the gRPC server
 	appLogger.Info("Shutting down gRPC server...")
@@ -1,23 +1,27 @@
 module github.com/Abdurahmanit/GroupProject/review-service
 
-go 1.23.4 // Using your specified Go version
+go 1.24.2 // Using your specified Go version
 
 require (
+	github.com/Abdurahmanit/GroupProject/listing-service v0.0.0-00010101000000-000000000000
+	github.com/Abdurahmanit/GroupProject/order-service v0.0.0-00010101000000-000000000000
+	github.com/Abdurahmanit/GroupProject/platform v0.0.0
+	github.com/Abdurahmanit/GroupProject/user-service v0.0.0-20250529172304-38141d74e416
 	github.com/golang-jwt/jwt/v5 v5.2.2
 	github.com/joho/godotenv v1.5.1
 	github.com/nats-io/nats.go v1.42.0
 	github.com/ory/dockertest/v3 v3.12.0
 	github.com/prometheus/client_golang v1.22.0
-	github.com/spf13/viper v1.20.0-alpha.6
+	github.com/redis/go-redis/v9 v9.9.0
+	github.com/spf13/viper v1.20.1
 	github.com/stretchr/testify v1.10.0
 	go.mongodb.org/mongo-driver v1.17.3
-	go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc v0.48.0
+	go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc v0.54.0
 	go.opentelemetry.io/otel v1.36.0
 	go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc v1.36.0
 	go.opentelemetry.io/otel/sdk v1.36.0
 	go.uber.org/zap v1.27.0
 	google.golang.org/grpc v1.72.2
-
 )
 
 require (
@@ -57,10 +61,10 @@ require (
 	github.com/prometheus/client_model v0.6.1 // indirect
 	github.com/prometheus/common v0.62.0 // indirect
 	github.com/prometheus/procfs v0.15.1 // indirect
-	github.com/sagikazarmark/locafero v0.6.0 // indirect
+	github.com/sagikazarmark/locafero v0.7.0 // indirect
 	github.com/sirupsen/logrus v1.9.3 // indirect
 	github.com/sourcegraph/conc v0.3.0 // indirect
-	github.com/spf13/afero v1.11.0 // indirect
+	github.com/spf13/afero v1.12.0 // indirect
 	github.com/spf13/cast v1.7.1 // indirect
 	github.com/spf13/pflag v1.0.6 // indirect
 	github.com/subosito/gotenv v1.6.0 // indirect
@@ -83,7 +87,8 @@ require (
 )
 
 require (
-	github.com/golang/snappy v0.0.4 // indirect
+	github.com/dgryski/go-rendezvous v0.0.0-20200823014737-9f7001d12a5f // indirect
+	github.com/golang/snappy v1.0.0 // indirect
 	github.com/google/uuid v1.6.0 // indirect
 	github.com/klauspost/compress v1.18.0 // indirect
 	github.com/montanaflynn/stats v0.7.1 // indirect
@@ -95,3 +100,11 @@ require (
 	golang.org/x/sync v0.14.0 // indirect
 	golang.org/x/text v0.25.0 // indirect
 )
+
+replace github.com/Abdurahmanit/GroupProject/platform => ../platform
+
+replace github.com/Abdurahmanit/GroupProject/user-service => ../user-service
+
+replace github.com/Abdurahmanit/GroupProject/listing-service => ../listing-service
+
+replace github.com/Abdurahmanit/GroupProject/order-service => ../order-service
@@ -35,7 +35,7 @@ func toProtoReview(review *domain.Review) *pb.Review {
 	if review == nil {
 		return nil
 	}
-	return &pb.Review{
+	pbReview := &pb.Review{
 		Id:                review.ID.Hex(),
 		UserId:            review.UserID,
 		ProductId:         review.ProductID,
@@ -46,7 +46,57 @@ func toProtoReview(review *domain.Review) *pb.Review {
 		CreatedAt:         timestamppb.New(review.CreatedAt),
 		UpdatedAt:         timestamppb.New(review.UpdatedAt),
 		ModerationComment: review.ModerationComment,
+		Edited:            review.Edited,
+		Flagged:           review.IsFlagged(),
+		Language:          review.Language,
+		FlagCount:         int32(len(review.Flags)),
+		VerifiedPurchase:  review.VerifiedPurchase,
+	}
+	if review.EditedAt != nil {
+		pbReview.EditedAt = timestamppb.New(*review.EditedAt)
+	}
+	if review.Reply != nil {
+		pbReview.Reply = &pb.ReviewReply{
+			ResponderId:   review.Reply.ResponderID,
+			ResponderRole: review.Reply.ResponderRole,
+			Text:          review.Reply.Text,
+			CreatedAt:     timestamppb.New(review.Reply.CreatedAt),
+			UpdatedAt:     timestamppb.New(review.Reply.UpdatedAt),
+		}
+	}
+	return pbReview
+}
+
+// isAdmin reports whether the caller authenticated with the admin role.
+func isAdmin(ctx context.Context) bool {
+	role, _ := ctx.Value(middleware.UserRoleKey).(string)
+	return role == "admin"
+}
+
+// redactModeration clears the status and moderation details unless the caller is the review's
+// author or an admin.
+func redactModeration(ctx context.Context, pbReview *pb.Review) *pb.Review {
+	if pbReview == nil {
+		return nil
+	}
+	callerID, _ := ctx.Value(middleware.UserIDKey).(string)
+	if isAdmin(ctx) || (callerID != "" && callerID == pbReview.GetUserId()) {
+		return pbReview
+	}
+	pbReview.Status = ""
+	pbReview.Flagged = false
+	pbReview.ModerationComment = ""
+	pbReview.FlagCount = 0
+	return pbReview
+}
+
+// translate adds the translated comment to pbReview when lang is set and a translation is available.
+func (h *ReviewHandler) translate(ctx context.Context, pbReview *pb.Review, review *domain.Review, lang string) *pb.Review {
+	if text, ok := h.usecase.TranslateComment(ctx, review, lang); ok {
+		pbReview.TranslatedComment = text
+		pbReview.TranslatedTo = lang
 	}
+	return pbReview
 }
 
 func (h *ReviewHandler) CreateReview(ctx context.Context, req *pb.CreateReviewRequest) (*pb.Review, error) {
@@ -79,6 +129,9 @@ func (h *ReviewHandler) CreateReview(ctx context.Context, req *pb.CreateReviewRe
 		if errors.Is(err, domain.ErrInvalidInput) {
 			return nil, status.Errorf(codes.InvalidArgument, "%s", err.Error())
 		}
+		if errors.Is(err, domain.ErrAccountTooNew) || errors.Is(err, domain.ErrSelfReview) || errors.Is(err, domain.ErrPurchaseRequired) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err.Error())
+		}
 		return nil, status.Errorf(codes.Internal, "failed to create review: %v", err)
 	}
 
@@ -94,6 +147,10 @@ func (h *ReviewHandler) GetReview(ctx context.Context, req *pb.GetReviewRequest)
 		h.logger.Warn("GetReview: Invalid review_id format", zap.String("review_id", req.GetReviewId()), zap.Error(err))
 		return nil, status.Errorf(codes.InvalidArgument, "invalid review ID format")
 	}
+	lang, err := usecase.NormalizeLanguage(req.GetTranslateTo())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
 
 	review, err := h.usecase.GetReview(ctx, reviewID)
 	if err != nil {
@@ -104,7 +161,7 @@ func (h *ReviewHandler) GetReview(ctx context.Context, req *pb.GetReviewRequest)
 		return nil, status.Errorf(codes.Internal, "failed to get review: %v", err)
 	}
 
-	return toProtoReview(review), nil
+	return h.translate(ctx, redactModeration(ctx, toProtoReview(review)), review, lang), nil
 }
 
 func (h *ReviewHandler) UpdateReview(ctx context.Context, req *pb.UpdateReviewRequest) (*pb.Review, error) {
@@ -204,28 +261,40 @@ func (h *ReviewHandler) ListReviewsByProduct(ctx context.Context, req *pb.ListRe
 		return nil, status.Errorf(codes.InvalidArgument, "product_id is required")
 	}
 
+	lang, err := usecase.NormalizeLanguage(req.GetTranslateTo())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
 	var statusFilter *string
 	if req.GetStatusFilter() != "" {
 		sf := req.GetStatusFilter()
+		if domain.ReviewStatus(sf).IsModeration() && !isAdmin(ctx) {
+			return nil, status.Errorf(codes.PermissionDenied, "only admins can list reviews with status %q", sf)
+		}
 		statusFilter = &sf
 	}
 
-	reviews, total, err := h.usecase.ListReviewsByProduct(ctx, req.GetProductId(), req.GetPage(), req.GetLimit(), statusFilter)
+	reviews, total, nextCursor, err := h.usecase.ListReviewsByProduct(ctx, req.GetProductId(), req.GetPage(), req.GetLimit(), statusFilter, req.GetCursor(), req.GetMinRating(), req.GetMaxRating(), req.GetSortBy(), req.GetSortOrder())
 	if err != nil {
 		h.logger.Error("ListReviewsByProduct usecase failed", zap.Error(err), zap.String("product_id", req.GetProductId()))
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
 		return nil, status.Errorf(codes.Internal, "failed to list reviews by product: %v", err)
 	}
 
 	protoReviews := make([]*pb.Review, len(reviews))
 	for i, r := range reviews {
-		protoReviews[i] = toProtoReview(r)
+		protoReviews[i] = h.translate(ctx, redactModeration(ctx, toProtoReview(r)), r, lang)
 	}
 
 	return &pb.ListReviewsResponse{
-		Reviews: protoReviews,
-		Total:   total,
-		Page:    req.GetPage(),
-		Limit:   req.GetLimit(),
+		Reviews:    protoReviews,
+		Total:      total,
+		Page:       req.GetPage(),
+		Limit:      req.GetLimit(),
+		NextCursor: nextCursor,
 	}, nil
 }
 
@@ -247,6 +316,10 @@ func (h *ReviewHandler) ListReviewsByUser(ctx context.Context, req *pb.ListRevie
 	}
 
 	h.logger.Info("ListReviewsByUser RPC called", zap.String("user_id", targetUserID))
+	lang, err := usecase.NormalizeLanguage(req.GetTranslateTo())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
 
 	reviews, total, err := h.usecase.ListReviewsByUser(ctx, targetUserID, req.GetPage(), req.GetLimit())
 	if err != nil {
@@ -256,7 +329,7 @@ func (h *ReviewHandler) ListReviewsByUser(ctx context.Context, req *pb.ListRevie
 
 	protoReviews := make([]*pb.Review, len(reviews))
 	for i, r := range reviews {
-		protoReviews[i] = toProtoReview(r)
+		protoReviews[i] = h.translate(ctx, toProtoReview(r), r, lang)
 	}
 
 	return &pb.ListReviewsResponse{
@@ -287,6 +360,28 @@ func (h *ReviewHandler) GetProductAverageRating(ctx context.Context, req *pb.Get
 	}, nil
 }
 
+func (h *ReviewHandler) GetProductRatingDistribution(ctx context.Context, req *pb.GetProductRatingDistributionRequest) (*pb.ProductRatingDistributionResponse, error) {
+	h.logger.Info("GetProductRatingDistribution RPC called", zap.String("product_id", req.GetProductId()))
+	if req.GetProductId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "product_id is required")
+	}
+	distribution, err := h.usecase.GetProductRatingDistribution(ctx, req.GetProductId())
+	if err != nil {
+		h.logger.Error("GetProductRatingDistribution usecase failed", zap.Error(err), zap.String("product_id", req.GetProductId()))
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return nil, status.Errorf(codes.InvalidArgument, "%s", err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get product rating distribution: %v", err)
+	}
+
+	resp := &pb.ProductRatingDistributionResponse{ProductId: req.GetProductId()}
+	for rating := int32(1); rating <= 5; rating++ {
+		resp.Buckets = append(resp.Buckets, &pb.RatingBucket{Rating: rating, Count: distribution[rating]})
+		resp.ReviewCount += distribution[rating]
+	}
+	return resp, nil
+}
+
 func (h *ReviewHandler) ModerateReview(ctx context.Context, req *pb.ModerateReviewRequest) (*pb.Review, error) {
 	adminID, ok := ctx.Value(middleware.UserIDKey).(string)
 	if !ok || adminID == "" {
@@ -327,3 +422,201 @@ func (h *ReviewHandler) ModerateReview(ctx context.Context, req *pb.ModerateRevi
 
 	return toProtoReview(review), nil
 }
+
+func (h *ReviewHandler) BulkModerateReviews(ctx context.Context, req *pb.BulkModerateReviewsRequest) (*pb.BulkModerateReviewsResponse, error) {
+	adminID, ok := ctx.Value(middleware.UserIDKey).(string)
+	if !ok || adminID == "" {
+		h.logger.Warn("BulkModerateReviews: Admin UserID not found in context")
+		return nil, status.Errorf(codes.Unauthenticated, "admin authentication required")
+	}
+
+	h.logger.Info("BulkModerateReviews RPC called",
+		zap.Int("count", len(req.GetReviewIds())),
+		zap.String("admin_id", adminID),
+		zap.String("new_status", req.GetNewStatus()))
+
+	results, err := h.usecase.BulkModerateReviews(ctx, adminID, req.GetReviewIds(), domain.ReviewStatus(req.GetNewStatus()), req.GetModerationComment())
+	if err != nil {
+		h.logger.Error("BulkModerateReviews usecase failed", zap.Error(err))
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return nil, status.Errorf(codes.InvalidArgument, "%s", err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to moderate reviews: %v", err)
+	}
+
+	resp := &pb.BulkModerateReviewsResponse{Results: make([]*pb.BulkModerationResult, len(results))}
+	for i, res := range results {
+		pbResult := &pb.BulkModerationResult{ReviewId: res.ReviewID, Success: res.Err == nil}
+		switch {
+		case res.Err == nil:
+			resp.SucceededCount++
+		case errors.Is(res.Err, domain.ErrNotFound):
+			pbResult.Error = "review not found"
+		case errors.Is(res.Err, domain.ErrInvalidInput):
+			pbResult.Error = res.Err.Error()
+		default:
+			pbResult.Error = "internal error"
+		}
+		if res.Err != nil {
+			resp.FailedCount++
+		}
+		resp.Results[i] = pbResult
+	}
+	return resp, nil
+}
+
+func (h *ReviewHandler) ReplyToReview(ctx context.Context, req *pb.ReplyToReviewRequest) (*pb.Review, error) {
+	responderID, ok := ctx.Value(middleware.UserIDKey).(string)
+	if !ok || responderID == "" {
+		h.logger.Warn("ReplyToReview: UserID not found in context")
+		return nil, status.Errorf(codes.Unauthenticated, "user authentication required")
+	}
+	role, _ := ctx.Value(middleware.UserRoleKey).(string)
+
+	h.logger.Info("ReplyToReview RPC called", zap.String("review_id", req.GetReviewId()), zap.String("responder_id", responderID))
+
+	reviewID, err := primitive.ObjectIDFromHex(req.GetReviewId())
+	if err != nil {
+		h.logger.Warn("ReplyToReview: Invalid review_id format", zap.String("review_id", req.GetReviewId()), zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "invalid review ID format")
+	}
+
+	review, err := h.usecase.ReplyToReview(ctx, reviewID, responderID, role, req.GetText())
+	if err != nil {
+		h.logger.Error("ReplyToReview usecase failed", zap.Error(err), zap.String("review_id", req.GetReviewId()))
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "review not found")
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			return nil, status.Errorf(codes.PermissionDenied, "only admins and the reviewed seller can reply to this review")
+		}
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return nil, status.Errorf(codes.InvalidArgument, "%s", err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to reply to review: %v", err)
+	}
+
+	return toProtoReview(review), nil
+}
+
+func (h *ReviewHandler) DeleteReviewReply(ctx context.Context, req *pb.DeleteReviewReplyRequest) (*emptypb.Empty, error) {
+	responderID, ok := ctx.Value(middleware.UserIDKey).(string)
+	if !ok || responderID == "" {
+		h.logger.Warn("DeleteReviewReply: UserID not found in context")
+		return nil, status.Errorf(codes.Unauthenticated, "user authentication required")
+	}
+	role, _ := ctx.Value(middleware.UserRoleKey).(string)
+
+	h.logger.Info("DeleteReviewReply RPC called", zap.String("review_id", req.GetReviewId()), zap.String("responder_id", responderID))
+
+	reviewID, err := primitive.ObjectIDFromHex(req.GetReviewId())
+	if err != nil {
+		h.logger.Warn("DeleteReviewReply: Invalid review_id format", zap.String("review_id", req.GetReviewId()), zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "invalid review ID format")
+	}
+
+	if err := h.usecase.DeleteReviewReply(ctx, reviewID, responderID, role); err != nil {
+		h.logger.Error("DeleteReviewReply usecase failed", zap.Error(err), zap.String("review_id", req.GetReviewId()))
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "%s", err.Error())
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			return nil, status.Errorf(codes.PermissionDenied, "only admins and the reviewed seller can delete this reply")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to delete review reply: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *ReviewHandler) FlagReview(ctx context.Context, req *pb.FlagReviewRequest) (*emptypb.Empty, error) {
+	userID, ok := ctx.Value(middleware.UserIDKey).(string)
+	if !ok || userID == "" {
+		h.logger.Warn("FlagReview: UserID not found in context")
+		return nil, status.Errorf(codes.Unauthenticated, "user authentication required")
+	}
+
+	h.logger.Info("FlagReview RPC called", zap.String("review_id", req.GetReviewId()), zap.String("user_id", userID))
+
+	reviewID, err := primitive.ObjectIDFromHex(req.GetReviewId())
+	if err != nil {
+		h.logger.Warn("FlagReview: Invalid review_id format", zap.String("review_id", req.GetReviewId()), zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "invalid review ID format")
+	}
+
+	if err := h.usecase.FlagReview(ctx, reviewID, userID, req.GetReason()); err != nil {
+		h.logger.Error("FlagReview usecase failed", zap.Error(err), zap.String("review_id", req.GetReviewId()))
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "review not found")
+		}
+		if errors.Is(err, domain.ErrAlreadyFlagged) {
+			return nil, status.Errorf(codes.AlreadyExists, "%s", err.Error())
+		}
+		if errors.Is(err, domain.ErrForbidden) {
+			return nil, status.Errorf(codes.PermissionDenied, "%s", err.Error())
+		}
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return nil, status.Errorf(codes.InvalidArgument, "%s", err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to flag review: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *ReviewHandler) ListPendingReviews(ctx context.Context, req *pb.ListPendingReviewsRequest) (*pb.ListReviewsResponse, error) {
+	adminID, ok := ctx.Value(middleware.UserIDKey).(string)
+	if !ok || adminID == "" {
+		h.logger.Warn("ListPendingReviews: Admin UserID not found in context")
+		return nil, status.Errorf(codes.Unauthenticated, "admin authentication required")
+	}
+	h.logger.Info("ListPendingReviews RPC called", zap.String("admin_id", adminID), zap.Int32("page", req.GetPage()), zap.Int32("limit", req.GetLimit()))
+
+	reviews, total, err := h.usecase.ListPendingReviews(ctx, adminID, req.GetPage(), req.GetLimit())
+	if err != nil {
+		h.logger.Error("ListPendingReviews usecase failed", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to list pending reviews: %v", err)
+	}
+
+	protoReviews := make([]*pb.Review, len(reviews))
+	for i, r := range reviews {
+		protoReviews[i] = toProtoReview(r)
+	}
+
+	return &pb.ListReviewsResponse{
+		Reviews: protoReviews,
+		Total:   total,
+		Page:    req.GetPage(),
+		Limit:   req.GetLimit(),
+	}, nil
+}
+
+func (h *ReviewHandler) ListFlaggedReviews(ctx context.Context, req *pb.ListFlaggedReviewsRequest) (*pb.ListReviewsResponse, error) {
+	h.logger.Info("ListFlaggedReviews RPC called", zap.Int32("page", req.GetPage()), zap.Int32("limit", req.GetLimit()))
+
+	reviews, total, err := h.usecase.ListFlaggedReviews(ctx, req.GetPage(), req.GetLimit())
+	if err != nil {
+		h.logger.Error("ListFlaggedReviews usecase failed", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to list flagged reviews: %v", err)
+	}
+
+	protoReviews := make([]*pb.Review, len(reviews))
+	for i, r := range reviews {
+		pbReview := toProtoReview(r)
+		for _, f := range r.Flags {
+			pbReview.Flags = append(pbReview.Flags, &pb.ReviewFlag{
+				UserId:    f.UserID,
+				Reason:    f.Reason,
+				CreatedAt: timestamppb.New(f.CreatedAt),
+			})
+		}
+		protoReviews[i] = pbReview
+	}
+
+	return &pb.ListReviewsResponse{
+		Reviews: protoReviews,
+		Total:   total,
+		Page:    req.GetPage(),
+		Limit:   req.GetLimit(),
+	}, nil
+}
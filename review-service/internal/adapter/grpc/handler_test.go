@@ -0,0 +1,67 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/Abdurahmanit/GroupProject/review-service"
+	"github.com/Abdurahmanit/GroupProject/review-service/internal/middleware"
+	"github.com/Abdurahmanit/GroupProject/review-service/internal/platform/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func callerContext(userID, role string) context.Context {
+	ctx := context.WithValue(context.Background(), middleware.UserIDKey, userID)
+	return context.WithValue(ctx, middleware.UserRoleKey, role)
+}
+
+func rejectedReview() *pb.Review {
+	return &pb.Review{UserId: "author", Status: "rejected", Flagged: true, ModerationComment: "spam", FlagCount: 3}
+}
+
+func TestRedactModeration_HidesStatusFromOtherUsers(t *testing.T) {
+	for name, ctx := range map[string]context.Context{
+		"other user": callerContext("someone-else", "user"),
+		"anonymous":  context.Background(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			review := redactModeration(ctx, rejectedReview())
+
+			assert.Empty(t, review.GetStatus())
+			assert.False(t, review.GetFlagged())
+			assert.Empty(t, review.GetModerationComment())
+			assert.Zero(t, review.GetFlagCount())
+		})
+	}
+}
+
+func TestRedactModeration_KeepsDetailsForAuthorAndAdmin(t *testing.T) {
+	for name, ctx := range map[string]context.Context{
+		"author": callerContext("author", "user"),
+		"admin":  callerContext("moderator", "admin"),
+	} {
+		t.Run(name, func(t *testing.T) {
+			review := redactModeration(ctx, rejectedReview())
+
+			assert.Equal(t, "rejected", review.GetStatus())
+			assert.True(t, review.GetFlagged())
+			assert.Equal(t, "spam", review.GetModerationComment())
+			assert.EqualValues(t, 3, review.GetFlagCount())
+		})
+	}
+}
+
+func TestListReviewsByProduct_ModerationStatusFilterIsAdminOnly(t *testing.T) {
+	// The check has to happen before the usecase (nil here) is called.
+	h := NewReviewHandler(nil, logger.NewLogger())
+
+	for _, filter := range []string{"rejected", "hidden", "reported", "flagged"} {
+		_, err := h.ListReviewsByProduct(callerContext("someone", "user"), &pb.ListReviewsByProductRequest{ProductId: "p1", StatusFilter: filter})
+
+		require.Error(t, err, filter)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err), filter)
+	}
+}
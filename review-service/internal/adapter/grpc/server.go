@@ -1,12 +1,13 @@
 package grpc
 
 import (
+	"github.com/Abdurahmanit/GroupProject/platform/grpcrecovery"
+	"github.com/Abdurahmanit/GroupProject/platform/logkv"
 	"github.com/Abdurahmanit/GroupProject/review-service/internal/middleware"
 	"github.com/Abdurahmanit/GroupProject/review-service/internal/platform/logger"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
@@ -15,18 +16,23 @@ func NewGRPCServer(
 	appLogger *logger.Logger,
 	jwtSecret string,
 	tp *sdktrace.TracerProvider,
+	onPanic grpcrecovery.PanicHook,
 ) *grpc.Server {
 	publicMethods := map[string]bool{
-		"/review.ReviewService/GetReview":               true,
-		"/review.ReviewService/ListReviewsByProduct":    true,
-		"/review.ReviewService/GetProductAverageRating": true,
-		grpc_health_v1.Health_Check_FullMethodName:      true,
+		"/review.ReviewService/GetReview":                    true,
+		"/review.ReviewService/ListReviewsByProduct":         true,
+		"/review.ReviewService/GetProductAverageRating":      true,
+		"/review.ReviewService/GetProductRatingDistribution": true,
+		grpc_health_v1.Health_Check_FullMethodName:           true,
 	}
 	requiredRoles := map[string][]string{
-		"/review.ReviewService/ModerateReview": {"admin"},
+		"/review.ReviewService/ModerateReview":      {"admin"},
+		"/review.ReviewService/BulkModerateReviews": {"admin"},
+		"/review.ReviewService/ListPendingReviews":  {"admin"},
+		"/review.ReviewService/ListFlaggedReviews":  {"admin"},
 	}
 
-	return NewGRPCServerWithInterceptors(appLogger, jwtSecret, tp, publicMethods, requiredRoles)
+	return NewGRPCServerWithInterceptors(appLogger, jwtSecret, tp, publicMethods, requiredRoles, onPanic)
 }
 
 func NewGRPCServerWithInterceptors(
@@ -35,16 +41,20 @@ func NewGRPCServerWithInterceptors(
 	tp *sdktrace.TracerProvider,
 	publicMethods map[string]bool,
 	requiredRoles map[string][]string,
+	onPanic grpcrecovery.PanicHook,
 ) *grpc.Server {
 
+	recoveryLogger := logkv.FromZap(appLogger.Logger)
 	unaryInterceptors := []grpc.UnaryServerInterceptor{
 		middleware.TracingInterceptor(),
+		grpcrecovery.UnaryServerInterceptor(recoveryLogger, onPanic),
 		middleware.LoggingInterceptor(appLogger),
 		middleware.AuthInterceptor(jwtSecret, appLogger, publicMethods, requiredRoles),
 	}
 
 	streamInterceptors := []grpc.StreamServerInterceptor{
 		middleware.StreamTracingInterceptor(),
+		grpcrecovery.StreamServerInterceptor(recoveryLogger, onPanic),
 	}
 
 	server := grpc.NewServer(
@@ -54,14 +64,12 @@ func NewGRPCServerWithInterceptors(
 
 	appLogger.Info("gRPC server configured with interceptors",
 		zap.Bool("tracing_enabled", tp != nil || middleware.TracingInterceptor() != nil),
+		zap.Bool("recovery_enabled", true),
 		zap.Bool("logging_enabled", true),
 		zap.Bool("auth_enabled", true),
 	)
 
 	reflection.Register(server)
 
-	healthServer := health.NewServer()
-	grpc_health_v1.RegisterHealthServer(server, healthServer)
-
 	return server
 }
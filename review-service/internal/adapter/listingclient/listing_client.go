@@ -0,0 +1,73 @@
+package listingclient
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	listingpb "github.com/Abdurahmanit/GroupProject/listing-service/genproto/listing_service"
+	"github.com/Abdurahmanit/GroupProject/review-service/internal/domain"
+	"github.com/Abdurahmanit/GroupProject/review-service/internal/platform/logger"
+	"github.com/Abdurahmanit/GroupProject/review-service/internal/platform/ttlcache"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	callTimeout = 3 * time.Second
+	// cacheTTL is short: ownership rarely changes, but a listing can be created and reviewed quickly.
+	cacheTTL = time.Minute
+	// maxCacheEntries bounds memory.
+	maxCacheEntries = 10000
+)
+
+// Client reads listing details from listing-service. It implements domain.ProductDirectory.
+type Client struct {
+	conn   *grpc.ClientConn
+	client listingpb.ListingServiceClient
+	logger *logger.Logger
+	cache  *ttlcache.Cache[string]
+}
+
+var _ domain.ProductDirectory = (*Client)(nil)
+
+func NewClient(address string, log *logger.Logger) (*Client, error) {
+	if address == "" {
+		return nil, fmt.Errorf("listing service address is not configured")
+	}
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create listing service client for %s: %w", address, err)
+	}
+	return &Client{
+		conn:   conn,
+		client: listingpb.NewListingServiceClient(conn),
+		logger: log.Named("ListingServiceClient"),
+		cache:  ttlcache.New[string](cacheTTL, maxCacheEntries),
+	}, nil
+}
+
+// GetProductOwner returns the ID of the user who owns the listing, or "" if it does not exist.
+// Recent lookups are served from cache.
+func (c *Client) GetProductOwner(ctx context.Context, productID string) (string, error) {
+	if ownerID, ok := c.cache.Get(productID); ok {
+		return ownerID, nil
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, callTimeout)
+	defer cancel()
+	resp, err := c.client.GetListingsStatus(callCtx, &listingpb.GetListingsStatusRequest{Ids: []string{productID}})
+	if err != nil {
+		c.logger.Warn("Listing service GetListingsStatus failed", zap.String("product_id", productID), zap.Error(err))
+		return "", fmt.Errorf("failed to get owner of product %s: %w", productID, err)
+	}
+	ownerID := resp.GetStatuses()[productID].GetUserId()
+
+	c.cache.Set(productID, ownerID)
+	return ownerID, nil
+}
+
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
@@ -3,6 +3,7 @@ package nats
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
@@ -101,6 +102,33 @@ func (c NATSHeaderCarrier) Keys() []string {
 	return keys
 }
 
+// Ping reports an error while the NATS connection is down or reconnecting.
+func (p *Publisher) Ping(ctx context.Context) error {
+	if p.conn == nil || !p.conn.IsConnected() {
+		return errors.New("nats connection is not established")
+	}
+	return nil
+}
+
+// PublishProbe publishes a small test message to subject and waits for the server to
+// acknowledge it, surfacing errors such as a permissions violation that Publish only
+// reports asynchronously.
+func (p *Publisher) PublishProbe(ctx context.Context, subject string) error {
+	if err := p.Ping(ctx); err != nil {
+		return err
+	}
+	if err := p.conn.Publish(subject, []byte(`{"probe":true}`)); err != nil {
+		return fmt.Errorf("publish to %s: %w", subject, err)
+	}
+	if err := p.conn.FlushWithContext(ctx); err != nil {
+		return fmt.Errorf("flush after publishing to %s: %w", subject, err)
+	}
+	if err := p.conn.LastError(); err != nil {
+		return fmt.Errorf("server rejected publish to %s: %w", subject, err)
+	}
+	return nil
+}
+
 // Close drains and closes the NATS connection.
 func (p *Publisher) Close() {
 	p.logger.Info("NATS Publisher: closing connection...")
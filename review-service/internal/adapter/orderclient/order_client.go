@@ -0,0 +1,56 @@
+package orderclient
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	servicepb "github.com/Abdurahmanit/GroupProject/order-service/proto/service"
+	"github.com/Abdurahmanit/GroupProject/review-service/internal/domain"
+	"github.com/Abdurahmanit/GroupProject/review-service/internal/platform/logger"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const callTimeout = 3 * time.Second
+
+// Client reads order history from order-service. It implements domain.PurchaseHistory.
+type Client struct {
+	conn   *grpc.ClientConn
+	client servicepb.OrderServiceClient
+	logger *logger.Logger
+}
+
+var _ domain.PurchaseHistory = (*Client)(nil)
+
+func NewClient(address string, log *logger.Logger) (*Client, error) {
+	if address == "" {
+		return nil, fmt.Errorf("order service address is not configured")
+	}
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create order service client for %s: %w", address, err)
+	}
+	return &Client{
+		conn:   conn,
+		client: servicepb.NewOrderServiceClient(conn),
+		logger: log.Named("OrderServiceClient"),
+	}, nil
+}
+
+// HasPurchased asks order-service whether the user has a paid order containing the product.
+func (c *Client) HasPurchased(ctx context.Context, userID, productID string) (bool, error) {
+	callCtx, cancel := context.WithTimeout(ctx, callTimeout)
+	defer cancel()
+	resp, err := c.client.VerifyPurchase(callCtx, &servicepb.VerifyPurchaseRequest{UserId: userID, ProductId: productID})
+	if err != nil {
+		c.logger.Warn("Order service VerifyPurchase failed", zap.String("user_id", userID), zap.String("product_id", productID), zap.Error(err))
+		return false, fmt.Errorf("failed to verify purchase of product %s by user %s: %w", productID, userID, err)
+	}
+	return resp.GetPurchased(), nil
+}
+
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
@@ -19,7 +19,54 @@ type reviewDocument struct {
 	ModerationComment string              `bson:"moderation_comment,omitempty"` // Comment from moderator
 	CreatedAt         time.Time           `bson:"created_at"`
 	UpdatedAt         time.Time           `bson:"updated_at"`
+	Edited            bool                `bson:"edited,omitempty"`
+	EditedAt          *time.Time          `bson:"edited_at,omitempty"`
 	Version           int64               `bson:"version"`
+	Language          string              `bson:"language,omitempty"`
+	Translations      map[string]string   `bson:"translations,omitempty"` // Target language -> translated comment
+	Reply             *replyDocument      `bson:"reply,omitempty"`
+	Flags             []flagDocument      `bson:"flags,omitempty"`
+	VerifiedPurchase  bool                `bson:"verified_purchase,omitempty"`
+}
+
+type flagDocument struct {
+	UserID    string    `bson:"user_id"`
+	Reason    string    `bson:"reason,omitempty"`
+	CreatedAt time.Time `bson:"created_at"`
+}
+
+type replyDocument struct {
+	ResponderID   string    `bson:"responder_id"`
+	ResponderRole string    `bson:"responder_role"`
+	Text          string    `bson:"text"`
+	CreatedAt     time.Time `bson:"created_at"`
+	UpdatedAt     time.Time `bson:"updated_at"`
+}
+
+func (doc *replyDocument) toDomain() *domain.ReviewReply {
+	if doc == nil {
+		return nil
+	}
+	return &domain.ReviewReply{
+		ResponderID:   doc.ResponderID,
+		ResponderRole: doc.ResponderRole,
+		Text:          doc.Text,
+		CreatedAt:     doc.CreatedAt,
+		UpdatedAt:     doc.UpdatedAt,
+	}
+}
+
+func fromDomainReply(reply *domain.ReviewReply) *replyDocument {
+	if reply == nil {
+		return nil
+	}
+	return &replyDocument{
+		ResponderID:   reply.ResponderID,
+		ResponderRole: reply.ResponderRole,
+		Text:          reply.Text,
+		CreatedAt:     reply.CreatedAt,
+		UpdatedAt:     reply.UpdatedAt,
+	}
 }
 
 // toDomainReview converts a reviewDocument from MongoDB to a domain.Review entity.
@@ -27,6 +74,10 @@ func (doc *reviewDocument) toDomainReview() *domain.Review {
 	if doc == nil {
 		return nil
 	}
+	var flags []domain.ReviewFlag
+	for _, f := range doc.Flags {
+		flags = append(flags, domain.ReviewFlag{UserID: f.UserID, Reason: f.Reason, CreatedAt: f.CreatedAt})
+	}
 	return &domain.Review{
 		ID:                doc.ID,
 		UserID:            doc.UserID,
@@ -38,6 +89,13 @@ func (doc *reviewDocument) toDomainReview() *domain.Review {
 		ModerationComment: doc.ModerationComment,
 		CreatedAt:         doc.CreatedAt,
 		UpdatedAt:         doc.UpdatedAt,
+		Edited:            doc.Edited,
+		EditedAt:          doc.EditedAt,
+		Language:          doc.Language,
+		Translations:      doc.Translations,
+		Reply:             doc.Reply.toDomain(),
+		Flags:             flags,
+		VerifiedPurchase:  doc.VerifiedPurchase,
 	}
 }
 
@@ -62,5 +120,11 @@ func fromDomainReview(review *domain.Review) (*reviewDocument, error) {
 		ModerationComment: review.ModerationComment,
 		CreatedAt:         review.CreatedAt,
 		UpdatedAt:         review.UpdatedAt,
+		Edited:            review.Edited,
+		EditedAt:          review.EditedAt,
+		Language:          review.Language,
+		Translations:      review.Translations,
+		Reply:             fromDomainReply(review.Reply),
+		VerifiedPurchase:  review.VerifiedPurchase,
 	}, nil
 }
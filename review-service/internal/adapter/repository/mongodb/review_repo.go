@@ -35,6 +35,8 @@ func NewReviewRepository(db *mongo.Database, log *logger.Logger) (*ReviewReposit
 		{Keys: bson.D{{Key: "product_id", Value: 1}, {Key: "user_id", Value: 1}}, Options: options.Index().SetUnique(true).SetPartialFilterExpression(bson.M{"seller_id": bson.M{"$exists": false}})}, // Unique review per user per product
 		{Keys: bson.D{{Key: "seller_id", Value: 1}, {Key: "user_id", Value: 1}}, Options: options.Index().SetUnique(true).SetPartialFilterExpression(bson.M{"product_id": bson.M{"$exists": false}})}, // Unique review per user per seller (if applicable)
 		{Keys: bson.D{{Key: "status", Value: 1}}}, // For querying by status (e.g., pending moderation)
+		{Keys: bson.D{{Key: "product_id", Value: 1}, {Key: "status", Value: 1}, {Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}},    // Keyset pagination of product reviews
+		{Keys: bson.D{{Key: "product_id", Value: 1}, {Key: "status", Value: 1}, {Key: "rating", Value: -1}, {Key: "created_at", Value: -1}}}, // Product reviews filtered or sorted by rating
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -117,16 +119,22 @@ func (r *ReviewRepository) Update(ctx context.Context, review *domain.Review) er
 	doc.UpdatedAt = time.Now().UTC()
 	review.UpdatedAt = doc.UpdatedAt
 
-	updatePayload := bson.M{
-		"$set": bson.M{
-			"rating":             doc.Rating,
-			"comment":            doc.Comment,
-			"status":             doc.Status,
-			"moderation_comment": doc.ModerationComment,
-			"updated_at":         doc.UpdatedAt,
-			"version":            doc.Version,
-		},
+	setFields := bson.M{
+		"rating":             doc.Rating,
+		"comment":            doc.Comment,
+		"status":             doc.Status,
+		"moderation_comment": doc.ModerationComment,
+		"updated_at":         doc.UpdatedAt,
+		"version":            doc.Version,
+		"language":           doc.Language,
+		"translations":       doc.Translations,
+	}
+	// edited/edited_at are only ever written by author edits; moderation leaves them untouched.
+	if doc.Edited && doc.EditedAt != nil {
+		setFields["edited"] = true
+		setFields["edited_at"] = doc.EditedAt
 	}
+	updatePayload := bson.M{"$set": setFields}
 
 	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": doc.ID}, updatePayload)
 	if err != nil {
@@ -141,6 +149,98 @@ func (r *ReviewRepository) Update(ctx context.Context, review *domain.Review) er
 	return nil
 }
 
+func (r *ReviewRepository) SaveTranslation(ctx context.Context, id primitive.ObjectID, comment, lang, translated string) error {
+	filter := bson.M{"_id": id, "comment": comment}
+	update := bson.M{"$set": bson.M{"translations." + lang: translated}}
+	if _, err := r.collection.UpdateOne(ctx, filter, update); err != nil {
+		r.logger.Error("Failed to save review translation", zap.Error(err), zap.String("review_id", id.Hex()), zap.String("lang", lang))
+		return fmt.Errorf("db update failed: %w", err)
+	}
+	return nil
+}
+
+func (r *ReviewRepository) SetReply(ctx context.Context, id primitive.ObjectID, reply *domain.ReviewReply) error {
+	update := bson.M{"$unset": bson.M{"reply": ""}, "$set": bson.M{"updated_at": time.Now().UTC()}}
+	if reply != nil {
+		update = bson.M{"$set": bson.M{"reply": fromDomainReply(reply), "updated_at": time.Now().UTC()}}
+	}
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": id}, update)
+	if err != nil {
+		r.logger.Error("Failed to save review reply", zap.Error(err), zap.String("review_id", id.Hex()))
+		return fmt.Errorf("db update failed: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+func (r *ReviewRepository) AddFlag(ctx context.Context, id primitive.ObjectID, flag domain.ReviewFlag) (*domain.Review, error) {
+	// The user_id condition makes the push a no-op for repeat flags, so concurrent
+	// flags from the same user cannot both be recorded.
+	filter := bson.M{"_id": id, "flags.user_id": bson.M{"$ne": flag.UserID}}
+	update := bson.M{"$push": bson.M{"flags": flagDocument{UserID: flag.UserID, Reason: flag.Reason, CreatedAt: flag.CreatedAt}}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var doc reviewDocument
+	err := r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&doc)
+	if err == nil {
+		return doc.toDomainReview(), nil
+	}
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		r.logger.Error("Failed to add review flag", zap.Error(err), zap.String("review_id", id.Hex()))
+		return nil, fmt.Errorf("db update failed: %w", err)
+	}
+	if _, err := r.GetByID(ctx, id); err != nil {
+		return nil, err
+	}
+	return nil, domain.ErrAlreadyFlagged
+}
+
+func (r *ReviewRepository) FindByIDs(ctx context.Context, ids []primitive.ObjectID) ([]*domain.Review, error) {
+	if len(ids) == 0 {
+		return []*domain.Review{}, nil
+	}
+	cursor, err := r.collection.Find(ctx, bson.M{"_id": bson.M{"$in": ids}})
+	if err != nil {
+		r.logger.Error("Failed to find reviews by IDs", zap.Error(err), zap.Int("count", len(ids)))
+		return nil, fmt.Errorf("db find failed: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var docs []reviewDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		r.logger.Error("Failed to decode reviews by IDs", zap.Error(err))
+		return nil, fmt.Errorf("db cursor decode failed: %w", err)
+	}
+	reviews := make([]*domain.Review, 0, len(docs))
+	for i := range docs {
+		reviews = append(reviews, docs[i].toDomainReview())
+	}
+	return reviews, nil
+}
+
+func (r *ReviewRepository) SetStatusMany(ctx context.Context, ids []primitive.ObjectID, status domain.ReviewStatus, moderationComment string, updatedAt time.Time) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"status":             status,
+			"moderation_comment": moderationComment,
+			"updated_at":         updatedAt,
+		},
+		"$inc": bson.M{"version": 1},
+	}
+	result, err := r.collection.UpdateMany(ctx, bson.M{"_id": bson.M{"$in": ids}}, update)
+	if err != nil {
+		r.logger.Error("Failed to bulk update review status", zap.Error(err), zap.Int("count", len(ids)))
+		return 0, fmt.Errorf("db update failed: %w", err)
+	}
+	r.logger.Info("Bulk updated review status", zap.Int64("matched", result.MatchedCount), zap.String("status", string(status)))
+	return result.MatchedCount, nil
+}
+
 // Delete removes a review from the database.
 func (r *ReviewRepository) Delete(ctx context.Context, id primitive.ObjectID) error {
 	r.logger.Info("Deleting review from DB", zap.String("review_id", id.Hex()))
@@ -164,17 +264,50 @@ func (r *ReviewRepository) FindByProductID(ctx context.Context, productID string
 	if filter.Status != nil {
 		mongoQuery["status"] = *filter.Status
 	}
+	if filter.MinRating != nil || filter.MaxRating != nil {
+		ratingQuery := bson.M{}
+		if filter.MinRating != nil {
+			ratingQuery["$gte"] = *filter.MinRating
+		}
+		if filter.MaxRating != nil {
+			ratingQuery["$lte"] = *filter.MaxRating
+		}
+		mongoQuery["rating"] = ratingQuery
+	}
 
+	findQuery := mongoQuery
 	findOptions := options.Find()
-	if filter.Limit > 0 {
+	if filter.After != nil {
+		// Keyset pagination: continue strictly after the cursor position, no skip.
+		findQuery = bson.M{}
+		for k, v := range mongoQuery {
+			findQuery[k] = v
+		}
+		findQuery["$or"] = bson.A{
+			bson.M{"created_at": bson.M{"$lt": filter.After.CreatedAt}},
+			bson.M{"created_at": filter.After.CreatedAt, "_id": bson.M{"$lt": filter.After.ID}},
+		}
+		if filter.Limit > 0 {
+			findOptions.SetLimit(int64(filter.Limit))
+		}
+	} else if filter.Limit > 0 {
 		findOptions.SetLimit(int64(filter.Limit))
 		if filter.Page > 0 {
 			findOptions.SetSkip(int64(filter.Page-1) * int64(filter.Limit))
 		}
 	}
-	findOptions.SetSort(bson.D{{Key: "created_at", Value: -1}}) // Newest first
+	sortOrder := -1 // Descending by default
+	if filter.SortOrder == "asc" {
+		sortOrder = 1
+	}
+	if filter.SortBy == "rating" {
+		// Reviews with the same rating are listed newest first
+		findOptions.SetSort(bson.D{{Key: "rating", Value: sortOrder}, {Key: "created_at", Value: -1}, {Key: "_id", Value: -1}})
+	} else {
+		findOptions.SetSort(bson.D{{Key: "created_at", Value: sortOrder}, {Key: "_id", Value: sortOrder}}) // _id breaks ties
+	}
 
-	cursor, err := r.collection.Find(ctx, mongoQuery, findOptions)
+	cursor, err := r.collection.Find(ctx, findQuery, findOptions)
 	if err != nil {
 		r.logger.Error("Failed to find reviews by product_id from DB", zap.Error(err), zap.String("product_id", productID))
 		return nil, 0, fmt.Errorf("db find failed: %w", err)
@@ -285,6 +418,44 @@ func (r *ReviewRepository) GetAverageRating(ctx context.Context, productID strin
 	return results[0].AverageRating, results[0].Count, nil
 }
 
+// GetRatingDistribution counts approved reviews per rating for a product.
+func (r *ReviewRepository) GetRatingDistribution(ctx context.Context, productID string) (map[int32]int32, error) {
+	r.logger.Debug("Calculating rating distribution for product_id", zap.String("product_id", productID))
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{
+			{Key: "product_id", Value: productID},
+			{Key: "status", Value: domain.ReviewStatusApproved}, // Same semantics as GetAverageRating
+		}}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: "$rating"},
+			{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+		}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		r.logger.Error("Failed to aggregate rating distribution", zap.Error(err), zap.String("product_id", productID))
+		return nil, fmt.Errorf("db aggregate failed: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var results []struct {
+		Rating int32 `bson:"_id"`
+		Count  int32 `bson:"count"`
+	}
+	if err = cursor.All(ctx, &results); err != nil {
+		r.logger.Error("Failed to decode rating distribution aggregation result", zap.Error(err))
+		return nil, fmt.Errorf("db cursor all for aggregate failed: %w", err)
+	}
+
+	distribution := make(map[int32]int32, len(results))
+	for _, res := range results {
+		distribution[res.Rating] = res.Count
+	}
+	return distribution, nil
+}
+
 // FindByStatus retrieves reviews by their status, with pagination.
 func (r *ReviewRepository) FindByStatus(ctx context.Context, status domain.ReviewStatus, filter domain.ReviewFilter) ([]*domain.Review, int64, error) {
 	r.logger.Debug("Finding reviews by status from DB", zap.String("status", string(status)), zap.Any("filter", filter))
@@ -307,7 +478,8 @@ func (r *ReviewRepository) FindByStatus(ctx context.Context, status domain.Revie
 	if filter.SortOrder == "asc" {
 		sortOrder = 1
 	}
-	findOptions.SetSort(bson.D{{Key: sortBy, Value: sortOrder}})
+	// _id breaks ties so that pages do not overlap when timestamps are equal
+	findOptions.SetSort(bson.D{{Key: sortBy, Value: sortOrder}, {Key: "_id", Value: sortOrder}})
 
 	cursor, err := r.collection.Find(ctx, mongoQuery, findOptions)
 	if err != nil {
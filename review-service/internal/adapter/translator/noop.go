@@ -0,0 +1,21 @@
+package translator
+
+import (
+	"context"
+
+	"github.com/Abdurahmanit/GroupProject/review-service/internal/domain"
+)
+
+// Noop is the default domain.Translator for deployments without a translation provider.
+// It detects no language and translates nothing, so reviews are always served as written.
+type Noop struct{}
+
+var _ domain.Translator = Noop{}
+
+func (Noop) DetectLanguage(ctx context.Context, text string) (string, error) {
+	return "", nil
+}
+
+func (Noop) Translate(ctx context.Context, text, sourceLang, targetLang string) (string, error) {
+	return "", domain.ErrTranslationUnavailable
+}
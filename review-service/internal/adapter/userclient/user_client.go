@@ -0,0 +1,75 @@
+package userclient
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Abdurahmanit/GroupProject/review-service/internal/domain"
+	"github.com/Abdurahmanit/GroupProject/review-service/internal/platform/logger"
+	"github.com/Abdurahmanit/GroupProject/review-service/internal/platform/ttlcache"
+	userpb "github.com/Abdurahmanit/GroupProject/user-service/proto"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	callTimeout = 3 * time.Second
+	// cacheTTL keeps repeated reviews from the same author from hitting user-service on every call.
+	cacheTTL = 5 * time.Minute
+	// maxCacheEntries bounds memory.
+	maxCacheEntries = 10000
+)
+
+// Client reads account details from user-service. It implements domain.UserDirectory.
+type Client struct {
+	conn   *grpc.ClientConn
+	client userpb.UserServiceClient
+	logger *logger.Logger
+	cache  *ttlcache.Cache[time.Time]
+}
+
+var _ domain.UserDirectory = (*Client)(nil)
+
+func NewClient(address string, log *logger.Logger) (*Client, error) {
+	if address == "" {
+		return nil, fmt.Errorf("user service address is not configured")
+	}
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create user service client for %s: %w", address, err)
+	}
+	return &Client{
+		conn:   conn,
+		client: userpb.NewUserServiceClient(conn),
+		logger: log.Named("UserServiceClient"),
+		cache:  ttlcache.New[time.Time](cacheTTL, maxCacheEntries),
+	}, nil
+}
+
+// GetAccountCreatedAt returns when the user's account was created, serving recent lookups from cache.
+func (c *Client) GetAccountCreatedAt(ctx context.Context, userID string) (time.Time, error) {
+	if createdAt, ok := c.cache.Get(userID); ok {
+		return createdAt, nil
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, callTimeout)
+	defer cancel()
+	resp, err := c.client.GetProfile(callCtx, &userpb.GetProfileRequest{UserId: userID})
+	if err != nil {
+		c.logger.Warn("User service GetProfile failed", zap.String("user_id", userID), zap.Error(err))
+		return time.Time{}, fmt.Errorf("failed to get profile for user %s: %w", userID, err)
+	}
+	createdAt, err := time.Parse(time.RFC3339, resp.GetCreatedAt())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid created_at %q for user %s: %w", resp.GetCreatedAt(), userID, err)
+	}
+
+	c.cache.Set(userID, createdAt)
+	return createdAt, nil
+}
+
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
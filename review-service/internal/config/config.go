@@ -3,6 +3,7 @@ package config
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/Abdurahmanit/GroupProject/review-service/internal/platform/logger"
 	"github.com/spf13/viper"
@@ -20,6 +21,50 @@ type Config struct {
 	LogLevel               string `mapstructure:"LOG_LEVEL"`
 	LogFormat              string `mapstructure:"LOG_FORMAT"`
 	OTExporterOTLPEndpoint string `mapstructure:"OTEL_EXPORTER_OTLP_ENDPOINT"`
+
+	// Reviews from trusted authors are approved on creation instead of waiting for moderation
+	AutoApproveReviews            bool   `mapstructure:"AUTO_APPROVE_REVIEWS"`
+	AutoApproveMinApprovedReviews int64  `mapstructure:"AUTO_APPROVE_MIN_APPROVED_REVIEWS"`
+	AutoApproveTrustedUserIDs     string `mapstructure:"AUTO_APPROVE_TRUSTED_USER_IDS"` // Comma-separated
+
+	// Accounts younger than ReviewMinAccountAge (e.g. "72h") cannot post reviews; 0 disables the rule.
+	// The account creation time is read from user-service at UserServiceAddress.
+	ReviewMinAccountAge time.Duration `mapstructure:"REVIEW_MIN_ACCOUNT_AGE"`
+	UserServiceAddress  string        `mapstructure:"USER_SERVICE_ADDRESS"`
+
+	// When BlockSelfReview is set, sellers cannot review their own products; product owners
+	// are read from listing-service at ListingServiceAddress. The same lookup decides who may
+	// reply to a product review as its seller; without an address only admins can.
+	BlockSelfReview       bool   `mapstructure:"REVIEW_BLOCK_SELF_REVIEW"`
+	ListingServiceAddress string `mapstructure:"LISTING_SERVICE_ADDRESS"`
+
+	// When RequireVerifiedPurchase is set, only users with a paid order for the product can
+	// review it; orders are read from order-service at OrderServiceAddress.
+	RequireVerifiedPurchase bool   `mapstructure:"REVIEW_REQUIRE_VERIFIED_PURCHASE"`
+	OrderServiceAddress     string `mapstructure:"ORDER_SERVICE_ADDRESS"`
+
+	// Reviews rated at or below LowRatingThreshold need a comment of at least
+	// LowRatingMinCommentLength characters; a zero length disables the rule.
+	LowRatingThreshold        int32 `mapstructure:"REVIEW_LOW_RATING_THRESHOLD"`
+	LowRatingMinCommentLength int   `mapstructure:"REVIEW_LOW_RATING_MIN_COMMENT_LENGTH"`
+
+	// Reviews flagged by this many distinct users move to the "flagged" status; 0 disables it.
+	ReviewFlagThreshold int `mapstructure:"REVIEW_FLAG_THRESHOLD"`
+
+	// When StartupSelfTest is set, the service writes and reads a canary document in MongoDB and
+	// publishes to "review.selftest" on boot, and refuses to start if either fails.
+	StartupSelfTest bool `mapstructure:"STARTUP_SELF_TEST"`
+
+	// Translator used to detect review languages and serve translate_to requests.
+	// Only "none" is built in; it stores no language and returns comments untranslated.
+	Translator string `mapstructure:"REVIEW_TRANSLATOR"`
+
+	// Product average ratings are cached in Redis at RedisAddr for RatingCacheTTL; an empty
+	// address disables the cache.
+	RedisAddr      string        `mapstructure:"REDIS_ADDR"`
+	RedisPassword  string        `mapstructure:"REDIS_PASSWORD"`
+	RedisDB        int           `mapstructure:"REDIS_DB"`
+	RatingCacheTTL time.Duration `mapstructure:"RATING_CACHE_TTL"`
 }
 
 func LoadConfig(appLogger *logger.Logger) (*Config, error) {
@@ -47,6 +92,36 @@ func LoadConfig(appLogger *logger.Logger) (*Config, error) {
 	viper.BindEnv("LOG_LEVEL")
 	viper.BindEnv("LOG_FORMAT")
 	viper.BindEnv("OTEL_EXPORTER_OTLP_ENDPOINT")
+	viper.BindEnv("AUTO_APPROVE_REVIEWS")
+	viper.BindEnv("AUTO_APPROVE_MIN_APPROVED_REVIEWS")
+	viper.BindEnv("AUTO_APPROVE_TRUSTED_USER_IDS")
+	viper.SetDefault("AUTO_APPROVE_REVIEWS", false)
+	viper.SetDefault("AUTO_APPROVE_MIN_APPROVED_REVIEWS", 5)
+	viper.BindEnv("REVIEW_MIN_ACCOUNT_AGE")
+	viper.BindEnv("USER_SERVICE_ADDRESS")
+	viper.SetDefault("REVIEW_MIN_ACCOUNT_AGE", "0s")
+	viper.BindEnv("REVIEW_BLOCK_SELF_REVIEW")
+	viper.BindEnv("LISTING_SERVICE_ADDRESS")
+	viper.SetDefault("REVIEW_BLOCK_SELF_REVIEW", false)
+	viper.BindEnv("REVIEW_REQUIRE_VERIFIED_PURCHASE")
+	viper.BindEnv("ORDER_SERVICE_ADDRESS")
+	viper.SetDefault("REVIEW_REQUIRE_VERIFIED_PURCHASE", false)
+	viper.BindEnv("REVIEW_LOW_RATING_THRESHOLD")
+	viper.BindEnv("REVIEW_LOW_RATING_MIN_COMMENT_LENGTH")
+	viper.SetDefault("REVIEW_LOW_RATING_THRESHOLD", 2)
+	viper.SetDefault("REVIEW_LOW_RATING_MIN_COMMENT_LENGTH", 20)
+	viper.BindEnv("REVIEW_FLAG_THRESHOLD")
+	viper.SetDefault("REVIEW_FLAG_THRESHOLD", 3)
+	viper.BindEnv("REVIEW_TRANSLATOR")
+	viper.SetDefault("REVIEW_TRANSLATOR", "none")
+	viper.BindEnv("STARTUP_SELF_TEST")
+	viper.SetDefault("STARTUP_SELF_TEST", false)
+	viper.BindEnv("REDIS_ADDR")
+	viper.BindEnv("REDIS_PASSWORD")
+	viper.BindEnv("REDIS_DB")
+	viper.BindEnv("RATING_CACHE_TTL")
+	viper.SetDefault("REDIS_DB", 0)
+	viper.SetDefault("RATING_CACHE_TTL", "1m")
 
 	var cfg Config
 	if err := viper.Unmarshal(&cfg); err != nil {
@@ -90,6 +165,36 @@ func LoadConfig(appLogger *logger.Logger) (*Config, error) {
 	if cfg.NATSURL == "" {
 		appLogger.Warn("NATS_URL is not set. NATS-dependent features may be unavailable or the application may fail if NATS is required.")
 	}
+	if cfg.ReviewMinAccountAge > 0 && cfg.UserServiceAddress == "" {
+		errMsg := "USER_SERVICE_ADDRESS must be set when REVIEW_MIN_ACCOUNT_AGE is enabled"
+		appLogger.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
+	if cfg.BlockSelfReview && cfg.ListingServiceAddress == "" {
+		errMsg := "LISTING_SERVICE_ADDRESS must be set when REVIEW_BLOCK_SELF_REVIEW is enabled"
+		appLogger.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
+	if cfg.RequireVerifiedPurchase && cfg.OrderServiceAddress == "" {
+		errMsg := "ORDER_SERVICE_ADDRESS must be set when REVIEW_REQUIRE_VERIFIED_PURCHASE is enabled"
+		appLogger.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
+	if cfg.LowRatingMinCommentLength < 0 || cfg.LowRatingThreshold < 0 || cfg.LowRatingThreshold > 5 {
+		errMsg := "REVIEW_LOW_RATING_THRESHOLD must be between 0 and 5 and REVIEW_LOW_RATING_MIN_COMMENT_LENGTH must not be negative"
+		appLogger.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
+	if cfg.RedisAddr != "" && cfg.RatingCacheTTL <= 0 {
+		errMsg := "RATING_CACHE_TTL must be positive when REDIS_ADDR is set"
+		appLogger.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
+	if cfg.Translator != "none" {
+		errMsg := fmt.Sprintf("unsupported REVIEW_TRANSLATOR '%s'", cfg.Translator)
+		appLogger.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
 	if cfg.PrometheusMetricsPort == "" {
 		appLogger.Info("PROMETHEUS_METRICS_PORT is not set. Prometheus metrics server will not start.")
 	}
@@ -105,6 +210,18 @@ func LoadConfig(appLogger *logger.Logger) (*Config, error) {
 		zap.String("log_level", cfg.LogLevel),
 		zap.String("log_format", cfg.LogFormat),
 		zap.String("otel_endpoint", cfg.OTExporterOTLPEndpoint),
+		zap.Bool("auto_approve_reviews", cfg.AutoApproveReviews),
+		zap.Int64("auto_approve_min_approved_reviews", cfg.AutoApproveMinApprovedReviews),
+		zap.Duration("review_min_account_age", cfg.ReviewMinAccountAge),
+		zap.Bool("review_block_self_review", cfg.BlockSelfReview),
+		zap.Bool("review_require_verified_purchase", cfg.RequireVerifiedPurchase),
+		zap.Int32("review_low_rating_threshold", cfg.LowRatingThreshold),
+		zap.Int("review_low_rating_min_comment_length", cfg.LowRatingMinCommentLength),
+		zap.Int("review_flag_threshold", cfg.ReviewFlagThreshold),
+		zap.String("review_translator", cfg.Translator),
+		zap.Bool("startup_self_test", cfg.StartupSelfTest),
+		zap.String("redis_addr", cfg.RedisAddr),
+		zap.Duration("rating_cache_ttl", cfg.RatingCacheTTL),
 	)
 
 	return &cfg, nil
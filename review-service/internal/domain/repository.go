@@ -2,6 +2,7 @@ package domain
 
 import (
 	"context"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
@@ -18,5 +19,61 @@ type ReviewRepository interface {
 
 	GetAverageRating(ctx context.Context, productID string) (average float64, count int32, err error)
 
+	// GetRatingDistribution counts approved reviews of the product per rating. Ratings without
+	// reviews are absent from the map.
+	GetRatingDistribution(ctx context.Context, productID string) (map[int32]int32, error)
+
 	FindByStatus(ctx context.Context, status ReviewStatus, filter ReviewFilter) ([]*Review, int64, error)
+
+	// SaveTranslation caches a translation of the review's comment. It is a no-op if the comment
+	// has changed from comment in the meantime, so a stale translation is never stored.
+	SaveTranslation(ctx context.Context, id primitive.ObjectID, comment, lang, translated string) error
+
+	// SetReply stores the official reply on the review, replacing any previous one; a nil
+	// reply removes it. Returns ErrNotFound if the review does not exist.
+	SetReply(ctx context.Context, id primitive.ObjectID, reply *ReviewReply) error
+
+	// AddFlag records the flag on the review and returns the updated review. Returns
+	// ErrAlreadyFlagged if the user has flagged it before and ErrNotFound if it does not exist.
+	AddFlag(ctx context.Context, id primitive.ObjectID, flag ReviewFlag) (*Review, error)
+
+	// FindByIDs returns the reviews with the given IDs; IDs that do not exist are skipped.
+	FindByIDs(ctx context.Context, ids []primitive.ObjectID) ([]*Review, error)
+
+	// SetStatusMany sets the status and moderation comment of all given reviews in one write
+	// and bumps their version. It returns the number of reviews matched.
+	SetStatusMany(ctx context.Context, ids []primitive.ObjectID, status ReviewStatus, moderationComment string, updatedAt time.Time) (int64, error)
+}
+
+// Translator detects the language of review text and translates it. Language codes are
+// ISO 639-1 (e.g. "en", "ru"); an empty detected language means "unknown".
+type Translator interface {
+	DetectLanguage(ctx context.Context, text string) (string, error)
+	Translate(ctx context.Context, text, sourceLang, targetLang string) (string, error)
+}
+
+// ProductDirectory looks up product details owned by listing-service.
+type ProductDirectory interface {
+	// GetProductOwner returns the seller's user ID, or "" if the product does not exist.
+	GetProductOwner(ctx context.Context, productID string) (string, error)
+}
+
+// RatingCache stores product average ratings computed by ReviewRepository.GetAverageRating.
+type RatingCache interface {
+	// GetAverageRating returns the cached rating; found is false if nothing is cached.
+	GetAverageRating(ctx context.Context, productID string) (average float64, count int32, found bool, err error)
+	SetAverageRating(ctx context.Context, productID string, average float64, count int32) error
+	// InvalidateProduct drops the cached rating after the product's reviews changed.
+	InvalidateProduct(ctx context.Context, productID string) error
+}
+
+// PurchaseHistory looks up orders owned by order-service.
+type PurchaseHistory interface {
+	// HasPurchased reports whether the user has a paid (or later) order containing the product.
+	HasPurchased(ctx context.Context, userID, productID string) (bool, error)
+}
+
+// UserDirectory looks up account details owned by user-service.
+type UserDirectory interface {
+	GetAccountCreatedAt(ctx context.Context, userID string) (time.Time, error)
 }
@@ -1,7 +1,11 @@
 package domain
 
 import (
+	"encoding/base64"
 	"errors"
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -14,6 +18,12 @@ var (
 	ErrReviewAlreadyExists = errors.New("review already exists for this user and target")
 	ErrOptimisticLock      = errors.New("optimistic lock conflict: data was modified by another process")
 	ErrRepository          = errors.New("repository error")
+	ErrAccountTooNew       = errors.New("account is too new to post reviews")
+	ErrAlreadyFlagged      = errors.New("review already flagged by this user")
+	ErrSelfReview          = errors.New("sellers cannot review their own products")
+	ErrPurchaseRequired    = errors.New("only customers who bought the product can review it")
+	// ErrTranslationUnavailable is returned by translators that cannot serve a language pair.
+	ErrTranslationUnavailable = errors.New("translation unavailable")
 )
 
 type ReviewStatus string
@@ -24,16 +34,32 @@ const (
 	ReviewStatusRejected ReviewStatus = "rejected"
 	ReviewStatusHidden   ReviewStatus = "hidden"   // Hidden by admin, not deleted
 	ReviewStatusReported ReviewStatus = "reported" // User reported, awaiting moderation
+	ReviewStatusFlagged  ReviewStatus = "flagged"  // Reached the flag threshold, awaiting admin attention
 )
 
 func (s ReviewStatus) IsValid() bool {
 	switch s {
-	case ReviewStatusPending, ReviewStatusApproved, ReviewStatusRejected, ReviewStatusHidden, ReviewStatusReported:
+	case ReviewStatusPending, ReviewStatusApproved, ReviewStatusRejected, ReviewStatusHidden, ReviewStatusReported, ReviewStatusFlagged:
 		return true
 	}
 	return false
 }
 
+// IsModeration reports whether the status comes from moderation: the review was taken out of
+// public view or a report about it is being reviewed.
+func (s ReviewStatus) IsModeration() bool {
+	switch s {
+	case ReviewStatusRejected, ReviewStatusHidden, ReviewStatusReported, ReviewStatusFlagged:
+		return true
+	}
+	return false
+}
+
+// IsFlagged reports whether moderation has taken the review out of public view or is reviewing a report.
+func (r *Review) IsFlagged() bool {
+	return r.Status.IsModeration()
+}
+
 type Review struct {
 	ID                primitive.ObjectID
 	UserID            string
@@ -44,8 +70,48 @@ type Review struct {
 	Status            ReviewStatus
 	ModerationComment string
 	CreatedAt         time.Time
-	UpdatedAt         time.Time
+	UpdatedAt         time.Time // Any change, including moderation
+	Edited            bool
+	EditedAt          *time.Time // Last change of rating/comment by the author; nil if never edited
 	Version           int64
+	Language          string            // Detected language of Comment; empty if unknown
+	Translations      map[string]string // Cached translations of Comment by target language
+	Reply             *ReviewReply      // Official reply; nil if nobody has replied
+	Flags             []ReviewFlag      // User flags, at most one per user
+	VerifiedPurchase  bool              // The author had bought the product when the review was posted
+}
+
+// MaxFlagReasonLength is the maximum length of a flag reason in characters.
+const MaxFlagReasonLength = 500
+
+// ReviewFlag records a user marking a review as inappropriate.
+type ReviewFlag struct {
+	UserID    string
+	Reason    string
+	CreatedAt time.Time
+}
+
+// MaxReplyLength is the maximum length of a review reply in characters.
+const MaxReplyLength = 2000
+
+// ReviewReply is the official response to a review, posted by an admin or the reviewed seller.
+type ReviewReply struct {
+	ResponderID   string
+	ResponderRole string
+	Text          string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// CanManageReply reports whether the caller may post, edit or delete the reply to the review:
+// admins always can, the reviewed seller only for reviews of themselves. sellerID is the
+// reviewed seller as resolved by the caller, not the SellerID the author supplied, which is
+// unchecked for product reviews.
+func (r *Review) CanManageReply(callerID, callerRole, sellerID string) bool {
+	if callerRole == "admin" {
+		return true
+	}
+	return callerID != "" && sellerID == callerID
 }
 
 func NewReview(userID, productID, sellerID, comment string, rating int32) (*Review, error) {
@@ -82,4 +148,45 @@ type ReviewFilter struct {
 	MaxRating *int32
 	SortBy    string
 	SortOrder string
+	// After switches to keyset pagination: only reviews that come after this position
+	// (newest first) are returned and Page is ignored.
+	After *ReviewCursor
+}
+
+// ReviewCursor is a keyset pagination position in the (created_at desc, _id desc) ordering.
+type ReviewCursor struct {
+	CreatedAt time.Time
+	ID        primitive.ObjectID
+}
+
+// CursorAfter returns the cursor pointing right after the given review.
+func CursorAfter(review *Review) *ReviewCursor {
+	return &ReviewCursor{CreatedAt: review.CreatedAt, ID: review.ID}
+}
+
+// Encode returns the opaque string form of the cursor handed out to clients.
+func (c *ReviewCursor) Encode() string {
+	raw := strconv.FormatInt(c.CreatedAt.UnixNano(), 10) + "_" + c.ID.Hex()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeReviewCursor parses a cursor produced by Encode.
+func DecodeReviewCursor(s string) (*ReviewCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed cursor", ErrInvalidInput)
+	}
+	nanosPart, idPart, ok := strings.Cut(string(raw), "_")
+	if !ok {
+		return nil, fmt.Errorf("%w: malformed cursor", ErrInvalidInput)
+	}
+	nanos, err := strconv.ParseInt(nanosPart, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed cursor", ErrInvalidInput)
+	}
+	id, err := primitive.ObjectIDFromHex(idPart)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed cursor", ErrInvalidInput)
+	}
+	return &ReviewCursor{CreatedAt: time.Unix(0, nanos).UTC(), ID: id}, nil
 }
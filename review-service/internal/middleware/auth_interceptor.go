@@ -23,9 +23,14 @@ const (
 	UserRoleKey UserRoleKeyType = "authenticatedUserRole"
 )
 
+// accessTokenType is the "type" claim of user-service access tokens. Refresh tokens and
+// pending 2FA tokens carry other types and must not authorize calls.
+const accessTokenType = "access"
+
 type Claims struct {
 	UserID string `json:"user_id"`
 	Role   string `json:"role"`
+	Type   string `json:"type"`
 	jwt.RegisteredClaims
 }
 
@@ -40,6 +45,12 @@ func AuthInterceptor(jwtSecret string, log *logger.Logger, publicMethods map[str
 
 		if publicMethods[info.FullMethod] {
 			log.Debug("AuthInterceptor: public method, skipping authentication", zap.String("method", info.FullMethod))
+			// Identity is optional here: a valid token lets handlers tailor the response
+			// (e.g. show moderation details to the author), an invalid or missing one is ignored.
+			if claims, ok := optionalClaims(ctx, jwtSecret); ok {
+				ctx = context.WithValue(ctx, UserIDKey, claims.UserID)
+				ctx = context.WithValue(ctx, UserRoleKey, claims.Role)
+			}
 			return handler(ctx, req)
 		}
 		log.Debug("AuthInterceptor: protected method, proceeding with authentication", zap.String("method", info.FullMethod))
@@ -91,6 +102,10 @@ func AuthInterceptor(jwtSecret string, log *logger.Logger, publicMethods map[str
 			return nil, status.Errorf(codes.Unauthenticated, "token is not valid")
 		}
 
+		if claims.Type != accessTokenType {
+			log.Warn("AuthInterceptor: token is not an access token", zap.String("method", info.FullMethod), zap.String("token_type", claims.Type))
+			return nil, status.Errorf(codes.Unauthenticated, "token of type '%s' cannot be used for authorization", claims.Type)
+		}
 		if claims.UserID == "" {
 			log.Error("AuthInterceptor: UserID not found in token claims", zap.String("method", info.FullMethod))
 			return nil, status.Errorf(codes.Unauthenticated, "UserID not found in token claims")
@@ -129,3 +144,31 @@ func AuthInterceptor(jwtSecret string, log *logger.Logger, publicMethods map[str
 		return handler(newCtx, req)
 	}
 }
+
+// optionalClaims returns the caller's claims if the request carries a valid bearer token.
+func optionalClaims(ctx context.Context, jwtSecret string) (*Claims, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, false
+	}
+	authHeaders := md.Get("authorization")
+	if len(authHeaders) == 0 {
+		return nil, false
+	}
+	parts := strings.Fields(authHeaders[0])
+	if len(parts) != 2 || !strings.EqualFold(parts[0], "Bearer") {
+		return nil, false
+	}
+
+	claims := &Claims{}
+	token, err := jwt.ParseWithClaims(parts[1], claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errors.New("unexpected signing method")
+		}
+		return []byte(jwtSecret), nil
+	})
+	if err != nil || !token.Valid || claims.Type != accessTokenType || claims.UserID == "" {
+		return nil, false
+	}
+	return claims, true
+}
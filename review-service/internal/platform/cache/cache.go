@@ -0,0 +1,93 @@
+// Package cache keeps computed product rating summaries in Redis so that hot products do not
+// run an aggregation on every request.
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Abdurahmanit/GroupProject/review-service/internal/domain"
+	"github.com/Abdurahmanit/GroupProject/review-service/internal/platform/logger"
+	"github.com/Abdurahmanit/GroupProject/review-service/internal/platform/metrics"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+const ratingKeyPrefix = "review:rating:"
+
+type cachedRating struct {
+	Average float64 `json:"average"`
+	Count   int32   `json:"count"`
+}
+
+// RatingCache implements domain.RatingCache on top of Redis.
+type RatingCache struct {
+	client  *redis.Client
+	ttl     time.Duration
+	logger  *logger.Logger
+	metrics *metrics.MetricsManager // Optional; nil when metrics are disabled
+}
+
+var _ domain.RatingCache = (*RatingCache)(nil)
+
+func NewRatingCache(client *redis.Client, ttl time.Duration, appLogger *logger.Logger, metricsManager *metrics.MetricsManager) *RatingCache {
+	return &RatingCache{
+		client:  client,
+		ttl:     ttl,
+		logger:  appLogger.Named("RatingCache"),
+		metrics: metricsManager,
+	}
+}
+
+func ratingKey(productID string) string {
+	return ratingKeyPrefix + productID
+}
+
+// GetAverageRating returns the cached summary of the product; found is false on a miss.
+func (c *RatingCache) GetAverageRating(ctx context.Context, productID string) (float64, int32, bool, error) {
+	data, err := c.client.Get(ctx, ratingKey(productID)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		c.record("miss")
+		return 0, 0, false, nil
+	}
+	if err != nil {
+		c.record("error")
+		return 0, 0, false, fmt.Errorf("failed to get cached rating of product %s: %w", productID, err)
+	}
+	var rating cachedRating
+	if err := json.Unmarshal(data, &rating); err != nil {
+		// A corrupt entry is treated as a miss and overwritten by the caller
+		c.logger.Warn("Discarding undecodable cached rating", zap.String("product_id", productID), zap.Error(err))
+		c.record("miss")
+		return 0, 0, false, nil
+	}
+	c.record("hit")
+	return rating.Average, rating.Count, true, nil
+}
+
+func (c *RatingCache) SetAverageRating(ctx context.Context, productID string, average float64, count int32) error {
+	data, err := json.Marshal(cachedRating{Average: average, Count: count})
+	if err != nil {
+		return fmt.Errorf("failed to encode rating of product %s: %w", productID, err)
+	}
+	if err := c.client.Set(ctx, ratingKey(productID), data, c.ttl).Err(); err != nil {
+		return fmt.Errorf("failed to cache rating of product %s: %w", productID, err)
+	}
+	return nil
+}
+
+func (c *RatingCache) InvalidateProduct(ctx context.Context, productID string) error {
+	if err := c.client.Del(ctx, ratingKey(productID)).Err(); err != nil {
+		return fmt.Errorf("failed to invalidate cached rating of product %s: %w", productID, err)
+	}
+	return nil
+}
+
+func (c *RatingCache) record(result string) {
+	if c.metrics != nil {
+		c.metrics.RatingCacheRequestsTotal.WithLabelValues(result).Inc()
+	}
+}
@@ -17,6 +17,9 @@ type MetricsManager struct {
 	ReviewDeletesTotal   prometheus.Counter
 	ReviewAPIErrorsTotal *prometheus.CounterVec   // To count errors by RPC method
 	ReviewAPILatency     *prometheus.HistogramVec // To measure RPC latency by method
+	PanicsRecoveredTotal *prometheus.CounterVec   // Handler panics turned into codes.Internal, by method
+	// RatingCacheRequestsTotal counts average rating cache lookups by result: hit, miss or error.
+	RatingCacheRequestsTotal *prometheus.CounterVec
 	// Add more metrics as needed, e.g., average ratings, moderation actions
 }
 
@@ -53,12 +56,26 @@ func NewMetricsManager(serviceName string) *MetricsManager {
 		Buckets:   prometheus.DefBuckets, // Default buckets: .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10
 	}, []string{"method"})
 
+	panicsRecoveredTotal := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: serviceName,
+		Name:      "panics_recovered_total",
+		Help:      "Total number of recovered panics in gRPC handlers by method.",
+	}, []string{"method"})
+
+	ratingCacheRequestsTotal := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: serviceName,
+		Name:      "rating_cache_requests_total",
+		Help:      "Total number of product average rating cache lookups by result.",
+	}, []string{"result"})
+
 	registry.MustRegister(
 		reviewsCreatedTotal,
 		reviewUpdatesTotal,
 		reviewDeletesTotal,
 		reviewAPIErrorsTotal,
 		reviewAPILatency,
+		panicsRecoveredTotal,
+		ratingCacheRequestsTotal,
 		prometheus.NewGoCollector(), // Standard Go runtime metrics
 		prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}), // Process metrics
 	)
@@ -70,6 +87,9 @@ func NewMetricsManager(serviceName string) *MetricsManager {
 		ReviewDeletesTotal:   reviewDeletesTotal,
 		ReviewAPIErrorsTotal: reviewAPIErrorsTotal,
 		ReviewAPILatency:     reviewAPILatency,
+		PanicsRecoveredTotal: panicsRecoveredTotal,
+
+		RatingCacheRequestsTotal: ratingCacheRequestsTotal,
 	}
 }
 
@@ -0,0 +1,84 @@
+// Package ttlcache keeps lookups from other services in memory for a short time so that
+// repeated calls for the same key do not go over the network.
+package ttlcache
+
+import (
+	"sync"
+	"time"
+)
+
+type entry[V any] struct {
+	value     V
+	expiresAt time.Time
+}
+
+// Cache is a size-bounded in-memory cache whose entries expire after a fixed TTL.
+// It is safe for concurrent use.
+type Cache[V any] struct {
+	ttl        time.Duration
+	maxEntries int
+	now        func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]entry[V]
+}
+
+// New returns a cache holding at most maxEntries values for ttl each.
+func New[V any](ttl time.Duration, maxEntries int) *Cache[V] {
+	return &Cache[V]{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		now:        time.Now,
+		entries:    make(map[string]entry[V]),
+	}
+}
+
+// Get returns the value cached for key, if it has not expired.
+func (c *Cache[V]) Get(key string) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	if !ok || !c.now().Before(e.expiresAt) {
+		var zero V
+		return zero, false
+	}
+	return e.value, true
+}
+
+// Set caches value for key. When the cache is full, expired entries are purged first; if none
+// expired, the entry closest to expiring is evicted, so the cache never exceeds maxEntries.
+func (c *Cache[V]) Set(key string, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	if _, exists := c.entries[key]; !exists && len(c.entries) >= c.maxEntries {
+		c.makeRoom(now)
+	}
+	c.entries[key] = entry[V]{value: value, expiresAt: now.Add(c.ttl)}
+}
+
+// makeRoom frees at least one slot. The caller holds c.mu.
+func (c *Cache[V]) makeRoom(now time.Time) {
+	var oldestKey string
+	var oldest time.Time
+	found := false
+	for k, e := range c.entries {
+		if !now.Before(e.expiresAt) {
+			delete(c.entries, k)
+			continue
+		}
+		if !found || e.expiresAt.Before(oldest) {
+			oldestKey, oldest, found = k, e.expiresAt, true
+		}
+	}
+	if found && len(c.entries) >= c.maxEntries {
+		delete(c.entries, oldestKey)
+	}
+}
+
+// Len returns the number of cached entries, including expired ones not purged yet.
+func (c *Cache[V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
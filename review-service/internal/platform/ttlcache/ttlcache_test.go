@@ -0,0 +1,76 @@
+package ttlcache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeClock struct{ t time.Time }
+
+func (c *fakeClock) now() time.Time { return c.t }
+
+func newTestCache(ttl time.Duration, maxEntries int) (*Cache[string], *fakeClock) {
+	clock := &fakeClock{t: time.Unix(1_700_000_000, 0)}
+	c := New[string](ttl, maxEntries)
+	c.now = clock.now
+	return c, clock
+}
+
+func TestCache_Expiry(t *testing.T) {
+	c, clock := newTestCache(time.Minute, 10)
+	c.Set("a", "1")
+
+	got, ok := c.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, "1", got)
+
+	clock.t = clock.t.Add(time.Minute)
+	_, ok = c.Get("a")
+	assert.False(t, ok, "entry must expire after the TTL")
+}
+
+func TestCache_PurgesExpiredEntriesWhenFull(t *testing.T) {
+	c, clock := newTestCache(time.Minute, 2)
+	c.Set("a", "1")
+	c.Set("b", "2")
+	clock.t = clock.t.Add(time.Minute)
+
+	c.Set("c", "3")
+
+	assert.Equal(t, 1, c.Len())
+	_, ok := c.Get("c")
+	assert.True(t, ok)
+}
+
+func TestCache_EvictsOldestWhenFullOfLiveEntries(t *testing.T) {
+	c, clock := newTestCache(time.Minute, 2)
+	c.Set("a", "1")
+	clock.t = clock.t.Add(time.Second)
+	c.Set("b", "2")
+
+	c.Set("c", "3")
+
+	assert.Equal(t, 2, c.Len(), "the bound must hold even when nothing has expired")
+	_, ok := c.Get("a")
+	assert.False(t, ok, "the entry closest to expiring is evicted")
+	_, ok = c.Get("b")
+	assert.True(t, ok)
+	_, ok = c.Get("c")
+	assert.True(t, ok)
+}
+
+func TestCache_UpdatingExistingKeyEvictsNothing(t *testing.T) {
+	c, _ := newTestCache(time.Minute, 2)
+	c.Set("a", "1")
+	c.Set("b", "2")
+
+	c.Set("a", "updated")
+
+	assert.Equal(t, 2, c.Len())
+	got, _ := c.Get("a")
+	assert.Equal(t, "updated", got)
+	_, ok := c.Get("b")
+	assert.True(t, ok)
+}
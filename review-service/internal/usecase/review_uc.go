@@ -4,7 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/Abdurahmanit/GroupProject/review-service/internal/adapter/messaging/nats" // For NATS publisher
 	"github.com/Abdurahmanit/GroupProject/review-service/internal/domain"
@@ -13,24 +16,287 @@ import (
 	"go.uber.org/zap"
 )
 
+// AutoApprovePolicy lets reviews from trusted authors skip the pending state.
+// An author qualifies if listed in TrustedUserIDs or, when MinApprovedReviews > 0,
+// if they already have at least that many approved reviews.
+type AutoApprovePolicy struct {
+	Enabled            bool
+	MinApprovedReviews int64
+	TrustedUserIDs     []string
+}
+
+// AccountAgeRule rejects reviews from accounts younger than MinAge, based on the
+// account creation time reported by user-service. A zero MinAge disables the rule.
+type AccountAgeRule struct {
+	MinAge time.Duration
+	Users  domain.UserDirectory
+}
+
+// CommentLengthRule requires reviews rated MaxRating or lower to explain themselves with a
+// comment of at least MinLength characters. A zero MinLength disables the rule.
+type CommentLengthRule struct {
+	MaxRating int32
+	MinLength int
+}
+
+// Check returns domain.ErrInvalidInput if a review with this rating needs a longer comment.
+func (r CommentLengthRule) Check(rating int32, comment string) error {
+	if r.MinLength <= 0 || rating > r.MaxRating {
+		return nil
+	}
+	if utf8.RuneCountInString(strings.TrimSpace(comment)) < r.MinLength {
+		return fmt.Errorf("%w: reviews rated %d or lower need a comment of at least %d characters", domain.ErrInvalidInput, r.MaxRating, r.MinLength)
+	}
+	return nil
+}
+
+// SelfReviewRule rejects reviews of a seller's own products (or of the seller themselves).
+// Product owners are resolved through Products; a disabled rule skips the lookup.
+type SelfReviewRule struct {
+	Enabled  bool
+	Products domain.ProductDirectory
+}
+
+// VerifiedPurchaseRule only accepts product reviews from users who bought the product, checked
+// through Orders, and marks accepted reviews as verified purchases. Reviews of a seller
+// without a product are not affected.
+type VerifiedPurchaseRule struct {
+	Required bool
+	Orders   domain.PurchaseHistory
+}
+
 // ReviewUsecase implements the business logic for reviews.
 type ReviewUsecase struct {
-	repo    domain.ReviewRepository
-	natsPub *nats.Publisher // NATS publisher for events
-	logger  *logger.Logger
+	repo          domain.ReviewRepository
+	natsPub       *nats.Publisher // NATS publisher for events
+	autoApprove   AutoApprovePolicy
+	accountAge    AccountAgeRule
+	selfReview    SelfReviewRule
+	purchase      VerifiedPurchaseRule
+	commentRule   CommentLengthRule
+	flagThreshold int
+	translator    domain.Translator
+	ratingCache   domain.RatingCache
+	products      domain.ProductDirectory
+	logger        *logger.Logger
 	// adminRole string // Could be configured, e.g., "admin"
 }
 
+// ReviewUsecaseOptions holds the optional rules and dependencies of a ReviewUsecase. The zero
+// value turns every rule off.
+type ReviewUsecaseOptions struct {
+	AutoApprove AutoApprovePolicy
+	AccountAge  AccountAgeRule
+	SelfReview  SelfReviewRule
+	Purchase    VerifiedPurchaseRule
+	CommentRule CommentLengthRule
+	// FlagThreshold distinct user flags move a review to ReviewStatusFlagged; 0 disables it.
+	FlagThreshold int
+	// Translator detects and translates review languages; nil disables translation.
+	Translator domain.Translator
+	// RatingCache caches average ratings; nil computes every average from the repository.
+	RatingCache domain.RatingCache
+	// Products resolves the seller of a product review; nil lets only admins reply to
+	// product reviews.
+	Products domain.ProductDirectory
+}
+
 // NewReviewUsecase creates a new ReviewUsecase.
-func NewReviewUsecase(repo domain.ReviewRepository, natsPub *nats.Publisher, log *logger.Logger) *ReviewUsecase {
+func NewReviewUsecase(repo domain.ReviewRepository, natsPub *nats.Publisher, opts ReviewUsecaseOptions, log *logger.Logger) *ReviewUsecase {
 	return &ReviewUsecase{
-		repo:    repo,
-		natsPub: natsPub,
-		logger:  log.Named("ReviewUsecase"),
+		repo:          repo,
+		natsPub:       natsPub,
+		autoApprove:   opts.AutoApprove,
+		accountAge:    opts.AccountAge,
+		selfReview:    opts.SelfReview,
+		purchase:      opts.Purchase,
+		commentRule:   opts.CommentRule,
+		flagThreshold: opts.FlagThreshold,
+		translator:    opts.Translator,
+		ratingCache:   opts.RatingCache,
+		products:      opts.Products,
+		logger:        log.Named("ReviewUsecase"),
 		// adminRole: "admin", // Default or from config
 	}
 }
 
+// qualifiesForAutoApproval reports whether the author's review can be approved without moderation.
+// Lookup errors keep the review pending.
+func (uc *ReviewUsecase) qualifiesForAutoApproval(ctx context.Context, userID string) bool {
+	if !uc.autoApprove.Enabled {
+		return false
+	}
+	for _, trustedID := range uc.autoApprove.TrustedUserIDs {
+		if trustedID == userID {
+			return true
+		}
+	}
+	if uc.autoApprove.MinApprovedReviews <= 0 {
+		return false
+	}
+
+	approved := domain.ReviewStatusApproved
+	_, approvedCount, err := uc.repo.FindByUserID(ctx, userID, domain.ReviewFilter{Page: 1, Limit: 1, Status: &approved})
+	if err != nil {
+		uc.logger.Warn("Failed to count approved reviews for auto-approval, keeping review pending", zap.String("user_id", userID), zap.Error(err))
+		return false
+	}
+	return approvedCount >= uc.autoApprove.MinApprovedReviews
+}
+
+// checkAccountAge returns domain.ErrAccountTooNew if the author's account is younger than the
+// configured minimum. If the creation time cannot be looked up, the review is rejected.
+func (uc *ReviewUsecase) checkAccountAge(ctx context.Context, userID string) error {
+	if uc.accountAge.MinAge <= 0 || uc.accountAge.Users == nil {
+		return nil
+	}
+	createdAt, err := uc.accountAge.Users.GetAccountCreatedAt(ctx, userID)
+	if err != nil {
+		uc.logger.Error("Failed to look up account age", zap.String("user_id", userID), zap.Error(err))
+		return fmt.Errorf("failed to verify account age: %w", err)
+	}
+	if age := time.Since(createdAt); age < uc.accountAge.MinAge {
+		uc.logger.Warn("Rejected review from new account", zap.String("user_id", userID), zap.Duration("account_age", age))
+		return fmt.Errorf("%w: accounts must be at least %s old", domain.ErrAccountTooNew, uc.accountAge.MinAge)
+	}
+	return nil
+}
+
+// checkSelfReview returns domain.ErrSelfReview if the author is the reviewed seller or owns the
+// reviewed product. If the owner cannot be looked up, the review is rejected.
+func (uc *ReviewUsecase) checkSelfReview(ctx context.Context, userID, productID, sellerID string) error {
+	if !uc.selfReview.Enabled {
+		return nil
+	}
+	if sellerID != "" && sellerID == userID {
+		return domain.ErrSelfReview
+	}
+	if productID == "" || uc.selfReview.Products == nil {
+		return nil
+	}
+	ownerID, err := uc.selfReview.Products.GetProductOwner(ctx, productID)
+	if err != nil {
+		uc.logger.Error("Failed to look up product owner", zap.String("product_id", productID), zap.Error(err))
+		return fmt.Errorf("failed to verify product owner: %w", err)
+	}
+	if ownerID == userID {
+		uc.logger.Warn("Rejected review of own product", zap.String("user_id", userID), zap.String("product_id", productID))
+		return domain.ErrSelfReview
+	}
+	return nil
+}
+
+// reviewedSeller returns the ID of the seller a review is about. For a product review it is
+// the listing owner from listing-service, since the seller ID sent with the review is not
+// verified; "" means no seller can be resolved.
+func (uc *ReviewUsecase) reviewedSeller(ctx context.Context, review *domain.Review) (string, error) {
+	if review.ProductID == "" {
+		return review.SellerID, nil
+	}
+	if uc.products == nil {
+		return "", nil
+	}
+	ownerID, err := uc.products.GetProductOwner(ctx, review.ProductID)
+	if err != nil {
+		uc.logger.Error("Failed to look up product owner", zap.String("product_id", review.ProductID), zap.Error(err))
+		return "", fmt.Errorf("failed to verify product owner: %w", err)
+	}
+	return ownerID, nil
+}
+
+// canManageReply reports whether the caller may manage the review's reply, see
+// domain.Review.CanManageReply. Admins skip the seller lookup.
+func (uc *ReviewUsecase) canManageReply(ctx context.Context, review *domain.Review, callerID, callerRole string) (bool, error) {
+	if review.CanManageReply(callerID, callerRole, "") {
+		return true, nil
+	}
+	sellerID, err := uc.reviewedSeller(ctx, review)
+	if err != nil {
+		return false, err
+	}
+	return review.CanManageReply(callerID, callerRole, sellerID), nil
+}
+
+// checkPurchase returns domain.ErrPurchaseRequired if verified purchases are required and the
+// user has not bought the product. It reports whether the purchase was verified; a failed
+// lookup rejects the review.
+func (uc *ReviewUsecase) checkPurchase(ctx context.Context, userID, productID string) (bool, error) {
+	if !uc.purchase.Required || productID == "" || uc.purchase.Orders == nil {
+		return false, nil
+	}
+	purchased, err := uc.purchase.Orders.HasPurchased(ctx, userID, productID)
+	if err != nil {
+		uc.logger.Error("Failed to verify purchase", zap.String("user_id", userID), zap.String("product_id", productID), zap.Error(err))
+		return false, fmt.Errorf("failed to verify purchase: %w", err)
+	}
+	if !purchased {
+		uc.logger.Warn("Rejected review without a purchase", zap.String("user_id", userID), zap.String("product_id", productID))
+		return false, domain.ErrPurchaseRequired
+	}
+	return true, nil
+}
+
+// invalidateRating drops the cached average rating of the product after one of its reviews
+// changed. Failures are only logged; the entry then expires after its TTL.
+func (uc *ReviewUsecase) invalidateRating(ctx context.Context, productID string) {
+	if uc.ratingCache == nil || productID == "" {
+		return
+	}
+	if err := uc.ratingCache.InvalidateProduct(ctx, productID); err != nil {
+		uc.logger.Warn("Failed to invalidate cached average rating", zap.String("product_id", productID), zap.Error(err))
+	}
+}
+
+// detectLanguage returns the language of text, or "" if it is unknown or detection fails.
+func (uc *ReviewUsecase) detectLanguage(ctx context.Context, text string) string {
+	if uc.translator == nil || strings.TrimSpace(text) == "" {
+		return ""
+	}
+	lang, err := uc.translator.DetectLanguage(ctx, text)
+	if err != nil {
+		uc.logger.Warn("Failed to detect review language", zap.Error(err))
+		return ""
+	}
+	return strings.ToLower(lang)
+}
+
+var languageCodeRe = regexp.MustCompile(`^[a-z]{2,3}(-[a-z0-9]{2,8})?$`)
+
+// NormalizeLanguage validates a requested translation target such as "en" or "pt-br".
+// An empty string means no translation was requested.
+func NormalizeLanguage(lang string) (string, error) {
+	lang = strings.ToLower(strings.TrimSpace(lang))
+	if lang != "" && !languageCodeRe.MatchString(lang) {
+		return "", fmt.Errorf("%w: invalid language code '%s'", domain.ErrInvalidInput, lang)
+	}
+	return lang, nil
+}
+
+// TranslateComment returns the review's comment translated to targetLang (already normalized).
+// ok is false when no translation applies: the comment is empty, already in targetLang, or the
+// translator failed, in which case callers serve the original. Translations are cached on the
+// review per target language.
+func (uc *ReviewUsecase) TranslateComment(ctx context.Context, review *domain.Review, targetLang string) (translated string, ok bool) {
+	if uc.translator == nil || targetLang == "" || strings.TrimSpace(review.Comment) == "" || review.Language == targetLang {
+		return "", false
+	}
+	if cached, found := review.Translations[targetLang]; found {
+		return cached, true
+	}
+
+	translated, err := uc.translator.Translate(ctx, review.Comment, review.Language, targetLang)
+	if err != nil {
+		if !errors.Is(err, domain.ErrTranslationUnavailable) {
+			uc.logger.Warn("Failed to translate review, serving original", zap.String("review_id", review.ID.Hex()), zap.String("target_lang", targetLang), zap.Error(err))
+		}
+		return "", false
+	}
+	if err := uc.repo.SaveTranslation(ctx, review.ID, review.Comment, targetLang, translated); err != nil {
+		uc.logger.Warn("Failed to cache review translation", zap.String("review_id", review.ID.Hex()), zap.Error(err))
+	}
+	return translated, true
+}
+
 // CreateReviewInput holds the input parameters for creating a review.
 type CreateReviewInput struct {
 	UserID    string
@@ -58,11 +324,30 @@ func (uc *ReviewUsecase) CreateReview(ctx context.Context, userID, productID, se
 	if rating < 1 || rating > 5 {
 		return nil, fmt.Errorf("%w: rating must be between 1 and 5", domain.ErrInvalidInput)
 	}
+	if err := uc.commentRule.Check(rating, comment); err != nil {
+		return nil, err
+	}
+	if err := uc.checkSelfReview(ctx, userID, productID, sellerID); err != nil {
+		return nil, err
+	}
+	if err := uc.checkAccountAge(ctx, userID); err != nil {
+		return nil, err
+	}
+	verifiedPurchase, err := uc.checkPurchase(ctx, userID, productID)
+	if err != nil {
+		return nil, err
+	}
 	review, err := domain.NewReview(userID, productID, sellerID, comment, rating)
 	if err != nil {
 		uc.logger.Error("Failed to create new domain review instance", zap.Error(err))
 		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidInput, err)
 	}
+	review.VerifiedPurchase = verifiedPurchase
+	review.Language = uc.detectLanguage(ctx, comment)
+	autoApproved := uc.qualifiesForAutoApproval(ctx, userID)
+	if autoApproved {
+		review.Status = domain.ReviewStatusApproved
+	}
 
 	err = uc.repo.Create(ctx, review)
 	if err != nil {
@@ -72,22 +357,38 @@ func (uc *ReviewUsecase) CreateReview(ctx context.Context, userID, productID, se
 		}
 		return nil, fmt.Errorf("%w: failed to create review: %v", domain.ErrRepository, err)
 	}
+	uc.invalidateRating(ctx, review.ProductID)
 
 	// Publish event to NATS
 	eventData := map[string]interface{}{
-		"review_id":  review.ID.Hex(),
-		"user_id":    review.UserID,
-		"product_id": review.ProductID,
-		"seller_id":  review.SellerID,
-		"rating":     review.Rating,
-		"status":     review.Status,
-		"created_at": review.CreatedAt.Format(time.RFC3339Nano),
+		"review_id":         review.ID.Hex(),
+		"user_id":           review.UserID,
+		"product_id":        review.ProductID,
+		"seller_id":         review.SellerID,
+		"rating":            review.Rating,
+		"status":            review.Status,
+		"verified_purchase": review.VerifiedPurchase,
+		"created_at":        review.CreatedAt.Format(time.RFC3339Nano),
 	}
 	if err := uc.natsPub.Publish(ctx, "review.created", eventData); err != nil {
 		uc.logger.Warn("Failed to publish review.created event to NATS", zap.Error(err), zap.String("review_id", review.ID.Hex()))
 	}
+	if autoApproved {
+		approvedEvent := map[string]interface{}{
+			"review_id":     review.ID.Hex(),
+			"user_id":       review.UserID,
+			"product_id":    review.ProductID,
+			"seller_id":     review.SellerID,
+			"rating":        review.Rating,
+			"auto_approved": true,
+			"approved_at":   review.CreatedAt.Format(time.RFC3339Nano),
+		}
+		if err := uc.natsPub.Publish(ctx, "review.approved", approvedEvent); err != nil {
+			uc.logger.Warn("Failed to publish review.approved event to NATS", zap.Error(err), zap.String("review_id", review.ID.Hex()))
+		}
+	}
 
-	uc.logger.Info("Review created successfully", zap.String("review_id", review.ID.Hex()))
+	uc.logger.Info("Review created successfully", zap.String("review_id", review.ID.Hex()), zap.Bool("auto_approved", autoApproved))
 	return review, nil
 }
 
@@ -130,6 +431,8 @@ func (uc *ReviewUsecase) UpdateReview(ctx context.Context, reviewID primitive.Ob
 	if comment != nil {
 		if review.Comment != *comment {
 			review.Comment = *comment
+			review.Language = uc.detectLanguage(ctx, *comment)
+			review.Translations = nil
 			updated = true
 		}
 	}
@@ -138,14 +441,21 @@ func (uc *ReviewUsecase) UpdateReview(ctx context.Context, reviewID primitive.Ob
 		uc.logger.Info("No changes detected for review update", zap.String("review_id", reviewID.Hex()))
 		return review, nil // Return existing review if no changes
 	}
+	if err := uc.commentRule.Check(review.Rating, review.Comment); err != nil {
+		return nil, err
+	}
 
-	review.UpdatedAt = time.Now().UTC()
+	now := time.Now().UTC()
+	review.UpdatedAt = now
+	review.Edited = true
+	review.EditedAt = &now
 	review.Version++
 
 	err = uc.repo.Update(ctx, review)
 	if err != nil {
 		return nil, err
 	}
+	uc.invalidateRating(ctx, review.ProductID)
 
 	// Publish event
 	eventData := map[string]interface{}{
@@ -153,6 +463,7 @@ func (uc *ReviewUsecase) UpdateReview(ctx context.Context, reviewID primitive.Ob
 		"user_id":    review.UserID,
 		"product_id": review.ProductID,
 		"updated_at": review.UpdatedAt.Format(time.RFC3339Nano),
+		"edited_at":  review.EditedAt.Format(time.RFC3339Nano),
 	}
 	uc.natsPub.Publish(ctx, "review.updated", eventData) // Error handling for NATS as in CreateReview
 
@@ -178,6 +489,7 @@ func (uc *ReviewUsecase) DeleteReview(ctx context.Context, reviewID primitive.Ob
 	if err != nil {
 		return err
 	}
+	uc.invalidateRating(ctx, review.ProductID)
 
 	// Publish event
 	eventData := map[string]interface{}{
@@ -193,8 +505,14 @@ func (uc *ReviewUsecase) DeleteReview(ctx context.Context, reviewID primitive.Ob
 }
 
 // ListReviewsByProduct retrieves reviews for a product with pagination and status filter.
-func (uc *ReviewUsecase) ListReviewsByProduct(ctx context.Context, productID string, page, limit int32, statusFilter *string) ([]*domain.Review, int64, error) {
-	uc.logger.Info("Listing reviews by product", zap.String("product_id", productID), zap.Int32("page", page), zap.Int32("limit", limit), zap.Any("status_filter", statusFilter))
+// minRating and maxRating (1-5, 0 for no bound) restrict the ratings returned; sortBy is
+// "date" or "rating" and sortOrder "desc" or "asc", both defaulting to newest first.
+// When cursor is non-empty keyset pagination is used and page is ignored; it is only
+// available for the default order. The returned next cursor is empty when there are no
+// more reviews or another order was requested.
+func (uc *ReviewUsecase) ListReviewsByProduct(ctx context.Context, productID string, page, limit int32, statusFilter *string, cursor string, minRating, maxRating int32, sortBy, sortOrder string) ([]*domain.Review, int64, string, error) {
+	uc.logger.Info("Listing reviews by product", zap.String("product_id", productID), zap.Int32("page", page), zap.Int32("limit", limit), zap.Any("status_filter", statusFilter), zap.Bool("cursor", cursor != ""),
+		zap.Int32("min_rating", minRating), zap.Int32("max_rating", maxRating), zap.String("sort_by", sortBy), zap.String("sort_order", sortOrder))
 
 	if page < 1 {
 		page = 1
@@ -212,15 +530,64 @@ func (uc *ReviewUsecase) ListReviewsByProduct(ctx context.Context, productID str
 	if statusFilter != nil {
 		s := domain.ReviewStatus(*statusFilter)
 		if !s.IsValid() {
-			return nil, 0, fmt.Errorf("%w: invalid status filter value '%s'", domain.ErrInvalidInput, *statusFilter)
+			return nil, 0, "", fmt.Errorf("%w: invalid status filter value '%s'", domain.ErrInvalidInput, *statusFilter)
 		}
 		filter.Status = &s
 	} else {
 		approvedStatus := domain.ReviewStatusApproved
 		filter.Status = &approvedStatus
 	}
+	if minRating < 0 || minRating > 5 || maxRating < 0 || maxRating > 5 {
+		return nil, 0, "", fmt.Errorf("%w: min_rating and max_rating must be between 1 and 5", domain.ErrInvalidInput)
+	}
+	if minRating > 0 && maxRating > 0 && minRating > maxRating {
+		return nil, 0, "", fmt.Errorf("%w: min_rating %d is greater than max_rating %d", domain.ErrInvalidInput, minRating, maxRating)
+	}
+	if minRating > 0 {
+		filter.MinRating = &minRating
+	}
+	if maxRating > 0 {
+		filter.MaxRating = &maxRating
+	}
+	switch sortBy {
+	case "", "date":
+	case "rating":
+		filter.SortBy = "rating"
+	case "helpfulness":
+		return nil, 0, "", fmt.Errorf("%w: sorting by helpfulness is not available, reviews have no helpfulness votes", domain.ErrInvalidInput)
+	default:
+		return nil, 0, "", fmt.Errorf("%w: invalid sort_by value '%s'", domain.ErrInvalidInput, sortBy)
+	}
+	switch sortOrder {
+	case "", "desc":
+	case "asc":
+		filter.SortOrder = "asc"
+	default:
+		return nil, 0, "", fmt.Errorf("%w: invalid sort_order value '%s'", domain.ErrInvalidInput, sortOrder)
+	}
+	// The cursor encodes a (created_at, _id) position, so it only works for newest first
+	keyset := filter.SortBy == "" && filter.SortOrder == ""
+	if cursor != "" && !keyset {
+		return nil, 0, "", fmt.Errorf("%w: cursor can only be used with the default sort order", domain.ErrInvalidInput)
+	}
+	if cursor != "" {
+		after, err := domain.DecodeReviewCursor(cursor)
+		if err != nil {
+			return nil, 0, "", err
+		}
+		filter.After = after
+	}
+
+	reviews, total, err := uc.repo.FindByProductID(ctx, productID, filter)
+	if err != nil {
+		return nil, 0, "", err
+	}
 
-	return uc.repo.FindByProductID(ctx, productID, filter)
+	nextCursor := ""
+	if keyset && len(reviews) == int(limit) {
+		nextCursor = domain.CursorAfter(reviews[len(reviews)-1]).Encode()
+	}
+	return reviews, total, nextCursor, nil
 }
 
 // ListReviewsByUser retrieves reviews by a user with pagination.
@@ -268,6 +635,7 @@ func (uc *ReviewUsecase) ModerateReview(ctx context.Context, reviewID primitive.
 	if err != nil {
 		return nil, err
 	}
+	uc.invalidateRating(ctx, review.ProductID)
 
 	// Publish event
 	eventData := map[string]interface{}{
@@ -285,11 +653,325 @@ func (uc *ReviewUsecase) ModerateReview(ctx context.Context, reviewID primitive.
 	return review, nil
 }
 
+// MaxBulkModerationSize caps the number of reviews BulkModerateReviews accepts in one call.
+const MaxBulkModerationSize = 100
+
+// BulkModerationResult is the outcome of moderating one review in a bulk call. Err is nil on
+// success; reviews that already had the requested status and comment count as successful.
+type BulkModerationResult struct {
+	ReviewID string
+	Err      error
+}
+
+// BulkModerateReviews applies the same status and moderation comment to many reviews with a
+// single write. Unknown or malformed IDs are reported per review instead of failing the call;
+// a review.moderated event is published for every review whose status or comment changed.
+func (uc *ReviewUsecase) BulkModerateReviews(ctx context.Context, adminUserID string, reviewIDs []string, newStatus domain.ReviewStatus, moderationComment string) ([]BulkModerationResult, error) {
+	uc.logger.Info("Bulk moderating reviews",
+		zap.String("admin_user_id", adminUserID),
+		zap.Int("count", len(reviewIDs)),
+		zap.String("new_status", string(newStatus)))
+
+	if !newStatus.IsValid() {
+		return nil, fmt.Errorf("%w: invalid new status '%s'", domain.ErrInvalidInput, newStatus)
+	}
+	if len(reviewIDs) == 0 {
+		return nil, fmt.Errorf("%w: at least one review ID is required", domain.ErrInvalidInput)
+	}
+	if len(reviewIDs) > MaxBulkModerationSize {
+		return nil, fmt.Errorf("%w: at most %d reviews can be moderated at once", domain.ErrInvalidInput, MaxBulkModerationSize)
+	}
+
+	results := make([]BulkModerationResult, len(reviewIDs))
+	// Duplicate IDs share the outcome of their first occurrence.
+	indexesByID := make(map[primitive.ObjectID][]int, len(reviewIDs))
+	var ids []primitive.ObjectID
+	for i, rawID := range reviewIDs {
+		results[i].ReviewID = rawID
+		id, err := primitive.ObjectIDFromHex(rawID)
+		if err != nil {
+			results[i].Err = fmt.Errorf("%w: invalid review ID format", domain.ErrInvalidInput)
+			continue
+		}
+		if _, seen := indexesByID[id]; !seen {
+			ids = append(ids, id)
+		}
+		indexesByID[id] = append(indexesByID[id], i)
+	}
+	setErr := func(id primitive.ObjectID, err error) {
+		for _, i := range indexesByID[id] {
+			results[i].Err = err
+		}
+	}
+
+	existing, err := uc.repo.FindByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	found := make(map[primitive.ObjectID]bool, len(existing))
+	var toUpdate []*domain.Review
+	for _, review := range existing {
+		found[review.ID] = true
+		if review.Status == newStatus && review.ModerationComment == moderationComment {
+			continue
+		}
+		toUpdate = append(toUpdate, review)
+	}
+	for _, id := range ids {
+		if !found[id] {
+			setErr(id, domain.ErrNotFound)
+		}
+	}
+	if len(toUpdate) == 0 {
+		return results, nil
+	}
+
+	updateIDs := make([]primitive.ObjectID, len(toUpdate))
+	for i, review := range toUpdate {
+		updateIDs[i] = review.ID
+	}
+	now := time.Now().UTC()
+	if _, err := uc.repo.SetStatusMany(ctx, updateIDs, newStatus, moderationComment, now); err != nil {
+		uc.logger.Error("Bulk moderation write failed", zap.Error(err), zap.Int("count", len(updateIDs)))
+		for _, id := range updateIDs {
+			setErr(id, err)
+		}
+		return results, nil
+	}
+
+	invalidated := make(map[string]bool)
+	for _, review := range toUpdate {
+		if !invalidated[review.ProductID] {
+			uc.invalidateRating(ctx, review.ProductID)
+			invalidated[review.ProductID] = true
+		}
+		eventData := map[string]interface{}{
+			"review_id":          review.ID.Hex(),
+			"moderator_id":       adminUserID,
+			"product_id":         review.ProductID,
+			"old_status":         review.Status,
+			"new_status":         newStatus,
+			"moderation_comment": moderationComment,
+			"moderated_at":       now.Format(time.RFC3339Nano),
+		}
+		uc.natsPub.Publish(ctx, "review.moderated", eventData)
+	}
+
+	uc.logger.Info("Reviews bulk moderated", zap.Int("updated", len(toUpdate)), zap.String("new_status", string(newStatus)))
+	return results, nil
+}
+
+// ReplyToReview posts the official reply to a review, or edits it if one already exists.
+// Only admins and the reviewed seller may reply; a review has at most one reply.
+func (uc *ReviewUsecase) ReplyToReview(ctx context.Context, reviewID primitive.ObjectID, responderID, responderRole, text string) (*domain.Review, error) {
+	uc.logger.Info("Replying to review", zap.String("review_id", reviewID.Hex()), zap.String("responder_id", responderID), zap.String("responder_role", responderRole))
+
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil, fmt.Errorf("%w: reply text cannot be empty", domain.ErrInvalidInput)
+	}
+	if utf8.RuneCountInString(text) > domain.MaxReplyLength {
+		return nil, fmt.Errorf("%w: reply must be at most %d characters", domain.ErrInvalidInput, domain.MaxReplyLength)
+	}
+
+	review, err := uc.repo.GetByID(ctx, reviewID)
+	if err != nil {
+		return nil, err
+	}
+	allowed, err := uc.canManageReply(ctx, review, responderID, responderRole)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		uc.logger.Warn("User forbidden to reply to review", zap.String("review_id", reviewID.Hex()), zap.String("responder_id", responderID))
+		return nil, domain.ErrForbidden
+	}
+
+	now := time.Now().UTC()
+	edited := review.Reply != nil
+	reply := &domain.ReviewReply{
+		ResponderID:   responderID,
+		ResponderRole: responderRole,
+		Text:          text,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	if edited {
+		reply.CreatedAt = review.Reply.CreatedAt
+	}
+	if err := uc.repo.SetReply(ctx, reviewID, reply); err != nil {
+		return nil, err
+	}
+	review.Reply = reply
+	review.UpdatedAt = now
+
+	eventData := map[string]interface{}{
+		"review_id":    review.ID.Hex(),
+		"product_id":   review.ProductID,
+		"user_id":      review.UserID,
+		"responder_id": responderID,
+		"edited":       edited,
+		"replied_at":   now.Format(time.RFC3339Nano),
+	}
+	if err := uc.natsPub.Publish(ctx, "review.replied", eventData); err != nil {
+		uc.logger.Warn("Failed to publish review.replied event to NATS", zap.Error(err), zap.String("review_id", review.ID.Hex()))
+	}
+
+	uc.logger.Info("Review reply saved", zap.String("review_id", review.ID.Hex()), zap.Bool("edited", edited))
+	return review, nil
+}
+
+// DeleteReviewReply removes the official reply from a review. Returns domain.ErrNotFound if the
+// review has no reply.
+func (uc *ReviewUsecase) DeleteReviewReply(ctx context.Context, reviewID primitive.ObjectID, responderID, responderRole string) error {
+	uc.logger.Info("Deleting review reply", zap.String("review_id", reviewID.Hex()), zap.String("responder_id", responderID))
+
+	review, err := uc.repo.GetByID(ctx, reviewID)
+	if err != nil {
+		return err
+	}
+	if review.Reply == nil {
+		return fmt.Errorf("%w: review has no reply", domain.ErrNotFound)
+	}
+	allowed, err := uc.canManageReply(ctx, review, responderID, responderRole)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		uc.logger.Warn("User forbidden to delete review reply", zap.String("review_id", reviewID.Hex()), zap.String("responder_id", responderID))
+		return domain.ErrForbidden
+	}
+	return uc.repo.SetReply(ctx, reviewID, nil)
+}
+
+// FlagReview records a user's flag on a review. Each user can flag a review once; authors cannot
+// flag their own. When the number of flags reaches the configured threshold, the review is moved
+// to ReviewStatusFlagged for admin attention.
+func (uc *ReviewUsecase) FlagReview(ctx context.Context, reviewID primitive.ObjectID, userID, reason string) error {
+	uc.logger.Info("Flagging review", zap.String("review_id", reviewID.Hex()), zap.String("user_id", userID))
+
+	reason = strings.TrimSpace(reason)
+	if utf8.RuneCountInString(reason) > domain.MaxFlagReasonLength {
+		return fmt.Errorf("%w: reason must be at most %d characters", domain.ErrInvalidInput, domain.MaxFlagReasonLength)
+	}
+
+	review, err := uc.repo.GetByID(ctx, reviewID)
+	if err != nil {
+		return err
+	}
+	if review.UserID == userID {
+		return fmt.Errorf("%w: cannot flag your own review", domain.ErrForbidden)
+	}
+
+	now := time.Now().UTC()
+	review, err = uc.repo.AddFlag(ctx, reviewID, domain.ReviewFlag{UserID: userID, Reason: reason, CreatedAt: now})
+	if err != nil {
+		return err
+	}
+
+	// Only the flag that crosses the threshold changes the status, so a review an admin has
+	// approved after looking at it is not pulled again by later flags.
+	autoFlagged := false
+	if uc.flagThreshold > 0 && len(review.Flags) == uc.flagThreshold && !review.IsFlagged() {
+		review.Status = domain.ReviewStatusFlagged
+		review.UpdatedAt = now
+		review.Version++
+		if err := uc.repo.Update(ctx, review); err != nil {
+			return err
+		}
+		uc.invalidateRating(ctx, review.ProductID)
+		autoFlagged = true
+	}
+
+	eventData := map[string]interface{}{
+		"review_id":    review.ID.Hex(),
+		"product_id":   review.ProductID,
+		"flagged_by":   userID,
+		"reason":       reason,
+		"flag_count":   len(review.Flags),
+		"auto_flagged": autoFlagged,
+		"flagged_at":   now.Format(time.RFC3339Nano),
+	}
+	if err := uc.natsPub.Publish(ctx, "review.flagged", eventData); err != nil {
+		uc.logger.Warn("Failed to publish review.flagged event to NATS", zap.Error(err), zap.String("review_id", review.ID.Hex()))
+	}
+
+	uc.logger.Info("Review flagged", zap.String("review_id", review.ID.Hex()), zap.Int("flag_count", len(review.Flags)), zap.Bool("auto_flagged", autoFlagged))
+	return nil
+}
+
+// ListPendingReviews returns the moderation queue: reviews awaiting approval, oldest first,
+// so moderators work through them in the order they were submitted.
+func (uc *ReviewUsecase) ListPendingReviews(ctx context.Context, adminUserID string, page, limit int32) ([]*domain.Review, int64, error) {
+	uc.logger.Info("Listing pending reviews", zap.String("admin_user_id", adminUserID), zap.Int32("page", page), zap.Int32("limit", limit))
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = 10
+	} else if limit > 100 {
+		limit = 100
+	}
+	filter := domain.ReviewFilter{Page: page, Limit: limit, SortBy: "created_at", SortOrder: "asc"}
+	return uc.repo.FindByStatus(ctx, domain.ReviewStatusPending, filter)
+}
+
+// ListFlaggedReviews lists reviews awaiting admin attention after reaching the flag threshold,
+// oldest first.
+func (uc *ReviewUsecase) ListFlaggedReviews(ctx context.Context, page, limit int32) ([]*domain.Review, int64, error) {
+	uc.logger.Info("Listing flagged reviews", zap.Int32("page", page), zap.Int32("limit", limit))
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = 10
+	} else if limit > 100 {
+		limit = 100
+	}
+	filter := domain.ReviewFilter{Page: page, Limit: limit, SortBy: "updated_at", SortOrder: "asc"}
+	return uc.repo.FindByStatus(ctx, domain.ReviewStatusFlagged, filter)
+}
+
 // GetProductAverageRating calculates and returns the average rating for a product.
 func (uc *ReviewUsecase) GetProductAverageRating(ctx context.Context, productID string) (float64, int32, error) {
 	uc.logger.Info("Getting average rating for product", zap.String("product_id", productID))
 	if productID == "" {
 		return 0, 0, fmt.Errorf("%w: productID cannot be empty", domain.ErrInvalidInput)
 	}
-	return uc.repo.GetAverageRating(ctx, productID)
+	if uc.ratingCache != nil {
+		average, count, found, err := uc.ratingCache.GetAverageRating(ctx, productID)
+		if err != nil {
+			uc.logger.Warn("Failed to read cached average rating, computing it", zap.String("product_id", productID), zap.Error(err))
+		} else if found {
+			return average, count, nil
+		}
+	}
+	average, count, err := uc.repo.GetAverageRating(ctx, productID)
+	if err != nil {
+		return 0, 0, err
+	}
+	if uc.ratingCache != nil {
+		if err := uc.ratingCache.SetAverageRating(ctx, productID, average, count); err != nil {
+			uc.logger.Warn("Failed to cache average rating", zap.String("product_id", productID), zap.Error(err))
+		}
+	}
+	return average, count, nil
+}
+
+// GetProductRatingDistribution returns the number of approved reviews of a product for each
+// rating from 1 to 5. All five ratings are always present, with zero counts if needed.
+func (uc *ReviewUsecase) GetProductRatingDistribution(ctx context.Context, productID string) (map[int32]int32, error) {
+	uc.logger.Info("Getting rating distribution for product", zap.String("product_id", productID))
+	if productID == "" {
+		return nil, fmt.Errorf("%w: productID cannot be empty", domain.ErrInvalidInput)
+	}
+	counts, err := uc.repo.GetRatingDistribution(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	distribution := make(map[int32]int32, 5)
+	for rating := int32(1); rating <= 5; rating++ {
+		distribution[rating] = counts[rating]
+	}
+	return distribution, nil
 }
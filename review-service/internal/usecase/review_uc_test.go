@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Abdurahmanit/GroupProject/review-service/internal/domain"
+	"github.com/Abdurahmanit/GroupProject/review-service/internal/platform/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakePurchaseHistory struct {
+	purchased bool
+	err       error
+	calls     int
+}
+
+func (f *fakePurchaseHistory) HasPurchased(ctx context.Context, userID, productID string) (bool, error) {
+	f.calls++
+	return f.purchased, f.err
+}
+
+func newPurchaseTestUsecase(rule VerifiedPurchaseRule) *ReviewUsecase {
+	return NewReviewUsecase(nil, nil, ReviewUsecaseOptions{Purchase: rule}, logger.NewLogger())
+}
+
+func TestVerifiedPurchaseRule(t *testing.T) {
+	lookupErr := errors.New("order-service unavailable")
+	tests := []struct {
+		name         string
+		required     bool
+		orders       *fakePurchaseHistory
+		productID    string
+		wantVerified bool
+		wantErr      error
+		wantLookups  int
+	}{
+		{"bought the product", true, &fakePurchaseHistory{purchased: true}, "p1", true, nil, 1},
+		{"did not buy the product", true, &fakePurchaseHistory{}, "p1", false, domain.ErrPurchaseRequired, 1},
+		{"lookup fails", true, &fakePurchaseHistory{err: lookupErr}, "p1", false, lookupErr, 1},
+		{"seller review without product", true, &fakePurchaseHistory{}, "", false, nil, 0},
+		{"rule disabled", false, &fakePurchaseHistory{}, "p1", false, nil, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := newPurchaseTestUsecase(VerifiedPurchaseRule{Required: tt.required, Orders: tt.orders})
+
+			verified, err := uc.checkPurchase(context.Background(), "u1", tt.productID)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantVerified, verified)
+			assert.Equal(t, tt.wantLookups, tt.orders.calls)
+		})
+	}
+}
+
+func TestCreateReview_RejectsWithoutPurchase(t *testing.T) {
+	// The rejection has to happen before the repository (nil here) is touched.
+	uc := newPurchaseTestUsecase(VerifiedPurchaseRule{Required: true, Orders: &fakePurchaseHistory{}})
+
+	_, err := uc.CreateReview(context.Background(), "u1", "p1", "", "Great bike", 5)
+
+	assert.ErrorIs(t, err, domain.ErrPurchaseRequired)
+}
@@ -34,7 +34,21 @@ type Review struct {
 	Status            string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`                                                // e.g., "pending", "approved", "rejected", "hidden"
 	ModerationComment string                 `protobuf:"bytes,8,opt,name=moderation_comment,json=moderationComment,proto3" json:"moderation_comment,omitempty"` // Optional comment from moderator
 	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Last change of any kind, including moderation
+	Edited            bool                   `protobuf:"varint,11,opt,name=edited,proto3" json:"edited,omitempty"`                       // True once the author changed rating or comment
+	EditedAt          *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`    // Last author edit; unset if never edited
+	// Moderation details: status, flagged and moderation_comment are only filled in for the
+	// review's author and admins; other callers always see empty / false.
+	Flagged  bool   `protobuf:"varint,13,opt,name=flagged,proto3" json:"flagged,omitempty"`  // Rejected, hidden or reported
+	Language string `protobuf:"bytes,14,opt,name=language,proto3" json:"language,omitempty"` // Detected language of comment; empty if unknown
+	// Set only when translate_to was requested and a translation was available;
+	// comment always holds the original text.
+	TranslatedComment string        `protobuf:"bytes,15,opt,name=translated_comment,json=translatedComment,proto3" json:"translated_comment,omitempty"`
+	TranslatedTo      string        `protobuf:"bytes,16,opt,name=translated_to,json=translatedTo,proto3" json:"translated_to,omitempty"`
+	Reply             *ReviewReply  `protobuf:"bytes,17,opt,name=reply,proto3" json:"reply,omitempty"`                                                // Official reply; unset if nobody has replied
+	FlagCount         int32         `protobuf:"varint,18,opt,name=flag_count,json=flagCount,proto3" json:"flag_count,omitempty"`                      // Moderation detail, see flagged
+	Flags             []*ReviewFlag `protobuf:"bytes,19,rep,name=flags,proto3" json:"flags,omitempty"`                                                // Only filled in by ListFlaggedReviews
+	VerifiedPurchase  bool          `protobuf:"varint,20,opt,name=verified_purchase,json=verifiedPurchase,proto3" json:"verified_purchase,omitempty"` // The author had bought the product when posting
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -139,6 +153,212 @@ func (x *Review) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Review) GetEdited() bool {
+	if x != nil {
+		return x.Edited
+	}
+	return false
+}
+
+func (x *Review) GetEditedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EditedAt
+	}
+	return nil
+}
+
+func (x *Review) GetFlagged() bool {
+	if x != nil {
+		return x.Flagged
+	}
+	return false
+}
+
+func (x *Review) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *Review) GetTranslatedComment() string {
+	if x != nil {
+		return x.TranslatedComment
+	}
+	return ""
+}
+
+func (x *Review) GetTranslatedTo() string {
+	if x != nil {
+		return x.TranslatedTo
+	}
+	return ""
+}
+
+func (x *Review) GetReply() *ReviewReply {
+	if x != nil {
+		return x.Reply
+	}
+	return nil
+}
+
+func (x *Review) GetFlagCount() int32 {
+	if x != nil {
+		return x.FlagCount
+	}
+	return 0
+}
+
+func (x *Review) GetFlags() []*ReviewFlag {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *Review) GetVerifiedPurchase() bool {
+	if x != nil {
+		return x.VerifiedPurchase
+	}
+	return false
+}
+
+type ReviewFlag struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewFlag) Reset() {
+	*x = ReviewFlag{}
+	mi := &file_review_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewFlag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewFlag) ProtoMessage() {}
+
+func (x *ReviewFlag) ProtoReflect() protoreflect.Message {
+	mi := &file_review_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewFlag.ProtoReflect.Descriptor instead.
+func (*ReviewFlag) Descriptor() ([]byte, []int) {
+	return file_review_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReviewFlag) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReviewFlag) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ReviewFlag) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ReviewReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResponderId   string                 `protobuf:"bytes,1,opt,name=responder_id,json=responderId,proto3" json:"responder_id,omitempty"`
+	ResponderRole string                 `protobuf:"bytes,2,opt,name=responder_role,json=responderRole,proto3" json:"responder_role,omitempty"` // Role claim of the responder, e.g. "admin"
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Equals created_at until the reply is edited
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewReply) Reset() {
+	*x = ReviewReply{}
+	mi := &file_review_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewReply) ProtoMessage() {}
+
+func (x *ReviewReply) ProtoReflect() protoreflect.Message {
+	mi := &file_review_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewReply.ProtoReflect.Descriptor instead.
+func (*ReviewReply) Descriptor() ([]byte, []int) {
+	return file_review_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReviewReply) GetResponderId() string {
+	if x != nil {
+		return x.ResponderId
+	}
+	return ""
+}
+
+func (x *ReviewReply) GetResponderRole() string {
+	if x != nil {
+		return x.ResponderRole
+	}
+	return ""
+}
+
+func (x *ReviewReply) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ReviewReply) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ReviewReply) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
 type CreateReviewRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Author ID (should match authenticated user or be set by an admin if they can create on behalf)
@@ -152,7 +372,7 @@ type CreateReviewRequest struct {
 
 func (x *CreateReviewRequest) Reset() {
 	*x = CreateReviewRequest{}
-	mi := &file_review_proto_msgTypes[1]
+	mi := &file_review_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -164,7 +384,7 @@ func (x *CreateReviewRequest) String() string {
 func (*CreateReviewRequest) ProtoMessage() {}
 
 func (x *CreateReviewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_review_proto_msgTypes[1]
+	mi := &file_review_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -177,7 +397,7 @@ func (x *CreateReviewRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateReviewRequest.ProtoReflect.Descriptor instead.
 func (*CreateReviewRequest) Descriptor() ([]byte, []int) {
-	return file_review_proto_rawDescGZIP(), []int{1}
+	return file_review_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreateReviewRequest) GetUserId() string {
@@ -218,13 +438,14 @@ func (x *CreateReviewRequest) GetComment() string {
 type GetReviewRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ReviewId      string                 `protobuf:"bytes,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	TranslateTo   string                 `protobuf:"bytes,2,opt,name=translate_to,json=translateTo,proto3" json:"translate_to,omitempty"` // Optional: language code (e.g. "en") to translate the comment into
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetReviewRequest) Reset() {
 	*x = GetReviewRequest{}
-	mi := &file_review_proto_msgTypes[2]
+	mi := &file_review_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -236,7 +457,7 @@ func (x *GetReviewRequest) String() string {
 func (*GetReviewRequest) ProtoMessage() {}
 
 func (x *GetReviewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_review_proto_msgTypes[2]
+	mi := &file_review_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -249,7 +470,7 @@ func (x *GetReviewRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetReviewRequest.ProtoReflect.Descriptor instead.
 func (*GetReviewRequest) Descriptor() ([]byte, []int) {
-	return file_review_proto_rawDescGZIP(), []int{2}
+	return file_review_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetReviewRequest) GetReviewId() string {
@@ -259,6 +480,13 @@ func (x *GetReviewRequest) GetReviewId() string {
 	return ""
 }
 
+func (x *GetReviewRequest) GetTranslateTo() string {
+	if x != nil {
+		return x.TranslateTo
+	}
+	return ""
+}
+
 type UpdateReviewRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ReviewId      string                 `protobuf:"bytes,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
@@ -271,7 +499,7 @@ type UpdateReviewRequest struct {
 
 func (x *UpdateReviewRequest) Reset() {
 	*x = UpdateReviewRequest{}
-	mi := &file_review_proto_msgTypes[3]
+	mi := &file_review_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -283,7 +511,7 @@ func (x *UpdateReviewRequest) String() string {
 func (*UpdateReviewRequest) ProtoMessage() {}
 
 func (x *UpdateReviewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_review_proto_msgTypes[3]
+	mi := &file_review_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -296,7 +524,7 @@ func (x *UpdateReviewRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateReviewRequest.ProtoReflect.Descriptor instead.
 func (*UpdateReviewRequest) Descriptor() ([]byte, []int) {
-	return file_review_proto_rawDescGZIP(), []int{3}
+	return file_review_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *UpdateReviewRequest) GetReviewId() string {
@@ -337,7 +565,7 @@ type DeleteReviewRequest struct {
 
 func (x *DeleteReviewRequest) Reset() {
 	*x = DeleteReviewRequest{}
-	mi := &file_review_proto_msgTypes[4]
+	mi := &file_review_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -349,7 +577,7 @@ func (x *DeleteReviewRequest) String() string {
 func (*DeleteReviewRequest) ProtoMessage() {}
 
 func (x *DeleteReviewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_review_proto_msgTypes[4]
+	mi := &file_review_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -362,7 +590,7 @@ func (x *DeleteReviewRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteReviewRequest.ProtoReflect.Descriptor instead.
 func (*DeleteReviewRequest) Descriptor() ([]byte, []int) {
-	return file_review_proto_rawDescGZIP(), []int{4}
+	return file_review_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *DeleteReviewRequest) GetReviewId() string {
@@ -384,14 +612,20 @@ type ListReviewsByProductRequest struct {
 	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
 	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                                    // For pagination
 	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                                  // For pagination
-	StatusFilter  string                 `protobuf:"bytes,4,opt,name=status_filter,json=statusFilter,proto3" json:"status_filter,omitempty"` // Optional: e.g., "approved" to only show approved reviews
+	StatusFilter  string                 `protobuf:"bytes,4,opt,name=status_filter,json=statusFilter,proto3" json:"status_filter,omitempty"` // Optional: e.g., "approved"; moderation statuses (rejected, hidden, reported, flagged) are admin-only
+	Cursor        string                 `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`                                 // Optional: next_cursor from a previous response; enables keyset pagination and ignores page
+	TranslateTo   string                 `protobuf:"bytes,6,opt,name=translate_to,json=translateTo,proto3" json:"translate_to,omitempty"`    // Optional: language code to translate comments into
+	MinRating     int32                  `protobuf:"varint,7,opt,name=min_rating,json=minRating,proto3" json:"min_rating,omitempty"`         // Optional: 1-5, 0 means no lower bound
+	MaxRating     int32                  `protobuf:"varint,8,opt,name=max_rating,json=maxRating,proto3" json:"max_rating,omitempty"`         // Optional: 1-5, 0 means no upper bound
+	SortBy        string                 `protobuf:"bytes,9,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`                   // Optional: "date" (default) or "rating"; cursor requires date, newest first
+	SortOrder     string                 `protobuf:"bytes,10,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`         // Optional: "desc" (default) or "asc"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListReviewsByProductRequest) Reset() {
 	*x = ListReviewsByProductRequest{}
-	mi := &file_review_proto_msgTypes[5]
+	mi := &file_review_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -403,7 +637,7 @@ func (x *ListReviewsByProductRequest) String() string {
 func (*ListReviewsByProductRequest) ProtoMessage() {}
 
 func (x *ListReviewsByProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_review_proto_msgTypes[5]
+	mi := &file_review_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -416,7 +650,7 @@ func (x *ListReviewsByProductRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListReviewsByProductRequest.ProtoReflect.Descriptor instead.
 func (*ListReviewsByProductRequest) Descriptor() ([]byte, []int) {
-	return file_review_proto_rawDescGZIP(), []int{5}
+	return file_review_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListReviewsByProductRequest) GetProductId() string {
@@ -447,18 +681,61 @@ func (x *ListReviewsByProductRequest) GetStatusFilter() string {
 	return ""
 }
 
+func (x *ListReviewsByProductRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListReviewsByProductRequest) GetTranslateTo() string {
+	if x != nil {
+		return x.TranslateTo
+	}
+	return ""
+}
+
+func (x *ListReviewsByProductRequest) GetMinRating() int32 {
+	if x != nil {
+		return x.MinRating
+	}
+	return 0
+}
+
+func (x *ListReviewsByProductRequest) GetMaxRating() int32 {
+	if x != nil {
+		return x.MaxRating
+	}
+	return 0
+}
+
+func (x *ListReviewsByProductRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *ListReviewsByProductRequest) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ""
+}
+
 type ListReviewsByUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // User whose reviews are being requested (should match authenticated user)
 	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
 	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	TranslateTo   string                 `protobuf:"bytes,4,opt,name=translate_to,json=translateTo,proto3" json:"translate_to,omitempty"` // Optional: language code to translate comments into
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListReviewsByUserRequest) Reset() {
 	*x = ListReviewsByUserRequest{}
-	mi := &file_review_proto_msgTypes[6]
+	mi := &file_review_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -470,7 +747,7 @@ func (x *ListReviewsByUserRequest) String() string {
 func (*ListReviewsByUserRequest) ProtoMessage() {}
 
 func (x *ListReviewsByUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_review_proto_msgTypes[6]
+	mi := &file_review_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -483,7 +760,7 @@ func (x *ListReviewsByUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListReviewsByUserRequest.ProtoReflect.Descriptor instead.
 func (*ListReviewsByUserRequest) Descriptor() ([]byte, []int) {
-	return file_review_proto_rawDescGZIP(), []int{6}
+	return file_review_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListReviewsByUserRequest) GetUserId() string {
@@ -507,19 +784,27 @@ func (x *ListReviewsByUserRequest) GetLimit() int32 {
 	return 0
 }
 
+func (x *ListReviewsByUserRequest) GetTranslateTo() string {
+	if x != nil {
+		return x.TranslateTo
+	}
+	return ""
+}
+
 type ListReviewsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Reviews       []*Review              `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"`
 	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // Total number of reviews matching the query (for pagination)
 	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
 	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,5,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // Cursor for the next page (ListReviewsByProduct only); empty when there are no more results
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListReviewsResponse) Reset() {
 	*x = ListReviewsResponse{}
-	mi := &file_review_proto_msgTypes[7]
+	mi := &file_review_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -531,7 +816,7 @@ func (x *ListReviewsResponse) String() string {
 func (*ListReviewsResponse) ProtoMessage() {}
 
 func (x *ListReviewsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_review_proto_msgTypes[7]
+	mi := &file_review_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -544,7 +829,7 @@ func (x *ListReviewsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListReviewsResponse.ProtoReflect.Descriptor instead.
 func (*ListReviewsResponse) Descriptor() ([]byte, []int) {
-	return file_review_proto_rawDescGZIP(), []int{7}
+	return file_review_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ListReviewsResponse) GetReviews() []*Review {
@@ -575,6 +860,13 @@ func (x *ListReviewsResponse) GetLimit() int32 {
 	return 0
 }
 
+func (x *ListReviewsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 type GetProductAverageRatingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
@@ -584,7 +876,7 @@ type GetProductAverageRatingRequest struct {
 
 func (x *GetProductAverageRatingRequest) Reset() {
 	*x = GetProductAverageRatingRequest{}
-	mi := &file_review_proto_msgTypes[8]
+	mi := &file_review_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -596,7 +888,7 @@ func (x *GetProductAverageRatingRequest) String() string {
 func (*GetProductAverageRatingRequest) ProtoMessage() {}
 
 func (x *GetProductAverageRatingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_review_proto_msgTypes[8]
+	mi := &file_review_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -609,7 +901,7 @@ func (x *GetProductAverageRatingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProductAverageRatingRequest.ProtoReflect.Descriptor instead.
 func (*GetProductAverageRatingRequest) Descriptor() ([]byte, []int) {
-	return file_review_proto_rawDescGZIP(), []int{8}
+	return file_review_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetProductAverageRatingRequest) GetProductId() string {
@@ -630,7 +922,7 @@ type ProductAverageRatingResponse struct {
 
 func (x *ProductAverageRatingResponse) Reset() {
 	*x = ProductAverageRatingResponse{}
-	mi := &file_review_proto_msgTypes[9]
+	mi := &file_review_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -642,7 +934,7 @@ func (x *ProductAverageRatingResponse) String() string {
 func (*ProductAverageRatingResponse) ProtoMessage() {}
 
 func (x *ProductAverageRatingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_review_proto_msgTypes[9]
+	mi := &file_review_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -655,7 +947,7 @@ func (x *ProductAverageRatingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProductAverageRatingResponse.ProtoReflect.Descriptor instead.
 func (*ProductAverageRatingResponse) Descriptor() ([]byte, []int) {
-	return file_review_proto_rawDescGZIP(), []int{9}
+	return file_review_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ProductAverageRatingResponse) GetProductId() string {
@@ -679,19 +971,175 @@ func (x *ProductAverageRatingResponse) GetReviewCount() int32 {
 	return 0
 }
 
-type ModerateReviewRequest struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	ReviewId          string                 `protobuf:"bytes,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
-	AdminId           string                 `protobuf:"bytes,2,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`                               // ID of the admin performing the action (from token)
-	NewStatus         string                 `protobuf:"bytes,3,opt,name=new_status,json=newStatus,proto3" json:"new_status,omitempty"`                         // New status (e.g., "approved", "rejected", "hidden")
-	ModerationComment string                 `protobuf:"bytes,4,opt,name=moderation_comment,json=moderationComment,proto3" json:"moderation_comment,omitempty"` // Optional comment from the moderator
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type GetProductRatingDistributionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ModerateReviewRequest) Reset() {
+func (x *GetProductRatingDistributionRequest) Reset() {
+	*x = GetProductRatingDistributionRequest{}
+	mi := &file_review_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductRatingDistributionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductRatingDistributionRequest) ProtoMessage() {}
+
+func (x *GetProductRatingDistributionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductRatingDistributionRequest.ProtoReflect.Descriptor instead.
+func (*GetProductRatingDistributionRequest) Descriptor() ([]byte, []int) {
+	return file_review_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetProductRatingDistributionRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type RatingBucket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rating        int32                  `protobuf:"varint,1,opt,name=rating,proto3" json:"rating,omitempty"` // 1-5
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`   // Approved reviews with this rating
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RatingBucket) Reset() {
+	*x = RatingBucket{}
+	mi := &file_review_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RatingBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RatingBucket) ProtoMessage() {}
+
+func (x *RatingBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_review_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RatingBucket.ProtoReflect.Descriptor instead.
+func (*RatingBucket) Descriptor() ([]byte, []int) {
+	return file_review_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RatingBucket) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *RatingBucket) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ProductRatingDistributionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Buckets       []*RatingBucket        `protobuf:"bytes,2,rep,name=buckets,proto3" json:"buckets,omitempty"`                             // Always five buckets, ordered from 1 to 5 stars
+	ReviewCount   int32                  `protobuf:"varint,3,opt,name=review_count,json=reviewCount,proto3" json:"review_count,omitempty"` // Sum of all bucket counts
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductRatingDistributionResponse) Reset() {
+	*x = ProductRatingDistributionResponse{}
+	mi := &file_review_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductRatingDistributionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductRatingDistributionResponse) ProtoMessage() {}
+
+func (x *ProductRatingDistributionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductRatingDistributionResponse.ProtoReflect.Descriptor instead.
+func (*ProductRatingDistributionResponse) Descriptor() ([]byte, []int) {
+	return file_review_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ProductRatingDistributionResponse) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ProductRatingDistributionResponse) GetBuckets() []*RatingBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+func (x *ProductRatingDistributionResponse) GetReviewCount() int32 {
+	if x != nil {
+		return x.ReviewCount
+	}
+	return 0
+}
+
+type ModerateReviewRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId          string                 `protobuf:"bytes,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	AdminId           string                 `protobuf:"bytes,2,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`                               // ID of the admin performing the action (from token)
+	NewStatus         string                 `protobuf:"bytes,3,opt,name=new_status,json=newStatus,proto3" json:"new_status,omitempty"`                         // New status (e.g., "approved", "rejected", "hidden")
+	ModerationComment string                 `protobuf:"bytes,4,opt,name=moderation_comment,json=moderationComment,proto3" json:"moderation_comment,omitempty"` // Optional comment from the moderator
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ModerateReviewRequest) Reset() {
 	*x = ModerateReviewRequest{}
-	mi := &file_review_proto_msgTypes[10]
+	mi := &file_review_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -703,7 +1151,7 @@ func (x *ModerateReviewRequest) String() string {
 func (*ModerateReviewRequest) ProtoMessage() {}
 
 func (x *ModerateReviewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_review_proto_msgTypes[10]
+	mi := &file_review_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -716,7 +1164,7 @@ func (x *ModerateReviewRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModerateReviewRequest.ProtoReflect.Descriptor instead.
 func (*ModerateReviewRequest) Descriptor() ([]byte, []int) {
-	return file_review_proto_rawDescGZIP(), []int{10}
+	return file_review_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ModerateReviewRequest) GetReviewId() string {
@@ -747,11 +1195,443 @@ func (x *ModerateReviewRequest) GetModerationComment() string {
 	return ""
 }
 
+type BulkModerateReviewsRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ReviewIds         []string               `protobuf:"bytes,1,rep,name=review_ids,json=reviewIds,proto3" json:"review_ids,omitempty"` // Up to 100 IDs
+	NewStatus         string                 `protobuf:"bytes,2,opt,name=new_status,json=newStatus,proto3" json:"new_status,omitempty"`
+	ModerationComment string                 `protobuf:"bytes,3,opt,name=moderation_comment,json=moderationComment,proto3" json:"moderation_comment,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *BulkModerateReviewsRequest) Reset() {
+	*x = BulkModerateReviewsRequest{}
+	mi := &file_review_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkModerateReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkModerateReviewsRequest) ProtoMessage() {}
+
+func (x *BulkModerateReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkModerateReviewsRequest.ProtoReflect.Descriptor instead.
+func (*BulkModerateReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_review_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BulkModerateReviewsRequest) GetReviewIds() []string {
+	if x != nil {
+		return x.ReviewIds
+	}
+	return nil
+}
+
+func (x *BulkModerateReviewsRequest) GetNewStatus() string {
+	if x != nil {
+		return x.NewStatus
+	}
+	return ""
+}
+
+func (x *BulkModerateReviewsRequest) GetModerationComment() string {
+	if x != nil {
+		return x.ModerationComment
+	}
+	return ""
+}
+
+type BulkModerationResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId      string                 `protobuf:"bytes,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"` // Why moderation failed; empty on success
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkModerationResult) Reset() {
+	*x = BulkModerationResult{}
+	mi := &file_review_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkModerationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkModerationResult) ProtoMessage() {}
+
+func (x *BulkModerationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_review_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkModerationResult.ProtoReflect.Descriptor instead.
+func (*BulkModerationResult) Descriptor() ([]byte, []int) {
+	return file_review_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *BulkModerationResult) GetReviewId() string {
+	if x != nil {
+		return x.ReviewId
+	}
+	return ""
+}
+
+func (x *BulkModerationResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BulkModerationResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type BulkModerateReviewsResponse struct {
+	state          protoimpl.MessageState  `protogen:"open.v1"`
+	Results        []*BulkModerationResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // In request order
+	SucceededCount int32                   `protobuf:"varint,2,opt,name=succeeded_count,json=succeededCount,proto3" json:"succeeded_count,omitempty"`
+	FailedCount    int32                   `protobuf:"varint,3,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BulkModerateReviewsResponse) Reset() {
+	*x = BulkModerateReviewsResponse{}
+	mi := &file_review_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkModerateReviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkModerateReviewsResponse) ProtoMessage() {}
+
+func (x *BulkModerateReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkModerateReviewsResponse.ProtoReflect.Descriptor instead.
+func (*BulkModerateReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_review_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BulkModerateReviewsResponse) GetResults() []*BulkModerationResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BulkModerateReviewsResponse) GetSucceededCount() int32 {
+	if x != nil {
+		return x.SucceededCount
+	}
+	return 0
+}
+
+func (x *BulkModerateReviewsResponse) GetFailedCount() int32 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+type ReplyToReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId      string                 `protobuf:"bytes,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"` // Replaces the existing reply, if any
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplyToReviewRequest) Reset() {
+	*x = ReplyToReviewRequest{}
+	mi := &file_review_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplyToReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplyToReviewRequest) ProtoMessage() {}
+
+func (x *ReplyToReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplyToReviewRequest.ProtoReflect.Descriptor instead.
+func (*ReplyToReviewRequest) Descriptor() ([]byte, []int) {
+	return file_review_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ReplyToReviewRequest) GetReviewId() string {
+	if x != nil {
+		return x.ReviewId
+	}
+	return ""
+}
+
+func (x *ReplyToReviewRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type DeleteReviewReplyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId      string                 `protobuf:"bytes,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReviewReplyRequest) Reset() {
+	*x = DeleteReviewReplyRequest{}
+	mi := &file_review_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReviewReplyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReviewReplyRequest) ProtoMessage() {}
+
+func (x *DeleteReviewReplyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReviewReplyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteReviewReplyRequest) Descriptor() ([]byte, []int) {
+	return file_review_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeleteReviewReplyRequest) GetReviewId() string {
+	if x != nil {
+		return x.ReviewId
+	}
+	return ""
+}
+
+type FlagReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId      string                 `protobuf:"bytes,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"` // Optional
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlagReviewRequest) Reset() {
+	*x = FlagReviewRequest{}
+	mi := &file_review_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlagReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlagReviewRequest) ProtoMessage() {}
+
+func (x *FlagReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlagReviewRequest.ProtoReflect.Descriptor instead.
+func (*FlagReviewRequest) Descriptor() ([]byte, []int) {
+	return file_review_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *FlagReviewRequest) GetReviewId() string {
+	if x != nil {
+		return x.ReviewId
+	}
+	return ""
+}
+
+func (x *FlagReviewRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ListPendingReviewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingReviewsRequest) Reset() {
+	*x = ListPendingReviewsRequest{}
+	mi := &file_review_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingReviewsRequest) ProtoMessage() {}
+
+func (x *ListPendingReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingReviewsRequest.ProtoReflect.Descriptor instead.
+func (*ListPendingReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_review_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListPendingReviewsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListPendingReviewsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListFlaggedReviewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFlaggedReviewsRequest) Reset() {
+	*x = ListFlaggedReviewsRequest{}
+	mi := &file_review_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFlaggedReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFlaggedReviewsRequest) ProtoMessage() {}
+
+func (x *ListFlaggedReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFlaggedReviewsRequest.ProtoReflect.Descriptor instead.
+func (*ListFlaggedReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_review_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListFlaggedReviewsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListFlaggedReviewsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
 var File_review_proto protoreflect.FileDescriptor
 
 const file_review_proto_rawDesc = "" +
 	"\n" +
-	"\freview.proto\x12\x06review\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xdc\x02\n" +
+	"\freview.proto\x12\x06review\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xd8\x05\n" +
 	"\x06Review\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
@@ -766,16 +1646,42 @@ const file_review_proto_rawDesc = "" +
 	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
 	"updated_at\x18\n" +
-	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x9c\x01\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x16\n" +
+	"\x06edited\x18\v \x01(\bR\x06edited\x127\n" +
+	"\tedited_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\beditedAt\x12\x18\n" +
+	"\aflagged\x18\r \x01(\bR\aflagged\x12\x1a\n" +
+	"\blanguage\x18\x0e \x01(\tR\blanguage\x12-\n" +
+	"\x12translated_comment\x18\x0f \x01(\tR\x11translatedComment\x12#\n" +
+	"\rtranslated_to\x18\x10 \x01(\tR\ftranslatedTo\x12)\n" +
+	"\x05reply\x18\x11 \x01(\v2\x13.review.ReviewReplyR\x05reply\x12\x1d\n" +
+	"\n" +
+	"flag_count\x18\x12 \x01(\x05R\tflagCount\x12(\n" +
+	"\x05flags\x18\x13 \x03(\v2\x12.review.ReviewFlagR\x05flags\x12+\n" +
+	"\x11verified_purchase\x18\x14 \x01(\bR\x10verifiedPurchase\"x\n" +
+	"\n" +
+	"ReviewFlag\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xe1\x01\n" +
+	"\vReviewReply\x12!\n" +
+	"\fresponder_id\x18\x01 \x01(\tR\vresponderId\x12%\n" +
+	"\x0eresponder_role\x18\x02 \x01(\tR\rresponderRole\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x9c\x01\n" +
 	"\x13CreateReviewRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x02 \x01(\tR\tproductId\x12\x1b\n" +
 	"\tseller_id\x18\x03 \x01(\tR\bsellerId\x12\x16\n" +
 	"\x06rating\x18\x04 \x01(\x05R\x06rating\x12\x18\n" +
-	"\acomment\x18\x05 \x01(\tR\acomment\"/\n" +
+	"\acomment\x18\x05 \x01(\tR\acomment\"R\n" +
 	"\x10GetReviewRequest\x12\x1b\n" +
-	"\treview_id\x18\x01 \x01(\tR\breviewId\"}\n" +
+	"\treview_id\x18\x01 \x01(\tR\breviewId\x12!\n" +
+	"\ftranslate_to\x18\x02 \x01(\tR\vtranslateTo\"}\n" +
 	"\x13UpdateReviewRequest\x12\x1b\n" +
 	"\treview_id\x18\x01 \x01(\tR\breviewId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
@@ -783,22 +1689,35 @@ const file_review_proto_rawDesc = "" +
 	"\acomment\x18\x04 \x01(\tR\acomment\"K\n" +
 	"\x13DeleteReviewRequest\x12\x1b\n" +
 	"\treview_id\x18\x01 \x01(\tR\breviewId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x8b\x01\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\xbc\x02\n" +
 	"\x1bListReviewsByProductRequest\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tR\tproductId\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
 	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12#\n" +
-	"\rstatus_filter\x18\x04 \x01(\tR\fstatusFilter\"]\n" +
+	"\rstatus_filter\x18\x04 \x01(\tR\fstatusFilter\x12\x16\n" +
+	"\x06cursor\x18\x05 \x01(\tR\x06cursor\x12!\n" +
+	"\ftranslate_to\x18\x06 \x01(\tR\vtranslateTo\x12\x1d\n" +
+	"\n" +
+	"min_rating\x18\a \x01(\x05R\tminRating\x12\x1d\n" +
+	"\n" +
+	"max_rating\x18\b \x01(\x05R\tmaxRating\x12\x17\n" +
+	"\asort_by\x18\t \x01(\tR\x06sortBy\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\n" +
+	" \x01(\tR\tsortOrder\"\x80\x01\n" +
 	"\x18ListReviewsByUserRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
-	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\x7f\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12!\n" +
+	"\ftranslate_to\x18\x04 \x01(\tR\vtranslateTo\"\xa0\x01\n" +
 	"\x13ListReviewsResponse\x12(\n" +
 	"\areviews\x18\x01 \x03(\v2\x0e.review.ReviewR\areviews\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
-	"\x05limit\x18\x04 \x01(\x05R\x05limit\"?\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x1f\n" +
+	"\vnext_cursor\x18\x05 \x01(\tR\n" +
+	"nextCursor\"?\n" +
 	"\x1eGetProductAverageRatingRequest\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tR\tproductId\"\x87\x01\n" +
@@ -806,13 +1725,52 @@ const file_review_proto_rawDesc = "" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tR\tproductId\x12%\n" +
 	"\x0eaverage_rating\x18\x02 \x01(\x01R\raverageRating\x12!\n" +
+	"\freview_count\x18\x03 \x01(\x05R\vreviewCount\"D\n" +
+	"#GetProductRatingDistributionRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"<\n" +
+	"\fRatingBucket\x12\x16\n" +
+	"\x06rating\x18\x01 \x01(\x05R\x06rating\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"\x95\x01\n" +
+	"!ProductRatingDistributionResponse\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12.\n" +
+	"\abuckets\x18\x02 \x03(\v2\x14.review.RatingBucketR\abuckets\x12!\n" +
 	"\freview_count\x18\x03 \x01(\x05R\vreviewCount\"\x9d\x01\n" +
 	"\x15ModerateReviewRequest\x12\x1b\n" +
 	"\treview_id\x18\x01 \x01(\tR\breviewId\x12\x19\n" +
 	"\badmin_id\x18\x02 \x01(\tR\aadminId\x12\x1d\n" +
 	"\n" +
 	"new_status\x18\x03 \x01(\tR\tnewStatus\x12-\n" +
-	"\x12moderation_comment\x18\x04 \x01(\tR\x11moderationComment2\xdd\x04\n" +
+	"\x12moderation_comment\x18\x04 \x01(\tR\x11moderationComment\"\x89\x01\n" +
+	"\x1aBulkModerateReviewsRequest\x12\x1d\n" +
+	"\n" +
+	"review_ids\x18\x01 \x03(\tR\treviewIds\x12\x1d\n" +
+	"\n" +
+	"new_status\x18\x02 \x01(\tR\tnewStatus\x12-\n" +
+	"\x12moderation_comment\x18\x03 \x01(\tR\x11moderationComment\"c\n" +
+	"\x14BulkModerationResult\x12\x1b\n" +
+	"\treview_id\x18\x01 \x01(\tR\breviewId\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"\xa1\x01\n" +
+	"\x1bBulkModerateReviewsResponse\x126\n" +
+	"\aresults\x18\x01 \x03(\v2\x1c.review.BulkModerationResultR\aresults\x12'\n" +
+	"\x0fsucceeded_count\x18\x02 \x01(\x05R\x0esucceededCount\x12!\n" +
+	"\ffailed_count\x18\x03 \x01(\x05R\vfailedCount\"G\n" +
+	"\x14ReplyToReviewRequest\x12\x1b\n" +
+	"\treview_id\x18\x01 \x01(\tR\breviewId\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\"7\n" +
+	"\x18DeleteReviewReplyRequest\x12\x1b\n" +
+	"\treview_id\x18\x01 \x01(\tR\breviewId\"H\n" +
+	"\x11FlagReviewRequest\x12\x1b\n" +
+	"\treview_id\x18\x01 \x01(\tR\breviewId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"E\n" +
+	"\x19ListPendingReviewsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"E\n" +
+	"\x19ListFlaggedReviewsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit2\xb0\t\n" +
 	"\rReviewService\x12;\n" +
 	"\fCreateReview\x12\x1b.review.CreateReviewRequest\x1a\x0e.review.Review\x125\n" +
 	"\tGetReview\x12\x18.review.GetReviewRequest\x1a\x0e.review.Review\x12;\n" +
@@ -820,8 +1778,16 @@ const file_review_proto_rawDesc = "" +
 	"\fDeleteReview\x12\x1b.review.DeleteReviewRequest\x1a\x16.google.protobuf.Empty\x12X\n" +
 	"\x14ListReviewsByProduct\x12#.review.ListReviewsByProductRequest\x1a\x1b.review.ListReviewsResponse\x12R\n" +
 	"\x11ListReviewsByUser\x12 .review.ListReviewsByUserRequest\x1a\x1b.review.ListReviewsResponse\x12g\n" +
-	"\x17GetProductAverageRating\x12&.review.GetProductAverageRatingRequest\x1a$.review.ProductAverageRatingResponse\x12?\n" +
-	"\x0eModerateReview\x12\x1d.review.ModerateReviewRequest\x1a\x0e.review.ReviewB\\ZZgithub.com/Abdurahmanit/GroupProject/review-service/genproto/review_service;review_serviceb\x06proto3"
+	"\x17GetProductAverageRating\x12&.review.GetProductAverageRatingRequest\x1a$.review.ProductAverageRatingResponse\x12v\n" +
+	"\x1cGetProductRatingDistribution\x12+.review.GetProductRatingDistributionRequest\x1a).review.ProductRatingDistributionResponse\x12?\n" +
+	"\x0eModerateReview\x12\x1d.review.ModerateReviewRequest\x1a\x0e.review.Review\x12^\n" +
+	"\x13BulkModerateReviews\x12\".review.BulkModerateReviewsRequest\x1a#.review.BulkModerateReviewsResponse\x12=\n" +
+	"\rReplyToReview\x12\x1c.review.ReplyToReviewRequest\x1a\x0e.review.Review\x12M\n" +
+	"\x11DeleteReviewReply\x12 .review.DeleteReviewReplyRequest\x1a\x16.google.protobuf.Empty\x12?\n" +
+	"\n" +
+	"FlagReview\x12\x19.review.FlagReviewRequest\x1a\x16.google.protobuf.Empty\x12T\n" +
+	"\x12ListPendingReviews\x12!.review.ListPendingReviewsRequest\x1a\x1b.review.ListReviewsResponse\x12T\n" +
+	"\x12ListFlaggedReviews\x12!.review.ListFlaggedReviewsRequest\x1a\x1b.review.ListReviewsResponseB\\ZZgithub.com/Abdurahmanit/GroupProject/review-service/genproto/review_service;review_serviceb\x06proto3"
 
 var (
 	file_review_proto_rawDescOnce sync.Once
@@ -835,47 +1801,82 @@ func file_review_proto_rawDescGZIP() []byte {
 	return file_review_proto_rawDescData
 }
 
-var file_review_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_review_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_review_proto_goTypes = []any{
-	(*Review)(nil),                         // 0: review.Review
-	(*CreateReviewRequest)(nil),            // 1: review.CreateReviewRequest
-	(*GetReviewRequest)(nil),               // 2: review.GetReviewRequest
-	(*UpdateReviewRequest)(nil),            // 3: review.UpdateReviewRequest
-	(*DeleteReviewRequest)(nil),            // 4: review.DeleteReviewRequest
-	(*ListReviewsByProductRequest)(nil),    // 5: review.ListReviewsByProductRequest
-	(*ListReviewsByUserRequest)(nil),       // 6: review.ListReviewsByUserRequest
-	(*ListReviewsResponse)(nil),            // 7: review.ListReviewsResponse
-	(*GetProductAverageRatingRequest)(nil), // 8: review.GetProductAverageRatingRequest
-	(*ProductAverageRatingResponse)(nil),   // 9: review.ProductAverageRatingResponse
-	(*ModerateReviewRequest)(nil),          // 10: review.ModerateReviewRequest
-	(*timestamppb.Timestamp)(nil),          // 11: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                  // 12: google.protobuf.Empty
+	(*Review)(nil),                              // 0: review.Review
+	(*ReviewFlag)(nil),                          // 1: review.ReviewFlag
+	(*ReviewReply)(nil),                         // 2: review.ReviewReply
+	(*CreateReviewRequest)(nil),                 // 3: review.CreateReviewRequest
+	(*GetReviewRequest)(nil),                    // 4: review.GetReviewRequest
+	(*UpdateReviewRequest)(nil),                 // 5: review.UpdateReviewRequest
+	(*DeleteReviewRequest)(nil),                 // 6: review.DeleteReviewRequest
+	(*ListReviewsByProductRequest)(nil),         // 7: review.ListReviewsByProductRequest
+	(*ListReviewsByUserRequest)(nil),            // 8: review.ListReviewsByUserRequest
+	(*ListReviewsResponse)(nil),                 // 9: review.ListReviewsResponse
+	(*GetProductAverageRatingRequest)(nil),      // 10: review.GetProductAverageRatingRequest
+	(*ProductAverageRatingResponse)(nil),        // 11: review.ProductAverageRatingResponse
+	(*GetProductRatingDistributionRequest)(nil), // 12: review.GetProductRatingDistributionRequest
+	(*RatingBucket)(nil),                        // 13: review.RatingBucket
+	(*ProductRatingDistributionResponse)(nil),   // 14: review.ProductRatingDistributionResponse
+	(*ModerateReviewRequest)(nil),               // 15: review.ModerateReviewRequest
+	(*BulkModerateReviewsRequest)(nil),          // 16: review.BulkModerateReviewsRequest
+	(*BulkModerationResult)(nil),                // 17: review.BulkModerationResult
+	(*BulkModerateReviewsResponse)(nil),         // 18: review.BulkModerateReviewsResponse
+	(*ReplyToReviewRequest)(nil),                // 19: review.ReplyToReviewRequest
+	(*DeleteReviewReplyRequest)(nil),            // 20: review.DeleteReviewReplyRequest
+	(*FlagReviewRequest)(nil),                   // 21: review.FlagReviewRequest
+	(*ListPendingReviewsRequest)(nil),           // 22: review.ListPendingReviewsRequest
+	(*ListFlaggedReviewsRequest)(nil),           // 23: review.ListFlaggedReviewsRequest
+	(*timestamppb.Timestamp)(nil),               // 24: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                       // 25: google.protobuf.Empty
 }
 var file_review_proto_depIdxs = []int32{
-	11, // 0: review.Review.created_at:type_name -> google.protobuf.Timestamp
-	11, // 1: review.Review.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 2: review.ListReviewsResponse.reviews:type_name -> review.Review
-	1,  // 3: review.ReviewService.CreateReview:input_type -> review.CreateReviewRequest
-	2,  // 4: review.ReviewService.GetReview:input_type -> review.GetReviewRequest
-	3,  // 5: review.ReviewService.UpdateReview:input_type -> review.UpdateReviewRequest
-	4,  // 6: review.ReviewService.DeleteReview:input_type -> review.DeleteReviewRequest
-	5,  // 7: review.ReviewService.ListReviewsByProduct:input_type -> review.ListReviewsByProductRequest
-	6,  // 8: review.ReviewService.ListReviewsByUser:input_type -> review.ListReviewsByUserRequest
-	8,  // 9: review.ReviewService.GetProductAverageRating:input_type -> review.GetProductAverageRatingRequest
-	10, // 10: review.ReviewService.ModerateReview:input_type -> review.ModerateReviewRequest
-	0,  // 11: review.ReviewService.CreateReview:output_type -> review.Review
-	0,  // 12: review.ReviewService.GetReview:output_type -> review.Review
-	0,  // 13: review.ReviewService.UpdateReview:output_type -> review.Review
-	12, // 14: review.ReviewService.DeleteReview:output_type -> google.protobuf.Empty
-	7,  // 15: review.ReviewService.ListReviewsByProduct:output_type -> review.ListReviewsResponse
-	7,  // 16: review.ReviewService.ListReviewsByUser:output_type -> review.ListReviewsResponse
-	9,  // 17: review.ReviewService.GetProductAverageRating:output_type -> review.ProductAverageRatingResponse
-	0,  // 18: review.ReviewService.ModerateReview:output_type -> review.Review
-	11, // [11:19] is the sub-list for method output_type
-	3,  // [3:11] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	24, // 0: review.Review.created_at:type_name -> google.protobuf.Timestamp
+	24, // 1: review.Review.updated_at:type_name -> google.protobuf.Timestamp
+	24, // 2: review.Review.edited_at:type_name -> google.protobuf.Timestamp
+	2,  // 3: review.Review.reply:type_name -> review.ReviewReply
+	1,  // 4: review.Review.flags:type_name -> review.ReviewFlag
+	24, // 5: review.ReviewFlag.created_at:type_name -> google.protobuf.Timestamp
+	24, // 6: review.ReviewReply.created_at:type_name -> google.protobuf.Timestamp
+	24, // 7: review.ReviewReply.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 8: review.ListReviewsResponse.reviews:type_name -> review.Review
+	13, // 9: review.ProductRatingDistributionResponse.buckets:type_name -> review.RatingBucket
+	17, // 10: review.BulkModerateReviewsResponse.results:type_name -> review.BulkModerationResult
+	3,  // 11: review.ReviewService.CreateReview:input_type -> review.CreateReviewRequest
+	4,  // 12: review.ReviewService.GetReview:input_type -> review.GetReviewRequest
+	5,  // 13: review.ReviewService.UpdateReview:input_type -> review.UpdateReviewRequest
+	6,  // 14: review.ReviewService.DeleteReview:input_type -> review.DeleteReviewRequest
+	7,  // 15: review.ReviewService.ListReviewsByProduct:input_type -> review.ListReviewsByProductRequest
+	8,  // 16: review.ReviewService.ListReviewsByUser:input_type -> review.ListReviewsByUserRequest
+	10, // 17: review.ReviewService.GetProductAverageRating:input_type -> review.GetProductAverageRatingRequest
+	12, // 18: review.ReviewService.GetProductRatingDistribution:input_type -> review.GetProductRatingDistributionRequest
+	15, // 19: review.ReviewService.ModerateReview:input_type -> review.ModerateReviewRequest
+	16, // 20: review.ReviewService.BulkModerateReviews:input_type -> review.BulkModerateReviewsRequest
+	19, // 21: review.ReviewService.ReplyToReview:input_type -> review.ReplyToReviewRequest
+	20, // 22: review.ReviewService.DeleteReviewReply:input_type -> review.DeleteReviewReplyRequest
+	21, // 23: review.ReviewService.FlagReview:input_type -> review.FlagReviewRequest
+	22, // 24: review.ReviewService.ListPendingReviews:input_type -> review.ListPendingReviewsRequest
+	23, // 25: review.ReviewService.ListFlaggedReviews:input_type -> review.ListFlaggedReviewsRequest
+	0,  // 26: review.ReviewService.CreateReview:output_type -> review.Review
+	0,  // 27: review.ReviewService.GetReview:output_type -> review.Review
+	0,  // 28: review.ReviewService.UpdateReview:output_type -> review.Review
+	25, // 29: review.ReviewService.DeleteReview:output_type -> google.protobuf.Empty
+	9,  // 30: review.ReviewService.ListReviewsByProduct:output_type -> review.ListReviewsResponse
+	9,  // 31: review.ReviewService.ListReviewsByUser:output_type -> review.ListReviewsResponse
+	11, // 32: review.ReviewService.GetProductAverageRating:output_type -> review.ProductAverageRatingResponse
+	14, // 33: review.ReviewService.GetProductRatingDistribution:output_type -> review.ProductRatingDistributionResponse
+	0,  // 34: review.ReviewService.ModerateReview:output_type -> review.Review
+	18, // 35: review.ReviewService.BulkModerateReviews:output_type -> review.BulkModerateReviewsResponse
+	0,  // 36: review.ReviewService.ReplyToReview:output_type -> review.Review
+	25, // 37: review.ReviewService.DeleteReviewReply:output_type -> google.protobuf.Empty
+	25, // 38: review.ReviewService.FlagReview:output_type -> google.protobuf.Empty
+	9,  // 39: review.ReviewService.ListPendingReviews:output_type -> review.ListReviewsResponse
+	9,  // 40: review.ReviewService.ListFlaggedReviews:output_type -> review.ListReviewsResponse
+	26, // [26:41] is the sub-list for method output_type
+	11, // [11:26] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_review_proto_init() }
@@ -889,7 +1890,7 @@ func file_review_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_review_proto_rawDesc), len(file_review_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
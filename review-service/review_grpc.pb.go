@@ -20,14 +20,21 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ReviewService_CreateReview_FullMethodName            = "/review.ReviewService/CreateReview"
-	ReviewService_GetReview_FullMethodName               = "/review.ReviewService/GetReview"
-	ReviewService_UpdateReview_FullMethodName            = "/review.ReviewService/UpdateReview"
-	ReviewService_DeleteReview_FullMethodName            = "/review.ReviewService/DeleteReview"
-	ReviewService_ListReviewsByProduct_FullMethodName    = "/review.ReviewService/ListReviewsByProduct"
-	ReviewService_ListReviewsByUser_FullMethodName       = "/review.ReviewService/ListReviewsByUser"
-	ReviewService_GetProductAverageRating_FullMethodName = "/review.ReviewService/GetProductAverageRating"
-	ReviewService_ModerateReview_FullMethodName          = "/review.ReviewService/ModerateReview"
+	ReviewService_CreateReview_FullMethodName                 = "/review.ReviewService/CreateReview"
+	ReviewService_GetReview_FullMethodName                    = "/review.ReviewService/GetReview"
+	ReviewService_UpdateReview_FullMethodName                 = "/review.ReviewService/UpdateReview"
+	ReviewService_DeleteReview_FullMethodName                 = "/review.ReviewService/DeleteReview"
+	ReviewService_ListReviewsByProduct_FullMethodName         = "/review.ReviewService/ListReviewsByProduct"
+	ReviewService_ListReviewsByUser_FullMethodName            = "/review.ReviewService/ListReviewsByUser"
+	ReviewService_GetProductAverageRating_FullMethodName      = "/review.ReviewService/GetProductAverageRating"
+	ReviewService_GetProductRatingDistribution_FullMethodName = "/review.ReviewService/GetProductRatingDistribution"
+	ReviewService_ModerateReview_FullMethodName               = "/review.ReviewService/ModerateReview"
+	ReviewService_BulkModerateReviews_FullMethodName          = "/review.ReviewService/BulkModerateReviews"
+	ReviewService_ReplyToReview_FullMethodName                = "/review.ReviewService/ReplyToReview"
+	ReviewService_DeleteReviewReply_FullMethodName            = "/review.ReviewService/DeleteReviewReply"
+	ReviewService_FlagReview_FullMethodName                   = "/review.ReviewService/FlagReview"
+	ReviewService_ListPendingReviews_FullMethodName           = "/review.ReviewService/ListPendingReviews"
+	ReviewService_ListFlaggedReviews_FullMethodName           = "/review.ReviewService/ListFlaggedReviews"
 )
 
 // ReviewServiceClient is the client API for ReviewService service.
@@ -48,8 +55,22 @@ type ReviewServiceClient interface {
 	ListReviewsByUser(ctx context.Context, in *ListReviewsByUserRequest, opts ...grpc.CallOption) (*ListReviewsResponse, error)
 	// Gets the average rating for a product.
 	GetProductAverageRating(ctx context.Context, in *GetProductAverageRatingRequest, opts ...grpc.CallOption) (*ProductAverageRatingResponse, error)
+	// Gets the number of approved reviews per star for a product.
+	GetProductRatingDistribution(ctx context.Context, in *GetProductRatingDistributionRequest, opts ...grpc.CallOption) (*ProductRatingDistributionResponse, error)
 	// Moderates a review (admin action).
 	ModerateReview(ctx context.Context, in *ModerateReviewRequest, opts ...grpc.CallOption) (*Review, error)
+	// Applies the same moderation decision to many reviews at once (admin action).
+	BulkModerateReviews(ctx context.Context, in *BulkModerateReviewsRequest, opts ...grpc.CallOption) (*BulkModerateReviewsResponse, error)
+	// Posts or edits the official reply to a review. Only admins and the reviewed seller.
+	ReplyToReview(ctx context.Context, in *ReplyToReviewRequest, opts ...grpc.CallOption) (*Review, error)
+	// Removes the official reply from a review. Only admins and the reviewed seller.
+	DeleteReviewReply(ctx context.Context, in *DeleteReviewReplyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Flags a review as inappropriate. Each user can flag a review once.
+	FlagReview(ctx context.Context, in *FlagReviewRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Lists reviews awaiting approval, oldest first (admin action).
+	ListPendingReviews(ctx context.Context, in *ListPendingReviewsRequest, opts ...grpc.CallOption) (*ListReviewsResponse, error)
+	// Lists reviews that reached the flag threshold (admin action).
+	ListFlaggedReviews(ctx context.Context, in *ListFlaggedReviewsRequest, opts ...grpc.CallOption) (*ListReviewsResponse, error)
 }
 
 type reviewServiceClient struct {
@@ -130,6 +151,16 @@ func (c *reviewServiceClient) GetProductAverageRating(ctx context.Context, in *G
 	return out, nil
 }
 
+func (c *reviewServiceClient) GetProductRatingDistribution(ctx context.Context, in *GetProductRatingDistributionRequest, opts ...grpc.CallOption) (*ProductRatingDistributionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductRatingDistributionResponse)
+	err := c.cc.Invoke(ctx, ReviewService_GetProductRatingDistribution_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *reviewServiceClient) ModerateReview(ctx context.Context, in *ModerateReviewRequest, opts ...grpc.CallOption) (*Review, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Review)
@@ -140,6 +171,66 @@ func (c *reviewServiceClient) ModerateReview(ctx context.Context, in *ModerateRe
 	return out, nil
 }
 
+func (c *reviewServiceClient) BulkModerateReviews(ctx context.Context, in *BulkModerateReviewsRequest, opts ...grpc.CallOption) (*BulkModerateReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkModerateReviewsResponse)
+	err := c.cc.Invoke(ctx, ReviewService_BulkModerateReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewServiceClient) ReplyToReview(ctx context.Context, in *ReplyToReviewRequest, opts ...grpc.CallOption) (*Review, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Review)
+	err := c.cc.Invoke(ctx, ReviewService_ReplyToReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewServiceClient) DeleteReviewReply(ctx context.Context, in *DeleteReviewReplyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ReviewService_DeleteReviewReply_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewServiceClient) FlagReview(ctx context.Context, in *FlagReviewRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ReviewService_FlagReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewServiceClient) ListPendingReviews(ctx context.Context, in *ListPendingReviewsRequest, opts ...grpc.CallOption) (*ListReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReviewsResponse)
+	err := c.cc.Invoke(ctx, ReviewService_ListPendingReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewServiceClient) ListFlaggedReviews(ctx context.Context, in *ListFlaggedReviewsRequest, opts ...grpc.CallOption) (*ListReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReviewsResponse)
+	err := c.cc.Invoke(ctx, ReviewService_ListFlaggedReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ReviewServiceServer is the server API for ReviewService service.
 // All implementations must embed UnimplementedReviewServiceServer
 // for forward compatibility.
@@ -158,8 +249,22 @@ type ReviewServiceServer interface {
 	ListReviewsByUser(context.Context, *ListReviewsByUserRequest) (*ListReviewsResponse, error)
 	// Gets the average rating for a product.
 	GetProductAverageRating(context.Context, *GetProductAverageRatingRequest) (*ProductAverageRatingResponse, error)
+	// Gets the number of approved reviews per star for a product.
+	GetProductRatingDistribution(context.Context, *GetProductRatingDistributionRequest) (*ProductRatingDistributionResponse, error)
 	// Moderates a review (admin action).
 	ModerateReview(context.Context, *ModerateReviewRequest) (*Review, error)
+	// Applies the same moderation decision to many reviews at once (admin action).
+	BulkModerateReviews(context.Context, *BulkModerateReviewsRequest) (*BulkModerateReviewsResponse, error)
+	// Posts or edits the official reply to a review. Only admins and the reviewed seller.
+	ReplyToReview(context.Context, *ReplyToReviewRequest) (*Review, error)
+	// Removes the official reply from a review. Only admins and the reviewed seller.
+	DeleteReviewReply(context.Context, *DeleteReviewReplyRequest) (*emptypb.Empty, error)
+	// Flags a review as inappropriate. Each user can flag a review once.
+	FlagReview(context.Context, *FlagReviewRequest) (*emptypb.Empty, error)
+	// Lists reviews awaiting approval, oldest first (admin action).
+	ListPendingReviews(context.Context, *ListPendingReviewsRequest) (*ListReviewsResponse, error)
+	// Lists reviews that reached the flag threshold (admin action).
+	ListFlaggedReviews(context.Context, *ListFlaggedReviewsRequest) (*ListReviewsResponse, error)
 	mustEmbedUnimplementedReviewServiceServer()
 }
 
@@ -191,9 +296,30 @@ func (UnimplementedReviewServiceServer) ListReviewsByUser(context.Context, *List
 func (UnimplementedReviewServiceServer) GetProductAverageRating(context.Context, *GetProductAverageRatingRequest) (*ProductAverageRatingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProductAverageRating not implemented")
 }
+func (UnimplementedReviewServiceServer) GetProductRatingDistribution(context.Context, *GetProductRatingDistributionRequest) (*ProductRatingDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductRatingDistribution not implemented")
+}
 func (UnimplementedReviewServiceServer) ModerateReview(context.Context, *ModerateReviewRequest) (*Review, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ModerateReview not implemented")
 }
+func (UnimplementedReviewServiceServer) BulkModerateReviews(context.Context, *BulkModerateReviewsRequest) (*BulkModerateReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkModerateReviews not implemented")
+}
+func (UnimplementedReviewServiceServer) ReplyToReview(context.Context, *ReplyToReviewRequest) (*Review, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplyToReview not implemented")
+}
+func (UnimplementedReviewServiceServer) DeleteReviewReply(context.Context, *DeleteReviewReplyRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReviewReply not implemented")
+}
+func (UnimplementedReviewServiceServer) FlagReview(context.Context, *FlagReviewRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlagReview not implemented")
+}
+func (UnimplementedReviewServiceServer) ListPendingReviews(context.Context, *ListPendingReviewsRequest) (*ListReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingReviews not implemented")
+}
+func (UnimplementedReviewServiceServer) ListFlaggedReviews(context.Context, *ListFlaggedReviewsRequest) (*ListReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFlaggedReviews not implemented")
+}
 func (UnimplementedReviewServiceServer) mustEmbedUnimplementedReviewServiceServer() {}
 func (UnimplementedReviewServiceServer) testEmbeddedByValue()                       {}
 
@@ -341,6 +467,24 @@ func _ReviewService_GetProductAverageRating_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ReviewService_GetProductRatingDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductRatingDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).GetProductRatingDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_GetProductRatingDistribution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).GetProductRatingDistribution(ctx, req.(*GetProductRatingDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ReviewService_ModerateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ModerateReviewRequest)
 	if err := dec(in); err != nil {
@@ -359,6 +503,114 @@ func _ReviewService_ModerateReview_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ReviewService_BulkModerateReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkModerateReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).BulkModerateReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_BulkModerateReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).BulkModerateReviews(ctx, req.(*BulkModerateReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewService_ReplyToReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplyToReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).ReplyToReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_ReplyToReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).ReplyToReview(ctx, req.(*ReplyToReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewService_DeleteReviewReply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReviewReplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).DeleteReviewReply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_DeleteReviewReply_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).DeleteReviewReply(ctx, req.(*DeleteReviewReplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewService_FlagReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlagReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).FlagReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_FlagReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).FlagReview(ctx, req.(*FlagReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewService_ListPendingReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).ListPendingReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_ListPendingReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).ListPendingReviews(ctx, req.(*ListPendingReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewService_ListFlaggedReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFlaggedReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).ListFlaggedReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewService_ListFlaggedReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).ListFlaggedReviews(ctx, req.(*ListFlaggedReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ReviewService_ServiceDesc is the grpc.ServiceDesc for ReviewService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -394,10 +646,38 @@ var ReviewService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetProductAverageRating",
 			Handler:    _ReviewService_GetProductAverageRating_Handler,
 		},
+		{
+			MethodName: "GetProductRatingDistribution",
+			Handler:    _ReviewService_GetProductRatingDistribution_Handler,
+		},
 		{
 			MethodName: "ModerateReview",
 			Handler:    _ReviewService_ModerateReview_Handler,
 		},
+		{
+			MethodName: "BulkModerateReviews",
+			Handler:    _ReviewService_BulkModerateReviews_Handler,
+		},
+		{
+			MethodName: "ReplyToReview",
+			Handler:    _ReviewService_ReplyToReview_Handler,
+		},
+		{
+			MethodName: "DeleteReviewReply",
+			Handler:    _ReviewService_DeleteReviewReply_Handler,
+		},
+		{
+			MethodName: "FlagReview",
+			Handler:    _ReviewService_FlagReview_Handler,
+		},
+		{
+			MethodName: "ListPendingReviews",
+			Handler:    _ReviewService_ListPendingReviews_Handler,
+		},
+		{
+			MethodName: "ListFlaggedReviews",
+			Handler:    _ReviewService_ListFlaggedReviews_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "review.proto",
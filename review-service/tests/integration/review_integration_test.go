@@ -125,7 +125,7 @@ func TestMain(m *testing.M) {
 	if err != nil {
 		log.Fatalf("Could not create test review repository: %s", err)
 	}
-	reviewUsecase := usecase.NewReviewUsecase(testReviewRepo, testNatsPub, testLogger)
+	reviewUsecase := usecase.NewReviewUsecase(testReviewRepo, testNatsPub, usecase.ReviewUsecaseOptions{}, testLogger)
 
 	listener, err := net.Listen("tcp", ":0")
 	if err != nil {
@@ -144,10 +144,11 @@ func TestMain(m *testing.M) {
 		"/review.ReviewService/GetProductAverageRating": true,
 	}
 	requiredRoles := map[string][]string{
-		"/review.ReviewService/ModerateReview": {adminRole},
+		"/review.ReviewService/ModerateReview":     {adminRole},
+		"/review.ReviewService/ListPendingReviews": {adminRole},
 	}
 
-	grpcServer := grpcAdapter.NewGRPCServerWithInterceptors(testLogger, testCfg.JWTSecret, nil, publicMethods, requiredRoles)
+	grpcServer := grpcAdapter.NewGRPCServerWithInterceptors(testLogger, testCfg.JWTSecret, nil, publicMethods, requiredRoles, nil)
 	pb.RegisterReviewServiceServer(grpcServer, grpcAdapter.NewReviewHandler(reviewUsecase, testLogger))
 
 	go func() {
@@ -266,6 +267,10 @@ func TestUpdateReview_ByAuthor_Success(t *testing.T) {
 	assert.Equal(t, int32(4), updatedReview.Rating)
 	assert.Equal(t, "Updated comment", updatedReview.Comment)
 	assert.NotEqual(t, created.UpdatedAt, updatedReview.UpdatedAt)
+	assert.False(t, created.Edited)
+	assert.Nil(t, created.EditedAt)
+	assert.True(t, updatedReview.Edited)
+	assert.NotNil(t, updatedReview.EditedAt)
 }
 
 func TestUpdateReview_ByNonAuthor_Forbidden(t *testing.T) {
@@ -403,6 +408,8 @@ func TestModerateReview_AdminApprove_Success(t *testing.T) {
 
 	fetched, _ := reviewClient.GetReview(context.Background(), &pb.GetReviewRequest{ReviewId: created.Id})
 	assert.Equal(t, string(domain.ReviewStatusApproved), fetched.Status)
+	assert.False(t, fetched.Edited, "moderation must not mark a review as edited")
+	assert.Nil(t, fetched.EditedAt)
 }
 
 func TestModerateReview_NonAdmin_Forbidden(t *testing.T) {
@@ -419,6 +426,53 @@ func TestModerateReview_NonAdmin_Forbidden(t *testing.T) {
 	assert.Equal(t, codes.PermissionDenied, st.Code())
 }
 
+func TestListPendingReviews_OldestFirst(t *testing.T) {
+	clearReviewsCollection(t)
+	adminCtx := createAuthContext(testAdminID, adminRole)
+
+	var createdIDs []string
+	for i := 0; i < 4; i++ {
+		userID := fmt.Sprintf("pendingUser%d", i)
+		created, err := reviewClient.CreateReview(createAuthContext(userID, customerRole), &pb.CreateReviewRequest{
+			UserId:    userID,
+			ProductId: testProductID,
+			Rating:    4,
+			Comment:   fmt.Sprintf("Pending review %d", i+1),
+		})
+		require.NoError(t, err)
+		createdIDs = append(createdIDs, created.Id)
+	}
+	// An approved review must not show up in the queue.
+	_, err := reviewClient.ModerateReview(adminCtx, &pb.ModerateReviewRequest{
+		ReviewId:  createdIDs[1],
+		AdminId:   testAdminID,
+		NewStatus: string(domain.ReviewStatusApproved),
+	})
+	require.NoError(t, err)
+	expected := []string{createdIDs[0], createdIDs[2], createdIDs[3]}
+
+	resp1, err := reviewClient.ListPendingReviews(adminCtx, &pb.ListPendingReviewsRequest{Page: 1, Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), resp1.Total)
+	require.Len(t, resp1.Reviews, 2)
+	resp2, err := reviewClient.ListPendingReviews(adminCtx, &pb.ListPendingReviewsRequest{Page: 2, Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), resp2.Total)
+	require.Len(t, resp2.Reviews, 1)
+
+	var gotIDs []string
+	for _, r := range append(resp1.Reviews, resp2.Reviews...) {
+		assert.Equal(t, string(domain.ReviewStatusPending), r.Status)
+		gotIDs = append(gotIDs, r.Id)
+	}
+	assert.Equal(t, expected, gotIDs)
+
+	_, err = reviewClient.ListPendingReviews(createAuthContext(testUserID, customerRole), &pb.ListPendingReviewsRequest{Page: 1, Limit: 10})
+	require.Error(t, err)
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+}
+
 func TestGetReview_NotFound(t *testing.T) {
 	clearReviewsCollection(t)
 	nonExistentID := primitive.NewObjectID().Hex()
@@ -477,3 +531,100 @@ func TestListReviewsByProduct_Pagination(t *testing.T) {
 	assert.Len(t, resp4.Reviews, 0)
 	assert.Equal(t, int64(5), resp4.Total)
 }
+
+func TestListReviewsByProduct_CursorPagination(t *testing.T) {
+	clearReviewsCollection(t)
+	adminCtx := createAuthContext(testAdminID, adminRole)
+
+	for i := 0; i < 5; i++ {
+		userID := fmt.Sprintf("userCursor%d", i)
+		reviewCtx := createAuthContext(userID, customerRole)
+		created, err := reviewClient.CreateReview(reviewCtx, &pb.CreateReviewRequest{
+			UserId:    userID,
+			ProductId: testProductID,
+			Rating:    int32(i%5 + 1),
+			Comment:   fmt.Sprintf("Review %d", i+1),
+		})
+		require.NoError(t, err)
+		_, err = reviewClient.ModerateReview(adminCtx, &pb.ModerateReviewRequest{
+			ReviewId:  created.Id,
+			AdminId:   testAdminID,
+			NewStatus: string(domain.ReviewStatusApproved),
+		})
+		require.NoError(t, err)
+	}
+
+	seen := make(map[string]bool)
+	cursor := ""
+	pages := 0
+	for {
+		resp, err := reviewClient.ListReviewsByProduct(context.Background(), &pb.ListReviewsByProductRequest{
+			ProductId:    testProductID,
+			Limit:        2,
+			StatusFilter: string(domain.ReviewStatusApproved),
+			Cursor:       cursor,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(5), resp.Total)
+		for _, r := range resp.Reviews {
+			assert.False(t, seen[r.Id], "review %s returned twice", r.Id)
+			seen[r.Id] = true
+		}
+		pages++
+		if resp.NextCursor == "" {
+			break
+		}
+		cursor = resp.NextCursor
+		require.LessOrEqual(t, pages, 5, "cursor pagination did not terminate")
+	}
+	assert.Len(t, seen, 5)
+
+	_, err := reviewClient.ListReviewsByProduct(context.Background(), &pb.ListReviewsByProductRequest{ProductId: testProductID, Limit: 2, Cursor: "not-a-cursor"})
+	require.Error(t, err)
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+func TestListReviewsByProduct_RatingFilterAndSort(t *testing.T) {
+	clearReviewsCollection(t)
+	adminCtx := createAuthContext(testAdminID, adminRole)
+
+	for i := 0; i < 5; i++ {
+		userID := fmt.Sprintf("userRating%d", i)
+		reviewCtx := createAuthContext(userID, customerRole)
+		created, err := reviewClient.CreateReview(reviewCtx, &pb.CreateReviewRequest{
+			UserId:    userID,
+			ProductId: testProductID,
+			Rating:    int32(i%5 + 1),
+			Comment:   fmt.Sprintf("Review %d", i+1),
+		})
+		require.NoError(t, err)
+		_, err = reviewClient.ModerateReview(adminCtx, &pb.ModerateReviewRequest{
+			ReviewId:  created.Id,
+			AdminId:   testAdminID,
+			NewStatus: string(domain.ReviewStatusApproved),
+		})
+		require.NoError(t, err)
+	}
+
+	resp, err := reviewClient.ListReviewsByProduct(context.Background(), &pb.ListReviewsByProductRequest{
+		ProductId: testProductID,
+		Limit:     10,
+		MinRating: 2,
+		MaxRating: 4,
+		SortBy:    "rating",
+		SortOrder: "asc",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), resp.Total)
+	require.Len(t, resp.Reviews, 3)
+	for i, r := range resp.Reviews {
+		assert.Equal(t, int32(i+2), r.Rating)
+	}
+	assert.Empty(t, resp.NextCursor)
+
+	_, err = reviewClient.ListReviewsByProduct(context.Background(), &pb.ListReviewsByProductRequest{ProductId: testProductID, MinRating: 4, MaxRating: 2})
+	require.Error(t, err)
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
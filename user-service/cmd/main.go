@@ -11,14 +11,22 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/Abdurahmanit/GroupProject/platform/grpcrecovery"
+	"github.com/Abdurahmanit/GroupProject/platform/health"
+	"github.com/Abdurahmanit/GroupProject/platform/logkv"
+	"github.com/Abdurahmanit/GroupProject/platform/natsdedup"
+	"github.com/Abdurahmanit/GroupProject/platform/selftest"
 	"github.com/Abdurahmanit/GroupProject/user-service/internal/adapter"
 	"github.com/Abdurahmanit/GroupProject/user-service/internal/config"
 	"github.com/Abdurahmanit/GroupProject/user-service/internal/mailer"
 	"github.com/Abdurahmanit/GroupProject/user-service/internal/repository"
+	"github.com/Abdurahmanit/GroupProject/user-service/internal/secretbox"
+	"github.com/Abdurahmanit/GroupProject/user-service/internal/storage"
 	"github.com/Abdurahmanit/GroupProject/user-service/internal/usecase"
 	user "github.com/Abdurahmanit/GroupProject/user-service/proto"
 	"github.com/go-redis/redis/v8"
 	"github.com/joho/godotenv"
+	"github.com/nats-io/nats.go"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.uber.org/zap"
@@ -125,11 +133,116 @@ func main() {
 		}
 	}()
 
+	// Steps are added as dependencies come up and run once, before the server starts
+	redisKV := repository.NewRedisKV(redisClient)
+	selfTest := selftest.NewRunner(logkv.FromZap(logger))
+	selfTest.Add("mongodb", selftest.MongoRoundTrip(db))
+	selfTest.Add("redis", selftest.KeyValueRoundTrip(redisKV))
+
+	twoFactorKey := cfg.TwoFactorEncryptionKey
+	if twoFactorKey == "" {
+		logger.Warn("TWO_FACTOR_ENCRYPTION_KEY is not set, falling back to JWT secret for encrypting 2FA secrets")
+		twoFactorKey = cfg.JWTSecret
+	}
+	twoFactorBox, err := secretbox.New(twoFactorKey)
+	if err != nil {
+		logger.Fatal("Failed to initialize 2FA secret encryption", zap.Error(err))
+	}
+
+	emailBlocklist := usecase.NewEmailBlocklist(config.SplitList(cfg.EmailBlockedDomains), config.SplitList(cfg.EmailBlockedAddresses))
+	if cfg.EmailBlocklistFile != "" {
+		err := config.WatchEmailBlocklist(cfg.EmailBlocklistFile, func(domains, addresses []string) {
+			emailBlocklist.Update(
+				append(config.SplitList(cfg.EmailBlockedDomains), domains...),
+				append(config.SplitList(cfg.EmailBlockedAddresses), addresses...),
+			)
+			logger.Info("Email blocklist loaded", zap.String("file", cfg.EmailBlocklistFile), zap.Int("domains", len(domains)), zap.Int("addresses", len(addresses)))
+		})
+		if err != nil {
+			logger.Fatal("Failed to load email blocklist file", zap.String("file", cfg.EmailBlocklistFile), zap.Error(err))
+		}
+	}
+
+	var avatarStorage usecase.AvatarStorage
+	if cfg.MinIOEndpoint != "" {
+		s3Storage, err := storage.NewS3Storage(cfg.MinIOEndpoint, cfg.MinIOAccessKey, cfg.MinIOSecretKey, cfg.MinIOBucket, cfg.MinIOUseSSL, logger)
+		if err != nil {
+			logger.Fatal("Failed to initialize avatar storage", zap.String("endpoint", cfg.MinIOEndpoint), zap.Error(err))
+		}
+		avatarStorage = s3Storage
+		logger.Info("Avatar storage initialized", zap.String("endpoint", cfg.MinIOEndpoint), zap.String("bucket", cfg.MinIOBucket))
+	} else {
+		logger.Warn("MINIO_ENDPOINT is not set; avatar uploads are disabled")
+	}
+
+	var natsConn *nats.Conn
+	if cfg.OrderConfirmationEmails || cfg.UserEvents {
+		natsConn, err = nats.Connect(cfg.NATSURL, nats.Name("UserService"))
+		if err != nil {
+			logger.Fatal("Failed to connect to NATS", zap.String("natsURL", cfg.NATSURL), zap.Error(err))
+		}
+		defer natsConn.Close()
+		selfTest.Add("nats", selftest.NATSPublish(natsConn, "user.selftest"))
+	}
+	var userEvents usecase.UserEventPublisher
+	if cfg.UserEvents {
+		userEvents = adapter.NewUserEventPublisher(natsConn)
+		logger.Info("Publishing user events", zap.String("natsURL", cfg.NATSURL))
+	}
+
 	// Initialize components
 	userRepo := repository.NewUserRepository(db, redisClient, logger)
-	userUsecase := usecase.NewUserUsecase(userRepo, mailerService, cfg.JWTSecret, logger)
+	userUsecase := usecase.NewUserUsecase(userRepo, mailerService, usecase.UserUsecaseConfig{
+		JWTSecret: cfg.JWTSecret,
+		PasswordReset: usecase.PasswordResetPolicy{
+			CodeExpiry:        time.Duration(cfg.PasswordResetCodeExpiryMinutes) * time.Minute,
+			MaxFailedAttempts: cfg.PasswordResetMaxAttempts,
+		},
+		LoginLockout: usecase.LoginLockoutPolicy{
+			MaxFailedAttempts: cfg.LoginMaxFailedAttempts,
+			Window:            time.Duration(cfg.LoginLockoutWindowMinutes) * time.Minute,
+		},
+		TwoFactor: usecase.TwoFactorSettings{
+			Issuer:    cfg.TwoFactorIssuer,
+			SecretBox: twoFactorBox,
+		},
+		PasswordPolicy: usecase.PasswordPolicy{
+			MinLength:     cfg.PasswordMinLength,
+			RequireUpper:  cfg.PasswordRequireUpper,
+			RequireLower:  cfg.PasswordRequireLower,
+			RequireDigit:  cfg.PasswordRequireDigit,
+			RequireSymbol: cfg.PasswordRequireSymbol,
+		},
+		IdempotentRegistration: cfg.IdempotentRegistration,
+		VerificationCooldown:   time.Duration(cfg.EmailVerificationCooldownSeconds) * time.Second,
+		EmailBlocklist:         emailBlocklist,
+		Avatars: usecase.AvatarSettings{
+			Storage:  avatarStorage,
+			MaxBytes: cfg.AvatarMaxBytes,
+		},
+		Events: userEvents,
+	}, logger)
 	userGRPCHandler := adapter.NewUserHandler(userUsecase, logger)
 
+	if cfg.OrderConfirmationEmails {
+		var dedup *natsdedup.Deduplicator
+		if cfg.NATSDedupEnabled {
+			dedup = natsdedup.New(redisKV, time.Duration(cfg.NATSDedupTTLHours)*time.Hour, logkv.FromZap(logger.Named("Deduplicator")))
+		}
+		orderEvents := adapter.NewOrderEventConsumer(natsConn, userUsecase, dedup, logger)
+		if err := orderEvents.Start(); err != nil {
+			logger.Fatal("Failed to start order event consumer", zap.Error(err))
+		}
+		defer orderEvents.Stop()
+		logger.Info("Order confirmation emails enabled", zap.String("natsURL", cfg.NATSURL), zap.Bool("dedup", cfg.NATSDedupEnabled))
+	}
+
+	if cfg.StartupSelfTest {
+		if err := selfTest.Run(context.Background()); err != nil {
+			logger.Fatal("Startup self-test failed", zap.Error(err))
+		}
+	}
+
 	// Start gRPC server
 	address := fmt.Sprintf(":%d", cfg.Port)
 	lis, err := net.Listen("tcp", address)
@@ -137,8 +250,17 @@ func main() {
 		logger.Fatal("Failed to listen on address", zap.String("address", address), zap.Error(err))
 	}
 
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(grpcrecovery.UnaryServerInterceptor(logkv.FromZap(logger.Named("RecoveryInterceptor")), nil)))
 	user.RegisterUserServiceServer(grpcServer, userGRPCHandler)
+
+	healthManager := health.NewManager(logkv.FromZap(logger), user.UserService_ServiceDesc.ServiceName)
+	healthManager.AddCheck("mongodb", func(ctx context.Context) error { return mongoClient.Ping(ctx, nil) })
+	healthManager.AddCheck("redis", func(ctx context.Context) error { return redisClient.Ping(ctx).Err() })
+	healthManager.Register(grpcServer)
+	healthCtx, stopHealthChecks := context.WithCancel(context.Background())
+	defer stopHealthChecks()
+	go healthManager.Run(healthCtx, health.DefaultInterval)
+
 	logger.Info("Starting User Service gRPC server", zap.String("address", address))
 
 	go func() {
@@ -151,6 +273,8 @@ func main() {
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
+	stopHealthChecks()
+	healthManager.Shutdown()
 	logger.Info("Shutting down gRPC server...")
 	grpcServer.GracefulStop()
 	logger.Info("User Service stopped gracefully.")
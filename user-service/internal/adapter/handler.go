@@ -41,10 +41,14 @@ func (h *UserHandler) Register(ctx context.Context, req *user.RegisterRequest) (
 			return nil, status.Error(codes.AlreadyExists, "Email already exists")
 		case errors.Is(err, usecase.ErrDuplicatePhoneNumber):
 			return nil, status.Error(codes.AlreadyExists, "Phone number already exists")
+		case errors.Is(err, usecase.ErrDuplicateUsername) || errors.Is(err, repository.ErrDuplicateUsername):
+			return nil, status.Error(codes.AlreadyExists, "Username already exists")
 		case errors.Is(err, usecase.ErrInvalidPhoneNumber):
 			return nil, status.Error(codes.InvalidArgument, usecase.ErrInvalidPhoneNumber.Error())
 		case errors.Is(err, usecase.ErrPhoneNumberRequired):
 			return nil, status.Error(codes.InvalidArgument, usecase.ErrPhoneNumberRequired.Error())
+		case errors.Is(err, usecase.ErrWeakPassword), errors.Is(err, usecase.ErrEmailBlocked):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
 		default:
 			return nil, status.Error(codes.Internal, "Failed to register user")
 		}
@@ -57,18 +61,70 @@ func (h *UserHandler) Login(ctx context.Context, req *user.LoginRequest) (*user.
 	h.logger.Info("gRPC Login request received", zap.String("email", req.GetEmail()))
 	if req.GetEmail() == "" || req.GetPassword() == "" {
 		h.logger.Warn("InvalidArgument for Login gRPC request: missing fields")
-		return nil, status.Error(codes.InvalidArgument, "Email and password are required")
+		return nil, status.Error(codes.InvalidArgument, "Email or phone number and password are required")
 	}
-	token, err := h.usecase.Login(ctx, req.Email, req.Password)
+	result, err := h.usecase.Login(ctx, req.Email, req.Password)
 	if err != nil {
 		h.logger.Warn("Usecase failed to login user", zap.String("email", req.Email), zap.Error(err))
 		if errors.Is(err, usecase.ErrInvalidCredentials) || errors.Is(err, usecase.ErrUserInactive) {
 			return nil, status.Error(codes.Unauthenticated, err.Error())
 		}
+		if errors.Is(err, usecase.ErrAccountLocked) {
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		}
 		return nil, status.Error(codes.Internal, "Login failed")
 	}
-	h.logger.Info("gRPC Login request processed successfully", zap.String("email", req.GetEmail()))
-	return &user.LoginResponse{Token: token}, nil
+	h.logger.Info("gRPC Login request processed successfully", zap.String("email", req.GetEmail()), zap.Bool("twoFactorRequired", result.TwoFactorRequired))
+	return &user.LoginResponse{
+		Token:             result.AccessToken,
+		RefreshToken:      result.RefreshToken,
+		TwoFactorRequired: result.TwoFactorRequired,
+		PendingToken:      result.PendingToken,
+	}, nil
+}
+
+func (h *UserHandler) RefreshToken(ctx context.Context, req *user.RefreshTokenRequest) (*user.RefreshTokenResponse, error) {
+	h.logger.Info("gRPC RefreshToken request received")
+	if req.GetRefreshToken() == "" {
+		h.logger.Warn("InvalidArgument for RefreshToken gRPC request: missing refresh token")
+		return nil, status.Error(codes.InvalidArgument, "Refresh token is required")
+	}
+	token, err := h.usecase.RefreshToken(ctx, req.RefreshToken)
+	if err != nil {
+		h.logger.Warn("Usecase failed to refresh token", zap.Error(err))
+		switch {
+		case errors.Is(err, usecase.ErrInvalidRefreshToken),
+			errors.Is(err, usecase.ErrRefreshTokenExpired),
+			errors.Is(err, usecase.ErrUserInactive):
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "Token refresh failed")
+		}
+	}
+	h.logger.Info("gRPC RefreshToken request processed successfully")
+	return &user.RefreshTokenResponse{Token: token}, nil
+}
+
+// ValidateToken checks an access token against the user's session so the gateway can reject
+// tokens revoked by logout, password reset or account removal before they expire.
+func (h *UserHandler) ValidateToken(ctx context.Context, req *user.ValidateTokenRequest) (*user.ValidateTokenResponse, error) {
+	if req.GetAccessToken() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Access token is required")
+	}
+	userID, err := h.usecase.ValidateAccessToken(ctx, req.GetAccessToken())
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrTokenRevoked):
+			h.logger.Info("Rejected revoked access token")
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		case errors.Is(err, usecase.ErrUnauthorized):
+			return nil, status.Error(codes.Unauthenticated, "invalid or expired token")
+		default:
+			h.logger.Error("Failed to validate access token", zap.Error(err))
+			return nil, status.Error(codes.Unavailable, "failed to validate token")
+		}
+	}
+	return &user.ValidateTokenResponse{UserId: userID}, nil
 }
 
 func (h *UserHandler) Logout(ctx context.Context, req *user.LogoutRequest) (*user.LogoutResponse, error) {
@@ -103,6 +159,10 @@ func (h *UserHandler) GetProfile(ctx context.Context, req *user.GetProfileReques
 	if profile.EmailVerifiedAt != nil {
 		emailVerifiedAtStr = profile.EmailVerifiedAt.Format(time.RFC3339)
 	}
+	lastLoginAtStr := ""
+	if profile.LastLoginAt != nil {
+		lastLoginAtStr = profile.LastLoginAt.Format(time.RFC3339)
+	}
 
 	h.logger.Info("gRPC GetProfile request processed successfully", zap.String("userID", profile.ID.Hex()))
 	return &user.GetProfileResponse{
@@ -116,9 +176,36 @@ func (h *UserHandler) GetProfile(ctx context.Context, req *user.GetProfileReques
 		UpdatedAt:       profile.UpdatedAt.Format(time.RFC3339),
 		IsEmailVerified: profile.IsEmailVerified,
 		EmailVerifiedAt: emailVerifiedAtStr,
+		LastLoginAt:     lastLoginAtStr,
+		AvatarUrl:       profile.AvatarURL,
 	}, nil
 }
 
+func (h *UserHandler) UploadAvatar(ctx context.Context, req *user.UploadAvatarRequest) (*user.UploadAvatarResponse, error) {
+	h.logger.Info("gRPC UploadAvatar request received", zap.String("userID", req.GetUserId()), zap.Int("sizeBytes", len(req.GetData())))
+	if req.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "User ID is required")
+	}
+	avatarURL, err := h.usecase.UploadAvatar(ctx, req.UserId, req.GetFileName(), req.GetData())
+	if err != nil {
+		h.logger.Error("Usecase failed to upload avatar", zap.String("userID", req.UserId), zap.Error(err))
+		switch {
+		case errors.Is(err, usecase.ErrAvatarEmpty), errors.Is(err, usecase.ErrAvatarNotImage), errors.Is(err, usecase.ErrAvatarTooLarge):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, usecase.ErrAvatarUploadDisabled):
+			return nil, status.Error(codes.Unimplemented, err.Error())
+		case errors.Is(err, usecase.ErrUserNotFound):
+			return nil, status.Error(codes.NotFound, "User not found")
+		case errors.Is(err, usecase.ErrUserInactive):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, "Failed to upload avatar")
+		}
+	}
+	h.logger.Info("gRPC UploadAvatar processed successfully", zap.String("userID", req.UserId))
+	return &user.UploadAvatarResponse{AvatarUrl: avatarURL}, nil
+}
+
 func (h *UserHandler) UpdateProfile(ctx context.Context, req *user.UpdateProfileRequest) (*user.UpdateProfileResponse, error) {
 	h.logger.Info("gRPC UpdateProfile request received", zap.String("userID", req.GetUserId()))
 	if req.GetUserId() == "" {
@@ -138,8 +225,12 @@ func (h *UserHandler) UpdateProfile(ctx context.Context, req *user.UpdateProfile
 			return nil, status.Error(codes.AlreadyExists, "Email already in use")
 		case errors.Is(err, usecase.ErrDuplicatePhoneNumber) || errors.Is(err, repository.ErrDuplicatePhoneNumber):
 			return nil, status.Error(codes.AlreadyExists, "Phone number already in use")
+		case errors.Is(err, usecase.ErrDuplicateUsername) || errors.Is(err, repository.ErrDuplicateUsername):
+			return nil, status.Error(codes.AlreadyExists, "Username already in use")
 		case errors.Is(err, usecase.ErrInvalidPhoneNumber):
 			return nil, status.Error(codes.InvalidArgument, usecase.ErrInvalidPhoneNumber.Error())
+		case errors.Is(err, usecase.ErrEmailBlocked):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
 		default:
 			return nil, status.Error(codes.Internal, "Failed to update profile")
 		}
@@ -159,6 +250,8 @@ func (h *UserHandler) ChangePassword(ctx context.Context, req *user.ChangePasswo
 		switch {
 		case errors.Is(err, usecase.ErrInvalidCredentials):
 			return nil, status.Error(codes.Unauthenticated, "Invalid old password")
+		case errors.Is(err, usecase.ErrWeakPassword):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
 		case errors.Is(err, repository.ErrUserNotFound) || errors.Is(err, usecase.ErrUserNotFound):
 			return nil, status.Error(codes.NotFound, "User not found")
 		case errors.Is(err, usecase.ErrUserInactive):
@@ -218,6 +311,8 @@ func (h *UserHandler) RequestEmailVerification(ctx context.Context, req *user.Re
 		switch {
 		case errors.Is(err, usecase.ErrEmailAlreadyVerified):
 			return &user.RequestEmailVerificationResponse{Success: false, Message: err.Error()}, nil
+		case errors.Is(err, usecase.ErrVerificationCooldown):
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
 		case errors.Is(err, repository.ErrUserNotFound) || errors.Is(err, usecase.ErrUserNotFound):
 			return nil, status.Error(codes.NotFound, "User not found")
 		case errors.Is(err, usecase.ErrMailerFailed):
@@ -271,6 +366,119 @@ func (h *UserHandler) CheckEmailVerificationStatus(ctx context.Context, req *use
 	return &user.CheckEmailVerificationStatusResponse{IsVerified: isVerified}, nil
 }
 
+// Password Reset Handlers
+func (h *UserHandler) RequestPasswordReset(ctx context.Context, req *user.RequestPasswordResetRequest) (*user.RequestPasswordResetResponse, error) {
+	h.logger.Info("gRPC RequestPasswordReset request received", zap.String("email", req.GetEmail()))
+	if req.GetEmail() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Email is required")
+	}
+
+	if err := h.usecase.RequestPasswordReset(ctx, req.Email); err != nil {
+		h.logger.Error("Usecase failed to request password reset", zap.String("email", req.Email), zap.Error(err))
+		return nil, status.Error(codes.Internal, "Failed to request password reset")
+	}
+	h.logger.Info("gRPC RequestPasswordReset processed successfully", zap.String("email", req.GetEmail()))
+	return &user.RequestPasswordResetResponse{Success: true, Message: "If an account with this email exists, a password reset code has been sent."}, nil
+}
+
+func (h *UserHandler) ResetPassword(ctx context.Context, req *user.ResetPasswordRequest) (*user.ResetPasswordResponse, error) {
+	h.logger.Info("gRPC ResetPassword request received", zap.String("email", req.GetEmail()))
+	if req.GetEmail() == "" || req.GetCode() == "" || req.GetNewPassword() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Email, reset code and new password are required")
+	}
+
+	err := h.usecase.ResetPassword(ctx, req.Email, req.Code, req.NewPassword)
+	if err != nil {
+		h.logger.Error("Usecase failed to reset password", zap.String("email", req.Email), zap.Error(err))
+		switch {
+		case errors.Is(err, usecase.ErrInvalidResetCode):
+			return &user.ResetPasswordResponse{Success: false, Message: err.Error()}, nil
+		case errors.Is(err, usecase.ErrWeakPassword):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, usecase.ErrUserInactive):
+			return nil, status.Error(codes.FailedPrecondition, usecase.ErrUserInactive.Error())
+		default:
+			return nil, status.Error(codes.Internal, "Failed to reset password")
+		}
+	}
+	h.logger.Info("gRPC ResetPassword processed successfully", zap.String("email", req.GetEmail()))
+	return &user.ResetPasswordResponse{Success: true, Message: "Password has been reset successfully."}, nil
+}
+
+// Two-Factor Authentication Handlers
+func twoFactorErrorToStatus(err error, fallback string) error {
+	switch {
+	case errors.Is(err, usecase.ErrUserNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, usecase.ErrTwoFactorAlreadyEnabled),
+		errors.Is(err, usecase.ErrTwoFactorNotEnabled),
+		errors.Is(err, usecase.ErrTwoFactorNotInitiated),
+		errors.Is(err, usecase.ErrUserInactive):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, usecase.ErrInvalidTwoFactorCode),
+		errors.Is(err, usecase.ErrInvalidPendingToken):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, usecase.ErrAccountLocked):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	default:
+		return status.Error(codes.Internal, fallback)
+	}
+}
+
+func (h *UserHandler) EnableTwoFactor(ctx context.Context, req *user.EnableTwoFactorRequest) (*user.EnableTwoFactorResponse, error) {
+	h.logger.Info("gRPC EnableTwoFactor request received", zap.String("userID", req.GetUserId()))
+	if req.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "User ID is required")
+	}
+	secret, otpauthURL, err := h.usecase.EnableTwoFactor(ctx, req.UserId)
+	if err != nil {
+		h.logger.Error("Usecase failed to enable two-factor authentication", zap.String("userID", req.UserId), zap.Error(err))
+		return nil, twoFactorErrorToStatus(err, "Failed to enable two-factor authentication")
+	}
+	h.logger.Info("gRPC EnableTwoFactor processed successfully", zap.String("userID", req.GetUserId()))
+	return &user.EnableTwoFactorResponse{Secret: secret, OtpauthUrl: otpauthURL}, nil
+}
+
+func (h *UserHandler) ConfirmTwoFactor(ctx context.Context, req *user.ConfirmTwoFactorRequest) (*user.ConfirmTwoFactorResponse, error) {
+	h.logger.Info("gRPC ConfirmTwoFactor request received", zap.String("userID", req.GetUserId()))
+	if req.GetUserId() == "" || req.GetCode() == "" {
+		return nil, status.Error(codes.InvalidArgument, "User ID and code are required")
+	}
+	if err := h.usecase.ConfirmTwoFactor(ctx, req.UserId, req.Code); err != nil {
+		h.logger.Warn("Usecase failed to confirm two-factor authentication", zap.String("userID", req.UserId), zap.Error(err))
+		return nil, twoFactorErrorToStatus(err, "Failed to confirm two-factor authentication")
+	}
+	h.logger.Info("gRPC ConfirmTwoFactor processed successfully", zap.String("userID", req.GetUserId()))
+	return &user.ConfirmTwoFactorResponse{Success: true}, nil
+}
+
+func (h *UserHandler) VerifyTwoFactor(ctx context.Context, req *user.VerifyTwoFactorRequest) (*user.VerifyTwoFactorResponse, error) {
+	h.logger.Info("gRPC VerifyTwoFactor request received")
+	if req.GetPendingToken() == "" || req.GetCode() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Pending token and code are required")
+	}
+	result, err := h.usecase.VerifyTwoFactor(ctx, req.PendingToken, req.Code)
+	if err != nil {
+		h.logger.Warn("Usecase failed to verify two-factor code", zap.Error(err))
+		return nil, twoFactorErrorToStatus(err, "Two-factor verification failed")
+	}
+	h.logger.Info("gRPC VerifyTwoFactor processed successfully")
+	return &user.VerifyTwoFactorResponse{Token: result.AccessToken, RefreshToken: result.RefreshToken}, nil
+}
+
+func (h *UserHandler) DisableTwoFactor(ctx context.Context, req *user.DisableTwoFactorRequest) (*user.DisableTwoFactorResponse, error) {
+	h.logger.Info("gRPC DisableTwoFactor request received", zap.String("userID", req.GetUserId()))
+	if req.GetUserId() == "" || req.GetCode() == "" {
+		return nil, status.Error(codes.InvalidArgument, "User ID and code are required")
+	}
+	if err := h.usecase.DisableTwoFactor(ctx, req.UserId, req.Code); err != nil {
+		h.logger.Warn("Usecase failed to disable two-factor authentication", zap.String("userID", req.UserId), zap.Error(err))
+		return nil, twoFactorErrorToStatus(err, "Failed to disable two-factor authentication")
+	}
+	h.logger.Info("gRPC DisableTwoFactor processed successfully", zap.String("userID", req.GetUserId()))
+	return &user.DisableTwoFactorResponse{Success: true}, nil
+}
+
 // --- Admin Handlers ---
 func (h *UserHandler) AdminDeleteUser(ctx context.Context, req *user.AdminDeleteUserRequest) (*user.AdminDeleteUserResponse, error) {
 	h.logger.Info("gRPC AdminDeleteUser request", zap.String("adminID", req.GetAdminId()), zap.String("targetUserID", req.GetUserIdToDelete()))
@@ -291,13 +499,75 @@ func (h *UserHandler) AdminDeleteUser(ctx context.Context, req *user.AdminDelete
 	return &user.AdminDeleteUserResponse{Success: true}, nil
 }
 
+func (h *UserHandler) RestoreUser(ctx context.Context, req *user.RestoreUserRequest) (*user.RestoreUserResponse, error) {
+	h.logger.Info("gRPC RestoreUser request", zap.String("adminID", req.GetAdminId()), zap.String("targetUserID", req.GetUserId()))
+	if req.GetAdminId() == "" || req.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Admin ID and User ID to restore are required")
+	}
+	err := h.usecase.RestoreUser(ctx, req.AdminId, req.UserId)
+	if err != nil {
+		h.logger.Error("Usecase failed for RestoreUser", zap.Error(err))
+		if errors.Is(err, usecase.ErrUnauthorized) {
+			return nil, status.Error(codes.PermissionDenied, "Admin unauthorized")
+		}
+		if errors.Is(err, usecase.ErrUserNotFound) {
+			return nil, status.Error(codes.NotFound, "Deleted user to restore not found")
+		}
+		if errors.Is(err, usecase.ErrDuplicateEmail) || errors.Is(err, usecase.ErrDuplicatePhoneNumber) || errors.Is(err, usecase.ErrDuplicateUsername) {
+			return nil, status.Errorf(codes.AlreadyExists, "Cannot restore user: %v", err)
+		}
+		return nil, status.Error(codes.Internal, "Failed to restore user")
+	}
+	return &user.RestoreUserResponse{Success: true}, nil
+}
+
+func (h *UserHandler) AdminPurgeUser(ctx context.Context, req *user.AdminPurgeUserRequest) (*user.AdminPurgeUserResponse, error) {
+	h.logger.Info("gRPC AdminPurgeUser request", zap.String("adminID", req.GetAdminId()), zap.String("targetUserID", req.GetUserIdToPurge()))
+	if req.GetAdminId() == "" || req.GetUserIdToPurge() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Admin ID and User ID to purge are required")
+	}
+	err := h.usecase.AdminPurgeUser(ctx, req.AdminId, req.UserIdToPurge)
+	if err != nil {
+		h.logger.Error("Usecase failed for AdminPurgeUser", zap.Error(err))
+		if errors.Is(err, usecase.ErrUnauthorized) {
+			return nil, status.Error(codes.PermissionDenied, "Admin unauthorized")
+		}
+		if errors.Is(err, usecase.ErrUserNotFound) {
+			return nil, status.Error(codes.NotFound, "User to purge not found")
+		}
+		return nil, status.Error(codes.Internal, "Failed to purge user")
+	}
+	return &user.AdminPurgeUserResponse{Success: true}, nil
+}
+
+// userFilterFromRequest converts the optional admin list filters; empty strings mean "no filter".
+func userFilterFromRequest(roleFilter, activeFilter string) (repository.UserFilter, error) {
+	filter := repository.UserFilter{Role: roleFilter}
+	switch activeFilter {
+	case "":
+	case "true", "active":
+		isActive := true
+		filter.IsActive = &isActive
+	case "false", "inactive":
+		isActive := false
+		filter.IsActive = &isActive
+	default:
+		return filter, errors.New("active_filter must be one of: true, false, active, inactive")
+	}
+	return filter, nil
+}
+
 func (h *UserHandler) AdminListUsers(ctx context.Context, req *user.AdminListUsersRequest) (*user.AdminListUsersResponse, error) {
 	h.logger.Info("gRPC AdminListUsers request received", zap.String("adminID", req.GetAdminId()))
 	if req.GetAdminId() == "" {
 		h.logger.Warn("InvalidArgument for AdminListUsers: Admin ID is required")
 		return nil, status.Error(codes.InvalidArgument, "Admin ID is required")
 	}
-	usersList, err := h.usecase.AdminListUsers(ctx, req.AdminId, req.Skip, req.Limit)
+	filter, err := userFilterFromRequest(req.GetRoleFilter(), req.GetActiveFilter())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	usersList, total, err := h.usecase.AdminListUsers(ctx, req.AdminId, filter, repository.UserSort{By: req.GetSortBy(), Order: req.GetSortOrder()}, req.Skip, req.Limit)
 	if err != nil {
 		h.logger.Error("Usecase failed for AdminListUsers", zap.String("adminID", req.AdminId), zap.Error(err))
 		if errors.Is(err, usecase.ErrUnauthorized) {
@@ -312,6 +582,10 @@ func (h *UserHandler) AdminListUsers(ctx context.Context, req *user.AdminListUse
 		if u.EmailVerifiedAt != nil {
 			emailVerifiedAtStr = u.EmailVerifiedAt.Format(time.RFC3339)
 		}
+		lastLoginAtStr := ""
+		if u.LastLoginAt != nil {
+			lastLoginAtStr = u.LastLoginAt.Format(time.RFC3339)
+		}
 		protoUsers[i] = &user.User{
 			UserId:          u.ID.Hex(),
 			Username:        u.Username,
@@ -323,10 +597,13 @@ func (h *UserHandler) AdminListUsers(ctx context.Context, req *user.AdminListUse
 			UpdatedAt:       u.UpdatedAt.Format(time.RFC3339),
 			IsEmailVerified: u.IsEmailVerified,
 			EmailVerifiedAt: emailVerifiedAtStr,
+			LastLoginAt:     lastLoginAtStr,
+			AvatarUrl:       u.AvatarURL,
 		}
 	}
-	h.logger.Info("gRPC AdminListUsers processed successfully", zap.String("adminID", req.AdminId), zap.Int("count", len(protoUsers)))
-	return &user.AdminListUsersResponse{Users: protoUsers}, nil
+	h.logger.Info("gRPC AdminListUsers processed successfully", zap.String("adminID", req.AdminId), zap.Int("count", len(protoUsers)), zap.Int64("total", total))
+	skip, limit := usecase.NormalizeAdminPage(req.Skip, req.Limit)
+	return &user.AdminListUsersResponse{Users: protoUsers, Total: total, Skip: skip, Limit: limit}, nil
 }
 
 func (h *UserHandler) AdminSearchUsers(ctx context.Context, req *user.AdminSearchUsersRequest) (*user.AdminSearchUsersResponse, error) {
@@ -335,7 +612,11 @@ func (h *UserHandler) AdminSearchUsers(ctx context.Context, req *user.AdminSearc
 		h.logger.Warn("InvalidArgument for AdminSearchUsers: Admin ID is required")
 		return nil, status.Error(codes.InvalidArgument, "Admin ID is required")
 	}
-	usersList, err := h.usecase.AdminSearchUsers(ctx, req.AdminId, req.Query, req.Skip, req.Limit)
+	filter, err := userFilterFromRequest(req.GetRoleFilter(), req.GetActiveFilter())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	usersList, err := h.usecase.AdminSearchUsers(ctx, req.AdminId, req.Query, filter, req.Skip, req.Limit)
 	if err != nil {
 		h.logger.Error("Usecase failed for AdminSearchUsers", zap.String("adminID", req.AdminId), zap.String("query", req.Query), zap.Error(err))
 		if errors.Is(err, usecase.ErrUnauthorized) {
@@ -349,6 +630,10 @@ func (h *UserHandler) AdminSearchUsers(ctx context.Context, req *user.AdminSearc
 		if u.EmailVerifiedAt != nil {
 			emailVerifiedAtStr = u.EmailVerifiedAt.Format(time.RFC3339)
 		}
+		lastLoginAtStr := ""
+		if u.LastLoginAt != nil {
+			lastLoginAtStr = u.LastLoginAt.Format(time.RFC3339)
+		}
 		protoUsers[i] = &user.User{
 			UserId:          u.ID.Hex(),
 			Username:        u.Username,
@@ -360,6 +645,8 @@ func (h *UserHandler) AdminSearchUsers(ctx context.Context, req *user.AdminSearc
 			UpdatedAt:       u.UpdatedAt.Format(time.RFC3339),
 			IsEmailVerified: u.IsEmailVerified,
 			EmailVerifiedAt: emailVerifiedAtStr,
+			LastLoginAt:     lastLoginAtStr,
+			AvatarUrl:       u.AvatarURL,
 		}
 	}
 	h.logger.Info("gRPC AdminSearchUsers processed successfully", zap.String("adminID", req.AdminId), zap.Int("count", len(protoUsers)))
@@ -0,0 +1,133 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Abdurahmanit/GroupProject/platform/natsdedup"
+	"github.com/Abdurahmanit/GroupProject/user-service/internal/mailer"
+	"github.com/Abdurahmanit/GroupProject/user-service/internal/usecase"
+	"github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+)
+
+const (
+	orderCreatedSubject = "order.created"
+	// orderEventsQueue makes replicas share the subscription so each event is handled once.
+	orderEventsQueue  = "user-service-order-confirmation"
+	orderEventTimeout = time.Minute
+)
+
+// orderCreatedEvent mirrors the JSON encoding of order-service's OrderProto, keeping only
+// the fields the confirmation email needs.
+type orderCreatedEvent struct {
+	ID          string `json:"id"`
+	UserID      string `json:"user_id"`
+	OrderNumber string `json:"order_number"`
+	Items       []struct {
+		ProductName  string  `json:"product_name"`
+		Quantity     int32   `json:"quantity"`
+		PricePerUnit float64 `json:"price_per_unit"`
+		TotalPrice   float64 `json:"total_price"`
+	} `json:"items"`
+	TotalAmount     float64       `json:"total_amount"`
+	ShippingAddress *eventAddress `json:"shipping_address"`
+	BillingAddress  *eventAddress `json:"billing_address"`
+}
+
+type eventAddress struct {
+	Street     string `json:"street"`
+	City       string `json:"city"`
+	PostalCode string `json:"postal_code"`
+	Country    string `json:"country"`
+}
+
+func (a *eventAddress) String() string {
+	if a == nil {
+		return ""
+	}
+	var parts []string
+	for _, p := range []string{a.Street, a.City, a.PostalCode, a.Country} {
+		if p != "" {
+			parts = append(parts, p)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// OrderEventConsumer sends order confirmation emails for "order.created" events.
+type OrderEventConsumer struct {
+	conn   *nats.Conn
+	ucase  *usecase.UserUsecase
+	dedup  *natsdedup.Deduplicator
+	logger *zap.Logger
+	sub    *nats.Subscription
+}
+
+// NewOrderEventConsumer creates the consumer. dedup may be nil to disable message deduplication.
+func NewOrderEventConsumer(conn *nats.Conn, ucase *usecase.UserUsecase, dedup *natsdedup.Deduplicator, logger *zap.Logger) *OrderEventConsumer {
+	return &OrderEventConsumer{conn: conn, ucase: ucase, dedup: dedup, logger: logger.Named("OrderEventConsumer")}
+}
+
+// Start subscribes to order.created. Messages are handled on the NATS delivery goroutine,
+// one at a time.
+func (c *OrderEventConsumer) Start() error {
+	sub, err := c.conn.QueueSubscribe(orderCreatedSubject, orderEventsQueue, c.dedup.Wrap(orderEventsQueue, c.handle))
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to %s: %w", orderCreatedSubject, err)
+	}
+	c.sub = sub
+	c.logger.Info("Subscribed to order events", zap.String("subject", orderCreatedSubject), zap.String("queue", orderEventsQueue))
+	return nil
+}
+
+// Stop drains the subscription so in-flight emails finish.
+func (c *OrderEventConsumer) Stop() {
+	if c.sub == nil {
+		return
+	}
+	if err := c.sub.Drain(); err != nil {
+		c.logger.Warn("Failed to drain order events subscription", zap.Error(err))
+	}
+}
+
+func (c *OrderEventConsumer) handle(msg *nats.Msg) error {
+	var event orderCreatedEvent
+	if err := json.Unmarshal(msg.Data, &event); err != nil {
+		c.logger.Error("Failed to decode order.created event", zap.Error(err))
+		return nil
+	}
+	if event.ID == "" || event.UserID == "" {
+		c.logger.Warn("Ignoring order.created event without order or user ID", zap.String("orderID", event.ID))
+		return nil
+	}
+
+	order := mailer.OrderConfirmation{
+		OrderNumber:     event.OrderNumber,
+		TotalAmount:     event.TotalAmount,
+		ShippingAddress: event.ShippingAddress.String(),
+		BillingAddress:  event.BillingAddress.String(),
+	}
+	if order.OrderNumber == "" {
+		order.OrderNumber = event.ID
+	}
+	for _, item := range event.Items {
+		order.Items = append(order.Items, mailer.OrderConfirmationItem{
+			Name:      item.ProductName,
+			Quantity:  item.Quantity,
+			UnitPrice: item.PricePerUnit,
+			Total:     item.TotalPrice,
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), orderEventTimeout)
+	defer cancel()
+	if err := c.ucase.SendOrderConfirmation(ctx, event.UserID, event.ID, order); err != nil {
+		c.logger.Error("Failed to send order confirmation", zap.String("orderID", event.ID), zap.Error(err))
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/Abdurahmanit/GroupProject/platform/natsdedup"
+	"github.com/nats-io/nats.go"
+)
+
+// userDeletedSubject is consumed by news-service, which cleans up the user's comments.
+const userDeletedSubject = "user.deleted"
+
+type userDeletedEvent struct {
+	UserID string `json:"user_id"`
+}
+
+// UserEventPublisher publishes account lifecycle events to NATS.
+type UserEventPublisher struct {
+	conn *nats.Conn
+}
+
+func NewUserEventPublisher(conn *nats.Conn) *UserEventPublisher {
+	return &UserEventPublisher{conn: conn}
+}
+
+// PublishUserDeleted announces that the user was deleted. Each deletion gets its own message
+// ID, so a user deleted again after a restore is cleaned up again.
+func (p *UserEventPublisher) PublishUserDeleted(ctx context.Context, userID string) error {
+	data, err := json.Marshal(userDeletedEvent{UserID: userID})
+	if err != nil {
+		return fmt.Errorf("failed to encode %s event: %w", userDeletedSubject, err)
+	}
+	msgID := userDeletedSubject + ":" + userID + ":" + strconv.FormatInt(time.Now().UnixNano(), 10)
+	if err := p.conn.PublishMsg(natsdedup.NewMsg(userDeletedSubject, msgID, data)); err != nil {
+		return fmt.Errorf("failed to publish %s event: %w", userDeletedSubject, err)
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package config
+
+import (
+	"strings"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/spf13/viper"
+)
+
+// SplitList splits a comma-separated config value, dropping empty entries.
+func SplitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// WatchEmailBlocklist loads the blocklist file at path and calls onChange with its
+// "domains" and "addresses" lists, then again every time the file is modified.
+func WatchEmailBlocklist(path string, onChange func(domains, addresses []string)) error {
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return err
+	}
+	onChange(v.GetStringSlice("domains"), v.GetStringSlice("addresses"))
+
+	v.OnConfigChange(func(fsnotify.Event) {
+		onChange(v.GetStringSlice("domains"), v.GetStringSlice("addresses"))
+	})
+	v.WatchConfig()
+	return nil
+}
@@ -12,6 +12,67 @@ type Config struct {
 
 	MailerType string `mapstructure:"MAILER_TYPE"` // "mailersend" or "smtp"
 
+	PasswordResetCodeExpiryMinutes int `mapstructure:"PASSWORD_RESET_CODE_EXPIRY_MINUTES"`
+	// A reset code is discarded after PasswordResetMaxAttempts wrong guesses
+	PasswordResetMaxAttempts int `mapstructure:"PASSWORD_RESET_MAX_ATTEMPTS"`
+
+	// Login lockout: after LoginMaxFailedAttempts failures for one email within
+	// LoginLockoutWindowMinutes, further logins for that email are rejected until the window expires.
+	LoginMaxFailedAttempts    int `mapstructure:"LOGIN_MAX_FAILED_ATTEMPTS"`
+	LoginLockoutWindowMinutes int `mapstructure:"LOGIN_LOCKOUT_WINDOW_MINUTES"`
+
+	// Two-factor authentication
+	TwoFactorIssuer        string `mapstructure:"TWO_FACTOR_ISSUER"`         // Shown in authenticator apps
+	TwoFactorEncryptionKey string `mapstructure:"TWO_FACTOR_ENCRYPTION_KEY"` // Encrypts TOTP secrets at rest
+
+	// Password strength rules applied on Register, ChangePassword and ResetPassword
+	PasswordMinLength     int  `mapstructure:"PASSWORD_MIN_LENGTH"`
+	PasswordRequireUpper  bool `mapstructure:"PASSWORD_REQUIRE_UPPER"`
+	PasswordRequireLower  bool `mapstructure:"PASSWORD_REQUIRE_LOWER"`
+	PasswordRequireDigit  bool `mapstructure:"PASSWORD_REQUIRE_DIGIT"`
+	PasswordRequireSymbol bool `mapstructure:"PASSWORD_REQUIRE_SYMBOL"`
+
+	// When true, registering again with the email and password of an unverified account
+	// returns the existing user ID and resends verification instead of a duplicate email error.
+	IdempotentRegistration bool `mapstructure:"IDEMPOTENT_REGISTRATION"`
+
+	// Minimum time between RequestEmailVerification emails for one user; 0 disables the cooldown.
+	EmailVerificationCooldownSeconds int `mapstructure:"EMAIL_VERIFICATION_COOLDOWN_SECONDS"`
+
+	// Email blocklist checked on registration and email change. Domains and addresses are
+	// comma-separated; domains may use wildcards like "*.mailinator.com". EmailBlocklistFile
+	// optionally points to a YAML/JSON file with "domains" and "addresses" lists that is
+	// reloaded whenever it changes.
+	EmailBlockedDomains   string `mapstructure:"EMAIL_BLOCKED_DOMAINS"`
+	EmailBlockedAddresses string `mapstructure:"EMAIL_BLOCKED_ADDRESSES"`
+	EmailBlocklistFile    string `mapstructure:"EMAIL_BLOCKLIST_FILE"`
+
+	// Avatar storage (MinIO/S3). Avatar uploads are disabled when MinIOEndpoint is empty.
+	// AvatarMaxBytes must stay below the gRPC message limit (4 MiB by default).
+	MinIOEndpoint  string `mapstructure:"MINIO_ENDPOINT"`
+	MinIOAccessKey string `mapstructure:"MINIO_ACCESS_KEY"`
+	MinIOSecretKey string `mapstructure:"MINIO_SECRET_KEY"`
+	MinIOBucket    string `mapstructure:"MINIO_BUCKET"`
+	MinIOUseSSL    bool   `mapstructure:"MINIO_USE_SSL"`
+	AvatarMaxBytes int64  `mapstructure:"AVATAR_MAX_BYTES"`
+
+	// When true, the service consumes "order.created" events from NATS and emails an order
+	// confirmation to the customer.
+	OrderConfirmationEmails bool `mapstructure:"ORDER_CONFIRMATION_EMAILS"`
+	// When true, the service publishes "user.deleted" to NATS when an account is deleted, so
+	// other services can clean up the user's data. Off by default so the service still starts
+	// without NATS. NATSURL is used when either is enabled.
+	UserEvents bool   `mapstructure:"USER_EVENTS"`
+	NATSURL    string `mapstructure:"NATS_URL"`
+	// Event consumers skip messages already handled within NATSDedupTTLHours (tracked in Redis).
+	// The TTL must exceed the longest redelivery window.
+	NATSDedupEnabled  bool `mapstructure:"NATS_DEDUP_ENABLED"`
+	NATSDedupTTLHours int  `mapstructure:"NATS_DEDUP_TTL_HOURS"`
+
+	// When true, the service writes and reads a canary in MongoDB and Redis (and publishes to
+	// "user.selftest" if NATS is in use) before serving, and exits if any step fails.
+	StartupSelfTest bool `mapstructure:"STARTUP_SELF_TEST"`
+
 	// MailerSend specific
 	MailerSendAPIKey    string `mapstructure:"MAILERSEND_API_KEY"`
 	MailerSendFromEmail string `mapstructure:"MAILERSEND_FROM_EMAIL"`
@@ -33,6 +94,50 @@ func LoadConfig() (*Config, error) {
 	viper.BindEnv("redis_addr", "REDIS_ADDR")
 	viper.BindEnv("jwt_secret", "JWT_SECRET")
 	viper.BindEnv("mailer_type", "MAILER_TYPE")
+	viper.BindEnv("password_reset_code_expiry_minutes", "PASSWORD_RESET_CODE_EXPIRY_MINUTES")
+	viper.BindEnv("password_reset_max_attempts", "PASSWORD_RESET_MAX_ATTEMPTS")
+	viper.BindEnv("login_max_failed_attempts", "LOGIN_MAX_FAILED_ATTEMPTS")
+	viper.BindEnv("login_lockout_window_minutes", "LOGIN_LOCKOUT_WINDOW_MINUTES")
+	viper.BindEnv("two_factor_issuer", "TWO_FACTOR_ISSUER")
+	viper.BindEnv("two_factor_encryption_key", "TWO_FACTOR_ENCRYPTION_KEY")
+	viper.BindEnv("password_min_length", "PASSWORD_MIN_LENGTH")
+	viper.BindEnv("password_require_upper", "PASSWORD_REQUIRE_UPPER")
+	viper.BindEnv("password_require_lower", "PASSWORD_REQUIRE_LOWER")
+	viper.BindEnv("password_require_digit", "PASSWORD_REQUIRE_DIGIT")
+	viper.BindEnv("password_require_symbol", "PASSWORD_REQUIRE_SYMBOL")
+	viper.BindEnv("idempotent_registration", "IDEMPOTENT_REGISTRATION")
+	viper.BindEnv("email_verification_cooldown_seconds", "EMAIL_VERIFICATION_COOLDOWN_SECONDS")
+	viper.BindEnv("email_blocked_domains", "EMAIL_BLOCKED_DOMAINS")
+	viper.BindEnv("email_blocked_addresses", "EMAIL_BLOCKED_ADDRESSES")
+	viper.BindEnv("email_blocklist_file", "EMAIL_BLOCKLIST_FILE")
+	viper.BindEnv("minio_endpoint", "MINIO_ENDPOINT")
+	viper.BindEnv("minio_access_key", "MINIO_ACCESS_KEY")
+	viper.BindEnv("minio_secret_key", "MINIO_SECRET_KEY")
+	viper.BindEnv("minio_bucket", "MINIO_BUCKET")
+	viper.BindEnv("minio_use_ssl", "MINIO_USE_SSL")
+	viper.BindEnv("avatar_max_bytes", "AVATAR_MAX_BYTES")
+	viper.BindEnv("order_confirmation_emails", "ORDER_CONFIRMATION_EMAILS")
+	viper.BindEnv("user_events", "USER_EVENTS")
+	viper.BindEnv("nats_url", "NATS_URL")
+	viper.BindEnv("nats_dedup_enabled", "NATS_DEDUP_ENABLED")
+	viper.BindEnv("nats_dedup_ttl_hours", "NATS_DEDUP_TTL_HOURS")
+	viper.BindEnv("startup_self_test", "STARTUP_SELF_TEST")
+
+	// Character class rules are on unless explicitly disabled
+	viper.SetDefault("password_require_upper", true)
+	viper.SetDefault("password_require_lower", true)
+	viper.SetDefault("password_require_digit", true)
+	viper.SetDefault("password_require_symbol", true)
+
+	viper.SetDefault("email_verification_cooldown_seconds", 60)
+
+	viper.SetDefault("minio_bucket", "user-avatars")
+	viper.SetDefault("avatar_max_bytes", 2<<20)
+
+	viper.SetDefault("user_events", false)
+	viper.SetDefault("nats_url", "nats://localhost:4222")
+	viper.SetDefault("nats_dedup_enabled", true)
+	viper.SetDefault("nats_dedup_ttl_hours", 24)
 
 	// Bind MailerSend specific
 	viper.BindEnv("mailersend_api_key", "MAILERSEND_API_KEY")
@@ -59,5 +164,24 @@ func LoadConfig() (*Config, error) {
 		cfg.MailerType = "mailersend" // Or "smtp" depending on primary choice
 	}
 
+	if cfg.PasswordResetCodeExpiryMinutes <= 0 {
+		cfg.PasswordResetCodeExpiryMinutes = 15
+	}
+	if cfg.PasswordResetMaxAttempts <= 0 {
+		cfg.PasswordResetMaxAttempts = 5
+	}
+	if cfg.LoginMaxFailedAttempts <= 0 {
+		cfg.LoginMaxFailedAttempts = 5
+	}
+	if cfg.LoginLockoutWindowMinutes <= 0 {
+		cfg.LoginLockoutWindowMinutes = 15
+	}
+	if cfg.PasswordMinLength <= 0 {
+		cfg.PasswordMinLength = 8
+	}
+	if cfg.TwoFactorIssuer == "" {
+		cfg.TwoFactorIssuer = "BicycleShop"
+	}
+
 	return &cfg, nil
 }
@@ -20,4 +20,12 @@ type User struct {
 	EmailVerifiedAt                *time.Time
 	EmailVerificationCode          string
 	EmailVerificationCodeExpiresAt *time.Time
+	PasswordResetCode              string
+	PasswordResetCodeExpiresAt     *time.Time
+	TwoFactorEnabled               bool
+	TwoFactorSecret                string // AES-GCM encrypted TOTP secret, see internal/secretbox
+	TwoFactorEnabledAt             *time.Time
+	LastLoginAt                    *time.Time
+	AvatarURL                      string
+	DeletedAt                      *time.Time // Set when soft-deleted; such users are hidden from all normal queries
 }
@@ -1,17 +1,148 @@
 package jwt
 
 import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 )
 
+const (
+	AccessTokenTTL           = 15 * time.Minute
+	RefreshTokenTTL          = 7 * 24 * time.Hour
+	TwoFactorPendingTokenTTL = 5 * time.Minute
+
+	tokenTypeAccess           = "access"
+	tokenTypeRefresh          = "refresh"
+	tokenTypeTwoFactorPending = "2fa_pending"
+)
+
+var (
+	ErrInvalidToken = errors.New("invalid token")
+	ErrTokenExpired = errors.New("token has expired")
+)
+
+// GenerateToken issues a short-lived access token. Services accept only tokens whose "type"
+// claim is "access", so refresh and pending 2FA tokens cannot authorize requests.
 func GenerateToken(userID, secret string) (string, error) {
 	claims := jwt.MapClaims{
 		"user_id": userID,
-		"exp":     time.Now().Add(24 * time.Hour).Unix(),
+		"type":    tokenTypeAccess,
+		"iat":     time.Now().Unix(),
+		"exp":     time.Now().Add(AccessTokenTTL).Unix(),
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString([]byte(secret))
+}
+
+// NewSessionID returns a random ID for a login session. Every refresh token carries the ID of
+// the session it belongs to, so sessions on different devices are tracked separately.
+func NewSessionID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// GenerateRefreshToken issues a long-lived token of the given session that can only be
+// exchanged for a new access token.
+func GenerateRefreshToken(userID, sessionID, secret string) (string, error) {
+	claims := jwt.MapClaims{
+		"user_id": userID,
+		"sid":     sessionID,
+		"type":    tokenTypeRefresh,
+		"iat":     time.Now().Unix(),
+		"exp":     time.Now().Add(RefreshTokenTTL).Unix(),
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString([]byte(secret))
+}
+
+// GenerateTwoFactorPendingToken issues a short-lived token proving the password step of a
+// login succeeded; it can only be exchanged for real tokens together with a valid 2FA code.
+func GenerateTwoFactorPendingToken(userID, secret string) (string, error) {
+	claims := jwt.MapClaims{
+		"user_id": userID,
+		"type":    tokenTypeTwoFactorPending,
+		"exp":     time.Now().Add(TwoFactorPendingTokenTTL).Unix(),
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	return token.SignedString([]byte(secret))
 }
+
+// ParseAccessToken validates an access token and returns the user ID and issue time.
+// The issue time is zero for tokens minted before access tokens carried an "iat" claim.
+func ParseAccessToken(tokenString, secret string) (string, time.Time, error) {
+	userID, claims, err := parseClaims(tokenString, secret, tokenTypeAccess)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return userID, issuedAt(claims), nil
+}
+
+// RefreshClaims are the claims of a valid refresh token. SessionID is empty for tokens issued
+// before refresh tokens carried a session.
+type RefreshClaims struct {
+	UserID    string
+	SessionID string
+	IssuedAt  time.Time
+}
+
+// ParseRefreshToken validates a refresh token and returns its claims.
+func ParseRefreshToken(tokenString, secret string) (*RefreshClaims, error) {
+	userID, claims, err := parseClaims(tokenString, secret, tokenTypeRefresh)
+	if err != nil {
+		return nil, err
+	}
+	sessionID, _ := claims["sid"].(string)
+	return &RefreshClaims{UserID: userID, SessionID: sessionID, IssuedAt: issuedAt(claims)}, nil
+}
+
+// ParseTwoFactorPendingToken validates a pending 2FA token and returns the user ID it was issued for.
+func ParseTwoFactorPendingToken(tokenString, secret string) (string, error) {
+	return parseTypedToken(tokenString, secret, tokenTypeTwoFactorPending)
+}
+
+func parseTypedToken(tokenString, secret, expectedType string) (string, error) {
+	userID, _, err := parseClaims(tokenString, secret, expectedType)
+	return userID, err
+}
+
+func parseClaims(tokenString, secret, expectedType string) (string, jwt.MapClaims, error) {
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		return []byte(secret), nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return "", nil, ErrTokenExpired
+		}
+		return "", nil, ErrInvalidToken
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || !token.Valid {
+		return "", nil, ErrInvalidToken
+	}
+	if tokenType, _ := claims["type"].(string); tokenType != expectedType {
+		return "", nil, ErrInvalidToken
+	}
+	userID, _ := claims["user_id"].(string)
+	if userID == "" {
+		return "", nil, ErrInvalidToken
+	}
+	return userID, claims, nil
+}
+
+func issuedAt(claims jwt.MapClaims) time.Time {
+	iat, err := claims.GetIssuedAt()
+	if err != nil || iat == nil {
+		return time.Time{}
+	}
+	return iat.Time
+}
@@ -1,6 +1,10 @@
 package mailer
 
+import "time"
+
 // Mailer defines the interface for sending emails.
 type Mailer interface {
 	SendEmailVerification(toEmail, toName, verificationCode string) error
+	SendPasswordReset(toEmail, toName, resetCode string, expiresIn time.Duration) error
+	SendOrderConfirmation(toEmail, toName string, order OrderConfirmation) error
 }
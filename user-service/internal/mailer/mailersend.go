@@ -72,6 +72,58 @@ func (s *MailerSendService) SendEmailVerification(toEmailAddr, toName, verificat
                            This code will expire in 15 minutes.
                            If you did not request this, please ignore this email.`, toName, verificationCode)
 
+	messageID, err := s.send(toEmailAddr, toName, subject, textBody, htmlBody, verificationCode)
+	if err != nil {
+		return err
+	}
+
+	s.logger.Info("Verification email sent successfully via MailerSend", zap.String("toEmail", toEmailAddr), zap.String("messageID", messageID))
+	return nil
+}
+
+// SendPasswordReset sends a password reset code to the user.
+func (s *MailerSendService) SendPasswordReset(toEmailAddr, toName, resetCode string, expiresIn time.Duration) error {
+	s.logger.Info("Attempting to send password reset email", zap.String("toEmail", toEmailAddr))
+
+	subject := "Reset Your Password"
+	minutes := int(expiresIn.Minutes())
+	htmlBody := fmt.Sprintf(`<p>Hello %s,</p>
+                             <p>Your password reset code is: <b>%s</b></p>
+                             <p>This code will expire in %d minutes.</p>
+                             <p>If you did not request a password reset, please ignore this email.</p>`, toName, resetCode, minutes)
+	textBody := fmt.Sprintf(`Hello %s,
+                           Your password reset code is: %s
+                           This code will expire in %d minutes.
+                           If you did not request a password reset, please ignore this email.`, toName, resetCode, minutes)
+
+	messageID, err := s.send(toEmailAddr, toName, subject, textBody, htmlBody, resetCode)
+	if err != nil {
+		return err
+	}
+
+	s.logger.Info("Password reset email sent successfully via MailerSend", zap.String("toEmail", toEmailAddr), zap.String("messageID", messageID))
+	return nil
+}
+
+// SendOrderConfirmation sends the order confirmation email.
+func (s *MailerSendService) SendOrderConfirmation(toEmailAddr, toName string, order OrderConfirmation) error {
+	s.logger.Info("Attempting to send order confirmation email", zap.String("toEmail", toEmailAddr), zap.String("orderNumber", order.OrderNumber))
+
+	subject, textBody, htmlBody, err := renderOrderConfirmation(toName, order)
+	if err != nil {
+		return err
+	}
+	messageID, err := s.send(toEmailAddr, toName, subject, textBody, htmlBody, order.OrderNumber)
+	if err != nil {
+		return err
+	}
+
+	s.logger.Info("Order confirmation email sent successfully via MailerSend", zap.String("toEmail", toEmailAddr), zap.String("messageID", messageID))
+	return nil
+}
+
+// send posts a single email to the MailerSend API and returns the message ID.
+func (s *MailerSendService) send(toEmailAddr, toName, subject, textBody, htmlBody, code string) (string, error) {
 	requestPayload := mailerSendRequest{
 		From: fromEmail{
 			Email: s.fromEmail,
@@ -88,7 +140,7 @@ func (s *MailerSendService) SendEmailVerification(toEmailAddr, toName, verificat
 				Email: toEmailAddr,
 				Data: map[string]string{
 					"name": toName,
-					"code": verificationCode,
+					"code": code,
 				},
 			},
 		},
@@ -97,13 +149,13 @@ func (s *MailerSendService) SendEmailVerification(toEmailAddr, toName, verificat
 	payloadBytes, err := json.Marshal(requestPayload)
 	if err != nil {
 		s.logger.Error("Failed to marshal MailerSend request payload", zap.Error(err))
-		return fmt.Errorf("failed to marshal request payload: %w", err)
+		return "", fmt.Errorf("failed to marshal request payload: %w", err)
 	}
 
 	req, err := http.NewRequest("POST", mailerSendAPIURL, bytes.NewBuffer(payloadBytes))
 	if err != nil {
 		s.logger.Error("Failed to create MailerSend HTTP request", zap.Error(err))
-		return fmt.Errorf("failed to create http request: %w", err)
+		return "", fmt.Errorf("failed to create http request: %w", err)
 	}
 
 	req.Header.Set("Content-Type", "application/json")
@@ -112,15 +164,13 @@ func (s *MailerSendService) SendEmailVerification(toEmailAddr, toName, verificat
 	resp, err := s.client.Do(req)
 	if err != nil {
 		s.logger.Error("Failed to send request to MailerSend", zap.Error(err))
-		return fmt.Errorf("failed to send request to MailerSend: %w", err)
+		return "", fmt.Errorf("failed to send request to MailerSend: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusAccepted {
 		s.logger.Error("MailerSend API request failed", zap.Int("statusCode", resp.StatusCode))
-		return fmt.Errorf("MailerSend API request failed with status code %d", resp.StatusCode)
+		return "", fmt.Errorf("MailerSend API request failed with status code %d", resp.StatusCode)
 	}
-
-	s.logger.Info("Verification email sent successfully via MailerSend", zap.String("toEmail", toEmailAddr), zap.String("messageID", resp.Header.Get("X-Message-Id")))
-	return nil
+	return resp.Header.Get("X-Message-Id"), nil
 }
@@ -0,0 +1,76 @@
+package mailer
+
+import (
+	"bytes"
+	"fmt"
+	htmltemplate "html/template"
+	texttemplate "text/template"
+)
+
+// OrderConfirmation is the data rendered into an order confirmation email.
+type OrderConfirmation struct {
+	OrderNumber     string
+	Items           []OrderConfirmationItem
+	TotalAmount     float64
+	ShippingAddress string
+	BillingAddress  string
+}
+
+type OrderConfirmationItem struct {
+	Name      string
+	Quantity  int32
+	UnitPrice float64
+	Total     float64
+}
+
+type orderConfirmationData struct {
+	Name string
+	OrderConfirmation
+}
+
+var orderConfirmationTemplateFuncs = map[string]any{
+	"money": func(v float64) string { return fmt.Sprintf("%.2f", v) },
+}
+
+var orderConfirmationText = texttemplate.Must(texttemplate.New("order_confirmation_text").Funcs(orderConfirmationTemplateFuncs).Parse(
+	`Hello {{.Name}},
+
+Thank you for your order {{.OrderNumber}}.
+
+Items:
+{{range .Items}}- {{.Name}} x{{.Quantity}} @ {{money .UnitPrice}} = {{money .Total}}
+{{end}}
+Total: {{money .TotalAmount}}
+{{if .ShippingAddress}}
+Shipping address: {{.ShippingAddress}}{{end}}{{if .BillingAddress}}
+Billing address: {{.BillingAddress}}{{end}}
+
+We will let you know when your order ships.
+`))
+
+var orderConfirmationHTML = htmltemplate.Must(htmltemplate.New("order_confirmation_html").Funcs(orderConfirmationTemplateFuncs).Parse(
+	`<p>Hello {{.Name}},</p>
+<p>Thank you for your order <b>{{.OrderNumber}}</b>.</p>
+<table>
+  <tr><th align="left">Item</th><th>Qty</th><th align="right">Price</th><th align="right">Total</th></tr>
+  {{range .Items}}<tr><td>{{.Name}}</td><td align="center">{{.Quantity}}</td><td align="right">{{money .UnitPrice}}</td><td align="right">{{money .Total}}</td></tr>
+  {{end}}
+</table>
+<p><b>Total: {{money .TotalAmount}}</b></p>
+{{if .ShippingAddress}}<p>Shipping address: {{.ShippingAddress}}</p>{{end}}
+{{if .BillingAddress}}<p>Billing address: {{.BillingAddress}}</p>{{end}}
+<p>We will let you know when your order ships.</p>`))
+
+// renderOrderConfirmation returns the subject, plain text and HTML bodies of the email.
+func renderOrderConfirmation(toName string, order OrderConfirmation) (string, string, string, error) {
+	data := orderConfirmationData{Name: toName, OrderConfirmation: order}
+
+	var text, html bytes.Buffer
+	if err := orderConfirmationText.Execute(&text, data); err != nil {
+		return "", "", "", fmt.Errorf("failed to render order confirmation text: %w", err)
+	}
+	if err := orderConfirmationHTML.Execute(&html, data); err != nil {
+		return "", "", "", fmt.Errorf("failed to render order confirmation html: %w", err)
+	}
+	return "Order confirmation " + order.OrderNumber, text.String(), html.String(), nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/smtp"
 	"strings"
+	"time"
 
 	"go.uber.org/zap"
 )
@@ -51,6 +52,62 @@ func (s *SMTPMailerService) SendEmailVerification(toEmailAddr, toName, verificat
                            This code will expire in 15 minutes.
                            If you did not request this, please ignore this email.`, toName, verificationCode)
 
+	if err := s.send(toEmailAddr, subject, plainTextBodyContent, htmlBodyContent); err != nil {
+		return err
+	}
+
+	s.logger.Info("Verification email sent successfully via SMTP", zap.String("toEmail", toEmailAddr))
+	return nil
+}
+
+// SendPasswordReset sends a password reset code using SMTP.
+func (s *SMTPMailerService) SendPasswordReset(toEmailAddr, toName, resetCode string, expiresIn time.Duration) error {
+	s.logger.Info("Attempting to send password reset email via SMTP",
+		zap.String("toEmail", toEmailAddr),
+		zap.String("smtpHost", s.host),
+		zap.Int("smtpPort", s.port))
+
+	subject := "Reset Your Password"
+	minutes := int(expiresIn.Minutes())
+
+	htmlBodyContent := fmt.Sprintf(`<p>Hello %s,</p>
+                             <p>Your password reset code is: <b>%s</b></p>
+                             <p>This code will expire in %d minutes.</p>
+                             <p>If you did not request a password reset, please ignore this email.</p>`, toName, resetCode, minutes)
+
+	plainTextBodyContent := fmt.Sprintf(`Hello %s,
+                           Your password reset code is: %s
+                           This code will expire in %d minutes.
+                           If you did not request a password reset, please ignore this email.`, toName, resetCode, minutes)
+
+	if err := s.send(toEmailAddr, subject, plainTextBodyContent, htmlBodyContent); err != nil {
+		return err
+	}
+
+	s.logger.Info("Password reset email sent successfully via SMTP", zap.String("toEmail", toEmailAddr))
+	return nil
+}
+
+// SendOrderConfirmation sends the order confirmation email using SMTP.
+func (s *SMTPMailerService) SendOrderConfirmation(toEmailAddr, toName string, order OrderConfirmation) error {
+	s.logger.Info("Attempting to send order confirmation email via SMTP",
+		zap.String("toEmail", toEmailAddr),
+		zap.String("orderNumber", order.OrderNumber))
+
+	subject, plainTextBodyContent, htmlBodyContent, err := renderOrderConfirmation(toName, order)
+	if err != nil {
+		return err
+	}
+	if err := s.send(toEmailAddr, subject, plainTextBodyContent, htmlBodyContent); err != nil {
+		return err
+	}
+
+	s.logger.Info("Order confirmation email sent successfully via SMTP", zap.String("toEmail", toEmailAddr), zap.String("orderNumber", order.OrderNumber))
+	return nil
+}
+
+// send builds a multipart/alternative message and delivers it over SMTP.
+func (s *SMTPMailerService) send(toEmailAddr, subject, plainTextBodyContent, htmlBodyContent string) error {
 	auth := smtp.PlainAuth("", s.username, s.password, s.host)
 
 	// Email headers
@@ -107,7 +164,5 @@ func (s *SMTPMailerService) SendEmailVerification(toEmailAddr, toName, verificat
 			zap.String("smtpHost", s.host))
 		return fmt.Errorf("smtp.SendMail failed: %w", err)
 	}
-
-	s.logger.Info("Verification email sent successfully via SMTP", zap.String("toEmail", toEmailAddr))
 	return nil
 }
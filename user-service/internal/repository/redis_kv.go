@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// RedisKV exposes the go-redis v8 client to the shared selftest (KeyValueStore) and natsdedup
+// (Store) packages, which are written against go-redis v9.
+type RedisKV struct {
+	client *redis.Client
+}
+
+func NewRedisKV(client *redis.Client) *RedisKV {
+	return &RedisKV{client: client}
+}
+
+func (kv *RedisKV) Set(ctx context.Context, key, value string, ttl time.Duration) error {
+	return kv.client.Set(ctx, key, value, ttl).Err()
+}
+
+func (kv *RedisKV) Get(ctx context.Context, key string) (string, error) {
+	return kv.client.Get(ctx, key).Result()
+}
+
+func (kv *RedisKV) Del(ctx context.Context, key string) error {
+	return kv.client.Del(ctx, key).Err()
+}
+
+// Claim records that an event consumer is handling the message stored under key.
+// It returns false when the message was already claimed.
+func (kv *RedisKV) Claim(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	return kv.client.SetNX(ctx, key, 1, ttl).Result()
+}
+
+// Release drops a claim whose handling failed so a redelivery is processed.
+func (kv *RedisKV) Release(ctx context.Context, key string) error {
+	return kv.client.Del(ctx, key).Err()
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/Abdurahmanit/GroupProject/user-service/internal/entity"
+	"github.com/Abdurahmanit/GroupProject/user-service/internal/jwt"
 	"github.com/go-redis/redis/v8"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -19,6 +20,7 @@ import (
 var (
 	ErrDuplicateEmail       = errors.New("email already exists")
 	ErrDuplicatePhoneNumber = errors.New("phone number already exists")
+	ErrDuplicateUsername    = errors.New("username already exists")
 	ErrUserNotFound         = errors.New("user not found")
 )
 
@@ -36,10 +38,28 @@ type mongoUser struct {
 	EmailVerifiedAt                *time.Time         `bson:"email_verified_at,omitempty"`
 	EmailVerificationCode          string             `bson:"email_verification_code,omitempty"`
 	EmailVerificationCodeExpiresAt *time.Time         `bson:"email_verification_code_expires_at,omitempty"`
+	PasswordResetCode              string             `bson:"password_reset_code,omitempty"`
+	PasswordResetCodeExpiresAt     *time.Time         `bson:"password_reset_code_expires_at,omitempty"`
+	TwoFactorEnabled               bool               `bson:"two_factor_enabled,omitempty"`
+	TwoFactorSecret                string             `bson:"two_factor_secret,omitempty"`
+	TwoFactorEnabledAt             *time.Time         `bson:"two_factor_enabled_at,omitempty"`
+	LastLoginAt                    *time.Time         `bson:"last_login_at,omitempty"`
+	AvatarURL                      string             `bson:"avatar_url,omitempty"`
+	DeletedAt                      *time.Time         `bson:"deleted_at,omitempty"`
+	DeletedIdentity                *deletedIdentity   `bson:"deleted_identity,omitempty"`
+}
+
+// deletedIdentity keeps the unique fields of a soft-deleted user. While the user is deleted
+// the fields themselves hold tombstones, so the email, phone number and username can be
+// registered again.
+type deletedIdentity struct {
+	Email       string `bson:"email"`
+	PhoneNumber string `bson:"phone_number,omitempty"`
+	Username    string `bson:"username"`
 }
 
 func (m *mongoUser) toEntity() *entity.User {
-	return &entity.User{
+	user := &entity.User{
 		ID:                             m.ID,
 		Username:                       m.Username,
 		Email:                          m.Email,
@@ -53,7 +73,21 @@ func (m *mongoUser) toEntity() *entity.User {
 		EmailVerifiedAt:                m.EmailVerifiedAt,
 		EmailVerificationCode:          m.EmailVerificationCode,
 		EmailVerificationCodeExpiresAt: m.EmailVerificationCodeExpiresAt,
-	}
+		PasswordResetCode:              m.PasswordResetCode,
+		PasswordResetCodeExpiresAt:     m.PasswordResetCodeExpiresAt,
+		TwoFactorEnabled:               m.TwoFactorEnabled,
+		TwoFactorSecret:                m.TwoFactorSecret,
+		TwoFactorEnabledAt:             m.TwoFactorEnabledAt,
+		LastLoginAt:                    m.LastLoginAt,
+		AvatarURL:                      m.AvatarURL,
+		DeletedAt:                      m.DeletedAt,
+	}
+	if m.DeletedIdentity != nil {
+		user.Email = m.DeletedIdentity.Email
+		user.PhoneNumber = m.DeletedIdentity.PhoneNumber
+		user.Username = m.DeletedIdentity.Username
+	}
+	return user
 }
 
 func fromEntity(e *entity.User) *mongoUser {
@@ -71,9 +105,73 @@ func fromEntity(e *entity.User) *mongoUser {
 		EmailVerifiedAt:                e.EmailVerifiedAt,
 		EmailVerificationCode:          e.EmailVerificationCode,
 		EmailVerificationCodeExpiresAt: e.EmailVerificationCodeExpiresAt,
+		PasswordResetCode:              e.PasswordResetCode,
+		PasswordResetCodeExpiresAt:     e.PasswordResetCodeExpiresAt,
+		TwoFactorEnabled:               e.TwoFactorEnabled,
+		TwoFactorSecret:                e.TwoFactorSecret,
+		TwoFactorEnabledAt:             e.TwoFactorEnabledAt,
+		LastLoginAt:                    e.LastLoginAt,
+		AvatarURL:                      e.AvatarURL,
+		DeletedAt:                      e.DeletedAt,
+	}
+}
+
+// usernameCollation makes username comparisons case-insensitive ("Alice" == "alice").
+// Queries must pass it too so they can use the unique username index.
+var usernameCollation = &options.Collation{Locale: "en", Strength: 2}
+
+// notDeleted restricts filter to users that have not been soft-deleted. {deleted_at: null}
+// matches documents where the field is missing, which covers users created before soft delete.
+func notDeleted(filter bson.M) bson.M {
+	filter["deleted_at"] = nil
+	return filter
+}
+
+// tombstoneIdentity is the pipeline stage pair SoftDeleteUser applies together with extra:
+// it moves email, phone number and username into deleted_identity and replaces them with
+// values derived from _id, which can never collide with a live user.
+func tombstoneIdentity(extra bson.M) mongo.Pipeline {
+	set := bson.M{
+		"deleted_identity": bson.M{
+			"email":        "$email",
+			"phone_number": "$phone_number",
+			"username":     "$username",
+		},
+		"email":    bson.M{"$concat": bson.A{"deleted:", bson.M{"$toString": "$_id"}}},
+		"username": bson.M{"$concat": bson.A{"deleted:", bson.M{"$toString": "$_id"}}},
+	}
+	for k, v := range extra {
+		set[k] = v
+	}
+	return mongo.Pipeline{
+		{{Key: "$set", Value: set}},
+		{{Key: "$unset", Value: "phone_number"}},
 	}
 }
 
+// duplicateFieldError maps a duplicate key error on one of the unique user indexes to
+// ErrDuplicateEmail, ErrDuplicatePhoneNumber or ErrDuplicateUsername, or returns nil.
+func duplicateFieldError(err error) error {
+	var writeException mongo.WriteException
+	if !errors.As(err, &writeException) {
+		return nil
+	}
+	for _, writeError := range writeException.WriteErrors {
+		if writeError.Code != 11000 {
+			continue
+		}
+		switch {
+		case strings.Contains(writeError.Message, "email_1"):
+			return ErrDuplicateEmail
+		case strings.Contains(writeError.Message, "phone_number_1"):
+			return ErrDuplicatePhoneNumber
+		case strings.Contains(writeError.Message, "username_1"):
+			return ErrDuplicateUsername
+		}
+	}
+	return nil
+}
+
 type UserRepository struct {
 	db     *mongo.Database
 	redis  *redis.Client
@@ -96,6 +194,26 @@ func NewUserRepository(db *mongo.Database, rds *redis.Client, logger *zap.Logger
 		logger.Info("Successfully ensured indexes for users collection")
 	}
 
+	// Created separately so existing duplicate usernames don't block the email/phone indexes.
+	usernameIndex := mongo.IndexModel{
+		Keys:    bson.D{{Key: "username", Value: 1}},
+		Options: options.Index().SetUnique(true).SetCollation(usernameCollation),
+	}
+	if _, err := userCollection.Indexes().CreateOne(ctx, usernameIndex); err != nil {
+		logger.Warn("Failed to create unique username index (existing duplicates must be resolved first)", zap.Error(err))
+	}
+
+	// Users soft-deleted before deleted_identity existed still hold their email, phone and
+	// username in the unique indexes; tombstone them so those can be registered again.
+	tombstoned, err := userCollection.UpdateMany(ctx,
+		bson.M{"deleted_at": bson.M{"$ne": nil}, "deleted_identity": bson.M{"$exists": false}},
+		tombstoneIdentity(nil))
+	if err != nil {
+		logger.Error("Failed to tombstone identities of soft-deleted users", zap.Error(err))
+	} else if tombstoned.ModifiedCount > 0 {
+		logger.Info("Tombstoned identities of soft-deleted users", zap.Int64("count", tombstoned.ModifiedCount))
+	}
+
 	return &UserRepository{
 		db:     db,
 		redis:  rds,
@@ -138,6 +256,10 @@ func (r *UserRepository) CreateUser(ctx context.Context, user *entity.User) (pri
 						r.logger.Warn("Duplicate phone number during user creation", zap.String("phoneNumber", user.PhoneNumber), zap.Error(writeError))
 						return primitive.NilObjectID, ErrDuplicatePhoneNumber
 					}
+					if strings.Contains(writeError.Message, "username_1") {
+						r.logger.Warn("Duplicate username during user creation", zap.String("username", user.Username), zap.Error(writeError))
+						return primitive.NilObjectID, ErrDuplicateUsername
+					}
 				}
 			}
 		}
@@ -151,7 +273,7 @@ func (r *UserRepository) CreateUser(ctx context.Context, user *entity.User) (pri
 func (r *UserRepository) GetUserByEmail(ctx context.Context, email string) (*entity.User, error) {
 	r.logger.Debug("Attempting to get user by email from repository", zap.String("email", email))
 	var dbUser mongoUser
-	err := r.db.Collection("users").FindOne(ctx, bson.M{"email": email}).Decode(&dbUser)
+	err := r.db.Collection("users").FindOne(ctx, notDeleted(bson.M{"email": email})).Decode(&dbUser)
 	if err != nil {
 		if errors.Is(err, mongo.ErrNoDocuments) {
 			r.logger.Debug("User not found by email in repository", zap.String("email", email))
@@ -167,7 +289,7 @@ func (r *UserRepository) GetUserByEmail(ctx context.Context, email string) (*ent
 func (r *UserRepository) GetUserByID(ctx context.Context, userID primitive.ObjectID) (*entity.User, error) {
 	r.logger.Debug("Attempting to get user by ID from repository", zap.String("userID", userID.Hex()))
 	var dbUser mongoUser
-	err := r.db.Collection("users").FindOne(ctx, bson.M{"_id": userID}).Decode(&dbUser)
+	err := r.db.Collection("users").FindOne(ctx, notDeleted(bson.M{"_id": userID})).Decode(&dbUser)
 	if err != nil {
 		if errors.Is(err, mongo.ErrNoDocuments) {
 			r.logger.Debug("User not found by ID in repository", zap.String("userID", userID.Hex()))
@@ -183,7 +305,7 @@ func (r *UserRepository) GetUserByID(ctx context.Context, userID primitive.Objec
 func (r *UserRepository) GetUserByPhoneNumber(ctx context.Context, phoneNumber string) (*entity.User, error) {
 	r.logger.Debug("Attempting to get user by phone number from repository", zap.String("phoneNumber", phoneNumber))
 	var dbUser mongoUser
-	err := r.db.Collection("users").FindOne(ctx, bson.M{"phone_number": phoneNumber}).Decode(&dbUser)
+	err := r.db.Collection("users").FindOne(ctx, notDeleted(bson.M{"phone_number": phoneNumber})).Decode(&dbUser)
 	if err != nil {
 		if errors.Is(err, mongo.ErrNoDocuments) {
 			r.logger.Debug("User not found by phone number in repository", zap.String("phoneNumber", phoneNumber))
@@ -196,6 +318,23 @@ func (r *UserRepository) GetUserByPhoneNumber(ctx context.Context, phoneNumber s
 	return dbUser.toEntity(), nil
 }
 
+// GetUserByUsername looks a user up by username, ignoring case.
+func (r *UserRepository) GetUserByUsername(ctx context.Context, username string) (*entity.User, error) {
+	r.logger.Debug("Attempting to get user by username from repository", zap.String("username", username))
+	var dbUser mongoUser
+	findOptions := options.FindOne().SetCollation(usernameCollation)
+	err := r.db.Collection("users").FindOne(ctx, notDeleted(bson.M{"username": username}), findOptions).Decode(&dbUser)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			r.logger.Debug("User not found by username in repository", zap.String("username", username))
+			return nil, ErrUserNotFound
+		}
+		r.logger.Error("Database error fetching user by username", zap.String("username", username), zap.Error(err))
+		return nil, err
+	}
+	return dbUser.toEntity(), nil
+}
+
 func (r *UserRepository) UpdateUser(ctx context.Context, user *entity.User) error {
 	r.logger.Info("Attempting to update user in repository",
 		zap.String("userID", user.ID.Hex()),
@@ -255,6 +394,10 @@ func (r *UserRepository) UpdateUser(ctx context.Context, user *entity.User) erro
 						r.logger.Warn("Duplicate phone number during user update", zap.String("userID", user.ID.Hex()), zap.String("phoneNumber", user.PhoneNumber), zap.Error(writeError))
 						return ErrDuplicatePhoneNumber
 					}
+					if strings.Contains(writeError.Message, "username_1") {
+						r.logger.Warn("Duplicate username during user update", zap.String("userID", user.ID.Hex()), zap.String("username", user.Username), zap.Error(writeError))
+						return ErrDuplicateUsername
+					}
 				}
 			}
 		}
@@ -313,6 +456,71 @@ func (r *UserRepository) HardDeleteUser(ctx context.Context, userID primitive.Ob
 	return nil
 }
 
+// SoftDeleteUser marks the user as deleted and inactive but keeps the document for auditing.
+// The user disappears from every lookup until RestoreUser is called, and the email, phone
+// number and username are freed for new registrations (see deletedIdentity).
+func (r *UserRepository) SoftDeleteUser(ctx context.Context, userID primitive.ObjectID) error {
+	r.logger.Info("Soft deleting user", zap.String("userID", userID.Hex()))
+	now := time.Now()
+	update := tombstoneIdentity(bson.M{
+		"deleted_at": now,
+		"is_active":  false,
+		"updated_at": now,
+	})
+	result, err := r.db.Collection("users").UpdateOne(ctx, notDeleted(bson.M{"_id": userID}), update)
+	if err != nil {
+		r.logger.Error("DB error soft deleting user", zap.String("userID", userID.Hex()), zap.Error(err))
+		return err
+	}
+	if result.MatchedCount == 0 {
+		r.logger.Warn("User not found for soft delete", zap.String("userID", userID.Hex()))
+		return ErrUserNotFound
+	}
+	if err := r.InvalidateToken(ctx, userID.Hex()); err != nil {
+		r.logger.Warn("Failed to invalidate token during soft delete, proceeding", zap.String("userID", userID.Hex()), zap.Error(err))
+	}
+	r.logger.Info("User soft deleted successfully", zap.String("userID", userID.Hex()))
+	return nil
+}
+
+// RestoreUser undoes SoftDeleteUser and reactivates the account. It returns ErrUserNotFound
+// if no soft-deleted user has that ID, and ErrDuplicateEmail, ErrDuplicatePhoneNumber or
+// ErrDuplicateUsername if someone registered with the user's identity in the meantime.
+func (r *UserRepository) RestoreUser(ctx context.Context, userID primitive.ObjectID) error {
+	r.logger.Info("Restoring soft-deleted user", zap.String("userID", userID.Hex()))
+	update := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{
+			"email": bson.M{"$ifNull": bson.A{"$deleted_identity.email", "$email"}},
+			// A missing phone must stay missing: null would collide in the sparse unique index.
+			"phone_number": bson.M{"$cond": bson.A{
+				bson.M{"$eq": bson.A{bson.M{"$type": "$deleted_identity"}, "missing"}},
+				"$phone_number",
+				bson.M{"$ifNull": bson.A{"$deleted_identity.phone_number", "$$REMOVE"}},
+			}},
+			"username":   bson.M{"$ifNull": bson.A{"$deleted_identity.username", "$username"}},
+			"is_active":  true,
+			"updated_at": time.Now(),
+		}}},
+		{{Key: "$unset", Value: bson.A{"deleted_at", "deleted_identity"}}},
+	}
+	filter := bson.M{"_id": userID, "deleted_at": bson.M{"$ne": nil}}
+	result, err := r.db.Collection("users").UpdateOne(ctx, filter, update)
+	if err != nil {
+		if dupErr := duplicateFieldError(err); dupErr != nil {
+			r.logger.Warn("Identity of restored user is taken", zap.String("userID", userID.Hex()), zap.Error(err))
+			return dupErr
+		}
+		r.logger.Error("DB error restoring user", zap.String("userID", userID.Hex()), zap.Error(err))
+		return err
+	}
+	if result.MatchedCount == 0 {
+		r.logger.Warn("Soft-deleted user not found for restore", zap.String("userID", userID.Hex()))
+		return ErrUserNotFound
+	}
+	r.logger.Info("User restored successfully", zap.String("userID", userID.Hex()))
+	return nil
+}
+
 func (r *UserRepository) DeactivateUser(ctx context.Context, userID primitive.ObjectID) error {
 	r.logger.Info("Deactivating user", zap.String("userID", userID.Hex()))
 	update := bson.M{
@@ -337,14 +545,60 @@ func (r *UserRepository) DeactivateUser(ctx context.Context, userID primitive.Ob
 	return nil
 }
 
-func (r *UserRepository) ListUsers(ctx context.Context, skip, limit int64) ([]*entity.User, error) {
-	r.logger.Debug("Listing users", zap.Int64("skip", skip), zap.Int64("limit", limit))
+// UserFilter narrows admin user listings. Zero values mean "no filter".
+type UserFilter struct {
+	Role     string
+	IsActive *bool
+}
+
+func (f UserFilter) apply(filter bson.M) bson.M {
+	notDeleted(filter)
+	if f.Role != "" {
+		filter["role"] = f.Role
+	}
+	if f.IsActive != nil {
+		filter["is_active"] = *f.IsActive
+	}
+	return filter
+}
+
+// sortableUserFields whitelists the fields admin listings may be sorted by,
+// so client input never reaches the sort document directly.
+var sortableUserFields = map[string]string{
+	"created_at": "created_at",
+	"updated_at": "updated_at",
+	"username":   "username",
+	"email":      "email",
+	"role":       "role",
+}
+
+// UserSort orders admin user listings. Unknown fields fall back to created_at
+// and anything other than "asc" sorts descending.
+type UserSort struct {
+	By    string
+	Order string
+}
+
+func (s UserSort) document() bson.D {
+	field, ok := sortableUserFields[strings.ToLower(s.By)]
+	if !ok {
+		field = "created_at"
+	}
+	direction := -1
+	if strings.EqualFold(s.Order, "asc") {
+		direction = 1
+	}
+	return bson.D{{Key: field, Value: direction}, {Key: "_id", Value: direction}}
+}
+
+func (r *UserRepository) ListUsers(ctx context.Context, userFilter UserFilter, sort UserSort, skip, limit int64) ([]*entity.User, error) {
+	r.logger.Debug("Listing users", zap.String("role", userFilter.Role), zap.String("sortBy", sort.By), zap.String("sortOrder", sort.Order), zap.Int64("skip", skip), zap.Int64("limit", limit))
 	findOptions := options.Find()
 	findOptions.SetSkip(skip)
 	findOptions.SetLimit(limit)
-	findOptions.SetSort(bson.M{"created_at": -1})
+	findOptions.SetSort(sort.document())
 
-	cursor, err := r.db.Collection("users").Find(ctx, bson.M{}, findOptions)
+	cursor, err := r.db.Collection("users").Find(ctx, userFilter.apply(bson.M{}), findOptions)
 	if err != nil {
 		r.logger.Error("DB error listing users", zap.Error(err))
 		return nil, err
@@ -365,20 +619,30 @@ func (r *UserRepository) ListUsers(ctx context.Context, skip, limit int64) ([]*e
 	return users, nil
 }
 
-func (r *UserRepository) SearchUsers(ctx context.Context, query string, skip, limit int64) ([]*entity.User, error) {
+// CountUsers returns the number of users matching userFilter.
+func (r *UserRepository) CountUsers(ctx context.Context, userFilter UserFilter) (int64, error) {
+	count, err := r.db.Collection("users").CountDocuments(ctx, userFilter.apply(bson.M{}))
+	if err != nil {
+		r.logger.Error("DB error counting users", zap.Error(err))
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *UserRepository) SearchUsers(ctx context.Context, query string, userFilter UserFilter, skip, limit int64) ([]*entity.User, error) {
 	r.logger.Info("Searching users in repository", zap.String("query", query), zap.Int64("skip", skip), zap.Int64("limit", limit))
 	findOptions := options.Find()
 	findOptions.SetSkip(skip)
 	findOptions.SetLimit(limit)
 	findOptions.SetSort(bson.M{"created_at": -1})
 
-	filter := bson.M{
+	filter := userFilter.apply(bson.M{
 		"$or": []bson.M{
 			{"username": bson.M{"$regex": query, "$options": "i"}},
 			{"email": bson.M{"$regex": query, "$options": "i"}},
 			{"phone_number": bson.M{"$regex": query, "$options": "i"}},
 		},
-	}
+	})
 
 	cursor, err := r.db.Collection("users").Find(ctx, filter, findOptions)
 	if err != nil {
@@ -442,6 +706,93 @@ func (r *UserRepository) SaveEmailVerificationDetails(ctx context.Context, userI
 	return nil
 }
 
+func (r *UserRepository) SavePasswordResetDetails(ctx context.Context, userID primitive.ObjectID, code string, expiresAt time.Time) error {
+	r.logger.Info("Saving password reset details",
+		zap.String("userID", userID.Hex()),
+		zap.Time("expiresAt", expiresAt))
+
+	updateDoc := bson.M{}
+	if code == "" && expiresAt.IsZero() {
+		updateDoc["$set"] = bson.M{"updated_at": time.Now()}
+		updateDoc["$unset"] = bson.M{
+			"password_reset_code":            "",
+			"password_reset_code_expires_at": "",
+		}
+	} else {
+		updateDoc["$set"] = bson.M{
+			"password_reset_code":            code,
+			"password_reset_code_expires_at": expiresAt,
+			"updated_at":                     time.Now(),
+		}
+	}
+
+	result, err := r.db.Collection("users").UpdateOne(ctx, bson.M{"_id": userID}, updateDoc)
+	if err != nil {
+		r.logger.Error("DB error saving/clearing password reset details", zap.String("userID", userID.Hex()), zap.Error(err))
+		return err
+	}
+	if result.MatchedCount == 0 {
+		r.logger.Warn("User not found for saving/clearing password reset details", zap.String("userID", userID.Hex()))
+		return ErrUserNotFound
+	}
+	r.logger.Info("Password reset details saved/cleared successfully", zap.String("userID", userID.Hex()))
+	return nil
+}
+
+// SaveTwoFactorSecret stores a new (encrypted) TOTP secret that still has to be confirmed.
+func (r *UserRepository) SaveTwoFactorSecret(ctx context.Context, userID primitive.ObjectID, encryptedSecret string) error {
+	r.logger.Info("Saving pending two-factor secret", zap.String("userID", userID.Hex()))
+	update := bson.M{
+		"$set": bson.M{
+			"two_factor_secret":  encryptedSecret,
+			"two_factor_enabled": false,
+			"updated_at":         time.Now(),
+		},
+		"$unset": bson.M{"two_factor_enabled_at": ""},
+	}
+	result, err := r.db.Collection("users").UpdateOne(ctx, bson.M{"_id": userID}, update)
+	if err != nil {
+		r.logger.Error("DB error saving two-factor secret", zap.String("userID", userID.Hex()), zap.Error(err))
+		return err
+	}
+	if result.MatchedCount == 0 {
+		r.logger.Warn("User not found for saving two-factor secret", zap.String("userID", userID.Hex()))
+		return ErrUserNotFound
+	}
+	return nil
+}
+
+func (r *UserRepository) SetTwoFactorEnabled(ctx context.Context, userID primitive.ObjectID, enabled bool) error {
+	r.logger.Info("Setting two-factor status", zap.String("userID", userID.Hex()), zap.Bool("enabled", enabled))
+	now := time.Now()
+	var update bson.M
+	if enabled {
+		update = bson.M{"$set": bson.M{
+			"two_factor_enabled":    true,
+			"two_factor_enabled_at": now,
+			"updated_at":            now,
+		}}
+	} else {
+		update = bson.M{
+			"$set": bson.M{"two_factor_enabled": false, "updated_at": now},
+			"$unset": bson.M{
+				"two_factor_secret":     "",
+				"two_factor_enabled_at": "",
+			},
+		}
+	}
+	result, err := r.db.Collection("users").UpdateOne(ctx, bson.M{"_id": userID}, update)
+	if err != nil {
+		r.logger.Error("DB error setting two-factor status", zap.String("userID", userID.Hex()), zap.Error(err))
+		return err
+	}
+	if result.MatchedCount == 0 {
+		r.logger.Warn("User not found for setting two-factor status", zap.String("userID", userID.Hex()))
+		return ErrUserNotFound
+	}
+	return nil
+}
+
 func (r *UserRepository) MarkEmailAsVerified(ctx context.Context, userID primitive.ObjectID) error {
 	r.logger.Info("Marking email as verified", zap.String("userID", userID.Hex()))
 	now := time.Now()
@@ -469,14 +820,167 @@ func (r *UserRepository) MarkEmailAsVerified(ctx context.Context, userID primiti
 	return nil
 }
 
-func (r *UserRepository) CacheToken(ctx context.Context, keySuffix, token string, expiration time.Duration) error {
-	return r.redis.Set(ctx, "token:"+keySuffix, token, expiration).Err()
+// UpdateLastLogin records a successful login. updated_at is left alone since the profile did not change.
+func (r *UserRepository) UpdateLastLogin(ctx context.Context, userID primitive.ObjectID, at time.Time) error {
+	result, err := r.db.Collection("users").UpdateOne(ctx, bson.M{"_id": userID}, bson.M{"$set": bson.M{"last_login_at": at}})
+	if err != nil {
+		r.logger.Error("DB error updating last login", zap.String("userID", userID.Hex()), zap.Error(err))
+		return err
+	}
+	if result.MatchedCount == 0 {
+		r.logger.Warn("User not found for updating last login", zap.String("userID", userID.Hex()))
+		return ErrUserNotFound
+	}
+	return nil
+}
+
+func (r *UserRepository) UpdateAvatarURL(ctx context.Context, userID primitive.ObjectID, avatarURL string) error {
+	update := bson.M{"$set": bson.M{"avatar_url": avatarURL, "updated_at": time.Now()}}
+	result, err := r.db.Collection("users").UpdateOne(ctx, bson.M{"_id": userID}, update)
+	if err != nil {
+		r.logger.Error("DB error updating avatar URL", zap.String("userID", userID.Hex()), zap.Error(err))
+		return err
+	}
+	if result.MatchedCount == 0 {
+		r.logger.Warn("User not found for updating avatar URL", zap.String("userID", userID.Hex()))
+		return ErrUserNotFound
+	}
+	return nil
+}
+
+func sessionKey(userID, sessionID string) string {
+	return "session:" + userID + ":" + sessionID
+}
+
+func tokensRevokedKey(userID string) string {
+	return "tokens_revoked_before:" + userID
+}
+
+// CacheSession records a login session; its refresh token can be exchanged until ttl passes.
+// Each login gets its own session, so logging in on one device keeps the others signed in.
+func (r *UserRepository) CacheSession(ctx context.Context, userID, sessionID string, ttl time.Duration) error {
+	return r.redis.Set(ctx, sessionKey(userID, sessionID), 1, ttl).Err()
+}
+
+// SessionExists reports whether the login session is still cached.
+func (r *UserRepository) SessionExists(ctx context.Context, userID, sessionID string) (bool, error) {
+	n, err := r.redis.Exists(ctx, sessionKey(userID, sessionID)).Result()
+	return n > 0, err
+}
+
+// InvalidateToken revokes every token issued to the user so far (logout, password reset,
+// deactivation, deletion). The revocation time is kept as long as a refresh token can live.
+func (r *UserRepository) InvalidateToken(ctx context.Context, userID string) error {
+	pipe := r.redis.TxPipeline()
+	pipe.Del(ctx, "token:"+userID) // refresh token cached before sessions existed
+	pipe.Set(ctx, tokensRevokedKey(userID), time.Now().Unix(), jwt.RefreshTokenTTL)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// GetTokensRevokedBefore returns when InvalidateToken was last called for the user, or the
+// zero time if it was not called within the refresh token lifetime.
+func (r *UserRepository) GetTokensRevokedBefore(ctx context.Context, userID string) (time.Time, error) {
+	unix, err := r.redis.Get(ctx, tokensRevokedKey(userID)).Int64()
+	if errors.Is(err, redis.Nil) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(unix, 0), nil
+}
+
+// incrWithExpiryScript increments a counter and starts its expiry on the first increment in one
+// atomic step, so a failure between the two commands cannot leave a counter that never expires.
+var incrWithExpiryScript = redis.NewScript(`
+local count = redis.call("INCR", KEYS[1])
+if count == 1 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[1])
+end
+return count
+`)
+
+// incrWithExpiry bumps the counter at key; the counter expires ttl after its first increment.
+func (r *UserRepository) incrWithExpiry(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	return incrWithExpiryScript.Run(ctx, r.redis, []string{key}, ttl.Milliseconds()).Int64()
+}
+
+// claimTwoFactorStepScript stores the TOTP time step a user just authenticated with, unless
+// that step or a later one was already used, and reports whether it was stored.
+var claimTwoFactorStepScript = redis.NewScript(`
+local last = tonumber(redis.call("GET", KEYS[1]))
+if last and last >= tonumber(ARGV[1]) then
+	return 0
+end
+redis.call("SET", KEYS[1], ARGV[1], "PX", ARGV[2])
+return 1
+`)
+
+// ClaimTwoFactorStep records that the user authenticated with a code from TOTP time step step.
+// It returns false when that step or a later one was already used, so each code works once.
+// The record is kept for ttl, after which older steps can no longer produce a valid code.
+func (r *UserRepository) ClaimTwoFactorStep(ctx context.Context, userID string, step int64, ttl time.Duration) (bool, error) {
+	claimed, err := claimTwoFactorStepScript.Run(ctx, r.redis, []string{"totp_last_step:" + userID}, step, ttl.Milliseconds()).Int()
+	return claimed == 1, err
+}
+
+func loginFailuresKey(subject string) string {
+	return "login_failures:" + strings.ToLower(strings.TrimSpace(subject))
+}
+
+// IncrementLoginFailures bumps the failed login counter for subject: a user ID, or the login
+// identifier when it matches no account. The counter expires `window` after the first failure,
+// so only failures inside that window are counted.
+func (r *UserRepository) IncrementLoginFailures(ctx context.Context, subject string, window time.Duration) (int64, error) {
+	return r.incrWithExpiry(ctx, loginFailuresKey(subject), window)
+}
+
+func (r *UserRepository) GetLoginFailures(ctx context.Context, subject string) (int64, error) {
+	count, err := r.redis.Get(ctx, loginFailuresKey(subject)).Int64()
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+	return count, err
+}
+
+func (r *UserRepository) ResetLoginFailures(ctx context.Context, subject string) error {
+	return r.redis.Del(ctx, loginFailuresKey(subject)).Err()
+}
+
+func passwordResetFailuresKey(email string) string {
+	return "password_reset_failures:" + strings.ToLower(strings.TrimSpace(email))
+}
+
+// IncrementPasswordResetFailures bumps the wrong reset code counter for an email. The counter
+// expires `ttl` after the first failure, matching the lifetime of the code.
+func (r *UserRepository) IncrementPasswordResetFailures(ctx context.Context, email string, ttl time.Duration) (int64, error) {
+	return r.incrWithExpiry(ctx, passwordResetFailuresKey(email), ttl)
+}
+
+func (r *UserRepository) ResetPasswordResetFailures(ctx context.Context, email string) error {
+	return r.redis.Del(ctx, passwordResetFailuresKey(email)).Err()
+}
+
+// AcquireVerificationEmailCooldown starts the resend cooldown for a user's verification email.
+// It returns false when a previous cooldown is still running.
+func (r *UserRepository) AcquireVerificationEmailCooldown(ctx context.Context, userID string, cooldown time.Duration) (bool, error) {
+	return r.redis.SetNX(ctx, "verification_email_cooldown:"+userID, 1, cooldown).Result()
+}
+
+// ClaimOrderConfirmation marks the confirmation email for an order as being sent.
+// It returns false when the order was already claimed, so each order is emailed once.
+func (r *UserRepository) ClaimOrderConfirmation(ctx context.Context, orderID string, ttl time.Duration) (bool, error) {
+	return r.redis.SetNX(ctx, "order_confirmation:"+orderID, 1, ttl).Result()
 }
 
-func (r *UserRepository) InvalidateToken(ctx context.Context, keySuffix string) error {
-	return r.redis.Del(ctx, "token:"+keySuffix).Err()
+// ReleaseOrderConfirmation drops a claim whose email could not be sent so a redelivery can retry it.
+func (r *UserRepository) ReleaseOrderConfirmation(ctx context.Context, orderID string) error {
+	return r.redis.Del(ctx, "order_confirmation:"+orderID).Err()
 }
 
+// GetToken returns the refresh token cached for the user before refresh tokens carried a
+// session, or "" if there is none.
 func (r *UserRepository) GetToken(ctx context.Context, keySuffix string) (string, error) {
 	token, err := r.redis.Get(ctx, "token:"+keySuffix).Result()
 	if errors.Is(err, redis.Nil) {
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func assertExcludesDeleted(t *testing.T, filter bson.M) {
+	t.Helper()
+	value, ok := filter["deleted_at"]
+	if !ok {
+		t.Fatalf("filter %v does not exclude soft-deleted users", filter)
+	}
+	if value != nil {
+		t.Fatalf("filter deleted_at = %v, want nil", value)
+	}
+}
+
+func TestNotDeleted_KeepsExistingConditions(t *testing.T) {
+	filter := notDeleted(bson.M{"email": "a@example.com"})
+	assertExcludesDeleted(t, filter)
+	if filter["email"] != "a@example.com" {
+		t.Errorf("email condition lost: %v", filter)
+	}
+}
+
+func TestUserFilter_ExcludesDeleted(t *testing.T) {
+	isActive := false
+	cases := []struct {
+		name   string
+		filter UserFilter
+		base   bson.M
+	}{
+		{"no filters", UserFilter{}, bson.M{}},
+		{"role and active", UserFilter{Role: "admin", IsActive: &isActive}, bson.M{}},
+		{"search", UserFilter{}, bson.M{"$or": []bson.M{{"username": "alice"}}}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			filter := c.filter.apply(c.base)
+			assertExcludesDeleted(t, filter)
+			if c.filter.Role != "" && filter["role"] != c.filter.Role {
+				t.Errorf("role = %v, want %q", filter["role"], c.filter.Role)
+			}
+			if c.filter.IsActive != nil && filter["is_active"] != *c.filter.IsActive {
+				t.Errorf("is_active = %v, want %v", filter["is_active"], *c.filter.IsActive)
+			}
+		})
+	}
+}
+
+func TestToEntity_DeletedUserShowsOriginalIdentity(t *testing.T) {
+	m := &mongoUser{
+		Email:    "deleted:abc",
+		Username: "deleted:abc",
+		DeletedIdentity: &deletedIdentity{
+			Email:       "a@example.com",
+			PhoneNumber: "+100",
+			Username:    "alice",
+		},
+	}
+	user := m.toEntity()
+	if user.Email != "a@example.com" || user.PhoneNumber != "+100" || user.Username != "alice" {
+		t.Errorf("identity = %q/%q/%q, want the original values", user.Email, user.PhoneNumber, user.Username)
+	}
+}
+
+func TestDuplicateFieldError(t *testing.T) {
+	dup := func(index string) error {
+		return mongo.WriteException{WriteErrors: []mongo.WriteError{{
+			Code:    11000,
+			Message: "E11000 duplicate key error collection: users index: " + index + " dup key",
+		}}}
+	}
+	cases := []struct {
+		err  error
+		want error
+	}{
+		{dup("email_1"), ErrDuplicateEmail},
+		{dup("phone_number_1"), ErrDuplicatePhoneNumber},
+		{dup("username_1"), ErrDuplicateUsername},
+		{errors.New("connection reset"), nil},
+	}
+	for _, c := range cases {
+		if got := duplicateFieldError(c.err); got != c.want {
+			t.Errorf("duplicateFieldError(%v) = %v, want %v", c.err, got, c.want)
+		}
+	}
+}
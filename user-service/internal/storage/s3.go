@@ -0,0 +1,84 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"go.uber.org/zap"
+)
+
+const avatarPrefix = "avatars/"
+
+// S3Storage keeps avatar images in a MinIO/S3 bucket and hands out their public URLs.
+type S3Storage struct {
+	client *minio.Client
+	bucket string
+	logger *zap.Logger
+}
+
+func NewS3Storage(endpoint, accessKey, secretKey, bucketName string, useSSL bool, logger *zap.Logger) (*S3Storage, error) {
+	logger = logger.Named("S3Storage")
+	client, err := minio.New(endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(accessKey, secretKey, ""),
+		Secure: useSSL,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create minio client for endpoint %s: %w", endpoint, err)
+	}
+
+	exists, err := client.BucketExists(context.Background(), bucketName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check bucket %s: %w", bucketName, err)
+	}
+	if !exists {
+		if err := client.MakeBucket(context.Background(), bucketName, minio.MakeBucketOptions{}); err != nil {
+			return nil, fmt.Errorf("failed to create bucket %s: %w", bucketName, err)
+		}
+		logger.Info("Bucket created", zap.String("bucket", bucketName))
+	}
+
+	return &S3Storage{client: client, bucket: bucketName, logger: logger}, nil
+}
+
+// Upload stores data under a fresh key that keeps fileName's extension and returns its URL.
+func (s *S3Storage) Upload(ctx context.Context, fileName, contentType string, data []byte) (string, error) {
+	suffix := make([]byte, 16)
+	if _, err := rand.Read(suffix); err != nil {
+		return "", fmt.Errorf("failed to generate object key: %w", err)
+	}
+	objectKey := avatarPrefix + hex.EncodeToString(suffix) + strings.ToLower(filepath.Ext(fileName))
+
+	_, err := s.client.PutObject(ctx, s.bucket, objectKey, bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{
+		ContentType: contentType,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to upload object %s to bucket %s: %w", objectKey, s.bucket, err)
+	}
+	s.logger.Info("Avatar uploaded", zap.String("key", objectKey), zap.Int("sizeBytes", len(data)))
+	return s.objectURL(objectKey), nil
+}
+
+// Delete removes the object behind a URL returned by Upload. URLs that point elsewhere are ignored.
+func (s *S3Storage) Delete(ctx context.Context, url string) error {
+	prefix := s.objectURL("")
+	if !strings.HasPrefix(url, prefix) {
+		s.logger.Warn("Not deleting object outside the avatar bucket", zap.String("url", url))
+		return nil
+	}
+	objectKey := strings.TrimPrefix(url, prefix)
+	if err := s.client.RemoveObject(ctx, s.bucket, objectKey, minio.RemoveObjectOptions{}); err != nil {
+		return fmt.Errorf("failed to delete object %s from bucket %s: %w", objectKey, s.bucket, err)
+	}
+	return nil
+}
+
+func (s *S3Storage) objectURL(objectKey string) string {
+	return fmt.Sprintf("%s/%s/%s", s.client.EndpointURL().String(), s.bucket, objectKey)
+}
@@ -0,0 +1,91 @@
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// RFC 6238 parameters understood by all common authenticator apps.
+const (
+	period     = 30
+	digits     = 6
+	secretSize = 20
+	// allowedSkew is the number of periods before/after now a code is still accepted,
+	// to tolerate clock drift between the server and the user's device.
+	allowedSkew = 1
+)
+
+// CodeLifetime is how long a single code can be accepted, skew included. Time steps that were
+// used longer ago than this can no longer match, so replay protection can forget them.
+const CodeLifetime = (2*allowedSkew + 1) * period * time.Second
+
+var b32 = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateSecret returns a new random base32-encoded TOTP secret.
+func GenerateSecret() (string, error) {
+	buf := make([]byte, secretSize)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate totp secret: %w", err)
+	}
+	return b32.EncodeToString(buf), nil
+}
+
+// URL builds the otpauth:// URL that authenticator apps import (usually via QR code).
+func URL(issuer, accountName, secret string) string {
+	label := url.PathEscape(issuer + ":" + accountName)
+	q := url.Values{}
+	q.Set("secret", secret)
+	q.Set("issuer", issuer)
+	q.Set("algorithm", "SHA1")
+	q.Set("digits", fmt.Sprint(digits))
+	q.Set("period", fmt.Sprint(period))
+	return "otpauth://totp/" + label + "?" + q.Encode()
+}
+
+// Validate reports whether code is valid for secret at time t.
+func Validate(code, secret string, t time.Time) bool {
+	_, ok := ValidateStep(code, secret, t)
+	return ok
+}
+
+// ValidateStep is Validate that also returns the time step the code was generated for, so
+// callers can reject a code whose step was already used.
+func ValidateStep(code, secret string, t time.Time) (int64, bool) {
+	code = strings.TrimSpace(code)
+	if len(code) != digits {
+		return 0, false
+	}
+	key, err := b32.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return 0, false
+	}
+	counter := t.Unix() / period
+	for i := -allowedSkew; i <= allowedSkew; i++ {
+		step := counter + int64(i)
+		expected := generateCode(key, uint64(step))
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+func generateCode(key []byte, counter uint64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], counter)
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", digits, value%1000000)
+}
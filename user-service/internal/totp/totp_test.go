@@ -0,0 +1,83 @@
+package totp
+
+import (
+	"encoding/base32"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Test vectors from RFC 6238 Appendix B (SHA1), truncated to 6 digits.
+func TestValidate_RFC6238Vectors(t *testing.T) {
+	secret := b32.EncodeToString([]byte("12345678901234567890"))
+	cases := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+	for _, c := range cases {
+		if !Validate(c.code, secret, time.Unix(c.unix, 0)) {
+			t.Errorf("expected code %s to be valid at %d", c.code, c.unix)
+		}
+	}
+}
+
+func TestValidate_RejectsWrongAndStaleCodes(t *testing.T) {
+	secret := b32.EncodeToString([]byte("12345678901234567890"))
+	at := time.Unix(1111111109, 0)
+
+	if Validate("000000", secret, at) {
+		t.Error("expected wrong code to be rejected")
+	}
+	if Validate("81804", secret, at) {
+		t.Error("expected short code to be rejected")
+	}
+	if Validate("081804", secret, at.Add(5*period*time.Second)) {
+		t.Error("expected code outside the skew window to be rejected")
+	}
+	if !Validate("081804", secret, at.Add(period*time.Second)) {
+		t.Error("expected code from the previous period to be accepted")
+	}
+}
+
+func TestGenerateSecret(t *testing.T) {
+	secret, err := GenerateSecret()
+	if err != nil {
+		t.Fatalf("GenerateSecret returned error: %v", err)
+	}
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(secret)
+	if err != nil {
+		t.Fatalf("secret is not valid base32: %v", err)
+	}
+	if len(key) != secretSize {
+		t.Errorf("expected %d byte secret, got %d", secretSize, len(key))
+	}
+	if u := URL("BicycleShop", "john@example.com", secret); !strings.HasPrefix(u, "otpauth://totp/BicycleShop:john@example.com?") {
+		t.Errorf("unexpected otpauth URL: %s", u)
+	}
+}
+
+func TestValidateStep_ReturnsMatchedStep(t *testing.T) {
+	secret := b32.EncodeToString([]byte("12345678901234567890"))
+	at := time.Unix(1111111109, 0)
+	codeStep := at.Unix() / period
+
+	step, ok := ValidateStep("081804", secret, at)
+	if !ok || step != codeStep {
+		t.Errorf("ValidateStep = %d, %v; want %d, true", step, ok, codeStep)
+	}
+	// A code from the previous period is accepted with the step it was generated for,
+	// so replay protection sees the same step whenever the code is reused.
+	step, ok = ValidateStep("081804", secret, at.Add(period*time.Second))
+	if !ok || step != codeStep {
+		t.Errorf("ValidateStep one period later = %d, %v; want %d, true", step, ok, codeStep)
+	}
+	if _, ok := ValidateStep("000000", secret, at); ok {
+		t.Error("expected wrong code to be rejected")
+	}
+}
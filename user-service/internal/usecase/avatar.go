@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/Abdurahmanit/GroupProject/user-service/internal/repository"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrAvatarUploadDisabled = errors.New("avatar uploads are not configured")
+	ErrAvatarEmpty          = errors.New("avatar image is empty")
+	ErrAvatarTooLarge       = errors.New("avatar image is too large")
+	ErrAvatarNotImage       = errors.New("avatar must be a JPEG, PNG, GIF or WebP image")
+)
+
+// allowedAvatarTypes are the content types sniffed from the uploaded bytes that are accepted.
+var allowedAvatarTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/gif":  true,
+	"image/webp": true,
+}
+
+// AvatarStorage stores avatar images and returns their public URLs.
+type AvatarStorage interface {
+	Upload(ctx context.Context, fileName, contentType string, data []byte) (string, error)
+	Delete(ctx context.Context, url string) error
+}
+
+type AvatarSettings struct {
+	Storage  AvatarStorage // nil disables UploadAvatar
+	MaxBytes int64
+}
+
+// UploadAvatar stores a new avatar for the user, saves its URL on the profile and removes
+// the previous image. The content type is sniffed from data, not taken from fileName.
+func (u *UserUsecase) UploadAvatar(ctx context.Context, userIDHex, fileName string, data []byte) (string, error) {
+	if u.avatars.Storage == nil {
+		return "", ErrAvatarUploadDisabled
+	}
+	if len(data) == 0 {
+		return "", ErrAvatarEmpty
+	}
+	if int64(len(data)) > u.avatars.MaxBytes {
+		return "", fmt.Errorf("%w: %d bytes, limit is %d", ErrAvatarTooLarge, len(data), u.avatars.MaxBytes)
+	}
+	contentType := http.DetectContentType(data)
+	if !allowedAvatarTypes[contentType] {
+		return "", ErrAvatarNotImage
+	}
+
+	user, err := u.getActiveUserByHexID(ctx, userIDHex)
+	if err != nil {
+		return "", err
+	}
+
+	url, err := u.avatars.Storage.Upload(ctx, fileName, contentType, data)
+	if err != nil {
+		u.logger.Error("Failed to upload avatar", zap.String("userID", userIDHex), zap.Error(err))
+		return "", err
+	}
+	if err := u.repo.UpdateAvatarURL(ctx, user.ID, url); err != nil {
+		if errDel := u.avatars.Storage.Delete(ctx, url); errDel != nil {
+			u.logger.Warn("Failed to clean up uploaded avatar", zap.String("url", url), zap.Error(errDel))
+		}
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return "", ErrUserNotFound
+		}
+		return "", err
+	}
+
+	if user.AvatarURL != "" {
+		if err := u.avatars.Storage.Delete(ctx, user.AvatarURL); err != nil {
+			u.logger.Warn("Failed to delete previous avatar", zap.String("userID", userIDHex), zap.String("url", user.AvatarURL), zap.Error(err))
+		}
+	}
+	u.logger.Info("Avatar updated", zap.String("userID", userIDHex), zap.String("contentType", contentType))
+	return url, nil
+}
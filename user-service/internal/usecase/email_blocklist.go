@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"errors"
+	"path"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+var ErrEmailBlocked = errors.New("email address or domain is not allowed")
+
+// EmailBlocklist rejects email addresses and domains used for abuse, such as disposable
+// mailboxes. Domain patterns use path.Match syntax, so "*.mailinator.com" matches any
+// subdomain and "tempmail.*" any top-level domain. Safe for concurrent use; Update swaps
+// the lists at runtime when the configuration is reloaded.
+type EmailBlocklist struct {
+	mu        sync.RWMutex
+	domains   []string
+	addresses map[string]struct{}
+}
+
+func NewEmailBlocklist(domains, addresses []string) *EmailBlocklist {
+	b := &EmailBlocklist{}
+	b.Update(domains, addresses)
+	return b
+}
+
+// Update replaces the blocked domain patterns and addresses.
+func (b *EmailBlocklist) Update(domains, addresses []string) {
+	normalizedDomains := make([]string, 0, len(domains))
+	for _, d := range domains {
+		if d = strings.ToLower(strings.TrimSpace(d)); d != "" {
+			normalizedDomains = append(normalizedDomains, d)
+		}
+	}
+	normalizedAddresses := make(map[string]struct{}, len(addresses))
+	for _, a := range addresses {
+		if a = strings.ToLower(strings.TrimSpace(a)); a != "" {
+			normalizedAddresses[a] = struct{}{}
+		}
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.domains = normalizedDomains
+	b.addresses = normalizedAddresses
+}
+
+// Blocked reports whether email matches a blocked address or domain pattern.
+// A nil blocklist blocks nothing.
+func (b *EmailBlocklist) Blocked(email string) bool {
+	if b == nil {
+		return false
+	}
+	email = strings.ToLower(strings.TrimSpace(email))
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return false
+	}
+	domain := email[at+1:]
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if _, ok := b.addresses[email]; ok {
+		return true
+	}
+	for _, pattern := range b.domains {
+		if pattern == domain {
+			return true
+		}
+		if matched, err := path.Match(pattern, domain); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
+// checkEmailAllowed returns ErrEmailBlocked and logs the attempt when email is on the blocklist.
+func (u *UserUsecase) checkEmailAllowed(flow, email string) error {
+	if !u.emailBlocklist.Blocked(email) {
+		return nil
+	}
+	u.logger.Warn("Blocked email rejected", zap.String("flow", flow), zap.String("email", email))
+	return ErrEmailBlocked
+}
@@ -0,0 +1,44 @@
+package usecase
+
+import "testing"
+
+func TestEmailBlocklist_Blocked(t *testing.T) {
+	b := NewEmailBlocklist(
+		[]string{"mailinator.com", "*.mailinator.com", "tempmail.*"},
+		[]string{"Spammer@Example.com"},
+	)
+	cases := []struct {
+		email   string
+		blocked bool
+	}{
+		{"user@mailinator.com", true},
+		{"user@eu.mailinator.com", true},
+		{"USER@MAILINATOR.COM", true},
+		{"user@tempmail.net", true},
+		{"spammer@example.com", true},
+		{"someone@example.com", false},
+		{"user@notmailinator.com", false},
+		{"not-an-email", false},
+	}
+	for _, c := range cases {
+		if got := b.Blocked(c.email); got != c.blocked {
+			t.Errorf("Blocked(%q) = %v, want %v", c.email, got, c.blocked)
+		}
+	}
+}
+
+func TestEmailBlocklist_UpdateReplacesLists(t *testing.T) {
+	b := NewEmailBlocklist([]string{"old.example"}, nil)
+	b.Update([]string{"new.example"}, nil)
+	if b.Blocked("a@old.example") {
+		t.Error("expected old domain to be unblocked after Update")
+	}
+	if !b.Blocked("a@new.example") {
+		t.Error("expected new domain to be blocked after Update")
+	}
+
+	var nilList *EmailBlocklist
+	if nilList.Blocked("a@new.example") {
+		t.Error("expected nil blocklist to block nothing")
+	}
+}
@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Abdurahmanit/GroupProject/user-service/internal/mailer"
+	"github.com/Abdurahmanit/GroupProject/user-service/internal/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+)
+
+const (
+	orderConfirmationAttempts = 3
+	orderConfirmationBackoff  = 2 * time.Second
+	// orderConfirmationDedupTTL bounds how long an order ID is remembered; redeliveries
+	// come within seconds, so a week is plenty.
+	orderConfirmationDedupTTL = 7 * 24 * time.Hour
+)
+
+// SendOrderConfirmation emails the order confirmation to the order's customer. Each order is
+// emailed at most once: repeated calls for the same orderID are no-ops. Sending is retried
+// with backoff; if every attempt fails the claim is released and the error returned.
+func (uc *UserUsecase) SendOrderConfirmation(ctx context.Context, userID, orderID string, order mailer.OrderConfirmation) error {
+	objID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return fmt.Errorf("invalid user ID %q in order %s: %w", userID, orderID, err)
+	}
+
+	claimed, err := uc.repo.ClaimOrderConfirmation(ctx, orderID, orderConfirmationDedupTTL)
+	if err != nil {
+		return fmt.Errorf("failed to claim order confirmation: %w", err)
+	}
+	if !claimed {
+		uc.logger.Info("Order confirmation already sent, skipping", zap.String("orderID", orderID))
+		return nil
+	}
+
+	u, err := uc.repo.GetUserByID(ctx, objID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			// Nothing to retry: the customer is gone, keep the claim.
+			uc.logger.Warn("Order confirmation not sent, user not found", zap.String("orderID", orderID), zap.String("userID", userID))
+			return nil
+		}
+		uc.releaseOrderConfirmation(orderID)
+		return fmt.Errorf("failed to load user for order confirmation: %w", err)
+	}
+
+retry:
+	for attempt := 1; attempt <= orderConfirmationAttempts; attempt++ {
+		err = uc.mailer.SendOrderConfirmation(u.Email, u.Username, order)
+		if err == nil {
+			uc.logger.Info("Order confirmation email sent", zap.String("orderID", orderID), zap.String("userID", userID))
+			return nil
+		}
+		uc.logger.Warn("Failed to send order confirmation email",
+			zap.String("orderID", orderID), zap.Int("attempt", attempt), zap.Error(err))
+		if attempt == orderConfirmationAttempts {
+			break
+		}
+		select {
+		case <-time.After(orderConfirmationBackoff * time.Duration(attempt)):
+		case <-ctx.Done():
+			err = ctx.Err()
+			break retry
+		}
+	}
+
+	uc.releaseOrderConfirmation(orderID)
+	return fmt.Errorf("failed to send order confirmation for order %s: %w", orderID, err)
+}
+
+func (uc *UserUsecase) releaseOrderConfirmation(orderID string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := uc.repo.ReleaseOrderConfirmation(ctx, orderID); err != nil {
+		uc.logger.Error("Failed to release order confirmation claim", zap.String("orderID", orderID), zap.Error(err))
+	}
+}
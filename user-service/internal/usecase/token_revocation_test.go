@@ -0,0 +1,29 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTokenRevoked(t *testing.T) {
+	revokedAt := time.Unix(1_700_000_000, 0)
+	cases := []struct {
+		name          string
+		issuedAt      time.Time
+		revokedBefore time.Time
+		revoked       bool
+	}{
+		// A login on another device or a Redis flush leaves no revocation behind.
+		{"no revocation", revokedAt, time.Time{}, false},
+		{"issued before revocation", revokedAt.Add(-time.Minute), revokedAt, true},
+		{"issued in the revocation second", revokedAt, revokedAt, true},
+		{"issued after revocation", revokedAt.Add(time.Second), revokedAt, false},
+		{"legacy token without revocation", time.Time{}, time.Time{}, false},
+		{"legacy token with revocation", time.Time{}, revokedAt, true},
+	}
+	for _, c := range cases {
+		if got := tokenRevoked(c.issuedAt, c.revokedBefore); got != c.revoked {
+			t.Errorf("%s: tokenRevoked = %v, want %v", c.name, got, c.revoked)
+		}
+	}
+}
@@ -0,0 +1,205 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Abdurahmanit/GroupProject/user-service/internal/entity"
+	"github.com/Abdurahmanit/GroupProject/user-service/internal/jwt"
+	"github.com/Abdurahmanit/GroupProject/user-service/internal/repository"
+	"github.com/Abdurahmanit/GroupProject/user-service/internal/totp"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrTwoFactorAlreadyEnabled = errors.New("two-factor authentication is already enabled")
+	ErrTwoFactorNotEnabled     = errors.New("two-factor authentication is not enabled")
+	ErrTwoFactorNotInitiated   = errors.New("two-factor setup has not been started")
+	ErrInvalidTwoFactorCode    = errors.New("invalid two-factor authentication code")
+	ErrInvalidPendingToken     = errors.New("invalid or expired two-factor login token")
+)
+
+func (u *UserUsecase) getActiveUserByHexID(ctx context.Context, userIDHex string) (*entity.User, error) {
+	objectID, err := primitive.ObjectIDFromHex(userIDHex)
+	if err != nil {
+		return nil, errors.New("invalid user ID format")
+	}
+	user, err := u.repo.GetUserByID(ctx, objectID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+	if !user.IsActive {
+		return nil, ErrUserInactive
+	}
+	return user, nil
+}
+
+// validateTwoFactorCode decrypts the stored secret and checks the code against it. A code is
+// accepted once: codes from a time step the user already authenticated with are rejected.
+func (u *UserUsecase) validateTwoFactorCode(ctx context.Context, user *entity.User, code string) error {
+	secret, err := u.twoFactor.SecretBox.Decrypt(user.TwoFactorSecret)
+	if err != nil {
+		u.logger.Error("Failed to decrypt two-factor secret", zap.String("userID", user.ID.Hex()), zap.Error(err))
+		return err
+	}
+	step, ok := totp.ValidateStep(code, secret, time.Now())
+	if !ok {
+		return ErrInvalidTwoFactorCode
+	}
+	claimed, err := u.repo.ClaimTwoFactorStep(ctx, user.ID.Hex(), step, totp.CodeLifetime)
+	if err != nil {
+		u.logger.Error("Failed to record used two-factor code", zap.String("userID", user.ID.Hex()), zap.Error(err))
+		return err
+	}
+	if !claimed {
+		u.logger.Warn("Rejected reused two-factor code", zap.String("userID", user.ID.Hex()))
+		return ErrInvalidTwoFactorCode
+	}
+	return nil
+}
+
+// checkTwoFactorCode validates a code under the login lockout: wrong codes count as failed
+// logins, and a locked account gets ErrAccountLocked without the code being checked.
+func (u *UserUsecase) checkTwoFactorCode(ctx context.Context, user *entity.User, code string) error {
+	userIDHex := user.ID.Hex()
+	failedAttempts, err := u.checkLoginLockout(ctx, userIDHex)
+	if err != nil {
+		return err
+	}
+	if err := u.validateTwoFactorCode(ctx, user, code); err != nil {
+		if errors.Is(err, ErrInvalidTwoFactorCode) {
+			u.recordFailedLogin(ctx, userIDHex)
+		}
+		return err
+	}
+	u.resetLoginFailures(ctx, userIDHex, failedAttempts)
+	return nil
+}
+
+// EnableTwoFactor starts 2FA setup: it generates a new TOTP secret and stores it encrypted.
+// 2FA only becomes active once the user proves possession with ConfirmTwoFactor.
+func (u *UserUsecase) EnableTwoFactor(ctx context.Context, userIDHex string) (string, string, error) {
+	u.logger.Info("EnableTwoFactor: Starting two-factor setup", zap.String("userID", userIDHex))
+	user, err := u.getActiveUserByHexID(ctx, userIDHex)
+	if err != nil {
+		return "", "", err
+	}
+	if user.TwoFactorEnabled {
+		return "", "", ErrTwoFactorAlreadyEnabled
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		u.logger.Error("EnableTwoFactor: Failed to generate secret", zap.String("userID", userIDHex), zap.Error(err))
+		return "", "", err
+	}
+	encrypted, err := u.twoFactor.SecretBox.Encrypt(secret)
+	if err != nil {
+		u.logger.Error("EnableTwoFactor: Failed to encrypt secret", zap.String("userID", userIDHex), zap.Error(err))
+		return "", "", err
+	}
+	if err := u.repo.SaveTwoFactorSecret(ctx, user.ID, encrypted); err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return "", "", ErrUserNotFound
+		}
+		return "", "", err
+	}
+
+	u.logger.Info("EnableTwoFactor: Secret generated, awaiting confirmation", zap.String("userID", userIDHex))
+	return secret, totp.URL(u.twoFactor.Issuer, user.Email, secret), nil
+}
+
+// ConfirmTwoFactor activates 2FA after checking a code generated from the pending secret.
+// Wrong codes count towards the login lockout, like in VerifyTwoFactor.
+func (u *UserUsecase) ConfirmTwoFactor(ctx context.Context, userIDHex, code string) error {
+	u.logger.Info("ConfirmTwoFactor: Confirming two-factor setup", zap.String("userID", userIDHex))
+	user, err := u.getActiveUserByHexID(ctx, userIDHex)
+	if err != nil {
+		return err
+	}
+	if user.TwoFactorEnabled {
+		return ErrTwoFactorAlreadyEnabled
+	}
+	if user.TwoFactorSecret == "" {
+		return ErrTwoFactorNotInitiated
+	}
+	if err := u.checkTwoFactorCode(ctx, user, code); err != nil {
+		u.logger.Warn("ConfirmTwoFactor: Code rejected", zap.String("userID", userIDHex), zap.Error(err))
+		return err
+	}
+
+	if err := u.repo.SetTwoFactorEnabled(ctx, user.ID, true); err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return ErrUserNotFound
+		}
+		return err
+	}
+	u.logger.Info("ConfirmTwoFactor: Two-factor authentication enabled", zap.String("userID", userIDHex))
+	return nil
+}
+
+// DisableTwoFactor turns 2FA off; a current code is required so a stolen session alone cannot do it.
+// Wrong codes count towards the login lockout, like in VerifyTwoFactor.
+func (u *UserUsecase) DisableTwoFactor(ctx context.Context, userIDHex, code string) error {
+	u.logger.Info("DisableTwoFactor: Attempting to disable two-factor authentication", zap.String("userID", userIDHex))
+	user, err := u.getActiveUserByHexID(ctx, userIDHex)
+	if err != nil {
+		return err
+	}
+	if !user.TwoFactorEnabled {
+		return ErrTwoFactorNotEnabled
+	}
+	if err := u.checkTwoFactorCode(ctx, user, code); err != nil {
+		u.logger.Warn("DisableTwoFactor: Code rejected", zap.String("userID", userIDHex), zap.Error(err))
+		return err
+	}
+
+	if err := u.repo.SetTwoFactorEnabled(ctx, user.ID, false); err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return ErrUserNotFound
+		}
+		return err
+	}
+	u.logger.Info("DisableTwoFactor: Two-factor authentication disabled", zap.String("userID", userIDHex))
+	return nil
+}
+
+// VerifyTwoFactor completes a login started with Login for a 2FA-enabled user.
+// Wrong codes count towards the same per-account lockout as wrong passwords.
+func (u *UserUsecase) VerifyTwoFactor(ctx context.Context, pendingToken, code string) (*LoginResult, error) {
+	userIDHex, err := jwt.ParseTwoFactorPendingToken(pendingToken, u.jwtSecret)
+	if err != nil {
+		u.logger.Warn("VerifyTwoFactor: Pending token rejected", zap.Error(err))
+		return nil, ErrInvalidPendingToken
+	}
+	u.logger.Info("VerifyTwoFactor: Verifying second factor", zap.String("userID", userIDHex))
+
+	user, err := u.getActiveUserByHexID(ctx, userIDHex)
+	if err != nil {
+		if errors.Is(err, ErrUserNotFound) {
+			return nil, ErrInvalidPendingToken
+		}
+		return nil, err
+	}
+	if !user.TwoFactorEnabled {
+		return nil, ErrTwoFactorNotEnabled
+	}
+
+	if err := u.checkTwoFactorCode(ctx, user, code); err != nil {
+		u.logger.Warn("VerifyTwoFactor: Code rejected", zap.String("userID", userIDHex), zap.Error(err))
+		return nil, err
+	}
+
+	result, err := u.issueTokens(ctx, userIDHex)
+	if err != nil {
+		return nil, err
+	}
+	u.recordLastLogin(ctx, user.ID)
+	u.logger.Info("VerifyTwoFactor: User logged in successfully with two-factor authentication", zap.String("userID", userIDHex))
+	return result, nil
+}
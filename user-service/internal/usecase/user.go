@@ -13,6 +13,7 @@ import (
 	"github.com/Abdurahmanit/GroupProject/user-service/internal/jwt"
 	"github.com/Abdurahmanit/GroupProject/user-service/internal/mailer"
 	"github.com/Abdurahmanit/GroupProject/user-service/internal/repository"
+	"github.com/Abdurahmanit/GroupProject/user-service/internal/secretbox"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.uber.org/zap"
 	"golang.org/x/crypto/bcrypt"
@@ -20,16 +21,23 @@ import (
 
 var (
 	ErrInvalidCredentials      = errors.New("invalid email or password")
+	ErrAccountLocked           = errors.New("too many failed login attempts, please try again later")
 	ErrUnauthorized            = errors.New("unauthorized")
 	ErrUserInactive            = errors.New("user account is inactive")
 	ErrInvalidPhoneNumber      = errors.New("invalid phone number format")
 	ErrPhoneNumberRequired     = errors.New("phone number is required")
 	ErrDuplicatePhoneNumber    = errors.New("phone number already exists")
 	ErrDuplicateEmail          = errors.New("email already exists")
+	ErrDuplicateUsername       = errors.New("username already exists")
 	ErrEmailAlreadyVerified    = errors.New("email is already verified")
 	ErrInvalidVerificationCode = errors.New("invalid or expired verification code")
 	ErrMailerFailed            = errors.New("failed to send verification email")
+	ErrVerificationCooldown    = errors.New("verification email was sent recently, please wait before requesting another")
 	ErrUserNotFound            = errors.New("user not found")
+	ErrInvalidResetCode        = errors.New("invalid or expired password reset code")
+	ErrInvalidRefreshToken     = errors.New("invalid refresh token")
+	ErrRefreshTokenExpired     = errors.New("refresh token has expired")
+	ErrTokenRevoked            = errors.New("token has been revoked")
 )
 
 var phoneRegex = regexp.MustCompile(`^\+?[1-9]\d{1,14}$`)
@@ -37,19 +45,135 @@ var phoneRegex = regexp.MustCompile(`^\+?[1-9]\d{1,14}$`)
 const verificationCodeLength = 6
 const verificationCodeExpiryMinutes = 15
 
+const (
+	defaultAdminPageLimit int64 = 20
+	maxAdminPageLimit     int64 = 100
+)
+
+// LoginLockoutPolicy limits failed logins per account, whichever identifier (email or phone number)
+// is used: once MaxFailedAttempts failures happen within Window, Login returns ErrAccountLocked
+// until the window expires. Identifiers that match no account are limited on their own.
+type LoginLockoutPolicy struct {
+	MaxFailedAttempts int
+	Window            time.Duration
+}
+
+// PasswordResetPolicy configures password reset codes: a code is valid for CodeExpiry and is
+// discarded after MaxFailedAttempts wrong guesses, so it cannot be brute-forced.
+type PasswordResetPolicy struct {
+	CodeExpiry        time.Duration
+	MaxFailedAttempts int
+}
+
+// TwoFactorSettings configures TOTP two-factor authentication.
+type TwoFactorSettings struct {
+	Issuer    string         // Account issuer shown in authenticator apps
+	SecretBox *secretbox.Box // Encrypts TOTP secrets before they are stored
+}
+
+// UserEventPublisher announces account lifecycle changes to other services.
+type UserEventPublisher interface {
+	PublishUserDeleted(ctx context.Context, userID string) error
+}
+
 type UserUsecase struct {
-	repo      *repository.UserRepository
-	mailer    mailer.Mailer
-	jwtSecret string
-	logger    *zap.Logger
+	repo                   *repository.UserRepository
+	mailer                 mailer.Mailer
+	jwtSecret              string
+	passwordReset          PasswordResetPolicy
+	loginLockout           LoginLockoutPolicy
+	twoFactor              TwoFactorSettings
+	passwordPolicy         PasswordPolicy
+	idempotentRegistration bool
+	verificationCooldown   time.Duration
+	emailBlocklist         *EmailBlocklist
+	avatars                AvatarSettings
+	events                 UserEventPublisher
+	logger                 *zap.Logger
+}
+
+// UserUsecaseConfig holds the account policies of a UserUsecase.
+type UserUsecaseConfig struct {
+	JWTSecret      string
+	PasswordReset  PasswordResetPolicy
+	LoginLockout   LoginLockoutPolicy
+	TwoFactor      TwoFactorSettings
+	PasswordPolicy PasswordPolicy
+	// IdempotentRegistration lets a repeated registration of an unverified account succeed
+	// instead of failing with ErrDuplicateEmail.
+	IdempotentRegistration bool
+	// VerificationCooldown is the minimum time between verification emails; 0 disables it.
+	VerificationCooldown time.Duration
+	EmailBlocklist       *EmailBlocklist
+	Avatars              AvatarSettings
+	// Events publishes user.deleted when an account is deleted; nil disables the events.
+	Events UserEventPublisher
 }
 
-func NewUserUsecase(repo *repository.UserRepository, mailer mailer.Mailer, jwtSecret string, logger *zap.Logger) *UserUsecase {
+func NewUserUsecase(repo *repository.UserRepository, mailer mailer.Mailer, cfg UserUsecaseConfig, logger *zap.Logger) *UserUsecase {
 	return &UserUsecase{
-		repo:      repo,
-		mailer:    mailer,
-		jwtSecret: jwtSecret,
-		logger:    logger.Named("UserUsecase"),
+		repo:                   repo,
+		mailer:                 mailer,
+		jwtSecret:              cfg.JWTSecret,
+		passwordReset:          cfg.PasswordReset,
+		loginLockout:           cfg.LoginLockout,
+		twoFactor:              cfg.TwoFactor,
+		passwordPolicy:         cfg.PasswordPolicy,
+		idempotentRegistration: cfg.IdempotentRegistration,
+		verificationCooldown:   cfg.VerificationCooldown,
+		emailBlocklist:         cfg.EmailBlocklist,
+		avatars:                cfg.Avatars,
+		events:                 cfg.Events,
+		logger:                 logger.Named("UserUsecase"),
+	}
+}
+
+// publishUserDeleted tells other services the user is gone. The deletion has already
+// succeeded, so a failed publish is only logged.
+func (u *UserUsecase) publishUserDeleted(ctx context.Context, userIDHex string) {
+	if u.events == nil {
+		return
+	}
+	if err := u.events.PublishUserDeleted(ctx, userIDHex); err != nil {
+		u.logger.Error("Failed to publish user.deleted event", zap.String("userID", userIDHex), zap.Error(err))
+	}
+}
+
+// checkLoginLockout returns the failed login count for subject (a user ID, or the identifier
+// of an unknown account) and ErrAccountLocked once it reaches the limit. A counter that cannot
+// be read is logged and treated as zero, so Redis trouble does not block logins.
+func (u *UserUsecase) checkLoginLockout(ctx context.Context, subject string) (int64, error) {
+	failedAttempts, err := u.repo.GetLoginFailures(ctx, subject)
+	if err != nil {
+		u.logger.Error("Failed to read failed login counter, continuing without lockout check", zap.String("subject", subject), zap.Error(err))
+		return 0, nil
+	}
+	if failedAttempts >= int64(u.loginLockout.MaxFailedAttempts) {
+		u.logger.Warn("Login attempt for locked account", zap.String("subject", subject), zap.Int64("failedAttempts", failedAttempts))
+		return failedAttempts, ErrAccountLocked
+	}
+	return failedAttempts, nil
+}
+
+func (u *UserUsecase) recordFailedLogin(ctx context.Context, subject string) {
+	count, err := u.repo.IncrementLoginFailures(ctx, subject, u.loginLockout.Window)
+	if err != nil {
+		u.logger.Error("Failed to record failed login attempt", zap.String("subject", subject), zap.Error(err))
+		return
+	}
+	if count >= int64(u.loginLockout.MaxFailedAttempts) {
+		u.logger.Warn("Login locked after too many failed attempts", zap.String("subject", subject), zap.Int64("failedAttempts", count))
+	}
+}
+
+// resetLoginFailures clears the counter after a successful login; failedAttempts is the count
+// read before the attempt, so the common case of no failures skips the Redis call.
+func (u *UserUsecase) resetLoginFailures(ctx context.Context, subject string, failedAttempts int64) {
+	if failedAttempts == 0 {
+		return
+	}
+	if err := u.repo.ResetLoginFailures(ctx, subject); err != nil {
+		u.logger.Warn("Failed to reset failed login counter", zap.String("subject", subject), zap.Error(err))
 	}
 }
 
@@ -101,9 +225,19 @@ func (u *UserUsecase) Register(ctx context.Context, username, email, password, p
 	if !phoneRegex.MatchString(phoneNumber) {
 		return "", ErrInvalidPhoneNumber
 	}
+	if err := u.validatePassword(password); err != nil {
+		u.logger.Warn("Register: Password rejected by policy", zap.String("email", email), zap.Error(err))
+		return "", err
+	}
+	if err := u.checkEmailAllowed("register", email); err != nil {
+		return "", err
+	}
 
-	_, err := u.repo.GetUserByEmail(ctx, email)
+	existingUser, err := u.repo.GetUserByEmail(ctx, email)
 	if err == nil {
+		if userID, ok := u.replayRegistration(ctx, existingUser, password); ok {
+			return userID, nil
+		}
 		return "", ErrDuplicateEmail
 	} else if !errors.Is(err, repository.ErrUserNotFound) {
 		return "", err
@@ -116,6 +250,13 @@ func (u *UserUsecase) Register(ctx context.Context, username, email, password, p
 		return "", err
 	}
 
+	_, err = u.repo.GetUserByUsername(ctx, username)
+	if err == nil {
+		return "", ErrDuplicateUsername
+	} else if !errors.Is(err, repository.ErrUserNotFound) {
+		return "", err
+	}
+
 	userEntity := &entity.User{
 		Username:        username,
 		Email:           email,
@@ -146,34 +287,181 @@ func (u *UserUsecase) Register(ctx context.Context, username, email, password, p
 	return objectID.Hex(), nil
 }
 
-func (u *UserUsecase) Login(ctx context.Context, email, password string) (string, error) {
-	u.logger.Info("Login attempt", zap.String("email", email))
-	user, err := u.repo.GetUserByEmail(ctx, email)
+// replayRegistration makes a repeated Register call idempotent when enabled: if the existing
+// account is still active and unverified and the password matches, the earlier registration is
+// treated as the one being retried, so its ID is returned and the verification email resent.
+func (u *UserUsecase) replayRegistration(ctx context.Context, existing *entity.User, password string) (string, bool) {
+	if !u.idempotentRegistration || existing.IsEmailVerified || !existing.IsActive {
+		return "", false
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(existing.Password), []byte(password)); err != nil {
+		return "", false
+	}
+
+	u.logger.Info("Register: Repeated registration for unverified account, returning existing user", zap.String("userID", existing.ID.Hex()))
+	if err := u.internalSendVerificationEmail(ctx, existing); err != nil {
+		u.logger.Error("Register: Failed to resend verification email for repeated registration", zap.String("userID", existing.ID.Hex()), zap.Error(err))
+	}
+	return existing.ID.Hex(), true
+}
+
+// LoginResult is the outcome of a successful password check. When TwoFactorRequired is set
+// only PendingToken is filled and the client has to finish the login with VerifyTwoFactor.
+type LoginResult struct {
+	AccessToken       string
+	RefreshToken      string
+	TwoFactorRequired bool
+	PendingToken      string
+}
+
+// lookupLoginUser resolves a login identifier: values matching phoneRegex are treated as
+// phone numbers, anything else as an email address.
+func (u *UserUsecase) lookupLoginUser(ctx context.Context, identifier string) (*entity.User, error) {
+	if phoneRegex.MatchString(identifier) {
+		return u.repo.GetUserByPhoneNumber(ctx, identifier)
+	}
+	return u.repo.GetUserByEmail(ctx, identifier)
+}
+
+// Login returns a short-lived access token and a long-lived refresh token, or a pending
+// token when the user has two-factor authentication enabled.
+// The refresh token is cached per session so that Logout can revoke it.
+// identifier is an email address or, when it matches phoneRegex, a phone number.
+func (u *UserUsecase) Login(ctx context.Context, identifier, password string) (*LoginResult, error) {
+	u.logger.Info("Login attempt", zap.String("identifier", identifier))
+
+	user, err := u.lookupLoginUser(ctx, identifier)
 	if err != nil {
 		if errors.Is(err, repository.ErrUserNotFound) {
-			u.logger.Warn("Login attempt for non-existent user", zap.String("email", email))
-			return "", ErrInvalidCredentials
+			u.logger.Warn("Login attempt for non-existent user", zap.String("identifier", identifier))
+			if _, err := u.checkLoginLockout(ctx, identifier); err != nil {
+				return nil, err
+			}
+			u.recordFailedLogin(ctx, identifier)
+			return nil, ErrInvalidCredentials
 		}
-		u.logger.Error("Error fetching user by email during login", zap.String("email", email), zap.Error(err))
-		return "", err
+		u.logger.Error("Error fetching user during login", zap.String("identifier", identifier), zap.Error(err))
+		return nil, err
+	}
+
+	// Count failures per account so switching between email and phone number does not reset them.
+	failedAttempts, err := u.checkLoginLockout(ctx, user.ID.Hex())
+	if err != nil {
+		return nil, err
 	}
 
 	if !user.IsActive {
-		u.logger.Warn("Login attempt for inactive user", zap.String("email", email), zap.String("userID", user.ID.Hex()))
-		return "", ErrUserInactive
+		u.logger.Warn("Login attempt for inactive user", zap.String("identifier", identifier), zap.String("userID", user.ID.Hex()))
+		return nil, ErrUserInactive
 	}
 	err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password))
 	if err != nil {
-		u.logger.Warn("Invalid password attempt", zap.String("email", email), zap.String("userID", user.ID.Hex()))
-		return "", ErrInvalidCredentials
+		u.logger.Warn("Invalid password attempt", zap.String("identifier", identifier), zap.String("userID", user.ID.Hex()))
+		u.recordFailedLogin(ctx, user.ID.Hex())
+		return nil, ErrInvalidCredentials
+	}
+	u.resetLoginFailures(ctx, user.ID.Hex(), failedAttempts)
+
+	if user.TwoFactorEnabled {
+		pendingToken, err := jwt.GenerateTwoFactorPendingToken(user.ID.Hex(), u.jwtSecret)
+		if err != nil {
+			u.logger.Error("Failed to generate two-factor pending token", zap.String("userID", user.ID.Hex()), zap.Error(err))
+			return nil, errors.New("failed to generate token")
+		}
+		u.logger.Info("Password verified, two-factor code required", zap.String("userID", user.ID.Hex()))
+		return &LoginResult{TwoFactorRequired: true, PendingToken: pendingToken}, nil
+	}
+
+	result, err := u.issueTokens(ctx, user.ID.Hex())
+	if err != nil {
+		return nil, err
+	}
+	u.recordLastLogin(ctx, user.ID)
+	u.logger.Info("User logged in successfully", zap.String("userID", user.ID.Hex()), zap.String("identifier", identifier))
+	return result, nil
+}
+
+// issueTokens starts a new login session and creates its access/refresh token pair.
+func (u *UserUsecase) issueTokens(ctx context.Context, userIDHex string) (*LoginResult, error) {
+	tokenString, err := jwt.GenerateToken(userIDHex, u.jwtSecret)
+	if err != nil {
+		u.logger.Error("Failed to generate JWT", zap.String("userID", userIDHex), zap.Error(err))
+		return nil, errors.New("failed to generate token")
+	}
+	sessionID, err := jwt.NewSessionID()
+	if err != nil {
+		u.logger.Error("Failed to generate session ID", zap.String("userID", userIDHex), zap.Error(err))
+		return nil, errors.New("failed to generate token")
 	}
+	refreshToken, err := jwt.GenerateRefreshToken(userIDHex, sessionID, u.jwtSecret)
+	if err != nil {
+		u.logger.Error("Failed to generate refresh token", zap.String("userID", userIDHex), zap.Error(err))
+		return nil, errors.New("failed to generate token")
+	}
+	if err := u.repo.CacheSession(ctx, userIDHex, sessionID, jwt.RefreshTokenTTL); err != nil {
+		u.logger.Error("Failed to cache login session", zap.String("userID", userIDHex), zap.Error(err))
+		return nil, errors.New("failed to generate token")
+	}
+	return &LoginResult{AccessToken: tokenString, RefreshToken: refreshToken}, nil
+}
 
-	tokenString, err := jwt.GenerateToken(user.ID.Hex(), u.jwtSecret)
+// recordLastLogin stores the login time. Failures are only logged so they never block a login.
+func (u *UserUsecase) recordLastLogin(ctx context.Context, userID primitive.ObjectID) {
+	if err := u.repo.UpdateLastLogin(ctx, userID, time.Now()); err != nil {
+		u.logger.Warn("Failed to record last login", zap.String("userID", userID.Hex()), zap.Error(err))
+	}
+}
+
+// RefreshToken exchanges the refresh token of a live session for a new access token.
+func (u *UserUsecase) RefreshToken(ctx context.Context, refreshToken string) (string, error) {
+	claims, err := jwt.ParseRefreshToken(refreshToken, u.jwtSecret)
 	if err != nil {
-		u.logger.Error("Failed to generate JWT", zap.String("userID", user.ID.Hex()), zap.Error(err))
+		u.logger.Warn("Refresh token rejected", zap.Error(err))
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return "", ErrRefreshTokenExpired
+		}
+		return "", ErrInvalidRefreshToken
+	}
+	userIDHex := claims.UserID
+	u.logger.Info("Refresh token attempt", zap.String("userID", userIDHex))
+
+	active, err := u.refreshSessionActive(ctx, claims, refreshToken)
+	if err != nil {
+		u.logger.Error("Failed to read login session", zap.String("userID", userIDHex), zap.Error(err))
+		return "", err
+	}
+	if !active {
+		u.logger.Warn("Refresh token was revoked or its session expired", zap.String("userID", userIDHex))
+		return "", ErrInvalidRefreshToken
+	}
+
+	objectID, err := primitive.ObjectIDFromHex(userIDHex)
+	if err != nil {
+		return "", ErrInvalidRefreshToken
+	}
+	user, err := u.repo.GetUserByID(ctx, objectID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			u.logger.Warn("Refresh token presented for non-existent user", zap.String("userID", userIDHex))
+			return "", ErrInvalidRefreshToken
+		}
+		u.logger.Error("Error fetching user during token refresh", zap.String("userID", userIDHex), zap.Error(err))
+		return "", err
+	}
+	if !user.IsActive {
+		u.logger.Warn("Refresh token presented for inactive user", zap.String("userID", userIDHex))
+		if err := u.repo.InvalidateToken(ctx, userIDHex); err != nil {
+			u.logger.Warn("Failed to invalidate refresh token of inactive user", zap.String("userID", userIDHex), zap.Error(err))
+		}
+		return "", ErrUserInactive
+	}
+
+	tokenString, err := jwt.GenerateToken(userIDHex, u.jwtSecret)
+	if err != nil {
+		u.logger.Error("Failed to generate JWT during refresh", zap.String("userID", userIDHex), zap.Error(err))
 		return "", errors.New("failed to generate token")
 	}
-	u.logger.Info("User logged in successfully", zap.String("userID", user.ID.Hex()), zap.String("email", email))
+	u.logger.Info("Access token refreshed successfully", zap.String("userID", userIDHex))
 	return tokenString, nil
 }
 
@@ -197,6 +485,16 @@ func (u *UserUsecase) RequestEmailVerification(ctx context.Context, userIDHex st
 		return ErrEmailAlreadyVerified
 	}
 
+	if u.verificationCooldown > 0 {
+		acquired, err := u.repo.AcquireVerificationEmailCooldown(ctx, userIDHex, u.verificationCooldown)
+		if err != nil {
+			u.logger.Error("RequestEmailVerification: Failed to check resend cooldown, continuing without it", zap.String("userID", userIDHex), zap.Error(err))
+		} else if !acquired {
+			u.logger.Warn("RequestEmailVerification: Resend requested during cooldown", zap.String("userID", userIDHex))
+			return ErrVerificationCooldown
+		}
+	}
+
 	return u.internalSendVerificationEmail(ctx, user)
 }
 
@@ -262,6 +560,126 @@ func (u *UserUsecase) CheckEmailVerificationStatus(ctx context.Context, userIDHe
 	return user.IsEmailVerified, nil
 }
 
+// RequestPasswordReset emails a reset code to the account owner. It reports success for
+// unknown or inactive accounts as well, so callers cannot probe which emails are registered.
+func (u *UserUsecase) RequestPasswordReset(ctx context.Context, email string) error {
+	u.logger.Info("RequestPasswordReset: Password reset requested", zap.String("email", email))
+
+	user, err := u.repo.GetUserByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			u.logger.Info("RequestPasswordReset: No user with this email, nothing sent", zap.String("email", email))
+			return nil
+		}
+		u.logger.Error("RequestPasswordReset: Error fetching user by email", zap.String("email", email), zap.Error(err))
+		return err
+	}
+	if !user.IsActive {
+		u.logger.Info("RequestPasswordReset: User is inactive, nothing sent", zap.String("userID", user.ID.Hex()))
+		return nil
+	}
+
+	code, err := generateVerificationCode(verificationCodeLength)
+	if err != nil {
+		u.logger.Error("RequestPasswordReset: Failed to generate reset code", zap.String("userID", user.ID.Hex()), zap.Error(err))
+		return fmt.Errorf("could not generate password reset code: %w", err)
+	}
+	expiresAt := time.Now().Add(u.passwordReset.CodeExpiry)
+
+	if err := u.repo.SavePasswordResetDetails(ctx, user.ID, code, expiresAt); err != nil {
+		u.logger.Error("RequestPasswordReset: Failed to save reset code to repository", zap.String("userID", user.ID.Hex()), zap.Error(err))
+		return err
+	}
+	if err := u.repo.ResetPasswordResetFailures(ctx, user.Email); err != nil {
+		u.logger.Warn("RequestPasswordReset: Failed to reset failed attempt counter", zap.String("userID", user.ID.Hex()), zap.Error(err))
+	}
+
+	if err := u.mailer.SendPasswordReset(user.Email, user.Username, code, u.passwordReset.CodeExpiry); err != nil {
+		// Not returned to the caller: a mailer error would reveal that the account exists.
+		u.logger.Error("RequestPasswordReset: Failed to send password reset email via mailer", zap.String("userID", user.ID.Hex()), zap.Error(err))
+		return nil
+	}
+
+	u.logger.Info("RequestPasswordReset: Password reset email sent successfully", zap.String("userID", user.ID.Hex()))
+	return nil
+}
+
+func (u *UserUsecase) ResetPassword(ctx context.Context, email, code, newPassword string) error {
+	u.logger.Info("ResetPassword: Attempting to reset password", zap.String("email", email))
+
+	user, err := u.repo.GetUserByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			u.logger.Warn("ResetPassword: No user with this email", zap.String("email", email))
+			return ErrInvalidResetCode
+		}
+		u.logger.Error("ResetPassword: Error fetching user by email", zap.String("email", email), zap.Error(err))
+		return err
+	}
+
+	if user.PasswordResetCode == "" || user.PasswordResetCodeExpiresAt == nil {
+		u.logger.Warn("ResetPassword: No reset code found or expiry not set for user", zap.String("userID", user.ID.Hex()))
+		return ErrInvalidResetCode
+	}
+	if user.PasswordResetCode != code {
+		u.logger.Warn("ResetPassword: Invalid reset code provided", zap.String("userID", user.ID.Hex()))
+		return u.recordFailedReset(ctx, user)
+	}
+	if time.Now().After(*user.PasswordResetCodeExpiresAt) {
+		u.logger.Warn("ResetPassword: Reset code expired", zap.String("userID", user.ID.Hex()))
+		return ErrInvalidResetCode
+	}
+	if !user.IsActive {
+		u.logger.Warn("ResetPassword: Attempt to reset password for inactive user", zap.String("userID", user.ID.Hex()))
+		return ErrUserInactive
+	}
+	if err := u.validatePassword(newPassword); err != nil {
+		u.logger.Warn("ResetPassword: New password rejected by policy", zap.String("userID", user.ID.Hex()), zap.Error(err))
+		return err
+	}
+
+	if err := u.repo.UpdatePassword(ctx, user.ID, newPassword); err != nil {
+		u.logger.Error("ResetPassword: Failed to update password in repository", zap.String("userID", user.ID.Hex()), zap.Error(err))
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return ErrUserNotFound
+		}
+		return err
+	}
+
+	if err := u.repo.SavePasswordResetDetails(ctx, user.ID, "", time.Time{}); err != nil {
+		u.logger.Error("ResetPassword: Failed to clear reset code after password reset", zap.String("userID", user.ID.Hex()), zap.Error(err))
+	}
+	if err := u.repo.ResetPasswordResetFailures(ctx, user.Email); err != nil {
+		u.logger.Warn("ResetPassword: Failed to reset failed attempt counter", zap.String("userID", user.ID.Hex()), zap.Error(err))
+	}
+	if err := u.repo.InvalidateToken(ctx, user.ID.Hex()); err != nil {
+		u.logger.Warn("ResetPassword: Failed to invalidate refresh token after password reset", zap.String("userID", user.ID.Hex()), zap.Error(err))
+	}
+
+	u.logger.Info("ResetPassword: Password reset successfully", zap.String("userID", user.ID.Hex()))
+	return nil
+}
+
+// recordFailedReset counts a wrong reset code for the user's email and discards the code once
+// the limit is reached; the user then has to request a new one. It always returns an error:
+// ErrInvalidResetCode, or the Redis error, so a broken counter never allows unlimited guesses.
+func (u *UserUsecase) recordFailedReset(ctx context.Context, user *entity.User) error {
+	count, err := u.repo.IncrementPasswordResetFailures(ctx, user.Email, u.passwordReset.CodeExpiry)
+	if err != nil {
+		u.logger.Error("ResetPassword: Failed to record failed attempt", zap.String("userID", user.ID.Hex()), zap.Error(err))
+		return err
+	}
+	if count < int64(u.passwordReset.MaxFailedAttempts) {
+		return ErrInvalidResetCode
+	}
+	u.logger.Warn("ResetPassword: Too many failed attempts, discarding reset code", zap.String("userID", user.ID.Hex()), zap.Int64("failedAttempts", count))
+	if err := u.repo.SavePasswordResetDetails(ctx, user.ID, "", time.Time{}); err != nil {
+		u.logger.Error("ResetPassword: Failed to discard reset code", zap.String("userID", user.ID.Hex()), zap.Error(err))
+		return err
+	}
+	return ErrInvalidResetCode
+}
+
 func (u *UserUsecase) Logout(ctx context.Context, userIDHex string) error {
 	u.logger.Info("Logout attempt", zap.String("userID", userIDHex))
 	err := u.repo.InvalidateToken(ctx, userIDHex)
@@ -273,6 +691,56 @@ func (u *UserUsecase) Logout(ctx context.Context, userIDHex string) error {
 	return nil
 }
 
+// refreshSessionActive reports whether the refresh token still belongs to a live session.
+// Tokens issued before sessions existed are checked against the token cached per user.
+func (u *UserUsecase) refreshSessionActive(ctx context.Context, claims *jwt.RefreshClaims, refreshToken string) (bool, error) {
+	if claims.SessionID == "" {
+		cachedToken, err := u.repo.GetToken(ctx, claims.UserID)
+		return cachedToken != "" && cachedToken == refreshToken, err
+	}
+	revokedBefore, err := u.repo.GetTokensRevokedBefore(ctx, claims.UserID)
+	if err != nil {
+		return false, err
+	}
+	if tokenRevoked(claims.IssuedAt, revokedBefore) {
+		return false, nil
+	}
+	return u.repo.SessionExists(ctx, claims.UserID, claims.SessionID)
+}
+
+// tokenRevoked reports whether a token issued at issuedAt was revoked by InvalidateToken at
+// revokedBefore. Token times have second precision, so a token issued in the same second as
+// the revocation counts as revoked; tokens without an issue time are revoked by any revocation.
+func tokenRevoked(issuedAt, revokedBefore time.Time) bool {
+	if revokedBefore.IsZero() {
+		return false
+	}
+	return issuedAt.IsZero() || !issuedAt.After(revokedBefore)
+}
+
+// ValidateAccessToken checks that an access token was not revoked and returns the user ID it
+// belongs to. Logout, password reset, deactivation and deletion revoke every token the user
+// was issued until then; a new login on another device revokes nothing.
+// Revocations live in Redis: if it loses them, already revoked access tokens are accepted
+// again until they expire (AccessTokenTTL), while their refresh tokens stop working because
+// the sessions are gone too.
+func (u *UserUsecase) ValidateAccessToken(ctx context.Context, accessToken string) (string, error) {
+	userIDHex, issuedAt, err := jwt.ParseAccessToken(accessToken, u.jwtSecret)
+	if err != nil {
+		return "", ErrUnauthorized
+	}
+
+	revokedBefore, err := u.repo.GetTokensRevokedBefore(ctx, userIDHex)
+	if err != nil {
+		u.logger.Error("Failed to read token revocation", zap.String("userID", userIDHex), zap.Error(err))
+		return "", err
+	}
+	if tokenRevoked(issuedAt, revokedBefore) {
+		return "", ErrTokenRevoked
+	}
+	return userIDHex, nil
+}
+
 func (u *UserUsecase) GetProfile(ctx context.Context, userIDHex string) (*entity.User, error) {
 	u.logger.Info("Attempting to get profile in usecase", zap.String("userID", userIDHex))
 	objectID, err := primitive.ObjectIDFromHex(userIDHex)
@@ -319,7 +787,13 @@ func (u *UserUsecase) UpdateProfile(ctx context.Context, userIDHex, username, em
 	originalIsEmailVerified := currentUser.IsEmailVerified
 	originalEmailVerifiedAt := currentUser.EmailVerifiedAt
 
-	if username != "" {
+	if username != "" && username != currentUser.Username {
+		existingUserWithUsername, usernameErr := u.repo.GetUserByUsername(ctx, username)
+		if usernameErr == nil && existingUserWithUsername.ID != objectID {
+			return ErrDuplicateUsername
+		} else if usernameErr != nil && !errors.Is(usernameErr, repository.ErrUserNotFound) {
+			return usernameErr
+		}
 		updateUser.Username = username
 	}
 
@@ -329,6 +803,10 @@ func (u *UserUsecase) UpdateProfile(ctx context.Context, userIDHex, username, em
 			zap.String("oldEmail", currentUser.Email),
 			zap.String("newEmail", email))
 
+		if err := u.checkEmailAllowed("update_profile", email); err != nil {
+			return err
+		}
+
 		existingUserWithEmail, emailErr := u.repo.GetUserByEmail(ctx, email)
 		if emailErr == nil && existingUserWithEmail.ID != objectID {
 			return ErrDuplicateEmail
@@ -420,6 +898,10 @@ func (u *UserUsecase) ChangePassword(ctx context.Context, userIDHex, oldPassword
 		u.logger.Warn("Invalid old password provided for ChangePassword", zap.String("userID", userIDHex), zap.Error(err))
 		return ErrInvalidCredentials
 	}
+	if err := u.validatePassword(newPassword); err != nil {
+		u.logger.Warn("New password rejected by policy for ChangePassword", zap.String("userID", userIDHex), zap.Error(err))
+		return err
+	}
 
 	err = u.repo.UpdatePassword(ctx, objectID, newPassword)
 	if err != nil {
@@ -433,22 +915,24 @@ func (u *UserUsecase) ChangePassword(ctx context.Context, userIDHex, oldPassword
 	return nil
 }
 
+// DeleteUser soft-deletes the user's own account; an admin can restore it with RestoreUser.
 func (u *UserUsecase) DeleteUser(ctx context.Context, userIDHex string) error {
-	u.logger.Info("Attempting to hard delete user (user initiated)", zap.String("userID", userIDHex))
+	u.logger.Info("Attempting to soft delete user (user initiated)", zap.String("userID", userIDHex))
 	objectID, err := primitive.ObjectIDFromHex(userIDHex)
 	if err != nil {
 		u.logger.Error("Invalid user ID format for DeleteUser", zap.String("userIDHex", userIDHex), zap.Error(err))
 		return errors.New("invalid user ID format")
 	}
-	err = u.repo.HardDeleteUser(ctx, objectID)
+	err = u.repo.SoftDeleteUser(ctx, objectID)
 	if err != nil {
-		u.logger.Error("Failed to hard delete user", zap.String("userID", userIDHex), zap.Error(err))
+		u.logger.Error("Failed to soft delete user", zap.String("userID", userIDHex), zap.Error(err))
 		if errors.Is(err, repository.ErrUserNotFound) {
 			return ErrUserNotFound
 		}
 		return err
 	}
-	u.logger.Info("User hard deleted successfully", zap.String("userID", userIDHex))
+	u.logger.Info("User soft deleted successfully", zap.String("userID", userIDHex))
+	u.publishUserDeleted(ctx, userIDHex)
 	return nil
 }
 
@@ -508,8 +992,9 @@ func (u *UserUsecase) AdminCheck(ctx context.Context, adminIDHex string) (*entit
 	return admin, nil
 }
 
+// AdminDeleteUser soft-deletes another user. Use AdminPurgeUser to remove the document for good.
 func (u *UserUsecase) AdminDeleteUser(ctx context.Context, adminIDHex, userIDHex string) error {
-	u.logger.Info("Admin attempting to hard delete user", zap.String("adminID", adminIDHex), zap.String("targetUserID", userIDHex))
+	u.logger.Info("Admin attempting to soft delete user", zap.String("adminID", adminIDHex), zap.String("targetUserID", userIDHex))
 	admin, err := u.AdminCheck(ctx, adminIDHex)
 	if err != nil {
 		return err
@@ -519,40 +1004,116 @@ func (u *UserUsecase) AdminDeleteUser(ctx context.Context, adminIDHex, userIDHex
 		u.logger.Error("Invalid target user ID format for AdminDeleteUser", zap.String("userIDHex", userIDHex), zap.Error(err))
 		return errors.New("invalid user ID format for deletion")
 	}
-	err = u.repo.HardDeleteUser(ctx, userObjectID)
+	err = u.repo.SoftDeleteUser(ctx, userObjectID)
 	if err != nil {
-		u.logger.Error("Admin failed to hard delete user", zap.String("adminID", admin.ID.Hex()), zap.String("targetUserID", userIDHex), zap.Error(err))
+		u.logger.Error("Admin failed to soft delete user", zap.String("adminID", admin.ID.Hex()), zap.String("targetUserID", userIDHex), zap.Error(err))
 		if errors.Is(err, repository.ErrUserNotFound) {
 			return ErrUserNotFound
 		}
 		return err
 	}
-	u.logger.Info("Admin successfully hard deleted user", zap.String("adminID", admin.ID.Hex()), zap.String("targetUserID", userIDHex))
+	u.logger.Info("Admin successfully soft deleted user", zap.String("adminID", admin.ID.Hex()), zap.String("targetUserID", userIDHex))
+	u.publishUserDeleted(ctx, userIDHex)
 	return nil
 }
 
-func (u *UserUsecase) AdminListUsers(ctx context.Context, adminIDHex string, skip, limit int64) ([]*entity.User, error) {
+// RestoreUser brings back a soft-deleted user and reactivates the account. It fails with
+// ErrDuplicateEmail, ErrDuplicatePhoneNumber or ErrDuplicateUsername if the user's identity
+// was registered by someone else after the deletion.
+func (u *UserUsecase) RestoreUser(ctx context.Context, adminIDHex, userIDHex string) error {
+	u.logger.Info("Admin attempting to restore user", zap.String("adminID", adminIDHex), zap.String("targetUserID", userIDHex))
+	admin, err := u.AdminCheck(ctx, adminIDHex)
+	if err != nil {
+		return err
+	}
+	userObjectID, err := primitive.ObjectIDFromHex(userIDHex)
+	if err != nil {
+		return errors.New("invalid user ID format for restore")
+	}
+	if err := u.repo.RestoreUser(ctx, userObjectID); err != nil {
+		u.logger.Error("Admin failed to restore user", zap.String("adminID", admin.ID.Hex()), zap.String("targetUserID", userIDHex), zap.Error(err))
+		switch {
+		case errors.Is(err, repository.ErrUserNotFound):
+			return ErrUserNotFound
+		case errors.Is(err, repository.ErrDuplicateEmail):
+			return ErrDuplicateEmail
+		case errors.Is(err, repository.ErrDuplicatePhoneNumber):
+			return ErrDuplicatePhoneNumber
+		case errors.Is(err, repository.ErrDuplicateUsername):
+			return ErrDuplicateUsername
+		}
+		return err
+	}
+	u.logger.Info("Admin successfully restored user", zap.String("adminID", admin.ID.Hex()), zap.String("targetUserID", userIDHex))
+	return nil
+}
+
+// AdminPurgeUser permanently removes a user document, whether or not it was soft-deleted first.
+func (u *UserUsecase) AdminPurgeUser(ctx context.Context, adminIDHex, userIDHex string) error {
+	u.logger.Info("Admin attempting to purge user", zap.String("adminID", adminIDHex), zap.String("targetUserID", userIDHex))
+	admin, err := u.AdminCheck(ctx, adminIDHex)
+	if err != nil {
+		return err
+	}
+	userObjectID, err := primitive.ObjectIDFromHex(userIDHex)
+	if err != nil {
+		return errors.New("invalid user ID format for purge")
+	}
+	if err := u.repo.HardDeleteUser(ctx, userObjectID); err != nil {
+		u.logger.Error("Admin failed to purge user", zap.String("adminID", admin.ID.Hex()), zap.String("targetUserID", userIDHex), zap.Error(err))
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return ErrUserNotFound
+		}
+		return err
+	}
+	u.logger.Info("Admin successfully purged user", zap.String("adminID", admin.ID.Hex()), zap.String("targetUserID", userIDHex))
+	u.publishUserDeleted(ctx, userIDHex)
+	return nil
+}
+
+// NormalizeAdminPage applies defaults to skip/limit coming from admin list requests.
+func NormalizeAdminPage(skip, limit int64) (int64, int64) {
+	if skip < 0 {
+		skip = 0
+	}
+	if limit <= 0 {
+		limit = defaultAdminPageLimit
+	} else if limit > maxAdminPageLimit {
+		limit = maxAdminPageLimit
+	}
+	return skip, limit
+}
+
+// AdminListUsers returns one page of users matching filter, ordered by sort, together with the
+// total number of matches. skip and limit are normalized; see NormalizeAdminPage.
+func (u *UserUsecase) AdminListUsers(ctx context.Context, adminIDHex string, filter repository.UserFilter, sort repository.UserSort, skip, limit int64) ([]*entity.User, int64, error) {
 	u.logger.Info("Admin attempting to list users", zap.String("adminID", adminIDHex), zap.Int64("skip", skip), zap.Int64("limit", limit))
 	admin, err := u.AdminCheck(ctx, adminIDHex)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
-	users, err := u.repo.ListUsers(ctx, skip, limit)
+	skip, limit = NormalizeAdminPage(skip, limit)
+	users, err := u.repo.ListUsers(ctx, filter, sort, skip, limit)
 	if err != nil {
 		u.logger.Error("Admin failed to list users", zap.String("adminID", admin.ID.Hex()), zap.Error(err))
-		return nil, err
+		return nil, 0, err
 	}
-	u.logger.Info("Admin successfully listed users", zap.String("adminID", admin.ID.Hex()), zap.Int("count", len(users)))
-	return users, nil
+	total, err := u.repo.CountUsers(ctx, filter)
+	if err != nil {
+		u.logger.Error("Admin failed to count users", zap.String("adminID", admin.ID.Hex()), zap.Error(err))
+		return nil, 0, err
+	}
+	u.logger.Info("Admin successfully listed users", zap.String("adminID", admin.ID.Hex()), zap.Int("count", len(users)), zap.Int64("total", total))
+	return users, total, nil
 }
 
-func (u *UserUsecase) AdminSearchUsers(ctx context.Context, adminIDHex, query string, skip, limit int64) ([]*entity.User, error) {
+func (u *UserUsecase) AdminSearchUsers(ctx context.Context, adminIDHex, query string, filter repository.UserFilter, skip, limit int64) ([]*entity.User, error) {
 	u.logger.Info("Admin attempting to search users (usecase)", zap.String("adminID", adminIDHex), zap.String("query", query), zap.Int64("skip", skip), zap.Int64("limit", limit))
 	admin, err := u.AdminCheck(ctx, adminIDHex)
 	if err != nil {
 		return nil, err
 	}
-	users, err := u.repo.SearchUsers(ctx, query, skip, limit)
+	users, err := u.repo.SearchUsers(ctx, query, filter, skip, limit)
 	if err != nil {
 		u.logger.Error("Admin failed to search users (repository error)", zap.String("adminID", admin.ID.Hex()), zap.String("query", query), zap.Error(err))
 		return nil, err